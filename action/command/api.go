@@ -64,10 +64,14 @@ type Client struct {
 	serviceAccountSecret string
 
 	// Connect RPC service clients
-	releaseClient  v1connect.ReleaseServiceClient
-	planClient     v1connect.PlanServiceClient
-	rolloutClient  v1connect.RolloutServiceClient
-	actuatorClient v1connect.ActuatorServiceClient
+	releaseClient       v1connect.ReleaseServiceClient
+	planClient          v1connect.PlanServiceClient
+	rolloutClient       v1connect.RolloutServiceClient
+	actuatorClient      v1connect.ActuatorServiceClient
+	databaseClient      v1connect.DatabaseServiceClient
+	databaseGroupClient v1connect.DatabaseGroupServiceClient
+	revisionClient      v1connect.RevisionServiceClient
+	settingClient       v1connect.SettingServiceClient
 
 	// Client options
 	options ClientOptions
@@ -114,6 +118,10 @@ func NewClientWithOptions(url, serviceAccount, serviceAccountSecret string, opts
 		planClient:           v1connect.NewPlanServiceClient(httpClient, url, interceptors),
 		rolloutClient:        v1connect.NewRolloutServiceClient(httpClient, url, interceptors),
 		actuatorClient:       v1connect.NewActuatorServiceClient(httpClient, url, interceptors),
+		databaseClient:       v1connect.NewDatabaseServiceClient(httpClient, url, interceptors),
+		databaseGroupClient:  v1connect.NewDatabaseGroupServiceClient(httpClient, url, interceptors),
+		revisionClient:       v1connect.NewRevisionServiceClient(httpClient, url, interceptors),
+		settingClient:        v1connect.NewSettingServiceClient(httpClient, url, interceptors),
 	}
 
 	return &c, nil
@@ -267,6 +275,122 @@ func (c *Client) GetActuatorInfo(ctx context.Context) (*v1pb.ActuatorInfo, error
 	return resp.Msg, nil
 }
 
+// GetDatabase gets the database by the full resource name.
+func (c *Client) GetDatabase(ctx context.Context, databaseName string) (*v1pb.Database, error) {
+	resp, err := c.databaseClient.GetDatabase(ctx, connect.NewRequest(&v1pb.GetDatabaseRequest{
+		Name: databaseName,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %s", databaseName)
+	}
+	return resp.Msg, nil
+}
+
+// SyncDatabase syncs the schema of the database.
+func (c *Client) SyncDatabase(ctx context.Context, databaseName string) error {
+	if _, err := c.databaseClient.SyncDatabase(ctx, connect.NewRequest(&v1pb.SyncDatabaseRequest{
+		Name: databaseName,
+	})); err != nil {
+		return errors.Wrapf(err, "failed to sync database %s", databaseName)
+	}
+	return nil
+}
+
+// GetLatestSchemaChangelog returns the latest done changelog which records the database schema, or nil if not found.
+func (c *Client) GetLatestSchemaChangelog(ctx context.Context, databaseName string) (*v1pb.Changelog, error) {
+	for nextPageToken := ""; ; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err := c.databaseClient.ListChangelogs(ctx, connect.NewRequest(&v1pb.ListChangelogsRequest{
+			Parent:    databaseName,
+			PageSize:  c.options.PageSize,
+			PageToken: nextPageToken,
+			View:      v1pb.ChangelogView_CHANGELOG_VIEW_BASIC,
+		}))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list changelogs of database %s", databaseName)
+		}
+		for _, changelog := range resp.Msg.Changelogs {
+			if changelog.Status != v1pb.Changelog_DONE {
+				continue
+			}
+			switch changelog.Type {
+			case v1pb.Changelog_BASELINE, v1pb.Changelog_MIGRATE, v1pb.Changelog_SDL:
+				return changelog, nil
+			default:
+			}
+		}
+		if resp.Msg.NextPageToken == "" {
+			return nil, nil
+		}
+		nextPageToken = resp.Msg.NextPageToken
+	}
+}
+
+// DiffSchemaWithChangelog returns the statements to change the current database schema to the schema recorded by the changelog.
+func (c *Client) DiffSchemaWithChangelog(ctx context.Context, databaseName, changelogName string) (string, error) {
+	resp, err := c.databaseClient.DiffSchema(ctx, connect.NewRequest(&v1pb.DiffSchemaRequest{
+		Name:   databaseName,
+		Target: &v1pb.DiffSchemaRequest_Changelog{Changelog: changelogName},
+	}))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to diff schema of database %s", databaseName)
+	}
+	return resp.Msg.Diff, nil
+}
+
+// GetDatabaseGroup gets the database group with the matched databases.
+func (c *Client) GetDatabaseGroup(ctx context.Context, databaseGroupName string) (*v1pb.DatabaseGroup, error) {
+	resp, err := c.databaseGroupClient.GetDatabaseGroup(ctx, connect.NewRequest(&v1pb.GetDatabaseGroupRequest{
+		Name: databaseGroupName,
+		View: v1pb.DatabaseGroupView_DATABASE_GROUP_VIEW_FULL,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database group %s", databaseGroupName)
+	}
+	return resp.Msg, nil
+}
+
+// ListAllRevisions lists all the revisions of the database.
+func (c *Client) ListAllRevisions(ctx context.Context, databaseName string) ([]*v1pb.Revision, error) {
+	var revisions []*v1pb.Revision
+	for nextPageToken := ""; ; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err := c.revisionClient.ListRevisions(ctx, connect.NewRequest(&v1pb.ListRevisionsRequest{
+			Parent:    databaseName,
+			PageSize:  c.options.PageSize,
+			PageToken: nextPageToken,
+		}))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list revisions of database %s", databaseName)
+		}
+		revisions = append(revisions, resp.Msg.Revisions...)
+		if resp.Msg.NextPageToken == "" {
+			break
+		}
+		nextPageToken = resp.Msg.NextPageToken
+	}
+	return revisions, nil
+}
+
+// ListEnvironmentIDs returns the environment IDs in the order of the environment setting.
+func (c *Client) ListEnvironmentIDs(ctx context.Context) ([]string, error) {
+	resp, err := c.settingClient.GetSetting(ctx, connect.NewRequest(&v1pb.GetSettingRequest{
+		Name: "settings/" + v1pb.Setting_ENVIRONMENT.String(),
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment setting")
+	}
+	var environmentIDs []string
+	for _, environment := range resp.Msg.GetValue().GetEnvironmentSetting().GetEnvironments() {
+		environmentIDs = append(environmentIDs, environment.Id)
+	}
+	return environmentIDs, nil
+}
+
 // Close cleans up resources used by the Client
 func (c *Client) Close() error {
 	if c.httpClient != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/bytebase/bytebase/action/command/output"
 	"github.com/bytebase/bytebase/action/github"
 	"github.com/bytebase/bytebase/action/gitlab"
+	"github.com/bytebase/bytebase/action/sarif"
 	"github.com/bytebase/bytebase/action/world"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 )
@@ -25,6 +26,7 @@ func NewCheckCommand(w *world.World) *cobra.Command {
 	}
 	cmdCheck.Flags().StringVar(&w.CheckRelease, "check-release", "SKIP", "Whether to fail on warning/error. Valid values: SKIP, FAIL_ON_WARNING, FAIL_ON_ERROR")
 	cmdCheck.Flags().StringVar(&w.CustomRules, "custom-rules", "", "Custom linting rules in natural language for AI-powered validation")
+	cmdCheck.Flags().StringVar(&w.SARIFOutput, "sarif-output", "", "SARIF 2.1.0 output file location for the check results. Works on any platform")
 	return cmdCheck
 }
 
@@ -101,6 +103,12 @@ func runCheck(w *world.World) func(*cobra.Command, []string) error {
 		default:
 			// Unknown platform, no specific output handling
 		}
+		if w.SARIFOutput != "" {
+			w.Logger.Info("writing SARIF output to file", "file", w.SARIFOutput)
+			if err := sarif.WriteReleaseCheckToSARIF(checkReleaseResponse, args.Version, w.SARIFOutput); err != nil {
+				return err
+			}
+		}
 
 		// Evaluate check results and return errors based on CheckRelease flag
 		if w.CheckRelease == "SKIP" {
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/action/args"
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/world"
+)
+
+func NewDriftCommand(w *world.World) *cobra.Command {
+	// bytebase-action drift flags
+	cmdDrift := &cobra.Command{
+		Use:   "drift",
+		Short: "Detect the schema drift of the targets",
+		Args:  cobra.NoArgs,
+		RunE:  runDrift(w),
+	}
+	cmdDrift.Flags().BoolVar(&w.SyncBeforeDrift, "sync", true, "Sync the database schemas before detecting the drift. Otherwise, the drift status of the last sync is reported.")
+	return cmdDrift
+}
+
+func runDrift(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		ctx := cmd.Context()
+		client, err := NewClient(w.URL, w.ServiceAccount, w.ServiceAccountSecret)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client")
+		}
+
+		// Check version compatibility
+		CheckVersionCompatibility(w, client, args.Version)
+
+		databases, err := getTargetDatabases(ctx, client, w.Targets)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get target databases")
+		}
+
+		var driftedCount, errorCount int
+		results := []*world.DriftResult{}
+		for _, database := range databases {
+			result := &world.DriftResult{Database: database.Name}
+			results = append(results, result)
+
+			// The server compares the synced schema with the schema recorded by the latest changelog.
+			if w.SyncBeforeDrift {
+				w.Logger.Info("syncing database schema", "database", database.Name)
+				if err := client.SyncDatabase(ctx, database.Name); err != nil {
+					result.Error = err.Error()
+					errorCount++
+					continue
+				}
+				synced, err := client.GetDatabase(ctx, database.Name)
+				if err != nil {
+					result.Error = err.Error()
+					errorCount++
+					continue
+				}
+				database = synced
+			}
+			result.Drifted = database.Drifted
+			if !result.Drifted {
+				continue
+			}
+			driftedCount++
+
+			changelog, err := client.GetLatestSchemaChangelog(ctx, database.Name)
+			if err != nil {
+				w.Logger.Warn("failed to get the latest changelog", "database", database.Name, "error", err)
+				continue
+			}
+			if changelog == nil {
+				continue
+			}
+			diff, err := client.DiffSchemaWithChangelog(ctx, database.Name, changelog.Name)
+			if err != nil {
+				w.Logger.Warn("failed to get the schema diff", "database", database.Name, "error", err)
+				continue
+			}
+			result.Diff = diff
+		}
+		w.OutputMap.DriftResults = results
+		printDriftResults(cmd.OutOrStdout(), results)
+
+		if errorCount > 0 {
+			return errors.Errorf("failed to detect the schema drift of %d database(s)", errorCount)
+		}
+		if driftedCount > 0 {
+			return errors.Errorf("found schema drift in %d database(s). view on Bytebase", driftedCount)
+		}
+		return nil
+	}
+}
+
+func printDriftResults(out io.Writer, results []*world.DriftResult) {
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			fmt.Fprintf(out, "%s: error: %s\n", result.Database, result.Error)
+		case result.Drifted:
+			fmt.Fprintf(out, "%s: drifted\n", result.Database)
+			if result.Diff != "" {
+				fmt.Fprintln(out, "  Statements to change the current schema back to the recorded schema:")
+				for _, line := range strings.Split(strings.TrimRight(result.Diff, "\n"), "\n") {
+					fmt.Fprintf(out, "    %s\n", line)
+				}
+			}
+		default:
+			fmt.Fprintf(out, "%s: no drift\n", result.Database)
+		}
+	}
+}
@@ -34,8 +34,8 @@ func getReleaseFiles(w *world.World) ([]*v1pb.Release_File, string, error) {
 	h := sha256.New()
 
 	if w.Declarative {
-		// For declarative files, we need to concat all the file contents if it is rollout.
-		if w.IsRollout {
+		// For declarative files, we need to concat all the file contents if it is rollout or plan.
+		if w.IsRollout || w.IsPlan {
 			var contents []byte
 			for _, m := range matches {
 				content, err := os.ReadFile(m)
@@ -67,6 +67,13 @@ func writeOutputJSON(w *world.World) error {
 		outputData["checkResults"] = checkResultsMap
 	}
 
+	if w.OutputMap.PlanPreview != nil {
+		outputData["planPreview"] = w.OutputMap.PlanPreview
+	}
+	if w.OutputMap.DriftResults != nil {
+		outputData["driftResults"] = w.OutputMap.DriftResults
+	}
+
 	j, err := json.MarshalIndent(outputData, "", "  ")
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal output map")
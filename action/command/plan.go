@@ -0,0 +1,249 @@
+package command
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/action/args"
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const environmentPrefix = "environments/"
+
+func NewPlanCommand(w *world.World) *cobra.Command {
+	// bytebase-action plan flags
+	cmdPlan := &cobra.Command{
+		Use:   "plan",
+		Short: "Preview the files to apply to the targets without creating anything",
+		Args:  cobra.NoArgs,
+		RunE:  runPlan(w),
+	}
+	return cmdPlan
+}
+
+func runPlan(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		w.IsPlan = true
+		ctx := cmd.Context()
+		client, err := NewClient(w.URL, w.ServiceAccount, w.ServiceAccountSecret)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client")
+		}
+
+		// Check version compatibility
+		CheckVersionCompatibility(w, client, args.Version)
+
+		releaseFiles, _, err := getReleaseFiles(w)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get release files")
+		}
+		databases, err := getTargetDatabases(ctx, client, w.Targets)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get target databases")
+		}
+		environmentIDs, err := client.ListEnvironmentIDs(ctx)
+		if err != nil {
+			return err
+		}
+		revisions := map[string][]*v1pb.Revision{}
+		for _, database := range databases {
+			databaseRevisions, err := client.ListAllRevisions(ctx, database.Name)
+			if err != nil {
+				return err
+			}
+			revisions[database.Name] = databaseRevisions
+		}
+
+		preview, err := buildPlanPreview(releaseFiles, databases, revisions, environmentIDs)
+		if err != nil {
+			return errors.Wrapf(err, "failed to build plan preview")
+		}
+		w.OutputMap.PlanPreview = preview
+		printPlanPreview(cmd.OutOrStdout(), preview)
+
+		if len(preview.Problems) > 0 {
+			return errors.Errorf("found %d problem(s) in the plan", len(preview.Problems))
+		}
+		return nil
+	}
+}
+
+// buildPlanPreview computes the tasks the rollout would create from the release files.
+// It mirrors how the server creates tasks from a release: versioned files already applied to a database
+// and declarative files not newer than the applied declarative revisions are skipped,
+// and the tasks are grouped into stages by the environment order.
+func buildPlanPreview(files []*v1pb.Release_File, databases []*v1pb.Database, revisions map[string][]*v1pb.Revision, environmentIDs []string) (*world.PlanPreview, error) {
+	type fileWithVersion struct {
+		file    *v1pb.Release_File
+		version []uint64
+	}
+	var sortedFiles []fileWithVersion
+	versionSet := map[string]bool{}
+	for _, file := range files {
+		version, err := parseVersion(file.Version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid version of file %q", file.Path)
+		}
+		if file.Type == v1pb.Release_File_VERSIONED {
+			if versionSet[file.Version] {
+				return nil, errors.Errorf("found duplicate version %q", file.Version)
+			}
+			versionSet[file.Version] = true
+		}
+		sortedFiles = append(sortedFiles, fileWithVersion{file: file, version: version})
+	}
+	slices.SortStableFunc(sortedFiles, func(a, b fileWithVersion) int {
+		return compareVersion(a.version, b.version)
+	})
+
+	preview := &world.PlanPreview{
+		Stages:   []*world.PlanStage{},
+		Skipped:  []*world.PlanFile{},
+		Problems: []string{},
+	}
+	tasksByEnvironment := map[string][]*world.PlanFile{}
+	for _, database := range databases {
+		appliedSha256 := map[string]string{}
+		var maxVersioned, maxDeclarative []uint64
+		var maxVersionedString string
+		for _, revision := range revisions[database.Name] {
+			version, err := parseVersion(revision.Version)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid version of revision %q", revision.Name)
+			}
+			switch revision.Type {
+			case v1pb.Revision_DECLARATIVE:
+				if maxDeclarative == nil || compareVersion(maxDeclarative, version) < 0 {
+					maxDeclarative = version
+				}
+			default:
+				appliedSha256[revision.Version] = revision.SheetSha256
+				if maxVersioned == nil || compareVersion(maxVersioned, version) < 0 {
+					maxVersioned = version
+					maxVersionedString = revision.Version
+				}
+			}
+		}
+
+		environment := strings.TrimPrefix(database.GetEffectiveEnvironment(), environmentPrefix)
+		for _, f := range sortedFiles {
+			planFile := &world.PlanFile{
+				Database: database.Name,
+				File:     f.file.Path,
+				Version:  f.file.Version,
+			}
+			switch f.file.Type {
+			case v1pb.Release_File_DECLARATIVE:
+				if maxDeclarative != nil && compareVersion(f.version, maxDeclarative) <= 0 {
+					planFile.Reason = "a declarative revision with an equal or higher version has been applied"
+					preview.Skipped = append(preview.Skipped, planFile)
+					continue
+				}
+			default:
+				if appliedHash, ok := appliedSha256[f.file.Version]; ok {
+					planFile.Reason = "the version has been applied"
+					preview.Skipped = append(preview.Skipped, planFile)
+					if appliedHash != getStatementSha256(f.file.Statement) {
+						preview.Problems = append(preview.Problems, fmt.Sprintf("file %q with version %q has been applied to %s, but its content has been modified", f.file.Path, f.file.Version, database.Name))
+					}
+					continue
+				}
+				if maxVersioned != nil && compareVersion(f.version, maxVersioned) < 0 {
+					preview.Problems = append(preview.Problems, fmt.Sprintf("file %q with version %q is lower than the applied version %q of %s", f.file.Path, f.file.Version, maxVersionedString, database.Name))
+				}
+			}
+			tasksByEnvironment[environment] = append(tasksByEnvironment[environment], planFile)
+		}
+	}
+
+	// Tasks of the environments not in the environment setting run first, which matches the server.
+	var unknownEnvironments []string
+	for environment := range tasksByEnvironment {
+		if !slices.Contains(environmentIDs, environment) {
+			unknownEnvironments = append(unknownEnvironments, environment)
+		}
+	}
+	slices.Sort(unknownEnvironments)
+	for _, environment := range append(unknownEnvironments, environmentIDs...) {
+		tasks := tasksByEnvironment[environment]
+		if len(tasks) == 0 {
+			continue
+		}
+		preview.Stages = append(preview.Stages, &world.PlanStage{
+			Environment: environmentPrefix + environment,
+			Tasks:       tasks,
+		})
+	}
+	return preview, nil
+}
+
+func printPlanPreview(out io.Writer, preview *world.PlanPreview) {
+	if len(preview.Stages) == 0 {
+		fmt.Fprintln(out, "No files to apply.")
+	}
+	order := 1
+	for _, stage := range preview.Stages {
+		fmt.Fprintf(out, "Stage %s:\n", stage.Environment)
+		for _, task := range stage.Tasks {
+			fmt.Fprintf(out, "  %d. %s (version %s) -> %s\n", order, task.File, task.Version, task.Database)
+			order++
+		}
+	}
+	if len(preview.Skipped) > 0 {
+		fmt.Fprintln(out, "Skipped:")
+		for _, skipped := range preview.Skipped {
+			fmt.Fprintf(out, "  - %s (version %s) -> %s: %s\n", skipped.File, skipped.Version, skipped.Database, skipped.Reason)
+		}
+	}
+	if len(preview.Problems) > 0 {
+		fmt.Fprintln(out, "Problems:")
+		for _, problem := range preview.Problems {
+			fmt.Fprintf(out, "  - %s\n", problem)
+		}
+	}
+}
+
+func getStatementSha256(statement []byte) string {
+	h := sha256.Sum256(statement)
+	return hex.EncodeToString(h[:])
+}
+
+// parseVersion parses the dot-separated numeric version, e.g. 20250101.120000.
+func parseVersion(v string) ([]uint64, error) {
+	if v == "" {
+		return nil, errors.New("version cannot be empty")
+	}
+	var parts []uint64
+	for _, p := range strings.Split(v, ".") {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid version %q", v)
+		}
+		parts = append(parts, n)
+	}
+	return parts, nil
+}
+
+// compareVersion compares the versions part by part. A version is lower than the versions it prefixes.
+func compareVersion(a, b []uint64) int {
+	for i := range min(len(a), len(b)) {
+		if c := cmp.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
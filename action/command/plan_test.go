@@ -0,0 +1,112 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestBuildPlanPreview(t *testing.T) {
+	a := require.New(t)
+	testEnvironment := "environments/test"
+	prodEnvironment := "environments/prod"
+	files := []*v1pb.Release_File{
+		{Path: "migrations/3_add_index.sql", Version: "3", Type: v1pb.Release_File_VERSIONED, Statement: []byte("CREATE INDEX idx ON t(a);")},
+		{Path: "migrations/1_init.sql", Version: "1", Type: v1pb.Release_File_VERSIONED, Statement: []byte("CREATE TABLE t(a int);")},
+		{Path: "migrations/2_add_column.sql", Version: "2", Type: v1pb.Release_File_VERSIONED, Statement: []byte("ALTER TABLE t ADD b int;")},
+	}
+	databases := []*v1pb.Database{
+		{Name: "instances/prod/databases/db", EffectiveEnvironment: &prodEnvironment},
+		{Name: "instances/test/databases/db", EffectiveEnvironment: &testEnvironment},
+	}
+	revisions := map[string][]*v1pb.Revision{
+		"instances/test/databases/db": {
+			{Name: "instances/test/databases/db/revisions/1", Version: "1", Type: v1pb.Revision_VERSIONED, SheetSha256: getStatementSha256([]byte("CREATE TABLE t(a int);"))},
+			{Name: "instances/test/databases/db/revisions/2", Version: "2", Type: v1pb.Revision_VERSIONED, SheetSha256: getStatementSha256([]byte("ALTER TABLE t ADD c int;"))},
+		},
+	}
+
+	preview, err := buildPlanPreview(files, databases, revisions, []string{"test", "prod"})
+	a.NoError(err)
+
+	// Stages follow the environment order, and the files follow the version order.
+	a.Equal([]*world.PlanStage{
+		{
+			Environment: testEnvironment,
+			Tasks: []*world.PlanFile{
+				{Database: "instances/test/databases/db", File: "migrations/3_add_index.sql", Version: "3"},
+			},
+		},
+		{
+			Environment: prodEnvironment,
+			Tasks: []*world.PlanFile{
+				{Database: "instances/prod/databases/db", File: "migrations/1_init.sql", Version: "1"},
+				{Database: "instances/prod/databases/db", File: "migrations/2_add_column.sql", Version: "2"},
+				{Database: "instances/prod/databases/db", File: "migrations/3_add_index.sql", Version: "3"},
+			},
+		},
+	}, preview.Stages)
+	a.Len(preview.Skipped, 2)
+	a.Equal("migrations/1_init.sql", preview.Skipped[0].File)
+	a.Equal("migrations/2_add_column.sql", preview.Skipped[1].File)
+	// The applied file 2 has been modified.
+	a.Len(preview.Problems, 1)
+	a.Contains(preview.Problems[0], "migrations/2_add_column.sql")
+}
+
+func TestBuildPlanPreview_OutOfOrderVersion(t *testing.T) {
+	a := require.New(t)
+	files := []*v1pb.Release_File{
+		{Path: "1_init.sql", Version: "1", Type: v1pb.Release_File_VERSIONED, Statement: []byte("CREATE TABLE t(a int);")},
+		{Path: "1.5_hotfix.sql", Version: "1.5", Type: v1pb.Release_File_VERSIONED, Statement: []byte("ALTER TABLE t ADD b int;")},
+	}
+	databases := []*v1pb.Database{{Name: "instances/test/databases/db"}}
+	revisions := map[string][]*v1pb.Revision{
+		"instances/test/databases/db": {
+			{Name: "instances/test/databases/db/revisions/1", Version: "1", Type: v1pb.Revision_VERSIONED, SheetSha256: getStatementSha256([]byte("CREATE TABLE t(a int);"))},
+			{Name: "instances/test/databases/db/revisions/2", Version: "2", Type: v1pb.Revision_VERSIONED},
+		},
+	}
+
+	preview, err := buildPlanPreview(files, databases, revisions, nil)
+	a.NoError(err)
+	a.Len(preview.Stages, 1)
+	a.Len(preview.Stages[0].Tasks, 1)
+	a.Equal([]string{`file "1.5_hotfix.sql" with version "1.5" is lower than the applied version "2" of instances/test/databases/db`}, preview.Problems)
+}
+
+func TestBuildPlanPreview_Declarative(t *testing.T) {
+	a := require.New(t)
+	files := []*v1pb.Release_File{
+		{Path: "schema/*.sql", Version: "20250101.000000", Type: v1pb.Release_File_DECLARATIVE},
+	}
+	databases := []*v1pb.Database{{Name: "instances/a/databases/db"}, {Name: "instances/b/databases/db"}}
+	revisions := map[string][]*v1pb.Revision{
+		"instances/a/databases/db": {
+			{Name: "instances/a/databases/db/revisions/1", Version: "20250101.000000", Type: v1pb.Revision_DECLARATIVE},
+		},
+		"instances/b/databases/db": {
+			{Name: "instances/b/databases/db/revisions/1", Version: "20241231.000000", Type: v1pb.Revision_DECLARATIVE},
+		},
+	}
+
+	preview, err := buildPlanPreview(files, databases, revisions, nil)
+	a.NoError(err)
+	a.Len(preview.Skipped, 1)
+	a.Equal("instances/a/databases/db", preview.Skipped[0].Database)
+	a.Len(preview.Stages, 1)
+	a.Equal("instances/b/databases/db", preview.Stages[0].Tasks[0].Database)
+	a.Empty(preview.Problems)
+}
+
+func TestBuildPlanPreview_DuplicateVersion(t *testing.T) {
+	files := []*v1pb.Release_File{
+		{Path: "1_a.sql", Version: "1", Type: v1pb.Release_File_VERSIONED},
+		{Path: "1_b.sql", Version: "1", Type: v1pb.Release_File_VERSIONED},
+	}
+	_, err := buildPlanPreview(files, nil, nil, nil)
+	require.ErrorContains(t, err, "duplicate version")
+}
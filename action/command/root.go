@@ -32,6 +32,8 @@ func NewRootCommand(w *world.World) *cobra.Command {
 
 	cmd.AddCommand(NewCheckCommand(w))
 	cmd.AddCommand(NewRolloutCommand(w))
+	cmd.AddCommand(NewPlanCommand(w))
+	cmd.AddCommand(NewDriftCommand(w))
 	return cmd
 }
 
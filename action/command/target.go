@@ -0,0 +1,42 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// getTargetDatabases returns the databases of the targets.
+// The targets are either databases or a single database group, which is validated by the root command.
+func getTargetDatabases(ctx context.Context, client *Client, targets []string) ([]*v1pb.Database, error) {
+	var databaseNames []string
+	for _, target := range targets {
+		if _, _, err := common.GetProjectIDDatabaseGroupID(target); err == nil {
+			databaseGroup, err := client.GetDatabaseGroup(ctx, target)
+			if err != nil {
+				return nil, err
+			}
+			for _, database := range databaseGroup.MatchedDatabases {
+				databaseNames = append(databaseNames, database.Name)
+			}
+			continue
+		}
+		databaseNames = append(databaseNames, target)
+	}
+	if len(databaseNames) == 0 {
+		return nil, errors.Errorf("no databases found for targets %v", targets)
+	}
+
+	var databases []*v1pb.Database
+	for _, databaseName := range databaseNames {
+		database, err := client.GetDatabase(ctx, databaseName)
+		if err != nil {
+			return nil, err
+		}
+		databases = append(databases, database)
+	}
+	return databases, nil
+}
@@ -0,0 +1,252 @@
+// Package sarif writes the release check results in the SARIF 2.1.0 format.
+//
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const (
+	schemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+	version   = "2.1.0"
+
+	toolName           = "Bytebase"
+	toolInformationURI = "https://www.bytebase.com/docs/sql-review/review-rules"
+	srcRootBaseID      = "%SRCROOT%"
+)
+
+// Log is the top-level SARIF log object.
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []*Run `json:"runs"`
+}
+
+// Run describes a single run of the analysis tool.
+type Run struct {
+	Tool       Tool           `json:"tool"`
+	Results    []*Result      `json:"results"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// Tool describes the analysis tool.
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver describes the tool component which contains the rules.
+type Driver struct {
+	Name           string                 `json:"name"`
+	Version        string                 `json:"version,omitempty"`
+	InformationURI string                 `json:"informationUri,omitempty"`
+	Rules          []*ReportingDescriptor `json:"rules"`
+}
+
+// ReportingDescriptor describes a SQL review rule.
+type ReportingDescriptor struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name,omitempty"`
+	ShortDescription *Message       `json:"shortDescription,omitempty"`
+	Properties       map[string]any `json:"properties,omitempty"`
+}
+
+// Result describes a single advice.
+type Result struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             Message           `json:"message"`
+	Locations           []*Location       `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Properties          map[string]any    `json:"properties,omitempty"`
+}
+
+// Message is a plain text message.
+type Message struct {
+	Text string `json:"text"`
+}
+
+// Location is the location of a result.
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+// PhysicalLocation is the file and the region of a result.
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           *Region          `json:"region,omitempty"`
+}
+
+// ArtifactLocation is the file of a result, relative to the source root.
+type ArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+// Region is the one-based line and column range of a result.
+type Region struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// WriteReleaseCheckToSARIF writes the release check response to the SARIF file.
+func WriteReleaseCheckToSARIF(resp *v1pb.CheckReleaseResponse, toolVersion, filename string) error {
+	data, err := json.MarshalIndent(BuildLog(resp, toolVersion), "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal SARIF log")
+	}
+	if dir := filepath.Dir(filename); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.Wrapf(err, "failed to create SARIF output directory: %s", dir)
+		}
+	}
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write SARIF file: %s", filename)
+	}
+	return nil
+}
+
+// BuildLog converts the release check response to a SARIF log.
+// Each distinct SQL review rule becomes a SARIF rule, and each warning or error advice becomes a result.
+func BuildLog(resp *v1pb.CheckReleaseResponse, toolVersion string) *Log {
+	driver := Driver{
+		Name:           toolName,
+		InformationURI: toolInformationURI,
+		Rules:          []*ReportingDescriptor{},
+	}
+	if toolVersion != "" && toolVersion != "unknown" {
+		driver.Version = toolVersion
+	}
+	run := &Run{
+		Results: []*Result{},
+		Properties: map[string]any{
+			"riskLevel":    resp.GetRiskLevel().String(),
+			"affectedRows": resp.GetAffectedRows(),
+		},
+	}
+
+	ruleIndexes := map[string]int{}
+	for _, result := range resp.GetResults() {
+		for _, advice := range result.GetAdvices() {
+			level := convertLevel(advice.GetStatus())
+			if level == "" {
+				continue
+			}
+			ruleID := getRuleID(advice)
+			ruleIndex, ok := ruleIndexes[ruleID]
+			if !ok {
+				ruleIndex = len(driver.Rules)
+				ruleIndexes[ruleID] = ruleIndex
+				driver.Rules = append(driver.Rules, &ReportingDescriptor{
+					ID:               ruleID,
+					Name:             advice.GetTitle(),
+					ShortDescription: &Message{Text: getRuleDescription(advice)},
+					Properties: map[string]any{
+						"code":     advice.GetCode(),
+						"ruleType": advice.GetRuleType().String(),
+					},
+				})
+			}
+
+			region := convertRegion(advice)
+			run.Results = append(run.Results, &Result{
+				RuleID:    ruleID,
+				RuleIndex: ruleIndex,
+				Level:     level,
+				Message:   Message{Text: getMessage(advice)},
+				Locations: []*Location{
+					{
+						PhysicalLocation: PhysicalLocation{
+							ArtifactLocation: ArtifactLocation{
+								URI:       filepath.ToSlash(result.GetFile()),
+								URIBaseID: srcRootBaseID,
+							},
+							Region: region,
+						},
+					},
+				},
+				PartialFingerprints: map[string]string{
+					"bytebaseAdvice/v1": fmt.Sprintf("%s#%s#%d#%s", result.GetFile(), ruleID, region.StartLine, result.GetTarget()),
+				},
+				Properties: map[string]any{
+					"target":       result.GetTarget(),
+					"riskLevel":    result.GetRiskLevel().String(),
+					"affectedRows": result.GetAffectedRows(),
+				},
+			})
+		}
+	}
+	run.Tool = Tool{Driver: driver}
+
+	return &Log{
+		Schema:  schemaURI,
+		Version: version,
+		Runs:    []*Run{run},
+	}
+}
+
+// convertLevel returns the SARIF level of the advice, or an empty string if the advice should not be reported.
+func convertLevel(status v1pb.Advice_Level) string {
+	switch status {
+	case v1pb.Advice_ERROR:
+		return "error"
+	case v1pb.Advice_WARNING:
+		return "warning"
+	default:
+		return ""
+	}
+}
+
+// getRuleID returns the SARIF rule ID of the advice.
+// The advice title is the SQL review rule type, e.g. naming.table, and the code is used if the title is empty.
+func getRuleID(advice *v1pb.Advice) string {
+	if advice.GetTitle() != "" {
+		return advice.GetTitle()
+	}
+	return strconv.Itoa(int(advice.GetCode()))
+}
+
+func getRuleDescription(advice *v1pb.Advice) string {
+	if advice.GetTitle() != "" {
+		return advice.GetTitle()
+	}
+	return fmt.Sprintf("Bytebase advice code %d", advice.GetCode())
+}
+
+func getMessage(advice *v1pb.Advice) string {
+	if advice.GetContent() != "" {
+		return advice.GetContent()
+	}
+	return getRuleDescription(advice)
+}
+
+// convertRegion converts the one-based advice positions to the SARIF region.
+// Unknown lines fall back to the first line because SARIF requires a positive start line.
+func convertRegion(advice *v1pb.Advice) *Region {
+	start, end := advice.GetStartPosition(), advice.GetEndPosition()
+	region := &Region{
+		StartLine: common.ConvertLineToActionLine(int(start.GetLine())),
+	}
+	if start.GetColumn() > 0 {
+		region.StartColumn = int(start.GetColumn())
+	}
+	if end.GetLine() >= int32(region.StartLine) {
+		region.EndLine = int(end.GetLine())
+		if end.GetColumn() > 0 && (region.EndLine > region.StartLine || int(end.GetColumn()) >= region.StartColumn) {
+			region.EndColumn = int(end.GetColumn())
+		}
+	}
+	return region
+}
@@ -0,0 +1,115 @@
+package sarif
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func newCheckReleaseResponse() *v1pb.CheckReleaseResponse {
+	return &v1pb.CheckReleaseResponse{
+		AffectedRows: 10,
+		RiskLevel:    v1pb.RiskLevel_HIGH,
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{
+			{
+				File:         "migrations/001_init.sql",
+				Target:       "instances/test/databases/hr",
+				AffectedRows: 10,
+				RiskLevel:    v1pb.RiskLevel_HIGH,
+				Advices: []*v1pb.Advice{
+					{
+						Status:        v1pb.Advice_WARNING,
+						Code:          301,
+						Title:         "naming.table",
+						Content:       `"Book" mismatches table naming convention`,
+						StartPosition: &v1pb.Position{Line: 3, Column: 1},
+						EndPosition:   &v1pb.Position{Line: 4, Column: 10},
+						RuleType:      v1pb.Advice_PARSER_BASED,
+					},
+					{
+						Status:  v1pb.Advice_SUCCESS,
+						Title:   "OK",
+						Content: "",
+					},
+					{
+						Status:  v1pb.Advice_ERROR,
+						Code:    201,
+						Title:   "statement.where.require.update-delete",
+						Content: "WHERE clause is required for DELETE statement",
+					},
+					{
+						Status:        v1pb.Advice_WARNING,
+						Code:          301,
+						Title:         "naming.table",
+						Content:       `"Author" mismatches table naming convention`,
+						StartPosition: &v1pb.Position{Line: 7},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildLog(t *testing.T) {
+	a := require.New(t)
+
+	log := BuildLog(newCheckReleaseResponse(), "3.9.0")
+	a.Equal("2.1.0", log.Version)
+	a.Len(log.Runs, 1)
+	run := log.Runs[0]
+	a.Equal("Bytebase", run.Tool.Driver.Name)
+	a.Equal("3.9.0", run.Tool.Driver.Version)
+	a.Equal("HIGH", run.Properties["riskLevel"])
+
+	// Rules are deduplicated by the SQL review rule type.
+	a.Len(run.Tool.Driver.Rules, 2)
+	a.Equal("naming.table", run.Tool.Driver.Rules[0].ID)
+	a.Equal(int32(301), run.Tool.Driver.Rules[0].Properties["code"])
+	a.Equal("statement.where.require.update-delete", run.Tool.Driver.Rules[1].ID)
+
+	// The success advice is not reported.
+	a.Len(run.Results, 3)
+	a.Equal("warning", run.Results[0].Level)
+	a.Equal(0, run.Results[0].RuleIndex)
+	a.Equal(`"Book" mismatches table naming convention`, run.Results[0].Message.Text)
+	a.Equal("migrations/001_init.sql", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	a.Equal(&Region{StartLine: 3, StartColumn: 1, EndLine: 4, EndColumn: 10}, run.Results[0].Locations[0].PhysicalLocation.Region)
+	a.Equal("instances/test/databases/hr", run.Results[0].Properties["target"])
+	a.Equal("HIGH", run.Results[0].Properties["riskLevel"])
+
+	// Unknown positions fall back to the first line.
+	a.Equal("error", run.Results[1].Level)
+	a.Equal(1, run.Results[1].RuleIndex)
+	a.Equal(&Region{StartLine: 1}, run.Results[1].Locations[0].PhysicalLocation.Region)
+
+	a.Equal(0, run.Results[2].RuleIndex)
+	a.Equal(&Region{StartLine: 7}, run.Results[2].Locations[0].PhysicalLocation.Region)
+}
+
+func TestBuildLog_UnknownVersion(t *testing.T) {
+	log := BuildLog(&v1pb.CheckReleaseResponse{}, "unknown")
+	require.Empty(t, log.Runs[0].Tool.Driver.Version)
+	require.Empty(t, log.Runs[0].Results)
+}
+
+func TestWriteReleaseCheckToSARIF(t *testing.T) {
+	a := require.New(t)
+	filename := filepath.Join(t.TempDir(), "reports", "bytebase.sarif")
+
+	a.NoError(WriteReleaseCheckToSARIF(newCheckReleaseResponse(), "3.9.0", filename))
+
+	data, err := os.ReadFile(filename)
+	a.NoError(err)
+	var result map[string]any
+	a.NoError(json.Unmarshal(data, &result))
+	a.Equal("2.1.0", result["version"])
+	a.Equal("https://json.schemastore.org/sarif-2.1.0.json", result["$schema"])
+	runs, ok := result["runs"].([]any)
+	a.True(ok)
+	a.Len(runs, 1)
+}
@@ -0,0 +1,38 @@
+package world
+
+// PlanPreview is the result of the plan subcommand.
+// It describes what the rollout subcommand would apply without creating any resource.
+type PlanPreview struct {
+	// Stages are ordered by the environment order.
+	Stages []*PlanStage `json:"stages"`
+	// Skipped are the files which have already been applied to the databases.
+	Skipped  []*PlanFile `json:"skipped"`
+	Problems []string    `json:"problems"`
+}
+
+// PlanStage is the tasks of an environment.
+type PlanStage struct {
+	// Format: environments/{environment}
+	Environment string      `json:"environment"`
+	Tasks       []*PlanFile `json:"tasks"`
+}
+
+// PlanFile is a release file on a database.
+type PlanFile struct {
+	// Format: instances/{instance}/databases/{database}
+	Database string `json:"database"`
+	File     string `json:"file"`
+	Version  string `json:"version"`
+	// The reason why the file is skipped.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DriftResult is the drift status of a database.
+type DriftResult struct {
+	// Format: instances/{instance}/databases/{database}
+	Database string `json:"database"`
+	Drifted  bool   `json:"drifted"`
+	// The statements to change the current schema back to the schema recorded by Bytebase.
+	Diff  string `json:"diff,omitempty"`
+	Error string `json:"error,omitempty"`
+}
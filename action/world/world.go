@@ -15,6 +15,8 @@ type World struct {
 
 	// Whether it is the rollout subcommand.
 	IsRollout bool
+	// Whether it is the plan subcommand.
+	IsPlan bool
 
 	// bytebase-action flags
 	Output               string
@@ -36,6 +38,8 @@ type World struct {
 	CheckRelease string
 	// Custom linting rules in natural language for AI-powered validation.
 	CustomRules string
+	// The SARIF output file location. The check results are written regardless of the platform if set.
+	SARIFOutput string
 
 	// bytebase-action rollout flags
 	ReleaseTitle string // The title of the release
@@ -44,12 +48,18 @@ type World struct {
 	TargetStage string
 	Plan        string
 
+	// bytebase-action drift flags
+	// Whether to sync the database schemas before reading the drift status.
+	SyncBeforeDrift bool
+
 	// Outputs
 	OutputMap struct {
 		Release      string                     `json:"release,omitempty"`
 		Plan         string                     `json:"plan,omitempty"`
 		Rollout      string                     `json:"rollout,omitempty"`
 		CheckResults *v1pb.CheckReleaseResponse `json:"checkResults,omitempty"`
+		PlanPreview  *PlanPreview               `json:"planPreview,omitempty"`
+		DriftResults []*DriftResult             `json:"driftResults,omitempty"`
 	}
 	PendingStages []string
 	Rollout       *v1pb.Rollout
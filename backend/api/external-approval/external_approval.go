@@ -0,0 +1,109 @@
+// Package externalapproval is the API endpoint for the callbacks from the external approval services.
+package externalapproval
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Service is the API endpoint for handling the callbacks from the external approval services.
+type Service struct {
+	store          *store.Store
+	licenseService *enterprise.LicenseService
+	approvalRunner *approval.Runner
+}
+
+// NewService creates an external approval service.
+func NewService(store *store.Store, licenseService *enterprise.LicenseService, approvalRunner *approval.Runner) *Service {
+	return &Service{
+		store:          store,
+		licenseService: licenseService,
+		approvalRunner: approvalRunner,
+	}
+}
+
+// callbackRequest is the decision posted back by the external approval service.
+type callbackRequest struct {
+	// Issue is the issue name in the approval request.
+	Issue string `json:"issue"`
+	// RequestID is the ID of the approval request returned by the external approval service.
+	RequestID string `json:"requestId"`
+	// Status is either APPROVED or REJECTED.
+	Status  string `json:"status"`
+	Comment string `json:"comment"`
+}
+
+// RegisterExternalApprovalRoutes registers the callback routes of the external approval nodes.
+func (s *Service) RegisterExternalApprovalRoutes(g *echo.Group) {
+	g.POST("/:nodeID", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW); err != nil {
+			return c.String(http.StatusForbidden, err.Error())
+		}
+
+		node, err := s.getNode(c)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if node == nil {
+			return c.String(http.StatusNotFound, fmt.Sprintf("external approval node %q not found", c.Param("nodeID")))
+		}
+		token := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(node.Token)) != 1 {
+			return c.String(http.StatusUnauthorized, "invalid bearer token")
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
+		}
+		var request callbackRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unmarshal body, error %v", err))
+		}
+		var approved bool
+		switch request.Status {
+		case approval.ExternalApprovalStatusApproved:
+			approved = true
+		case approval.ExternalApprovalStatusRejected:
+			approved = false
+		default:
+			return c.String(http.StatusBadRequest, fmt.Sprintf("invalid status %q", request.Status))
+		}
+
+		if err := s.approvalRunner.DecideExternalApproval(ctx, request.Issue, node, request.RequestID, approved, request.Comment); err != nil {
+			if errors.Is(err, approval.ErrExternalApprovalInProgress) {
+				// The external approval service should post the decision again.
+				return c.String(http.StatusServiceUnavailable, err.Error())
+			}
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		return c.NoContent(http.StatusOK)
+	})
+}
+
+func (s *Service) getNode(c echo.Context) (*storepb.WorkspaceExternalApprovalSetting_Node, error) {
+	setting, err := s.store.GetWorkspaceExternalApprovalSetting(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range setting.Nodes {
+		if node.Id == c.Param("nodeID") {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,177 @@
+// Package iminteraction is the API endpoint for the interactive messages of the IM apps.
+package iminteraction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/lark"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/slack"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// slackInteractionTimeout is the timeout of taking the action and posting the outcome of the Slack interaction.
+const slackInteractionTimeout = time.Minute
+
+// Service is the API endpoint for handling the approve and reject actions in the interactive IM messages.
+type Service struct {
+	store          *store.Store
+	licenseService *enterprise.LicenseService
+	issueService   *apiv1.IssueService
+}
+
+// NewService creates an IM interaction service.
+func NewService(store *store.Store, licenseService *enterprise.LicenseService, issueService *apiv1.IssueService) *Service {
+	return &Service{
+		store:          store,
+		licenseService: licenseService,
+		issueService:   issueService,
+	}
+}
+
+// RegisterIMInteractionRoutes registers the interaction routes of the IM apps.
+func (s *Service) RegisterIMInteractionRoutes(g *echo.Group) {
+	// The request URL of the interactivity of the Slack app.
+	g.POST("/slack", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		body, setting, err := s.readRequest(c)
+		if err != nil {
+			return err
+		}
+		interaction, err := slack.ParseInteraction(setting, c.Request().Header, body, time.Now())
+		if err != nil {
+			return c.String(http.StatusUnauthorized, err.Error())
+		}
+
+		// Slack requires the acknowledgement within 3 seconds, so the action is taken in the background,
+		// and the outcome is posted to the response URL.
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), slackInteractionTimeout)
+			defer cancel()
+			var outcome string
+			email, err := slack.GetUserEmail(ctx, setting, interaction.UserID)
+			if err != nil {
+				outcome = fmt.Sprintf("Failed to get the Slack user: %s", err.Error())
+			} else {
+				outcome = s.decide(ctx, interaction.IssueName, email, interaction.Approve, interaction.Comment)
+			}
+			if err := slack.UpdateMessage(ctx, interaction, outcome); err != nil {
+				slog.Warn("failed to update slack message", slog.String("issue", interaction.IssueName), log.BBError(err))
+			}
+		}()
+		return c.NoContent(http.StatusOK)
+	})
+
+	// The message card request URL of the Lark app.
+	g.POST("/lark", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		body, setting, err := s.readRequest(c)
+		if err != nil {
+			return err
+		}
+		interaction, err := lark.ParseInteraction(setting, c.Request().Header, body, time.Now())
+		if err != nil {
+			return c.String(http.StatusUnauthorized, err.Error())
+		}
+		if interaction.Challenge != "" {
+			return c.JSON(http.StatusOK, map[string]string{"challenge": interaction.Challenge})
+		}
+
+		email, err := lark.GetUserEmail(ctx, setting, interaction.OpenID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		outcome := s.decide(ctx, interaction.IssueName, email, interaction.Approve, interaction.Comment)
+		return c.JSON(http.StatusOK, lark.GetUpdatedCard(interaction, outcome))
+	})
+}
+
+// readRequest checks the license and reads the request body and the IM setting.
+func (s *Service) readRequest(c echo.Context) ([]byte, *storepb.AppIMSetting, error) {
+	if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to read body, error %v", err))
+	}
+	setting, err := s.store.GetAppIMSetting(c.Request().Context())
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return body, setting, nil
+}
+
+// decide approves or rejects the issue on behalf of the Bytebase user with the email, and returns the outcome to show in the message.
+func (s *Service) decide(ctx context.Context, issueName, email string, approve bool, comment string) string {
+	action := "reject"
+	if approve {
+		action = "approve"
+	}
+	user, issue, err := s.getUserAndIssue(ctx, issueName, email)
+	if err != nil {
+		return fmt.Sprintf("Failed to %s the issue: %s", action, err.Error())
+	}
+	if approve {
+		_, err = s.issueService.ApproveIssueByUser(ctx, issue, user, comment)
+	} else {
+		_, err = s.issueService.RejectIssueByUser(ctx, issue, user, comment)
+	}
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return fmt.Sprintf("Failed to %s the issue: %s", action, connectErr.Message())
+		}
+		return fmt.Sprintf("Failed to %s the issue: %s", action, err.Error())
+	}
+
+	outcome := fmt.Sprintf("Approved by %s (%s)", user.Name, user.Email)
+	if !approve {
+		outcome = fmt.Sprintf("Rejected by %s (%s)", user.Name, user.Email)
+	}
+	if comment != "" {
+		outcome += fmt.Sprintf("\nComment: %s", comment)
+	}
+	return outcome
+}
+
+func (s *Service) getUserAndIssue(ctx context.Context, issueName, email string) (*store.UserMessage, *store.IssueMessage, error) {
+	user, err := s.store.GetUserByEmail(ctx, strings.ToLower(email))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get user %q", email)
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, nil, errors.Errorf("user %q not found in Bytebase", email)
+	}
+
+	projectID, issueUID, err := common.GetProjectIDIssueUID(issueName)
+	if err != nil {
+		return nil, nil, err
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{ProjectID: &projectID, UID: &issueUID})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get issue %q", issueName)
+	}
+	if issue == nil {
+		return nil, nil, errors.Errorf("issue %q not found", issueName)
+	}
+	if issue.Status != storepb.Issue_OPEN {
+		return nil, nil, errors.Errorf("issue %q is not open", issueName)
+	}
+	return user, issue, nil
+}
@@ -10,3 +10,13 @@ type SQLStatementRangesParams struct {
 	URI    lsp.DocumentURI `json:"uri"`
 	Ranges []lsp.Range     `json:"ranges"`
 }
+
+// TextDocumentContentParams are the parameters to the "$/textDocument/content" request.
+type TextDocumentContentParams struct {
+	URI lsp.DocumentURI `json:"uri"`
+}
+
+// TextDocumentContentResult is the result of the "$/textDocument/content" request.
+type TextDocumentContentResult struct {
+	Text string `json:"text"`
+}
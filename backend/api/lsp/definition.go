@@ -0,0 +1,151 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+// definitionURIScheme is the scheme of the virtual documents for the object definitions.
+// The clients get the content of the virtual documents by the "$/textDocument/content" request.
+const definitionURIScheme = "bytebase"
+
+func (h *Handler) handleTextDocumentDefinition(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DefinitionParams) ([]lsp.Location, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/definition not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return []lsp.Location{}, nil
+	}
+	offset, err := offsetForPosition(content, params.Position)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Position.Line, params.Position.Character)
+	}
+	chain, index := getIdentifierChainAtOffset(content, offset)
+	if index < 0 {
+		return []lsp.Location{}, nil
+	}
+	names := identifierNames(chain[:index+1])
+	name := names[index]
+
+	// The common table expressions shadow the views with the same name.
+	if index == 0 {
+		engine := h.getEngineType(ctx)
+		statement, statementOffset := getStatementAtPosition(ctx, engine, content, params.Position)
+		if start, end, ok := findCTEDefinition(engine, statement, name); ok {
+			return []lsp.Location{
+				{
+					URI: params.TextDocument.URI,
+					Range: lsp.Range{
+						Start: positionForOffset(content, statementOffset+start),
+						End:   positionForOffset(content, statementOffset+end),
+					},
+				},
+			}, nil
+		}
+	}
+
+	for _, candidate := range h.listSchemaCandidates(ctx, names[:index]) {
+		if view := candidate.schema.GetView(name); view != nil {
+			return []lsp.Location{{URI: buildDefinitionURI(h.getInstanceID(), candidate.database, candidate.schemaName(), view.GetName())}}, nil
+		}
+		if view := candidate.schema.GetMaterializedView(name); view != nil {
+			return []lsp.Location{{URI: buildDefinitionURI(h.getInstanceID(), candidate.database, candidate.schemaName(), view.GetName())}}, nil
+		}
+	}
+	return []lsp.Location{}, nil
+}
+
+func (h *Handler) handleTextDocumentContent(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params TextDocumentContentParams) (*TextDocumentContentResult, error) {
+	instanceID, database, schema, view, err := parseDefinitionURI(params.URI)
+	if err != nil {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: err.Error()}
+	}
+	// Only the objects of the instance set by the setMetadata command are accessible.
+	if instanceID != h.getInstanceID() {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: fmt.Sprintf("instance %q is not connected", instanceID)}
+	}
+	_, metadata, err := h.GetDatabaseMetadataFunc(ctx, instanceID, database)
+	if err != nil {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: err.Error()}
+	}
+	schemaMetadata := metadata.GetSchemaMetadata(schema)
+	if schemaMetadata == nil {
+		return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: fmt.Sprintf("schema %q not found", schema)}
+	}
+	if v := schemaMetadata.GetView(view); v != nil {
+		return &TextDocumentContentResult{Text: v.GetDefinition()}, nil
+	}
+	if v := schemaMetadata.GetMaterializedView(view); v != nil {
+		return &TextDocumentContentResult{Text: v.GetDefinition()}, nil
+	}
+	return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams, Message: fmt.Sprintf("view %q not found", view)}
+}
+
+// buildDefinitionURI builds the virtual document URI for the view definition.
+// The path is the qualified view name for display, and the query locates the view.
+func buildDefinitionURI(instanceID, database, schema, view string) lsp.DocumentURI {
+	u := url.URL{
+		Scheme: definitionURIScheme,
+		Path:   "/" + joinNonEmpty(database, schema, view) + ".sql",
+		RawQuery: url.Values{
+			"instance": []string{instanceID},
+			"database": []string{database},
+			"schema":   []string{schema},
+			"view":     []string{view},
+		}.Encode(),
+	}
+	return lsp.DocumentURI(u.String())
+}
+
+func parseDefinitionURI(uri lsp.DocumentURI) (string, string, string, string, error) {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return "", "", "", "", errors.Wrapf(err, "invalid URI %q", uri)
+	}
+	if u.Scheme != definitionURIScheme {
+		return "", "", "", "", errors.Errorf("unsupported URI scheme %q", u.Scheme)
+	}
+	query := u.Query()
+	if query.Get("instance") == "" || query.Get("database") == "" || query.Get("view") == "" {
+		return "", "", "", "", errors.Errorf("invalid definition URI %q", uri)
+	}
+	return query.Get("instance"), query.Get("database"), query.Get("schema"), query.Get("view"), nil
+}
+
+// findCTEDefinition finds the name of the common table expression in the parse tree of the statement.
+// It returns the byte offsets of the name.
+func findCTEDefinition(engine storepb.Engine, statement, name string) (int, int, bool) {
+	definitions, err := parserbase.GetCTEDefinitions(engine, statement)
+	if err != nil {
+		slog.Debug("failed to get common table expressions", log.BBError(err))
+		return 0, 0, false
+	}
+	runes := []rune(statement)
+	for _, definition := range definitions {
+		if !strings.EqualFold(definition.Name, name) || definition.End > len(runes) {
+			continue
+		}
+		// The offsets of the parse tree count the runes.
+		return len(string(runes[:definition.Start])), len(string(runes[:definition.End])), true
+	}
+	return 0, 0, false
+}
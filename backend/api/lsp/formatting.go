@@ -0,0 +1,101 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func (h *Handler) handleTextDocumentFormatting(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DocumentFormattingParams) ([]lsp.TextEdit, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/formatting not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to format a huge file.
+		return []lsp.TextEdit{}, nil
+	}
+	return h.formatRange(ctx, content, 0, len(content), params.Options), nil
+}
+
+func (h *Handler) handleTextDocumentRangeFormatting(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.DocumentRangeFormattingParams) ([]lsp.TextEdit, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/rangeFormatting not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to format a huge file.
+		return []lsp.TextEdit{}, nil
+	}
+	start, err := offsetForPosition(content, params.Range.Start)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Range.Start.Line, params.Range.Start.Character)
+	}
+	end, err := offsetForPosition(content, params.Range.End)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Range.End.Line, params.Range.End.Character)
+	}
+	if start >= end {
+		return []lsp.TextEdit{}, nil
+	}
+	return h.formatRange(ctx, content, start, end, params.Options), nil
+}
+
+// formatRange formats the content in the byte range [start, end) and returns the edit replacing the range.
+// The formatting errors are logged rather than returned, because the statements may be incomplete while typing.
+func (h *Handler) formatRange(ctx context.Context, content []byte, start, end int, options lsp.FormattingOptions) []lsp.TextEdit {
+	statement := string(content[start:end])
+	formatOptions := parserbase.FormatOptions{Indent: getFormatIndent(options)}
+	// Keep the lines of the range aligned to the line the range starts in.
+	lineStart := strings.LastIndexByte(string(content[:start]), '\n') + 1
+	if prefix := string(content[lineStart:start]); strings.TrimSpace(prefix) == "" {
+		formatOptions.BaseIndent = prefix
+	}
+	formatted, err := parserbase.Format(h.getEngineType(ctx), statement, formatOptions)
+	if err != nil {
+		slog.Debug("failed to format statement", log.BBError(err))
+		return []lsp.TextEdit{}
+	}
+	if formatted == statement {
+		return []lsp.TextEdit{}
+	}
+	return []lsp.TextEdit{
+		{
+			Range: lsp.Range{
+				Start: positionForOffset(content, start),
+				End:   positionForOffset(content, end),
+			},
+			NewText: formatted,
+		},
+	}
+}
+
+func getFormatIndent(options lsp.FormattingOptions) string {
+	if !options.InsertSpaces {
+		return "\t"
+	}
+	if options.TabSize == 0 {
+		return "  "
+	}
+	return strings.Repeat(" ", int(options.TabSize))
+}
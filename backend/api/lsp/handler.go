@@ -24,15 +24,20 @@ import (
 type Method string
 
 const (
-	LSPMethodPing           Method = "$ping"
-	LSPMethodInitialize     Method = "initialize"
-	LSPMethodInitialized    Method = "initialized"
-	LSPMethodShutdown       Method = "shutdown"
-	LSPMethodExit           Method = "exit"
-	LSPMethodCancelRequest  Method = "$/cancelRequest"
-	LSPMethodSetTrace       Method = "$/setTrace"
-	LSPMethodExecuteCommand Method = "workspace/executeCommand"
-	LSPMethodCompletion     Method = "textDocument/completion"
+	LSPMethodPing            Method = "$ping"
+	LSPMethodInitialize      Method = "initialize"
+	LSPMethodInitialized     Method = "initialized"
+	LSPMethodShutdown        Method = "shutdown"
+	LSPMethodExit            Method = "exit"
+	LSPMethodCancelRequest   Method = "$/cancelRequest"
+	LSPMethodSetTrace        Method = "$/setTrace"
+	LSPMethodExecuteCommand  Method = "workspace/executeCommand"
+	LSPMethodCompletion      Method = "textDocument/completion"
+	LSPMethodHover           Method = "textDocument/hover"
+	LSPMethodDefinition      Method = "textDocument/definition"
+	LSPMethodSignatureHelp   Method = "textDocument/signatureHelp"
+	LSPMethodFormatting      Method = "textDocument/formatting"
+	LSPMethodRangeFormatting Method = "textDocument/rangeFormatting"
 
 	LSPMethodTextDocumentDidOpen   Method = "textDocument/didOpen"
 	LSPMethodTextDocumentDidChange Method = "textDocument/didChange"
@@ -45,6 +50,8 @@ const (
 	// Custom Methods.
 	// See dollar request: https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#dollarRequests.
 	LSPCustomMethodSQLStatementRanges Method = "$/textDocument/statementRanges"
+	// LSPCustomMethodTextDocumentContent returns the content of the virtual documents, such as the view definitions returned by textDocument/definition.
+	LSPCustomMethodTextDocumentContent Method = "$/textDocument/content"
 )
 
 // NewHandlerWithAuth creates a new Language Server Protocol handler with authentication.
@@ -161,9 +168,17 @@ func (h *Handler) getScene() base.SceneType {
 }
 
 func (h *Handler) getEngineType(ctx context.Context) storepb.Engine {
+	instance := h.getInstance(ctx)
+	if instance == nil {
+		return storepb.Engine_ENGINE_UNSPECIFIED
+	}
+	return instance.Metadata.GetEngine()
+}
+
+func (h *Handler) getInstance(ctx context.Context) *store.InstanceMessage {
 	instanceID := h.getInstanceID()
 	if instanceID == "" {
-		return storepb.Engine_ENGINE_UNSPECIFIED
+		return nil
 	}
 
 	instance, err := h.store.GetInstanceV2(ctx, &store.FindInstanceMessage{
@@ -171,13 +186,13 @@ func (h *Handler) getEngineType(ctx context.Context) storepb.Engine {
 	})
 	if err != nil {
 		slog.Error("Failed to get instance", log.BBError(err))
-		return storepb.Engine_ENGINE_UNSPECIFIED
+		return nil
 	}
 	if instance == nil {
 		slog.Error("Instance not found", slog.String("instanceID", instanceID))
-		return storepb.Engine_ENGINE_UNSPECIFIED
+		return nil
 	}
-	return instance.Metadata.GetEngine()
+	return instance
 }
 
 func (h *Handler) checkInitialized(req *jsonrpc2.Request) error {
@@ -315,6 +330,13 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 				CompletionProvider: &lsp.CompletionOptions{
 					TriggerCharacters: []string{".", " "},
 				},
+				HoverProvider:                   &lsp.Or_ServerCapabilities_hoverProvider{Value: true},
+				DefinitionProvider:              &lsp.Or_ServerCapabilities_definitionProvider{Value: true},
+				DocumentFormattingProvider:      &lsp.Or_ServerCapabilities_documentFormattingProvider{Value: true},
+				DocumentRangeFormattingProvider: &lsp.Or_ServerCapabilities_documentRangeFormattingProvider{Value: true},
+				SignatureHelpProvider: &lsp.SignatureHelpOptions{
+					TriggerCharacters: []string{"(", ","},
+				},
 				ExecuteCommandProvider: &lsp.ExecuteCommandOptions{
 					Commands: []string{string(CommandNameSetMetadata)},
 				},
@@ -402,6 +424,90 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 			h.cancelF.Delete(req.ID)
 		}()
 		return h.handleTextDocumentCompletion(childCtx, conn, req, params)
+	case LSPMethodHover:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.HoverParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentHover(childCtx, conn, req, params)
+	case LSPMethodDefinition:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DefinitionParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentDefinition(childCtx, conn, req, params)
+	case LSPMethodSignatureHelp:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.SignatureHelpParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentSignatureHelp(childCtx, conn, req, params)
+	case LSPMethodFormatting:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DocumentFormattingParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentFormatting(childCtx, conn, req, params)
+	case LSPMethodRangeFormatting:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DocumentRangeFormattingParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		childCtx, cancel := context.WithCancel(ctx)
+		h.cancelF.Store(req.ID, cancel)
+		defer func() {
+			cancel()
+			h.cancelF.Delete(req.ID)
+		}()
+		return h.handleTextDocumentRangeFormatting(childCtx, conn, req, params)
+	case LSPCustomMethodTextDocumentContent:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params TextDocumentContentParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentContent(ctx, conn, req, params)
 	default:
 		if isFileSystemRequest(req.Method) {
 			_, _, err := h.handleFileSystemRequest(ctx, conn, req)
@@ -0,0 +1,250 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func (h *Handler) handleTextDocumentHover(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.HoverParams) (*lsp.Hover, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/hover not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+	offset, err := offsetForPosition(content, params.Position)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Position.Line, params.Position.Character)
+	}
+	chain, index := getIdentifierChainAtOffset(content, offset)
+	if index < 0 {
+		return nil, nil
+	}
+	instance := h.getInstance(ctx)
+	if instance == nil {
+		return nil, nil
+	}
+
+	statement, _ := getStatementAtPosition(ctx, instance.Metadata.GetEngine(), content, params.Position)
+	value := h.getHoverContent(ctx, instance, statement, identifierNames(chain[:index+1]), index == len(chain)-1)
+	if value == "" {
+		return nil, nil
+	}
+	return &lsp.Hover{
+		Contents: lsp.MarkupContent{
+			Kind:  lsp.Markdown,
+			Value: value,
+		},
+		Range: lsp.Range{
+			Start: positionForOffset(content, chain[index].start),
+			End:   positionForOffset(content, chain[index].end),
+		},
+	}, nil
+}
+
+// getHoverContent returns the markdown content for the object the names refer to.
+// The last name is the hovered identifier, and the names before it are its qualifiers.
+// isLast is false if the hovered identifier qualifies other identifiers, so that it cannot be a column.
+func (h *Handler) getHoverContent(ctx context.Context, instance *store.InstanceMessage, statement string, names []string, isLast bool) string {
+	name := names[len(names)-1]
+	qualifiers := names[:len(names)-1]
+
+	// The query span resolves the column references and the table aliases to the source tables.
+	spans, err := parserbase.GetQuerySpan(ctx, parserbase.GetQuerySpanContext{
+		InstanceID:              instance.ResourceID,
+		GetDatabaseMetadataFunc: h.GetDatabaseMetadataFunc,
+		ListDatabaseNamesFunc:   h.ListDatabaseNamesFunc,
+	}, instance.Metadata.GetEngine(), statement, h.getDefaultDatabase(), h.getDefaultSchema(), !store.IsObjectCaseSensitive(instance))
+	if err != nil {
+		// The statement may be incomplete while typing.
+		slog.Debug("failed to get query span for hover", log.BBError(err))
+	}
+	resources := collectColumnResources(spans)
+	if isLast {
+		if resource, ok := findColumnResource(resources, name, qualifiers); ok {
+			if value := h.getColumnHoverContent(ctx, resource); value != "" {
+				return value
+			}
+		}
+	}
+	for _, resource := range resources {
+		if !strings.EqualFold(resource.Table, name) {
+			continue
+		}
+		if value := h.getTableHoverContent(ctx, resource); value != "" {
+			return value
+		}
+	}
+
+	// Fall back to the metadata for the statements without query spans, such as DDL.
+	for _, candidate := range h.listSchemaCandidates(ctx, qualifiers) {
+		if table := candidate.schema.GetTable(name); table != nil {
+			tableConfig := candidate.metadata.GetSchemaConfig(candidate.schemaName()).GetTableConfig(table.GetProto().GetName())
+			return renderTableHover(candidate.database, candidate.schemaName(), table, tableConfig)
+		}
+		if view := candidate.schema.GetView(name); view != nil {
+			return renderViewHover("view", candidate.database, candidate.schemaName(), view.GetName(), view.GetComment(), view.GetDefinition())
+		}
+		if view := candidate.schema.GetMaterializedView(name); view != nil {
+			return renderViewHover("materialized view", candidate.database, candidate.schemaName(), view.GetName(), view.GetComment(), view.GetDefinition())
+		}
+	}
+	return ""
+}
+
+func (h *Handler) getColumnHoverContent(ctx context.Context, resource parserbase.ColumnResource) string {
+	_, metadata, err := h.GetDatabaseMetadataFunc(ctx, h.getInstanceID(), resource.Database)
+	if err != nil {
+		slog.Debug("failed to get database metadata", slog.String("database", resource.Database), log.BBError(err))
+		return ""
+	}
+	table := metadata.GetSchemaMetadata(resource.Schema).GetTable(resource.Table)
+	column := table.GetColumn(resource.Column)
+	if column == nil {
+		return ""
+	}
+	columnConfig := metadata.GetSchemaConfig(resource.Schema).GetTableConfig(table.GetProto().GetName()).GetColumnConfig(column.GetName())
+	return renderColumnHover(resource.Database, resource.Schema, table.GetProto().GetName(), column, columnConfig)
+}
+
+func (h *Handler) getTableHoverContent(ctx context.Context, resource parserbase.ColumnResource) string {
+	_, metadata, err := h.GetDatabaseMetadataFunc(ctx, h.getInstanceID(), resource.Database)
+	if err != nil {
+		slog.Debug("failed to get database metadata", slog.String("database", resource.Database), log.BBError(err))
+		return ""
+	}
+	table := metadata.GetSchemaMetadata(resource.Schema).GetTable(resource.Table)
+	if table == nil {
+		return ""
+	}
+	tableConfig := metadata.GetSchemaConfig(resource.Schema).GetTableConfig(table.GetProto().GetName())
+	return renderTableHover(resource.Database, resource.Schema, table, tableConfig)
+}
+
+// collectColumnResources collects the source columns of the spans in a stable order.
+// The columns from the linked servers are skipped because we don't have their metadata.
+func collectColumnResources(spans []*parserbase.QuerySpan) []parserbase.ColumnResource {
+	set := make(parserbase.SourceColumnSet)
+	for _, span := range spans {
+		for resource := range span.SourceColumns {
+			set[resource] = true
+		}
+		for resource := range span.PredicateColumns {
+			set[resource] = true
+		}
+		for _, result := range span.Results {
+			for resource := range result.SourceColumns {
+				set[resource] = true
+			}
+		}
+	}
+	var resources []parserbase.ColumnResource
+	for resource := range set {
+		if resource.Server != "" {
+			continue
+		}
+		resources = append(resources, resource)
+	}
+	slices.SortFunc(resources, func(a, b parserbase.ColumnResource) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return resources
+}
+
+// findColumnResource finds the source column with the name.
+// The column of the table named by the closest qualifier takes precedence, the qualifier may be an alias otherwise.
+func findColumnResource(resources []parserbase.ColumnResource, name string, qualifiers []string) (parserbase.ColumnResource, bool) {
+	var matched []parserbase.ColumnResource
+	for _, resource := range resources {
+		if strings.EqualFold(resource.Column, name) {
+			matched = append(matched, resource)
+		}
+	}
+	if len(matched) == 0 {
+		return parserbase.ColumnResource{}, false
+	}
+	if len(qualifiers) > 0 {
+		for _, resource := range matched {
+			if strings.EqualFold(resource.Table, qualifiers[len(qualifiers)-1]) {
+				return resource, true
+			}
+		}
+	}
+	return matched[0], true
+}
+
+func renderColumnHover(database, schema, table string, column *storepb.ColumnMetadata, columnConfig *storepb.ColumnCatalog) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "**column** `%s`\n\n", joinNonEmpty(database, schema, table, column.GetName()))
+	fmt.Fprintf(&buf, "- Type: `%s`\n", column.GetType())
+	fmt.Fprintf(&buf, "- Nullable: `%t`\n", column.GetNullable())
+	if column.GetDefault() != "" {
+		fmt.Fprintf(&buf, "- Default: `%s`\n", column.GetDefault())
+	}
+	if columnConfig.GetClassification() != "" {
+		fmt.Fprintf(&buf, "- Classification: `%s`\n", columnConfig.GetClassification())
+	}
+	if columnConfig.GetSemanticType() != "" {
+		fmt.Fprintf(&buf, "- Semantic type: `%s`\n", columnConfig.GetSemanticType())
+	}
+	if column.GetComment() != "" {
+		fmt.Fprintf(&buf, "\n%s\n", column.GetComment())
+	}
+	return buf.String()
+}
+
+func renderTableHover(database, schema string, table *model.TableMetadata, tableConfig *model.TableConfig) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "**table** `%s`\n\n", joinNonEmpty(database, schema, table.GetProto().GetName()))
+	fmt.Fprintf(&buf, "- Columns: %d\n", len(table.GetProto().GetColumns()))
+	if tableConfig != nil && tableConfig.Classification != "" {
+		fmt.Fprintf(&buf, "- Classification: `%s`\n", tableConfig.Classification)
+	}
+	if table.GetTableComment() != "" {
+		fmt.Fprintf(&buf, "\n%s\n", table.GetTableComment())
+	}
+	return buf.String()
+}
+
+func renderViewHover(kind, database, schema, name, comment, definition string) string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "**%s** `%s`\n", kind, joinNonEmpty(database, schema, name))
+	if comment != "" {
+		fmt.Fprintf(&buf, "\n%s\n", comment)
+	}
+	if definition != "" {
+		fmt.Fprintf(&buf, "\n```sql\n%s\n```\n", strings.TrimSpace(definition))
+	}
+	return buf.String()
+}
+
+func joinNonEmpty(names ...string) string {
+	var list []string
+	for _, name := range names {
+		if name != "" {
+			list = append(list, name)
+		}
+	}
+	return strings.Join(list, ".")
+}
@@ -0,0 +1,218 @@
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+
+	// Parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
+)
+
+func TestFindColumnResource(t *testing.T) {
+	a := require.New(t)
+	resources := collectColumnResources([]*parserbase.QuerySpan{
+		{
+			SourceColumns: parserbase.SourceColumnSet{
+				{Database: "db", Schema: "public", Table: "users", Column: "id"}:  true,
+				{Database: "db", Schema: "public", Table: "orders", Column: "id"}: true,
+				{Server: "linked", Database: "db", Table: "remote", Column: "id"}: true,
+			},
+			PredicateColumns: parserbase.SourceColumnSet{
+				{Database: "db", Schema: "public", Table: "orders", Column: "user_id"}: true,
+			},
+		},
+	})
+	a.Len(resources, 3)
+
+	resource, ok := findColumnResource(resources, "ID", []string{"users"})
+	a.True(ok)
+	a.Equal("users", resource.Table)
+	// The alias qualifier falls back to the first matched column.
+	resource, ok = findColumnResource(resources, "id", []string{"o"})
+	a.True(ok)
+	a.Equal("orders", resource.Table)
+	resource, ok = findColumnResource(resources, "user_id", nil)
+	a.True(ok)
+	a.Equal("orders", resource.Table)
+	_, ok = findColumnResource(resources, "name", nil)
+	a.False(ok)
+}
+
+func TestRenderHover(t *testing.T) {
+	a := require.New(t)
+	metadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:    "users",
+						Comment: "The registered users.",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "email", Type: "text", Nullable: true, Comment: "The login email."},
+						},
+					},
+				},
+			},
+		},
+	}, nil, &storepb.DatabaseConfig{
+		Name: "db",
+		Schemas: []*storepb.SchemaCatalog{
+			{
+				Name: "public",
+				Tables: []*storepb.TableCatalog{
+					{
+						Name:           "users",
+						Classification: "1",
+						Columns: []*storepb.ColumnCatalog{
+							{Name: "email", Classification: "1-2", SemanticType: "email-mask"},
+						},
+					},
+				},
+			},
+		},
+	}, storepb.Engine_POSTGRES, true)
+	table := metadata.GetSchemaMetadata("public").GetTable("users")
+	tableConfig := metadata.GetSchemaConfig("public").GetTableConfig("users")
+
+	a.Equal("**table** `db.public.users`\n\n- Columns: 2\n- Classification: `1`\n\nThe registered users.\n", renderTableHover("db", "public", table, tableConfig))
+	a.Equal("**column** `db.public.users.email`\n\n- Type: `text`\n- Nullable: `true`\n- Classification: `1-2`\n- Semantic type: `email-mask`\n\nThe login email.\n",
+		renderColumnHover("db", "public", "users", table.GetColumn("email"), tableConfig.GetColumnConfig("email")))
+	a.Equal("**column** `db.public.users.id`\n\n- Type: `integer`\n- Nullable: `false`\n",
+		renderColumnHover("db", "public", "users", table.GetColumn("id"), tableConfig.GetColumnConfig("id")))
+	a.Equal("**view** `db.v`\n\n```sql\nSELECT 1\n```\n", renderViewHover("view", "db", "", "v", "", "SELECT 1\n"))
+}
+
+func TestFindCTEDefinition(t *testing.T) {
+	testCases := []struct {
+		engine    storepb.Engine
+		statement string
+		name      string
+		expected  string
+		found     bool
+	}{
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "WITH a AS (SELECT 1), b(x) AS (SELECT * FROM a) SELECT * FROM b",
+			name:      "b",
+			expected:  "b",
+			found:     true,
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: `with recursive "Tree" as materialized (select 1) select * from "Tree"`,
+			name:      "Tree",
+			expected:  `"Tree"`,
+			found:     true,
+		},
+		{
+			engine:    storepb.Engine_POSTGRES,
+			statement: "WITH ab AS (SELECT 1) SELECT * FROM a",
+			name:      "a",
+			found:     false,
+		},
+		{
+			// The names in the strings and comments are not the common table expressions.
+			engine:    storepb.Engine_POSTGRES,
+			statement: "SELECT 'WITH a AS (' /* , a AS ( */ FROM t",
+			name:      "a",
+			found:     false,
+		},
+		{
+			// The offsets are in bytes.
+			engine:    storepb.Engine_POSTGRES,
+			statement: "SELECT '日本' AS x FROM (WITH 表 AS (SELECT 1) SELECT * FROM 表) s",
+			name:      "表",
+			expected:  "表",
+			found:     true,
+		},
+		{
+			engine:    storepb.Engine_MYSQL,
+			statement: "WITH RECURSIVE `cte` (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM cte WHERE n < 5) SELECT * FROM cte",
+			name:      "cte",
+			expected:  "`cte`",
+			found:     true,
+		},
+		{
+			engine:    storepb.Engine_MSSQL,
+			statement: "WITH [Sales CTE] AS (SELECT 1 AS a) SELECT * FROM [Sales CTE]",
+			name:      "Sales CTE",
+			expected:  "[Sales CTE]",
+			found:     true,
+		},
+	}
+
+	for idx, tc := range testCases {
+		start, end, ok := findCTEDefinition(tc.engine, tc.statement, tc.name)
+		require.Equal(t, tc.found, ok, "test cases %d", idx)
+		if tc.found {
+			require.Equal(t, tc.expected, tc.statement[start:end], "test cases %d", idx)
+		}
+	}
+}
+
+func TestDefinitionURI(t *testing.T) {
+	a := require.New(t)
+	uri := buildDefinitionURI("prod", "db", "my schema", "v&1")
+	a.Equal("bytebase:///db.my%20schema.v&1.sql?database=db&instance=prod&schema=my+schema&view=v%261", string(uri))
+	instance, database, schema, view, err := parseDefinitionURI(uri)
+	a.NoError(err)
+	a.Equal([]string{"prod", "db", "my schema", "v&1"}, []string{instance, database, schema, view})
+
+	_, _, _, _, err = parseDefinitionURI("file:///a.sql")
+	a.Error(err)
+}
+
+func TestGetFunctionCallAtOffset(t *testing.T) {
+	testCases := []struct {
+		content         string
+		names           []string
+		activeParameter int
+		found           bool
+	}{
+		{
+			content:         "SELECT public.add_tax(price, ",
+			names:           []string{"public", "add_tax"},
+			activeParameter: 1,
+			found:           true,
+		},
+		{
+			content:         "SELECT f(g(1, 2), 'a,(b'",
+			names:           []string{"f"},
+			activeParameter: 1,
+			found:           true,
+		},
+		{
+			content: "SELECT f(1) ",
+			found:   false,
+		},
+		{
+			content: "(1 + ",
+			found:   false,
+		},
+	}
+
+	for idx, tc := range testCases {
+		names, activeParameter, ok := getFunctionCallAtOffset([]byte(tc.content), len(tc.content))
+		require.Equal(t, tc.found, ok, "test cases %d", idx)
+		if tc.found {
+			require.Equal(t, tc.names, names, "test cases %d", idx)
+			require.Equal(t, tc.activeParameter, activeParameter, "test cases %d", idx)
+		}
+	}
+}
+
+func TestSplitSignatureParameters(t *testing.T) {
+	require.Equal(t, []string{"a integer", "b numeric(10, 2)"}, splitSignatureParameters("f(a integer, b numeric(10, 2))"))
+	require.Empty(t, splitSignatureParameters("f()"))
+	require.Empty(t, splitSignatureParameters("f"))
+}
@@ -0,0 +1,110 @@
+package lsp
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+
+	lsp "github.com/bytebase/lsp-protocol"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// schemaCandidate is a schema an unqualified or partially qualified object name may refer to.
+type schemaCandidate struct {
+	database string
+	metadata *model.DatabaseMetadata
+	schema   *model.SchemaMetadata
+}
+
+func (c *schemaCandidate) schemaName() string {
+	return c.schema.GetProto().GetName()
+}
+
+// listSchemaCandidates lists the schemas the object with the qualifiers may belong to, in the order of precedence.
+// The qualifiers are the identifiers before the object name, e.g. `schema` in `schema.table`.
+// A single qualifier is either a schema of the default database or a database, depending on the engine.
+func (h *Handler) listSchemaCandidates(ctx context.Context, qualifiers []string) []*schemaCandidate {
+	instanceID := h.getInstanceID()
+	defaultDatabase := h.getDefaultDatabase()
+	type reference struct {
+		database string
+		schema   string
+	}
+	var references []reference
+	switch len(qualifiers) {
+	case 0:
+		references = []reference{{database: defaultDatabase, schema: h.getDefaultSchema()}}
+	case 1:
+		references = []reference{{database: defaultDatabase, schema: qualifiers[0]}, {database: qualifiers[0]}}
+	default:
+		n := len(qualifiers)
+		references = []reference{{database: qualifiers[n-2], schema: qualifiers[n-1]}}
+	}
+
+	var candidates []*schemaCandidate
+	for _, ref := range references {
+		if ref.database == "" {
+			continue
+		}
+		_, metadata, err := h.GetDatabaseMetadataFunc(ctx, instanceID, ref.database)
+		if err != nil {
+			slog.Debug("failed to get database metadata", slog.String("database", ref.database), log.BBError(err))
+			continue
+		}
+		schemaNames := []string{ref.schema}
+		if ref.schema == "" {
+			// Engines without schemas use the empty schema, otherwise follow the search path.
+			schemaNames = append(schemaNames, metadata.GetSearchPath()...)
+			schemaNames = append(schemaNames, metadata.ListSchemaNames()...)
+		}
+		for _, schemaName := range schemaNames {
+			schema := metadata.GetSchemaMetadata(schemaName)
+			if schema == nil {
+				continue
+			}
+			candidates = append(candidates, &schemaCandidate{
+				database: ref.database,
+				metadata: metadata,
+				schema:   schema,
+			})
+		}
+	}
+	return candidates
+}
+
+// getStatementAtPosition returns the statement containing the position, and the byte offset of the statement in the content.
+// The whole content is returned if the engine does not support splitting the statement ranges.
+func getStatementAtPosition(ctx context.Context, engine storepb.Engine, content []byte, position lsp.Position) (string, int) {
+	ranges, err := parserbase.GetStatementRanges(ctx, parserbase.StatementRangeContext{}, engine, string(content))
+	if err != nil {
+		slog.Debug("failed to get statement ranges", log.BBError(err))
+		return string(content), 0
+	}
+	for _, r := range ranges {
+		if comparePosition(position, r.Start) < 0 || comparePosition(position, r.End) > 0 {
+			continue
+		}
+		start, err := offsetForPosition(content, r.Start)
+		if err != nil {
+			break
+		}
+		end, err := offsetForPosition(content, r.End)
+		if err != nil {
+			// The range of the last statement ends at the line after the content.
+			end = len(content)
+		}
+		return string(content[start:end]), start
+	}
+	return string(content), 0
+}
+
+func comparePosition(a, b lsp.Position) int {
+	if c := cmp.Compare(a.Line, b.Line); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Character, b.Character)
+}
@@ -0,0 +1,183 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/pkg/errors"
+	"github.com/sourcegraph/jsonrpc2"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func (h *Handler) handleTextDocumentSignatureHelp(ctx context.Context, _ *jsonrpc2.Conn, _ *jsonrpc2.Request, params lsp.SignatureHelpParams) (*lsp.SignatureHelp, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/signatureHelp not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to parse a huge file.
+		return nil, nil
+	}
+	offset, err := offsetForPosition(content, params.Position)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid position %d:%d", params.Position.Line, params.Position.Character)
+	}
+	statement, statementOffset := getStatementAtPosition(ctx, h.getEngineType(ctx), content, params.Position)
+	if offset < statementOffset || offset-statementOffset > len(statement) {
+		return nil, nil
+	}
+	names, activeParameter, ok := getFunctionCallAtOffset([]byte(statement), offset-statementOffset)
+	if !ok {
+		return nil, nil
+	}
+	name := names[len(names)-1]
+
+	// Only the user-defined functions in the database metadata are supported.
+	var signatures []lsp.SignatureInformation
+	for _, candidate := range h.listSchemaCandidates(ctx, names[:len(names)-1]) {
+		for _, function := range candidate.schema.GetProto().GetFunctions() {
+			if strings.EqualFold(function.GetName(), name) {
+				signatures = append(signatures, buildSignatureInformation(function))
+			}
+		}
+		if len(signatures) > 0 {
+			break
+		}
+	}
+	if len(signatures) == 0 {
+		return nil, nil
+	}
+	// Prefer the first overload with enough parameters.
+	var activeSignature uint32
+	for i, signature := range signatures {
+		if len(signature.Parameters) > activeParameter {
+			activeSignature = uint32(i)
+			break
+		}
+	}
+	for i := range signatures {
+		signatures[i].ActiveParameter = uint32(activeParameter)
+	}
+	return &lsp.SignatureHelp{
+		Signatures:      signatures,
+		ActiveSignature: activeSignature,
+		ActiveParameter: uint32(activeParameter),
+	}, nil
+}
+
+func buildSignatureInformation(function *storepb.FunctionMetadata) lsp.SignatureInformation {
+	label := function.GetSignature()
+	if label == "" {
+		label = function.GetName() + "()"
+	}
+	signature := lsp.SignatureInformation{
+		Label: label,
+	}
+	for _, parameter := range splitSignatureParameters(label) {
+		signature.Parameters = append(signature.Parameters, lsp.ParameterInformation{Label: parameter})
+	}
+	if function.GetComment() != "" {
+		signature.Documentation = &lsp.Or_SignatureInformation_documentation{Value: function.GetComment()}
+	}
+	return signature
+}
+
+// splitSignatureParameters splits the parameters in the signature, such as `f(a integer, b numeric(10, 2))`.
+func splitSignatureParameters(signature string) []string {
+	start := strings.IndexByte(signature, '(')
+	end := strings.LastIndexByte(signature, ')')
+	if start < 0 || end < start {
+		return nil
+	}
+	var parameters []string
+	depth, begin := 0, start+1
+	for i := start + 1; i < end; i++ {
+		switch signature[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parameters = append(parameters, strings.TrimSpace(signature[begin:i]))
+				begin = i + 1
+			}
+		default:
+		}
+	}
+	if last := strings.TrimSpace(signature[begin:end]); last != "" {
+		parameters = append(parameters, last)
+	}
+	return parameters
+}
+
+// getFunctionCallAtOffset returns the qualified function name of the innermost function call enclosing the byte offset,
+// and the index of the argument the offset is in.
+func getFunctionCallAtOffset(content []byte, offset int) ([]string, int, bool) {
+	type call struct {
+		paren  int
+		commas int
+	}
+	var stack []call
+	for i := 0; i < offset; i++ {
+		switch c := content[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// Skip the string literals and the quoted identifiers, the doubled quote is an escaped quote.
+			for i++; i < offset; i++ {
+				if content[i] == c {
+					if i+1 < offset && content[i+1] == c {
+						i++
+						continue
+					}
+					break
+				}
+			}
+		case c == '-' && i+1 < offset && content[i+1] == '-':
+			for i < offset && content[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < offset && content[i+1] == '*':
+			end := strings.Index(string(content[i+2:offset]), "*/")
+			if end < 0 {
+				return nil, 0, false
+			}
+			i += end + 3
+		case c == '(':
+			stack = append(stack, call{paren: i})
+		case c == ')':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case c == ',':
+			if len(stack) > 0 {
+				stack[len(stack)-1].commas++
+			}
+		default:
+		}
+	}
+	if len(stack) == 0 {
+		return nil, 0, false
+	}
+	top := stack[len(stack)-1]
+
+	// The function name is right before the parenthesis.
+	nameEnd := top.paren
+	for nameEnd > 0 && unicode.IsSpace(rune(content[nameEnd-1])) {
+		nameEnd--
+	}
+	chain, index := getIdentifierChainAtOffset(content, nameEnd)
+	if index < 0 || index != len(chain)-1 || chain[index].end != nameEnd {
+		return nil, 0, false
+	}
+	return identifierNames(chain), top.commas, true
+}
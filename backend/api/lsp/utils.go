@@ -152,3 +152,151 @@ func getSQLStatementRangesUTF16Position(content []byte) []lsp.Range {
 	}
 	return ranges
 }
+
+// positionForOffset converts a byte offset to a protocol (UTF-16) position. content is utf8 encoded sequence.
+func positionForOffset(content []byte, offset int) lsp.Position {
+	var line, character uint32
+	for _, r := range string(content[:min(offset, len(content))]) {
+		if r == '\n' {
+			line++
+			character = 0
+			continue
+		}
+		// Check rune utf16 length by BMP.
+		if r <= 0xFFFF {
+			character++
+		} else {
+			character += 2
+		}
+	}
+	return lsp.Position{Line: line, Character: character}
+}
+
+// identifier is an identifier in the dot-separated identifier chain, such as `schema.table.column`.
+type identifier struct {
+	// name is the identifier with the quotes removed.
+	name string
+	// start and end are the byte offsets of the identifier in the content, including the quotes.
+	start int
+	end   int
+}
+
+func identifierNames(chain []identifier) []string {
+	var names []string
+	for _, id := range chain {
+		names = append(names, id.name)
+	}
+	return names
+}
+
+// getIdentifierChainAtOffset returns the identifier chain at the byte offset, and the index of the identifier the offset is in.
+// The index is -1 if the offset is not in an identifier.
+// The identifier chain cannot span lines, and the identifiers in comments and string literals are ignored.
+func getIdentifierChainAtOffset(content []byte, offset int) ([]identifier, int) {
+	if offset < 0 || offset > len(content) {
+		return nil, -1
+	}
+	lineStart := bytes.LastIndexByte(content[:offset], '\n') + 1
+	lineEnd := len(content)
+	if i := bytes.IndexByte(content[offset:], '\n'); i >= 0 {
+		lineEnd = offset + i
+	}
+	line := content[:lineEnd]
+
+	var chain []identifier
+	// dotted is true if the last token is a dot following an identifier.
+	dotted := false
+	for i := lineStart; i < len(line); {
+		var id *identifier
+		c := line[i]
+		switch {
+		case c == '"' || c == '`' || c == '[':
+			closing := c
+			if c == '[' {
+				closing = ']'
+			}
+			end := i + 1
+			var buf strings.Builder
+			for end < len(line) {
+				if line[end] == closing {
+					// The doubled closing quote is an escaped quote.
+					if end+1 < len(line) && line[end+1] == closing && closing != ']' {
+						buf.WriteByte(closing)
+						end += 2
+						continue
+					}
+					break
+				}
+				buf.WriteByte(line[end])
+				end++
+			}
+			// Include the closing quote.
+			end = min(end+1, len(line))
+			id = &identifier{name: buf.String(), start: i, end: end}
+			i = end
+		case c == '\'':
+			end := i + 1
+			for end < len(line) {
+				if line[end] == '\'' {
+					if end+1 < len(line) && line[end+1] == '\'' {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			i = min(end+1, len(line))
+		case c == '-' && i+1 < len(line) && line[i+1] == '-':
+			i = len(line)
+		case c == '.':
+			dotted = len(chain) > 0
+			i++
+			continue
+		default:
+			r, size := utf8.DecodeRune(line[i:])
+			if !isIdentifierRune(r) {
+				i += size
+				break
+			}
+			end := i + size
+			for end < len(line) {
+				r, size := utf8.DecodeRune(line[end:])
+				if !isIdentifierRune(r) {
+					break
+				}
+				end += size
+			}
+			id = &identifier{name: string(line[i:end]), start: i, end: end}
+			i = end
+		}
+
+		if id == nil {
+			// The chain ends at a non-identifier token.
+			if len(chain) > 0 && chain[len(chain)-1].end >= offset {
+				break
+			}
+			chain, dotted = nil, false
+			continue
+		}
+		if !dotted || len(chain) == 0 || chain[len(chain)-1].end != id.start-1 {
+			if len(chain) > 0 && chain[len(chain)-1].end >= offset {
+				break
+			}
+			chain = nil
+		}
+		chain = append(chain, *id)
+		dotted = false
+	}
+
+	for i, id := range chain {
+		if id.start <= offset && offset <= id.end {
+			return chain, i
+		}
+	}
+	return nil, -1
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -116,3 +116,82 @@ SELECT * FROM public.workday;`),
 		require.Equal(t, tc.ranges, ranges, "test cases %d", idx)
 	}
 }
+
+func TestPositionForOffset(t *testing.T) {
+	testCases := []struct {
+		content  []byte
+		offset   int
+		expected lsp.Position
+	}{
+		{
+			content:  []byte("Hello,\nWorld!"),
+			offset:   12,
+			expected: lsp.Position{Line: 1, Character: 5},
+		},
+		{
+			content:  []byte("Hello, 𐍈!"),
+			offset:   11,
+			expected: lsp.Position{Line: 0, Character: 9},
+		},
+	}
+
+	for idx, tc := range testCases {
+		position := positionForOffset(tc.content, tc.offset)
+		require.Equal(t, tc.expected, position, "test cases %d", idx)
+		offset, err := offsetForPosition(tc.content, position)
+		require.NoError(t, err)
+		require.Equal(t, tc.offset, offset, "test cases %d", idx)
+	}
+}
+
+func TestGetIdentifierChainAtOffset(t *testing.T) {
+	testCases := []struct {
+		content string
+		offset  int
+		names   []string
+		index   int
+	}{
+		{
+			content: "SELECT u.name FROM public.users u",
+			offset:  10, // 'name'
+			names:   []string{"u", "name"},
+			index:   1,
+		},
+		{
+			content: "SELECT u.name FROM public.users u",
+			offset:  7, // 'u'
+			names:   []string{"u", "name"},
+			index:   0,
+		},
+		{
+			content: `SELECT * FROM "My Schema"."Users"`,
+			offset:  30, // 'Users'
+			names:   []string{"My Schema", "Users"},
+			index:   1,
+		},
+		{
+			content: "SELECT * FROM [dbo].[t1] WHERE a = 1",
+			offset:  24, // End of '[t1]'
+			names:   []string{"dbo", "t1"},
+			index:   1,
+		},
+		{
+			content: "SELECT 'users.name' FROM t",
+			offset:  10, // In the string literal.
+			index:   -1,
+		},
+		{
+			content: "SELECT 1;\nSELECT * FROM t -- users",
+			offset:  31, // In the comment.
+			index:   -1,
+		},
+	}
+
+	for idx, tc := range testCases {
+		chain, index := getIdentifierChainAtOffset([]byte(tc.content), tc.offset)
+		require.Equal(t, tc.index, index, "test cases %d", idx)
+		if tc.index >= 0 {
+			require.Equal(t, tc.names, identifierNames(chain), "test cases %d", idx)
+		}
+	}
+}
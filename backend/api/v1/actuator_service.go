@@ -93,7 +93,7 @@ func (s *ActuatorService) DeleteCache(
 	ctx context.Context,
 	_ *connect.Request[v1pb.DeleteCacheRequest],
 ) (*connect.Response[emptypb.Empty], error) {
-	s.store.DeleteCache()
+	s.store.DeleteCache(ctx)
 	s.licenseService.RefreshCache(ctx)
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
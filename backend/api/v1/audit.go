@@ -124,12 +124,14 @@ func (c *auditConnectStreamingConn) Send(resp any) error {
 	if err != nil {
 		return err
 	}
-	// Create audit log for each message pair
+	// Create audit log for each message pair, the following responses of the same request such as
+	// the chunks of ExportStream are not logged again.
 	if c.curRequest != nil {
 		latency := time.Since(c.startTime)
 		if auditErr := createAuditLogConnect(c.ctx, c.curRequest, resp, c.method, c.interceptor.store, c.interceptor.secret, c.interceptor.profile, nil, nil, c.RequestHeader(), c.Peer().Addr, latency); auditErr != nil {
 			return auditErr
 		}
+		c.curRequest = nil
 	}
 	return nil
 }
@@ -8,7 +8,7 @@ import (
 
 func checkDatabaseMetadata(engine storepb.Engine, metadata *storepb.DatabaseSchemaMetadata) error {
 	switch engine {
-	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES, storepb.Engine_ORACLE:
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_POSTGRES, storepb.Engine_ORACLE, storepb.Engine_MSSQL, storepb.Engine_CLICKHOUSE, storepb.Engine_SNOWFLAKE, storepb.Engine_SPANNER:
 	default:
 		return errors.Errorf("unsupported engine for check database metadata: %v", engine)
 	}
@@ -50,6 +50,10 @@ func checkDatabaseMetadata(engine storepb.Engine, metadata *storepb.DatabaseSche
 
 			indexNameMap := make(map[string]bool)
 			for _, index := range table.GetIndexes() {
+				// ClickHouse keeps the primary key in the table, so the primary index has no name and may use expressions.
+				if engine == storepb.Engine_CLICKHOUSE && index.Primary {
+					continue
+				}
 				if index.GetName() == "" {
 					return errors.Errorf("index name should not be empty in table %s", table.GetName())
 				}
@@ -212,6 +212,8 @@ func convertToChangelogTypeAndMigrationType(t storepb.ChangelogPayload_Type, mt
 			return v1pb.Changelog_MIGRATE, v1pb.Changelog_DML
 		case storepb.MigrationType_GHOST:
 			return v1pb.Changelog_MIGRATE, v1pb.Changelog_GHOST
+		case storepb.MigrationType_PG_ONLINE:
+			return v1pb.Changelog_MIGRATE, v1pb.Changelog_PG_ONLINE
 		default:
 			return v1pb.Changelog_MIGRATE, v1pb.Changelog_MIGRATION_TYPE_UNSPECIFIED
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -720,6 +721,25 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 	if err != nil {
 		return nil, err
 	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	issue, err = s.ApproveIssueByUser(ctx, issue, user, req.Msg.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	issueV1, err := s.convertToIssue(ctx, issue)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to convert to issue, error: %v", err))
+	}
+	return connect.NewResponse(issueV1), nil
+}
+
+// ApproveIssueByUser approves the issue on behalf of the user with the comment.
+// It is shared by the API and the interactive messages from the IM apps.
+func (s *IssueService) ApproveIssueByUser(ctx context.Context, issue *store.IssueMessage, user *store.UserMessage, comment string) (*store.IssueMessage, error) {
 	payload := issue.Payload
 	if payload.Approval == nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("issue payload approval is nil"))
@@ -734,29 +754,27 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 		return nil, connect.NewError(connect.CodeInternal, errors.New("approval template is required"))
 	}
 
-	rejectedRole := utils.FindRejectedRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-	if rejectedRole != "" {
+	if utils.IsApprovalRejected(payload.Approval) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("cannot approve because the issue has been rejected"))
 	}
 
-	role := utils.FindNextPendingRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-	if role == "" {
+	pendingApproverSets := utils.FindPendingApproverSets(payload.Approval)
+	if len(pendingApproverSets) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("the issue has been approved"))
 	}
 
-	user, ok := GetUserFromContext(ctx)
-	if !ok {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
-	}
-
-	canApprove := s.isUserReviewer(ctx, issue, role, user)
-	if !canApprove {
+	approverSets := s.getUserApproverSets(ctx, issue, pendingApproverSets, user)
+	if len(approverSets) == 0 {
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot approve because the user does not have the required permission"))
 	}
 
+	// The approval of the user counts for the first pending approver set the user belongs to,
+	// so that the approver sets of a parallel step are approved by distinct users.
 	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
 		PrincipalId: int32(user.ID),
+		Step:        int32(approverSets[0].Step),
+		ApproverSet: int32(approverSets[0].ApproverSet),
 	})
 
 	approved, err := utils.CheckApprovalApproved(payload.Approval)
@@ -789,7 +807,7 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 
 	if err := func() error {
 		p := &storepb.IssueCommentPayload{
-			Comment: req.Msg.Comment,
+			Comment: comment,
 			Event: &storepb.IssueCommentPayload_Approval_{
 				Approval: &storepb.IssueCommentPayload_Approval{
 					Status: storepb.IssuePayloadApproval_Approver_APPROVED,
@@ -811,8 +829,8 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 		if payload.Approval.ApprovalTemplate == nil {
 			return
 		}
-		role := utils.FindNextPendingRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-		if role == "" {
+		approvers := utils.FindPendingApprovers(payload.Approval, pendingApproverSets)
+		if len(approvers) == 0 {
 			return
 		}
 
@@ -823,7 +841,7 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 			Issue:   webhook.NewIssue(issue),
 			Project: webhook.NewProject(issue.Project),
 			IssueApprovalCreate: &webhook.EventIssueApprovalCreate{
-				Role: role,
+				Approvers: approvers,
 			},
 		})
 	}()
@@ -896,12 +914,7 @@ func (s *IssueService) ApproveIssue(ctx context.Context, req *connect.Request[v1
 			slog.Debug("failed to update issue status to done if grant request issue is approved", log.BBError(err))
 		}
 	}
-
-	issueV1, err := s.convertToIssue(ctx, issue)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to convert to issue, error: %v", err))
-	}
-	return connect.NewResponse(issueV1), nil
+	return issue, nil
 }
 
 // RejectIssue rejects a issue.
@@ -910,6 +923,25 @@ func (s *IssueService) RejectIssue(ctx context.Context, req *connect.Request[v1p
 	if err != nil {
 		return nil, err
 	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	issue, err = s.RejectIssueByUser(ctx, issue, user, req.Msg.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	issueV1, err := s.convertToIssue(ctx, issue)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to convert to issue, error: %v", err))
+	}
+	return connect.NewResponse(issueV1), nil
+}
+
+// RejectIssueByUser rejects the issue on behalf of the user with the comment.
+// It is shared by the API and the interactive messages from the IM apps.
+func (s *IssueService) RejectIssueByUser(ctx context.Context, issue *store.IssueMessage, user *store.UserMessage, comment string) (*store.IssueMessage, error) {
 	payload := issue.Payload
 	if payload.Approval == nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("issue payload approval is nil"))
@@ -924,31 +956,28 @@ func (s *IssueService) RejectIssue(ctx context.Context, req *connect.Request[v1p
 		return nil, connect.NewError(connect.CodeInternal, errors.New("approval template is required"))
 	}
 
-	rejectedRole := utils.FindRejectedRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-	if rejectedRole != "" {
+	if utils.IsApprovalRejected(payload.Approval) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("cannot reject because the issue has been rejected"))
 	}
 
-	role := utils.FindNextPendingRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-	if role == "" {
+	pendingApproverSets := utils.FindPendingApproverSets(payload.Approval)
+	if len(pendingApproverSets) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("the issue has been approved"))
 	}
 
-	user, ok := GetUserFromContext(ctx)
-	if !ok {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
-	}
-
-	canApprove := s.isUserReviewer(ctx, issue, role, user)
-	if !canApprove {
+	approverSets := s.getUserApproverSets(ctx, issue, pendingApproverSets, user)
+	if len(approverSets) == 0 {
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot reject because the user does not have the required permission"))
 	}
+	// Rejecting any approver set fails the whole approval flow.
 	payload.Approval.Approvers = append(payload.Approval.Approvers, &storepb.IssuePayloadApproval_Approver{
 		Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
 		PrincipalId: int32(user.ID),
+		Step:        int32(approverSets[0].Step),
+		ApproverSet: int32(approverSets[0].ApproverSet),
 	})
 
-	issue, err = s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+	issue, err := s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 		PayloadUpsert: &storepb.Issue{
 			Approval: payload.Approval,
 		},
@@ -959,7 +988,7 @@ func (s *IssueService) RejectIssue(ctx context.Context, req *connect.Request[v1p
 
 	if err := func() error {
 		p := &storepb.IssueCommentPayload{
-			Comment: req.Msg.Comment,
+			Comment: comment,
 			Event: &storepb.IssueCommentPayload_Approval_{
 				Approval: &storepb.IssueCommentPayload_Approval{
 					Status: storepb.IssuePayloadApproval_Approver_REJECTED,
@@ -974,12 +1003,7 @@ func (s *IssueService) RejectIssue(ctx context.Context, req *connect.Request[v1p
 	}(); err != nil {
 		slog.Warn("failed to create issue comment", log.BBError(err))
 	}
-
-	issueV1, err := s.convertToIssue(ctx, issue)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to convert to issue, error: %v", err))
-	}
-	return connect.NewResponse(issueV1), nil
+	return issue, nil
 }
 
 // RequestIssue requests a issue.
@@ -1002,8 +1026,7 @@ func (s *IssueService) RequestIssue(ctx context.Context, req *connect.Request[v1
 		return nil, connect.NewError(connect.CodeInternal, errors.New("approval template is required"))
 	}
 
-	rejectedRole := utils.FindRejectedRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-	if rejectedRole == "" {
+	if !utils.IsApprovalRejected(payload.Approval) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("cannot request issues because the issue is not rejected"))
 	}
 
@@ -1017,14 +1040,31 @@ func (s *IssueService) RequestIssue(ctx context.Context, req *connect.Request[v1
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot request issues because you are not the issue creator"))
 	}
 
+	flow := payload.Approval.ApprovalTemplate.Flow
+	steps := utils.GetApprovalSteps(flow)
+	// rejectedApprovers is the approvers of the rejected approver sets keyed by the step.
+	rejectedApprovers := map[int][]string{}
 	var updatedApprovers []*storepb.IssuePayloadApproval_Approver
-	for _, approver := range payload.Approval.Approvers {
+	for i, approver := range payload.Approval.Approvers {
 		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+			step, approverSet := utils.GetApproverPosition(flow, i, approver)
+			if step < len(steps) && approverSet < len(steps[step].ApproverSets) {
+				rejectedApprovers[step] = append(rejectedApprovers[step], steps[step].ApproverSets[approverSet].Approvers...)
+			}
 			continue
 		}
 		updatedApprovers = append(updatedApprovers, approver)
 	}
 	payload.Approval.Approvers = updatedApprovers
+	// Drop the decided external approval requests of the rejected approver sets so that they will be sent again.
+	var updatedExternalApprovals []*storepb.IssuePayloadApproval_ExternalApproval
+	for _, externalApproval := range payload.Approval.ExternalApprovals {
+		if slices.Contains(rejectedApprovers[int(externalApproval.Step)], common.FormatExternalApprovalNode(externalApproval.NodeId)) {
+			continue
+		}
+		updatedExternalApprovals = append(updatedExternalApprovals, externalApproval)
+	}
+	payload.Approval.ExternalApprovals = updatedExternalApprovals
 
 	newApprovers, err := utils.HandleIncomingApprovalSteps(payload.Approval)
 	if err != nil {
@@ -1045,8 +1085,8 @@ func (s *IssueService) RequestIssue(ctx context.Context, req *connect.Request[v1
 		if payload.Approval.ApprovalTemplate == nil {
 			return
 		}
-		role := utils.FindNextPendingRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-		if role == "" {
+		approvers := utils.FindPendingApprovers(payload.Approval, nil)
+		if len(approvers) == 0 {
 			return
 		}
 
@@ -1057,7 +1097,7 @@ func (s *IssueService) RequestIssue(ctx context.Context, req *connect.Request[v1
 			Issue:   webhook.NewIssue(issue),
 			Project: webhook.NewProject(issue.Project),
 			IssueApprovalCreate: &webhook.EventIssueApprovalCreate{
-				Role: role,
+				Approvers: approvers,
 			},
 		})
 	}()
@@ -1545,9 +1585,24 @@ func (s *IssueService) getIssueMessage(ctx context.Context, name string) (*store
 	return issue, nil
 }
 
-func (s *IssueService) isUserReviewer(ctx context.Context, issue *store.IssueMessage, role string, user *store.UserMessage) bool {
+// getUserApproverSets returns the pending approver sets which the user can approve.
+// The approver sets already approved by the user, or in the parallel step where the user has approved
+// another approver set, are skipped because the approvers must be distinct.
+func (s *IssueService) getUserApproverSets(ctx context.Context, issue *store.IssueMessage, pendingApproverSets []*utils.PendingApproverSet, user *store.UserMessage) []*utils.PendingApproverSet {
 	roles := s.getUserRoleMap(ctx, issue.Project.ResourceID, user.ID)
-	return roles[role]
+	var approverSets []*utils.PendingApproverSet
+	for _, approverSet := range pendingApproverSets {
+		if !approverSet.CanApprove(int32(user.ID)) {
+			continue
+		}
+		for _, approver := range approverSet.Approvers {
+			if utils.ApproverContainsUser(ctx, s.store, approver, user, roles) {
+				approverSets = append(approverSets, approverSet)
+				break
+			}
+		}
+	}
+	return approverSets
 }
 
 func canRequestIssue(issueCreator *store.UserMessage, user *store.UserMessage) bool {
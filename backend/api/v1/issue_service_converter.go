@@ -35,7 +35,7 @@ func (s *IssueService) convertToIssues(ctx context.Context, issues []*store.Issu
 				slog.Error("failed to parse the issue name", log.BBError(err), slog.String("issue", v1Issue.Name))
 				continue
 			}
-			if v := issueFilter.ApproverID; v != nil && !s.isIssueNextApprover(ctx, v1Issue, projectID, *v) {
+			if v := issueFilter.ApproverID; v != nil && !s.isIssueNextApprover(ctx, issue, projectID, *v) {
 				continue
 			}
 		}
@@ -61,15 +61,31 @@ func (s *IssueService) getUserRoleMap(ctx context.Context, projectResourceID str
 	}, policy.Policy, workspacePolicy.Policy)
 }
 
-func (s *IssueService) isIssueNextApprover(ctx context.Context, issue *v1pb.Issue, projectResourceID string, principalUID int) bool {
-	roles := s.getUserRoleMap(ctx, projectResourceID, principalUID)
-	approvalRoles := issue.GetApprovalTemplate().GetFlow().GetRoles()
-	index := len(issue.Approvers)
-	if index >= len(approvalRoles) {
+func (s *IssueService) isIssueNextApprover(ctx context.Context, issue *store.IssueMessage, projectResourceID string, principalUID int) bool {
+	approval := issue.Payload.GetApproval()
+	if approval.GetApprovalTemplate() == nil || utils.IsApprovalRejected(approval) {
 		return false
 	}
-
-	return roles[approvalRoles[index]]
+	pendingApproverSets := utils.FindPendingApproverSets(approval)
+	if len(pendingApproverSets) == 0 {
+		return false
+	}
+	user, err := s.store.GetUserByID(ctx, principalUID)
+	if err != nil || user == nil {
+		return false
+	}
+	roles := s.getUserRoleMap(ctx, projectResourceID, principalUID)
+	for _, approverSet := range pendingApproverSets {
+		if !approverSet.CanApprove(int32(principalUID)) {
+			continue
+		}
+		for _, approver := range approverSet.Approvers {
+			if utils.ApproverContainsUser(ctx, s.store, approver, user, roles) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (s *IssueService) convertToIssue(ctx context.Context, issue *store.IssueMessage) (*v1pb.Issue, error) {
@@ -113,7 +129,11 @@ func (s *IssueService) convertToIssue(ctx context.Context, issue *store.IssueMes
 		issueV1.ApprovalTemplate = convertToApprovalTemplate(template)
 	}
 	for _, approver := range approval.GetApprovers() {
-		convertedApprover := &v1pb.Issue_Approver{Status: v1pb.Issue_Approver_Status(approver.GetStatus())}
+		convertedApprover := &v1pb.Issue_Approver{
+			Status:      v1pb.Issue_Approver_Status(approver.GetStatus()),
+			Step:        approver.GetStep(),
+			ApproverSet: approver.GetApproverSet(),
+		}
 		user, err := s.store.GetUserByID(ctx, int(approver.GetPrincipalId()))
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to find user by id %v", approver.GetPrincipalId())
@@ -144,39 +164,14 @@ func computeApprovalStatus(approval *storepb.IssuePayloadApproval) v1pb.Issue_Ap
 		return v1pb.Issue_SKIPPED
 	}
 
-	approvalTemplate := approval.GetApprovalTemplate()
-	approvers := approval.GetApprovers()
-	totalSteps := len(approvalTemplate.GetFlow().GetRoles())
-
-	// If no approvers are assigned yet, it's pending
-	if len(approvers) == 0 {
-		return v1pb.Issue_PENDING
+	// Short-circuit: if any approver rejected, overall status is rejected
+	if utils.IsApprovalRejected(approval) {
+		return v1pb.Issue_REJECTED
 	}
 
-	// Check approver statuses
-	for _, approver := range approvers {
-		if approver.GetStatus() == storepb.IssuePayloadApproval_Approver_REJECTED {
-			// Short-circuit: if any approver rejected, overall status is rejected
-			return v1pb.Issue_REJECTED
-		}
-	}
-
-	// Check if all steps are completed
-	// Each approver corresponds to one step in the approval flow
-	// All steps are approved if:
-	// 1. Number of approvers equals number of steps
-	// 2. All approvers have APPROVED status
-	if len(approvers) >= totalSteps {
-		allApproved := true
-		for _, approver := range approvers {
-			if approver.GetStatus() != storepb.IssuePayloadApproval_Approver_APPROVED {
-				allApproved = false
-				break
-			}
-		}
-		if allApproved {
-			return v1pb.Issue_APPROVED
-		}
+	// All steps are approved if no approver set is waiting for approvals.
+	if len(utils.FindPendingApproverSets(approval)) == 0 {
+		return v1pb.Issue_APPROVED
 	}
 
 	// Otherwise, approval is pending (more steps to complete or waiting for approvals)
@@ -297,9 +292,22 @@ func convertToApprovalTemplate(template *storepb.ApprovalTemplate) *v1pb.Approva
 }
 
 func convertToApprovalFlow(flow *storepb.ApprovalFlow) *v1pb.ApprovalFlow {
-	return &v1pb.ApprovalFlow{
+	v1Flow := &v1pb.ApprovalFlow{
 		Roles: flow.Roles,
 	}
+	for _, step := range flow.Steps {
+		v1Step := &v1pb.ApprovalStep{
+			Type: v1pb.ApprovalStep_Type(step.Type),
+		}
+		for _, approverSet := range step.ApproverSets {
+			v1Step.ApproverSets = append(v1Step.ApproverSets, &v1pb.ApprovalStep_ApproverSet{
+				Approvers:     approverSet.Approvers,
+				RequiredCount: approverSet.RequiredCount,
+			})
+		}
+		v1Flow.Steps = append(v1Flow.Steps, v1Step)
+	}
+	return v1Flow
 }
 
 func convertToGrantRequest(ctx context.Context, s *store.Store, v *storepb.GrantRequest) (*v1pb.GrantRequest, error) {
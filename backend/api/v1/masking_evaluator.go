@@ -277,6 +277,16 @@ func getAlgorithmNameFromSemanticType(semanticType *storepb.SemanticTypeSetting_
 		return "Hash (MD5)"
 	case *storepb.Algorithm_InnerOuterMask_:
 		return "Inner/Outer mask"
+	case *storepb.Algorithm_FormatPreservingEncryptionMask_:
+		return "Format-preserving encryption"
+	case *storepb.Algorithm_TokenizationMask_:
+		return "Tokenization"
+	case *storepb.Algorithm_DateTruncateMask_:
+		return "Date truncation"
+	case *storepb.Algorithm_NumericBucketMask_:
+		return "Numeric bucketing"
+	case *storepb.Algorithm_NumericNoiseMask_:
+		return "Numeric noise"
 	default:
 		return "Unknown"
 	}
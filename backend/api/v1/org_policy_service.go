@@ -21,13 +21,15 @@ import (
 var (
 	// allowedResourceTypes includes allowed resource types for each policy type.
 	allowedResourceTypes = map[storepb.Policy_Type][]storepb.Policy_Resource{
-		storepb.Policy_ROLLOUT:           {storepb.Policy_ENVIRONMENT},
-		storepb.Policy_TAG:               {storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
-		storepb.Policy_QUERY_DATA:        {storepb.Policy_WORKSPACE, storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
-		storepb.Policy_MASKING_RULE:      {storepb.Policy_WORKSPACE},
-		storepb.Policy_MASKING_EXCEPTION: {storepb.Policy_PROJECT},
-		storepb.Policy_IAM:               {storepb.Policy_WORKSPACE},
-		storepb.Policy_DATA_SOURCE_QUERY: {storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
+		storepb.Policy_ROLLOUT:            {storepb.Policy_ENVIRONMENT},
+		storepb.Policy_TAG:                {storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
+		storepb.Policy_QUERY_DATA:         {storepb.Policy_WORKSPACE, storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
+		storepb.Policy_MASKING_RULE:       {storepb.Policy_WORKSPACE},
+		storepb.Policy_MASKING_EXCEPTION:  {storepb.Policy_PROJECT},
+		storepb.Policy_IAM:                {storepb.Policy_WORKSPACE},
+		storepb.Policy_DATA_SOURCE_QUERY:  {storepb.Policy_ENVIRONMENT, storepb.Policy_PROJECT},
+		storepb.Policy_MAINTENANCE_WINDOW: {storepb.Policy_ENVIRONMENT},
+		storepb.Policy_ROW_FILTER:         {storepb.Policy_PROJECT},
 	}
 )
 
@@ -177,13 +179,18 @@ func (s *OrgPolicyService) UpdatePolicy(ctx context.Context, req *connect.Reques
 			"tag_policy",
 			"data_source_query_policy",
 			"export_data_policy",
-			"query_data_policy":
+			"query_data_policy",
+			"maintenance_window_policy",
+			"row_filter_policy":
 			if !pathMatchType(path, policy.Type) {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid path %s for policy type %s", path, policy.Type.String()))
 			}
 			if err := validatePolicyPayload(policy.Type, req.Msg.Policy); err != nil {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid policy"))
 			}
+			if err := s.validateRowFilterDatabases(ctx, policy.Type, policy.Resource, req.Msg.Policy); err != nil {
+				return nil, err
+			}
 			payloadStr, err := s.convertPolicyPayloadToString(ctx, req.Msg.Policy)
 			if err != nil {
 				return nil, err
@@ -223,6 +230,10 @@ func pathMatchType(path string, policyType storepb.Policy_Type) bool {
 		return path == "data_source_query_policy"
 	case storepb.Policy_QUERY_DATA:
 		return path == "query_data_policy"
+	case storepb.Policy_MAINTENANCE_WINDOW:
+		return path == "maintenance_window_policy"
+	case storepb.Policy_ROW_FILTER:
+		return path == "row_filter_policy"
 	default:
 		return false
 	}
@@ -355,6 +366,9 @@ func (s *OrgPolicyService) createPolicyMessage(ctx context.Context, parent strin
 	if err := validatePolicyPayload(policyType, policy); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid policy"))
 	}
+	if err := s.validateRowFilterDatabases(ctx, policyType, parent, policy); err != nil {
+		return nil, err
+	}
 
 	payloadStr, err := s.convertPolicyPayloadToString(ctx, policy)
 	if err != nil {
@@ -398,7 +412,7 @@ func validatePolicyType(policyType storepb.Policy_Type, policyResourceType store
 }
 
 func (s *OrgPolicyService) checkPolicyFeatureGuard(policyType v1pb.PolicyType) error {
-	if policyType == v1pb.PolicyType_DATA_QUERY || policyType == v1pb.PolicyType_DATA_SOURCE_QUERY {
+	if policyType == v1pb.PolicyType_DATA_QUERY || policyType == v1pb.PolicyType_DATA_SOURCE_QUERY || policyType == v1pb.PolicyType_ROW_FILTER {
 		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_QUERY_POLICY); err != nil {
 			return connect.NewError(connect.CodePermissionDenied, err)
 		}
@@ -443,11 +457,79 @@ func validatePolicyPayload(policyType storepb.Policy_Type, policy *v1pb.Policy)
 				return err
 			}
 		}
+	case storepb.Policy_MAINTENANCE_WINDOW:
+		maintenanceWindowPolicy, ok := policy.Policy.(*v1pb.Policy_MaintenanceWindowPolicy)
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unmatched policy type %v and policy %v", policyType, policy.Policy))
+		}
+		if maintenanceWindowPolicy.MaintenanceWindowPolicy == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("maintenance window policy must be set"))
+		}
+		if len(maintenanceWindowPolicy.MaintenanceWindowPolicy.Windows) == 0 {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("maintenance window policy must have windows"))
+		}
+		if _, err := common.NewMaintenanceWindow(convertToStorePBMaintenanceWindowPolicy(maintenanceWindowPolicy.MaintenanceWindowPolicy)); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid maintenance window policy"))
+		}
+	case storepb.Policy_ROW_FILTER:
+		rowFilterPolicy, ok := policy.Policy.(*v1pb.Policy_RowFilterPolicy)
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unmatched policy type %v and policy %v", policyType, policy.Policy))
+		}
+		if rowFilterPolicy.RowFilterPolicy == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("row filter policy must be set"))
+		}
+		for _, filter := range rowFilterPolicy.RowFilterPolicy.Filters {
+			if _, _, err := common.GetInstanceDatabaseID(filter.Database); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid row filter database %q", filter.Database))
+			}
+			if filter.Table == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.New("row filter must have table set"))
+			}
+			if strings.TrimSpace(filter.Predicate) == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.New("row filter must have predicate set"))
+			}
+			if _, err := common.ValidateRowFilterCELExpr(filter.Condition); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid row filter expression: %v", err))
+			}
+		}
 	default:
 	}
 	return nil
 }
 
+// validateRowFilterDatabases checks that the row filters are on the databases of the project owning the policy,
+// so that a project can't filter the queries on the databases of other projects.
+func (s *OrgPolicyService) validateRowFilterDatabases(ctx context.Context, policyType storepb.Policy_Type, resource string, policy *v1pb.Policy) error {
+	if policyType != storepb.Policy_ROW_FILTER {
+		return nil
+	}
+	projectID, err := common.GetProjectID(resource)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "row filter policy must be set on a project"))
+	}
+	for _, filter := range policy.GetRowFilterPolicy().GetFilters() {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(filter.Database)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid row filter database %q", filter.Database))
+		}
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:   &instanceID,
+			DatabaseName: &databaseName,
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database %q", filter.Database))
+		}
+		if database == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("row filter database %q not found", filter.Database))
+		}
+		if database.ProjectID != projectID {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("row filter database %q is not in project %q", filter.Database, projectID))
+		}
+	}
+	return nil
+}
+
 func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, policy *v1pb.Policy) (string, error) {
 	switch policy.Type {
 	case v1pb.PolicyType_ROLLOUT_POLICY:
@@ -512,6 +594,20 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", errors.Wrap(err, "failed to marshal data source query policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_MAINTENANCE_WINDOW:
+		payload := convertToStorePBMaintenanceWindowPolicy(policy.GetMaintenanceWindowPolicy())
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal maintenance window policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_FILTER:
+		payload := convertToStorePBRowFilterPolicy(policy.GetRowFilterPolicy())
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row filter policy")
+		}
+		return string(payloadBytes), nil
 	default:
 	}
 
@@ -581,6 +677,22 @@ func (s *OrgPolicyService) convertToPolicy(ctx context.Context, policyMessage *s
 			return nil, err
 		}
 		policy.Policy = payload
+	case storepb.Policy_MAINTENANCE_WINDOW:
+		maintenanceWindowPolicy := &storepb.MaintenanceWindowPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), maintenanceWindowPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal maintenance window policy")
+		}
+		policy.Policy = &v1pb.Policy_MaintenanceWindowPolicy{
+			MaintenanceWindowPolicy: convertToV1PBMaintenanceWindowPolicy(maintenanceWindowPolicy),
+		}
+	case storepb.Policy_ROW_FILTER:
+		rowFilterPolicy := &storepb.RowFilterPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), rowFilterPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row filter policy")
+		}
+		policy.Policy = &v1pb.Policy_RowFilterPolicy{
+			RowFilterPolicy: convertToV1PBRowFilterPolicy(rowFilterPolicy),
+		}
 	default:
 	}
 
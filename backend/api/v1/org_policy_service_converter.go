@@ -278,6 +278,60 @@ func convertToDataSourceQueryPayload(policy *v1pb.DataSourceQueryPolicy) *storep
 	}
 }
 
+func convertToStorePBMaintenanceWindowPolicy(policy *v1pb.MaintenanceWindowPolicy) *storepb.MaintenanceWindowPolicy {
+	result := &storepb.MaintenanceWindowPolicy{
+		TimeZone: policy.GetTimeZone(),
+	}
+	for _, window := range policy.GetWindows() {
+		result.Windows = append(result.Windows, &storepb.MaintenanceWindowPolicy_Window{
+			Cron:     window.Cron,
+			Duration: window.Duration,
+		})
+	}
+	return result
+}
+
+func convertToV1PBMaintenanceWindowPolicy(policy *storepb.MaintenanceWindowPolicy) *v1pb.MaintenanceWindowPolicy {
+	result := &v1pb.MaintenanceWindowPolicy{
+		TimeZone: policy.GetTimeZone(),
+	}
+	for _, window := range policy.GetWindows() {
+		result.Windows = append(result.Windows, &v1pb.MaintenanceWindowPolicy_Window{
+			Cron:     window.Cron,
+			Duration: window.Duration,
+		})
+	}
+	return result
+}
+
+func convertToStorePBRowFilterPolicy(policy *v1pb.RowFilterPolicy) *storepb.RowFilterPolicy {
+	result := &storepb.RowFilterPolicy{}
+	for _, filter := range policy.GetFilters() {
+		result.Filters = append(result.Filters, &storepb.RowFilterPolicy_RowFilter{
+			Database:  filter.Database,
+			Schema:    filter.Schema,
+			Table:     filter.Table,
+			Condition: filter.Condition,
+			Predicate: filter.Predicate,
+		})
+	}
+	return result
+}
+
+func convertToV1PBRowFilterPolicy(policy *storepb.RowFilterPolicy) *v1pb.RowFilterPolicy {
+	result := &v1pb.RowFilterPolicy{}
+	for _, filter := range policy.GetFilters() {
+		result.Filters = append(result.Filters, &v1pb.RowFilterPolicy_RowFilter{
+			Database:  filter.Database,
+			Schema:    filter.Schema,
+			Table:     filter.Table,
+			Condition: filter.Condition,
+			Predicate: filter.Predicate,
+		})
+	}
+	return result
+}
+
 func convertV1PBToStorePBPolicyType(pType v1pb.PolicyType) (storepb.Policy_Type, error) {
 	switch pType {
 	case v1pb.PolicyType_ROLLOUT_POLICY:
@@ -292,6 +346,10 @@ func convertV1PBToStorePBPolicyType(pType v1pb.PolicyType) (storepb.Policy_Type,
 		return storepb.Policy_QUERY_DATA, nil
 	case v1pb.PolicyType_DATA_SOURCE_QUERY:
 		return storepb.Policy_DATA_SOURCE_QUERY, nil
+	case v1pb.PolicyType_MAINTENANCE_WINDOW:
+		return storepb.Policy_MAINTENANCE_WINDOW, nil
+	case v1pb.PolicyType_ROW_FILTER:
+		return storepb.Policy_ROW_FILTER, nil
 	default:
 	}
 	return storepb.Policy_TYPE_UNSPECIFIED, errors.Errorf("invalid policy type %v", pType)
@@ -311,6 +369,10 @@ func convertStorePBToV1PBPolicyType(pType storepb.Policy_Type) v1pb.PolicyType {
 		return v1pb.PolicyType_DATA_QUERY
 	case storepb.Policy_DATA_SOURCE_QUERY:
 		return v1pb.PolicyType_DATA_SOURCE_QUERY
+	case storepb.Policy_MAINTENANCE_WINDOW:
+		return v1pb.PolicyType_MAINTENANCE_WINDOW
+	case storepb.Policy_ROW_FILTER:
+		return v1pb.PolicyType_ROW_FILTER
 	default:
 	}
 	return v1pb.PolicyType_POLICY_TYPE_UNSPECIFIED
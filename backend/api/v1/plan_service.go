@@ -277,7 +277,7 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *connect.Request[v
 	}
 
 	// Tickle plan check scheduler.
-	s.stateCfg.PlanCheckTickleChan <- 0
+	s.stateCfg.TicklePlanCheckScheduler(ctx)
 
 	convertedPlan, err := convertToPlan(ctx, s.store, plan)
 	if err != nil {
@@ -1033,7 +1033,7 @@ func (s *PlanService) RunPlanChecks(ctx context.Context, request *connect.Reques
 	}
 
 	// Tickle plan check scheduler.
-	s.stateCfg.PlanCheckTickleChan <- 0
+	s.stateCfg.TicklePlanCheckScheduler(ctx)
 
 	return connect.NewResponse(&v1pb.RunPlanChecksResponse{}), nil
 }
@@ -1090,12 +1090,12 @@ func (s *PlanService) BatchCancelPlanCheckRuns(ctx context.Context, request *con
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("planCheckRun %v(%v) is not running", planCheckRun.UID, planCheckRun.Type))
 		}
 	}
-	// Cancel the plan check runs.
+	// Cancel the plan check runs, which may be executed by the other replicas in the HA deployment.
+	var runningPlanCheckRunUIDs []int
 	for _, planCheckRun := range planCheckRuns {
-		if cancelFunc, ok := s.stateCfg.RunningPlanCheckRunsCancelFunc.Load(planCheckRun.UID); ok {
-			cancelFunc.(context.CancelFunc)()
-		}
+		runningPlanCheckRunUIDs = append(runningPlanCheckRunUIDs, planCheckRun.UID)
 	}
+	s.stateCfg.CancelPlanCheckRuns(ctx, runningPlanCheckRunUIDs)
 	// Update the status of the plan check runs to canceled.
 	if err := s.store.BatchCancelPlanCheckRuns(ctx, planCheckRunIDs); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to batch patch task run status to canceled, error: %v", err))
@@ -143,6 +143,8 @@ func convertToPlanSpecMigrationType(t storepb.MigrationType) v1pb.MigrationType
 		return v1pb.MigrationType_DDL
 	case storepb.MigrationType_GHOST:
 		return v1pb.MigrationType_GHOST
+	case storepb.MigrationType_PG_ONLINE:
+		return v1pb.MigrationType_PG_ONLINE
 	case storepb.MigrationType_DML:
 		return v1pb.MigrationType_DML
 	default:
@@ -270,6 +272,8 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 		storeMigrateType = storepb.MigrationType_DML
 	case v1pb.MigrationType_GHOST:
 		storeMigrateType = storepb.MigrationType_GHOST
+	case v1pb.MigrationType_PG_ONLINE:
+		storeMigrateType = storepb.MigrationType_PG_ONLINE
 	default:
 		storeMigrateType = storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED
 	}
@@ -349,6 +353,8 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabasePgOnlineCheck:
+		return v1pb.PlanCheckRun_DATABASE_PG_ONLINE_CHECK
 	default:
 		return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
 	}
@@ -238,6 +238,19 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			},
 		})
 	}
+	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE && config.MigrateType == storepb.MigrationType_PG_ONLINE {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			PlanUID: plan.UID,
+			Status:  store.PlanCheckRunStatusRunning,
+			Type:    store.PlanCheckDatabasePgOnlineCheck,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type, config.MigrateType),
+				InstanceId:         instance.ResourceID,
+				DatabaseName:       database.DatabaseName,
+			},
+		})
+	}
 
 	return planCheckRuns, nil
 }
@@ -250,6 +263,8 @@ func convertToChangeDatabaseType(t storepb.PlanConfig_ChangeDatabaseConfig_Type,
 			return storepb.PlanCheckRunConfig_DDL
 		case storepb.MigrationType_GHOST:
 			return storepb.PlanCheckRunConfig_DDL_GHOST
+		case storepb.MigrationType_PG_ONLINE:
+			return storepb.PlanCheckRunConfig_DDL_PG_ONLINE
 		case storepb.MigrationType_DML:
 			return storepb.PlanCheckRunConfig_DML
 		default:
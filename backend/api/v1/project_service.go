@@ -23,6 +23,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -38,6 +39,7 @@ type ProjectService struct {
 	profile        *config.Profile
 	iamManager     *iam.Manager
 	licenseService *enterprise.LicenseService
+	webhookManager *webhook.Manager
 }
 
 // NewProjectService creates a new ProjectService.
@@ -46,12 +48,14 @@ func NewProjectService(
 	profile *config.Profile,
 	iamManager *iam.Manager,
 	licenseService *enterprise.LicenseService,
+	webhookManager *webhook.Manager,
 ) *ProjectService {
 	return &ProjectService{
 		store:          store,
 		profile:        profile,
 		iamManager:     iamManager,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -911,6 +915,13 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, req *connect.Request
 			updatedPayload.Activities = types
 		case "direct_message":
 			updatedPayload.DirectMessage = req.Msg.Webhook.DirectMessage
+		case "signing_secret":
+			updatedPayload.SigningSecret = req.Msg.Webhook.SigningSecret
+		case "headers":
+			if err := validateWebhookHeaders(updatedPayload.Type, req.Msg.Webhook.Headers); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			updatedPayload.Headers = req.Msg.Webhook.Headers
 		default:
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid field %q", path))
 		}
@@ -1055,6 +1066,124 @@ func (s *ProjectService) TestWebhook(ctx context.Context, req *connect.Request[v
 	return connect.NewResponse(resp), nil
 }
 
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListWebhookDeliveriesResponse], error) {
+	if req.Msg.PageSize < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("page size must be non-negative: %d", req.Msg.PageSize))
+	}
+	projectID, webhookID, err := common.GetProjectIDWebhookID(req.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid webhook id %q", webhookID))
+	}
+	hook, err := s.getProjectWebhook(ctx, projectID, webhookIDInt)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   req.Msg.PageToken,
+		limit:   int(req.Msg.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		WebhookID: &hook.ID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset.offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list webhook deliveries"))
+	}
+
+	var nextPageToken string
+	if len(deliveries) == limitPlusOne {
+		if nextPageToken, err = offset.getNextPageToken(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		deliveries = deliveries[:offset.limit]
+	}
+
+	resp := &v1pb.ListWebhookDeliveriesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, convertToV1WebhookDelivery(delivery))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RetryWebhookDelivery attempts a webhook delivery again immediately.
+func (s *ProjectService) RetryWebhookDelivery(ctx context.Context, req *connect.Request[v1pb.RetryWebhookDeliveryRequest]) (*connect.Response[v1pb.WebhookDelivery], error) {
+	projectID, webhookID, deliveryID, err := common.GetProjectIDWebhookIDDeliveryID(req.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	hook, err := s.getProjectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		ID:        &deliveryID,
+		WebhookID: &hook.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get webhook delivery"))
+	}
+	if delivery == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook delivery %q not found", req.Msg.Name))
+	}
+	// Claim the delivery, so that it's not attempted by the automatic retry or another manual retry concurrently.
+	delivery, err = s.store.ClaimWebhookDelivery(ctx, delivery.ID, webhook.DeliveryLease)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to claim webhook delivery"))
+	}
+	if delivery == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("webhook delivery %q is being attempted", req.Msg.Name))
+	}
+
+	delivery, err = s.webhookManager.RetryDelivery(ctx, hook, delivery)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to retry webhook delivery"))
+	}
+	return connect.NewResponse(convertToV1WebhookDelivery(delivery)), nil
+}
+
+func (s *ProjectService) getProjectWebhook(ctx context.Context, projectID string, webhookID int) (*store.ProjectWebhookMessage, error) {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", projectID))
+	}
+	if project.Deleted {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q has been deleted", projectID))
+	}
+
+	hook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.ResourceID,
+		ID:        &webhookID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if hook == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("webhook %d not found", webhookID))
+	}
+	return hook, nil
+}
+
 func (s *ProjectService) getProjectMessage(ctx context.Context, name string) (*store.ProjectMessage, error) {
 	projectID, err := common.GetProjectID(name)
 	if err != nil {
@@ -11,11 +11,13 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 )
@@ -44,6 +46,9 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 	if err != nil {
 		return nil, err
 	}
+	if err := validateWebhookHeaders(storeType, webhook.Headers); err != nil {
+		return nil, err
+	}
 	return &store.ProjectWebhookMessage{
 		Payload: &storepb.ProjectWebhook{
 			Type:          storeType,
@@ -51,10 +56,25 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 			Url:           webhook.Url,
 			Activities:    activityTypes,
 			DirectMessage: webhook.DirectMessage,
+			SigningSecret: webhook.SigningSecret,
+			Headers:       webhook.Headers,
 		},
 	}, nil
 }
 
+func validateWebhookHeaders(tp storepb.ProjectWebhook_Type, headers map[string]string) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	if tp != storepb.ProjectWebhook_CUSTOM {
+		return common.Errorf(common.Invalid, "headers are only supported by %s webhooks", storepb.ProjectWebhook_CUSTOM)
+	}
+	if err := webhookplugin.ValidateCustomHeaders(headers); err != nil {
+		return common.Wrap(err, common.Invalid)
+	}
+	return nil
+}
+
 func convertToStoreActivityTypes(types []v1pb.Activity_Type) ([]storepb.Activity_Type, error) {
 	var result []storepb.Activity_Type
 	for _, tp := range types {
@@ -79,6 +99,8 @@ func convertToStoreActivityTypes(types []v1pb.Activity_Type) ([]storepb.Activity
 			result = append(result, storepb.Activity_NOTIFY_ISSUE_APPROVED)
 		case v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, storepb.Activity_NOTIFY_PIPELINE_ROLLOUT)
+		case v1pb.Activity_NOTIFY_GRANT_EXPIRED:
+			result = append(result, storepb.Activity_NOTIFY_GRANT_EXPIRED)
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -108,6 +130,8 @@ func convertToV1ActivityTypes(types []storepb.Activity_Type) []v1pb.Activity_Typ
 			result = append(result, v1pb.Activity_NOTIFY_ISSUE_APPROVED)
 		case storepb.Activity_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, v1pb.Activity_NOTIFY_PIPELINE_ROLLOUT)
+		case storepb.Activity_NOTIFY_GRANT_EXPIRED:
+			result = append(result, v1pb.Activity_NOTIFY_GRANT_EXPIRED)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -133,6 +157,8 @@ func convertToStoreWebhookType(tp v1pb.Webhook_Type) (storepb.ProjectWebhook_Typ
 		return storepb.ProjectWebhook_WECOM, nil
 	case v1pb.Webhook_LARK:
 		return storepb.ProjectWebhook_LARK, nil
+	case v1pb.Webhook_CUSTOM:
+		return storepb.ProjectWebhook_CUSTOM, nil
 	default:
 		return storepb.ProjectWebhook_TYPE_UNSPECIFIED, common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -154,11 +180,42 @@ func convertToV1WebhookType(tp storepb.ProjectWebhook_Type) v1pb.Webhook_Type {
 		return v1pb.Webhook_WECOM
 	case storepb.ProjectWebhook_LARK:
 		return v1pb.Webhook_LARK
+	case storepb.ProjectWebhook_CUSTOM:
+		return v1pb.Webhook_CUSTOM
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
 }
 
+func convertToV1WebhookDelivery(delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	status := v1pb.WebhookDelivery_STATUS_UNSPECIFIED
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		status = v1pb.WebhookDelivery_PENDING
+	case store.WebhookDeliverySucceeded:
+		status = v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		status = v1pb.WebhookDelivery_FAILED
+	default:
+	}
+	v1Delivery := &v1pb.WebhookDelivery{
+		Name:        fmt.Sprintf("%s/%s%d/%s%d", common.FormatProject(delivery.ProjectID), common.WebhookIDPrefix, delivery.WebhookID, common.WebhookDeliveryPrefix, delivery.ID),
+		EventType:   convertToV1ActivityTypes([]storepb.Activity_Type{delivery.EventType})[0],
+		Status:      status,
+		Attempts:    int32(delivery.Attempts),
+		RequestBody: delivery.Payload.GetRequestBody(),
+		Error:       delivery.Payload.GetError(),
+		CreateTime:  timestamppb.New(delivery.CreatedAt),
+		UpdateTime:  timestamppb.New(delivery.UpdatedAt),
+		StatusCode:  delivery.Payload.GetStatusCode(),
+		Latency:     delivery.Payload.GetLatency(),
+	}
+	if delivery.Status == store.WebhookDeliveryPending && delivery.NextAttemptAt != nil {
+		v1Delivery.NextAttemptTime = timestamppb.New(*delivery.NextAttemptAt)
+	}
+	return v1Delivery
+}
+
 func convertToV1MemberInBinding(ctx context.Context, stores *store.Store, member string) string {
 	if strings.HasPrefix(member, common.UserNamePrefix) {
 		userUID, err := common.GetUserID(member)
@@ -300,6 +357,7 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 			Url:               webhook.Payload.GetUrl(),
 			NotificationTypes: convertToV1ActivityTypes(webhook.Payload.GetActivities()),
 			DirectMessage:     webhook.Payload.GetDirectMessage(),
+			Headers:           webhook.Payload.GetHeaders(),
 		})
 	}
 
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -27,26 +29,11 @@ func NewQueryResultMasker(store *store.Store) *QueryResultMasker {
 
 // MaskResults masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*parserbase.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, user *store.UserMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
-	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
-	}
-
-	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
-	}
-
-	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	m, err := s.newMaskingLevelEvaluator(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
+		return err
 	}
 
-	m := newEmptyMaskingLevelEvaluator().
-		withMaskingRulePolicy(maskingRulePolicy).
-		withDataClassificationSetting(classificationSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
 	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
 	loopBoundary := min(len(spans), len(results))
 	for i := 0; i < loopBoundary; i++ {
@@ -73,6 +60,69 @@ func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*parserbase
 	return nil
 }
 
+// RowMasker masks the rows of a query span one at a time.
+// It is used to mask rows when the query result is streamed.
+type RowMasker struct {
+	maskers []masker.Masker
+}
+
+// Mask masks the row in-place.
+func (m *RowMasker) Mask(row *v1pb.QueryRow) {
+	maskRow(m.maskers, row)
+}
+
+// MaskedColumns returns whether each column is masked.
+func (m *RowMasker) MaskedColumns() []bool {
+	masked := make([]bool, len(m.maskers))
+	for i, columnMasker := range m.maskers {
+		if _, ok := columnMasker.(*masker.NoneMasker); !ok && columnMasker != nil {
+			masked[i] = true
+		}
+	}
+	return masked
+}
+
+// GetRowMasker returns the masker of the rows of the query span.
+func (s *QueryResultMasker) GetRowMasker(ctx context.Context, span *parserbase.QuerySpan, instance *store.InstanceMessage, user *store.UserMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) (*RowMasker, error) {
+	if span.FunctionNotSupportedError != nil {
+		return nil, errors.Errorf("masking error: %v", span.FunctionNotSupportedError)
+	}
+	if span.NotFoundError != nil {
+		return nil, errors.Errorf("masking error: %v", span.NotFoundError)
+	}
+	m, err := s.newMaskingLevelEvaluator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maskers, _, err := s.getMaskersForQuerySpan(ctx, m, instance, user, span, action)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get maskers for query span")
+	}
+	return &RowMasker{maskers: maskers}, nil
+}
+
+func (s *QueryResultMasker) newMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
+	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+
+	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
+		withMaskingRulePolicy(maskingRulePolicy).
+		withDataClassificationSetting(classificationSetting).
+		withSemanticTypeSetting(semanticTypesSetting), nil
+}
+
 func getAlgorithmName(m masker.Masker) string {
 	switch m.(type) {
 	case *masker.NoneMasker:
@@ -86,6 +136,16 @@ func getAlgorithmName(m masker.Masker) string {
 	case *masker.InnerOuterMasker:
 		// Check the actual type by examining the mask result
 		return "Inner/Outer mask"
+	case *masker.FPEMasker:
+		return "Format-preserving encryption"
+	case *masker.TokenizationMasker:
+		return "Tokenization"
+	case *masker.DateTruncateMasker:
+		return "Date truncation"
+	case *masker.NumericBucketMasker:
+		return "Numeric bucketing"
+	case *masker.NumericNoiseMasker:
+		return "Numeric noise"
 	default:
 		return "Unknown"
 	}
@@ -100,12 +160,19 @@ func buildSemanticTypeToMaskerMap(ctx context.Context, stores *store.Store) (map
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get semantic types setting")
 	}
+	var secret string
 	for _, semanticType := range semanticTypesSetting.GetTypes() {
 		if semanticType.GetId() == "bb.default" || semanticType.GetId() == "bb.default-partial" {
 			// Skip the built-in default semantic types.
 			continue
 		}
-		m, err := getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm())
+		if secret == "" && isKeyedMaskingAlgorithm(semanticType.GetAlgorithm()) {
+			secret, err = stores.GetSecret(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace secret")
+			}
+		}
+		m, err := getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm(), secret)
 		if err != nil {
 			return nil, err
 		}
@@ -353,7 +420,25 @@ func (s *QueryResultMasker) getColumnForColumnResource(ctx context.Context, inst
 	return columnMetadata, columnConfig, nil
 }
 
-func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) (masker.Masker, error) {
+// isKeyedMaskingAlgorithm returns whether the masking algorithm needs a key derived from the workspace secret.
+func isKeyedMaskingAlgorithm(algorithm *storepb.Algorithm) bool {
+	switch algorithm.GetMask().(type) {
+	case *storepb.Algorithm_FormatPreservingEncryptionMask_, *storepb.Algorithm_TokenizationMask_:
+		return true
+	default:
+		return false
+	}
+}
+
+// deriveMaskingKey derives the key of a keyed masking algorithm from the workspace secret,
+// so that each algorithm uses a different key and the secret itself is never used as a cipher key.
+func deriveMaskingKey(secret, purpose string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write([]byte(purpose))
+	return h.Sum(nil)
+}
+
+func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm, secret string) (masker.Masker, error) {
 	if algorithm == nil {
 		return masker.NewNoneMasker(), nil
 	}
@@ -367,6 +452,16 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) (masker.M
 		return masker.NewMD5Masker(m.Md5Mask.Salt), nil
 	case *storepb.Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.Algorithm_FormatPreservingEncryptionMask_:
+		return masker.NewFPEMasker(m.FormatPreservingEncryptionMask.Mode, deriveMaskingKey(secret, "masking.fpe"), m.FormatPreservingEncryptionMask.Tweak)
+	case *storepb.Algorithm_TokenizationMask_:
+		return masker.NewTokenizationMasker(deriveMaskingKey(secret, "masking.tokenization"), m.TokenizationMask.Prefix), nil
+	case *storepb.Algorithm_DateTruncateMask_:
+		return masker.NewDateTruncateMasker(m.DateTruncateMask.Granularity)
+	case *storepb.Algorithm_NumericBucketMask_:
+		return masker.NewNumericBucketMasker(m.NumericBucketMask.BucketSize)
+	case *storepb.Algorithm_NumericNoiseMask_:
+		return masker.NewNumericNoiseMasker(m.NumericNoiseMask.MaxNoise, deriveMaskingKey(secret, "masking.noise"))
 	}
 	return masker.NewNoneMasker(), nil
 }
@@ -396,20 +491,22 @@ func doMaskResult(maskers []masker.Masker, reasons []*v1pb.MaskingReason, result
 		}
 	}
 
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
-			if value == nil {
-				continue
-			}
-			maskedValue := row.Values[j]
-			if j < len(maskers) && maskers[j] != nil {
-				maskedValue = maskers[j].Mask(&masker.MaskData{
-					Data: row.Values[j],
-				})
-			}
-			result.Rows[i].Values[j] = maskedValue
-		}
+	for _, row := range result.Rows {
+		maskRow(maskers, row)
 	}
 
 	result.Masked = reasons
 }
+
+func maskRow(maskers []masker.Masker, row *v1pb.QueryRow) {
+	for j, value := range row.Values {
+		if value == nil {
+			continue
+		}
+		if j < len(maskers) && maskers[j] != nil {
+			row.Values[j] = maskers[j].Mask(&masker.MaskData{
+				Data: value,
+			})
+		}
+	}
+}
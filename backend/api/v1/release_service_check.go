@@ -15,9 +15,14 @@ import (
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
 	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	advisormssql "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
+	advisormysql "github.com/bytebase/bytebase/backend/plugin/advisor/mysql"
+	advisororacle "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
 	advisorpg "github.com/bytebase/bytebase/backend/plugin/advisor/pg"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	"github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+	"github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/store/model"
@@ -473,6 +478,38 @@ func (s *ReleaseService) checkReleaseDeclarative(ctx context.Context, files []*v
 			}
 		}
 
+		// Perform SDL integrity checks for MySQL and TiDB
+		if engine == storepb.Engine_MYSQL || engine == storepb.Engine_TIDB {
+			fileContents := make(map[string]string)
+			for _, file := range files {
+				fileContents[file.Path] = string(file.Statement)
+			}
+
+			var err error
+			sdlIntegrityAdvices, err = advisormysql.CheckSDLIntegrity(fileContents)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to check SDL integrity"))
+			}
+		}
+
+		// Perform SDL integrity checks for SQL Server and Oracle
+		if engine == storepb.Engine_MSSQL || engine == storepb.Engine_ORACLE {
+			fileContents := make(map[string]string)
+			for _, file := range files {
+				fileContents[file.Path] = string(file.Statement)
+			}
+
+			var err error
+			if engine == storepb.Engine_MSSQL {
+				sdlIntegrityAdvices, err = advisormssql.CheckSDLIntegrity(fileContents)
+			} else {
+				sdlIntegrityAdvices, err = advisororacle.CheckSDLIntegrity(fileContents)
+			}
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to check SDL integrity"))
+			}
+		}
+
 		// Batch AI linting for all declarative files (if custom rules provided)
 		var aiAdvicesMap map[string][]*v1pb.Advice
 		if customRules != "" {
@@ -526,7 +563,7 @@ func (s *ReleaseService) checkReleaseDeclarative(ctx context.Context, files []*v
 					} else {
 						// Check all statement types against whitelist and collect disallowed ones with positions
 						for _, stmt := range statementsWithPos {
-							if !isAllowedInSDL(stmt.Type) {
+							if !isAllowedInSDL(engine, stmt.Type) {
 								// Create a separate advice for each disallowed statement with position
 								advice := &v1pb.Advice{
 									Status: v1pb.Advice_ERROR,
@@ -556,8 +593,8 @@ func (s *ReleaseService) checkReleaseDeclarative(ctx context.Context, files []*v
 						}
 					}
 
-					// Add SDL style and integrity check results for this file (PostgreSQL only)
-					if engine == storepb.Engine_POSTGRES && len(checkResult.Advices) == 0 {
+					// Add SDL style and integrity check results for this file
+					if len(checkResult.Advices) == 0 {
 						// Add SDL style check results
 						if advices, exists := sdlStyleAdvices[file.Path]; exists {
 							for _, advice := range advices {
@@ -717,7 +754,7 @@ func (s *ReleaseService) runSQLReviewCheckForFile(
 
 func getRiskSourceFromChangeType(changeType storepb.PlanCheckRunConfig_ChangeDatabaseType) store.RiskSource {
 	switch changeType {
-	case storepb.PlanCheckRunConfig_DDL, storepb.PlanCheckRunConfig_DDL_GHOST:
+	case storepb.PlanCheckRunConfig_DDL, storepb.PlanCheckRunConfig_DDL_GHOST, storepb.PlanCheckRunConfig_DDL_PG_ONLINE:
 		return store.RiskSourceDatabaseSchemaUpdate
 	case storepb.PlanCheckRunConfig_DML:
 		return store.RiskSourceDatabaseDataUpdate
@@ -746,13 +783,14 @@ func convertRiskLevel(riskLevel storepb.RiskLevel) (v1pb.RiskLevel, error) {
 // ALTER SEQUENCE is allowed for setting ownership (OWNED BY).
 var allowedSDLStatementTypes = map[string]bool{
 	// CREATE statements - declare new objects
-	"CREATE_TABLE":     true,
-	"CREATE_INDEX":     true,
-	"CREATE_VIEW":      true,
-	"CREATE_SEQUENCE":  true,
-	"CREATE_FUNCTION":  true,
-	"CREATE_PROCEDURE": true,
-	"CREATE_SCHEMA":    true,
+	"CREATE_TABLE":             true,
+	"CREATE_INDEX":             true,
+	"CREATE_VIEW":              true,
+	"CREATE_MATERIALIZED_VIEW": true,
+	"CREATE_SEQUENCE":          true,
+	"CREATE_FUNCTION":          true,
+	"CREATE_PROCEDURE":         true,
+	"CREATE_SCHEMA":            true,
 
 	// ALTER statements - limited to specific cases
 	"ALTER_SEQUENCE": true, // Allowed for OWNED BY and sequence options
@@ -761,8 +799,12 @@ var allowedSDLStatementTypes = map[string]bool{
 	"COMMENT": true,
 }
 
-// isAllowedInSDL checks if a statement type is allowed in SDL files.
-func isAllowedInSDL(stmtType string) bool {
+// isAllowedInSDL checks if a statement type is allowed in SDL files of the engine.
+func isAllowedInSDL(engine storepb.Engine, stmtType string) bool {
+	if stmtType == "ALTER_SEQUENCE" && engine == storepb.Engine_ORACLE {
+		// Oracle sequences have no OWNED BY, and the SDL diff doesn't apply the ALTER statements.
+		return false
+	}
 	return allowedSDLStatementTypes[stmtType]
 }
 
@@ -776,7 +818,7 @@ type statementTypeWithPosition struct {
 
 // getStatementTypesWithPositionsForEngine returns statement types with position info for the given engine and ASTs.
 // The line numbers are one-based.
-// Currently only PostgreSQL is supported.
+// Currently PostgreSQL, SQL Server and Oracle are supported.
 func getStatementTypesWithPositionsForEngine(engine storepb.Engine, asts any) ([]statementTypeWithPosition, error) {
 	switch engine {
 	case storepb.Engine_POSTGRES, storepb.Engine_COCKROACHDB, storepb.Engine_REDSHIFT:
@@ -794,6 +836,34 @@ func getStatementTypesWithPositionsForEngine(engine storepb.Engine, asts any) ([
 			}
 		}
 		return result, nil
+	case storepb.Engine_MSSQL:
+		tsqlStmts, err := tsql.GetStatementTypesWithPositions(asts)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]statementTypeWithPosition, len(tsqlStmts))
+		for i, stmt := range tsqlStmts {
+			result[i] = statementTypeWithPosition{
+				Type: stmt.Type,
+				Line: stmt.Line,
+				Text: stmt.Text,
+			}
+		}
+		return result, nil
+	case storepb.Engine_ORACLE:
+		plsqlStmts, err := plsql.GetStatementTypesWithPositions(asts)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]statementTypeWithPosition, len(plsqlStmts))
+		for i, stmt := range plsqlStmts {
+			result[i] = statementTypeWithPosition{
+				Type: stmt.Type,
+				Line: stmt.Line,
+				Text: stmt.Text,
+			}
+		}
+		return result, nil
 	default:
 		// For unsupported engines, return empty list (skip check)
 		return []statementTypeWithPosition{}, nil
@@ -275,7 +275,7 @@ func (s *RolloutService) CreateRollout(ctx context.Context, req *connect.Request
 	}
 
 	// Tickle task run scheduler.
-	s.stateCfg.TaskRunTickleChan <- 0
+	s.stateCfg.TickleTaskRunScheduler(ctx)
 
 	return connect.NewResponse(rolloutV1), nil
 }
@@ -613,6 +613,12 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, req *connect.Request
 		}
 	}
 
+	if request.GetRunTime() != nil {
+		if err := s.checkMaintenanceWindow(ctx, user, project, environmentToRun, request.GetRunTime().AsTime()); err != nil {
+			return nil, err
+		}
+	}
+
 	var taskRunCreates []*store.TaskRunMessage
 	for _, task := range stageToRunTasks {
 		if !taskIDsToRunMap[task.ID] {
@@ -657,7 +663,7 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, req *connect.Request
 		},
 	})
 	// Tickle task run scheduler.
-	s.stateCfg.TaskRunTickleChan <- 0
+	s.stateCfg.TickleTaskRunScheduler(ctx)
 
 	return connect.NewResponse(&v1pb.BatchRunTasksResponse{}), nil
 }
@@ -860,13 +866,14 @@ func (s *RolloutService) BatchCancelTaskRuns(ctx context.Context, req *connect.R
 		}
 	}
 
+	var runningTaskRunIDs []int
 	for _, taskRun := range taskRuns {
 		if taskRun.Status == storepb.TaskRun_RUNNING {
-			if cancelFunc, ok := s.stateCfg.RunningTaskRunsCancelFunc.Load(taskRun.ID); ok {
-				cancelFunc.(context.CancelFunc)()
-			}
+			runningTaskRunIDs = append(runningTaskRunIDs, taskRun.ID)
 		}
 	}
+	// The task runs may be executed by the other replicas in the HA deployment.
+	s.stateCfg.CancelTaskRuns(ctx, runningTaskRunIDs)
 
 	if err := s.store.BatchCancelTaskRuns(ctx, taskRunIDs); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to batch patch task run status to canceled, error: %v", err))
@@ -1107,6 +1114,36 @@ func (s *RolloutService) canUserRunEnvironmentTasks(ctx context.Context, user *s
 	return false, nil
 }
 
+// checkMaintenanceWindow checks that the run time is within the maintenance window of the environment.
+// Users with bb.taskRuns.override can run tasks outside the window.
+func (s *RolloutService) checkMaintenanceWindow(ctx context.Context, user *store.UserMessage, project *store.ProjectMessage, environment string, runTime time.Time) error {
+	if environment == "" {
+		return nil
+	}
+	policy, err := s.store.GetMaintenanceWindowPolicy(ctx, environment)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to get maintenance window policy, error: %v", err))
+	}
+	if policy == nil {
+		return nil
+	}
+	window, err := common.NewMaintenanceWindow(policy)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("invalid maintenance window policy for environment %s, error: %v", environment, err))
+	}
+	if window.Contains(runTime) {
+		return nil
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionTaskRunsOverride, user, project.ResourceID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission, error: %v", err))
+	}
+	if ok {
+		return nil
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("run time %s is outside the maintenance window of environment %s, the next window starts at %s", runTime.Format(time.RFC3339), environment, window.Next(runTime).Format(time.RFC3339)))
+}
+
 func (s *RolloutService) canUserCancelEnvironmentTaskRun(ctx context.Context, user *store.UserMessage, project *store.ProjectMessage, issue *store.IssueMessage, environment string, creatorUID int) (bool, error) {
 	return s.canUserRunEnvironmentTasks(ctx, user, project, issue, environment, creatorUID)
 }
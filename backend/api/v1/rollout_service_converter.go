@@ -174,6 +174,14 @@ func convertToSchedulerInfoWaitingCause(ctx context.Context, s *store.Store, c *
 				ParallelTasksLimit: cause.ParallelTasksLimit,
 			},
 		}, nil
+	case *storepb.SchedulerInfo_WaitingCause_MaintenanceWindow_:
+		return &v1pb.TaskRun_SchedulerInfo_WaitingCause{
+			Cause: &v1pb.TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_{
+				MaintenanceWindow: &v1pb.TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow{
+					NextWindowTime: cause.MaintenanceWindow.GetNextWindowTime(),
+				},
+			},
+		}, nil
 	default:
 		return nil, nil
 	}
@@ -321,7 +329,7 @@ func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMe
 	case storepb.Task_DATABASE_MIGRATE:
 		// Handle DATABASE_MIGRATE based on migrate_type
 		switch task.Payload.GetMigrateType() {
-		case storepb.MigrationType_DDL, storepb.MigrationType_GHOST, storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
+		case storepb.MigrationType_DDL, storepb.MigrationType_GHOST, storepb.MigrationType_PG_ONLINE, storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
 			return convertToTaskFromSchemaUpdate(ctx, s, project, task)
 		case storepb.MigrationType_DML:
 			return convertToTaskFromDataUpdate(ctx, s, project, task)
@@ -398,6 +406,8 @@ func convertToTaskFromSchemaUpdate(ctx context.Context, s *store.Store, project
 			migrationType = v1pb.MigrationType_DDL
 		case storepb.MigrationType_GHOST:
 			migrationType = v1pb.MigrationType_GHOST
+		case storepb.MigrationType_PG_ONLINE:
+			migrationType = v1pb.MigrationType_PG_ONLINE
 		default:
 			migrationType = v1pb.MigrationType_DDL
 		}
@@ -560,6 +570,8 @@ func getMigrateTypeFromMigrationType(migrationType v1pb.MigrationType) storepb.M
 		return storepb.MigrationType_DDL
 	case v1pb.MigrationType_GHOST:
 		return storepb.MigrationType_GHOST
+	case v1pb.MigrationType_PG_ONLINE:
+		return storepb.MigrationType_PG_ONLINE
 	case v1pb.MigrationType_DML:
 		return storepb.MigrationType_DML
 	default:
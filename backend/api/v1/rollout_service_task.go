@@ -315,6 +315,8 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(
 				return nil, errors.Wrapf(err, "invalid ghost flags %q", c.GhostFlags)
 			}
 			flags = c.GhostFlags
+		case storepb.MigrationType_PG_ONLINE:
+			taskMigrateType = storepb.MigrationType_PG_ONLINE
 		default:
 			taskMigrateType = storepb.MigrationType_DDL
 		}
@@ -3,7 +3,11 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"net"
+	"net/mail"
+	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -14,11 +18,13 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/masker"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/plugin/email"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/dingtalk"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
@@ -68,6 +74,8 @@ var whitelistSettings = []storepb.SettingName{
 	storepb.SettingName_SCIM,
 	storepb.SettingName_PASSWORD_RESTRICTION,
 	storepb.SettingName_ENVIRONMENT,
+	storepb.SettingName_EMAIL,
+	storepb.SettingName_AUDIT_LOG_SINK,
 }
 
 // ListSettings lists all settings.
@@ -165,6 +173,8 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 	}
 
 	var storeSettingValue string
+	// removedAuditLogSinks are the audit log sinks removed by the update, whose delivery state is deleted after the update.
+	var removedAuditLogSinks []string
 	switch apiSettingName {
 	case storepb.SettingName_WORKSPACE_PROFILE:
 		if request.Msg.UpdateMask == nil {
@@ -289,6 +299,14 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
 
+		externalApprovalSetting, err := s.store.GetWorkspaceExternalApprovalSetting(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get external approval setting with error: %v", err))
+		}
+		externalApprovalNodes := map[string]bool{}
+		for _, node := range externalApprovalSetting.Nodes {
+			externalApprovalNodes[node.Id] = true
+		}
 		payload := &storepb.WorkspaceApprovalSetting{}
 		for _, rule := range request.Msg.Setting.Value.GetWorkspaceApprovalSettingValue().Rules {
 			// Validate the condition.
@@ -298,6 +316,21 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			if err := validateApprovalTemplate(rule.Template); err != nil {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid approval template: %v, err: %v", rule.Template, err))
 			}
+			approvers := rule.Template.Flow.Roles
+			for _, step := range rule.Template.Flow.Steps {
+				for _, approverSet := range step.ApproverSets {
+					approvers = append(approvers, approverSet.Approvers...)
+				}
+			}
+			for _, approver := range approvers {
+				if !strings.HasPrefix(approver, common.ExternalApprovalNodePrefix) {
+					continue
+				}
+				nodeID, err := common.GetExternalApprovalNodeID(approver)
+				if err != nil || !externalApprovalNodes[nodeID] {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("external approval node %q not found", approver))
+				}
+			}
 
 			flow := convertApprovalFlow(rule.Template.Flow)
 			payload.Rules = append(payload.Rules, &storepb.WorkspaceApprovalSetting_Rule{
@@ -315,6 +348,74 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to marshal setting for %s with error: %v", apiSettingName, err))
 		}
 		storeSettingValue = string(bytes)
+	case storepb.SettingName_WORKSPACE_EXTERNAL_APPROVAL:
+		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+
+		payload := convertWorkspaceExternalApprovalSetting(request.Msg.Setting.Value.GetWorkspaceExternalApprovalSettingValue())
+		if err := validateExternalApprovalNodes(payload.Nodes); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		// The token is input only, keep the existing token if it's not provided.
+		oldPayload, err := s.store.GetWorkspaceExternalApprovalSetting(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get existed external approval setting with error: %v", err))
+		}
+		oldTokens := map[string]string{}
+		for _, node := range oldPayload.Nodes {
+			oldTokens[node.Id] = node.Token
+		}
+		for _, node := range payload.Nodes {
+			if node.Token == "" {
+				node.Token = oldTokens[node.Id]
+			}
+			if node.Token == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("token is required for external approval node %q", node.Id))
+			}
+		}
+		bytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to marshal setting for %s with error: %v", apiSettingName, err))
+		}
+		storeSettingValue = string(bytes)
+	case storepb.SettingName_AUDIT_LOG_SINK:
+		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_AUDIT_LOG); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+
+		payload := convertAuditLogSinkSetting(request.Msg.Setting.Value.GetAuditLogSinkSetting())
+		oldPayload, err := s.store.GetAuditLogSinkSetting(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get existed audit log sink setting with error: %v", err))
+		}
+		oldSinks := map[string]*storepb.AuditLogSinkSetting_Sink{}
+		for _, sink := range oldPayload.Sinks {
+			oldSinks[sink.Id] = sink
+		}
+		for _, sink := range payload.Sinks {
+			oldSink, ok := oldSinks[sink.Id]
+			if !ok {
+				continue
+			}
+			delete(oldSinks, sink.Id)
+			// The token is input only, keep the existing token if it's not provided.
+			// The token is not kept if the sink is pointed to another endpoint, otherwise it would be sent there.
+			if sink.Token == "" && sink.Type == oldSink.Type && sink.Endpoint == oldSink.Endpoint {
+				sink.Token = oldSink.Token
+			}
+		}
+		for id := range oldSinks {
+			removedAuditLogSinks = append(removedAuditLogSinks, id)
+		}
+		if err := s.validateAuditLogSinks(ctx, payload.Sinks); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		bytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to marshal setting for %s with error: %v", apiSettingName, err))
+		}
+		storeSettingValue = string(bytes)
 	case storepb.SettingName_BRANDING_LOGO:
 		if err := s.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_CUSTOM_LOGO); err != nil {
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
@@ -448,11 +549,23 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			if idMap[tp.Id] {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("duplicate semantic type id: %s", tp.Id))
 			}
-			m, ok := tp.GetAlgorithm().GetMask().(*storepb.Algorithm_InnerOuterMask_)
-			if ok && m.InnerOuterMask != nil {
-				if m.InnerOuterMask.Type == storepb.Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED {
+			switch m := tp.GetAlgorithm().GetMask().(type) {
+			case *storepb.Algorithm_InnerOuterMask_:
+				if m.InnerOuterMask != nil && m.InnerOuterMask.Type == storepb.Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED {
 					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("inner outer mask type has to be specified"))
 				}
+			case *storepb.Algorithm_DateTruncateMask_:
+				if m.DateTruncateMask.GetGranularity() == storepb.Algorithm_DateTruncateMask_GRANULARITY_UNSPECIFIED {
+					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("date truncate granularity has to be specified"))
+				}
+			case *storepb.Algorithm_NumericBucketMask_:
+				if _, err := masker.NewNumericBucketMasker(m.NumericBucketMask.GetBucketSize()); err != nil {
+					return nil, connect.NewError(connect.CodeInvalidArgument, err)
+				}
+			case *storepb.Algorithm_NumericNoiseMask_:
+				if _, err := masker.NewNumericNoiseMasker(m.NumericNoiseMask.GetMaxNoise(), nil); err != nil {
+					return nil, connect.NewError(connect.CodeInvalidArgument, err)
+				}
 			}
 			idMap[tp.Id] = true
 		}
@@ -516,6 +629,45 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to marshal setting for %s with error: %v", apiSettingName, err))
 		}
 		storeSettingValue = string(bytes)
+	case storepb.SettingName_EMAIL:
+		emailSetting := convertEmailSetting(request.Msg.Setting.Value.GetEmailSetting())
+		if emailSetting == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("value cannot be nil when setting email setting"))
+		}
+		if emailSetting.Enabled || request.Msg.ValidateOnly {
+			if emailSetting.Host == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("SMTP host is required"))
+			}
+			if _, err := mail.ParseAddress(emailSetting.From); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid sender address %q", emailSetting.From))
+			}
+		}
+		if emailSetting.Password == "" && emailSetting.Username != "" {
+			existedEmailSetting, err := s.store.GetEmailSetting(ctx)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get existed email setting with error: %v", err))
+			}
+			emailSetting.Password = existedEmailSetting.Password
+		}
+		if request.Msg.ValidateOnly {
+			if err := email.Validate(ctx, emailSetting, user.Email); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("validation failed, error: %v", err))
+			}
+			return connect.NewResponse(&v1pb.Setting{
+				Name: request.Msg.Setting.Name,
+				Value: &v1pb.Value{
+					Value: &v1pb.Value_EmailSetting{
+						EmailSetting: &v1pb.EmailSetting{},
+					},
+				},
+			}), nil
+		}
+
+		bytes, err := protojson.Marshal(emailSetting)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to marshal setting for %s with error: %v", apiSettingName, err))
+		}
+		storeSettingValue = string(bytes)
 	case storepb.SettingName_ENVIRONMENT:
 		if serr := s.validateEnvironments(request.Msg.Setting.Value.GetEnvironmentSetting().GetEnvironments()); serr != nil {
 			return nil, serr
@@ -564,6 +716,11 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to set setting: %v", err))
 	}
+	for _, sink := range removedAuditLogSinks {
+		if err := s.store.DeleteAuditLogSinkState(ctx, sink); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to delete the state of removed audit log sink %q with error: %v", sink, err))
+		}
+	}
 
 	settingMessage, err := convertToSettingMessage(setting, s.profile)
 	if err != nil {
@@ -724,12 +881,111 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 	if template.Flow == nil {
 		return errors.Errorf("approval template cannot be nil")
 	}
+	if len(template.Flow.Steps) > 0 {
+		return validateApprovalSteps(template.Flow.Steps)
+	}
 	if len(template.Flow.Roles) == 0 {
 		return errors.Errorf("approval template cannot have 0 role")
 	}
 	return nil
 }
 
+func validateApprovalSteps(steps []*v1pb.ApprovalStep) error {
+	for i, step := range steps {
+		if step.Type != v1pb.ApprovalStep_TYPE_UNSPECIFIED && step.Type != v1pb.ApprovalStep_SEQUENTIAL && step.Type != v1pb.ApprovalStep_PARALLEL {
+			return errors.Errorf("invalid type %v of approval step %d", step.Type, i)
+		}
+		if len(step.ApproverSets) == 0 {
+			return errors.Errorf("approval step %d cannot have 0 approver set", i)
+		}
+		externalApprovalNodes := map[string]bool{}
+		for j, approverSet := range step.ApproverSets {
+			if len(approverSet.Approvers) == 0 {
+				return errors.Errorf("approver set %d of approval step %d cannot have 0 approver", j, i)
+			}
+			if approverSet.RequiredCount < 0 {
+				return errors.Errorf("invalid required count %d of approver set %d of approval step %d", approverSet.RequiredCount, j, i)
+			}
+			for _, approver := range approverSet.Approvers {
+				switch {
+				case strings.HasPrefix(approver, common.RolePrefix):
+				case strings.HasPrefix(approver, common.GroupPrefix):
+				case strings.HasPrefix(approver, common.UserNamePrefix):
+					if _, err := common.GetUserEmail(approver); err != nil {
+						return errors.Errorf("invalid approver %q, user should be in the users/{email} format", approver)
+					}
+				case strings.HasPrefix(approver, common.ExternalApprovalNodePrefix):
+					// The external approval node decides the approver set on its own.
+					if len(approverSet.Approvers) != 1 || approverSet.RequiredCount > 1 {
+						return errors.Errorf("external approval node %q must be the only approver of the approver set requiring one approval", approver)
+					}
+					if externalApprovalNodes[approver] {
+						return errors.Errorf("external approval node %q is used more than once in approval step %d", approver, i)
+					}
+					externalApprovalNodes[approver] = true
+				default:
+					return errors.Errorf("invalid approver %q", approver)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateExternalApprovalNodes(nodes []*storepb.WorkspaceExternalApprovalSetting_Node) error {
+	ids := map[string]bool{}
+	for _, node := range nodes {
+		if !isValidResourceID(node.Id) {
+			return errors.Errorf("invalid external approval node id %q", node.Id)
+		}
+		if ids[node.Id] {
+			return errors.Errorf("duplicate external approval node id %q", node.Id)
+		}
+		ids[node.Id] = true
+		u, err := url.Parse(node.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid endpoint %q of external approval node %q", node.Endpoint, node.Id)
+		}
+		if node.Timeout != nil && node.Timeout.AsDuration() < 0 {
+			return errors.Errorf("invalid timeout of external approval node %q", node.Id)
+		}
+	}
+	return nil
+}
+
+func (s *SettingService) validateAuditLogSinks(ctx context.Context, sinks []*storepb.AuditLogSinkSetting_Sink) error {
+	ids := map[string]bool{}
+	for _, sink := range sinks {
+		if !isValidResourceID(sink.Id) {
+			return errors.Errorf("invalid audit log sink id %q", sink.Id)
+		}
+		if ids[sink.Id] {
+			return errors.Errorf("duplicate audit log sink id %q", sink.Id)
+		}
+		ids[sink.Id] = true
+		switch sink.Type {
+		case storepb.AuditLogSinkSetting_Sink_SYSLOG:
+			if _, _, err := net.SplitHostPort(sink.Endpoint); err != nil {
+				return errors.Errorf("invalid endpoint %q of audit log sink %q, expect host:port", sink.Endpoint, sink.Id)
+			}
+		case storepb.AuditLogSinkSetting_Sink_HTTP, storepb.AuditLogSinkSetting_Sink_OTLP:
+			u, err := url.Parse(sink.Endpoint)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return errors.Errorf("invalid endpoint %q of audit log sink %q", sink.Endpoint, sink.Id)
+			}
+			if sink.Type == storepb.AuditLogSinkSetting_Sink_HTTP && sink.Format == storepb.AuditLogSinkSetting_Sink_SPLUNK_HEC && sink.Token == "" {
+				return errors.Errorf("token is required for Splunk HTTP Event Collector sink %q", sink.Id)
+			}
+		default:
+			return errors.Errorf("invalid type of audit log sink %q", sink.Id)
+		}
+		if _, err := store.GetSearchAuditLogsFilter(ctx, s.store, sink.Filter); err != nil {
+			return errors.Wrapf(err, "invalid filter of audit log sink %q", sink.Id)
+		}
+	}
+	return nil
+}
+
 func validateDomains(domains []string) error {
 	for _, domain := range domains {
 		if !domainRegexp.MatchString(domain) {
@@ -66,6 +66,40 @@ func convertToSettingMessage(setting *store.SettingMessage, profile *config.Prof
 				},
 			},
 		}, nil
+	case storepb.SettingName_WORKSPACE_EXTERNAL_APPROVAL:
+		storeValue := new(storepb.WorkspaceExternalApprovalSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to unmarshal setting value for %s with error: %v", setting.Name, err))
+		}
+		// DO NOT expose the tokens.
+		for _, node := range storeValue.Nodes {
+			node.Token = ""
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_WorkspaceExternalApprovalSettingValue{
+					WorkspaceExternalApprovalSettingValue: convertToWorkspaceExternalApprovalSetting(storeValue),
+				},
+			},
+		}, nil
+	case storepb.SettingName_AUDIT_LOG_SINK:
+		storeValue := new(storepb.AuditLogSinkSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to unmarshal setting value for %s with error: %v", setting.Name, err))
+		}
+		// DO NOT expose the tokens.
+		for _, sink := range storeValue.Sinks {
+			sink.Token = ""
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_AuditLogSinkSetting{
+					AuditLogSinkSetting: convertToAuditLogSinkSetting(storeValue),
+				},
+			},
+		}, nil
 	case storepb.SettingName_SCHEMA_TEMPLATE:
 		storeValue := new(storepb.SchemaTemplateSetting)
 		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
@@ -148,6 +182,21 @@ func convertToSettingMessage(setting *store.SettingMessage, profile *config.Prof
 				},
 			},
 		}, nil
+	case storepb.SettingName_EMAIL:
+		storeValue := new(storepb.EmailSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to unmarshal setting value for %s with error: %v", setting.Name, err))
+		}
+		// DO NOT expose the password.
+		storeValue.Password = ""
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_EmailSetting{
+					EmailSetting: convertToEmailSetting(storeValue),
+				},
+			},
+		}, nil
 	case storepb.SettingName_ENVIRONMENT:
 		storeValue, err := convertToEnvironmentSetting(setting.Value)
 		if err != nil {
@@ -225,6 +274,10 @@ func convertStoreSettingNameToV1(storeName storepb.SettingName) v1pb.Setting_Set
 		return v1pb.Setting_PASSWORD_RESTRICTION
 	case storepb.SettingName_ENVIRONMENT:
 		return v1pb.Setting_ENVIRONMENT
+	case storepb.SettingName_EMAIL:
+		return v1pb.Setting_EMAIL
+	case storepb.SettingName_AUDIT_LOG_SINK:
+		return v1pb.Setting_AUDIT_LOG_SINK
 	default:
 	}
 	return v1pb.Setting_SETTING_NAME_UNSPECIFIED
@@ -268,6 +321,10 @@ func convertV1SettingNameToStore(v1Name v1pb.Setting_SettingName) storepb.Settin
 		return storepb.SettingName_PASSWORD_RESTRICTION
 	case v1pb.Setting_ENVIRONMENT:
 		return storepb.SettingName_ENVIRONMENT
+	case v1pb.Setting_EMAIL:
+		return storepb.SettingName_EMAIL
+	case v1pb.Setting_AUDIT_LOG_SINK:
+		return storepb.SettingName_AUDIT_LOG_SINK
 	default:
 		return storepb.SettingName_SETTING_NAME_UNSPECIFIED
 	}
@@ -496,9 +553,22 @@ func convertApprovalFlow(v1Flow *v1pb.ApprovalFlow) *storepb.ApprovalFlow {
 		return nil
 	}
 
-	return &storepb.ApprovalFlow{
+	flow := &storepb.ApprovalFlow{
 		Roles: v1Flow.Roles,
 	}
+	for _, v1Step := range v1Flow.Steps {
+		step := &storepb.ApprovalStep{
+			Type: storepb.ApprovalStep_Type(v1Step.Type),
+		}
+		for _, v1ApproverSet := range v1Step.ApproverSets {
+			step.ApproverSets = append(step.ApproverSets, &storepb.ApprovalStep_ApproverSet{
+				Approvers:     v1ApproverSet.Approvers,
+				RequiredCount: v1ApproverSet.RequiredCount,
+			})
+		}
+		flow.Steps = append(flow.Steps, step)
+	}
+	return flow
 }
 
 func convertAppIMSetting(v1Setting *v1pb.AppIMSetting) (*storepb.AppIMSetting, error) {
@@ -521,7 +591,8 @@ func convertAppIMSetting(v1Setting *v1pb.AppIMSetting) (*storepb.AppIMSetting, e
 		case *v1pb.AppIMSetting_IMSetting_Slack:
 			imSetting.Payload = &storepb.AppIMSetting_IMSetting_Slack{
 				Slack: &storepb.AppIMSetting_Slack{
-					Token: payload.Slack.Token,
+					Token:         payload.Slack.Token,
+					SigningSecret: payload.Slack.SigningSecret,
 				},
 			}
 		case *v1pb.AppIMSetting_IMSetting_Feishu:
@@ -542,8 +613,9 @@ func convertAppIMSetting(v1Setting *v1pb.AppIMSetting) (*storepb.AppIMSetting, e
 		case *v1pb.AppIMSetting_IMSetting_Lark:
 			imSetting.Payload = &storepb.AppIMSetting_IMSetting_Lark{
 				Lark: &storepb.AppIMSetting_Lark{
-					AppId:     payload.Lark.AppId,
-					AppSecret: payload.Lark.AppSecret,
+					AppId:             payload.Lark.AppId,
+					AppSecret:         payload.Lark.AppSecret,
+					VerificationToken: payload.Lark.VerificationToken,
 				},
 			}
 		case *v1pb.AppIMSetting_IMSetting_Dingtalk:
@@ -794,6 +866,37 @@ func convertAlgorithm(v1Algo *v1pb.Algorithm) *storepb.Algorithm {
 				Substitution: mask.InnerOuterMask.Substitution,
 			},
 		}
+	case *v1pb.Algorithm_FormatPreservingEncryptionMask_:
+		storeAlgo.Mask = &storepb.Algorithm_FormatPreservingEncryptionMask_{
+			FormatPreservingEncryptionMask: &storepb.Algorithm_FormatPreservingEncryptionMask{
+				Mode:  storepb.Algorithm_FormatPreservingEncryptionMask_Mode(mask.FormatPreservingEncryptionMask.Mode),
+				Tweak: mask.FormatPreservingEncryptionMask.Tweak,
+			},
+		}
+	case *v1pb.Algorithm_TokenizationMask_:
+		storeAlgo.Mask = &storepb.Algorithm_TokenizationMask_{
+			TokenizationMask: &storepb.Algorithm_TokenizationMask{
+				Prefix: mask.TokenizationMask.Prefix,
+			},
+		}
+	case *v1pb.Algorithm_DateTruncateMask_:
+		storeAlgo.Mask = &storepb.Algorithm_DateTruncateMask_{
+			DateTruncateMask: &storepb.Algorithm_DateTruncateMask{
+				Granularity: storepb.Algorithm_DateTruncateMask_Granularity(mask.DateTruncateMask.Granularity),
+			},
+		}
+	case *v1pb.Algorithm_NumericBucketMask_:
+		storeAlgo.Mask = &storepb.Algorithm_NumericBucketMask_{
+			NumericBucketMask: &storepb.Algorithm_NumericBucketMask{
+				BucketSize: mask.NumericBucketMask.BucketSize,
+			},
+		}
+	case *v1pb.Algorithm_NumericNoiseMask_:
+		storeAlgo.Mask = &storepb.Algorithm_NumericNoiseMask_{
+			NumericNoiseMask: &storepb.Algorithm_NumericNoiseMask{
+				MaxNoise: mask.NumericNoiseMask.MaxNoise,
+			},
+		}
 	}
 	return storeAlgo
 }
@@ -832,6 +935,37 @@ func convertToAlgorithm(storeAlgo *storepb.Algorithm) *v1pb.Algorithm {
 				Substitution: mask.InnerOuterMask.Substitution,
 			},
 		}
+	case *storepb.Algorithm_FormatPreservingEncryptionMask_:
+		v1Algo.Mask = &v1pb.Algorithm_FormatPreservingEncryptionMask_{
+			FormatPreservingEncryptionMask: &v1pb.Algorithm_FormatPreservingEncryptionMask{
+				Mode:  v1pb.Algorithm_FormatPreservingEncryptionMask_Mode(mask.FormatPreservingEncryptionMask.Mode),
+				Tweak: mask.FormatPreservingEncryptionMask.Tweak,
+			},
+		}
+	case *storepb.Algorithm_TokenizationMask_:
+		v1Algo.Mask = &v1pb.Algorithm_TokenizationMask_{
+			TokenizationMask: &v1pb.Algorithm_TokenizationMask{
+				Prefix: mask.TokenizationMask.Prefix,
+			},
+		}
+	case *storepb.Algorithm_DateTruncateMask_:
+		v1Algo.Mask = &v1pb.Algorithm_DateTruncateMask_{
+			DateTruncateMask: &v1pb.Algorithm_DateTruncateMask{
+				Granularity: v1pb.Algorithm_DateTruncateMask_Granularity(mask.DateTruncateMask.Granularity),
+			},
+		}
+	case *storepb.Algorithm_NumericBucketMask_:
+		v1Algo.Mask = &v1pb.Algorithm_NumericBucketMask_{
+			NumericBucketMask: &v1pb.Algorithm_NumericBucketMask{
+				BucketSize: mask.NumericBucketMask.BucketSize,
+			},
+		}
+	case *storepb.Algorithm_NumericNoiseMask_:
+		v1Algo.Mask = &v1pb.Algorithm_NumericNoiseMask_{
+			NumericNoiseMask: &v1pb.Algorithm_NumericNoiseMask{
+				MaxNoise: mask.NumericNoiseMask.MaxNoise,
+			},
+		}
 	}
 	return v1Algo
 }
@@ -924,6 +1058,98 @@ func convertToAISetting(storeSetting *storepb.AISetting) *v1pb.AISetting {
 	}
 }
 
+func convertWorkspaceExternalApprovalSetting(v1Setting *v1pb.WorkspaceExternalApprovalSetting) *storepb.WorkspaceExternalApprovalSetting {
+	storeSetting := &storepb.WorkspaceExternalApprovalSetting{}
+	for _, node := range v1Setting.GetNodes() {
+		storeSetting.Nodes = append(storeSetting.Nodes, &storepb.WorkspaceExternalApprovalSetting_Node{
+			Id:       node.Id,
+			Title:    node.Title,
+			Endpoint: node.Endpoint,
+			Token:    node.Token,
+			Timeout:  node.Timeout,
+		})
+	}
+	return storeSetting
+}
+
+func convertToWorkspaceExternalApprovalSetting(storeSetting *storepb.WorkspaceExternalApprovalSetting) *v1pb.WorkspaceExternalApprovalSetting {
+	v1Setting := &v1pb.WorkspaceExternalApprovalSetting{}
+	for _, node := range storeSetting.GetNodes() {
+		v1Setting.Nodes = append(v1Setting.Nodes, &v1pb.WorkspaceExternalApprovalSetting_Node{
+			Id:       node.Id,
+			Title:    node.Title,
+			Endpoint: node.Endpoint,
+			Token:    node.Token,
+			Timeout:  node.Timeout,
+		})
+	}
+	return v1Setting
+}
+
+func convertAuditLogSinkSetting(v1Setting *v1pb.AuditLogSinkSetting) *storepb.AuditLogSinkSetting {
+	storeSetting := &storepb.AuditLogSinkSetting{}
+	for _, sink := range v1Setting.GetSinks() {
+		storeSetting.Sinks = append(storeSetting.Sinks, &storepb.AuditLogSinkSetting_Sink{
+			Id:       sink.Id,
+			Type:     storepb.AuditLogSinkSetting_Sink_Type(sink.Type),
+			Endpoint: sink.Endpoint,
+			Tls:      sink.Tls,
+			Token:    sink.Token,
+			Format:   storepb.AuditLogSinkSetting_Sink_Format(sink.Format),
+			Filter:   sink.Filter,
+		})
+	}
+	return storeSetting
+}
+
+func convertToAuditLogSinkSetting(storeSetting *storepb.AuditLogSinkSetting) *v1pb.AuditLogSinkSetting {
+	v1Setting := &v1pb.AuditLogSinkSetting{}
+	for _, sink := range storeSetting.GetSinks() {
+		v1Setting.Sinks = append(v1Setting.Sinks, &v1pb.AuditLogSinkSetting_Sink{
+			Id:       sink.Id,
+			Type:     v1pb.AuditLogSinkSetting_Sink_Type(sink.Type),
+			Endpoint: sink.Endpoint,
+			Tls:      sink.Tls,
+			Token:    sink.Token,
+			Format:   v1pb.AuditLogSinkSetting_Sink_Format(sink.Format),
+			Filter:   sink.Filter,
+		})
+	}
+	return v1Setting
+}
+
+func convertEmailSetting(v1Setting *v1pb.EmailSetting) *storepb.EmailSetting {
+	if v1Setting == nil {
+		return nil
+	}
+
+	return &storepb.EmailSetting{
+		Enabled:    v1Setting.Enabled,
+		Host:       v1Setting.Host,
+		Port:       v1Setting.Port,
+		Username:   v1Setting.Username,
+		Password:   v1Setting.Password,
+		Encryption: storepb.EmailSetting_Encryption(v1Setting.Encryption),
+		From:       v1Setting.From,
+	}
+}
+
+func convertToEmailSetting(storeSetting *storepb.EmailSetting) *v1pb.EmailSetting {
+	if storeSetting == nil {
+		return nil
+	}
+
+	return &v1pb.EmailSetting{
+		Enabled:    storeSetting.Enabled,
+		Host:       storeSetting.Host,
+		Port:       storeSetting.Port,
+		Username:   storeSetting.Username,
+		Password:   storeSetting.Password,
+		Encryption: v1pb.EmailSetting_Encryption(storeSetting.Encryption),
+		From:       storeSetting.From,
+	}
+}
+
 func convertToSCIMSetting(storeSetting *storepb.SCIMSetting) *v1pb.SCIMSetting {
 	if storeSetting == nil {
 		return nil
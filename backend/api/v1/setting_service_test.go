@@ -2,8 +2,13 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 )
 
 func TestValidateDomains(t *testing.T) {
@@ -52,3 +57,110 @@ func TestValidateDomains(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateExternalApprovalNodes(t *testing.T) {
+	a := require.New(t)
+
+	testCases := []struct {
+		nodes   []*storepb.WorkspaceExternalApprovalSetting_Node
+		wantErr bool
+	}{
+		{
+			nodes: []*storepb.WorkspaceExternalApprovalSetting_Node{
+				{Id: "change-board", Endpoint: "https://approval.example.com/requests", Timeout: durationpb.New(time.Hour)},
+				{Id: "security", Endpoint: "http://localhost:8080"},
+			},
+			wantErr: false,
+		},
+		{
+			nodes: []*storepb.WorkspaceExternalApprovalSetting_Node{
+				{Id: "Change Board", Endpoint: "https://approval.example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			nodes: []*storepb.WorkspaceExternalApprovalSetting_Node{
+				{Id: "change-board", Endpoint: "https://approval.example.com"},
+				{Id: "change-board", Endpoint: "https://approval2.example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			nodes: []*storepb.WorkspaceExternalApprovalSetting_Node{
+				{Id: "change-board", Endpoint: "ftp://approval.example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			nodes: []*storepb.WorkspaceExternalApprovalSetting_Node{
+				{Id: "change-board", Endpoint: "https://approval.example.com", Timeout: durationpb.New(-time.Hour)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateExternalApprovalNodes(tc.nodes)
+		if tc.wantErr {
+			a.Error(err)
+		} else {
+			a.NoError(err)
+		}
+	}
+}
+
+func TestValidateApprovalSteps(t *testing.T) {
+	a := require.New(t)
+
+	newSteps := func(approverSets ...*v1pb.ApprovalStep_ApproverSet) []*v1pb.ApprovalStep {
+		return []*v1pb.ApprovalStep{{Type: v1pb.ApprovalStep_PARALLEL, ApproverSets: approverSets}}
+	}
+	testCases := []struct {
+		steps   []*v1pb.ApprovalStep
+		wantErr bool
+	}{
+		{
+			steps: newSteps(
+				&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"roles/projectOwner", "groups/dba@example.com", "users/alice@example.com"}, RequiredCount: 2},
+				&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"externalApprovalNodes/change-board"}},
+			),
+			wantErr: false,
+		},
+		{
+			steps:   []*v1pb.ApprovalStep{{Type: v1pb.ApprovalStep_SEQUENTIAL}},
+			wantErr: true,
+		},
+		{
+			steps:   newSteps(&v1pb.ApprovalStep_ApproverSet{}),
+			wantErr: true,
+		},
+		{
+			steps:   newSteps(&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"roles/projectOwner"}, RequiredCount: -1}),
+			wantErr: true,
+		},
+		{
+			steps:   newSteps(&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"alice@example.com"}}),
+			wantErr: true,
+		},
+		{
+			steps:   newSteps(&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"roles/projectOwner", "externalApprovalNodes/change-board"}}),
+			wantErr: true,
+		},
+		{
+			steps: newSteps(
+				&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"externalApprovalNodes/change-board"}},
+				&v1pb.ApprovalStep_ApproverSet{Approvers: []string{"externalApprovalNodes/change-board"}},
+			),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateApprovalSteps(tc.steps)
+		if tc.wantErr {
+			a.Error(err)
+		} else {
+			a.NoError(err)
+		}
+	}
+}
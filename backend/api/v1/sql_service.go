@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"database/sql"
@@ -149,7 +150,7 @@ func (s *SQLService) AdminExecute(ctx context.Context, stream *connect.BidiStrea
 			queryContext,
 		)
 
-		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.ID, duration, queryErr)
+		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.ID, duration, nil /* rowFilters */, queryErr)
 		response := &v1pb.AdminExecuteResponse{}
 		if queryErr != nil {
 			response.Results = []*v1pb.QueryResult{
@@ -235,6 +236,10 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 	if queryRestriction.MaxQueryTimeoutInSeconds > 0 {
 		queryContext.Timeout = &durationpb.Duration{Seconds: queryRestriction.MaxQueryTimeoutInSeconds}
 	}
+	rowFilter, err := newQueryRowFilter(ctx, s.store, s.licenseService, instance, database, user, queryContext.Schema)
+	if err != nil {
+		return nil, err
+	}
 
 	results, _, duration, queryErr := queryRetryStopOnError(
 		ctx,
@@ -248,6 +253,7 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 		queryContext,
 		s.licenseService,
 		s.accessCheck,
+		rowFilter,
 		s.schemaSyncer,
 		storepb.MaskingExceptionPolicy_MaskingException_QUERY,
 	)
@@ -259,7 +265,7 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 	)
 
 	// Update activity.
-	s.createQueryHistory(database, store.QueryHistoryTypeQuery, statement, user.ID, duration, queryErr)
+	s.createQueryHistory(database, store.QueryHistoryTypeQuery, statement, user.ID, duration, rowFilter.getAppliedRowFilters(), queryErr)
 
 	if queryErr != nil {
 		if len(results) == 0 {
@@ -359,8 +365,8 @@ func extractSourceTable(comment string) (string, string, string, error) {
 
 func getSchemaMetadata(engine storepb.Engine, dbMetadata *model.DatabaseMetadata) *model.SchemaMetadata {
 	switch engine {
-	case storepb.Engine_POSTGRES:
-		return dbMetadata.GetSchemaMetadata(common.BackupDatabaseNameOfEngine(storepb.Engine_POSTGRES))
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE:
+		return dbMetadata.GetSchemaMetadata(common.BackupDatabaseNameOfEngine(engine))
 	case storepb.Engine_MSSQL:
 		return dbMetadata.GetSchemaMetadata("dbo")
 	default:
@@ -370,9 +376,9 @@ func getSchemaMetadata(engine storepb.Engine, dbMetadata *model.DatabaseMetadata
 
 func replaceBackupTableWithSource(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*parserbase.QuerySpan) error {
 	switch instance.Metadata.GetEngine() {
-	case storepb.Engine_POSTGRES:
-		// Don't need to check the database name for postgres here.
-		// We backup the table to the same database with bbdataarchive schema for Postgres.
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE:
+		// Don't need to check the database name for postgres, redshift and snowflake here.
+		// We backup the table to the same database with bbdataarchive schema for them.
 	case storepb.Engine_ORACLE:
 		if database.DatabaseName != common.BackupDatabaseNameOfEngine(storepb.Engine_ORACLE) {
 			return nil
@@ -429,7 +435,7 @@ func generateNewSourceColumnSet(engine storepb.Engine, origin parserbase.SourceC
 
 func generateNewColumn(engine storepb.Engine, column parserbase.ColumnResource, database, schema, table string) parserbase.ColumnResource {
 	switch engine {
-	case storepb.Engine_POSTGRES:
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE:
 		return parserbase.ColumnResource{
 			Server:   column.Server,
 			Database: column.Database,
@@ -450,8 +456,8 @@ func generateNewColumn(engine storepb.Engine, column parserbase.ColumnResource,
 
 func isBackupTable(engine storepb.Engine, column parserbase.ColumnResource) bool {
 	switch engine {
-	case storepb.Engine_POSTGRES:
-		return column.Schema == common.BackupDatabaseNameOfEngine(storepb.Engine_POSTGRES)
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE:
+		return column.Schema == common.BackupDatabaseNameOfEngine(engine)
 	case storepb.Engine_ORACLE:
 		return column.Database == common.BackupDatabaseNameOfEngine(storepb.Engine_ORACLE)
 	default:
@@ -471,6 +477,7 @@ func queryRetry(
 	queryContext db.QueryContext,
 	licenseService *enterprise.LicenseService,
 	optionalAccessCheck accessCheckFunc,
+	rowFilter *queryRowFilter,
 	schemaSyncer *schemasync.Syncer,
 	action storepb.MaskingExceptionPolicy_MaskingException_Action,
 ) ([]*v1pb.QueryResult, []*parserbase.QuerySpan, time.Duration, error) {
@@ -478,28 +485,10 @@ func queryRetry(
 	var sensitivePredicateColumns [][]parserbase.ColumnResource
 	var err error
 	if !queryContext.Explain {
-		spans, err = parserbase.GetQuerySpan(
-			ctx,
-			parserbase.GetQuerySpanContext{
-				InstanceID:                    instance.ResourceID,
-				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
-				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
-				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Metadata.GetEngine()),
-			},
-			instance.Metadata.GetEngine(),
-			statement,
-			database.DatabaseName,
-			queryContext.Schema,
-			!store.IsObjectCaseSensitive(instance),
-		)
+		spans, err = getQuerySpans(ctx, stores, instance, database, statement, queryContext.Schema)
 		if err != nil {
 			return nil, nil, time.Duration(0), err
 		}
-		// After replacing backup table with source, we can apply the original access check and mask sensitive data for backup table.
-		// If err != nil, this function will return the original spans.
-		if err := replaceBackupTableWithSource(ctx, stores, instance, database, spans); err != nil {
-			slog.Debug("failed to replace backup table with source", log.BBError(err))
-		}
 		if optionalAccessCheck != nil {
 			// Check query access
 			if err := optionalAccessCheck(ctx, instance, database, user, spans, queryContext.Explain); err != nil {
@@ -517,17 +506,31 @@ func queryRetry(
 		}
 	}
 
-	slog.Debug("start execute with timeout", slog.String("instance", instance.ResourceID), slog.String("database", database.DatabaseName), slog.String("statement", statement))
+	// The query span is extracted from the original statement, and the statement rewritten with the row filters is executed.
+	executeStatement := statement
+	if !queryContext.Explain {
+		executeStatement, err = rowFilter.rewrite(ctx, statement)
+		if err != nil {
+			return nil, nil, time.Duration(0), err
+		}
+	}
+
+	slog.Debug("start execute with timeout", slog.String("instance", instance.ResourceID), slog.String("database", database.DatabaseName), slog.String("statement", executeStatement))
 	results, duration, queryErr := executeWithTimeout(
 		ctx,
 		driver,
 		conn,
-		statement,
+		executeStatement,
 		queryContext,
 	)
 	if queryErr != nil {
 		return nil, nil, duration, queryErr
 	}
+	if executeStatement != statement {
+		for _, result := range results {
+			result.Statement = statement
+		}
+	}
 	slog.Debug("execute success", slog.String("instance", instance.ResourceID), slog.String("statement", statement), slog.Duration("duration", duration))
 	if queryContext.Explain {
 		return results, nil, duration, nil
@@ -561,28 +564,10 @@ func queryRetry(
 	// Retry getting query span.
 	if len(syncDatabaseMap) > 0 {
 		slog.Debug("retry query after sync metadata", slog.String("instance", instance.ResourceID), slog.String("database", database.DatabaseName))
-		spans, err = parserbase.GetQuerySpan(
-			ctx,
-			parserbase.GetQuerySpanContext{
-				InstanceID:                    instance.ResourceID,
-				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
-				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
-				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Metadata.GetEngine()),
-			},
-			instance.Metadata.GetEngine(),
-			statement,
-			database.DatabaseName,
-			queryContext.Schema,
-			!store.IsObjectCaseSensitive(instance),
-		)
+		spans, err = getQuerySpans(ctx, stores, instance, database, statement, queryContext.Schema)
 		if err != nil {
 			return nil, nil, time.Duration(0), err
 		}
-		// After replacing backup table with source, we can apply the original access check and mask sensitive data for backup table.
-		// If err != nil, this function will return the original spans.
-		if err := replaceBackupTableWithSource(ctx, stores, instance, database, spans); err != nil {
-			slog.Debug("failed to replace backup table with source", log.BBError(err))
-		}
 		if licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
 			masker := NewQueryResultMasker(stores)
 			sensitivePredicateColumns, err = masker.ExtractSensitivePredicateColumns(ctx, spans, instance, user, action)
@@ -726,6 +711,33 @@ func getSensitivePredicateColumnErrorMessages(sensitiveColumns []parserbase.Colu
 	return buf.String()
 }
 
+// getQuerySpans returns the query spans of the statement.
+// The backup tables in the spans are replaced with their source tables, so that the access check and masking apply to them.
+func getQuerySpans(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string, schema string) ([]*parserbase.QuerySpan, error) {
+	spans, err := parserbase.GetQuerySpan(
+		ctx,
+		parserbase.GetQuerySpanContext{
+			InstanceID:                    instance.ResourceID,
+			GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
+			ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
+			GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Metadata.GetEngine()),
+		},
+		instance.Metadata.GetEngine(),
+		statement,
+		database.DatabaseName,
+		schema,
+		!store.IsObjectCaseSensitive(instance),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// If err != nil, this function will return the original spans.
+	if err := replaceBackupTableWithSource(ctx, stores, instance, database, spans); err != nil {
+		slog.Debug("failed to replace backup table with source", log.BBError(err))
+	}
+	return spans, nil
+}
+
 // queryRetryStopOnError runs the query and stops on encountering errors.
 // The error is both present in the returned QueryResult and error, the caller decides what to do.
 func queryRetryStopOnError(
@@ -740,6 +752,7 @@ func queryRetryStopOnError(
 	queryContext db.QueryContext,
 	licenseService *enterprise.LicenseService,
 	optionalAccessCheck accessCheckFunc,
+	rowFilter *queryRowFilter,
 	schemaSyncer *schemasync.Syncer,
 	action storepb.MaskingExceptionPolicy_MaskingException_Action,
 ) ([]*v1pb.QueryResult, []*parserbase.QuerySpan, time.Duration, error) {
@@ -747,7 +760,7 @@ func queryRetryStopOnError(
 	statements, err := parserbase.SplitMultiSQL(instance.Metadata.GetEngine(), statement)
 	if err != nil {
 		// Fall back to executing as a single statement if splitting fails
-		return queryRetry(ctx, stores, user, instance, database, driver, conn, statement, queryContext, licenseService, optionalAccessCheck, schemaSyncer, action)
+		return queryRetry(ctx, stores, user, instance, database, driver, conn, statement, queryContext, licenseService, optionalAccessCheck, rowFilter, schemaSyncer, action)
 	}
 
 	var allResults []*v1pb.QueryResult
@@ -760,7 +773,7 @@ func queryRetryStopOnError(
 			continue
 		}
 
-		results, spans, duration, err := queryRetry(ctx, stores, user, instance, database, driver, conn, stmt.Text, queryContext, licenseService, optionalAccessCheck, schemaSyncer, action)
+		results, spans, duration, err := queryRetry(ctx, stores, user, instance, database, driver, conn, stmt.Text, queryContext, licenseService, optionalAccessCheck, rowFilter, schemaSyncer, action)
 		totalDuration += duration
 
 		if err != nil {
@@ -818,22 +831,66 @@ func executeWithTimeout(
 	return result, time.Since(start), err
 }
 
+// exportChunkSize is the maximum size of the file content in each response of ExportStream.
+const exportChunkSize = 1024 * 1024
+
 // Export exports the SQL query result.
+// The export file is kept in memory, so the export of a database is limited by the maximum result size.
 func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.ExportRequest]) (*connect.Response[v1pb.ExportResponse], error) {
-	request := req.Msg
+	var content bytes.Buffer
+	if err := s.export(ctx, req.Msg, &content, true /* limitSize */); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.ExportResponse{
+		Content: content.Bytes(),
+	}), nil
+}
+
+// ExportStream exports the SQL query result, and streams the export file in chunks while it's written.
+func (s *SQLService) ExportStream(ctx context.Context, req *connect.Request[v1pb.ExportRequest], stream *connect.ServerStream[v1pb.ExportResponse]) error {
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	if err := s.export(ctx, req.Msg, w, false /* limitSize */); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to send export file"))
+	}
+	return nil
+}
+
+// exportStreamWriter sends the written content to the stream in chunks of at most exportChunkSize.
+type exportStreamWriter struct {
+	stream *connect.ServerStream[v1pb.ExportResponse]
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	for i := 0; i < len(p); i += exportChunkSize {
+		chunk := p[i:min(i+exportChunkSize, len(p))]
+		if err := w.stream.Send(&v1pb.ExportResponse{Content: chunk}); err != nil {
+			return i, err
+		}
+	}
+	return len(p), nil
+}
+
+// export writes the export file of the request to the writer.
+func (s *SQLService) export(ctx context.Context, request *v1pb.ExportRequest, archive io.Writer, limitSize bool) error {
 	// Prehandle export from issue.
 	if strings.HasPrefix(request.Name, common.ProjectNamePrefix) {
-		response, err := s.doExportFromIssue(ctx, request.Name)
+		content, err := s.doExportFromIssue(ctx, request.Name)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		return connect.NewResponse(response), nil
+		if _, err := archive.Write(content); err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to write export file"))
+		}
+		return nil
 	}
 
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Check if data export is allowed.
@@ -845,7 +902,7 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 		database.ProjectID,
 	)
 	if queryDataPolicy.DisableExport {
-		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("data export is not allowed"))
+		return connect.NewError(connect.CodePermissionDenied, errors.Errorf("data export is not allowed"))
 	}
 
 	statement := request.Statement
@@ -858,28 +915,25 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 	// New query ACL experience.
 	if instance.Metadata.GetEngine() != storepb.Engine_MYSQL {
 		if err := validateQueryRequest(instance, statement); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, s.licenseService, database, request.DataSourceId)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	bytes, duration, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource)
+	duration, rowFilters, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource, archive, limitSize)
 
-	s.createQueryHistory(database, store.QueryHistoryTypeExport, statement, user.ID, duration, exportErr)
+	s.createQueryHistory(database, store.QueryHistoryTypeExport, statement, user.ID, duration, rowFilters, exportErr)
 
 	if exportErr != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
+		return connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
 	}
-
-	return connect.NewResponse(&v1pb.ExportResponse{
-		Content: bytes,
-	}), nil
+	return nil
 }
 
-func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string) (*v1pb.ExportResponse, error) {
+func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string) ([]byte, error) {
 	// Try to parse as rollout name first (more specific), then fallback to stage name
 	var rolloutID int
 	var projectID string
@@ -969,13 +1023,15 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to encrypt data: %v", err))
 	}
-
-	return &v1pb.ExportResponse{
-		Content: encryptedBytes,
-	}, nil
+	return encryptedBytes, nil
 }
 
-// DoExport does the export.
+// DoExport does the export and writes the ZIP archive to the writer.
+// For the drivers implementing db.StreamQuerier, the rows of each statement are streamed from the database
+// into the ZIP archive and masked one at a time, so the memory usage does not grow with the result size.
+// The streamed rows are not limited by the maximum result size if limitSize is false, which is used
+// when the archive is streamed to the client rather than kept in memory.
+// The row filters applying to the user are enforced, and the applied ones are returned.
 func DoExport(
 	ctx context.Context,
 	stores *store.Store,
@@ -988,9 +1044,11 @@ func DoExport(
 	optionalAccessCheck accessCheckFunc,
 	schemaSyncer *schemasync.Syncer,
 	dataSource *storepb.DataSource,
-) ([]byte, time.Duration, error) {
+	archive io.Writer,
+	limitSize bool,
+) (time.Duration, []*storepb.QueryHistoryPayload_RowFilter, error) {
 	if dataSource == nil {
-		return nil, 0, connect.NewError(connect.CodeNotFound, errors.Errorf("cannot found valid data source"))
+		return 0, nil, connect.NewError(connect.CodeNotFound, errors.Errorf("cannot found valid data source"))
 	}
 	driver, err := dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
 		DatabaseName: database.DatabaseName,
@@ -998,7 +1056,7 @@ func DoExport(
 		ReadOnly:     true,
 	})
 	if err != nil {
-		return nil, 0, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
+		return 0, nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
 	}
 	defer driver.Close(ctx)
 
@@ -1007,7 +1065,7 @@ func DoExport(
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return 0, nil, err
 		}
 		defer conn.Close()
 	}
@@ -1029,6 +1087,58 @@ func DoExport(
 	if request.Schema != nil {
 		queryContext.Schema = *request.Schema
 	}
+	rowFilter, err := newQueryRowFilter(ctx, stores, licenseService, instance, database, user, queryContext.Schema)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	zipw := zip.NewWriter(archive)
+
+	var exportCount int
+	var duration time.Duration
+	statements, splitErr := parserbase.SplitMultiSQL(instance.Metadata.GetEngine(), request.Statement)
+	if querier, ok := driver.(db.StreamQuerier); ok && conn != nil && splitErr == nil {
+		streamQueryContext := queryContext
+		if !limitSize {
+			streamQueryContext.MaximumSQLResultSize = 0
+		}
+		exportCount, duration, err = streamExportToZip(ctx, zipw, stores, licenseService, querier, conn, statements, streamQueryContext, request, user, instance, database, optionalAccessCheck, rowFilter, schemaSyncer)
+	} else {
+		exportCount, duration, err = exportToZip(ctx, zipw, stores, licenseService, driver, conn, queryContext, request, user, instance, database, optionalAccessCheck, rowFilter, schemaSyncer)
+	}
+	if err != nil {
+		return duration, nil, err
+	}
+
+	if exportCount == 0 {
+		return duration, nil, errors.Errorf("empty export data for database %s", database.DatabaseName)
+	}
+
+	if err := zipw.Close(); err != nil {
+		return duration, nil, errors.Wrap(err, "failed to close zip writer")
+	}
+
+	return duration, rowFilter.getAppliedRowFilters(), nil
+}
+
+// exportToZip runs the statement, masks the materialized query results and writes them to the ZIP archive.
+// It is used for the drivers which cannot stream query results.
+func exportToZip(
+	ctx context.Context,
+	zipw *zip.Writer,
+	stores *store.Store,
+	licenseService *enterprise.LicenseService,
+	driver db.Driver,
+	conn *sql.Conn,
+	queryContext db.QueryContext,
+	request *v1pb.ExportRequest,
+	user *store.UserMessage,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	optionalAccessCheck accessCheckFunc,
+	rowFilter *queryRowFilter,
+	schemaSyncer *schemasync.Syncer,
+) (int, time.Duration, error) {
 	results, spans, duration, queryErr := queryRetry(
 		ctx,
 		stores,
@@ -1041,23 +1151,21 @@ func DoExport(
 		queryContext,
 		licenseService,
 		optionalAccessCheck,
+		rowFilter,
 		schemaSyncer,
 		storepb.MaskingExceptionPolicy_MaskingException_EXPORT,
 	)
 	if queryErr != nil {
-		return nil, duration, queryErr
+		return 0, duration, queryErr
 	}
 
 	if licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
 		masker := NewQueryResultMasker(stores)
 		if err := masker.MaskResults(ctx, spans, results, instance, user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT); err != nil {
-			return nil, duration, err
+			return 0, duration, err
 		}
 	}
 
-	var buf bytes.Buffer
-	zipw := zip.NewWriter(&buf)
-
 	exportCount := 0
 	for i, result := range results {
 		if result.GetError() != "" {
@@ -1065,7 +1173,7 @@ func DoExport(
 			continue
 		}
 
-		if err := exportResultToZip(ctx, zipw, stores, instance, database, result, request, i+1); err != nil {
+		if err := exportRowsToZip(ctx, zipw, stores, instance, database, result.Statement, export.NewResultRows(result), request, i+1); err != nil {
 			logExportError(database, "failed to export result to zip", err)
 			continue
 		}
@@ -1074,16 +1182,215 @@ func DoExport(
 		// Help GC by clearing the result data we've already processed
 		result.Rows = nil
 	}
+	return exportCount, duration, nil
+}
 
-	if exportCount == 0 {
-		return nil, duration, errors.Errorf("empty export data for database %s", database.DatabaseName)
+// streamExportToZip runs the statements one by one and streams their rows into the ZIP archive.
+func streamExportToZip(
+	ctx context.Context,
+	zipw *zip.Writer,
+	stores *store.Store,
+	licenseService *enterprise.LicenseService,
+	querier db.StreamQuerier,
+	conn *sql.Conn,
+	statements []parserbase.SingleSQL,
+	queryContext db.QueryContext,
+	request *v1pb.ExportRequest,
+	user *store.UserMessage,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	optionalAccessCheck accessCheckFunc,
+	rowFilter *queryRowFilter,
+	schemaSyncer *schemasync.Syncer,
+) (int, time.Duration, error) {
+	exportCount := 0
+	statementNumber := 0
+	var totalDuration time.Duration
+	for _, statement := range statements {
+		if statement.Empty {
+			continue
+		}
+		statementNumber++
+
+		rowMasker, err := prepareStreamExport(ctx, stores, licenseService, user, instance, database, statement.Text, queryContext, optionalAccessCheck, schemaSyncer)
+		if err != nil {
+			var predicateErr *sensitivePredicateError
+			if errors.As(err, &predicateErr) {
+				logExportError(database, "failed to query result", err)
+				continue
+			}
+			return exportCount, totalDuration, err
+		}
+
+		executeStatement, err := rowFilter.rewrite(ctx, statement.Text)
+		if err != nil {
+			return exportCount, totalDuration, err
+		}
+
+		duration, err := streamStatementToZip(ctx, zipw, stores, querier, conn, statement.Text, executeStatement, queryContext, rowMasker, request, instance, database, statementNumber)
+		totalDuration += duration
+		if err != nil {
+			var queryErr *statementQueryError
+			if errors.As(err, &queryErr) {
+				// Same as querying, the remaining statements are not executed after a failed statement.
+				logExportError(database, "failed to query result", queryErr.err)
+				break
+			}
+			return exportCount, totalDuration, err
+		}
+		exportCount++
 	}
+	return exportCount, totalDuration, nil
+}
 
-	if err := zipw.Close(); err != nil {
-		return nil, duration, errors.Wrap(err, "failed to close zip writer")
+// statementQueryError is returned if the statement fails in the database before any row is exported.
+type statementQueryError struct {
+	err error
+}
+
+func (e *statementQueryError) Error() string {
+	return e.err.Error()
+}
+
+// sensitivePredicateError is returned if the statement uses sensitive columns in the predicates.
+// The statement is skipped in the export like the statement failed in the database.
+type sensitivePredicateError struct {
+	message string
+}
+
+func (e *sensitivePredicateError) Error() string {
+	return e.message
+}
+
+// prepareStreamExport checks the query access of the statement and returns the masker of its rows.
+// The returned masker is nil if data masking is not enabled.
+func prepareStreamExport(
+	ctx context.Context,
+	stores *store.Store,
+	licenseService *enterprise.LicenseService,
+	user *store.UserMessage,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	statement string,
+	queryContext db.QueryContext,
+	optionalAccessCheck accessCheckFunc,
+	schemaSyncer *schemasync.Syncer,
+) (*RowMasker, error) {
+	spans, err := getQuerySpans(ctx, stores, instance, database, statement, queryContext.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if optionalAccessCheck != nil {
+		if err := optionalAccessCheck(ctx, instance, database, user, spans, false /* isExplain */); err != nil {
+			return nil, err
+		}
+	}
+	if licenseService.IsFeatureEnabledForInstance(v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) != nil || len(spans) == 0 {
+		return nil, nil
+	}
+
+	// The rows are masked while they are streamed, so the metadata must be synced before the statement runs.
+	if spans[0].NotFoundError != nil {
+		syncDatabaseMap := make(map[string]bool)
+		for k := range spans[0].SourceColumns {
+			syncDatabaseMap[k.Database] = true
+		}
+		for accessDatabaseName := range syncDatabaseMap {
+			d, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &accessDatabaseName})
+			if err != nil {
+				return nil, err
+			}
+			if d == nil {
+				continue
+			}
+			if err := schemaSyncer.SyncDatabaseSchema(ctx, d); err != nil {
+				return nil, errors.Wrapf(err, "failed to sync database schema for database %q", accessDatabaseName)
+			}
+		}
+		spans, err = getQuerySpans(ctx, stores, instance, database, statement, queryContext.Schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	masker := NewQueryResultMasker(stores)
+	sensitivePredicateColumns, err := masker.ExtractSensitivePredicateColumns(ctx, spans, instance, user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+	}
+	if len(sensitivePredicateColumns) > 0 && len(sensitivePredicateColumns[0]) > 0 {
+		return nil, &sensitivePredicateError{message: getSensitivePredicateColumnErrorMessages(sensitivePredicateColumns[0])}
+	}
+	rowMasker, err := masker.GetRowMasker(ctx, spans[0], instance, user, storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to mask data: %v", err))
 	}
+	return rowMasker, nil
+}
+
+// streamStatementToZip runs a single statement and streams its rows into the ZIP archive.
+// The executeStatement is the statement rewritten with the row filters, and the original statement is written to the archive.
+func streamStatementToZip(
+	ctx context.Context,
+	zipw *zip.Writer,
+	stores *store.Store,
+	querier db.StreamQuerier,
+	conn *sql.Conn,
+	statement string,
+	executeStatement string,
+	queryContext db.QueryContext,
+	rowMasker *RowMasker,
+	request *v1pb.ExportRequest,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	statementNumber int,
+) (time.Duration, error) {
+	queryCtx := ctx
+	if queryContext.Timeout != nil {
+		newCtx, cancelCtx := context.WithTimeout(ctx, queryContext.Timeout.AsDuration())
+		defer cancelCtx()
+		queryCtx = newCtx
+	}
+
+	start := time.Now()
+	it, err := querier.QueryConnStream(queryCtx, conn, executeStatement, queryContext)
+	if err != nil {
+		if queryContext.Timeout != nil && errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+			return time.Since(start), errors.Errorf("timeout reached: %v", queryContext.Timeout.AsDuration())
+		}
+		return time.Since(start), &statementQueryError{err: err}
+	}
+	defer it.Close()
 
-	return buf.Bytes(), duration, nil
+	var rows export.Rows = it
+	if rowMasker != nil {
+		rows = &maskedRows{RowIterator: it, masker: rowMasker}
+	}
+	if err := exportRowsToZip(queryCtx, zipw, stores, instance, database, statement, rows, request, statementNumber); err != nil {
+		if queryContext.Timeout != nil && errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+			return time.Since(start), errors.Errorf("timeout reached: %v", queryContext.Timeout.AsDuration())
+		}
+		return time.Since(start), errors.Wrapf(err, "failed to export statement %d", statementNumber)
+	}
+	return time.Since(start), nil
+}
+
+// maskedRows masks each row of the underlying iterator as it is read.
+type maskedRows struct {
+	db.RowIterator
+	masker *RowMasker
+}
+
+func (r *maskedRows) Next() bool {
+	if !r.RowIterator.Next() {
+		return false
+	}
+	r.masker.Mask(r.RowIterator.Row())
+	return true
+}
+
+func (r *maskedRows) MaskedColumns() []bool {
+	return r.masker.MaskedColumns()
 }
 
 // logExportError logs export-related errors with consistent database context.
@@ -1096,15 +1403,16 @@ func logExportError(database *store.DatabaseMessage, message string, err error)
 	)
 }
 
-// exportResultToZip exports a single query result to the ZIP archive.
+// exportRowsToZip exports the rows of a single statement to the ZIP archive.
 // It writes both the SQL statement and the formatted result data.
-func exportResultToZip(
+func exportRowsToZip(
 	ctx context.Context,
 	zipw *zip.Writer,
 	stores *store.Store,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
-	result *v1pb.QueryResult,
+	statement string,
+	rows export.Rows,
 	request *v1pb.ExportRequest,
 	statementNumber int,
 ) error {
@@ -1112,59 +1420,47 @@ func exportResultToZip(
 
 	// Write statement file
 	statementFilename := fmt.Sprintf("%s.sql", baseFilename)
-	if err := export.WriteZipEntry(zipw, statementFilename, []byte(result.Statement), request.GetPassword()); err != nil {
+	if err := export.WriteZipEntry(zipw, statementFilename, []byte(statement), request.GetPassword()); err != nil {
 		return errors.Wrap(err, "failed to write statement")
 	}
 
 	// Write result file by streaming directly to ZIP
 	resultExt := strings.ToLower(request.Format.String())
 	resultFilename := fmt.Sprintf("%s.result.%s", baseFilename, resultExt)
-	if err := formatExportToZip(ctx, zipw, resultFilename, stores, instance, database, result, request); err != nil {
-		return errors.Wrap(err, "failed to write formatted result")
-	}
-
-	return nil
-}
-
-// formatExportToZip formats query results and writes them directly to a ZIP entry.
-// This function streams the formatted data to minimize memory usage.
-func formatExportToZip(
-	ctx context.Context,
-	zipw *zip.Writer,
-	filename string,
-	stores *store.Store,
-	instance *store.InstanceMessage,
-	database *store.DatabaseMessage,
-	result *v1pb.QueryResult,
-	request *v1pb.ExportRequest,
-) error {
-	writer, err := export.CreateZipWriter(zipw, filename, request.GetPassword())
+	writer, err := export.CreateZipWriter(zipw, resultFilename, request.GetPassword())
 	if err != nil {
 		return err
 	}
+	if err := writeFormattedRows(ctx, writer, stores, instance, database, rows, request); err != nil {
+		return errors.Wrap(err, "failed to write formatted result")
+	}
 
-	return writeFormattedResult(ctx, writer, stores, instance, database, result, request)
+	return nil
 }
 
-// writeFormattedResult writes the query result in the requested format to the writer.
-func writeFormattedResult(
+// writeFormattedRows writes the rows in the requested format to the writer.
+func writeFormattedRows(
 	ctx context.Context,
 	w io.Writer,
 	stores *store.Store,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
-	result *v1pb.QueryResult,
+	rows export.Rows,
 	request *v1pb.ExportRequest,
 ) error {
 	switch request.Format {
 	case v1pb.ExportFormat_CSV:
-		return export.CSVToWriter(w, result)
+		return export.CSVRowsToWriter(w, rows)
 	case v1pb.ExportFormat_JSON:
-		return export.JSONToWriter(w, result)
+		return export.JSONRowsToWriter(w, rows)
 	case v1pb.ExportFormat_SQL:
-		return exportSQLWithContext(ctx, w, stores, instance, database, result, request)
+		return exportSQLWithContext(ctx, w, stores, instance, database, rows, request)
 	case v1pb.ExportFormat_XLSX:
-		return export.XLSXToWriter(w, result)
+		return export.XLSXRowsToWriter(w, rows)
+	case v1pb.ExportFormat_PARQUET:
+		return export.ParquetRowsToWriter(w, rows)
+	case v1pb.ExportFormat_ARROW:
+		return export.ArrowRowsToWriter(w, rows)
 	default:
 		return errors.Errorf("unsupported export format: %s", request.Format.String())
 	}
@@ -1177,7 +1473,7 @@ func exportSQLWithContext(
 	stores *store.Store,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
-	result *v1pb.QueryResult,
+	rows export.Rows,
 	request *v1pb.ExportRequest,
 ) error {
 	resourceList, err := export.GetResources(
@@ -1194,11 +1490,11 @@ func exportSQLWithContext(
 	if err != nil {
 		return errors.Wrapf(err, "failed to extract resource list")
 	}
-	statementPrefix, err := export.SQLStatementPrefix(instance.Metadata.GetEngine(), resourceList, result.ColumnNames)
+	statementPrefix, err := export.SQLStatementPrefix(instance.Metadata.GetEngine(), resourceList, rows.ColumnNames())
 	if err != nil {
 		return err
 	}
-	return export.SQLToWriter(w, instance.Metadata.GetEngine(), statementPrefix, result)
+	return export.SQLRowsToWriter(w, instance.Metadata.GetEngine(), statementPrefix, rows)
 }
 
 type encryptContent struct {
@@ -1226,7 +1522,7 @@ func doEncrypt(exports []*encryptContent, password string) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
-func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryType store.QueryHistoryType, statement string, userUID int, duration time.Duration, queryErr error) {
+func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryType store.QueryHistoryType, statement string, userUID int, duration time.Duration, rowFilters []*storepb.QueryHistoryPayload_RowFilter, queryErr error) {
 	qh := &store.QueryHistoryMessage{
 		CreatorUID: userUID,
 		ProjectID:  database.ProjectID,
@@ -1234,8 +1530,9 @@ func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryTy
 		Statement:  statement,
 		Type:       queryType,
 		Payload: &storepb.QueryHistoryPayload{
-			Error:    nil,
-			Duration: durationpb.New(duration),
+			Error:      nil,
+			Duration:   durationpb.New(duration),
+			RowFilters: rowFilters,
 		},
 	}
 	if queryErr != nil {
@@ -1741,7 +2038,7 @@ func getUseDatabaseOwner(ctx context.Context, stores *store.Store, instance *sto
 func (*SQLService) DiffMetadata(_ context.Context, req *connect.Request[v1pb.DiffMetadataRequest]) (*connect.Response[v1pb.DiffMetadataResponse], error) {
 	request := req.Msg
 	switch request.Engine {
-	case v1pb.Engine_MYSQL, v1pb.Engine_POSTGRES, v1pb.Engine_TIDB, v1pb.Engine_ORACLE, v1pb.Engine_MSSQL:
+	case v1pb.Engine_MYSQL, v1pb.Engine_POSTGRES, v1pb.Engine_TIDB, v1pb.Engine_ORACLE, v1pb.Engine_MSSQL, v1pb.Engine_CLICKHOUSE, v1pb.Engine_SNOWFLAKE, v1pb.Engine_SPANNER:
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported engine: %v", request.Engine))
 	}
@@ -1783,6 +2080,22 @@ func (*SQLService) DiffMetadata(_ context.Context, req *connect.Request[v1pb.Dif
 	}), nil
 }
 
+func (*SQLService) Format(_ context.Context, req *connect.Request[v1pb.FormatRequest]) (*connect.Response[v1pb.FormatResponse], error) {
+	request := req.Msg
+	switch request.Engine {
+	case v1pb.Engine_MYSQL, v1pb.Engine_MARIADB, v1pb.Engine_OCEANBASE, v1pb.Engine_POSTGRES, v1pb.Engine_COCKROACHDB, v1pb.Engine_MSSQL, v1pb.Engine_ORACLE:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported engine: %v", request.Engine))
+	}
+	statement, err := parserbase.Format(convertEngine(request.Engine), request.Statement, parserbase.FormatOptions{Indent: request.Indent})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to format statement"))
+	}
+	return connect.NewResponse(&v1pb.FormatResponse{
+		Statement: statement,
+	}), nil
+}
+
 func sanitizeCommentForSchemaMetadata(dbMetadata *storepb.DatabaseSchemaMetadata, dbModelConfig *model.DatabaseMetadata, classificationFromConfig bool) {
 	for _, schema := range dbMetadata.Schemas {
 		schemaConfig := dbModelConfig.GetSchemaConfig(schema.Name)
@@ -55,6 +55,14 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 	default:
 	}
 
+	var rowFilters []*v1pb.QueryHistory_RowFilter
+	for _, rowFilter := range history.Payload.GetRowFilters() {
+		rowFilters = append(rowFilters, &v1pb.QueryHistory_RowFilter{
+			Table:     rowFilter.Table,
+			Predicate: rowFilter.Predicate,
+		})
+	}
+
 	return &v1pb.QueryHistory{
 		Name:       fmt.Sprintf("queryHistories/%d", history.UID),
 		Statement:  history.Statement,
@@ -64,5 +72,6 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 		CreateTime: timestamppb.New(history.CreatedAt),
 		Duration:   history.Payload.Duration,
 		Type:       historyType,
+		RowFilters: rowFilters,
 	}, nil
 }
@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// queryRowFilter rewrites the query statements with the row filters applying to the user.
+type queryRowFilter struct {
+	stores   *store.Store
+	instance *store.InstanceMessage
+	rCtx     parserbase.RowFilterContext
+	// filteredDatabases is the names of the databases having the row filters applying to the user.
+	filteredDatabases map[string]bool
+
+	// applied is the row filters applied to the rewritten statements.
+	applied []*parserbase.RowFilter
+}
+
+// newQueryRowFilter returns the row filter of the user querying the database, or nil if no row filter applies to the user.
+func newQueryRowFilter(
+	ctx context.Context,
+	stores *store.Store,
+	licenseService *enterprise.LicenseService,
+	instance *store.InstanceMessage,
+	database *store.DatabaseMessage,
+	user *store.UserMessage,
+	schema string,
+) (*queryRowFilter, error) {
+	if licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_QUERY_POLICY) != nil {
+		return nil, nil
+	}
+	// The row filters of a project are validated to be on its own databases, so only the filters of the project owning
+	// the queried database apply.
+	filters, err := stores.GetProjectRowFilters(ctx, database.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list row filters"))
+	}
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	groups, roles, err := getRowFilterPrincipal(ctx, stores, database, user)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &queryRowFilter{
+		stores:   stores,
+		instance: instance,
+		rCtx: parserbase.RowFilterContext{
+			DefaultDatabase:     database.DatabaseName,
+			DefaultSchema:       schema,
+			IgnoreCaseSensitive: !store.IsObjectCaseSensitive(instance),
+		},
+		filteredDatabases: make(map[string]bool),
+	}
+	for _, filter := range filters {
+		ok, err := common.EvalRowFilterCondition(filter.Condition, user.Email, groups, roles)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to evaluate the condition of the row filter on table %q", filter.Table))
+		}
+		if !ok {
+			continue
+		}
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(filter.Database)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "invalid database %q of the row filter", filter.Database))
+		}
+		if instanceID != instance.ResourceID {
+			continue
+		}
+		// The database may have been transferred to another project after the policy is set.
+		filterDatabase, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:   &instanceID,
+			DatabaseName: &databaseName,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database %q of the row filter", filter.Database))
+		}
+		if filterDatabase == nil || filterDatabase.ProjectID != database.ProjectID {
+			continue
+		}
+		schemaName := filter.Schema
+		if schemaName == "" && instance.Metadata.GetEngine() == storepb.Engine_POSTGRES {
+			schemaName = "public"
+		}
+		f.rCtx.Filters = append(f.rCtx.Filters, &parserbase.RowFilter{
+			Database:  databaseName,
+			Schema:    schemaName,
+			Table:     filter.Table,
+			Predicate: filter.Predicate,
+		})
+		f.filteredDatabases[databaseName] = true
+	}
+	if len(f.rCtx.Filters) == 0 {
+		return nil, nil
+	}
+	return f, nil
+}
+
+// getRowFilterPrincipal returns the group emails and the roles of the user for evaluating the row filter conditions.
+func getRowFilterPrincipal(ctx context.Context, stores *store.Store, database *store.DatabaseMessage, user *store.UserMessage) ([]string, []string, error) {
+	allGroups, err := stores.ListGroups(ctx, &store.FindGroupMessage{})
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list groups"))
+	}
+	userIDFullName := common.FormatUserUID(user.ID)
+	var groups []string
+	for _, group := range allGroups {
+		for _, member := range group.Payload.GetMembers() {
+			if member.Member == userIDFullName {
+				groups = append(groups, group.Email)
+				break
+			}
+		}
+	}
+
+	workspacePolicy, err := stores.GetWorkspaceIamPolicy(ctx)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get workspace iam policy"))
+	}
+	projectPolicy, err := stores.GetProjectIamPolicy(ctx, database.ProjectID)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project iam policy"))
+	}
+	roles := utils.GetUserRolesInIamPolicy(ctx, stores, user, workspacePolicy.Policy, projectPolicy.Policy)
+	return groups, roles, nil
+}
+
+// rewrite returns the statement rewritten with the row filters.
+// The nil row filter returns the statement as is.
+func (f *queryRowFilter) rewrite(ctx context.Context, statement string) (string, error) {
+	if f == nil {
+		return statement, nil
+	}
+	result, err := parserbase.RewriteRowFilter(f.instance.Metadata.GetEngine(), statement, f.rCtx)
+	if err != nil {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.Wrapf(err, "failed to apply the row filters"))
+	}
+	if err := f.checkViews(ctx, result.Tables); err != nil {
+		return "", err
+	}
+	f.applied = append(f.applied, result.Applied...)
+	return result.Statement, nil
+}
+
+// checkViews rejects the views in the databases having the row filters, because the views may read the filtered tables.
+func (f *queryRowFilter) checkViews(ctx context.Context, tables []parserbase.SchemaResource) error {
+	for _, table := range tables {
+		if !f.filteredDatabases[table.Database] {
+			continue
+		}
+		dbMetadata, err := f.stores.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+			InstanceID:   f.instance.ResourceID,
+			DatabaseName: table.Database,
+		})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database schema %q", table.Database))
+		}
+		if dbMetadata == nil {
+			continue
+		}
+		schema := dbMetadata.GetSchemaMetadata(table.Schema)
+		if schema == nil {
+			continue
+		}
+		if schema.GetView(table.Table) != nil || schema.GetMaterializedView(table.Table) != nil {
+			return connect.NewError(connect.CodePermissionDenied, errors.Errorf("querying view %q is not allowed because the database %q has row filters", table.Table, table.Database))
+		}
+	}
+	return nil
+}
+
+// getAppliedRowFilters returns the row filters applied to the rewritten statements for the query history.
+func (f *queryRowFilter) getAppliedRowFilters() []*storepb.QueryHistoryPayload_RowFilter {
+	if f == nil {
+		return nil
+	}
+	var rowFilters []*storepb.QueryHistoryPayload_RowFilter
+	seen := make(map[*parserbase.RowFilter]bool)
+	for _, filter := range f.applied {
+		if seen[filter] {
+			continue
+		}
+		seen[filter] = true
+		table := filter.Table
+		if filter.Schema != "" {
+			table = filter.Schema + "." + filter.Table
+		}
+		rowFilters = append(rowFilters, &storepb.QueryHistoryPayload_RowFilter{
+			Table:     table,
+			Predicate: filter.Predicate,
+		})
+	}
+	return rowFilters
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/server"
 )
 
@@ -71,6 +72,8 @@ var (
 		debug bool
 		// memoryProfileThreshold is the threshold of memory usage in bytes to trigger a memory profile.
 		memoryProfileThreshold uint64
+		// webhookAllowedPrivateNetworks is the private networks in CIDR notation which the custom webhooks are allowed to post to.
+		webhookAllowedPrivateNetworks []string
 	}
 
 	rootCmd = &cobra.Command{
@@ -105,6 +108,8 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&flags.demo, "demo", false, "run in demo mode.")
 	rootCmd.PersistentFlags().BoolVar(&flags.debug, "debug", false, "whether to enable debug level logging")
 	rootCmd.PersistentFlags().Uint64Var(&flags.memoryProfileThreshold, "memory-profile-threshold", 0, "the threshold of memory usage in bytes to trigger a memory profile")
+	// Custom webhooks are not allowed to post to the private, loopback and link-local addresses to prevent SSRF.
+	rootCmd.PersistentFlags().StringSliceVar(&flags.webhookAllowedPrivateNetworks, "webhook-allowed-private-networks", nil, "the private networks in CIDR notation which the custom webhooks are allowed to post to, e.g. 10.0.0.0/8")
 }
 
 // -----------------------------------Command Line Config END--------------------------------------
@@ -158,6 +163,11 @@ func start() {
 		}
 	}
 
+	if err := webhook.SetAllowedPrivateNetworks(flags.webhookAllowedPrivateNetworks); err != nil {
+		slog.Error("invalid --webhook-allowed-private-networks", log.BBError(err))
+		return
+	}
+
 	if err := checkDataDir(); err != nil {
 		slog.Error(err.Error())
 		return
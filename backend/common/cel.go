@@ -31,6 +31,7 @@ var RiskFactors = []cel.EnvOption{
 	cel.Variable(CELAttributeStatementText, cel.StringType),
 
 	cel.Variable(CELAttributeRequestExpirationDays, cel.IntType),
+	cel.Variable(CELAttributeRequestExpirationHours, cel.IntType),
 	cel.Variable(CELAttributeRequestRole, cel.StringType),
 }
 
@@ -75,6 +76,14 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// RowFilterPolicyCELAttributes are the variables when evaluating row filter condition.
+var RowFilterPolicyCELAttributes = []cel.EnvOption{
+	cel.Variable(CELAttributePrincipalEmail, cel.StringType),
+	cel.Variable(CELAttributePrincipalGroups, cel.ListType(cel.StringType)),
+	cel.Variable(CELAttributePrincipalRoles, cel.ListType(cel.StringType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // DatabaseGroupCELAttributes are the variables when evaluating database group conditions.
 var DatabaseGroupCELAttributes = []cel.EnvOption{
 	cel.Variable(CELAttributeResourceEnvironmentID, cel.StringType),
@@ -167,6 +176,36 @@ func ValidateMaskingExceptionCELExpr(expression *expr.Expr) (cel.Program, error)
 	return validateCELExpr(expression, MaskingExceptionPolicyCELAttributes)
 }
 
+// ValidateRowFilterCELExpr validates row filter condition expr.
+func ValidateRowFilterCELExpr(expression *expr.Expr) (cel.Program, error) {
+	return validateCELExpr(expression, RowFilterPolicyCELAttributes)
+}
+
+// EvalRowFilterCondition evaluates the row filter condition with the principal attributes.
+// The empty condition matches all principals.
+func EvalRowFilterCondition(expression *expr.Expr, email string, groups, roles []string) (bool, error) {
+	prog, err := ValidateRowFilterCELExpr(expression)
+	if err != nil {
+		return false, err
+	}
+	if prog == nil {
+		return true, nil
+	}
+	out, _, err := prog.Eval(map[string]any{
+		CELAttributePrincipalEmail:  email,
+		CELAttributePrincipalGroups: append([]string{}, groups...),
+		CELAttributePrincipalRoles:  append([]string{}, roles...),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to eval cel expr")
+	}
+	res, ok := out.Equal(celtypes.True).Value().(bool)
+	if !ok {
+		return false, errors.Errorf("failed to convert cel result to bool")
+	}
+	return res, nil
+}
+
 func ValidateProjectMemberCELExpr(expression *expr.Expr) (cel.Program, error) {
 	return validateCELExpr(expression, IAMPolicyConditionCELAttributes)
 }
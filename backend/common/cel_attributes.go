@@ -43,12 +43,24 @@ const (
 const (
 	// CELAttributeRequestExpirationDays is the number of days until the request expires.
 	CELAttributeRequestExpirationDays = "request.expiration_days"
+	// CELAttributeRequestExpirationHours is the number of hours until the request expires.
+	CELAttributeRequestExpirationHours = "request.expiration_hours"
 	// CELAttributeRequestRole is the requested role.
 	CELAttributeRequestRole = "request.role"
 	// CELAttributeRequestTime is the timestamp of the request.
 	CELAttributeRequestTime = "request.time"
 )
 
+// CEL attribute names for principal scope.
+const (
+	// CELAttributePrincipalEmail is the email of the principal.
+	CELAttributePrincipalEmail = "principal.email"
+	// CELAttributePrincipalGroups is the emails of the groups that the principal belongs to.
+	CELAttributePrincipalGroups = "principal.groups"
+	// CELAttributePrincipalRoles is the workspace and project roles of the principal, e.g. "roles/projectQuerier".
+	CELAttributePrincipalRoles = "principal.roles"
+)
+
 // CEL attribute names for approval scope (deprecated, kept for backward compatibility).
 const (
 	// CELAttributeLevel is the risk level (deprecated).
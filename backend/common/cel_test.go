@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
 )
 
 func TestPartialEval(t *testing.T) {
@@ -113,3 +114,24 @@ func TestGetQueryExportFactors(t *testing.T) {
 		a.Equal(tt.want, *factors)
 	}
 }
+
+func TestEvalRowFilterCondition(t *testing.T) {
+	a := require.New(t)
+
+	testCases := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "", want: true},
+		{expr: `principal.email == "alice@example.com"`, want: true},
+		{expr: `"finance@example.com" in principal.groups`, want: true},
+		{expr: `"roles/projectOwner" in principal.roles`, want: false},
+		{expr: `principal.email.endsWith("@example.com") && !("roles/workspaceAdmin" in principal.roles)`, want: true},
+	}
+
+	for _, tc := range testCases {
+		res, err := EvalRowFilterCondition(&expr.Expr{Expression: tc.expr}, "alice@example.com", []string{"finance@example.com"}, []string{"roles/projectQuerier"})
+		a.NoError(err)
+		a.Equal(tc.want, res, tc.expr)
+	}
+}
@@ -244,11 +244,12 @@ func EngineSupportPriorBackup(e storepb.Engine) bool {
 		storepb.Engine_TIDB,
 		storepb.Engine_MSSQL,
 		storepb.Engine_ORACLE,
-		storepb.Engine_POSTGRES:
+		storepb.Engine_POSTGRES,
+		storepb.Engine_SNOWFLAKE,
+		storepb.Engine_REDSHIFT:
 		return true
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
-		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_CASSANDRA,
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
@@ -256,7 +257,6 @@ func EngineSupportPriorBackup(e storepb.Engine) bool {
 		storepb.Engine_CLICKHOUSE,
 		storepb.Engine_SPANNER,
 		storepb.Engine_BIGQUERY,
-		storepb.Engine_REDSHIFT,
 		storepb.Engine_MARIADB,
 		storepb.Engine_OCEANBASE,
 		storepb.Engine_STARROCKS,
@@ -395,14 +395,15 @@ func BackupDatabaseNameOfEngine(e storepb.Engine) string {
 		storepb.Engine_MYSQL,
 		storepb.Engine_TIDB,
 		storepb.Engine_MSSQL,
-		storepb.Engine_POSTGRES:
+		storepb.Engine_POSTGRES,
+		storepb.Engine_REDSHIFT:
 		return "bbdataarchive"
 	case
-		storepb.Engine_ORACLE:
+		storepb.Engine_ORACLE,
+		storepb.Engine_SNOWFLAKE:
 		return "BBDATAARCHIVE"
 	case
 		storepb.Engine_ENGINE_UNSPECIFIED,
-		storepb.Engine_SNOWFLAKE,
 		storepb.Engine_CASSANDRA,
 		storepb.Engine_SQLITE,
 		storepb.Engine_MONGODB,
@@ -410,7 +411,6 @@ func BackupDatabaseNameOfEngine(e storepb.Engine) string {
 		storepb.Engine_CLICKHOUSE,
 		storepb.Engine_SPANNER,
 		storepb.Engine_BIGQUERY,
-		storepb.Engine_REDSHIFT,
 		storepb.Engine_MARIADB,
 		storepb.Engine_OCEANBASE,
 		storepb.Engine_STARROCKS,
@@ -486,6 +486,12 @@ func ConvertToParserEngine(e storepb.Engine) (storepb.Engine, error) {
 		return storepb.Engine_MSSQL, nil
 	case storepb.Engine_COCKROACHDB:
 		return storepb.Engine_COCKROACHDB, nil
+	case storepb.Engine_CLICKHOUSE:
+		return storepb.Engine_CLICKHOUSE, nil
+	case storepb.Engine_SNOWFLAKE:
+		return storepb.Engine_SNOWFLAKE, nil
+	case storepb.Engine_SPANNER:
+		return storepb.Engine_SPANNER, nil
 	default:
 		return storepb.Engine_ENGINE_UNSPECIFIED, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid engine type %v", e))
 	}
@@ -0,0 +1,240 @@
+package common
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// MaxMaintenanceWindowDuration is the maximum length of a maintenance window.
+const MaxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// cronSearchLimit bounds the search for the next cron match.
+// It covers leap days, e.g. "0 0 29 2 *".
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// MaintenanceWindow is the parsed maintenance window policy of an environment.
+type MaintenanceWindow struct {
+	location *time.Location
+	windows  []*maintenanceWindow
+}
+
+type maintenanceWindow struct {
+	schedule *cronSchedule
+	duration time.Duration
+}
+
+// NewMaintenanceWindow parses the maintenance window policy.
+// A nil policy or a policy without windows imposes no restriction.
+func NewMaintenanceWindow(policy *storepb.MaintenanceWindowPolicy) (*MaintenanceWindow, error) {
+	location := time.UTC
+	if policy.GetTimeZone() != "" {
+		l, err := time.LoadLocation(policy.GetTimeZone())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid time zone %q", policy.GetTimeZone())
+		}
+		location = l
+	}
+	w := &MaintenanceWindow{location: location}
+	for _, window := range policy.GetWindows() {
+		schedule, err := parseCronSchedule(window.GetCron())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cron %q", window.GetCron())
+		}
+		if schedule.next(time.Now().In(location)).IsZero() {
+			return nil, errors.Errorf("cron %q never matches", window.GetCron())
+		}
+		duration := window.GetDuration().AsDuration()
+		if duration <= 0 || duration > MaxMaintenanceWindowDuration {
+			return nil, errors.Errorf("window duration must be positive and at most %v, got %v", MaxMaintenanceWindowDuration, duration)
+		}
+		w.windows = append(w.windows, &maintenanceWindow{schedule: schedule, duration: duration})
+	}
+	return w, nil
+}
+
+// Contains returns whether t is within one of the windows.
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	if len(w.windows) == 0 {
+		return true
+	}
+	t = t.In(w.location)
+	for _, window := range w.windows {
+		start := window.schedule.prev(t, t.Add(-window.duration))
+		if !start.IsZero() && t.Before(start.Add(window.duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the start time of the next window after t.
+// It returns the zero time if there are no windows.
+func (w *MaintenanceWindow) Next(t time.Time) time.Time {
+	var next time.Time
+	t = t.In(w.location)
+	for _, window := range w.windows {
+		start := window.schedule.next(t)
+		if start.IsZero() {
+			continue
+		}
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+	return next
+}
+
+// cronSchedule is a 5-field cron expression "minute hour day-of-month month day-of-week".
+// Each field is a bit set of the matching values.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record whether the day fields are "*".
+	// As in cron, if both day fields are restricted, a day matches if either field matches.
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is also Sunday.
+	{name: "day-of-week", min: 0, max: 7},
+}
+
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, errors.Errorf("expect %d fields, got %d", len(cronFields), len(fields))
+	}
+	var sets []uint64
+	for i, field := range cronFields {
+		set, err := parseCronField(fields[i], field)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s field %q", field.name, fields[i])
+		}
+		sets = append(sets, set)
+	}
+	dow := sets[4]
+	if dow&(1<<7) != 0 {
+		dow = dow&^(1<<7) | 1
+	}
+	return &cronSchedule{
+		minute:  sets[0],
+		hour:    sets[1],
+		dom:     sets[2],
+		month:   sets[3],
+		dow:     dow,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+// parseCronField parses a comma-separated list of "*", "a", "a-b", with an optional "/step".
+func parseCronField(s string, field cronField) (uint64, error) {
+	var set uint64
+	for part := range strings.SplitSeq(s, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			v, err := strconv.Atoi(stepPart)
+			if err != nil || v <= 0 {
+				return 0, errors.Errorf("invalid step %q", stepPart)
+			}
+			step = v
+		}
+		low, high := field.min, field.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+			v, err := strconv.Atoi(lowPart)
+			if err != nil {
+				return 0, errors.Errorf("invalid value %q", lowPart)
+			}
+			low, high = v, v
+			if isRange {
+				v, err := strconv.Atoi(highPart)
+				if err != nil {
+					return 0, errors.Errorf("invalid value %q", highPart)
+				}
+				high = v
+			} else if hasStep {
+				high = field.max
+			}
+		}
+		if low < field.min || high > field.max || low > high {
+			return 0, errors.Errorf("value out of range [%d, %d]", field.min, field.max)
+		}
+		for v := low; v <= high; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func (c *cronSchedule) matchDay(t time.Time) bool {
+	domMatch := c.dom&(1<<t.Day()) != 0
+	dowMatch := c.dow&(1<<int(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the earliest matching minute after t, or the zero time if there is none within the search limit.
+func (c *cronSchedule) next(t time.Time) time.Time {
+	limit := t.Add(cronSearchLimit)
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<t.Hour()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case c.minute&(1<<t.Minute()) == 0:
+			// Jump to the next matching minute in the hour, or the next hour.
+			if rest := c.minute >> (t.Minute() + 1); rest != 0 {
+				t = t.Add(time.Duration(bits.TrailingZeros64(rest)+1) * time.Minute)
+			} else {
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			}
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// prev returns the latest matching minute at or before t and after the lower bound, or the zero time if there is none.
+func (c *cronSchedule) prev(t, lowerBound time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute)
+	for t.After(lowerBound) {
+		switch {
+		case c.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc).Add(-time.Minute)
+		case !c.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(-time.Minute)
+		case c.hour&(1<<t.Hour()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc).Add(-time.Minute)
+		case c.minute&(1<<t.Minute()) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	a := require.New(t)
+
+	// Tuesday and Thursday 02:00-04:00 UTC.
+	window, err := NewMaintenanceWindow(&storepb.MaintenanceWindowPolicy{
+		Windows: []*storepb.MaintenanceWindowPolicy_Window{
+			{Cron: "0 2 * * 2,4", Duration: durationpb.New(2 * time.Hour)},
+		},
+	})
+	a.NoError(err)
+
+	// 2025-01-07 is a Tuesday.
+	tests := []struct {
+		time     string
+		contains bool
+		next     string
+	}{
+		{time: "2025-01-07T01:59:00Z", contains: false, next: "2025-01-07T02:00:00Z"},
+		{time: "2025-01-07T02:00:00Z", contains: true, next: "2025-01-09T02:00:00Z"},
+		{time: "2025-01-07T03:59:59Z", contains: true, next: "2025-01-09T02:00:00Z"},
+		{time: "2025-01-07T04:00:00Z", contains: false, next: "2025-01-09T02:00:00Z"},
+		{time: "2025-01-08T03:00:00Z", contains: false, next: "2025-01-09T02:00:00Z"},
+		{time: "2025-01-10T03:00:00Z", contains: false, next: "2025-01-14T02:00:00Z"},
+	}
+	for _, tc := range tests {
+		tm, err := time.Parse(time.RFC3339, tc.time)
+		a.NoError(err)
+		a.Equal(tc.contains, window.Contains(tm), tc.time)
+		a.Equal(tc.next, window.Next(tm).UTC().Format(time.RFC3339), tc.time)
+	}
+}
+
+func TestMaintenanceWindowTimeZone(t *testing.T) {
+	a := require.New(t)
+
+	// Every day 22:00-02:00 in Shanghai (UTC+8), crossing midnight.
+	window, err := NewMaintenanceWindow(&storepb.MaintenanceWindowPolicy{
+		Windows: []*storepb.MaintenanceWindowPolicy_Window{
+			{Cron: "0 22 * * *", Duration: durationpb.New(4 * time.Hour)},
+		},
+		TimeZone: "Asia/Shanghai",
+	})
+	a.NoError(err)
+
+	a.True(window.Contains(time.Date(2025, 1, 7, 14, 0, 0, 0, time.UTC)))
+	a.True(window.Contains(time.Date(2025, 1, 7, 17, 59, 0, 0, time.UTC)))
+	a.False(window.Contains(time.Date(2025, 1, 7, 18, 0, 0, 0, time.UTC)))
+	a.False(window.Contains(time.Date(2025, 1, 7, 13, 59, 0, 0, time.UTC)))
+	a.Equal(time.Date(2025, 1, 7, 14, 0, 0, 0, time.UTC), window.Next(time.Date(2025, 1, 7, 3, 0, 0, 0, time.UTC)).UTC())
+}
+
+func TestMaintenanceWindowNoWindows(t *testing.T) {
+	a := require.New(t)
+
+	window, err := NewMaintenanceWindow(nil)
+	a.NoError(err)
+	a.True(window.Contains(time.Now()))
+	a.True(window.Next(time.Now()).IsZero())
+}
+
+func TestMaintenanceWindowInvalid(t *testing.T) {
+	tests := []*storepb.MaintenanceWindowPolicy{
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "0 2 * *", Duration: durationpb.New(time.Hour)}}},
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "60 2 * * *", Duration: durationpb.New(time.Hour)}}},
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "0 2 30 2 *", Duration: durationpb.New(time.Hour)}}},
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "0 2 * * MON", Duration: durationpb.New(time.Hour)}}},
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "0 2 * * *"}}},
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "0 2 * * *", Duration: durationpb.New(8 * 24 * time.Hour)}}},
+		{Windows: []*storepb.MaintenanceWindowPolicy_Window{{Cron: "0 2 * * *", Duration: durationpb.New(time.Hour)}}, TimeZone: "Mars/Olympus"},
+	}
+	for _, tc := range tests {
+		_, err := NewMaintenanceWindow(tc)
+		require.Error(t, err, tc.String())
+	}
+}
+
+func TestParseCronSchedule(t *testing.T) {
+	a := require.New(t)
+
+	schedule, err := parseCronSchedule("*/15 9-17 1,15 * 7")
+	a.NoError(err)
+	a.Equal(uint64(1<<0|1<<15|1<<30|1<<45), schedule.minute)
+	a.Equal(uint64(0x3fe00), schedule.hour)
+	a.Equal(uint64(1<<1|1<<15), schedule.dom)
+	// 7 is Sunday.
+	a.Equal(uint64(1), schedule.dow)
+	// Both day fields are restricted, so either matches.
+	a.True(schedule.matchDay(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)))
+	a.True(schedule.matchDay(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)))
+	a.False(schedule.matchDay(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)))
+}
@@ -33,6 +33,7 @@ const (
 	PlanCheckRunPrefix         = "planCheckRuns/"
 	RolePrefix                 = "roles/"
 	WebhookIDPrefix            = "webhooks/"
+	WebhookDeliveryPrefix      = "deliveries/"
 	SheetIDPrefix              = "sheets/"
 	WorksheetIDPrefix          = "worksheets/"
 	DatabaseGroupNamePrefix    = "databaseGroups/"
@@ -51,6 +52,7 @@ const (
 	ReleaseNamePrefix          = "releases/"
 	FileNamePrefix             = "files/"
 	RevisionNamePrefix         = "revisions/"
+	ExternalApprovalNodePrefix = "externalApprovalNodes/"
 
 	SchemaSuffix    = "/schema"
 	SDLSchemaSuffix = "/sdlSchema"
@@ -100,6 +102,23 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryID returns the project ID, webhook ID and delivery ID from a resource name.
+func GetProjectIDWebhookIDDeliveryID(name string) (string, int, int64, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryPrefix)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	webhookID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid webhook ID %q", tokens[1])
+	}
+	deliveryID, err := strconv.ParseInt(tokens[2], 10, 64)
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid delivery ID %q", tokens[2])
+	}
+	return tokens[0], webhookID, deliveryID, nil
+}
+
 // GetUIDFromName returns the UID from a resource name.
 func GetUIDFromName(name, prefix string) (int, error) {
 	tokens, err := GetNameParentTokens(name, prefix)
@@ -223,6 +242,15 @@ func GetRiskID(name string) (int64, error) {
 	return riskID, nil
 }
 
+// GetExternalApprovalNodeID returns the external approval node ID from a resource name.
+func GetExternalApprovalNodeID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, ExternalApprovalNodePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
 // GetProjectIDIssueUID returns the project ID and issue UID from the issue name.
 func GetProjectIDIssueUID(name string) (string, int, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, IssueNamePrefix)
@@ -562,6 +590,10 @@ func FormatRole(role string) string {
 	return fmt.Sprintf("%s%s", RolePrefix, role)
 }
 
+func FormatExternalApprovalNode(id string) string {
+	return fmt.Sprintf("%s%s", ExternalApprovalNodePrefix, id)
+}
+
 func FormatSheet(projectID string, sheetUID int) string {
 	return fmt.Sprintf("%s/%s%d", FormatProject(projectID), SheetIDPrefix, sheetUID)
 }
@@ -0,0 +1,365 @@
+package export
+
+import (
+	"encoding/base64"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// recordBatchSize is the number of rows in an Arrow record batch and a Parquet row group.
+// Only one batch is materialized at a time, so the output is streamed to the writer batch by batch.
+const recordBatchSize = 64 * 1024
+
+var typeModifierRegexp = regexp.MustCompile(`\(.*\)`)
+
+// ArrowToWriter streams query results as an Apache Arrow IPC file directly to the writer.
+func ArrowToWriter(w io.Writer, result *v1pb.QueryResult) error {
+	return ArrowRowsToWriter(w, NewResultRows(result))
+}
+
+// ArrowRowsToWriter streams rows as an Apache Arrow IPC file to the writer, one record batch at a time.
+func ArrowRowsToWriter(w io.Writer, rows Rows) error {
+	schema := arrowSchema(rows)
+	writer, err := ipc.NewFileWriter(w, ipc.WithSchema(schema), ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return errors.Wrap(err, "failed to create arrow writer")
+	}
+	if err := writeRecordBatches(rows, schema, writer.Write); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to close arrow writer")
+	}
+	return nil
+}
+
+// arrowSchema builds the Arrow schema from the column names and column type names of the rows.
+// The masked columns are exported as strings, since the masked values such as "******" cannot be converted to the column types.
+// The materialized rows are checked ahead, so that the columns with any unconvertible values are exported as strings too.
+func arrowSchema(rows Rows) *arrow.Schema {
+	columnNames, columnTypeNames := rows.ColumnNames(), rows.ColumnTypeNames()
+	var masked []bool
+	if r, ok := rows.(MaskedRows); ok {
+		masked = r.MaskedColumns()
+	}
+	fields := make([]arrow.Field, 0, len(columnNames))
+	for i, name := range columnNames {
+		var typeName string
+		if i < len(columnTypeNames) {
+			typeName = columnTypeNames[i]
+		}
+		dataType := arrowType(typeName)
+		if i < len(masked) && masked[i] {
+			dataType = arrow.BinaryTypes.String
+		}
+		if r, ok := rows.(*resultRows); ok && !arrowConvertible(dataType, r.result.Rows, i) {
+			dataType = arrow.BinaryTypes.String
+		}
+		fields = append(fields, arrow.Field{
+			Name:     name,
+			Type:     dataType,
+			Nullable: true,
+		})
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// arrowType maps the database column type name to the Arrow data type.
+// Decimal types are exported as strings because the type name does not carry the precision and scale.
+// Unknown types are exported as strings.
+func arrowType(typeName string) arrow.DataType {
+	name := strings.ToUpper(strings.TrimSpace(typeModifierRegexp.ReplaceAllString(typeName, "")))
+	unsigned := strings.Contains(name, "UNSIGNED")
+	name = strings.TrimSpace(strings.ReplaceAll(name, "UNSIGNED", ""))
+	switch name {
+	case "BOOL", "BOOLEAN":
+		return arrow.FixedWidthTypes.Boolean
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT",
+		"INT2", "INT4", "INT8", "INT16", "INT32", "INT64", "SMALLSERIAL", "SERIAL", "BIGSERIAL", "LONG":
+		if unsigned {
+			return arrow.PrimitiveTypes.Uint64
+		}
+		return arrow.PrimitiveTypes.Int64
+	case "UINT8", "UINT16", "UINT32", "UINT64":
+		return arrow.PrimitiveTypes.Uint64
+	case "FLOAT", "FLOAT4", "FLOAT8", "FLOAT32", "FLOAT64", "REAL", "DOUBLE", "DOUBLE PRECISION":
+		return arrow.PrimitiveTypes.Float64
+	case "DATE":
+		return arrow.FixedWidthTypes.Date32
+	case "TIMESTAMP", "DATETIME", "DATETIME2", "SMALLDATETIME", "TIMESTAMP WITHOUT TIME ZONE", "TIMESTAMP_NTZ", "DATETIME64":
+		return &arrow.TimestampType{Unit: arrow.Microsecond}
+	case "TIMESTAMPTZ", "TIMESTAMP WITH TIME ZONE", "DATETIMEOFFSET", "TIMESTAMP_TZ", "TIMESTAMP_LTZ":
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	case "BYTEA", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BINARY", "VARBINARY", "RAW", "LONG RAW", "BYTES", "IMAGE":
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// arrowConvertible returns whether all the values of the column can be converted to the Arrow data type.
+func arrowConvertible(dataType arrow.DataType, rows []*v1pb.QueryRow, column int) bool {
+	if dataType.ID() == arrow.STRING {
+		return true
+	}
+	builder := array.NewBuilder(memory.DefaultAllocator, dataType)
+	defer builder.Release()
+	for _, row := range rows {
+		var value *v1pb.RowValue
+		if column < len(row.Values) {
+			value = row.Values[column]
+		}
+		if err := appendArrowValue(builder, value); err != nil {
+			return false
+		}
+		// Only the conversion matters, so release the values batch by batch.
+		if builder.Len() >= recordBatchSize {
+			builder.NewArray().Release()
+		}
+	}
+	return true
+}
+
+// writeRecordBatches converts the rows to Arrow record batches and passes them to the write function one by one.
+// The streamed rows cannot be checked ahead like the materialized ones, so the values which cannot be converted
+// to the column types are exported as nulls rather than failing the export.
+func writeRecordBatches(rows Rows, schema *arrow.Schema, write func(arrow.Record) error) error {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	flush := func() error {
+		record := builder.NewRecord()
+		defer record.Release()
+		if err := write(record); err != nil {
+			return errors.Wrap(err, "failed to write record batch")
+		}
+		return nil
+	}
+
+	count := 0
+	for rows.Next() {
+		row := rows.Row()
+		for j := range schema.Fields() {
+			var value *v1pb.RowValue
+			if j < len(row.Values) {
+				value = row.Values[j]
+			}
+			if err := appendArrowValue(builder.Field(j), value); err != nil {
+				builder.Field(j).AppendNull()
+			}
+		}
+		count++
+		if count%recordBatchSize == 0 {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	// Write the remaining rows, or an empty batch so that the output always has the schema.
+	if count == 0 || count%recordBatchSize != 0 {
+		return flush()
+	}
+	return nil
+}
+
+func appendArrowValue(b array.Builder, value *v1pb.RowValue) error {
+	if value == nil || value.Kind == nil {
+		b.AppendNull()
+		return nil
+	}
+	if _, ok := value.Kind.(*v1pb.RowValue_NullValue); ok {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		v, err := convertValueToBool(value)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Int64Builder:
+		v, err := convertValueToInt64(value)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Uint64Builder:
+		v, err := convertValueToUint64(value)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Float64Builder:
+		v, err := convertValueToFloat64(value)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Date32Builder:
+		t, err := convertValueToTime(value)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Date32FromTime(t))
+	case *array.TimestampBuilder:
+		t, err := convertValueToTime(value)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Timestamp(t.UnixMicro()))
+	case *array.BinaryBuilder:
+		if b.Type().ID() == arrow.STRING {
+			b.AppendString(convertValueToString(value))
+			return nil
+		}
+		if v, ok := value.Kind.(*v1pb.RowValue_BytesValue); ok {
+			b.Append(v.BytesValue)
+			return nil
+		}
+		b.AppendString(convertValueToString(value))
+	case *array.StringBuilder:
+		b.Append(convertValueToString(value))
+	default:
+		return errors.Errorf("unsupported arrow builder %T", b)
+	}
+	return nil
+}
+
+func convertValueToBool(value *v1pb.RowValue) (bool, error) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_BoolValue:
+		return v.BoolValue, nil
+	case *v1pb.RowValue_Int32Value:
+		return v.Int32Value != 0, nil
+	case *v1pb.RowValue_Int64Value:
+		return v.Int64Value != 0, nil
+	case *v1pb.RowValue_StringValue:
+		return strconv.ParseBool(v.StringValue)
+	default:
+		return false, errors.Errorf("cannot convert %T to boolean", v)
+	}
+}
+
+func convertValueToInt64(value *v1pb.RowValue) (int64, error) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_Int32Value:
+		return int64(v.Int32Value), nil
+	case *v1pb.RowValue_Int64Value:
+		return v.Int64Value, nil
+	case *v1pb.RowValue_Uint32Value:
+		return int64(v.Uint32Value), nil
+	case *v1pb.RowValue_Uint64Value:
+		if v.Uint64Value > math.MaxInt64 {
+			return 0, errors.Errorf("value %d overflows int64", v.Uint64Value)
+		}
+		return int64(v.Uint64Value), nil
+	case *v1pb.RowValue_StringValue:
+		return strconv.ParseInt(v.StringValue, 10, 64)
+	default:
+		return 0, errors.Errorf("cannot convert %T to int64", v)
+	}
+}
+
+func convertValueToUint64(value *v1pb.RowValue) (uint64, error) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_Uint32Value:
+		return uint64(v.Uint32Value), nil
+	case *v1pb.RowValue_Uint64Value:
+		return v.Uint64Value, nil
+	case *v1pb.RowValue_Int32Value:
+		if v.Int32Value < 0 {
+			return 0, errors.Errorf("negative value %d for unsigned column", v.Int32Value)
+		}
+		return uint64(v.Int32Value), nil
+	case *v1pb.RowValue_Int64Value:
+		if v.Int64Value < 0 {
+			return 0, errors.Errorf("negative value %d for unsigned column", v.Int64Value)
+		}
+		return uint64(v.Int64Value), nil
+	case *v1pb.RowValue_StringValue:
+		return strconv.ParseUint(v.StringValue, 10, 64)
+	default:
+		return 0, errors.Errorf("cannot convert %T to uint64", v)
+	}
+}
+
+func convertValueToFloat64(value *v1pb.RowValue) (float64, error) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_FloatValue:
+		return float64(v.FloatValue), nil
+	case *v1pb.RowValue_DoubleValue:
+		return v.DoubleValue, nil
+	case *v1pb.RowValue_Int32Value:
+		return float64(v.Int32Value), nil
+	case *v1pb.RowValue_Int64Value:
+		return float64(v.Int64Value), nil
+	case *v1pb.RowValue_StringValue:
+		return strconv.ParseFloat(v.StringValue, 64)
+	default:
+		return 0, errors.Errorf("cannot convert %T to float64", v)
+	}
+}
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+func convertValueToTime(value *v1pb.RowValue) (time.Time, error) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_TimestampValue:
+		return v.TimestampValue.GetGoogleTimestamp().AsTime(), nil
+	case *v1pb.RowValue_TimestampTzValue:
+		return v.TimestampTzValue.GetGoogleTimestamp().AsTime(), nil
+	case *v1pb.RowValue_StringValue:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v.StringValue); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, errors.Errorf("cannot parse %q as time", v.StringValue)
+	default:
+		return time.Time{}, errors.Errorf("cannot convert %T to time", v)
+	}
+}
+
+func convertValueToString(value *v1pb.RowValue) string {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue
+	case *v1pb.RowValue_BytesValue:
+		if utf8.Valid(v.BytesValue) {
+			return string(v.BytesValue)
+		}
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case *v1pb.RowValue_ValueValue:
+		b, err := protojson.Marshal(v.ValueValue)
+		if err != nil {
+			return v.ValueValue.String()
+		}
+		return string(b)
+	default:
+		return convertValueToStringInXLSX(value)
+	}
+}
@@ -19,14 +19,24 @@ func CSV(result *v1pb.QueryResult) ([]byte, error) {
 // CSVToWriter streams query results as CSV directly to the writer.
 // This minimizes memory usage by avoiding intermediate buffering.
 func CSVToWriter(w io.Writer, result *v1pb.QueryResult) error {
-	if _, err := w.Write([]byte(strings.Join(result.ColumnNames, ","))); err != nil {
+	return CSVRowsToWriter(w, NewResultRows(result))
+}
+
+// CSVRowsToWriter streams rows as CSV to the writer as they are read.
+func CSVRowsToWriter(w io.Writer, rows Rows) error {
+	if _, err := w.Write([]byte(strings.Join(rows.ColumnNames(), ","))); err != nil {
 		return err
 	}
 	if _, err := w.Write([]byte{'\n'}); err != nil {
 		return err
 	}
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
+	for i := 0; rows.Next(); i++ {
+		if i != 0 {
+			if _, err := w.Write([]byte{'\n'}); err != nil {
+				return err
+			}
+		}
+		for j, value := range rows.Row().Values {
 			if j != 0 {
 				if _, err := w.Write([]byte{','}); err != nil {
 					return err
@@ -36,13 +46,8 @@ func CSVToWriter(w io.Writer, result *v1pb.QueryResult) error {
 				return err
 			}
 		}
-		if i != len(result.Rows)-1 {
-			if _, err := w.Write([]byte{'\n'}); err != nil {
-				return err
-			}
-		}
 	}
-	return nil
+	return rows.Err()
 }
 
 func convertValueToBytesInCSV(value *v1pb.RowValue) []byte {
@@ -1,4 +1,4 @@
-// Package export provides data export functionality for various formats (CSV, JSON, SQL, XLSX).
+// Package export provides data export functionality for various formats (CSV, JSON, SQL, XLSX, Parquet, Arrow).
 // It implements streaming export to minimize memory usage for large datasets.
 package export
 
@@ -12,6 +12,70 @@ import (
 // Writer is a function type that writes query results to a writer.
 type Writer func(w io.Writer, result *v1pb.QueryResult) error
 
+// Rows is a forward-only iterator over the rows of a query result.
+// It is satisfied by db.RowIterator, so rows can be streamed from the database to the writer
+// without materializing the full query result.
+type Rows interface {
+	ColumnNames() []string
+	ColumnTypeNames() []string
+	Next() bool
+	Row() *v1pb.QueryRow
+	Err() error
+}
+
+// MaskedRows is implemented by the rows whose columns may be masked.
+// The masked values are strings no matter what the column types are.
+type MaskedRows interface {
+	Rows
+	// MaskedColumns returns whether each column is masked.
+	MaskedColumns() []bool
+}
+
+// RowsWriter is a function type that streams rows to a writer.
+type RowsWriter func(w io.Writer, rows Rows) error
+
+// NewResultRows returns the rows of a materialized query result.
+func NewResultRows(result *v1pb.QueryResult) Rows {
+	return &resultRows{result: result, index: -1}
+}
+
+type resultRows struct {
+	result *v1pb.QueryResult
+	index  int
+}
+
+func (r *resultRows) ColumnNames() []string {
+	return r.result.ColumnNames
+}
+
+func (r *resultRows) ColumnTypeNames() []string {
+	return r.result.ColumnTypeNames
+}
+
+func (r *resultRows) MaskedColumns() []bool {
+	masked := make([]bool, len(r.result.Masked))
+	for i, reason := range r.result.Masked {
+		masked[i] = reason != nil
+	}
+	return masked
+}
+
+func (r *resultRows) Next() bool {
+	if r.index+1 >= len(r.result.Rows) {
+		return false
+	}
+	r.index++
+	return true
+}
+
+func (r *resultRows) Row() *v1pb.QueryRow {
+	return r.result.Rows[r.index]
+}
+
+func (*resultRows) Err() error {
+	return nil
+}
+
 // exportToBytes is a helper function that exports to a byte slice using a writer function.
 func exportToBytes(result *v1pb.QueryResult, writerFunc Writer) ([]byte, error) {
 	var buf bytes.Buffer
@@ -1,9 +1,24 @@
 package export
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"testing"
+	"time"
 
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -309,3 +324,225 @@ func TestExportJSON(t *testing.T) {
 		a.Equal(test.want, string(got))
 	}
 }
+
+func newColumnarTestResult(rowCount int) *v1pb.QueryResult {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "name", "price", "created_at", "active", "data"},
+		ColumnTypeNames: []string{"BIGINT", "VARCHAR(255)", "DECIMAL(10,2)", "TIMESTAMP", "BOOLEAN", "BYTEA"},
+	}
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 0; i < rowCount; i++ {
+		result.Rows = append(result.Rows, &v1pb.QueryRow{
+			Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(i)}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: fmt.Sprintf("name-%d", i)}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "12.30"}},
+				{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(createdAt)}}},
+				{Kind: &v1pb.RowValue_BoolValue{BoolValue: i%2 == 0}},
+				{Kind: &v1pb.RowValue_NullValue{}},
+			},
+		})
+	}
+	return result
+}
+
+func assertColumnarTestRecord(t *testing.T, record arrow.Record) {
+	a := assert.New(t)
+	a.Equal(int64(2), record.NumRows())
+	a.Equal(arrow.PrimitiveTypes.Int64, record.Schema().Field(0).Type)
+	a.Equal(arrow.BinaryTypes.String, record.Schema().Field(2).Type)
+	a.Equal(int64(1), record.Column(0).(*array.Int64).Value(1))
+	a.Equal("name-1", record.Column(1).(*array.String).Value(1))
+	a.Equal("12.30", record.Column(2).(*array.String).Value(0))
+	a.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), record.Column(3).(*array.Timestamp).Value(0).ToTime(arrow.Microsecond))
+	a.True(record.Column(4).(*array.Boolean).Value(0))
+	a.False(record.Column(4).(*array.Boolean).Value(1))
+	a.True(record.Column(5).IsNull(0))
+}
+
+func TestExportArrow(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ArrowToWriter(&buf, newColumnarTestResult(2)))
+
+	reader, err := ipc.NewFileReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Equal(t, 1, reader.NumRecords())
+	record, err := reader.Record(0)
+	require.NoError(t, err)
+	assertColumnarTestRecord(t, record)
+}
+
+func TestExportParquet(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ParquetToWriter(&buf, newColumnarTestResult(2)))
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf.Bytes()), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	defer table.Release()
+	record := array.NewTableReader(table, -1)
+	defer record.Release()
+	require.True(t, record.Next())
+	assertColumnarTestRecord(t, record.Record())
+}
+
+func TestExportParquetRowGroups(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ParquetToWriter(&buf, newColumnarTestResult(recordBatchSize+1)))
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Equal(t, 2, reader.NumRowGroups())
+	require.Equal(t, int64(recordBatchSize+1), reader.NumRows())
+}
+
+func TestExportColumnarEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ParquetToWriter(&buf, newColumnarTestResult(0)))
+	buf.Reset()
+	require.NoError(t, ArrowToWriter(&buf, newColumnarTestResult(0)))
+}
+
+func TestExportColumnarInvalidValue(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id"},
+		ColumnTypeNames: []string{"INT"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_StringValue{StringValue: "abc"}}}},
+		},
+	}
+	// The materialized column with the unconvertible values is exported as strings.
+	var buf bytes.Buffer
+	require.NoError(t, ArrowToWriter(&buf, result))
+	record := readArrowRecord(t, buf.Bytes())
+	require.Equal(t, arrow.BinaryTypes.String, record.Schema().Field(0).Type)
+	require.Equal(t, "abc", record.Column(0).(*array.String).Value(0))
+
+	// The streamed rows cannot be checked ahead, so the unconvertible values are exported as nulls.
+	buf.Reset()
+	require.NoError(t, ArrowRowsToWriter(&buf, &streamedRows{Rows: NewResultRows(result)}))
+	record = readArrowRecord(t, buf.Bytes())
+	require.Equal(t, arrow.PrimitiveTypes.Int64, record.Schema().Field(0).Type)
+	require.Equal(t, int64(1), record.NumRows())
+	require.True(t, record.Column(0).IsNull(0))
+
+	buf.Reset()
+	require.NoError(t, ParquetRowsToWriter(&buf, &streamedRows{Rows: NewResultRows(result)}))
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf.Bytes()), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	defer table.Release()
+	require.Equal(t, 1, table.Column(0).Data().Chunk(0).NullN())
+}
+
+// streamedRows hides the materialized rows.
+type streamedRows struct {
+	Rows
+	masked []bool
+}
+
+func (r *streamedRows) MaskedColumns() []bool {
+	return r.masked
+}
+
+func TestExportColumnarMasked(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     []string{"id", "birthday", "active", "score", "name"},
+		ColumnTypeNames: []string{"INT", "DATE", "BOOLEAN", "DOUBLE", "VARCHAR"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "Alice"}},
+			}},
+		},
+		Masked: []*v1pb.MaskingReason{{Algorithm: "Full mask"}, {Algorithm: "Full mask"}, {Algorithm: "Full mask"}, {Algorithm: "Full mask"}, nil},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, ArrowToWriter(&buf, result))
+	record := readArrowRecord(t, buf.Bytes())
+	for i := 0; i < 4; i++ {
+		require.Equal(t, arrow.BinaryTypes.String, record.Schema().Field(i).Type)
+		require.Equal(t, "******", record.Column(i).(*array.String).Value(0))
+	}
+
+	// The masked columns of the streamed rows are exported as strings.
+	buf.Reset()
+	rows := &streamedRows{Rows: NewResultRows(result), masked: []bool{true, true, true, true, false}}
+	require.NoError(t, ArrowRowsToWriter(&buf, rows))
+	record = readArrowRecord(t, buf.Bytes())
+	require.Equal(t, arrow.BinaryTypes.String, record.Schema().Field(0).Type)
+	require.Equal(t, "******", record.Column(0).(*array.String).Value(0))
+	require.Equal(t, "Alice", record.Column(4).(*array.String).Value(0))
+}
+
+func readArrowRecord(t *testing.T, b []byte) arrow.Record {
+	reader, err := ipc.NewFileReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = reader.Close() })
+	require.Equal(t, 1, reader.NumRecords())
+	record, err := reader.Record(0)
+	require.NoError(t, err)
+	return record
+}
+
+type failingRows struct {
+	Rows
+	err error
+}
+
+func (r *failingRows) Err() error {
+	return r.err
+}
+
+func TestExportRowsToWriter(t *testing.T) {
+	result := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}, {Kind: &v1pb.RowValue_StringValue{StringValue: "a"}}}},
+			{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}}, {Kind: &v1pb.RowValue_StringValue{StringValue: "b"}}}},
+		},
+	}
+
+	// The streamed JSON must be the same as encoding the whole array at once.
+	var records []map[string]any
+	for _, row := range result.Rows {
+		records = append(records, map[string]any{
+			"id":   row.Values[0].GetInt64Value(),
+			"name": row.Values[1].GetStringValue(),
+		})
+	}
+	want, err := json.MarshalIndent(records, "", "  ")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, JSONRowsToWriter(&buf, NewResultRows(result)))
+	require.Equal(t, string(want), buf.String())
+
+	buf.Reset()
+	require.NoError(t, JSONRowsToWriter(&buf, NewResultRows(&v1pb.QueryResult{ColumnNames: []string{"id"}})))
+	require.Equal(t, "[]", buf.String())
+
+	buf.Reset()
+	require.NoError(t, CSVRowsToWriter(&buf, NewResultRows(result)))
+	require.Equal(t, "id,name\n1,\"a\"\n2,\"b\"", buf.String())
+
+	buf.Reset()
+	require.NoError(t, SQLRowsToWriter(&buf, storepb.Engine_POSTGRES, "INSERT INTO t (id,name) VALUES (", NewResultRows(result)))
+	require.Equal(t, "INSERT INTO t (id,name) VALUES (1,'a');\nINSERT INTO t (id,name) VALUES (2,'b');", buf.String())
+
+	// Errors from the row iterator must fail the export.
+	iteratorErr := errors.New("connection reset")
+	writers := map[string]RowsWriter{
+		"CSV":     CSVRowsToWriter,
+		"JSON":    JSONRowsToWriter,
+		"XLSX":    XLSXRowsToWriter,
+		"PARQUET": ParquetRowsToWriter,
+		"ARROW":   ArrowRowsToWriter,
+	}
+	for name, writer := range writers {
+		err := writer(io.Discard, &failingRows{Rows: NewResultRows(result), err: iteratorErr})
+		require.ErrorIs(t, err, iteratorErr, name)
+	}
+}
@@ -19,23 +19,43 @@ func JSON(result *v1pb.QueryResult) ([]byte, error) {
 
 // JSONToWriter streams query results as pretty-printed JSON directly to the writer.
 func JSONToWriter(w io.Writer, result *v1pb.QueryResult) error {
-	records := make([]map[string]any, 0, len(result.Rows))
-	for _, row := range result.Rows {
-		record := make(map[string]any, len(result.ColumnNames))
-		for i, value := range row.Values {
-			record[result.ColumnNames[i]] = convertValueToJSONValue(value)
-		}
-		records = append(records, record)
-	}
+	return JSONRowsToWriter(w, NewResultRows(result))
+}
 
-	jsonBytes, err := json.MarshalIndent(records, "", "  ")
-	if err != nil {
-		return errors.Errorf("failed to encode JSON: %v", err)
+// JSONRowsToWriter streams rows as a pretty-printed JSON array to the writer, one record at a time.
+func JSONRowsToWriter(w io.Writer, rows Rows) error {
+	columnNames := rows.ColumnNames()
+	count := 0
+	for rows.Next() {
+		record := make(map[string]any, len(columnNames))
+		for i, value := range rows.Row().Values {
+			record[columnNames[i]] = convertValueToJSONValue(value)
+		}
+		jsonBytes, err := json.MarshalIndent(record, "  ", "  ")
+		if err != nil {
+			return errors.Errorf("failed to encode JSON: %v", err)
+		}
+		separator := ",\n  "
+		if count == 0 {
+			separator = "[\n  "
+		}
+		if _, err := io.WriteString(w, separator); err != nil {
+			return err
+		}
+		if _, err := w.Write(jsonBytes); err != nil {
+			return err
+		}
+		count++
 	}
-	if _, err := w.Write(jsonBytes); err != nil {
+	if err := rows.Err(); err != nil {
 		return err
 	}
-	return nil
+	end := "\n]"
+	if count == 0 {
+		end = "[]"
+	}
+	_, err := io.WriteString(w, end)
+	return err
 }
 
 func convertValueToJSONValue(value *v1pb.RowValue) any {
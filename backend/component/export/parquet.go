@@ -0,0 +1,38 @@
+package export
+
+import (
+	"io"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// ParquetToWriter streams query results as a Snappy-compressed Apache Parquet file directly to the writer.
+func ParquetToWriter(w io.Writer, result *v1pb.QueryResult) error {
+	return ParquetRowsToWriter(w, NewResultRows(result))
+}
+
+// ParquetRowsToWriter streams rows as a Snappy-compressed Apache Parquet file to the writer.
+// Each record batch is written as a separate row group.
+func ParquetRowsToWriter(w io.Writer, rows Rows) error {
+	schema := arrowSchema(rows)
+	props := parquet.NewWriterProperties(
+		parquet.WithMaxRowGroupLength(recordBatchSize),
+		parquet.WithCompression(compress.Codecs.Snappy),
+	)
+	writer, err := pqarrow.NewFileWriter(schema, w, props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return errors.Wrap(err, "failed to create parquet writer")
+	}
+	if err := writeRecordBatches(rows, schema, writer.Write); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to close parquet writer")
+	}
+	return nil
+}
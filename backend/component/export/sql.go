@@ -24,11 +24,22 @@ func SQL(engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult
 
 // SQLToWriter streams SQL INSERT statements directly to the writer.
 func SQLToWriter(w io.Writer, engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult) error {
-	for i, row := range result.Rows {
+	return SQLRowsToWriter(w, engine, statementPrefix, NewResultRows(result))
+}
+
+// SQLRowsToWriter streams rows as SQL INSERT statements to the writer as they are read.
+func SQLRowsToWriter(w io.Writer, engine storepb.Engine, statementPrefix string, rows Rows) error {
+	i := 0
+	for ; rows.Next(); i++ {
+		if i != 0 {
+			if _, err := w.Write([]byte(");\n")); err != nil {
+				return err
+			}
+		}
 		if _, err := w.Write([]byte(statementPrefix)); err != nil {
 			return err
 		}
-		for j, value := range row.Values {
+		for j, value := range rows.Row().Values {
 			if j != 0 {
 				if _, err := w.Write([]byte{','}); err != nil {
 					return err
@@ -38,14 +49,13 @@ func SQLToWriter(w io.Writer, engine storepb.Engine, statementPrefix string, res
 				return err
 			}
 		}
-		if i != len(result.Rows)-1 {
-			if _, err := w.Write([]byte(");\n")); err != nil {
-				return err
-			}
-		} else {
-			if _, err := w.Write([]byte(");")); err != nil {
-				return err
-			}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if i != 0 {
+		if _, err := w.Write([]byte(");")); err != nil {
+			return err
 		}
 	}
 	return nil
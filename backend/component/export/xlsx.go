@@ -20,51 +20,58 @@ const (
 	ExcelMaxColumn = 18278
 )
 
-// XLSX exports query results as XLSX format.
-// Note: XLSX still materializes in memory due to excelize library limitations.
+// XLSX exports query results as XLSX format (legacy wrapper).
 func XLSX(result *v1pb.QueryResult) ([]byte, error) {
+	return exportToBytes(result, XLSXToWriter)
+}
+
+// XLSXToWriter exports XLSX format to a writer.
+func XLSXToWriter(w io.Writer, result *v1pb.QueryResult) error {
+	return XLSXRowsToWriter(w, NewResultRows(result))
+}
+
+// XLSXRowsToWriter streams rows as XLSX to the writer.
+// The rows are written through the excelize stream writer, which spills to a temporary file for large sheets.
+func XLSXRowsToWriter(w io.Writer, rows Rows) error {
 	f := excelize.NewFile()
 	defer f.Close()
-	index, err := f.NewSheet("Sheet1")
+	index, err := f.NewSheet(sheet1Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var columnPrefixes []string
-	for i, columnName := range result.ColumnNames {
-		columnPrefix, err := ExcelColumnName(i)
-		if err != nil {
-			return nil, err
-		}
-		columnPrefixes = append(columnPrefixes, columnPrefix)
-		if err := f.SetCellValue(sheet1Name, fmt.Sprintf("%s1", columnPrefix), columnName); err != nil {
-			return nil, err
-		}
+	sw, err := f.NewStreamWriter(sheet1Name)
+	if err != nil {
+		return err
+	}
+	columnNames := rows.ColumnNames()
+	if len(columnNames) > ExcelMaxColumn {
+		return errors.Errorf("index cannot be greater than %v (column ZZZ)", ExcelMaxColumn)
+	}
+	header := make([]any, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		header = append(header, columnName)
 	}
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
-			columnName := fmt.Sprintf("%s%d", columnPrefixes[j], i+2)
-			if err := f.SetCellValue("Sheet1", columnName, convertValueToStringInXLSX(value)); err != nil {
-				return nil, err
-			}
+	if err := sw.SetRow("A1", header); err != nil {
+		return err
+	}
+	for i := 0; rows.Next(); i++ {
+		values := rows.Row().Values
+		cells := make([]any, 0, len(values))
+		for _, value := range values {
+			cells = append(cells, convertValueToStringInXLSX(value))
+		}
+		if err := sw.SetRow(fmt.Sprintf("A%d", i+2), cells); err != nil {
+			return err
 		}
 	}
-	f.SetActiveSheet(index)
-	excelBytes, err := f.WriteToBuffer()
-	if err != nil {
-		return nil, err
+	if err := rows.Err(); err != nil {
+		return err
 	}
-	return excelBytes.Bytes(), nil
-}
-
-// XLSXToWriter exports XLSX format to a writer.
-// Note: XLSX still materializes in memory due to excelize library limitations.
-func XLSXToWriter(w io.Writer, result *v1pb.QueryResult) error {
-	content, err := XLSX(result)
-	if err != nil {
+	if err := sw.Flush(); err != nil {
 		return err
 	}
-	_, err = w.Write(content)
-	return err
+	f.SetActiveSheet(index)
+	return f.Write(w)
 }
 
 // ExcelColumnName converts a column index to Excel column name (A, B, ..., Z, AA, AB, ..., ZZZ).
@@ -97,6 +97,7 @@ const (
 	PermissionSQLAdmin                Permission = "bb.sql.admin"
 	PermissionTaskRunsCreate          Permission = "bb.taskRuns.create"
 	PermissionTaskRunsList            Permission = "bb.taskRuns.list"
+	PermissionTaskRunsOverride        Permission = "bb.taskRuns.override"
 	PermissionGroupsCreate            Permission = "bb.groups.create"
 	PermissionGroupsDelete            Permission = "bb.groups.delete"
 	PermissionGroupsGet               Permission = "bb.groups.get"
@@ -209,6 +210,7 @@ var allPermissions = []Permission{
 	PermissionSQLAdmin,
 	PermissionTaskRunsCreate,
 	PermissionTaskRunsList,
+	PermissionTaskRunsOverride,
 	PermissionGroupsCreate,
 	PermissionGroupsDelete,
 	PermissionGroupsGet,
@@ -0,0 +1,337 @@
+package masker
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const (
+	// fpeMinDomainSize is the minimum domain size radix^n required by NIST SP 800-38G Revision 1.
+	fpeMinDomainSize = 1000000
+	ff1Rounds        = 10
+	ff3Rounds        = 8
+)
+
+// fpeAlphabet is a character class encrypted by the FPEMasker.
+type fpeAlphabet struct {
+	name  string
+	chars string
+}
+
+var fpeAlphabets = []fpeAlphabet{
+	{name: "digit", chars: "0123456789"},
+	{name: "lower", chars: "abcdefghijklmnopqrstuvwxyz"},
+	{name: "upper", chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZ"},
+}
+
+// FPEMasker is the masker that encrypts the data with format-preserving encryption (FF1 or FF3-1).
+// ASCII digits, lowercase and uppercase letters are encrypted as separate numeral strings, so digits stay digits
+// and letters keep their case. Other ASCII characters keep their positions, such as '@' and '.' in emails,
+// so the masked values still pass format checks. Other letters and digits are replaced with '*'.
+type FPEMasker struct {
+	mode  storepb.Algorithm_FormatPreservingEncryptionMask_Mode
+	key   []byte
+	tweak string
+}
+
+// NewFPEMasker returns a new FPEMasker. The key must be an AES-128, AES-192 or AES-256 key.
+func NewFPEMasker(mode storepb.Algorithm_FormatPreservingEncryptionMask_Mode, key []byte, tweak string) (*FPEMasker, error) {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, errors.Errorf("invalid key size %d for format-preserving encryption", len(key))
+	}
+	if mode == storepb.Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED {
+		mode = storepb.Algorithm_FormatPreservingEncryptionMask_FF1
+	}
+	return &FPEMasker{
+		mode:  mode,
+		key:   key,
+		tweak: tweak,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *FPEMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return maskValueAsString(m, data, m.encryptString)
+}
+
+// Equal implements Masker.Equal.
+func (m *FPEMasker) Equal(other Masker) bool {
+	if otherFPEMasker, ok := other.(*FPEMasker); ok {
+		return m.mode == otherFPEMasker.mode && m.tweak == otherFPEMasker.tweak && hmac.Equal(m.key, otherFPEMasker.key)
+	}
+	return false
+}
+
+func (m *FPEMasker) encryptString(s string) string {
+	runes := []rune(s)
+	for _, alphabet := range fpeAlphabets {
+		var positions []int
+		var numerals []uint16
+		for i, r := range runes {
+			if index := indexOfASCII(alphabet.chars, r); index >= 0 {
+				positions = append(positions, i)
+				numerals = append(numerals, uint16(index))
+			}
+		}
+		if len(numerals) == 0 {
+			continue
+		}
+		encrypted, err := m.encryptNumerals(alphabet, numerals)
+		if err != nil {
+			// The key size is validated in the constructor, so it should never happen.
+			return "******"
+		}
+		for i, position := range positions {
+			runes[position] = rune(alphabet.chars[encrypted[i]])
+		}
+	}
+	for i, r := range runes {
+		if r > unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+func (m *FPEMasker) encryptNumerals(alphabet fpeAlphabet, numerals []uint16) ([]uint16, error) {
+	radix := len(alphabet.chars)
+	tweak := []byte(alphabet.name + ":" + m.tweak)
+	if m.mode == storepb.Algorithm_FormatPreservingEncryptionMask_FF3_1 {
+		c, err := newFF31(m.key, tweak, radix)
+		if err != nil {
+			return nil, err
+		}
+		// FF3-1 limits the input length, so long inputs are encrypted in chunks.
+		var result []uint16
+		for chunk := range slices.Chunk(numerals, c.maxLen) {
+			if !fpeDomainLargeEnough(radix, len(chunk)) {
+				result = append(result, m.substituteNumerals(tweak, radix, chunk)...)
+				continue
+			}
+			result = append(result, c.encrypt(chunk)...)
+		}
+		return result, nil
+	}
+
+	if !fpeDomainLargeEnough(radix, len(numerals)) {
+		return m.substituteNumerals(tweak, radix, numerals), nil
+	}
+	c, err := newFF1(m.key, tweak, radix)
+	if err != nil {
+		return nil, err
+	}
+	return c.encrypt(numerals), nil
+}
+
+// substituteNumerals maps the numerals which are too short for format-preserving encryption to
+// numerals derived from a keyed hash. The mapping is deterministic but not reversible.
+func (m *FPEMasker) substituteNumerals(tweak []byte, radix int, numerals []uint16) []uint16 {
+	h := hmac.New(sha256.New, m.key)
+	_, _ = h.Write(tweak)
+	for _, numeral := range numerals {
+		_, _ = h.Write([]byte{byte(numeral >> 8), byte(numeral)})
+	}
+	y := new(big.Int).SetBytes(h.Sum(nil))
+	return bigToNumerals(y, radix, len(numerals))
+}
+
+func fpeDomainLargeEnough(radix, n int) bool {
+	size := 1
+	for range n {
+		size *= radix
+		if size >= fpeMinDomainSize {
+			return true
+		}
+	}
+	return false
+}
+
+func indexOfASCII(chars string, r rune) int {
+	if r > unicode.MaxASCII {
+		return -1
+	}
+	for i := 0; i < len(chars); i++ {
+		if rune(chars[i]) == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// ff1 implements the FF1 mode of NIST SP 800-38G.
+type ff1 struct {
+	block cipher.Block
+	tweak []byte
+	radix int
+}
+
+func newFF1(key, tweak []byte, radix int) (*ff1, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &ff1{block: block, tweak: tweak, radix: radix}, nil
+}
+
+func (c *ff1) encrypt(x []uint16) []uint16 {
+	n := len(x)
+	u := n / 2
+	v := n - u
+	a, b := slices.Clone(x[:u]), slices.Clone(x[u:])
+	radix := big.NewInt(int64(c.radix))
+	t := len(c.tweak)
+
+	// b = ceil(ceil(v * log2(radix)) / 8), d = 4 * ceil(b / 4) + 4.
+	radixV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+	byteLen := (new(big.Int).Sub(radixV, big.NewInt(1)).BitLen() + 7) / 8
+	d := 4*((byteLen+3)/4) + 4
+
+	p := []byte{1, 2, 1, byte(c.radix >> 16), byte(c.radix >> 8), byte(c.radix), 10, byte(u % 256)}
+	p = binary.BigEndian.AppendUint32(p, uint32(n))
+	p = binary.BigEndian.AppendUint32(p, uint32(t))
+
+	zeroPad := ((-t-byteLen-1)%16 + 16) % 16
+	for i := range ff1Rounds {
+		q := make([]byte, 0, t+zeroPad+1+byteLen)
+		q = append(q, c.tweak...)
+		q = append(q, make([]byte, zeroPad)...)
+		q = append(q, byte(i))
+		q = append(q, fixedBytes(numeralsToBig(b, c.radix), byteLen)...)
+
+		r := c.prf(append(slices.Clone(p), q...))
+		s := slices.Clone(r)
+		for j := 1; len(s) < d; j++ {
+			block := slices.Clone(r)
+			binary.BigEndian.PutUint64(block[8:], binary.BigEndian.Uint64(block[8:])^uint64(j))
+			c.block.Encrypt(block, block)
+			s = append(s, block...)
+		}
+		y := new(big.Int).SetBytes(s[:d])
+
+		m := u
+		if i%2 == 1 {
+			m = v
+		}
+		y.Add(y, numeralsToBig(a, c.radix))
+		a, b = b, bigToNumerals(y, c.radix, m)
+	}
+	return append(a, b...)
+}
+
+// prf is the CBC-MAC of the input with a zero IV.
+func (c *ff1) prf(x []byte) []byte {
+	y := make([]byte, aes.BlockSize)
+	for i := 0; i < len(x); i += aes.BlockSize {
+		for j := range aes.BlockSize {
+			y[j] ^= x[i+j]
+		}
+		c.block.Encrypt(y, y)
+	}
+	return y
+}
+
+// ff3 implements the FF3 mode of NIST SP 800-38G with the 64-bit tweak split into the left and right halves.
+// FF3-1 is the same algorithm with the halves derived from a 56-bit tweak.
+type ff3 struct {
+	block  cipher.Block
+	tl, tr [4]byte
+	radix  int
+	maxLen int
+}
+
+// newFF31 returns the FF3-1 cipher. The tweak is hashed to 56 bits.
+func newFF31(key, tweak []byte, radix int) (*ff3, error) {
+	sum := sha256.Sum256(tweak)
+	t := sum[:7]
+	return newFF3(key, [4]byte{t[0], t[1], t[2], t[3] & 0xf0}, [4]byte{t[4], t[5], t[6], (t[3] & 0x0f) << 4}, radix)
+}
+
+func newFF3(key []byte, tl, tr [4]byte, radix int) (*ff3, error) {
+	reversedKey := slices.Clone(key)
+	slices.Reverse(reversedKey)
+	block, err := aes.NewCipher(reversedKey)
+	if err != nil {
+		return nil, err
+	}
+	// maxlen = 2 * floor(log_radix(2^96)).
+	maxLen := 0
+	limit := new(big.Int).Lsh(big.NewInt(1), 96)
+	for power := big.NewInt(int64(radix)); power.Cmp(limit) <= 0; power.Mul(power, big.NewInt(int64(radix))) {
+		maxLen++
+	}
+	return &ff3{block: block, tl: tl, tr: tr, radix: radix, maxLen: 2 * maxLen}, nil
+}
+
+func (c *ff3) encrypt(x []uint16) []uint16 {
+	n := len(x)
+	u := (n + 1) / 2
+	v := n - u
+	a, b := slices.Clone(x[:u]), slices.Clone(x[u:])
+	for i := range ff3Rounds {
+		m, w := u, c.tr
+		if i%2 == 1 {
+			m, w = v, c.tl
+		}
+		p := make([]byte, 0, aes.BlockSize)
+		p = append(p, w[:]...)
+		p[3] ^= byte(i)
+		p = append(p, fixedBytes(numeralsToBig(reversed(b), c.radix), 12)...)
+
+		slices.Reverse(p)
+		c.block.Encrypt(p, p)
+		slices.Reverse(p)
+		y := new(big.Int).SetBytes(p)
+
+		y.Add(y, numeralsToBig(reversed(a), c.radix))
+		a, b = b, reversed(bigToNumerals(y, c.radix, m))
+	}
+	return append(a, b...)
+}
+
+// numeralsToBig returns NUM_radix(x), the number represented by the numerals with the most significant numeral first.
+func numeralsToBig(x []uint16, radix int) *big.Int {
+	r := big.NewInt(int64(radix))
+	result := new(big.Int)
+	for _, numeral := range x {
+		result.Mul(result, r)
+		result.Add(result, big.NewInt(int64(numeral)))
+	}
+	return result
+}
+
+// bigToNumerals returns STR_radix^m(y mod radix^m).
+func bigToNumerals(y *big.Int, radix, m int) []uint16 {
+	r := big.NewInt(int64(radix))
+	y = new(big.Int).Mod(y, new(big.Int).Exp(r, big.NewInt(int64(m)), nil))
+	result := make([]uint16, m)
+	remainder := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		y.DivMod(y, r, remainder)
+		result[i] = uint16(remainder.Int64())
+	}
+	return result
+}
+
+// fixedBytes returns the big-endian representation of x in exactly n bytes.
+func fixedBytes(x *big.Int, n int) []byte {
+	return x.FillBytes(make([]byte, n))
+}
+
+func reversed(x []uint16) []uint16 {
+	y := slices.Clone(x)
+	slices.Reverse(y)
+	return y
+}
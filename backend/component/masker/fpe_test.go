@@ -0,0 +1,203 @@
+package masker
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const fpeTestAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+func toNumerals(s string) []uint16 {
+	var result []uint16
+	for _, r := range s {
+		result = append(result, uint16(strings.IndexRune(fpeTestAlphabet, r)))
+	}
+	return result
+}
+
+func fromNumerals(x []uint16) string {
+	var sb strings.Builder
+	for _, numeral := range x {
+		_ = sb.WriteByte(fpeTestAlphabet[numeral])
+	}
+	return sb.String()
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// The test vectors are the samples of NIST SP 800-38G.
+func TestFF1(t *testing.T) {
+	testCases := []struct {
+		key        string
+		tweak      string
+		radix      int
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "2433477484",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "39383736353433323130",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "6124200773",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "3737373770717273373737",
+			radix:      36,
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "a9tv40mll9kdu509eum",
+		},
+		{
+			key:        "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:      "",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "6657667009",
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := newFF1(mustDecodeHex(t, tc.key), mustDecodeHex(t, tc.tweak), tc.radix)
+		require.NoError(t, err)
+		require.Equal(t, tc.ciphertext, fromNumerals(c.encrypt(toNumerals(tc.plaintext))), tc.plaintext)
+	}
+}
+
+// The test vectors are the FF3 samples of NIST SP 800-38G. FF3-1 shares the same rounds with FF3.
+func TestFF3(t *testing.T) {
+	testCases := []struct {
+		key        string
+		tweak      string
+		radix      int
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			key:        "EF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:      "D8E7920AFA330A73",
+			radix:      10,
+			plaintext:  "890121234567890000",
+			ciphertext: "750918814058654607",
+		},
+		{
+			key:        "EF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:      "9A768A92F60E12D8",
+			radix:      10,
+			plaintext:  "890121234567890000",
+			ciphertext: "018989839189395384",
+		},
+		{
+			key:        "EF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:      "D8E7920AFA330A73",
+			radix:      10,
+			plaintext:  "89012123456789000000789000000",
+			ciphertext: "48598367162252569629397416226",
+		},
+		{
+			key:        "EF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:      "9A768A92F60E12D8",
+			radix:      26,
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "g2pk40i992fn20cjakb",
+		},
+	}
+
+	for _, tc := range testCases {
+		tweak := mustDecodeHex(t, tc.tweak)
+		c, err := newFF3(mustDecodeHex(t, tc.key), [4]byte(tweak[:4]), [4]byte(tweak[4:]), tc.radix)
+		require.NoError(t, err)
+		require.Equal(t, tc.ciphertext, fromNumerals(c.encrypt(toNumerals(tc.plaintext))), tc.plaintext)
+	}
+}
+
+func TestFPEMask(t *testing.T) {
+	a := require.New(t)
+	key := mustDecodeHex(t, "2B7E151628AED2A6ABF7158809CF4F3C")
+	for _, mode := range []storepb.Algorithm_FormatPreservingEncryptionMask_Mode{
+		storepb.Algorithm_FormatPreservingEncryptionMask_FF1,
+		storepb.Algorithm_FormatPreservingEncryptionMask_FF3_1,
+	} {
+		m, err := NewFPEMasker(mode, key, "customer")
+		a.NoError(err)
+		for _, input := range []string{
+			"john.doe@example.com",
+			"+1 (555) 123-4567",
+			"AB-12",
+			"Zoë",
+			strings.Repeat("0123456789", 10),
+		} {
+			masked := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: input}}}).GetStringValue()
+			a.NotEqual(input, masked, input)
+			inputRunes, maskedRunes := []rune(input), []rune(masked)
+			a.Len(maskedRunes, len(inputRunes), input)
+			for i, r := range inputRunes {
+				switch {
+				case r >= '0' && r <= '9':
+					a.True(maskedRunes[i] >= '0' && maskedRunes[i] <= '9', masked)
+				case r >= 'a' && r <= 'z':
+					a.True(maskedRunes[i] >= 'a' && maskedRunes[i] <= 'z', masked)
+				case r >= 'A' && r <= 'Z':
+					a.True(maskedRunes[i] >= 'A' && maskedRunes[i] <= 'Z', masked)
+				case r > 127:
+					a.Equal('*', maskedRunes[i], masked)
+				default:
+					a.Equal(r, maskedRunes[i], masked)
+				}
+			}
+			// The encryption is deterministic.
+			again := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: input}}}).GetStringValue()
+			a.Equal(masked, again)
+		}
+
+		// NULL stays NULL.
+		null := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}
+		a.Equal(null, m.Mask(&MaskData{Data: null}))
+		// Numbers keep the number of digits.
+		masked := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 13800138000}}}).GetStringValue()
+		a.Len(masked, 11)
+	}
+
+	_, err := NewFPEMasker(storepb.Algorithm_FormatPreservingEncryptionMask_FF1, []byte("short"), "")
+	a.Error(err)
+}
+
+func TestTokenizationMask(t *testing.T) {
+	a := require.New(t)
+	m := NewTokenizationMasker([]byte("workspace-key"), "tok_")
+	token := func(value *v1pb.RowValue) string {
+		return m.Mask(&MaskData{Data: value}).GetStringValue()
+	}
+
+	email := token(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "a@example.com"}})
+	a.True(strings.HasPrefix(email, "tok_"))
+	a.Equal(email, token(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "a@example.com"}}))
+	a.NotEqual(email, token(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "b@example.com"}}))
+	// The same value in columns of different types gets the same token.
+	a.Equal(
+		token(&v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}),
+		token(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "42"}}),
+	)
+	// A different key gets different tokens.
+	other := NewTokenizationMasker([]byte("another-key"), "tok_")
+	a.NotEqual(email, other.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "a@example.com"}}}).GetStringValue())
+	a.False(m.Equal(other))
+}
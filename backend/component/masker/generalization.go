@@ -0,0 +1,338 @@
+package masker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+var (
+	minInt32  = big.NewInt(math.MinInt32)
+	maxInt32  = big.NewInt(math.MaxInt32)
+	minInt64  = big.NewInt(math.MinInt64)
+	maxInt64  = big.NewInt(math.MaxInt64)
+	zero      = big.NewInt(0)
+	maxUint32 = big.NewInt(math.MaxUint32)
+	maxUint64 = new(big.Int).SetUint64(math.MaxUint64)
+)
+
+// dateLayouts are the layouts of the dates and timestamps in string values, such as the dates in JSON documents.
+var dateLayouts = []string{
+	time.DateOnly,
+	time.DateTime,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	time.RFC3339Nano,
+}
+
+// DateTruncateMasker is the masker that truncates the dates and timestamps to the year, month, day or hour.
+// Timestamps with time zones are truncated in their own time zones. The values which are not dates are fully masked.
+type DateTruncateMasker struct {
+	granularity storepb.Algorithm_DateTruncateMask_Granularity
+}
+
+// NewDateTruncateMasker returns a new DateTruncateMasker.
+func NewDateTruncateMasker(granularity storepb.Algorithm_DateTruncateMask_Granularity) (*DateTruncateMasker, error) {
+	switch granularity {
+	case storepb.Algorithm_DateTruncateMask_YEAR,
+		storepb.Algorithm_DateTruncateMask_MONTH,
+		storepb.Algorithm_DateTruncateMask_DAY,
+		storepb.Algorithm_DateTruncateMask_HOUR:
+	default:
+		return nil, errors.Errorf("invalid date truncate granularity %v", granularity)
+	}
+	return &DateTruncateMasker{
+		granularity: granularity,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *DateTruncateMasker) Mask(data *MaskData) *v1pb.RowValue {
+	switch kind := data.Data.GetKind().(type) {
+	case nil, *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_TimestampValue:
+		t := m.truncate(kind.TimestampValue.GetGoogleTimestamp().AsTime())
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_TimestampValue{
+				TimestampValue: &v1pb.RowValue_Timestamp{
+					GoogleTimestamp: timestamppb.New(t),
+					Accuracy:        kind.TimestampValue.GetAccuracy(),
+				},
+			},
+		}
+	case *v1pb.RowValue_TimestampTzValue:
+		z := time.FixedZone(kind.TimestampTzValue.GetZone(), int(kind.TimestampTzValue.GetOffset()))
+		t := m.truncate(kind.TimestampTzValue.GetGoogleTimestamp().AsTime().In(z))
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_TimestampTzValue{
+				TimestampTzValue: &v1pb.RowValue_TimestampTZ{
+					GoogleTimestamp: timestamppb.New(t),
+					Zone:            kind.TimestampTzValue.GetZone(),
+					Offset:          kind.TimestampTzValue.GetOffset(),
+					Accuracy:        kind.TimestampTzValue.GetAccuracy(),
+				},
+			},
+		}
+	case *v1pb.RowValue_StringValue:
+		s, ok := m.truncateString(kind.StringValue)
+		if !ok {
+			return NewDefaultFullMasker().Mask(data)
+		}
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: s,
+			},
+		}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: generalizeProtoValue(kind.ValueValue, func(value *structpb.Value) *structpb.Value {
+					if s, ok := m.truncateString(value.GetStringValue()); ok {
+						return structpb.NewStringValue(s)
+					}
+					return structpb.NewStringValue("******")
+				}),
+			},
+		}
+	default:
+		return NewDefaultFullMasker().Mask(data)
+	}
+}
+
+// Equal implements Masker.Equal.
+func (m *DateTruncateMasker) Equal(other Masker) bool {
+	if otherDateTruncateMasker, ok := other.(*DateTruncateMasker); ok {
+		return m.granularity == otherDateTruncateMasker.granularity
+	}
+	return false
+}
+
+func (m *DateTruncateMasker) truncate(t time.Time) time.Time {
+	switch m.granularity {
+	case storepb.Algorithm_DateTruncateMask_YEAR:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	case storepb.Algorithm_DateTruncateMask_MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case storepb.Algorithm_DateTruncateMask_DAY:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	}
+}
+
+// truncateString truncates the date in the string and formats it with the same layout.
+func (m *DateTruncateMasker) truncateString(s string) (string, bool) {
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		return m.truncate(t).Format(layout), true
+	}
+	return "", false
+}
+
+// NumericBucketMasker is the masker that rounds the numbers down to the lower bounds of their buckets.
+// The values which are not numbers are fully masked.
+type NumericBucketMasker struct {
+	bucketSize decimal.Decimal
+}
+
+// NewNumericBucketMasker returns a new NumericBucketMasker.
+func NewNumericBucketMasker(bucketSize float64) (*NumericBucketMasker, error) {
+	if !(bucketSize > 0) || math.IsInf(bucketSize, 0) {
+		return nil, errors.Errorf("bucket size must be positive, got %v", bucketSize)
+	}
+	return &NumericBucketMasker{
+		bucketSize: decimal.NewFromFloat(bucketSize),
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *NumericBucketMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return maskNumeric(data, func(d decimal.Decimal) decimal.Decimal {
+		return d.Div(m.bucketSize).Floor().Mul(m.bucketSize)
+	})
+}
+
+// Equal implements Masker.Equal.
+func (m *NumericBucketMasker) Equal(other Masker) bool {
+	if otherNumericBucketMasker, ok := other.(*NumericBucketMasker); ok {
+		return m.bucketSize.Equal(otherNumericBucketMasker.bucketSize)
+	}
+	return false
+}
+
+// NumericNoiseMasker is the masker that adds bounded noise to the numbers.
+// The noise is derived from the keyed hash of the value, so the same value always gets the same noise,
+// and the noise cannot be averaged out by repeating the query.
+// The values which are not numbers are fully masked.
+type NumericNoiseMasker struct {
+	maxNoise float64
+	key      []byte
+}
+
+// NewNumericNoiseMasker returns a new NumericNoiseMasker.
+func NewNumericNoiseMasker(maxNoise float64, key []byte) (*NumericNoiseMasker, error) {
+	if !(maxNoise > 0) || math.IsInf(maxNoise, 0) {
+		return nil, errors.Errorf("max noise must be positive, got %v", maxNoise)
+	}
+	return &NumericNoiseMasker{
+		maxNoise: maxNoise,
+		key:      key,
+	}, nil
+}
+
+// Mask implements Masker.Mask.
+func (m *NumericNoiseMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return maskNumeric(data, func(d decimal.Decimal) decimal.Decimal {
+		return d.Add(decimal.NewFromFloat(m.noise(d)))
+	})
+}
+
+// noise returns the noise in [-maxNoise, maxNoise) derived from the keyed hash of the value.
+func (m *NumericNoiseMasker) noise(d decimal.Decimal) float64 {
+	h := hmac.New(sha256.New, m.key)
+	// The trailing zeros are removed so that the same number in different scales gets the same noise.
+	_, _ = h.Write([]byte(d.String()))
+	// The top 53 bits fill the mantissa of the float64 in [0, 1).
+	f := float64(binary.BigEndian.Uint64(h.Sum(nil))>>11) / (1 << 53)
+	return (f*2 - 1) * m.maxNoise
+}
+
+// Equal implements Masker.Equal.
+func (m *NumericNoiseMasker) Equal(other Masker) bool {
+	if otherNumericNoiseMasker, ok := other.(*NumericNoiseMasker); ok {
+		return m.maxNoise == otherNumericNoiseMasker.maxNoise && hmac.Equal(m.key, otherNumericNoiseMasker.key)
+	}
+	return false
+}
+
+// maskNumeric applies f to the numeric value and keeps the value type.
+// Integers are rounded to integers, and decimals in strings keep their scale.
+// The values which are not numbers, or the results out of the range of the integer type, are fully masked.
+func maskNumeric(data *MaskData, f func(decimal.Decimal) decimal.Decimal) *v1pb.RowValue {
+	fullMask := func() *v1pb.RowValue {
+		return NewDefaultFullMasker().Mask(data)
+	}
+	switch kind := data.Data.GetKind().(type) {
+	case nil, *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_Int32Value:
+		v, ok := integerResult(f(decimal.NewFromInt32(kind.Int32Value)), minInt32, maxInt32)
+		if !ok {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v.Int64())}}
+	case *v1pb.RowValue_Int64Value:
+		v, ok := integerResult(f(decimal.NewFromInt(kind.Int64Value)), minInt64, maxInt64)
+		if !ok {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v.Int64()}}
+	case *v1pb.RowValue_Uint32Value:
+		v, ok := integerResult(f(decimal.NewFromUint64(uint64(kind.Uint32Value))), zero, maxUint32)
+		if !ok {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v.Uint64())}}
+	case *v1pb.RowValue_Uint64Value:
+		v, ok := integerResult(f(decimal.NewFromUint64(kind.Uint64Value)), zero, maxUint64)
+		if !ok {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: v.Uint64()}}
+	case *v1pb.RowValue_DoubleValue:
+		if math.IsNaN(kind.DoubleValue) || math.IsInf(kind.DoubleValue, 0) {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: f(decimal.NewFromFloat(kind.DoubleValue)).InexactFloat64()}}
+	case *v1pb.RowValue_FloatValue:
+		if math.IsNaN(float64(kind.FloatValue)) || math.IsInf(float64(kind.FloatValue), 0) {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_FloatValue{FloatValue: float32(f(decimal.NewFromFloat32(kind.FloatValue)).InexactFloat64())}}
+	case *v1pb.RowValue_StringValue:
+		s, ok := maskDecimalString(kind.StringValue, f)
+		if !ok {
+			return fullMask()
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: generalizeProtoValue(kind.ValueValue, func(value *structpb.Value) *structpb.Value {
+					switch v := value.GetKind().(type) {
+					case *structpb.Value_NumberValue:
+						if !math.IsNaN(v.NumberValue) && !math.IsInf(v.NumberValue, 0) {
+							return structpb.NewNumberValue(f(decimal.NewFromFloat(v.NumberValue)).InexactFloat64())
+						}
+					case *structpb.Value_StringValue:
+						if s, ok := maskDecimalString(v.StringValue, f); ok {
+							return structpb.NewStringValue(s)
+						}
+					}
+					return structpb.NewStringValue("******")
+				}),
+			},
+		}
+	default:
+		return fullMask()
+	}
+}
+
+// integerResult rounds the result to an integer and checks that it is in [lower, upper].
+func integerResult(d decimal.Decimal, lower, upper *big.Int) (*big.Int, bool) {
+	v := d.Round(0).BigInt()
+	if v.Cmp(lower) < 0 || v.Cmp(upper) > 0 {
+		return nil, false
+	}
+	return v, true
+}
+
+// maskDecimalString applies f to the decimal in the string and keeps the number of digits after the decimal point.
+func maskDecimalString(s string, f func(decimal.Decimal) decimal.Decimal) (string, bool) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return "", false
+	}
+	places := int32(0)
+	if exp := d.Exponent(); exp < 0 {
+		places = -exp
+	}
+	return f(d).StringFixed(places), true
+}
+
+// generalizeProtoValue applies f to the leaf values of the JSON document. NULL values are kept.
+func generalizeProtoValue(value *structpb.Value, f func(*structpb.Value) *structpb.Value) *structpb.Value {
+	switch kind := value.GetKind().(type) {
+	case nil, *structpb.Value_NullValue:
+		return value
+	case *structpb.Value_StructValue:
+		fields := make(map[string]*structpb.Value, len(kind.StructValue.GetFields()))
+		for field, v := range kind.StructValue.GetFields() {
+			fields[field] = generalizeProtoValue(v, f)
+		}
+		return structpb.NewStructValue(&structpb.Struct{Fields: fields})
+	case *structpb.Value_ListValue:
+		values := make([]*structpb.Value, 0, len(kind.ListValue.GetValues()))
+		for _, v := range kind.ListValue.GetValues() {
+			values = append(values, generalizeProtoValue(v, f))
+		}
+		return structpb.NewListValue(&structpb.ListValue{Values: values})
+	default:
+		return f(value)
+	}
+}
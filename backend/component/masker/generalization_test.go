@@ -0,0 +1,196 @@
+package masker
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestDateTruncateMask(t *testing.T) {
+	a := require.New(t)
+	ts := time.Date(2024, time.March, 15, 10, 30, 45, 123000000, time.UTC)
+
+	testCases := []struct {
+		granularity storepb.Algorithm_DateTruncateMask_Granularity
+		input       *v1pb.RowValue
+		want        *v1pb.RowValue
+	}{
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_YEAR,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(ts), Accuracy: 6}}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)), Accuracy: 6}}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_HOUR,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(ts)}}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC))}}},
+		},
+		{
+			// 2024-03-01 02:00:00 UTC is 2024-02-29 18:00:00 in PST, so the month is February.
+			granularity: storepb.Algorithm_DateTruncateMask_MONTH,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampTzValue{TimestampTzValue: &v1pb.RowValue_TimestampTZ{GoogleTimestamp: timestamppb.New(time.Date(2024, time.March, 1, 2, 0, 0, 0, time.UTC)), Zone: "PST", Offset: -8 * 3600}}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampTzValue{TimestampTzValue: &v1pb.RowValue_TimestampTZ{GoogleTimestamp: timestamppb.New(time.Date(2024, time.February, 1, 8, 0, 0, 0, time.UTC)), Zone: "PST", Offset: -8 * 3600}}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_MONTH,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-15"}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-01"}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_DAY,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-15 10:30:45"}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-15 00:00:00"}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_YEAR,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-03-15T10:30:45+08:00"}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "2024-01-01T00:00:00+08:00"}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_YEAR,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "not a date"}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_YEAR,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 20240315}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+		},
+		{
+			granularity: storepb.Algorithm_DateTruncateMask_YEAR,
+			input:       &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}},
+			want:        &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		m, err := NewDateTruncateMasker(tc.granularity)
+		a.NoError(err)
+		got := m.Mask(&MaskData{Data: tc.input})
+		a.Equal(tc.want.String(), got.String(), tc.input.String())
+	}
+
+	_, err := NewDateTruncateMasker(storepb.Algorithm_DateTruncateMask_GRANULARITY_UNSPECIFIED)
+	a.Error(err)
+}
+
+func TestNumericBucketMask(t *testing.T) {
+	a := require.New(t)
+
+	testCases := []struct {
+		bucketSize float64
+		input      *v1pb.RowValue
+		want       *v1pb.RowValue
+	}{
+		{
+			bucketSize: 10,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 37}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 30}},
+		},
+		{
+			bucketSize: 10,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: -37}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: -40}},
+		},
+		{
+			bucketSize: 1000,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 123456}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 123000}},
+		},
+		{
+			bucketSize: 0.5,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 3.14}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 3}},
+		},
+		{
+			bucketSize: 100,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "12345.67"}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "12300.00"}},
+		},
+		{
+			bucketSize: 10,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "abc"}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+		},
+		{
+			// The lower bound of the bucket is out of the range of int32.
+			bucketSize: 1000,
+			input:      &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: -2147483648}},
+			want:       &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+		},
+		{
+			bucketSize: 10,
+			input: &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewNumberValue(42),
+				structpb.NewStringValue("15"),
+				structpb.NewNullValue(),
+			}})}},
+			want: &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewNumberValue(40),
+				structpb.NewStringValue("10"),
+				structpb.NewNullValue(),
+			}})}},
+		},
+	}
+
+	for _, tc := range testCases {
+		m, err := NewNumericBucketMasker(tc.bucketSize)
+		a.NoError(err)
+		got := m.Mask(&MaskData{Data: tc.input})
+		a.Equal(tc.want.String(), got.String(), tc.input.String())
+	}
+
+	for _, bucketSize := range []float64{-1, 0, math.NaN(), math.Inf(1)} {
+		_, err := NewNumericBucketMasker(bucketSize)
+		a.Error(err)
+	}
+}
+
+func TestNumericNoiseMask(t *testing.T) {
+	a := require.New(t)
+	m, err := NewNumericNoiseMasker(5, []byte("key"))
+	a.NoError(err)
+
+	for i := range 100 {
+		got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(100 + i)}}})
+		v, ok := got.Kind.(*v1pb.RowValue_Int64Value)
+		a.True(ok)
+		a.GreaterOrEqual(v.Int64Value, int64(95+i))
+		a.LessOrEqual(v.Int64Value, int64(105+i))
+
+		got = m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 1.5 + float64(i)}}})
+		a.InDelta(1.5+float64(i), got.GetDoubleValue(), 5)
+
+		got = m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: fmt.Sprintf("%d.25", i)}}})
+		a.Regexp(`^-?\d+\.\d{2}$`, got.GetStringValue())
+	}
+
+	// The same value always gets the same noise, so the noise cannot be averaged out by repeated queries.
+	value := &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 1234.5}}
+	first := m.Mask(&MaskData{Data: value}).GetDoubleValue()
+	a.NotEqual(1234.5, first)
+	for range 10 {
+		a.Equal(first, m.Mask(&MaskData{Data: value}).GetDoubleValue())
+	}
+	// The noise depends on the key.
+	other, err := NewNumericNoiseMasker(5, []byte("other"))
+	a.NoError(err)
+	a.NotEqual(first, other.Mask(&MaskData{Data: value}).GetDoubleValue())
+	a.False(m.Equal(other))
+
+	got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}}})
+	a.Equal("******", got.GetStringValue())
+
+	for _, maxNoise := range []float64{-1, 0, math.NaN(), math.Inf(1)} {
+		_, err = NewNumericNoiseMasker(maxNoise, nil)
+		a.Error(err)
+	}
+}
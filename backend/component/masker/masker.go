@@ -558,6 +558,83 @@ func (m *MD5Masker) Equal(other Masker) bool {
 	return false
 }
 
+// maskValueAsString masks the string representation of the value with f and returns a string value.
+// NULL values stay NULL, and the values in JSON documents are masked recursively.
+func maskValueAsString(m Masker, data *MaskData, f func(string) string) *v1pb.RowValue {
+	var s string
+	switch kind := data.Data.GetKind().(type) {
+	case nil, *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_BoolValue:
+		s = strconv.FormatBool(kind.BoolValue)
+	case *v1pb.RowValue_BytesValue:
+		s = string(kind.BytesValue)
+	case *v1pb.RowValue_DoubleValue:
+		s = strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64)
+	case *v1pb.RowValue_FloatValue:
+		s = strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 64)
+	case *v1pb.RowValue_Int32Value:
+		s = strconv.FormatInt(int64(kind.Int32Value), 10)
+	case *v1pb.RowValue_Int64Value:
+		s = strconv.FormatInt(kind.Int64Value, 10)
+	case *v1pb.RowValue_StringValue:
+		s = kind.StringValue
+	case *v1pb.RowValue_Uint32Value:
+		s = strconv.FormatUint(uint64(kind.Uint32Value), 10)
+	case *v1pb.RowValue_Uint64Value:
+		s = strconv.FormatUint(kind.Uint64Value, 10)
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValueAsString(kind.ValueValue, f),
+			},
+		}
+	case *v1pb.RowValue_TimestampValue:
+		s = kind.TimestampValue.GoogleTimestamp.AsTime().Format("2006-01-02 15:04:05.000000")
+	case *v1pb.RowValue_TimestampTzValue:
+		t := kind.TimestampTzValue.GoogleTimestamp.AsTime()
+		z := time.FixedZone(kind.TimestampTzValue.GetZone(), int(kind.TimestampTzValue.GetOffset()))
+		s = t.In(z).Format(time.RFC3339Nano)
+	default:
+		slog.Warn("unsupported value type for masking", slog.String("masker", fmt.Sprintf("%T", m)), slog.String("type", fmt.Sprintf("%T", kind)))
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: "******",
+			},
+		}
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: f(s),
+		},
+	}
+}
+
+func maskProtoValueAsString(value *structpb.Value, f func(string) string) *structpb.Value {
+	switch kind := value.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return structpb.NewStringValue(f(kind.StringValue))
+	case *structpb.Value_NumberValue:
+		return structpb.NewStringValue(f(strconv.FormatFloat(kind.NumberValue, 'f', -1, 64)))
+	case *structpb.Value_BoolValue:
+		return structpb.NewStringValue(f(strconv.FormatBool(kind.BoolValue)))
+	case *structpb.Value_StructValue:
+		fields := make(map[string]*structpb.Value, len(kind.StructValue.GetFields()))
+		for field, v := range kind.StructValue.GetFields() {
+			fields[field] = maskProtoValueAsString(v, f)
+		}
+		return structpb.NewStructValue(&structpb.Struct{Fields: fields})
+	case *structpb.Value_ListValue:
+		values := make([]*structpb.Value, 0, len(kind.ListValue.GetValues()))
+		for _, v := range kind.ListValue.GetValues() {
+			values = append(values, maskProtoValueAsString(v, f))
+		}
+		return structpb.NewListValue(&structpb.ListValue{Values: values})
+	default:
+		return value
+	}
+}
+
 func maskProtoValue(m Masker, value *structpb.Value) *structpb.Value {
 	switch kindValue := value.Kind.(type) {
 	case *structpb.Value_NullValue:
@@ -0,0 +1,581 @@
+package pgonline
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+const (
+	// chunkSize is the number of rows to backfill or replay in a batch.
+	chunkSize = 1000
+	// cutoverLockTimeout is the lock timeout to lock the original table at cutover.
+	cutoverLockTimeout = 3 * time.Second
+	// cutoverRetries is the number of attempts to cut over if the table is busy.
+	cutoverRetries = 10
+	// lockNotAvailable is the SQLSTATE for lock timeout.
+	lockNotAvailable = "55P03"
+)
+
+// Migrator migrates a table online.
+type Migrator struct {
+	db        *sql.DB
+	statement *Statement
+
+	// Names of the objects created for the migration.
+	shadowTable string
+	oldTable    string
+	logTable    string
+	function    string
+	trigger     string
+	keysTable   string
+
+	oid         uint32
+	primaryKey  []column
+	columns     []string
+	foreignKeys []foreignKey
+}
+
+type foreignKey struct {
+	name       string
+	definition string
+}
+
+// NewMigrator creates a migrator for the statement.
+// The id makes the names of the migration objects unique, e.g. the task ID.
+func NewMigrator(db *sql.DB, statement *Statement, id int) *Migrator {
+	return &Migrator{
+		db:          db,
+		statement:   statement,
+		shadowTable: objectName(statement.Table, id, "new"),
+		oldTable:    objectName(statement.Table, id, "old"),
+		logTable:    objectName(statement.Table, id, "log"),
+		function:    objectName(statement.Table, id, "fn"),
+		trigger:     objectName(statement.Table, id, "trg"),
+		keysTable:   objectName(statement.Table, id, "keys"),
+	}
+}
+
+// Run runs the migration.
+// The objects created for the migration are dropped when it finishes, whether it succeeds or not.
+func (m *Migrator) Run(ctx context.Context) error {
+	defer m.cleanup()
+
+	problems, err := Validate(ctx, m.db, m.statement)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return errors.Errorf("cannot migrate table %q.%q online: %s", m.statement.Schema, m.statement.Table, strings.Join(problems, " "))
+	}
+
+	if err := m.prepare(ctx); err != nil {
+		return errors.Wrapf(err, "failed to prepare")
+	}
+	if err := m.backfill(ctx); err != nil {
+		return errors.Wrapf(err, "failed to backfill")
+	}
+	// Catch up with the changes during backfill before locking the table.
+	for {
+		n, err := m.replayInTx(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to replay changes")
+		}
+		if n < chunkSize {
+			break
+		}
+	}
+	if err := m.cutoverWithRetry(ctx); err != nil {
+		return errors.Wrapf(err, "failed to cut over")
+	}
+	m.postCutover(ctx)
+	return nil
+}
+
+// prepare creates the shadow table with the new schema, the log table and the trigger.
+func (m *Migrator) prepare(ctx context.Context) error {
+	if err := m.db.QueryRowContext(ctx, `SELECT $1::regclass::oid`, m.quote(m.statement.Table)).Scan(&m.oid); err != nil {
+		return errors.Wrapf(err, "failed to get table oid")
+	}
+	primaryKey, err := getPrimaryKey(ctx, m.db, m.oid)
+	if err != nil {
+		return err
+	}
+	m.primaryKey = primaryKey
+	foreignKeys, err := m.getForeignKeys(ctx)
+	if err != nil {
+		return err
+	}
+	m.foreignKeys = foreignKeys
+
+	if _, err := m.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING ALL)`, m.quote(m.shadowTable), m.quote(m.statement.Table))); err != nil {
+		return errors.Wrapf(err, "failed to create shadow table")
+	}
+	if _, err := m.db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s %s`, m.quote(m.shadowTable), m.statement.Commands)); err != nil {
+		return errors.Wrapf(err, "failed to alter shadow table")
+	}
+	columns, err := getCommonColumns(ctx, m.db, m.quote(m.shadowTable), m.oid)
+	if err != nil {
+		return err
+	}
+	m.columns = columns
+	columnSet := map[string]bool{}
+	for _, c := range columns {
+		columnSet[c] = true
+	}
+	for _, c := range m.primaryKey {
+		if !columnSet[c.name] {
+			return errors.Errorf("primary key column %q must be kept", c.name)
+		}
+	}
+
+	var logColumns []string
+	for _, c := range m.primaryKey {
+		logColumns = append(logColumns, fmt.Sprintf("%s %s", pgx.Identifier{c.name}.Sanitize(), c.typ))
+	}
+	if _, err := m.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE %s (id bigserial PRIMARY KEY, %s)`, m.quote(m.logTable), strings.Join(logColumns, ", "))); err != nil {
+		return errors.Wrapf(err, "failed to create log table")
+	}
+
+	var oldValues, newValues []string
+	for _, c := range m.primaryKey {
+		oldValues = append(oldValues, "OLD."+pgx.Identifier{c.name}.Sanitize())
+		newValues = append(newValues, "NEW."+pgx.Identifier{c.name}.Sanitize())
+	}
+	keyList := m.primaryKeyList("")
+	if _, err := m.db.ExecContext(ctx, fmt.Sprintf(`CREATE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		INSERT INTO %s (%s) VALUES (%s);
+	END IF;
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO %s (%s) VALUES (%s);
+	END IF;
+	RETURN NULL;
+END
+$$`,
+		m.quote(m.function),
+		m.quote(m.logTable), keyList, strings.Join(oldValues, ", "),
+		m.quote(m.logTable), keyList, strings.Join(newValues, ", "),
+	)); err != nil {
+		return errors.Wrapf(err, "failed to create trigger function")
+	}
+	// Changes after the trigger is created are replayed, so the backfill must start after it.
+	if _, err := m.db.ExecContext(ctx, fmt.Sprintf(`CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE FUNCTION %s()`,
+		pgx.Identifier{m.trigger}.Sanitize(), m.quote(m.statement.Table), m.quote(m.function),
+	)); err != nil {
+		return errors.Wrapf(err, "failed to create trigger")
+	}
+	return nil
+}
+
+// backfill copies the rows of the original table to the shadow table in chunks ordered by the primary key.
+func (m *Migrator) backfill(ctx context.Context) error {
+	columnList := m.columnList("")
+	keyList := m.primaryKeyList("")
+	var keyTexts, keyParams []string
+	for i, c := range m.primaryKey {
+		keyTexts = append(keyTexts, pgx.Identifier{c.name}.Sanitize()+"::text")
+		keyParams = append(keyParams, fmt.Sprintf("$%d::%s", i+1, c.typ))
+	}
+	descKeyList := strings.Join(m.primaryKeyNames(""), " DESC, ") + " DESC"
+	query := func(where string) string {
+		return fmt.Sprintf(`WITH chunk AS (
+	SELECT %s FROM %s %s ORDER BY %s LIMIT %d
+), ins AS (
+	INSERT INTO %s (%s) SELECT %s FROM chunk ON CONFLICT DO NOTHING
+)
+SELECT %s, count(*) OVER () FROM chunk ORDER BY %s LIMIT 1`,
+			columnList, m.quote(m.statement.Table), where, keyList, chunkSize,
+			m.quote(m.shadowTable), columnList, columnList,
+			strings.Join(keyTexts, ", "), descKeyList,
+		)
+	}
+	firstQuery := query("")
+	nextQuery := query(fmt.Sprintf("WHERE (%s) > (%s)", keyList, strings.Join(keyParams, ", ")))
+
+	var lastKey []any
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		q := firstQuery
+		if lastKey != nil {
+			q = nextQuery
+		}
+		keys := make([]sql.NullString, len(m.primaryKey))
+		var count int64
+		dest := make([]any, 0, len(keys)+1)
+		for i := range keys {
+			dest = append(dest, &keys[i])
+		}
+		dest = append(dest, &count)
+		if err := m.db.QueryRowContext(ctx, q, lastKey...).Scan(dest...); err != nil {
+			if err == sql.ErrNoRows {
+				break
+			}
+			return err
+		}
+		total += count
+		lastKey = lastKey[:0]
+		for _, k := range keys {
+			lastKey = append(lastKey, k.String)
+		}
+		if count < chunkSize {
+			break
+		}
+	}
+	slog.Info("pgonline backfilled table", slog.String("table", m.statement.Table), slog.Int64("rows", total))
+	return nil
+}
+
+// replayInTx replays a batch of logged changes in a transaction.
+func (m *Migrator) replayInTx(ctx context.Context) (int64, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	n, err := m.replay(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// replay replays a batch of logged changes in the transaction and returns the number of replayed changes.
+// The rows of the changed keys are deleted from the shadow table and copied again from the original table.
+func (m *Migrator) replay(ctx context.Context, tx *sql.Tx) (int64, error) {
+	keysTable := pgx.Identifier{m.keysTable}.Sanitize()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TEMP TABLE IF NOT EXISTS %s (LIKE %s) ON COMMIT DROP`, keysTable, m.quote(m.logTable))); err != nil {
+		return 0, errors.Wrapf(err, "failed to create keys table")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`TRUNCATE %s`, keysTable)); err != nil {
+		return 0, errors.Wrapf(err, "failed to truncate keys table")
+	}
+	// Take the logged changes and remove them from the log in one statement, so changes logged later are kept for the next batch.
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`WITH d AS (
+	DELETE FROM %s WHERE id IN (SELECT id FROM %s ORDER BY id LIMIT %d) RETURNING *
+)
+INSERT INTO %s SELECT * FROM d`,
+		m.quote(m.logTable), m.quote(m.logTable), chunkSize, keysTable,
+	))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to take logged changes")
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	var conditions []string
+	for _, name := range m.primaryKeyNames("") {
+		conditions = append(conditions, fmt.Sprintf("s.%s = k.%s", name, name))
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s s USING %s k WHERE %s`,
+		m.quote(m.shadowTable), keysTable, strings.Join(conditions, " AND "),
+	)); err != nil {
+		return 0, errors.Wrapf(err, "failed to delete changed rows")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM %s o WHERE (%s) IN (SELECT %s FROM %s)`,
+		m.quote(m.shadowTable), m.columnList(""), m.columnList("o."), m.quote(m.statement.Table),
+		m.primaryKeyList("o."), m.primaryKeyList(""), keysTable,
+	)); err != nil {
+		return 0, errors.Wrapf(err, "failed to copy changed rows")
+	}
+	return n, nil
+}
+
+func (m *Migrator) cutoverWithRetry(ctx context.Context) error {
+	var err error
+	for i := 0; i < cutoverRetries; i++ {
+		err = m.cutover(ctx)
+		var pgErr *pgconn.PgError
+		if err == nil || !errors.As(err, &pgErr) || pgErr.Code != lockNotAvailable {
+			return err
+		}
+		slog.Warn("pgonline cutover timed out to lock the table, retrying", slog.String("table", m.statement.Table), slog.Int("attempt", i+1))
+		// Replay the changes during the attempt so the next attempt holds the lock shorter.
+		if _, err := m.replayInTx(ctx); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// cutover swaps the original table with the shadow table in a transaction holding the exclusive lock of the original table.
+func (m *Migrator) cutover(ctx context.Context) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`SET LOCAL lock_timeout = '%dms'`, cutoverLockTimeout.Milliseconds())); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`LOCK TABLE %s IN ACCESS EXCLUSIVE MODE`, m.quote(m.statement.Table))); err != nil {
+		return err
+	}
+	for {
+		n, err := m.replay(ctx, tx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to replay changes")
+		}
+		if n == 0 {
+			break
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP TRIGGER %s ON %s`, pgx.Identifier{m.trigger}.Sanitize(), m.quote(m.statement.Table))); err != nil {
+		return errors.Wrapf(err, "failed to drop trigger")
+	}
+	if err := m.moveSequences(ctx, tx); err != nil {
+		return err
+	}
+	indexes, err := m.getIndexes(ctx, tx, m.statement.Table)
+	if err != nil {
+		return err
+	}
+	var owner, comment sql.NullString
+	var privileges []string
+	if err := tx.QueryRowContext(ctx, `SELECT pg_get_userbyid(relowner), obj_description(oid, 'pg_class') FROM pg_class WHERE oid = $1`, m.oid).Scan(&owner, &comment); err != nil {
+		return errors.Wrapf(err, "failed to get table owner")
+	}
+	privileges, err = queryStrings(ctx, tx, `
+		SELECT format('GRANT %s ON TABLE %%s TO %s%s',
+			a.privilege_type,
+			CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE quote_ident(pg_get_userbyid(a.grantee)) END,
+			CASE WHEN a.is_grantable THEN ' WITH GRANT OPTION' ELSE '' END)
+		FROM pg_class c, aclexplode(c.relacl) a
+		WHERE c.oid = $1 AND a.grantee <> c.relowner`, m.oid)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get table privileges")
+	}
+
+	for _, statement := range []string{
+		fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, m.quote(m.statement.Table), pgx.Identifier{m.oldTable}.Sanitize()),
+		fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, m.quote(m.shadowTable), pgx.Identifier{m.statement.Table}.Sanitize()),
+		fmt.Sprintf(`DROP TABLE %s`, m.quote(m.oldTable)),
+	} {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+
+	// Restore the names of the indexes, the constraints, and the table properties not copied by CREATE TABLE LIKE.
+	newIndexes, err := m.getIndexes(ctx, tx, m.statement.Table)
+	if err != nil {
+		return err
+	}
+	var statements []string
+	for name, definition := range newIndexes {
+		for oldName, oldDefinition := range indexes {
+			if oldDefinition == definition && oldName != name {
+				statements = append(statements, fmt.Sprintf(`ALTER INDEX %s RENAME TO %s`, m.quote(name), pgx.Identifier{oldName}.Sanitize()))
+				delete(indexes, oldName)
+				break
+			}
+		}
+	}
+	for _, fk := range m.foreignKeys {
+		statements = append(statements, fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT %s %s NOT VALID`, m.quote(m.statement.Table), pgx.Identifier{fk.name}.Sanitize(), fk.definition))
+	}
+	if owner.Valid {
+		statements = append(statements, fmt.Sprintf(`ALTER TABLE %s OWNER TO %s`, m.quote(m.statement.Table), pgx.Identifier{owner.String}.Sanitize()))
+	}
+	for _, privilege := range privileges {
+		statements = append(statements, fmt.Sprintf(privilege, m.quote(m.statement.Table)))
+	}
+	if comment.Valid {
+		statements = append(statements, fmt.Sprintf(`COMMENT ON TABLE %s IS %s`, m.quote(m.statement.Table), quoteLiteral(comment.String)))
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+	return tx.Commit()
+}
+
+// moveSequences keeps the sequences of the original table.
+// Sequences owned by serial columns are moved to the shadow table, and identity sequences of the shadow table continue from the original ones.
+func (m *Migrator) moveSequences(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT s.oid::regclass::text, a.attname, d.deptype
+		FROM pg_depend d
+		JOIN pg_class s ON s.oid = d.objid AND s.relkind = 'S'
+		JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+		WHERE d.classid = 'pg_class'::regclass AND d.refobjid = $1 AND d.deptype IN ('a', 'i')`, m.oid)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sequences")
+	}
+	type sequence struct {
+		name, column, depType string
+	}
+	var sequences []sequence
+	for rows.Next() {
+		var s sequence
+		if err := rows.Scan(&s.name, &s.column, &s.depType); err != nil {
+			rows.Close()
+			return err
+		}
+		sequences = append(sequences, s)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	shadowColumns := map[string]bool{}
+	for _, c := range m.columns {
+		shadowColumns[c] = true
+	}
+	for _, s := range sequences {
+		if !shadowColumns[s.column] {
+			// The column is dropped, and so is its sequence.
+			continue
+		}
+		switch s.depType {
+		case "a":
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf(`ALTER SEQUENCE %s OWNED BY %s.%s`, s.name, m.quote(m.shadowTable), pgx.Identifier{s.column}.Sanitize())); err != nil {
+				return errors.Wrapf(err, "failed to move sequence %s", s.name)
+			}
+		case "i":
+			var shadowSequence sql.NullString
+			if err := tx.QueryRowContext(ctx, `SELECT pg_get_serial_sequence($1, $2)`, m.quote(m.shadowTable), s.column).Scan(&shadowSequence); err != nil {
+				return errors.Wrapf(err, "failed to get identity sequence of column %q", s.column)
+			}
+			if !shadowSequence.Valid {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf(`SELECT setval($1, last_value, is_called) FROM %s`, s.name), shadowSequence.String); err != nil {
+				return errors.Wrapf(err, "failed to set identity sequence of column %q", s.column)
+			}
+		default:
+		}
+	}
+	return nil
+}
+
+// postCutover validates the foreign keys and analyzes the new table.
+// They run without blocking writes, so failures are only logged.
+func (m *Migrator) postCutover(ctx context.Context) {
+	var statements []string
+	for _, fk := range m.foreignKeys {
+		statements = append(statements, fmt.Sprintf(`ALTER TABLE %s VALIDATE CONSTRAINT %s`, m.quote(m.statement.Table), pgx.Identifier{fk.name}.Sanitize()))
+	}
+	statements = append(statements, fmt.Sprintf(`ANALYZE %s`, m.quote(m.statement.Table)))
+	for _, statement := range statements {
+		if _, err := m.db.ExecContext(ctx, statement); err != nil {
+			slog.Warn("pgonline failed to execute post cutover statement", slog.String("statement", statement), log.BBError(err))
+		}
+	}
+}
+
+// cleanup drops the objects created for the migration.
+func (m *Migrator) cleanup() {
+	ctx := context.Background()
+	for _, statement := range []string{
+		fmt.Sprintf(`DROP TRIGGER IF EXISTS %s ON %s`, pgx.Identifier{m.trigger}.Sanitize(), m.quote(m.statement.Table)),
+		fmt.Sprintf(`DROP FUNCTION IF EXISTS %s()`, m.quote(m.function)),
+		fmt.Sprintf(`DROP TABLE IF EXISTS %s`, m.quote(m.logTable)),
+		fmt.Sprintf(`DROP TABLE IF EXISTS %s`, m.quote(m.shadowTable)),
+	} {
+		if _, err := m.db.ExecContext(ctx, statement); err != nil {
+			slog.Warn("pgonline failed to clean up", slog.String("statement", statement), log.BBError(err))
+		}
+	}
+}
+
+func (m *Migrator) getForeignKeys(ctx context.Context) ([]foreignKey, error) {
+	rows, err := m.db.QueryContext(ctx, `SELECT conname, pg_get_constraintdef(oid) FROM pg_constraint WHERE conrelid = $1 AND contype = 'f' ORDER BY conname`, m.oid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get foreign keys")
+	}
+	defer rows.Close()
+	var foreignKeys []foreignKey
+	for rows.Next() {
+		var fk foreignKey
+		if err := rows.Scan(&fk.name, &fk.definition); err != nil {
+			return nil, err
+		}
+		foreignKeys = append(foreignKeys, fk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return foreignKeys, nil
+}
+
+// getIndexes returns the index names of the table to their definitions without the index and table names.
+func (m *Migrator) getIndexes(ctx context.Context, tx *sql.Tx, table string) (map[string]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT c.relname, i.indisunique, pg_get_indexdef(i.indexrelid)
+		FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid
+		WHERE i.indrelid = $1::regclass`, m.quote(table))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get indexes")
+	}
+	defer rows.Close()
+	indexes := map[string]string{}
+	for rows.Next() {
+		var name, definition string
+		var unique bool
+		if err := rows.Scan(&name, &unique, &definition); err != nil {
+			return nil, err
+		}
+		// CREATE [UNIQUE] INDEX name ON schema.table USING method (columns)
+		if i := strings.Index(definition, " USING "); i >= 0 {
+			definition = definition[i:]
+		}
+		indexes[name] = fmt.Sprintf("%t%s", unique, definition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+func (m *Migrator) quote(name string) string {
+	return pgx.Identifier{m.statement.Schema, name}.Sanitize()
+}
+
+func (m *Migrator) primaryKeyNames(prefix string) []string {
+	var names []string
+	for _, c := range m.primaryKey {
+		names = append(names, prefix+pgx.Identifier{c.name}.Sanitize())
+	}
+	return names
+}
+
+func (m *Migrator) primaryKeyList(prefix string) string {
+	return strings.Join(m.primaryKeyNames(prefix), ", ")
+}
+
+func (m *Migrator) columnList(prefix string) string {
+	var names []string
+	for _, c := range m.columns {
+		names = append(names, prefix+pgx.Identifier{c}.Sanitize())
+	}
+	return strings.Join(names, ", ")
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,180 @@
+package pgonline
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common/testcontainer"
+)
+
+func TestMigratorWithTestcontainer(t *testing.T) {
+	ctx := context.Background()
+	container := testcontainer.GetTestPgContainer(ctx, t)
+	defer container.Close(ctx)
+	db := container.GetDB()
+
+	const rows = 5 * chunkSize
+	for _, statement := range []string{
+		`CREATE TABLE orders (id bigint PRIMARY KEY, amount int NOT NULL, note text)`,
+		`CREATE INDEX idx_orders_amount ON orders (amount)`,
+		`COMMENT ON TABLE orders IS 'the orders'`,
+		fmt.Sprintf(`INSERT INTO orders SELECT i, i %% 100, 'n' || i FROM generate_series(1, %d) i`, rows),
+	} {
+		_, err := db.ExecContext(ctx, statement)
+		require.NoError(t, err, statement)
+	}
+	want := map[int64]int64{}
+	for i := int64(1); i <= rows; i++ {
+		want[i] = i % 100
+	}
+
+	// Write to the table during the backfill and the cutover, and keep the expected amounts.
+	// Each write is a single statement, so it's either applied or not if it fails.
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	var writes int
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r := rand.New(rand.NewSource(1))
+		nextID := int64(rows + 1)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			id := r.Int63n(nextID-1) + 1
+			var err error
+			switch r.Intn(3) {
+			case 0:
+				if _, err = db.ExecContext(ctx, `INSERT INTO orders (id, amount) VALUES ($1, $2)`, nextID, nextID%100); err == nil {
+					want[nextID] = nextID % 100
+					nextID++
+				}
+			case 1:
+				if _, err = db.ExecContext(ctx, `UPDATE orders SET amount = amount + 1 WHERE id = $1`, id); err == nil {
+					if amount, ok := want[id]; ok {
+						want[id] = amount + 1
+					}
+				}
+			default:
+				if _, err = db.ExecContext(ctx, `DELETE FROM orders WHERE id = $1`, id); err == nil {
+					delete(want, id)
+				}
+			}
+			if err == nil {
+				writes++
+			}
+		}
+	}()
+
+	statement, err := ParseStatement(`ALTER TABLE orders ALTER COLUMN amount TYPE bigint, ADD COLUMN status text NOT NULL DEFAULT 'new', DROP COLUMN note`)
+	require.NoError(t, err)
+	err = NewMigrator(db, statement, 101).Run(ctx)
+	close(stop)
+	wg.Wait()
+	require.NoError(t, err)
+	require.Positive(t, writes)
+
+	got := map[int64]int64{}
+	r, err := db.QueryContext(ctx, `SELECT id, amount FROM orders`)
+	require.NoError(t, err)
+	defer r.Close()
+	for r.Next() {
+		var id, amount int64
+		require.NoError(t, r.Scan(&id, &amount))
+		got[id] = amount
+	}
+	require.NoError(t, r.Err())
+	require.Equal(t, want, got)
+
+	// The new table has the new schema, and keeps the index names and the properties of the original table.
+	columns, err := queryStrings(ctx, db, `SELECT column_name || ' ' || data_type FROM information_schema.columns WHERE table_name = 'orders' ORDER BY ordinal_position`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"id bigint", "amount bigint", "status text"}, columns)
+	var status string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT DISTINCT status FROM orders`).Scan(&status))
+	require.Equal(t, "new", status)
+	indexes, err := queryStrings(ctx, db, `SELECT indexname FROM pg_indexes WHERE tablename = 'orders' ORDER BY 1`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"idx_orders_amount", "orders_pkey"}, indexes)
+	var comment string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT obj_description('orders'::regclass, 'pg_class')`).Scan(&comment))
+	require.Equal(t, "the orders", comment)
+
+	// The objects created for the migration are dropped.
+	tables, err := queryStrings(ctx, db, `SELECT tablename FROM pg_tables WHERE schemaname = 'public' ORDER BY 1`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"orders"}, tables)
+	triggers, err := queryStrings(ctx, db, `SELECT tgname FROM pg_trigger WHERE tgrelid = 'orders'::regclass AND NOT tgisinternal`)
+	require.NoError(t, err)
+	require.Empty(t, triggers)
+}
+
+func TestValidateWithTestcontainer(t *testing.T) {
+	ctx := context.Background()
+	container := testcontainer.GetTestPgContainer(ctx, t)
+	defer container.Close(ctx)
+	db := container.GetDB()
+
+	for _, statement := range []string{
+		`CREATE TABLE items (id int PRIMARY KEY, code text, quantity int)`,
+		`CREATE TABLE logs (message text)`,
+		`INSERT INTO items VALUES (1, '10', 1)`,
+	} {
+		_, err := db.ExecContext(ctx, statement)
+		require.NoError(t, err, statement)
+	}
+
+	tests := []struct {
+		statement string
+		// problem is the text in the problem, or empty if the table can be migrated.
+		problem string
+	}{
+		{
+			statement: `ALTER TABLE items ALTER COLUMN quantity TYPE bigint, ADD COLUMN price numeric`,
+		},
+		{
+			// The integers are cast to text by assignment.
+			statement: `ALTER TABLE items ALTER COLUMN quantity TYPE text`,
+		},
+		{
+			// There is no assignment cast from text to integer, which requires USING.
+			statement: `ALTER TABLE items ALTER COLUMN code TYPE int`,
+			problem:   "assignment casts",
+		},
+		{
+			statement: `ALTER TABLE items DROP COLUMN missing`,
+			problem:   "The ALTER TABLE commands failed",
+		},
+		{
+			statement: `ALTER TABLE logs ADD COLUMN level int`,
+			problem:   "no primary key",
+		},
+	}
+	for _, tc := range tests {
+		statement, err := ParseStatement(tc.statement)
+		require.NoError(t, err, tc.statement)
+		problems, err := Validate(ctx, db, statement)
+		require.NoError(t, err, tc.statement)
+		if tc.problem == "" {
+			require.Empty(t, problems, tc.statement)
+			continue
+		}
+		require.Contains(t, strings.Join(problems, " "), tc.problem, tc.statement)
+	}
+
+	// The scratch table is rolled back.
+	var scratch sql.NullString
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT to_regclass('public._items_0_check')::text`).Scan(&scratch))
+	require.False(t, scratch.Valid)
+}
@@ -0,0 +1,101 @@
+// Package pgonline runs ALTER TABLE statements on PostgreSQL online with a shadow table.
+//
+// The migration creates a shadow table with the new schema, records the changes to the original table
+// in a log table by triggers, backfills the shadow table in chunks, replays the logged changes,
+// and swaps the tables in a short transaction.
+package pgonline
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/postgresql"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/pg"
+)
+
+// maxIdentifierLength is the maximum length of PostgreSQL identifiers in bytes.
+const maxIdentifierLength = 63
+
+// Statement is the ALTER TABLE statement to run online.
+type Statement struct {
+	Schema string
+	Table  string
+	// Commands is the text of the ALTER TABLE commands, e.g. "ADD COLUMN c int".
+	Commands string
+}
+
+// ParseStatement parses the statement which must be a single ALTER TABLE statement.
+func ParseStatement(statement string) (*Statement, error) {
+	results, err := pg.ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("online migration requires exactly one ALTER TABLE statement, got %d statements", len(results))
+	}
+	result := results[0]
+	listener := &alterTableListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+	if listener.rename {
+		// The rows are copied to the shadow table by the column names, so the renamed columns would lose their values.
+		return nil, errors.New("online migration does not support RENAME")
+	}
+	ctx := listener.ctx
+	if ctx == nil || ctx.TABLE() == nil || ctx.ALL() != nil || ctx.FOREIGN() != nil || ctx.Relation_expr() == nil || ctx.Alter_table_cmds() == nil {
+		return nil, errors.New("online migration only supports ALTER TABLE statements")
+	}
+
+	for _, cmd := range ctx.Alter_table_cmds().AllAlter_table_cmd() {
+		if cmd.Alter_using() != nil {
+			// The shadow table is altered while it's empty, and the rows are copied with the assignment casts.
+			return nil, errors.New("online migration does not support ALTER COLUMN TYPE with USING")
+		}
+	}
+
+	name := pg.NormalizePostgreSQLQualifiedName(ctx.Relation_expr().Qualified_name())
+	s := &Statement{
+		Commands: strings.TrimSpace(result.Tokens.GetTextFromRuleContext(ctx.Alter_table_cmds())),
+	}
+	switch len(name) {
+	case 1:
+		s.Schema, s.Table = "public", name[0]
+	case 2:
+		s.Schema, s.Table = name[0], name[1]
+	default:
+		return nil, errors.Errorf("invalid table name %q", strings.Join(name, "."))
+	}
+	return s, nil
+}
+
+type alterTableListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	ctx    *parser.AltertablestmtContext
+	rename bool
+}
+
+func (l *alterTableListener) EnterRenamestmt(*parser.RenamestmtContext) {
+	l.rename = true
+}
+
+func (l *alterTableListener) EnterAltertablestmt(ctx *parser.AltertablestmtContext) {
+	if l.ctx == nil {
+		l.ctx = ctx
+	}
+}
+
+// objectName returns the name of an object created for the migration of the table, e.g. "_t_101_new".
+// The table name is truncated to fit in the identifier length limit.
+func objectName(table string, id int, suffix string) string {
+	tail := fmt.Sprintf("_%d_%s", id, suffix)
+	limit := maxIdentifierLength - len(tail) - 1
+	for len(table) > limit {
+		_, size := utf8.DecodeLastRuneInString(table)
+		table = table[:len(table)-size]
+	}
+	return "_" + table + tail
+}
@@ -0,0 +1,64 @@
+package pgonline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      *Statement
+		wantErr   bool
+	}{
+		{
+			statement: "ALTER TABLE t ADD COLUMN c int;",
+			want:      &Statement{Schema: "public", Table: "t", Commands: "ADD COLUMN c int"},
+		},
+		{
+			statement: `ALTER TABLE "Sales"."Order" ALTER COLUMN amount TYPE numeric(12, 2), ADD COLUMN note text`,
+			want:      &Statement{Schema: "Sales", Table: "Order", Commands: "ALTER COLUMN amount TYPE numeric(12, 2), ADD COLUMN note text"},
+		},
+		{
+			statement: "ALTER TABLE t ADD COLUMN c int; ALTER TABLE t ADD COLUMN d int;",
+			wantErr:   true,
+		},
+		{
+			statement: "CREATE TABLE t (id int);",
+			wantErr:   true,
+		},
+		{
+			statement: "ALTER INDEX i RENAME TO j;",
+			wantErr:   true,
+		},
+		{
+			statement: "ALTER TABLE t RENAME COLUMN a TO b;",
+			wantErr:   true,
+		},
+		{
+			statement: "ALTER TABLE t ALTER COLUMN a TYPE int USING length(a);",
+			wantErr:   true,
+		},
+	}
+	for _, tc := range tests {
+		got, err := ParseStatement(tc.statement)
+		if tc.wantErr {
+			require.Error(t, err, tc.statement)
+			continue
+		}
+		require.NoError(t, err, tc.statement)
+		require.Equal(t, tc.want, got, tc.statement)
+	}
+}
+
+func TestObjectName(t *testing.T) {
+	a := require.New(t)
+	a.Equal("_orders_101_new", objectName("orders", 101, "new"))
+
+	name := objectName(strings.Repeat("表", 30), 101, "keys")
+	a.LessOrEqual(len(name), maxIdentifierLength)
+	a.True(strings.HasSuffix(name, "_101_keys"))
+	a.True(strings.HasPrefix(name, "_表"))
+}
@@ -0,0 +1,201 @@
+package pgonline
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// Validate checks the preconditions to migrate the table of the statement online.
+// It returns the problems which prevent the migration, or nil if the table can be migrated.
+func Validate(ctx context.Context, db *sql.DB, statement *Statement) ([]string, error) {
+	schema, table := statement.Schema, statement.Table
+	var oid uint32
+	var relkind string
+	var rowSecurity bool
+	if err := db.QueryRowContext(ctx, `
+		SELECT c.oid, c.relkind, c.relrowsecurity
+		FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2`,
+		schema, table,
+	).Scan(&oid, &relkind, &rowSecurity); err != nil {
+		if err == sql.ErrNoRows {
+			return []string{fmt.Sprintf("Table %q.%q does not exist.", schema, table)}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get table %q.%q", schema, table)
+	}
+	if relkind != "r" {
+		return []string{fmt.Sprintf("%q.%q is not an ordinary table. Partitioned tables, views and foreign tables are not supported.", schema, table)}, nil
+	}
+
+	var problems []string
+	primaryKey, err := getPrimaryKey(ctx, db, oid)
+	if err != nil {
+		return nil, err
+	}
+	if len(primaryKey) == 0 {
+		problems = append(problems, "The table has no primary key. The primary key is required to backfill and replay changes.")
+	}
+
+	for _, check := range []struct {
+		query  string
+		format string
+	}{
+		{
+			query:  `SELECT tgname FROM pg_trigger WHERE tgrelid = $1 AND NOT tgisinternal ORDER BY tgname`,
+			format: "The table has triggers %s. They conflict with the triggers to replay changes.",
+		},
+		{
+			query:  `SELECT conrelid::regclass::text || '.' || conname FROM pg_constraint WHERE contype = 'f' AND confrelid = $1 AND conrelid <> $1 ORDER BY 1`,
+			format: "The table is referenced by foreign keys %s. They would keep referencing the original table after cutover.",
+		},
+		{
+			query: `SELECT DISTINCT v.oid::regclass::text
+				FROM pg_depend d
+				JOIN pg_rewrite r ON r.oid = d.objid
+				JOIN pg_class v ON v.oid = r.ev_class
+				WHERE d.classid = 'pg_rewrite'::regclass AND d.refobjid = $1 AND v.oid <> $1
+				ORDER BY 1`,
+			format: "The table is used by views %s. They would keep referencing the original table after cutover.",
+		},
+		{
+			query:  `SELECT inhrelid::regclass::text FROM pg_inherits WHERE inhparent = $1 UNION SELECT inhparent::regclass::text FROM pg_inherits WHERE inhrelid = $1 ORDER BY 1`,
+			format: "The table is in an inheritance hierarchy with %s.",
+		},
+		{
+			query:  `SELECT polname FROM pg_policy WHERE polrelid = $1 ORDER BY polname`,
+			format: "The table has row level security policies %s.",
+		},
+	} {
+		names, err := queryStrings(ctx, db, check.query, oid)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) > 0 {
+			problems = append(problems, fmt.Sprintf(check.format, strings.Join(names, ", ")))
+		}
+	}
+	if rowSecurity {
+		problems = append(problems, "The table has row level security enabled.")
+	}
+	problem, err := checkCommands(ctx, db, statement, oid)
+	if err != nil {
+		return nil, err
+	}
+	if problem != "" {
+		problems = append(problems, problem)
+	}
+	return problems, nil
+}
+
+// checkCommands applies the commands to a scratch copy of the table in a transaction which is rolled back,
+// and checks that the rows of the table can be copied to it.
+// The rows are copied by the column names with the assignment casts, so the type changes without
+// the assignment casts are rejected.
+func checkCommands(ctx context.Context, db *sql.DB, statement *Statement, oid uint32) (string, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	table := pgx.Identifier{statement.Schema, statement.Table}.Sanitize()
+	scratch := pgx.Identifier{statement.Schema, objectName(statement.Table, 0, "check")}.Sanitize()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING ALL)`, scratch, table)); err != nil {
+		return "", errors.Wrapf(err, "failed to create scratch table")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s %s`, scratch, statement.Commands)); err != nil {
+		return fmt.Sprintf("The ALTER TABLE commands failed: %v.", err), nil
+	}
+	columns, err := getCommonColumns(ctx, tx, scratch, oid)
+	if err != nil {
+		return "", err
+	}
+	var names []string
+	for _, c := range columns {
+		names = append(names, pgx.Identifier{c}.Sanitize())
+	}
+	columnList := strings.Join(names, ", ")
+	// EXPLAIN analyzes the INSERT without running it, which fails if a column has no assignment cast to its new type.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`EXPLAIN INSERT INTO %s (%s) SELECT %s FROM %s`, scratch, columnList, columnList, table)); err != nil {
+		return fmt.Sprintf("The rows cannot be copied to the new schema: %v. The changed column types must have assignment casts from the original types.", err), nil
+	}
+	return "", nil
+}
+
+// getCommonColumns returns the columns of the table which are also in the original table, except generated columns.
+func getCommonColumns(ctx context.Context, db queryer, table string, oid uint32) ([]string, error) {
+	return queryStrings(ctx, db, `
+		SELECT s.attname
+		FROM pg_attribute s
+		JOIN pg_attribute o ON o.attrelid = $2 AND o.attname = s.attname AND o.attnum > 0 AND NOT o.attisdropped
+		WHERE s.attrelid = $1::regclass AND s.attnum > 0 AND NOT s.attisdropped AND s.attgenerated = ''
+		ORDER BY s.attnum`,
+		table, oid,
+	)
+}
+
+type column struct {
+	name string
+	// typ is the formatted type, e.g. "character varying(20)".
+	typ string
+}
+
+func getPrimaryKey(ctx context.Context, db queryer, oid uint32) ([]column, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod)
+		FROM pg_index i
+		JOIN LATERAL unnest(i.indkey) WITH ORDINALITY AS k(attnum, ord) ON true
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = k.attnum
+		WHERE i.indrelid = $1 AND i.indisprimary
+		ORDER BY k.ord`,
+		oid,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get primary key")
+	}
+	defer rows.Close()
+	var columns []column
+	for rows.Next() {
+		var c column
+		if err := rows.Scan(&c.name, &c.typ); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+func queryStrings(ctx context.Context, db queryer, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query %q", query)
+	}
+	defer rows.Close()
+	var result []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
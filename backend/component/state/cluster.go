@@ -0,0 +1,159 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Cluster coordinates the replicas sharing the same metadata database in the HA deployment.
+type Cluster interface {
+	// NotifyClusterEvent broadcasts the event to the other replicas.
+	NotifyClusterEvent(ctx context.Context, event *store.ClusterEvent) error
+	// ListenClusterEvents listens to the events broadcast by the other replicas until the context is done.
+	ListenClusterEvents(ctx context.Context, handler func(*store.ClusterEvent))
+	// TryLockClusterResource tries to lock one of the slots of the resource across the replicas.
+	TryLockClusterResource(ctx context.Context, resource string, slots int) (int, bool, error)
+	// UnlockClusterResource unlocks the slot of the resource.
+	UnlockClusterResource(ctx context.Context, resource string, slot int) error
+	// SetClusterLockLostHandler sets the handler of the resources whose locks are lost.
+	SetClusterLockLostHandler(handler func(resources []string))
+}
+
+// TaskRunResource returns the cluster resource of the task run.
+func TaskRunResource(taskRunID int) string {
+	return fmt.Sprintf("task_runs/%d", taskRunID)
+}
+
+// PlanCheckRunResource returns the cluster resource of the plan check run.
+func PlanCheckRunResource(planCheckRunUID int) string {
+	return fmt.Sprintf("plan_check_runs/%d", planCheckRunUID)
+}
+
+// TryClaim claims the ownership of the resource across the replicas, so that only one replica executes it.
+// The claim is not reentrant, so it also serializes the claimants in the same replica.
+// The resource is claimed in the process if the deployment is not HA.
+func (s *State) TryClaim(ctx context.Context, resource string) (bool, error) {
+	if s.cluster == nil {
+		_, loaded := s.localClaims.LoadOrStore(resource, true)
+		return !loaded, nil
+	}
+	_, ok, err := s.cluster.TryLockClusterResource(ctx, resource, 1)
+	return ok, err
+}
+
+// Release releases the ownership of the resource claimed by TryClaim.
+func (s *State) Release(ctx context.Context, resource string) {
+	if s.cluster == nil {
+		s.localClaims.Delete(resource)
+		return
+	}
+	if err := s.cluster.UnlockClusterResource(ctx, resource, 0); err != nil {
+		slog.Error("failed to release cluster resource", slog.String("resource", resource), log.BBError(err))
+	}
+}
+
+// TickleTaskRunScheduler tickles the task run schedulers of all the replicas.
+func (s *State) TickleTaskRunScheduler(ctx context.Context) {
+	s.TaskRunTickleChan <- 0
+	s.notify(ctx, &store.ClusterEvent{Type: store.ClusterEventTickleTaskRunScheduler})
+}
+
+// TicklePlanCheckScheduler tickles the plan check schedulers of all the replicas.
+func (s *State) TicklePlanCheckScheduler(ctx context.Context) {
+	s.PlanCheckTickleChan <- 0
+	s.notify(ctx, &store.ClusterEvent{Type: store.ClusterEventTicklePlanCheckScheduler})
+}
+
+// CancelTaskRuns cancels the running task runs, no matter which replica executes them.
+func (s *State) CancelTaskRuns(ctx context.Context, taskRunIDs []int) {
+	cancelRuns(&s.RunningTaskRunsCancelFunc, taskRunIDs)
+	s.notify(ctx, &store.ClusterEvent{Type: store.ClusterEventCancelTaskRuns, IDs: taskRunIDs})
+}
+
+// CancelPlanCheckRuns cancels the running plan check runs, no matter which replica executes them.
+func (s *State) CancelPlanCheckRuns(ctx context.Context, planCheckRunUIDs []int) {
+	cancelRuns(&s.RunningPlanCheckRunsCancelFunc, planCheckRunUIDs)
+	s.notify(ctx, &store.ClusterEvent{Type: store.ClusterEventCancelPlanCheckRuns, IDs: planCheckRunUIDs})
+}
+
+// ListenCluster handles the events broadcast by the other replicas until the context is done.
+func (s *State) ListenCluster(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if s.cluster == nil {
+		return
+	}
+	slog.Debug("Cluster listener started")
+	s.cluster.ListenClusterEvents(ctx, s.handleClusterEvent)
+}
+
+func (s *State) handleClusterEvent(event *store.ClusterEvent) {
+	switch event.Type {
+	case store.ClusterEventCancelTaskRuns:
+		cancelRuns(&s.RunningTaskRunsCancelFunc, event.IDs)
+	case store.ClusterEventCancelPlanCheckRuns:
+		cancelRuns(&s.RunningPlanCheckRunsCancelFunc, event.IDs)
+	case store.ClusterEventTickleTaskRunScheduler:
+		tickle(s.TaskRunTickleChan)
+	case store.ClusterEventTicklePlanCheckScheduler:
+		tickle(s.PlanCheckTickleChan)
+	default:
+		slog.Warn("unknown cluster event", slog.String("type", string(event.Type)))
+	}
+}
+
+// handleLockLost cancels the task runs and plan check runs whose claims are lost, since they may be executed
+// by the other replicas.
+func (s *State) handleLockLost(resources []string) {
+	var taskRunIDs, planCheckRunUIDs []int
+	for _, resource := range resources {
+		if v, ok := strings.CutPrefix(resource, "task_runs/"); ok {
+			if id, err := strconv.Atoi(v); err == nil {
+				taskRunIDs = append(taskRunIDs, id)
+			}
+		} else if v, ok := strings.CutPrefix(resource, "plan_check_runs/"); ok {
+			if uid, err := strconv.Atoi(v); err == nil {
+				planCheckRunUIDs = append(planCheckRunUIDs, uid)
+			}
+		}
+	}
+	if len(taskRunIDs) > 0 {
+		slog.Warn("cancel the task runs whose claims are lost", slog.Any("ids", taskRunIDs))
+		cancelRuns(&s.RunningTaskRunsCancelFunc, taskRunIDs)
+	}
+	if len(planCheckRunUIDs) > 0 {
+		slog.Warn("cancel the plan check runs whose claims are lost", slog.Any("uids", planCheckRunUIDs))
+		cancelRuns(&s.RunningPlanCheckRunsCancelFunc, planCheckRunUIDs)
+	}
+}
+
+func (s *State) notify(ctx context.Context, event *store.ClusterEvent) {
+	if s.cluster == nil {
+		return
+	}
+	if err := s.cluster.NotifyClusterEvent(ctx, event); err != nil {
+		slog.Warn("failed to notify cluster event", slog.String("type", string(event.Type)), log.BBError(err))
+	}
+}
+
+func cancelRuns(cancelFuncs *sync.Map, ids []int) {
+	for _, id := range ids {
+		if cancelFunc, ok := cancelFuncs.Load(id); ok {
+			cancelFunc.(context.CancelFunc)()
+		}
+	}
+}
+
+// tickle doesn't block the listener if the tickle channel is full, since the scheduler will run anyway.
+func tickle(c chan int) {
+	select {
+	case c <- 0:
+	default:
+	}
+}
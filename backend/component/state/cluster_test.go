@@ -0,0 +1,142 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// fakeCluster mocks the advisory locks shared by the replicas.
+// The locks are not re-entrant, which is guaranteed by the store skipping the slots locked by the replica.
+type fakeCluster struct {
+	sync.Mutex
+	locked      map[string]bool
+	events      []*store.ClusterEvent
+	lostHandler func(resources []string)
+}
+
+func (c *fakeCluster) NotifyClusterEvent(_ context.Context, event *store.ClusterEvent) error {
+	c.Lock()
+	defer c.Unlock()
+	c.events = append(c.events, event)
+	return nil
+}
+
+func (*fakeCluster) ListenClusterEvents(context.Context, func(*store.ClusterEvent)) {}
+
+func (c *fakeCluster) TryLockClusterResource(_ context.Context, resource string, slots int) (int, bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	for slot := 0; slot < slots; slot++ {
+		key := fmt.Sprintf("%s/%d", resource, slot)
+		if !c.locked[key] {
+			c.locked[key] = true
+			return slot, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func (c *fakeCluster) UnlockClusterResource(_ context.Context, resource string, slot int) error {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.locked, fmt.Sprintf("%s/%d", resource, slot))
+	return nil
+}
+
+func (c *fakeCluster) SetClusterLockLostHandler(handler func(resources []string)) {
+	c.lostHandler = handler
+}
+
+func TestClusterResourceLimiter(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	cluster := &fakeCluster{locked: map[string]bool{}}
+	replica1, err := New(cluster)
+	a.NoError(err)
+	replica2, err := New(cluster)
+	a.NoError(err)
+
+	// The limit is shared by the replicas.
+	a.False(replica1.InstanceOutstandingConnections.Increment(ctx, "prod", 2))
+	a.False(replica2.InstanceOutstandingConnections.Increment(ctx, "prod", 2))
+	a.True(replica1.InstanceOutstandingConnections.Increment(ctx, "prod", 2))
+	a.True(replica2.InstanceOutstandingConnections.Increment(ctx, "prod", 2))
+	// The other limiters and keys are not affected.
+	a.False(replica1.RolloutOutstandingTasks.Increment(ctx, "prod", 2))
+	a.False(replica1.InstanceOutstandingConnections.Increment(ctx, "test", 2))
+
+	replica2.InstanceOutstandingConnections.Decrement(ctx, "prod")
+	a.False(replica1.InstanceOutstandingConnections.Increment(ctx, "prod", 2))
+	a.True(replica2.InstanceOutstandingConnections.Increment(ctx, "prod", 2))
+}
+
+func TestClusterClaim(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	cluster := &fakeCluster{locked: map[string]bool{}}
+	replica1, err := New(cluster)
+	a.NoError(err)
+	replica2, err := New(cluster)
+	a.NoError(err)
+
+	claimed, err := replica1.TryClaim(ctx, TaskRunResource(101))
+	a.NoError(err)
+	a.True(claimed)
+	claimed, err = replica2.TryClaim(ctx, TaskRunResource(101))
+	a.NoError(err)
+	a.False(claimed)
+
+	replica1.Release(ctx, TaskRunResource(101))
+	claimed, err = replica2.TryClaim(ctx, TaskRunResource(101))
+	a.NoError(err)
+	a.True(claimed)
+
+	// The task runs executed by the other replicas are canceled by the event.
+	canceled := false
+	replica2.RunningTaskRunsCancelFunc.Store(101, context.CancelFunc(func() { canceled = true }))
+	replica1.CancelTaskRuns(ctx, []int{101})
+	a.Len(cluster.events, 1)
+	replica2.handleClusterEvent(cluster.events[0])
+	a.True(canceled)
+}
+
+func TestClaimWithoutCluster(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s, err := New(nil)
+	a.NoError(err)
+
+	claimed, err := s.TryClaim(ctx, TaskRunResource(101))
+	a.NoError(err)
+	a.True(claimed)
+	// The claim serializes the claimants in the process.
+	claimed, err = s.TryClaim(ctx, TaskRunResource(101))
+	a.NoError(err)
+	a.False(claimed)
+
+	s.Release(ctx, TaskRunResource(101))
+	claimed, err = s.TryClaim(ctx, TaskRunResource(101))
+	a.NoError(err)
+	a.True(claimed)
+}
+
+func TestClusterLockLost(t *testing.T) {
+	a := require.New(t)
+	cluster := &fakeCluster{locked: map[string]bool{}}
+	replica, err := New(cluster)
+	a.NoError(err)
+
+	var canceledTaskRun, canceledPlanCheckRun bool
+	replica.RunningTaskRunsCancelFunc.Store(101, context.CancelFunc(func() { canceledTaskRun = true }))
+	replica.RunningPlanCheckRunsCancelFunc.Store(201, context.CancelFunc(func() { canceledPlanCheckRun = true }))
+	// The task runs and plan check runs whose claims are lost are canceled.
+	cluster.lostHandler([]string{TaskRunResource(101), PlanCheckRunResource(201), "instance_connections/prod"})
+	a.True(canceledTaskRun)
+	a.True(canceledPlanCheckRun)
+}
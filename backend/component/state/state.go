@@ -2,11 +2,15 @@
 package state
 
 import (
+	"context"
+	"log/slog"
 	"sync"
 
 	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
 )
 
 // State is the state for all in-memory states within the server.
@@ -44,30 +48,57 @@ type State struct {
 	TaskRunTickleChan chan int
 
 	ExpireCache *lru.Cache[string, bool]
+
+	// cluster coordinates the replicas in the HA deployment, and it's nil otherwise.
+	cluster Cluster
+	// localClaims is the resources claimed by TryClaim if the deployment is not HA.
+	localClaims sync.Map // map[resource]bool
 }
 
-func New() (*State, error) {
+// New creates the state. The cluster is nil if the deployment is not HA.
+func New(cluster Cluster) (*State, error) {
 	expireCache, err := lru.New[string, bool](128)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create auth expire cache")
 	}
-	return &State{
-		InstanceOutstandingConnections: &resourceLimiter{connections: map[string]int{}},
-		RolloutOutstandingTasks:        &resourceLimiter{connections: map[string]int{}},
+	s := &State{
+		InstanceOutstandingConnections: newResourceLimiter("instance_connections", cluster),
+		RolloutOutstandingTasks:        newResourceLimiter("rollout_tasks", cluster),
 		TaskSkippedOrDoneChan:          make(chan int, 1000),
 		PlanCheckTickleChan:            make(chan int, 1000),
 		TaskRunTickleChan:              make(chan int, 1000),
 		ExpireCache:                    expireCache,
-	}, nil
+		cluster:                        cluster,
+	}
+	if cluster != nil {
+		cluster.SetClusterLockLostHandler(s.handleLockLost)
+	}
+	return s, nil
 }
 
 type resourceLimiter struct {
 	sync.Mutex
 	connections map[string]int
+
+	// name is the prefix of the cluster resources locked by the limiter.
+	name    string
+	cluster Cluster
+	// slots are the slots of the cluster resources locked by the replica.
+	slots map[string][]int
+}
+
+func newResourceLimiter(name string, cluster Cluster) *resourceLimiter {
+	return &resourceLimiter{
+		connections: map[string]int{},
+		name:        name,
+		cluster:     cluster,
+		slots:       map[string][]int{},
+	}
 }
 
 // limit <= 0 means no limit.
-func (c *resourceLimiter) Increment(key string, limit int) bool {
+// In the HA deployment, the limit is shared by all the replicas.
+func (c *resourceLimiter) Increment(ctx context.Context, key string, limit int) bool {
 	c.Lock()
 	defer c.Unlock()
 	if limit <= 0 {
@@ -79,12 +110,34 @@ func (c *resourceLimiter) Increment(key string, limit int) bool {
 	if c.connections[key] >= limit {
 		return true
 	}
+	if c.cluster != nil {
+		slot, ok, err := c.cluster.TryLockClusterResource(ctx, c.name+"/"+key, limit)
+		if err != nil {
+			slog.Error("failed to lock cluster resource", slog.String("limiter", c.name), slog.String("key", key), log.BBError(err))
+			return true
+		}
+		if !ok {
+			return true
+		}
+		c.slots[key] = append(c.slots[key], slot)
+	}
 	c.connections[key]++
 	return false
 }
 
-func (c *resourceLimiter) Decrement(key string) {
+func (c *resourceLimiter) Decrement(ctx context.Context, key string) {
 	c.Lock()
 	defer c.Unlock()
 	c.connections[key]--
+	if slots := c.slots[key]; len(slots) > 0 {
+		slot := slots[len(slots)-1]
+		if len(slots) == 1 {
+			delete(c.slots, key)
+		} else {
+			c.slots[key] = slots[:len(slots)-1]
+		}
+		if err := c.cluster.UnlockClusterResource(ctx, c.name+"/"+key, slot); err != nil {
+			slog.Error("failed to unlock cluster resource", slog.String("limiter", c.name), slog.String("key", key), log.BBError(err))
+		}
+	}
 }
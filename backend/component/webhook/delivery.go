@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// DeliveryLease is how long a delivery is leased while it is being attempted, and its automatic retry is postponed.
+	DeliveryLease = 5 * time.Minute
+	// maxDeliveryAttempts is the number of attempts after which a failed delivery is no longer retried automatically.
+	maxDeliveryAttempts = 6
+	// The interval before the first retry, doubled after each failed attempt.
+	deliveryRetryInitialInterval = 30 * time.Second
+	deliveryRetryMaxInterval     = 1 * time.Hour
+)
+
+// createDelivery records a delivery of the event to the webhook and makes its first attempt.
+func (m *Manager) createDelivery(ctx context.Context, eventType storepb.Activity_Type, webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
+	body, err := json.Marshal(webhook.NewCustomEvent(*webhookCtx))
+	if err != nil {
+		slog.Error("failed to marshal webhook event", slog.String("webhook name", hook.Payload.GetTitle()), log.BBError(err))
+		return
+	}
+	// The request body only records the names and emails of the users, so the IDs are recorded to rebuild the context for retries.
+	payload := &storepb.WebhookDeliveryPayload{
+		RequestBody: string(body),
+		ActorId:     int32(webhookCtx.ActorID),
+	}
+	if webhookCtx.Issue != nil && webhookCtx.Issue.Creator != nil {
+		payload.IssueCreatorId = int32(webhookCtx.Issue.Creator.ID)
+	}
+	// The delivery is leased for the first attempt.
+	nextAttemptAt := time.Now().Add(DeliveryLease)
+	delivery, err := m.store.CreateWebhookDelivery(ctx, &store.WebhookDeliveryMessage{
+		ProjectID:     hook.ProjectID,
+		WebhookID:     hook.ID,
+		EventType:     eventType,
+		Status:        store.WebhookDeliveryPending,
+		Payload:       payload,
+		NextAttemptAt: &nextAttemptAt,
+		LeaseExpireAt: &nextAttemptAt,
+	})
+	if err != nil {
+		slog.Error("failed to create webhook delivery", slog.String("webhook name", hook.Payload.GetTitle()), log.BBError(err))
+		return
+	}
+	if _, err := m.attemptDelivery(ctx, hook, delivery, webhookCtx); err != nil {
+		slog.Error("failed to update webhook delivery", slog.Int64("delivery", delivery.ID), log.BBError(err))
+	}
+}
+
+// RetryDelivery attempts the delivery again with the recorded event, and updates the delivery with the result.
+// The delivery must be leased by the caller with store.ClaimWebhookDeliveries or store.ClaimWebhookDelivery.
+func (m *Manager) RetryDelivery(ctx context.Context, hook *store.ProjectWebhookMessage, delivery *store.WebhookDeliveryMessage) (*store.WebhookDeliveryMessage, error) {
+	webhookCtx, err := m.getWebhookContextFromDelivery(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+	return m.attemptDelivery(ctx, hook, delivery, webhookCtx)
+}
+
+// attemptDelivery posts the event to the webhook once.
+// A failed delivery is scheduled for retry with exponential backoff until it runs out of attempts.
+func (m *Manager) attemptDelivery(ctx context.Context, hook *store.ProjectWebhookMessage, delivery *store.WebhookDeliveryMessage, webhookCtx *webhook.Context) (*store.WebhookDeliveryMessage, error) {
+	result := &webhook.Result{}
+	webhookCtx.URL = hook.Payload.GetUrl()
+	webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
+	webhookCtx.SigningSecret = hook.Payload.GetSigningSecret()
+	webhookCtx.Headers = hook.Payload.GetHeaders()
+	webhookCtx.DeliveryID = delivery.ID
+	webhookCtx.Result = result
+	setting, err := m.store.GetAppIMSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get app im setting", log.BBError(err))
+	} else {
+		webhookCtx.IMSetting = setting
+	}
+
+	start := time.Now()
+	if hook.Payload.GetType() == storepb.ProjectWebhook_CUSTOM {
+		// Post the recorded body as-is, so that the receiver gets the same event on every attempt.
+		err = webhook.PostCustomEvent(*webhookCtx, []byte(delivery.Payload.GetRequestBody()))
+	} else {
+		err = webhook.Post(hook.Payload.GetType(), *webhookCtx)
+	}
+
+	attempts := delivery.Attempts + 1
+	status := store.WebhookDeliverySucceeded
+	update := &store.UpdateWebhookDeliveryMessage{
+		ID:           delivery.ID,
+		Status:       &status,
+		Attempts:     &attempts,
+		ReleaseLease: true,
+		Payload: &storepb.WebhookDeliveryPayload{
+			RequestBody:    delivery.Payload.GetRequestBody(),
+			StatusCode:     int32(result.StatusCode),
+			Latency:        durationpb.New(time.Since(start)),
+			ActorId:        delivery.Payload.GetActorId(),
+			IssueCreatorId: delivery.Payload.GetIssueCreatorId(),
+		},
+	}
+	if err != nil {
+		// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
+		slog.Warn("failed to post webhook event",
+			slog.String("webhook type", hook.Payload.GetType().String()),
+			slog.String("webhook name", hook.Payload.GetTitle()),
+			slog.Int64("delivery", delivery.ID),
+			slog.Int("attempts", attempts),
+			log.BBError(err))
+		update.Payload.Error = err.Error()
+		status = store.WebhookDeliveryFailed
+		if attempts < maxDeliveryAttempts {
+			status = store.WebhookDeliveryPending
+			nextAttemptAt := time.Now().Add(getDeliveryRetryInterval(attempts))
+			update.NextAttemptAt = &nextAttemptAt
+		}
+	}
+	return m.store.UpdateWebhookDelivery(ctx, update)
+}
+
+// getDeliveryRetryInterval returns the interval before retrying a delivery that failed the given number of attempts.
+func getDeliveryRetryInterval(attempts int) time.Duration {
+	interval := deliveryRetryInitialInterval
+	for i := 1; i < attempts && interval < deliveryRetryMaxInterval; i++ {
+		interval *= 2
+	}
+	return min(interval, deliveryRetryMaxInterval)
+}
+
+// getWebhookContextFromDelivery rebuilds the webhook context from the event recorded in the delivery.
+func (m *Manager) getWebhookContextFromDelivery(ctx context.Context, delivery *store.WebhookDeliveryMessage) (*webhook.Context, error) {
+	var event webhook.CustomEvent
+	if err := json.Unmarshal([]byte(delivery.Payload.GetRequestBody()), &event); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal webhook event")
+	}
+	createTime, err := time.Parse(time.RFC3339, event.CreateTime)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse create time %q", event.CreateTime)
+	}
+
+	webhookCtx := &webhook.Context{
+		Level:       event.Level,
+		EventType:   event.Type,
+		Title:       event.Title,
+		TitleZh:     zhTitles[event.Title],
+		Description: event.Description,
+		Link:        event.Link,
+		CreatedTS:   createTime.Unix(),
+		ActorID:     int(delivery.Payload.GetActorId()),
+	}
+	if a := event.Actor; a != nil {
+		webhookCtx.ActorName = a.Name
+		webhookCtx.ActorEmail = a.Email
+	}
+	if p := event.Project; p != nil {
+		webhookCtx.Project = &webhook.Project{
+			Name:  p.Name,
+			Title: p.Title,
+		}
+	}
+	if i := event.Issue; i != nil {
+		webhookCtx.Issue = &webhook.Issue{
+			ID:          i.UID,
+			Name:        i.Title,
+			Status:      i.Status,
+			Type:        i.Type,
+			Description: i.Description,
+			Creator:     &store.UserMessage{},
+		}
+		if c := i.Creator; c != nil {
+			webhookCtx.Issue.Creator = &store.UserMessage{
+				Name:  c.Name,
+				Email: c.Email,
+			}
+		}
+		// The deliveries recorded before the creator ID was recorded fall back to the name and email.
+		if id := delivery.Payload.GetIssueCreatorId(); id != 0 {
+			creator, err := m.store.GetUserByID(ctx, int(id))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get issue creator %d", id)
+			}
+			if creator != nil {
+				webhookCtx.Issue.Creator = creator
+			}
+		}
+	}
+	if r := event.Rollout; r != nil {
+		webhookCtx.Rollout = &webhook.Rollout{
+			UID:   r.UID,
+			Title: r.Title,
+		}
+	}
+	if s := event.Stage; s != nil {
+		webhookCtx.Stage = &webhook.Stage{
+			Name: s.Title,
+		}
+	}
+	if t := event.TaskResult; t != nil {
+		webhookCtx.TaskResult = &webhook.TaskResult{
+			Name:          t.Title,
+			Status:        t.Status,
+			Detail:        t.Detail,
+			SkippedReason: t.SkippedReason,
+		}
+	}
+	// The receivers need the full users to mention them, e.g. the phone for DingTalk.
+	for _, u := range event.MentionUsers {
+		user, err := m.store.GetUserByEmail(ctx, u.Email)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", u.Email)
+		}
+		if user == nil || user.MemberDeleted {
+			continue
+		}
+		webhookCtx.MentionEndUsers = append(webhookCtx.MentionEndUsers, user)
+	}
+	return webhookCtx, nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/email"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// sendEmail sends the event by email to the users concerned with the issue.
+func (m *Manager) sendEmail(ctx context.Context, setting *storepb.EmailSetting, e *Event, webhookCtx webhook.Context) {
+	var to []string
+	for _, user := range m.getEmailRecipients(ctx, e, webhookCtx) {
+		to = append(to, user.Email)
+	}
+	if len(to) == 0 {
+		return
+	}
+
+	message, err := email.NewMessage(webhookCtx, to)
+	if err != nil {
+		slog.Error("failed to render email", slog.String("event", e.Type.String()), log.BBError(err))
+		return
+	}
+	if message == nil {
+		return
+	}
+	if err := common.Retry(ctx, func() error {
+		return email.Send(ctx, setting, message)
+	}); err != nil {
+		// The SMTP server might be misconfigured which is out of our code control, so we just emit a warning
+		slog.Warn("failed to send email",
+			slog.String("event", e.Type.String()),
+			slog.String("title", webhookCtx.Title),
+			log.BBError(err))
+	}
+}
+
+// getEmailRecipients returns the users to notify by email.
+// They are the users to act on the event, e.g. the approvers of the current step, and the issue creator.
+// Task failures also go to the users who approved the issue.
+func (m *Manager) getEmailRecipients(ctx context.Context, e *Event, webhookCtx webhook.Context) []*store.UserMessage {
+	users := webhookCtx.MentionEndUsers
+	switch e.Type {
+	case storepb.Activity_NOTIFY_PIPELINE_ROLLOUT:
+		if e.Issue != nil {
+			users = append(users, e.Issue.Creator)
+		}
+	case storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE:
+		if e.Issue != nil {
+			users = append(users, e.Issue.Creator)
+			for _, approver := range e.Issue.Approval.GetApprovers() {
+				if approver.Status != storepb.IssuePayloadApproval_Approver_APPROVED {
+					continue
+				}
+				user, err := m.store.GetUserByID(ctx, int(approver.PrincipalId))
+				if err != nil {
+					slog.Warn("failed to get approver", slog.Int("id", int(approver.PrincipalId)), log.BBError(err))
+					continue
+				}
+				users = append(users, user)
+			}
+		}
+	default:
+	}
+
+	seen := map[int]bool{}
+	var recipients []*store.UserMessage
+	for _, user := range users {
+		if user == nil || user.Email == "" || user.MemberDeleted || user.Type != storepb.PrincipalType_END_USER {
+			continue
+		}
+		if seen[user.ID] {
+			continue
+		}
+		seen[user.ID] = true
+		recipients = append(recipients, user)
+	}
+	return recipients
+}
@@ -19,6 +19,7 @@ type Event struct {
 	IssueRolloutReady   *EventIssueRolloutReady
 	StageStatusUpdate   *EventStageStatusUpdate
 	TaskRunStatusUpdate *EventTaskRunStatusUpdate
+	GrantExpire         *EventGrantExpire
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -73,7 +74,15 @@ type EventIssueUpdate struct {
 }
 
 type EventIssueApprovalCreate struct {
+	// Approvers are the approvers of the pending approver sets,
+	// in the format of "roles/{role}", "groups/{email}" or "users/{email}".
+	Approvers []string
+}
+
+type EventGrantExpire struct {
 	Role string
+	// Members are the users whose role is revoked.
+	Members []*store.UserMessage
 }
 
 type EventIssueRolloutReady struct {
@@ -13,6 +13,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/email"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -20,6 +21,31 @@ import (
 	"github.com/pkg/errors"
 )
 
+// zhTitles maps the titles of webhook events to their Chinese translations.
+var zhTitles = map[string]string{
+	"Issue created":                "创建工单",
+	"Issue reopened":               "工单重开",
+	"Issue resolved":               "工单完成",
+	"Issue canceled":               "工单取消",
+	"Issue status changed":         "工单状态变更",
+	"Comment created":              "工单新评论",
+	"Changed issue description":    "工单描述变更",
+	"Changed issue name":           "工单标题变更",
+	"Updated issue":                "工单信息变更",
+	"Stage ends":                   "阶段结束",
+	"Task run started":             "任务开始",
+	"Task run is running":          "任务运行中",
+	"Task run completed":           "任务完成",
+	"Task run failed":              "任务失败",
+	"Task run is canceled":         "任务取消",
+	"Task is skipped":              "任务跳过",
+	"Task run status changed":      "任务状态变更",
+	"Issue approved":               "工单审批通过",
+	"Issue is waiting for rollout": "工单待发布",
+	"Issue approval needed":        "工单待审批",
+	"Access grant expired":         "权限授予过期",
+}
+
 // Manager is the webhook manager.
 type Manager struct {
 	store      *store.Store
@@ -51,7 +77,13 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		return
 	}
 
-	if len(webhookList) == 0 {
+	emailSetting, err := m.store.GetEmailSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get email setting", log.BBError(err))
+	}
+	sendEmail := emailSetting.GetEnabled() && email.SupportEvent(e.Type)
+
+	if len(webhookList) == 0 && !sendEmail {
 		return
 	}
 
@@ -63,7 +95,10 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		return
 	}
 	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go m.postWebhookList(ctx, webhookCtx, webhookList)
+	go m.postWebhookList(ctx, e.Type, webhookCtx, webhookList)
+	if sendEmail {
+		go m.sendEmail(context.WithoutCancel(ctx), emailSetting, e, *webhookCtx)
+	}
 }
 
 func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, eventType storepb.Activity_Type) (*webhook.Context, error) {
@@ -77,7 +112,6 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 
 	level := webhook.WebhookInfo
 	title := ""
-	titleZh := ""
 	link := ""
 	if e.Issue != nil {
 		// TODO(steven): Remove the slug dependency when the legacy issue page is removed.
@@ -88,41 +122,32 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 	switch e.Type {
 	case storepb.Activity_ISSUE_CREATE:
 		title = "Issue created"
-		titleZh = "创建工单"
 
 	case storepb.Activity_ISSUE_STATUS_UPDATE:
 		switch e.Issue.Status {
 		case "OPEN":
 			title = "Issue reopened"
-			titleZh = "工单重开"
 		case "DONE":
 			level = webhook.WebhookSuccess
 			title = "Issue resolved"
-			titleZh = "工单完成"
 		case "CANCELED":
 			title = "Issue canceled"
-			titleZh = "工单取消"
 		default:
 			title = "Issue status changed"
-			titleZh = "工单状态变更"
 		}
 
 	case storepb.Activity_ISSUE_COMMENT_CREATE:
 		title = "Comment created"
-		titleZh = "工单新评论"
 
 	case storepb.Activity_ISSUE_FIELD_UPDATE:
 		update := e.IssueUpdate
 		switch update.Path {
 		case "description":
 			title = "Changed issue description"
-			titleZh = "工单描述变更"
 		case "title":
 			title = "Changed issue name"
-			titleZh = "工单标题变更"
 		default:
 			title = "Updated issue"
-			titleZh = "工单信息变更"
 		}
 
 	case storepb.Activity_ISSUE_PIPELINE_STAGE_STATUS_UPDATE:
@@ -135,45 +160,35 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 			link = fmt.Sprintf("%s/projects/%s/issues/%s-%d?stage=%s", setting.ExternalUrl, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID, stageID)
 		}
 		title = "Stage ends"
-		titleZh = "阶段结束"
 
 	case storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE:
 		u := e.TaskRunStatusUpdate
 		switch u.Status {
 		case storepb.TaskRun_PENDING.String():
 			title = "Task run started"
-			titleZh = "任务开始"
 		case storepb.TaskRun_RUNNING.String():
 			title = "Task run is running"
-			titleZh = "任务运行中"
 		case storepb.TaskRun_DONE.String():
 			level = webhook.WebhookSuccess
 			title = "Task run completed"
-			titleZh = "任务完成"
 		case storepb.TaskRun_FAILED.String():
 			level = webhook.WebhookError
 			title = "Task run failed"
-			titleZh = "任务失败"
 		case storepb.TaskRun_CANCELED.String():
 			title = "Task run is canceled"
-			titleZh = "任务取消"
 		case storepb.TaskRun_SKIPPED.String():
 			title = "Task is skipped"
-			titleZh = "任务跳过"
 		default:
 			title = "Task run status changed"
-			titleZh = "任务状态变更"
 		}
 
 	case storepb.Activity_NOTIFY_ISSUE_APPROVED:
 		title = "Issue approved"
-		titleZh = "工单审批通过"
 		mentionUsers = append(mentionUsers, e.Issue.Creator)
 
 	case storepb.Activity_NOTIFY_PIPELINE_ROLLOUT:
 		u := e.IssueRolloutReady
 		title = "Issue is waiting for rollout"
-		titleZh = "工单待发布"
 		var usersGetters []UsersGetter
 		if u.RolloutPolicy.GetAutomatic() {
 			usersGetters = append(usersGetters, getUsersFromUsers(e.Issue.Creator))
@@ -186,15 +201,27 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 		mentionUsers = getUsersForDirectMessage(ctx, e, usersGetters...)
 
 	case storepb.Activity_ISSUE_APPROVAL_NOTIFY:
-		roleWithPrefix := e.IssueApprovalCreate.Role
-
 		title = "Issue approval needed"
-		titleZh = "工单待审批"
 
-		var usersGetter UsersGetter
-		role := strings.TrimPrefix(roleWithPrefix, "roles/")
-		usersGetter = getUsersFromRole(m.store, role, e.Project.ResourceID)
-		mentionUsers = getUsersForDirectMessage(ctx, e, usersGetter)
+		var usersGetters []UsersGetter
+		for _, approver := range e.IssueApprovalCreate.Approvers {
+			switch {
+			case strings.HasPrefix(approver, common.RolePrefix):
+				role := strings.TrimPrefix(approver, common.RolePrefix)
+				usersGetters = append(usersGetters, getUsersFromRole(m.store, role, e.Project.ResourceID))
+			case strings.HasPrefix(approver, common.GroupPrefix):
+				usersGetters = append(usersGetters, getUsersFromGroup(m.store, approver))
+			case strings.HasPrefix(approver, common.UserNamePrefix):
+				usersGetters = append(usersGetters, getUsersFromEmail(m.store, approver))
+			default:
+			}
+		}
+		mentionUsers = getUsersForDirectMessage(ctx, e, usersGetters...)
+
+	case storepb.Activity_NOTIFY_GRANT_EXPIRED:
+		level = webhook.WebhookWarn
+		title = "Access grant expired"
+		mentionUsers = append(mentionUsers, e.GrantExpire.Members...)
 
 	default:
 		// Unsupported event type
@@ -210,9 +237,9 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 
 	webhookCtx = webhook.Context{
 		Level:     level,
-		EventType: string(eventType),
+		EventType: eventType.String(),
 		Title:     title,
-		TitleZh:   titleZh,
+		TitleZh:   zhTitles[title],
 		Issue:     nil,
 		Rollout:   nil,
 		Project: &webhook.Project{
@@ -288,34 +315,12 @@ func getUsersForDirectMessage(ctx context.Context, e *Event, usersGetters ...Use
 	return mentionUsers
 }
 
-func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+func (m *Manager) postWebhookList(ctx context.Context, eventType storepb.Activity_Type, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
 	ctx = context.WithoutCancel(ctx)
-	setting, err := m.store.GetAppIMSetting(ctx)
-	if err != nil {
-		slog.Error("failed to get app im setting", log.BBError(err))
-	} else {
-		webhookCtx.IMSetting = setting
-	}
-
 	for _, hook := range webhookList {
 		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.Payload.GetUrl()
 		webhookCtx.CreatedTS = time.Now().Unix()
-		webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Payload.GetType(), *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				slog.Warn("failed to post webhook event on activity",
-					slog.String("webhook type", hook.Payload.GetType().String()),
-					slog.String("webhook name", hook.Payload.GetTitle()),
-					slog.String("activity type", webhookCtx.EventType),
-					slog.String("title", webhookCtx.Title),
-					log.BBError(err))
-				return
-			}
-		}(&webhookCtx, hook)
+		go m.createDelivery(ctx, eventType, &webhookCtx, hook)
 	}
 }
 
@@ -334,6 +339,29 @@ func getUsersFromRole(s *store.Store, role string, projectID string) UsersGetter
 	}
 }
 
+func getUsersFromGroup(s *store.Store, group string) UsersGetter {
+	return func(ctx context.Context) ([]*store.UserMessage, error) {
+		return utils.GetUsersByMember(ctx, s, group), nil
+	}
+}
+
+func getUsersFromEmail(s *store.Store, user string) UsersGetter {
+	return func(ctx context.Context) ([]*store.UserMessage, error) {
+		email, err := common.GetUserEmail(user)
+		if err != nil {
+			return nil, err
+		}
+		u, err := s.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			return nil, nil
+		}
+		return []*store.UserMessage{u}, nil
+	}
+}
+
 func getUsersFromUsers(users ...*store.UserMessage) UsersGetter {
 	return func(_ context.Context) ([]*store.UserMessage, error) {
 		return users, nil
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -77,6 +78,58 @@ func (IssuePayloadApproval_Approver_Status) EnumDescriptor() ([]byte, []int) {
 	return file_store_approval_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+// Type defines how the approver sets in the step are approved.
+type ApprovalStep_Type int32
+
+const (
+	ApprovalStep_TYPE_UNSPECIFIED ApprovalStep_Type = 0
+	// The approver sets are approved one after another, in order.
+	ApprovalStep_SEQUENTIAL ApprovalStep_Type = 1
+	// The approver sets are approved at the same time.
+	ApprovalStep_PARALLEL ApprovalStep_Type = 2
+)
+
+// Enum value maps for ApprovalStep_Type.
+var (
+	ApprovalStep_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SEQUENTIAL",
+		2: "PARALLEL",
+	}
+	ApprovalStep_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SEQUENTIAL":       1,
+		"PARALLEL":         2,
+	}
+)
+
+func (x ApprovalStep_Type) Enum() *ApprovalStep_Type {
+	p := new(ApprovalStep_Type)
+	*p = x
+	return p
+}
+
+func (x ApprovalStep_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStep_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_approval_proto_enumTypes[1].Descriptor()
+}
+
+func (ApprovalStep_Type) Type() protoreflect.EnumType {
+	return &file_store_approval_proto_enumTypes[1]
+}
+
+func (x ApprovalStep_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStep_Type.Descriptor instead.
+func (ApprovalStep_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{3, 0}
+}
+
 // IssuePayloadApproval records the approval template used and approval history for an issue.
 type IssuePayloadApproval struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -90,9 +143,11 @@ type IssuePayloadApproval struct {
 	// Error message if approval template finding failed.
 	ApprovalFindingError string `protobuf:"bytes,4,opt,name=approval_finding_error,json=approvalFindingError,proto3" json:"approval_finding_error,omitempty"`
 	// The assessed risk level for this issue.
-	RiskLevel     RiskLevel `protobuf:"varint,5,opt,name=risk_level,json=riskLevel,proto3,enum=bytebase.store.RiskLevel" json:"risk_level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RiskLevel RiskLevel `protobuf:"varint,5,opt,name=risk_level,json=riskLevel,proto3,enum=bytebase.store.RiskLevel" json:"risk_level,omitempty"`
+	// The approval requests sent to the external approval nodes.
+	ExternalApprovals []*IssuePayloadApproval_ExternalApproval `protobuf:"bytes,6,rep,name=external_approvals,json=externalApprovals,proto3" json:"external_approvals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *IssuePayloadApproval) Reset() {
@@ -160,6 +215,13 @@ func (x *IssuePayloadApproval) GetRiskLevel() RiskLevel {
 	return RiskLevel_RISK_LEVEL_UNSPECIFIED
 }
 
+func (x *IssuePayloadApproval) GetExternalApprovals() []*IssuePayloadApproval_ExternalApproval {
+	if x != nil {
+		return x.ExternalApprovals
+	}
+	return nil
+}
+
 // ApprovalTemplate defines the approval workflow and requirements for an issue.
 type ApprovalTemplate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -239,7 +301,11 @@ func (x *ApprovalTemplate) GetDescription() string {
 type ApprovalFlow struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of role names that must approve, in order.
-	Roles         []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// An external approval node is referenced as "externalApprovalNodes/{id}".
+	// Ignored if the steps are set.
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// The approval steps that must be approved, in order.
+	Steps         []*ApprovalStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -281,20 +347,88 @@ func (x *ApprovalFlow) GetRoles() []string {
 	return nil
 }
 
+func (x *ApprovalFlow) GetSteps() []*ApprovalStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// ApprovalStep is a step of the approval flow consisting of one or more approver sets.
+type ApprovalStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How the approver sets are approved. Unspecified means sequential.
+	Type ApprovalStep_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.store.ApprovalStep_Type" json:"type,omitempty"`
+	// All approver sets must approve to complete the step.
+	ApproverSets  []*ApprovalStep_ApproverSet `protobuf:"bytes,2,rep,name=approver_sets,json=approverSets,proto3" json:"approver_sets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStep) Reset() {
+	*x = ApprovalStep{}
+	mi := &file_store_approval_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStep) ProtoMessage() {}
+
+func (x *ApprovalStep) ProtoReflect() protoreflect.Message {
+	mi := &file_store_approval_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStep.ProtoReflect.Descriptor instead.
+func (*ApprovalStep) Descriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApprovalStep) GetType() ApprovalStep_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ApprovalStep_TYPE_UNSPECIFIED
+}
+
+func (x *ApprovalStep) GetApproverSets() []*ApprovalStep_ApproverSet {
+	if x != nil {
+		return x.ApproverSets
+	}
+	return nil
+}
+
 // Approver represents a user who can approve or reject an issue.
 type IssuePayloadApproval_Approver struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The current approval status.
 	Status IssuePayloadApproval_Approver_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bytebase.store.IssuePayloadApproval_Approver_Status" json:"status,omitempty"`
 	// The ID of the principal who is the approver.
-	PrincipalId   int32 `protobuf:"varint,2,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	PrincipalId int32 `protobuf:"varint,2,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	// The index of the approval step approved by the approver.
+	// Only used by the approval flows with steps.
+	Step int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	// The index of the approver set in the approval step approved by the approver.
+	// Only used by the approval flows with steps.
+	ApproverSet   int32 `protobuf:"varint,4,opt,name=approver_set,json=approverSet,proto3" json:"approver_set,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IssuePayloadApproval_Approver) Reset() {
 	*x = IssuePayloadApproval_Approver{}
-	mi := &file_store_approval_proto_msgTypes[3]
+	mi := &file_store_approval_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +440,7 @@ func (x *IssuePayloadApproval_Approver) String() string {
 func (*IssuePayloadApproval_Approver) ProtoMessage() {}
 
 func (x *IssuePayloadApproval_Approver) ProtoReflect() protoreflect.Message {
-	mi := &file_store_approval_proto_msgTypes[3]
+	mi := &file_store_approval_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,35 +470,202 @@ func (x *IssuePayloadApproval_Approver) GetPrincipalId() int32 {
 	return 0
 }
 
+func (x *IssuePayloadApproval_Approver) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *IssuePayloadApproval_Approver) GetApproverSet() int32 {
+	if x != nil {
+		return x.ApproverSet
+	}
+	return 0
+}
+
+// ExternalApproval represents a request sent to an external approval node.
+type IssuePayloadApproval_ExternalApproval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The index of the approval step in the approval flow.
+	Step int32 `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	// The ID of the external approval node.
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// The ID of the approval request returned by the external approval service.
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The time when the approval request was sent.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) Reset() {
+	*x = IssuePayloadApproval_ExternalApproval{}
+	mi := &file_store_approval_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePayloadApproval_ExternalApproval) ProtoMessage() {}
+
+func (x *IssuePayloadApproval_ExternalApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_store_approval_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePayloadApproval_ExternalApproval.ProtoReflect.Descriptor instead.
+func (*IssuePayloadApproval_ExternalApproval) Descriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// ApproverSet is a set of approvers of which the required number of approvers must approve.
+type ApprovalStep_ApproverSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The approvers in the format of
+	// "roles/{role}", "groups/{email}", "users/{email}" or "externalApprovalNodes/{id}".
+	Approvers []string `protobuf:"bytes,1,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// The number of the distinct approvers required to approve the set.
+	// Zero means one.
+	RequiredCount int32 `protobuf:"varint,2,opt,name=required_count,json=requiredCount,proto3" json:"required_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStep_ApproverSet) Reset() {
+	*x = ApprovalStep_ApproverSet{}
+	mi := &file_store_approval_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStep_ApproverSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStep_ApproverSet) ProtoMessage() {}
+
+func (x *ApprovalStep_ApproverSet) ProtoReflect() protoreflect.Message {
+	mi := &file_store_approval_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStep_ApproverSet.ProtoReflect.Descriptor instead.
+func (*ApprovalStep_ApproverSet) Descriptor() ([]byte, []int) {
+	return file_store_approval_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ApprovalStep_ApproverSet) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *ApprovalStep_ApproverSet) GetRequiredCount() int32 {
+	if x != nil {
+		return x.RequiredCount
+	}
+	return 0
+}
+
 var File_store_approval_proto protoreflect.FileDescriptor
 
 const file_store_approval_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/approval.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\x9f\x04\n" +
+	"\x14store/approval.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12store/common.proto\"\xda\x06\n" +
 	"\x14IssuePayloadApproval\x12M\n" +
 	"\x11approval_template\x18\x01 \x01(\v2 .bytebase.store.ApprovalTemplateR\x10approvalTemplate\x12K\n" +
 	"\tapprovers\x18\x02 \x03(\v2-.bytebase.store.IssuePayloadApproval.ApproverR\tapprovers\x122\n" +
 	"\x15approval_finding_done\x18\x03 \x01(\bR\x13approvalFindingDone\x124\n" +
 	"\x16approval_finding_error\x18\x04 \x01(\tR\x14approvalFindingError\x128\n" +
 	"\n" +
-	"risk_level\x18\x05 \x01(\x0e2\x19.bytebase.store.RiskLevelR\triskLevel\x1a\xc6\x01\n" +
+	"risk_level\x18\x05 \x01(\x0e2\x19.bytebase.store.RiskLevelR\triskLevel\x12d\n" +
+	"\x12external_approvals\x18\x06 \x03(\v25.bytebase.store.IssuePayloadApproval.ExternalApprovalR\x11externalApprovals\x1a\xfd\x01\n" +
 	"\bApprover\x12L\n" +
 	"\x06status\x18\x01 \x01(\x0e24.bytebase.store.IssuePayloadApproval.Approver.StatusR\x06status\x12!\n" +
-	"\fprincipal_id\x18\x02 \x01(\x05R\vprincipalId\"I\n" +
+	"\fprincipal_id\x18\x02 \x01(\x05R\vprincipalId\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\x05R\x04step\x12!\n" +
+	"\fapprover_set\x18\x04 \x01(\x05R\vapproverSet\"I\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\f\n" +
 	"\bAPPROVED\x10\x02\x12\f\n" +
-	"\bREJECTED\x10\x03\"\x8c\x01\n" +
+	"\bREJECTED\x10\x03\x1a\x9b\x01\n" +
+	"\x10ExternalApproval\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x8c\x01\n" +
 	"\x10ApprovalTemplate\x12\x0e\n" +
 	"\x02id\x18\x04 \x01(\tR\x02id\x120\n" +
 	"\x04flow\x18\x01 \x01(\v2\x1c.bytebase.store.ApprovalFlowR\x04flow\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"$\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"X\n" +
 	"\fApprovalFlow\x12\x14\n" +
-	"\x05roles\x18\x01 \x03(\tR\x05rolesB\x90\x01\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\x122\n" +
+	"\x05steps\x18\x02 \x03(\v2\x1c.bytebase.store.ApprovalStepR\x05steps\"\xa4\x02\n" +
+	"\fApprovalStep\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.bytebase.store.ApprovalStep.TypeR\x04type\x12M\n" +
+	"\rapprover_sets\x18\x02 \x03(\v2(.bytebase.store.ApprovalStep.ApproverSetR\fapproverSets\x1aR\n" +
+	"\vApproverSet\x12\x1c\n" +
+	"\tapprovers\x18\x01 \x03(\tR\tapprovers\x12%\n" +
+	"\x0erequired_count\x18\x02 \x01(\x05R\rrequiredCount\":\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SEQUENTIAL\x10\x01\x12\f\n" +
+	"\bPARALLEL\x10\x02B\x90\x01\n" +
 	"\x12com.bytebase.storeB\rApprovalProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
+// Enum value maps for IssuePayloadApproval_Approver_Status.
 var (
 	file_store_approval_proto_rawDescOnce sync.Once
 	file_store_approval_proto_rawDescData []byte
@@ -377,27 +678,37 @@ func file_store_approval_proto_rawDescGZIP() []byte {
 	return file_store_approval_proto_rawDescData
 }
 
-var file_store_approval_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_approval_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_store_approval_proto_goTypes = []any{
-	(IssuePayloadApproval_Approver_Status)(0), // 0: bytebase.store.IssuePayloadApproval.Approver.Status
-	(*IssuePayloadApproval)(nil),              // 1: bytebase.store.IssuePayloadApproval
-	(*ApprovalTemplate)(nil),                  // 2: bytebase.store.ApprovalTemplate
-	(*ApprovalFlow)(nil),                      // 3: bytebase.store.ApprovalFlow
-	(*IssuePayloadApproval_Approver)(nil),     // 4: bytebase.store.IssuePayloadApproval.Approver
-	(RiskLevel)(0),                            // 5: bytebase.store.RiskLevel
+	(IssuePayloadApproval_Approver_Status)(0),     // 0: bytebase.store.IssuePayloadApproval.Approver.Status
+	(ApprovalStep_Type)(0),                        // 1: bytebase.store.ApprovalStep.Type
+	(*IssuePayloadApproval)(nil),                  // 2: bytebase.store.IssuePayloadApproval
+	(*ApprovalTemplate)(nil),                      // 3: bytebase.store.ApprovalTemplate
+	(*ApprovalFlow)(nil),                          // 4: bytebase.store.ApprovalFlow
+	(*ApprovalStep)(nil),                          // 5: bytebase.store.ApprovalStep
+	(*IssuePayloadApproval_Approver)(nil),         // 6: bytebase.store.IssuePayloadApproval.Approver
+	(*IssuePayloadApproval_ExternalApproval)(nil), // 7: bytebase.store.IssuePayloadApproval.ExternalApproval
+	(*ApprovalStep_ApproverSet)(nil),              // 8: bytebase.store.ApprovalStep.ApproverSet
+	(RiskLevel)(0),                                // 9: bytebase.store.RiskLevel
+	(*timestamppb.Timestamp)(nil),                 // 10: google.protobuf.Timestamp
 }
 var file_store_approval_proto_depIdxs = []int32{
-	2, // 0: bytebase.store.IssuePayloadApproval.approval_template:type_name -> bytebase.store.ApprovalTemplate
-	4, // 1: bytebase.store.IssuePayloadApproval.approvers:type_name -> bytebase.store.IssuePayloadApproval.Approver
-	5, // 2: bytebase.store.IssuePayloadApproval.risk_level:type_name -> bytebase.store.RiskLevel
-	3, // 3: bytebase.store.ApprovalTemplate.flow:type_name -> bytebase.store.ApprovalFlow
-	0, // 4: bytebase.store.IssuePayloadApproval.Approver.status:type_name -> bytebase.store.IssuePayloadApproval.Approver.Status
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	3,  // 0: bytebase.store.IssuePayloadApproval.approval_template:type_name -> bytebase.store.ApprovalTemplate
+	6,  // 1: bytebase.store.IssuePayloadApproval.approvers:type_name -> bytebase.store.IssuePayloadApproval.Approver
+	9,  // 2: bytebase.store.IssuePayloadApproval.risk_level:type_name -> bytebase.store.RiskLevel
+	7,  // 3: bytebase.store.IssuePayloadApproval.external_approvals:type_name -> bytebase.store.IssuePayloadApproval.ExternalApproval
+	4,  // 4: bytebase.store.ApprovalTemplate.flow:type_name -> bytebase.store.ApprovalFlow
+	5,  // 5: bytebase.store.ApprovalFlow.steps:type_name -> bytebase.store.ApprovalStep
+	1,  // 6: bytebase.store.ApprovalStep.type:type_name -> bytebase.store.ApprovalStep.Type
+	8,  // 7: bytebase.store.ApprovalStep.approver_sets:type_name -> bytebase.store.ApprovalStep.ApproverSet
+	0,  // 8: bytebase.store.IssuePayloadApproval.Approver.status:type_name -> bytebase.store.IssuePayloadApproval.Approver.Status
+	10, // 9: bytebase.store.IssuePayloadApproval.ExternalApproval.create_time:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_store_approval_proto_init() }
@@ -411,8 +722,8 @@ func file_store_approval_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_approval_proto_rawDesc), len(file_store_approval_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,6 +16,34 @@ func (x *IssuePayloadApproval_Approver) Equal(y *IssuePayloadApproval_Approver)
 	if x.PrincipalId != y.PrincipalId {
 		return false
 	}
+	if x.Step != y.Step {
+		return false
+	}
+	if x.ApproverSet != y.ApproverSet {
+		return false
+	}
+	return true
+}
+
+func (x *IssuePayloadApproval_ExternalApproval) Equal(y *IssuePayloadApproval_ExternalApproval) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Step != y.Step {
+		return false
+	}
+	if x.NodeId != y.NodeId {
+		return false
+	}
+	if x.RequestId != y.RequestId {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
 	return true
 }
 
@@ -46,6 +74,14 @@ func (x *IssuePayloadApproval) Equal(y *IssuePayloadApproval) bool {
 	if x.RiskLevel != y.RiskLevel {
 		return false
 	}
+	if len(x.ExternalApprovals) != len(y.ExternalApprovals) {
+		return false
+	}
+	for i := 0; i < len(x.ExternalApprovals); i++ {
+		if !x.ExternalApprovals[i].Equal(y.ExternalApprovals[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -86,5 +122,55 @@ func (x *ApprovalFlow) Equal(y *ApprovalFlow) bool {
 			return false
 		}
 	}
+	if len(x.Steps) != len(y.Steps) {
+		return false
+	}
+	for i := 0; i < len(x.Steps); i++ {
+		if !x.Steps[i].Equal(y.Steps[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *ApprovalStep_ApproverSet) Equal(y *ApprovalStep_ApproverSet) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Approvers) != len(y.Approvers) {
+		return false
+	}
+	for i := 0; i < len(x.Approvers); i++ {
+		if x.Approvers[i] != y.Approvers[i] {
+			return false
+		}
+	}
+	if x.RequiredCount != y.RequiredCount {
+		return false
+	}
+	return true
+}
+
+func (x *ApprovalStep) Equal(y *ApprovalStep) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if len(x.ApproverSets) != len(y.ApproverSets) {
+		return false
+	}
+	for i := 0; i < len(x.ApproverSets); i++ {
+		if !x.ApproverSets[i].Equal(y.ApproverSets[i]) {
+			return false
+		}
+	}
 	return true
 }
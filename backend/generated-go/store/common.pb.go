@@ -261,6 +261,8 @@ const (
 	ExportFormat_JSON               ExportFormat = 2
 	ExportFormat_SQL                ExportFormat = 3
 	ExportFormat_XLSX               ExportFormat = 4
+	ExportFormat_PARQUET            ExportFormat = 5
+	ExportFormat_ARROW              ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -271,6 +273,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "ARROW",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -278,6 +282,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"ARROW":              6,
 	}
 )
 
@@ -372,6 +378,8 @@ const (
 	MigrationType_DML MigrationType = 2
 	// Online schema migration using gh-ost tool.
 	MigrationType_GHOST MigrationType = 3
+	// Online schema migration for PostgreSQL using a shadow table.
+	MigrationType_PG_ONLINE MigrationType = 4
 )
 
 // Enum value maps for MigrationType.
@@ -381,12 +389,14 @@ var (
 		1: "DDL",
 		2: "DML",
 		3: "GHOST",
+		4: "PG_ONLINE",
 	}
 	MigrationType_value = map[string]int32{
 		"MIGRATION_TYPE_UNSPECIFIED": 0,
 		"DDL":                        1,
 		"DML":                        2,
 		"GHOST":                      3,
+		"PG_ONLINE":                  4,
 	}
 )
 
@@ -714,23 +724,26 @@ const file_store_common_proto_rawDesc = "" +
 	"\x19MASKING_LEVEL_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04NONE\x10\x01\x12\v\n" +
 	"\aPARTIAL\x10\x02\x12\b\n" +
-	"\x04FULL\x10\x03*L\n" +
+	"\x04FULL\x10\x03*d\n" +
 	"\fExportFormat\x12\x16\n" +
 	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03CSV\x10\x01\x12\b\n" +
 	"\x04JSON\x10\x02\x12\a\n" +
 	"\x03SQL\x10\x03\x12\b\n" +
-	"\x04XLSX\x10\x04*H\n" +
+	"\x04XLSX\x10\x04\x12\v\n" +
+	"\aPARQUET\x10\x05\x12\t\n" +
+	"\x05ARROW\x10\x06*H\n" +
 	"\tRiskLevel\x12\x1a\n" +
 	"\x16RISK_LEVEL_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03LOW\x10\x01\x12\f\n" +
 	"\bMODERATE\x10\x02\x12\b\n" +
-	"\x04HIGH\x10\x03*L\n" +
+	"\x04HIGH\x10\x03*[\n" +
 	"\rMigrationType\x12\x1e\n" +
 	"\x1aMIGRATION_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03DDL\x10\x01\x12\a\n" +
 	"\x03DML\x10\x02\x12\t\n" +
-	"\x05GHOST\x10\x03*V\n" +
+	"\x05GHOST\x10\x03\x12\r\n" +
+	"\tPG_ONLINE\x10\x04*V\n" +
 	"\x10SchemaChangeType\x12\"\n" +
 	"\x1eSCHEMA_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tVERSIONED\x10\x01\x12\x0f\n" +
@@ -233,6 +233,14 @@ func (x *SchemaMetadata) Equal(y *SchemaMetadata) bool {
 	if x.SkipDump != y.SkipDump {
 		return false
 	}
+	if len(x.ChangeStreams) != len(y.ChangeStreams) {
+		return false
+	}
+	for i := 0; i < len(x.ChangeStreams); i++ {
+		if !x.ChangeStreams[i].Equal(y.ChangeStreams[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -490,6 +498,25 @@ func (x *StreamMetadata) Equal(y *StreamMetadata) bool {
 	return true
 }
 
+func (x *ChangeStreamMetadata) Equal(y *ChangeStreamMetadata) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.ForClause != y.ForClause {
+		return false
+	}
+	if x.Options != y.Options {
+		return false
+	}
+	return true
+}
+
 func (x *TableMetadata) Equal(y *TableMetadata) bool {
 	if x == y {
 		return true
@@ -614,6 +641,26 @@ func (x *TableMetadata) Equal(y *TableMetadata) bool {
 			return false
 		}
 	}
+	if x.Ttl != y.Ttl {
+		return false
+	}
+	if len(x.ClusteringKeys) != len(y.ClusteringKeys) {
+		return false
+	}
+	for i := 0; i < len(x.ClusteringKeys); i++ {
+		if x.ClusteringKeys[i] != y.ClusteringKeys[i] {
+			return false
+		}
+	}
+	if x.Transient != y.Transient {
+		return false
+	}
+	if x.InterleaveParent != y.InterleaveParent {
+		return false
+	}
+	if x.InterleaveOnDelete != y.InterleaveOnDelete {
+		return false
+	}
 	return true
 }
 
@@ -31,6 +31,7 @@ const (
 	PlanCheckRunConfig_DML                              PlanCheckRunConfig_ChangeDatabaseType = 2
 	PlanCheckRunConfig_SDL                              PlanCheckRunConfig_ChangeDatabaseType = 3
 	PlanCheckRunConfig_DDL_GHOST                        PlanCheckRunConfig_ChangeDatabaseType = 4
+	PlanCheckRunConfig_DDL_PG_ONLINE                    PlanCheckRunConfig_ChangeDatabaseType = 5
 )
 
 // Enum value maps for PlanCheckRunConfig_ChangeDatabaseType.
@@ -41,6 +42,7 @@ var (
 		2: "DML",
 		3: "SDL",
 		4: "DDL_GHOST",
+		5: "DDL_PG_ONLINE",
 	}
 	PlanCheckRunConfig_ChangeDatabaseType_value = map[string]int32{
 		"CHANGE_DATABASE_TYPE_UNSPECIFIED": 0,
@@ -48,6 +50,7 @@ var (
 		"DML":                              2,
 		"SDL":                              3,
 		"DDL_GHOST":                        4,
+		"DDL_PG_ONLINE":                    5,
 	}
 )
 
@@ -447,7 +450,7 @@ var File_store_plan_check_run_proto protoreflect.FileDescriptor
 
 const file_store_plan_check_run_proto_rawDesc = "" +
 	"\n" +
-	"\x1astore/plan_check_run.proto\x12\x0ebytebase.store\x1a\x12store/advice.proto\x1a\x15store/changelog.proto\x1a\x12store/common.proto\"\x8a\x04\n" +
+	"\x1astore/plan_check_run.proto\x12\x0ebytebase.store\x1a\x12store/advice.proto\x1a\x15store/changelog.proto\x1a\x12store/common.proto\"\x9d\x04\n" +
 	"\x12PlanCheckRunConfig\x12\x1b\n" +
 	"\tsheet_uid\x18\x01 \x01(\x05R\bsheetUid\x12g\n" +
 	"\x14change_database_type\x18\x02 \x01(\x0e25.bytebase.store.PlanCheckRunConfig.ChangeDatabaseTypeR\x12changeDatabaseType\x12\x1f\n" +
@@ -459,13 +462,14 @@ const file_store_plan_check_run_proto_rawDesc = "" +
 	"\x13enable_prior_backup\x18\a \x01(\bR\x11enablePriorBackup\x1a=\n" +
 	"\x0fGhostFlagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"w\n" +
 	"\x12ChangeDatabaseType\x12$\n" +
 	" CHANGE_DATABASE_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03DDL\x10\x01\x12\a\n" +
 	"\x03DML\x10\x02\x12\a\n" +
 	"\x03SDL\x10\x03\x12\r\n" +
-	"\tDDL_GHOST\x10\x04\"\xb6\x06\n" +
+	"\tDDL_GHOST\x10\x04\x12\x11\n" +
+	"\rDDL_PG_ONLINE\x10\x05\"\xb6\x06\n" +
 	"\x12PlanCheckRunResult\x12C\n" +
 	"\aresults\x18\x01 \x03(\v2).bytebase.store.PlanCheckRunResult.ResultR\aresults\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x1a\xc4\x05\n" +
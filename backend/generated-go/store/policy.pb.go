@@ -75,14 +75,16 @@ func (SQLReviewRuleLevel) EnumDescriptor() ([]byte, []int) {
 type Policy_Type int32
 
 const (
-	Policy_TYPE_UNSPECIFIED  Policy_Type = 0
-	Policy_ROLLOUT           Policy_Type = 1
-	Policy_MASKING_EXCEPTION Policy_Type = 2
-	Policy_QUERY_DATA        Policy_Type = 5
-	Policy_MASKING_RULE      Policy_Type = 6
-	Policy_IAM               Policy_Type = 8
-	Policy_TAG               Policy_Type = 9
-	Policy_DATA_SOURCE_QUERY Policy_Type = 10
+	Policy_TYPE_UNSPECIFIED   Policy_Type = 0
+	Policy_ROLLOUT            Policy_Type = 1
+	Policy_MASKING_EXCEPTION  Policy_Type = 2
+	Policy_QUERY_DATA         Policy_Type = 5
+	Policy_MASKING_RULE       Policy_Type = 6
+	Policy_IAM                Policy_Type = 8
+	Policy_TAG                Policy_Type = 9
+	Policy_DATA_SOURCE_QUERY  Policy_Type = 10
+	Policy_MAINTENANCE_WINDOW Policy_Type = 11
+	Policy_ROW_FILTER         Policy_Type = 12
 )
 
 // Enum value maps for Policy_Type.
@@ -96,16 +98,20 @@ var (
 		8:  "IAM",
 		9:  "TAG",
 		10: "DATA_SOURCE_QUERY",
+		11: "MAINTENANCE_WINDOW",
+		12: "ROW_FILTER",
 	}
 	Policy_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED":  0,
-		"ROLLOUT":           1,
-		"MASKING_EXCEPTION": 2,
-		"QUERY_DATA":        5,
-		"MASKING_RULE":      6,
-		"IAM":               8,
-		"TAG":               9,
-		"DATA_SOURCE_QUERY": 10,
+		"TYPE_UNSPECIFIED":   0,
+		"ROLLOUT":            1,
+		"MASKING_EXCEPTION":  2,
+		"QUERY_DATA":         5,
+		"MASKING_RULE":       6,
+		"IAM":                8,
+		"TAG":                9,
+		"DATA_SOURCE_QUERY":  10,
+		"MAINTENANCE_WINDOW": 11,
+		"ROW_FILTER":         12,
 	}
 )
 
@@ -1012,6 +1018,107 @@ func (x *DataSourceQueryPolicy) GetDisallowDml() bool {
 	return false
 }
 
+// MaintenanceWindowPolicy restricts task runs in an environment to recurring maintenance windows.
+type MaintenanceWindowPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Task runs can only start within one of the windows.
+	Windows []*MaintenanceWindowPolicy_Window `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	// The IANA time zone of the cron expressions, e.g. "Asia/Shanghai". Defaults to UTC.
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindowPolicy) Reset() {
+	*x = MaintenanceWindowPolicy{}
+	mi := &file_store_policy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindowPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindowPolicy) ProtoMessage() {}
+
+func (x *MaintenanceWindowPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindowPolicy.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindowPolicy) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MaintenanceWindowPolicy) GetWindows() []*MaintenanceWindowPolicy_Window {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *MaintenanceWindowPolicy) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// RowFilterPolicy restricts the rows that the principals can read from the tables in the SQL editor.
+type RowFilterPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The filters on the same table are combined with AND.
+	Filters       []*RowFilterPolicy_RowFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilterPolicy) Reset() {
+	*x = RowFilterPolicy{}
+	mi := &file_store_policy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilterPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilterPolicy) ProtoMessage() {}
+
+func (x *RowFilterPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilterPolicy.ProtoReflect.Descriptor instead.
+func (*RowFilterPolicy) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RowFilterPolicy) GetFilters() []*RowFilterPolicy_RowFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type RolloutPolicy_Checkers struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Whether issue approval is required before proceeding with rollout.
@@ -1024,7 +1131,7 @@ type RolloutPolicy_Checkers struct {
 
 func (x *RolloutPolicy_Checkers) Reset() {
 	*x = RolloutPolicy_Checkers{}
-	mi := &file_store_policy_proto_msgTypes[11]
+	mi := &file_store_policy_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1036,7 +1143,7 @@ func (x *RolloutPolicy_Checkers) String() string {
 func (*RolloutPolicy_Checkers) ProtoMessage() {}
 
 func (x *RolloutPolicy_Checkers) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[11]
+	mi := &file_store_policy_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1183,7 @@ type RolloutPolicy_Checkers_RequiredStatusChecks struct {
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) Reset() {
 	*x = RolloutPolicy_Checkers_RequiredStatusChecks{}
-	mi := &file_store_policy_proto_msgTypes[12]
+	mi := &file_store_policy_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1195,7 @@ func (x *RolloutPolicy_Checkers_RequiredStatusChecks) String() string {
 func (*RolloutPolicy_Checkers_RequiredStatusChecks) ProtoMessage() {}
 
 func (x *RolloutPolicy_Checkers_RequiredStatusChecks) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[12]
+	mi := &file_store_policy_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1234,7 @@ type MaskingExceptionPolicy_MaskingException struct {
 
 func (x *MaskingExceptionPolicy_MaskingException) Reset() {
 	*x = MaskingExceptionPolicy_MaskingException{}
-	mi := &file_store_policy_proto_msgTypes[13]
+	mi := &file_store_policy_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1139,7 +1246,7 @@ func (x *MaskingExceptionPolicy_MaskingException) String() string {
 func (*MaskingExceptionPolicy_MaskingException) ProtoMessage() {}
 
 func (x *MaskingExceptionPolicy_MaskingException) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[13]
+	mi := &file_store_policy_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1295,7 @@ type MaskingRulePolicy_MaskingRule struct {
 
 func (x *MaskingRulePolicy_MaskingRule) Reset() {
 	*x = MaskingRulePolicy_MaskingRule{}
-	mi := &file_store_policy_proto_msgTypes[14]
+	mi := &file_store_policy_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1200,7 +1307,7 @@ func (x *MaskingRulePolicy_MaskingRule) String() string {
 func (*MaskingRulePolicy_MaskingRule) ProtoMessage() {}
 
 func (x *MaskingRulePolicy_MaskingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[14]
+	mi := &file_store_policy_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,12 +1344,149 @@ func (x *MaskingRulePolicy_MaskingRule) GetSemanticType() string {
 	return ""
 }
 
+type MaintenanceWindowPolicy_Window struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The cron expression of the window start in the 5-field format "minute hour day-of-month month day-of-week".
+	// For example, "0 2 * * 2,4" starts the window at 02:00 on Tuesday and Thursday.
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// The length of the window.
+	Duration      *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindowPolicy_Window) Reset() {
+	*x = MaintenanceWindowPolicy_Window{}
+	mi := &file_store_policy_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindowPolicy_Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindowPolicy_Window) ProtoMessage() {}
+
+func (x *MaintenanceWindowPolicy_Window) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindowPolicy_Window.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindowPolicy_Window) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *MaintenanceWindowPolicy_Window) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *MaintenanceWindowPolicy_Window) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type RowFilterPolicy_RowFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database of the table, which must be in the project of the policy.
+	// Format: instances/{instance}/databases/{database}
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// The schema of the table. Empty for the engines without schemas.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The CEL condition over the principal attributes, e.g. "'finance@example.com' in principal.groups".
+	// The filter applies to the principals matching the condition, or to all principals if the condition is empty.
+	Condition *expr.Expr `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The SQL predicate that the readable rows must satisfy, e.g. "region = 'us'".
+	Predicate     string `protobuf:"bytes,5,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilterPolicy_RowFilter) Reset() {
+	*x = RowFilterPolicy_RowFilter{}
+	mi := &file_store_policy_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilterPolicy_RowFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilterPolicy_RowFilter) ProtoMessage() {}
+
+func (x *RowFilterPolicy_RowFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilterPolicy_RowFilter.ProtoReflect.Descriptor instead.
+func (*RowFilterPolicy_RowFilter) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *RowFilterPolicy_RowFilter) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetCondition() *expr.Expr {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
 var File_store_policy_proto protoreflect.FileDescriptor
 
 const file_store_policy_proto_rawDesc = "" +
 	"\n" +
-	"\x12store/policy.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x12store/common.proto\"\xe9\x01\n" +
-	"\x06Policy\"\x8b\x01\n" +
+	"\x12store/policy.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x12store/common.proto\"\x91\x02\n" +
+	"\x06Policy\"\xb3\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aROLLOUT\x10\x01\x12\x15\n" +
@@ -1253,7 +1497,10 @@ const file_store_policy_proto_rawDesc = "" +
 	"\x03IAM\x10\b\x12\a\n" +
 	"\x03TAG\x10\t\x12\x15\n" +
 	"\x11DATA_SOURCE_QUERY\x10\n" +
-	"\"Q\n" +
+	"\x12\x16\n" +
+	"\x12MAINTENANCE_WINDOW\x10\v\x12\x0e\n" +
+	"\n" +
+	"ROW_FILTER\x10\f\"Q\n" +
 	"\bResource\x12\x18\n" +
 	"\x14RESOURCE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\x0f\n" +
@@ -1328,7 +1575,21 @@ const file_store_policy_proto_rawDesc = "" +
 	"\vRestriction\x12\x1b\n" +
 	"\x17RESTRICTION_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bFALLBACK\x10\x01\x12\f\n" +
-	"\bDISALLOW\x10\x02*C\n" +
+	"\bDISALLOW\x10\x02\"\xd5\x01\n" +
+	"\x17MaintenanceWindowPolicy\x12H\n" +
+	"\awindows\x18\x01 \x03(\v2..bytebase.store.MaintenanceWindowPolicy.WindowR\awindows\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x1aS\n" +
+	"\x06Window\x12\x12\n" +
+	"\x04cron\x18\x01 \x01(\tR\x04cron\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\"\xfd\x01\n" +
+	"\x0fRowFilterPolicy\x12C\n" +
+	"\afilters\x18\x01 \x03(\v2).bytebase.store.RowFilterPolicy.RowFilterR\afilters\x1a\xa4\x01\n" +
+	"\tRowFilter\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x12/\n" +
+	"\tcondition\x18\x04 \x01(\v2\x11.google.type.ExprR\tcondition\x12\x1c\n" +
+	"\tpredicate\x18\x05 \x01(\tR\tpredicate*C\n" +
 	"\x12SQLReviewRuleLevel\x12\x15\n" +
 	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ERROR\x10\x01\x12\v\n" +
@@ -1348,7 +1609,7 @@ func file_store_policy_proto_rawDescGZIP() []byte {
 }
 
 var file_store_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_store_policy_proto_goTypes = []any{
 	(SQLReviewRuleLevel)(0),                             // 0: bytebase.store.SQLReviewRuleLevel
 	(Policy_Type)(0),                                    // 1: bytebase.store.Policy.Type
@@ -1368,37 +1629,45 @@ var file_store_policy_proto_goTypes = []any{
 	(*EnvironmentTierPolicy)(nil),                       // 15: bytebase.store.EnvironmentTierPolicy
 	(*QueryDataPolicy)(nil),                             // 16: bytebase.store.QueryDataPolicy
 	(*DataSourceQueryPolicy)(nil),                       // 17: bytebase.store.DataSourceQueryPolicy
-	(*RolloutPolicy_Checkers)(nil),                      // 18: bytebase.store.RolloutPolicy.Checkers
-	(*RolloutPolicy_Checkers_RequiredStatusChecks)(nil), // 19: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
-	(*MaskingExceptionPolicy_MaskingException)(nil),     // 20: bytebase.store.MaskingExceptionPolicy.MaskingException
-	(*MaskingRulePolicy_MaskingRule)(nil),               // 21: bytebase.store.MaskingRulePolicy.MaskingRule
-	nil,                                                 // 22: bytebase.store.TagPolicy.TagsEntry
-	(Engine)(0),                                         // 23: bytebase.store.Engine
-	(*expr.Expr)(nil),                                   // 24: google.type.Expr
-	(*durationpb.Duration)(nil),                         // 25: google.protobuf.Duration
+	(*MaintenanceWindowPolicy)(nil),                     // 18: bytebase.store.MaintenanceWindowPolicy
+	(*RowFilterPolicy)(nil),                             // 19: bytebase.store.RowFilterPolicy
+	(*RolloutPolicy_Checkers)(nil),                      // 20: bytebase.store.RolloutPolicy.Checkers
+	(*RolloutPolicy_Checkers_RequiredStatusChecks)(nil), // 21: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
+	(*MaskingExceptionPolicy_MaskingException)(nil),     // 22: bytebase.store.MaskingExceptionPolicy.MaskingException
+	(*MaskingRulePolicy_MaskingRule)(nil),               // 23: bytebase.store.MaskingRulePolicy.MaskingRule
+	nil,                                                 // 24: bytebase.store.TagPolicy.TagsEntry
+	(*MaintenanceWindowPolicy_Window)(nil),              // 25: bytebase.store.MaintenanceWindowPolicy.Window
+	(*RowFilterPolicy_RowFilter)(nil),                   // 26: bytebase.store.RowFilterPolicy.RowFilter
+	(Engine)(0),                                         // 27: bytebase.store.Engine
+	(*expr.Expr)(nil),                                   // 28: google.type.Expr
+	(*durationpb.Duration)(nil),                         // 29: google.protobuf.Duration
 }
 var file_store_policy_proto_depIdxs = []int32{
-	18, // 0: bytebase.store.RolloutPolicy.checkers:type_name -> bytebase.store.RolloutPolicy.Checkers
-	20, // 1: bytebase.store.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException
-	21, // 2: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
+	20, // 0: bytebase.store.RolloutPolicy.checkers:type_name -> bytebase.store.RolloutPolicy.Checkers
+	22, // 1: bytebase.store.MaskingExceptionPolicy.masking_exceptions:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException
+	23, // 2: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
 	0,  // 3: bytebase.store.SQLReviewRule.level:type_name -> bytebase.store.SQLReviewRuleLevel
-	23, // 4: bytebase.store.SQLReviewRule.engine:type_name -> bytebase.store.Engine
-	22, // 5: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
-	24, // 6: bytebase.store.Binding.condition:type_name -> google.type.Expr
+	27, // 4: bytebase.store.SQLReviewRule.engine:type_name -> bytebase.store.Engine
+	24, // 5: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
+	28, // 6: bytebase.store.Binding.condition:type_name -> google.type.Expr
 	13, // 7: bytebase.store.IamPolicy.bindings:type_name -> bytebase.store.Binding
 	5,  // 8: bytebase.store.EnvironmentTierPolicy.environment_tier:type_name -> bytebase.store.EnvironmentTierPolicy.EnvironmentTier
-	25, // 9: bytebase.store.QueryDataPolicy.timeout:type_name -> google.protobuf.Duration
+	29, // 9: bytebase.store.QueryDataPolicy.timeout:type_name -> google.protobuf.Duration
 	6,  // 10: bytebase.store.DataSourceQueryPolicy.admin_data_source_restriction:type_name -> bytebase.store.DataSourceQueryPolicy.Restriction
-	19, // 11: bytebase.store.RolloutPolicy.Checkers.required_status_checks:type_name -> bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
-	3,  // 12: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks.plan_check_enforcement:type_name -> bytebase.store.RolloutPolicy.Checkers.PlanCheckEnforcement
-	4,  // 13: bytebase.store.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException.Action
-	24, // 14: bytebase.store.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
-	24, // 15: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	25, // 11: bytebase.store.MaintenanceWindowPolicy.windows:type_name -> bytebase.store.MaintenanceWindowPolicy.Window
+	26, // 12: bytebase.store.RowFilterPolicy.filters:type_name -> bytebase.store.RowFilterPolicy.RowFilter
+	21, // 13: bytebase.store.RolloutPolicy.Checkers.required_status_checks:type_name -> bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks
+	3,  // 14: bytebase.store.RolloutPolicy.Checkers.RequiredStatusChecks.plan_check_enforcement:type_name -> bytebase.store.RolloutPolicy.Checkers.PlanCheckEnforcement
+	4,  // 15: bytebase.store.MaskingExceptionPolicy.MaskingException.action:type_name -> bytebase.store.MaskingExceptionPolicy.MaskingException.Action
+	28, // 16: bytebase.store.MaskingExceptionPolicy.MaskingException.condition:type_name -> google.type.Expr
+	28, // 17: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
+	29, // 18: bytebase.store.MaintenanceWindowPolicy.Window.duration:type_name -> google.protobuf.Duration
+	28, // 19: bytebase.store.RowFilterPolicy.RowFilter.condition:type_name -> google.type.Expr
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_store_policy_proto_init() }
@@ -1413,7 +1682,7 @@ func file_store_policy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_policy_proto_rawDesc), len(file_store_policy_proto_rawDesc)),
 			NumEnums:      7,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -299,3 +299,85 @@ func (x *DataSourceQueryPolicy) Equal(y *DataSourceQueryPolicy) bool {
 	}
 	return true
 }
+
+func (x *MaintenanceWindowPolicy_Window) Equal(y *MaintenanceWindowPolicy_Window) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Cron != y.Cron {
+		return false
+	}
+	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *MaintenanceWindowPolicy) Equal(y *MaintenanceWindowPolicy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Windows) != len(y.Windows) {
+		return false
+	}
+	for i := 0; i < len(x.Windows); i++ {
+		if !x.Windows[i].Equal(y.Windows[i]) {
+			return false
+		}
+	}
+	if x.TimeZone != y.TimeZone {
+		return false
+	}
+	return true
+}
+
+func (x *RowFilterPolicy_RowFilter) Equal(y *RowFilterPolicy_RowFilter) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Database != y.Database {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if equal, ok := interface{}(x.Condition).(interface{ Equal(*expr.Expr) bool }); !ok || !equal.Equal(y.Condition) {
+		return false
+	} else if !proto.Equal(x.Condition, y.Condition) {
+		return false
+	}
+	if x.Predicate != y.Predicate {
+		return false
+	}
+	return true
+}
+
+func (x *RowFilterPolicy) Equal(y *RowFilterPolicy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Filters) != len(y.Filters) {
+		return false
+	}
+	for i := 0; i < len(x.Filters); i++ {
+		if !x.Filters[i].Equal(y.Filters[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -33,6 +34,8 @@ const (
 	Activity_NOTIFY_ISSUE_APPROVED Activity_Type = 23
 	// NOTIFY_PIPELINE_ROLLOUT represents the pipeline rollout notification.
 	Activity_NOTIFY_PIPELINE_ROLLOUT Activity_Type = 24
+	// NOTIFY_GRANT_EXPIRED represents the notification of revoking the expired role granted by the request.
+	Activity_NOTIFY_GRANT_EXPIRED Activity_Type = 25
 	// Issue related activity types.
 	//
 	// ISSUE_CREATE represents creating an issue.
@@ -57,6 +60,7 @@ var (
 		0:  "TYPE_UNSPECIFIED",
 		23: "NOTIFY_ISSUE_APPROVED",
 		24: "NOTIFY_PIPELINE_ROLLOUT",
+		25: "NOTIFY_GRANT_EXPIRED",
 		1:  "ISSUE_CREATE",
 		2:  "ISSUE_COMMENT_CREATE",
 		3:  "ISSUE_FIELD_UPDATE",
@@ -69,6 +73,7 @@ var (
 		"TYPE_UNSPECIFIED":                      0,
 		"NOTIFY_ISSUE_APPROVED":                 23,
 		"NOTIFY_PIPELINE_ROLLOUT":               24,
+		"NOTIFY_GRANT_EXPIRED":                  25,
 		"ISSUE_CREATE":                          1,
 		"ISSUE_COMMENT_CREATE":                  2,
 		"ISSUE_FIELD_UPDATE":                    3,
@@ -125,6 +130,8 @@ const (
 	ProjectWebhook_WECOM ProjectWebhook_Type = 6
 	// Lark integration.
 	ProjectWebhook_LARK ProjectWebhook_Type = 8
+	// Custom HTTP endpoint receiving a signed JSON event envelope.
+	ProjectWebhook_CUSTOM ProjectWebhook_Type = 9
 )
 
 // Enum value maps for ProjectWebhook_Type.
@@ -138,6 +145,7 @@ var (
 		5: "FEISHU",
 		6: "WECOM",
 		8: "LARK",
+		9: "CUSTOM",
 	}
 	ProjectWebhook_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
@@ -148,6 +156,7 @@ var (
 		"FEISHU":           5,
 		"WECOM":            6,
 		"LARK":             8,
+		"CUSTOM":           9,
 	}
 )
 
@@ -229,6 +238,12 @@ type ProjectWebhook struct {
 	// to the persons and url will be ignored.
 	// IM integration setting should be set for this function to work.
 	DirectMessage bool `protobuf:"varint,5,opt,name=direct_message,json=directMessage,proto3" json:"direct_message,omitempty"`
+	// The secret used to sign the event envelope with HMAC-SHA256.
+	// Only used by CUSTOM webhooks.
+	SigningSecret string `protobuf:"bytes,6,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// Additional HTTP headers sent with every request.
+	// Only used by CUSTOM webhooks.
+	Headers       map[string]string `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,23 +313,130 @@ func (x *ProjectWebhook) GetDirectMessage() bool {
 	return false
 }
 
+func (x *ProjectWebhook) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *ProjectWebhook) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type WebhookDeliveryPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The JSON event envelope of the delivery.
+	// It is posted as-is to CUSTOM webhooks, and used to rebuild the message for other webhook types.
+	RequestBody string `protobuf:"bytes,1,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	// The error of the latest attempt, empty if the delivery succeeded.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The HTTP status code of the latest attempt, zero if no response was received.
+	StatusCode int32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The latency of the latest attempt.
+	Latency *durationpb.Duration `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The ID of the user who triggered the event.
+	ActorId int32 `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// The ID of the issue creator, zero if the event is not related to an issue.
+	// The request body only records the name and email of the creator.
+	IssueCreatorId int32 `protobuf:"varint,6,opt,name=issue_creator_id,json=issueCreatorId,proto3" json:"issue_creator_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryPayload) Reset() {
+	*x = WebhookDeliveryPayload{}
+	mi := &file_store_project_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryPayload) ProtoMessage() {}
+
+func (x *WebhookDeliveryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryPayload.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryPayload) Descriptor() ([]byte, []int) {
+	return file_store_project_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookDeliveryPayload) GetRequestBody() string {
+	if x != nil {
+		return x.RequestBody
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryPayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryPayload) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryPayload) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryPayload) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryPayload) GetIssueCreatorId() int32 {
+	if x != nil {
+		return x.IssueCreatorId
+	}
+	return 0
+}
+
 var File_store_project_webhook_proto protoreflect.FileDescriptor
 
 const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
-	"\x1bstore/project_webhook.proto\x12\x0ebytebase.store\"\xac\x02\n" +
-	"\bActivity\"\x9f\x02\n" +
+	"\x1bstore/project_webhook.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"\xc6\x02\n" +
+	"\bActivity\"\xb9\x02\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15NOTIFY_ISSUE_APPROVED\x10\x17\x12\x1b\n" +
-	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x10\n" +
+	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x18\n" +
+	"\x14NOTIFY_GRANT_EXPIRED\x10\x19\x12\x10\n" +
 	"\fISSUE_CREATE\x10\x01\x12\x18\n" +
 	"\x14ISSUE_COMMENT_CREATE\x10\x02\x12\x16\n" +
 	"\x12ISSUE_FIELD_UPDATE\x10\x03\x12\x17\n" +
 	"\x13ISSUE_STATUS_UPDATE\x10\x04\x12\x19\n" +
 	"\x15ISSUE_APPROVAL_NOTIFY\x10\x15\x12&\n" +
 	"\"ISSUE_PIPELINE_STAGE_STATUS_UPDATE\x10\x05\x12)\n" +
-	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x16\"\xc7\x02\n" +
+	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x16\"\xfd\x03\n" +
 	"\x0eProjectWebhook\x127\n" +
 	"\x04type\x18\x01 \x01(\x0e2#.bytebase.store.ProjectWebhook.TypeR\x04type\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
@@ -322,7 +444,12 @@ const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
 	"activities\x18\x04 \x03(\x0e2\x1d.bytebase.store.Activity.TypeR\n" +
 	"activities\x12%\n" +
-	"\x0edirect_message\x18\x05 \x01(\bR\rdirectMessage\"n\n" +
+	"\x0edirect_message\x18\x05 \x01(\bR\rdirectMessage\x12%\n" +
+	"\x0esigning_secret\x18\x06 \x01(\tR\rsigningSecret\x12E\n" +
+	"\aheaders\x18\a \x03(\v2+.bytebase.store.ProjectWebhook.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"z\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05SLACK\x10\x01\x12\v\n" +
@@ -332,7 +459,17 @@ const file_store_project_webhook_proto_rawDesc = "" +
 	"\n" +
 	"\x06FEISHU\x10\x05\x12\t\n" +
 	"\x05WECOM\x10\x06\x12\b\n" +
-	"\x04LARK\x10\bB\x96\x01\n" +
+	"\x04LARK\x10\b\x12\n" +
+	"\n" +
+	"\x06CUSTOM\x10\t\"\xec\x01\n" +
+	"\x16WebhookDeliveryPayload\x12!\n" +
+	"\frequest_body\x18\x01 \x01(\tR\vrequestBody\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x123\n" +
+	"\alatency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x05R\aactorId\x12(\n" +
+	"\x10issue_creator_id\x18\x06 \x01(\x05R\x0eissueCreatorIdB\x96\x01\n" +
 	"\x12com.bytebase.storeB\x13ProjectWebhookProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -348,21 +485,26 @@ func file_store_project_webhook_proto_rawDescGZIP() []byte {
 }
 
 var file_store_project_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_project_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_store_project_webhook_proto_goTypes = []any{
-	(Activity_Type)(0),       // 0: bytebase.store.Activity.Type
-	(ProjectWebhook_Type)(0), // 1: bytebase.store.ProjectWebhook.Type
-	(*Activity)(nil),         // 2: bytebase.store.Activity
-	(*ProjectWebhook)(nil),   // 3: bytebase.store.ProjectWebhook
+	(Activity_Type)(0),             // 0: bytebase.store.Activity.Type
+	(ProjectWebhook_Type)(0),       // 1: bytebase.store.ProjectWebhook.Type
+	(*Activity)(nil),               // 2: bytebase.store.Activity
+	(*ProjectWebhook)(nil),         // 3: bytebase.store.ProjectWebhook
+	(*WebhookDeliveryPayload)(nil), // 4: bytebase.store.WebhookDeliveryPayload
+	nil,                            // 5: bytebase.store.ProjectWebhook.HeadersEntry
+	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
 }
 var file_store_project_webhook_proto_depIdxs = []int32{
 	1, // 0: bytebase.store.ProjectWebhook.type:type_name -> bytebase.store.ProjectWebhook.Type
 	0, // 1: bytebase.store.ProjectWebhook.activities:type_name -> bytebase.store.Activity.Type
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: bytebase.store.ProjectWebhook.headers:type_name -> bytebase.store.ProjectWebhook.HeadersEntry
+	6, // 3: bytebase.store.WebhookDeliveryPayload.latency:type_name -> google.protobuf.Duration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_store_project_webhook_proto_init() }
@@ -376,7 +518,7 @@ func file_store_project_webhook_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_project_webhook_proto_rawDesc), len(file_store_project_webhook_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -40,5 +40,48 @@ func (x *ProjectWebhook) Equal(y *ProjectWebhook) bool {
 	if x.DirectMessage != y.DirectMessage {
 		return false
 	}
+	if x.SigningSecret != y.SigningSecret {
+		return false
+	}
+	if len(x.Headers) != len(y.Headers) {
+		return false
+	}
+	for k := range x.Headers {
+		_, ok := y.Headers[k]
+		if !ok {
+			return false
+		}
+		if x.Headers[k] != y.Headers[k] {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *WebhookDeliveryPayload) Equal(y *WebhookDeliveryPayload) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.RequestBody != y.RequestBody {
+		return false
+	}
+	if x.Error != y.Error {
+		return false
+	}
+	if x.StatusCode != y.StatusCode {
+		return false
+	}
+	if p, q := x.Latency, y.Latency; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if x.ActorId != y.ActorId {
+		return false
+	}
+	if x.IssueCreatorId != y.IssueCreatorId {
+		return false
+	}
 	return true
 }
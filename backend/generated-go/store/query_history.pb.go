@@ -23,9 +23,11 @@ const (
 )
 
 type QueryHistoryPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Error    *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Duration *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The row filters applied to the query.
+	RowFilters    []*QueryHistoryPayload_RowFilter `protobuf:"bytes,3,rep,name=row_filters,json=rowFilters,proto3" json:"row_filters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,14 +76,79 @@ func (x *QueryHistoryPayload) GetDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *QueryHistoryPayload) GetRowFilters() []*QueryHistoryPayload_RowFilter {
+	if x != nil {
+		return x.RowFilters
+	}
+	return nil
+}
+
+type QueryHistoryPayload_RowFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The table that the filter applied to, e.g. "public.orders".
+	Table         string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Predicate     string `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryHistoryPayload_RowFilter) Reset() {
+	*x = QueryHistoryPayload_RowFilter{}
+	mi := &file_store_query_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryHistoryPayload_RowFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistoryPayload_RowFilter) ProtoMessage() {}
+
+func (x *QueryHistoryPayload_RowFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_query_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistoryPayload_RowFilter.ProtoReflect.Descriptor instead.
+func (*QueryHistoryPayload_RowFilter) Descriptor() ([]byte, []int) {
+	return file_store_query_history_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *QueryHistoryPayload_RowFilter) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *QueryHistoryPayload_RowFilter) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
+}
+
 var File_store_query_history_proto protoreflect.FileDescriptor
 
 const file_store_query_history_proto_rawDesc = "" +
 	"\n" +
-	"\x19store/query_history.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"q\n" +
+	"\x19store/query_history.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"\x82\x02\n" +
 	"\x13QueryHistoryPayload\x12\x19\n" +
 	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x125\n" +
-	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bdurationB\b\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12N\n" +
+	"\vrow_filters\x18\x03 \x03(\v2-.bytebase.store.QueryHistoryPayload.RowFilterR\n" +
+	"rowFilters\x1a?\n" +
+	"\tRowFilter\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12\x1c\n" +
+	"\tpredicate\x18\x02 \x01(\tR\tpredicateB\b\n" +
 	"\x06_errorB\x94\x01\n" +
 	"\x12com.bytebase.storeB\x11QueryHistoryProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
@@ -97,18 +164,20 @@ func file_store_query_history_proto_rawDescGZIP() []byte {
 	return file_store_query_history_proto_rawDescData
 }
 
-var file_store_query_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_query_history_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_query_history_proto_goTypes = []any{
-	(*QueryHistoryPayload)(nil), // 0: bytebase.store.QueryHistoryPayload
-	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+	(*QueryHistoryPayload)(nil),           // 0: bytebase.store.QueryHistoryPayload
+	(*QueryHistoryPayload_RowFilter)(nil), // 1: bytebase.store.QueryHistoryPayload.RowFilter
+	(*durationpb.Duration)(nil),           // 2: google.protobuf.Duration
 }
 var file_store_query_history_proto_depIdxs = []int32{
-	1, // 0: bytebase.store.QueryHistoryPayload.duration:type_name -> google.protobuf.Duration
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: bytebase.store.QueryHistoryPayload.duration:type_name -> google.protobuf.Duration
+	1, // 1: bytebase.store.QueryHistoryPayload.row_filters:type_name -> bytebase.store.QueryHistoryPayload.RowFilter
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_store_query_history_proto_init() }
@@ -123,7 +192,7 @@ func file_store_query_history_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_query_history_proto_rawDesc), len(file_store_query_history_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,22 @@
 
 package store
 
+func (x *QueryHistoryPayload_RowFilter) Equal(y *QueryHistoryPayload_RowFilter) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Predicate != y.Predicate {
+		return false
+	}
+	return true
+}
+
 func (x *QueryHistoryPayload) Equal(y *QueryHistoryPayload) bool {
 	if x == y {
 		return true
@@ -16,5 +32,13 @@ func (x *QueryHistoryPayload) Equal(y *QueryHistoryPayload) bool {
 	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if len(x.RowFilters) != len(y.RowFilters) {
+		return false
+	}
+	for i := 0; i < len(x.RowFilters); i++ {
+		if !x.RowFilters[i].Equal(y.RowFilters[i]) {
+			return false
+		}
+	}
 	return true
 }
@@ -43,6 +43,8 @@ const (
 	SettingName_SCIM                        SettingName = 17
 	SettingName_PASSWORD_RESTRICTION        SettingName = 18
 	SettingName_ENVIRONMENT                 SettingName = 19
+	SettingName_EMAIL                       SettingName = 20
+	SettingName_AUDIT_LOG_SINK              SettingName = 21
 )
 
 // Enum value maps for SettingName.
@@ -65,6 +67,8 @@ var (
 		17: "SCIM",
 		18: "PASSWORD_RESTRICTION",
 		19: "ENVIRONMENT",
+		20: "EMAIL",
+		21: "AUDIT_LOG_SINK",
 	}
 	SettingName_value = map[string]int32{
 		"SETTING_NAME_UNSPECIFIED":    0,
@@ -84,6 +88,8 @@ var (
 		"SCIM":                        17,
 		"PASSWORD_RESTRICTION":        18,
 		"ENVIRONMENT":                 19,
+		"EMAIL":                       20,
+		"AUDIT_LOG_SINK":              21,
 	}
 )
 
@@ -265,7 +271,113 @@ func (x Algorithm_InnerOuterMask_MaskType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Algorithm_InnerOuterMask_MaskType.Descriptor instead.
 func (Algorithm_InnerOuterMask_MaskType) EnumDescriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 3, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 3, 0}
+}
+
+type Algorithm_FormatPreservingEncryptionMask_Mode int32
+
+const (
+	Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED Algorithm_FormatPreservingEncryptionMask_Mode = 0
+	// NIST SP 800-38G FF1.
+	Algorithm_FormatPreservingEncryptionMask_FF1 Algorithm_FormatPreservingEncryptionMask_Mode = 1
+	// NIST SP 800-38G Revision 1 FF3-1.
+	Algorithm_FormatPreservingEncryptionMask_FF3_1 Algorithm_FormatPreservingEncryptionMask_Mode = 2
+)
+
+// Enum value maps for Algorithm_FormatPreservingEncryptionMask_Mode.
+var (
+	Algorithm_FormatPreservingEncryptionMask_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "FF1",
+		2: "FF3_1",
+	}
+	Algorithm_FormatPreservingEncryptionMask_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"FF1":              1,
+		"FF3_1":            2,
+	}
+)
+
+func (x Algorithm_FormatPreservingEncryptionMask_Mode) Enum() *Algorithm_FormatPreservingEncryptionMask_Mode {
+	p := new(Algorithm_FormatPreservingEncryptionMask_Mode)
+	*p = x
+	return p
+}
+
+func (x Algorithm_FormatPreservingEncryptionMask_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Algorithm_FormatPreservingEncryptionMask_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (Algorithm_FormatPreservingEncryptionMask_Mode) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[4]
+}
+
+func (x Algorithm_FormatPreservingEncryptionMask_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Algorithm_FormatPreservingEncryptionMask_Mode.Descriptor instead.
+func (Algorithm_FormatPreservingEncryptionMask_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 4, 0}
+}
+
+type Algorithm_DateTruncateMask_Granularity int32
+
+const (
+	Algorithm_DateTruncateMask_GRANULARITY_UNSPECIFIED Algorithm_DateTruncateMask_Granularity = 0
+	Algorithm_DateTruncateMask_YEAR                    Algorithm_DateTruncateMask_Granularity = 1
+	Algorithm_DateTruncateMask_MONTH                   Algorithm_DateTruncateMask_Granularity = 2
+	Algorithm_DateTruncateMask_DAY                     Algorithm_DateTruncateMask_Granularity = 3
+	Algorithm_DateTruncateMask_HOUR                    Algorithm_DateTruncateMask_Granularity = 4
+)
+
+// Enum value maps for Algorithm_DateTruncateMask_Granularity.
+var (
+	Algorithm_DateTruncateMask_Granularity_name = map[int32]string{
+		0: "GRANULARITY_UNSPECIFIED",
+		1: "YEAR",
+		2: "MONTH",
+		3: "DAY",
+		4: "HOUR",
+	}
+	Algorithm_DateTruncateMask_Granularity_value = map[string]int32{
+		"GRANULARITY_UNSPECIFIED": 0,
+		"YEAR":                    1,
+		"MONTH":                   2,
+		"DAY":                     3,
+		"HOUR":                    4,
+	}
+)
+
+func (x Algorithm_DateTruncateMask_Granularity) Enum() *Algorithm_DateTruncateMask_Granularity {
+	p := new(Algorithm_DateTruncateMask_Granularity)
+	*p = x
+	return p
+}
+
+func (x Algorithm_DateTruncateMask_Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Algorithm_DateTruncateMask_Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[5].Descriptor()
+}
+
+func (Algorithm_DateTruncateMask_Granularity) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[5]
+}
+
+func (x Algorithm_DateTruncateMask_Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Algorithm_DateTruncateMask_Granularity.Descriptor instead.
+func (Algorithm_DateTruncateMask_Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 6, 0}
 }
 
 type AISetting_Provider int32
@@ -307,11 +419,11 @@ func (x AISetting_Provider) String() string {
 }
 
 func (AISetting_Provider) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[4].Descriptor()
+	return file_store_setting_proto_enumTypes[6].Descriptor()
 }
 
 func (AISetting_Provider) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[4]
+	return &file_store_setting_proto_enumTypes[6]
 }
 
 func (x AISetting_Provider) Number() protoreflect.EnumNumber {
@@ -320,7 +432,168 @@ func (x AISetting_Provider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AISetting_Provider.Descriptor instead.
 func (AISetting_Provider) EnumDescriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{10, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type EmailSetting_Encryption int32
+
+const (
+	EmailSetting_ENCRYPTION_UNSPECIFIED EmailSetting_Encryption = 0
+	// Plain connection without encryption.
+	EmailSetting_NONE EmailSetting_Encryption = 1
+	// Upgrade the plain connection with STARTTLS.
+	EmailSetting_STARTTLS EmailSetting_Encryption = 2
+	// Implicit TLS from the start of the connection.
+	EmailSetting_SSL_TLS EmailSetting_Encryption = 3
+)
+
+// Enum value maps for EmailSetting_Encryption.
+var (
+	EmailSetting_Encryption_name = map[int32]string{
+		0: "ENCRYPTION_UNSPECIFIED",
+		1: "NONE",
+		2: "STARTTLS",
+		3: "SSL_TLS",
+	}
+	EmailSetting_Encryption_value = map[string]int32{
+		"ENCRYPTION_UNSPECIFIED": 0,
+		"NONE":                   1,
+		"STARTTLS":               2,
+		"SSL_TLS":                3,
+	}
+)
+
+func (x EmailSetting_Encryption) Enum() *EmailSetting_Encryption {
+	p := new(EmailSetting_Encryption)
+	*p = x
+	return p
+}
+
+func (x EmailSetting_Encryption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmailSetting_Encryption) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[7].Descriptor()
+}
+
+func (EmailSetting_Encryption) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[7]
+}
+
+func (x EmailSetting_Encryption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmailSetting_Encryption.Descriptor instead.
+func (EmailSetting_Encryption) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type AuditLogSinkSetting_Sink_Type int32
+
+const (
+	AuditLogSinkSetting_Sink_TYPE_UNSPECIFIED AuditLogSinkSetting_Sink_Type = 0
+	// RFC 5424 syslog messages over TCP, framed by the octet counting of RFC 6587.
+	AuditLogSinkSetting_Sink_SYSLOG AuditLogSinkSetting_Sink_Type = 1
+	// JSON batches posted to the HTTP endpoint, e.g. Splunk HTTP Event Collector.
+	AuditLogSinkSetting_Sink_HTTP AuditLogSinkSetting_Sink_Type = 2
+	// OTLP logs posted to the HTTP endpoint with the JSON encoding.
+	AuditLogSinkSetting_Sink_OTLP AuditLogSinkSetting_Sink_Type = 3
+)
+
+// Enum value maps for AuditLogSinkSetting_Sink_Type.
+var (
+	AuditLogSinkSetting_Sink_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SYSLOG",
+		2: "HTTP",
+		3: "OTLP",
+	}
+	AuditLogSinkSetting_Sink_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SYSLOG":           1,
+		"HTTP":             2,
+		"OTLP":             3,
+	}
+)
+
+func (x AuditLogSinkSetting_Sink_Type) Enum() *AuditLogSinkSetting_Sink_Type {
+	p := new(AuditLogSinkSetting_Sink_Type)
+	*p = x
+	return p
+}
+
+func (x AuditLogSinkSetting_Sink_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogSinkSetting_Sink_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[8].Descriptor()
+}
+
+func (AuditLogSinkSetting_Sink_Type) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[8]
+}
+
+func (x AuditLogSinkSetting_Sink_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting_Sink_Type.Descriptor instead.
+func (AuditLogSinkSetting_Sink_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{14, 0, 0}
+}
+
+type AuditLogSinkSetting_Sink_Format int32
+
+const (
+	AuditLogSinkSetting_Sink_FORMAT_UNSPECIFIED AuditLogSinkSetting_Sink_Format = 0
+	// A JSON array of the audit logs with the bearer token.
+	AuditLogSinkSetting_Sink_JSON AuditLogSinkSetting_Sink_Format = 1
+	// The Splunk HTTP Event Collector events with the "Splunk {token}" authorization.
+	AuditLogSinkSetting_Sink_SPLUNK_HEC AuditLogSinkSetting_Sink_Format = 2
+)
+
+// Enum value maps for AuditLogSinkSetting_Sink_Format.
+var (
+	AuditLogSinkSetting_Sink_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "SPLUNK_HEC",
+	}
+	AuditLogSinkSetting_Sink_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JSON":               1,
+		"SPLUNK_HEC":         2,
+	}
+)
+
+func (x AuditLogSinkSetting_Sink_Format) Enum() *AuditLogSinkSetting_Sink_Format {
+	p := new(AuditLogSinkSetting_Sink_Format)
+	*p = x
+	return p
+}
+
+func (x AuditLogSinkSetting_Sink_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogSinkSetting_Sink_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[9].Descriptor()
+}
+
+func (AuditLogSinkSetting_Sink_Format) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[9]
+}
+
+func (x AuditLogSinkSetting_Sink_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting_Sink_Format.Descriptor instead.
+func (AuditLogSinkSetting_Sink_Format) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{14, 0, 1}
 }
 
 type WorkspaceProfileSetting struct {
@@ -584,6 +857,50 @@ func (x *WorkspaceApprovalSetting) GetRules() []*WorkspaceApprovalSetting_Rule {
 	return nil
 }
 
+type WorkspaceExternalApprovalSetting struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Nodes         []*WorkspaceExternalApprovalSetting_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceExternalApprovalSetting) Reset() {
+	*x = WorkspaceExternalApprovalSetting{}
+	mi := &file_store_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceExternalApprovalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceExternalApprovalSetting) ProtoMessage() {}
+
+func (x *WorkspaceExternalApprovalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceExternalApprovalSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceExternalApprovalSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkspaceExternalApprovalSetting) GetNodes() []*WorkspaceExternalApprovalSetting_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
 type SchemaTemplateSetting struct {
 	state          protoimpl.MessageState                 `protogen:"open.v1"`
 	FieldTemplates []*SchemaTemplateSetting_FieldTemplate `protobuf:"bytes,1,rep,name=field_templates,json=fieldTemplates,proto3" json:"field_templates,omitempty"`
@@ -595,7 +912,7 @@ type SchemaTemplateSetting struct {
 
 func (x *SchemaTemplateSetting) Reset() {
 	*x = SchemaTemplateSetting{}
-	mi := &file_store_setting_proto_msgTypes[3]
+	mi := &file_store_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +924,7 @@ func (x *SchemaTemplateSetting) String() string {
 func (*SchemaTemplateSetting) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[3]
+	mi := &file_store_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +937,7 @@ func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaTemplateSetting.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{3}
+	return file_store_setting_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SchemaTemplateSetting) GetFieldTemplates() []*SchemaTemplateSetting_FieldTemplate {
@@ -653,7 +970,7 @@ type DataClassificationSetting struct {
 
 func (x *DataClassificationSetting) Reset() {
 	*x = DataClassificationSetting{}
-	mi := &file_store_setting_proto_msgTypes[4]
+	mi := &file_store_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +982,7 @@ func (x *DataClassificationSetting) String() string {
 func (*DataClassificationSetting) ProtoMessage() {}
 
 func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[4]
+	mi := &file_store_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +995,7 @@ func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataClassificationSetting.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{4}
+	return file_store_setting_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DataClassificationSetting) GetConfigs() []*DataClassificationSetting_DataClassificationConfig {
@@ -697,7 +1014,7 @@ type SemanticTypeSetting struct {
 
 func (x *SemanticTypeSetting) Reset() {
 	*x = SemanticTypeSetting{}
-	mi := &file_store_setting_proto_msgTypes[5]
+	mi := &file_store_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -709,7 +1026,7 @@ func (x *SemanticTypeSetting) String() string {
 func (*SemanticTypeSetting) ProtoMessage() {}
 
 func (x *SemanticTypeSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[5]
+	mi := &file_store_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +1039,7 @@ func (x *SemanticTypeSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemanticTypeSetting.ProtoReflect.Descriptor instead.
 func (*SemanticTypeSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{5}
+	return file_store_setting_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SemanticTypeSetting) GetTypes() []*SemanticTypeSetting_SemanticType {
@@ -740,6 +1057,11 @@ type Algorithm struct {
 	//	*Algorithm_RangeMask_
 	//	*Algorithm_Md5Mask
 	//	*Algorithm_InnerOuterMask_
+	//	*Algorithm_FormatPreservingEncryptionMask_
+	//	*Algorithm_TokenizationMask_
+	//	*Algorithm_DateTruncateMask_
+	//	*Algorithm_NumericBucketMask_
+	//	*Algorithm_NumericNoiseMask_
 	Mask          isAlgorithm_Mask `protobuf_oneof:"mask"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -747,7 +1069,7 @@ type Algorithm struct {
 
 func (x *Algorithm) Reset() {
 	*x = Algorithm{}
-	mi := &file_store_setting_proto_msgTypes[6]
+	mi := &file_store_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -759,7 +1081,7 @@ func (x *Algorithm) String() string {
 func (*Algorithm) ProtoMessage() {}
 
 func (x *Algorithm) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[6]
+	mi := &file_store_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,7 +1094,7 @@ func (x *Algorithm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm.ProtoReflect.Descriptor instead.
 func (*Algorithm) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6}
+	return file_store_setting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Algorithm) GetMask() isAlgorithm_Mask {
@@ -818,6 +1140,51 @@ func (x *Algorithm) GetInnerOuterMask() *Algorithm_InnerOuterMask {
 	return nil
 }
 
+func (x *Algorithm) GetFormatPreservingEncryptionMask() *Algorithm_FormatPreservingEncryptionMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_FormatPreservingEncryptionMask_); ok {
+			return x.FormatPreservingEncryptionMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetTokenizationMask() *Algorithm_TokenizationMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_TokenizationMask_); ok {
+			return x.TokenizationMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDateTruncateMask() *Algorithm_DateTruncateMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_DateTruncateMask_); ok {
+			return x.DateTruncateMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericBucketMask() *Algorithm_NumericBucketMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericBucketMask_); ok {
+			return x.NumericBucketMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericNoiseMask() *Algorithm_NumericNoiseMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericNoiseMask_); ok {
+			return x.NumericNoiseMask
+		}
+	}
+	return nil
+}
+
 type isAlgorithm_Mask interface {
 	isAlgorithm_Mask()
 }
@@ -838,6 +1205,32 @@ type Algorithm_InnerOuterMask_ struct {
 	InnerOuterMask *Algorithm_InnerOuterMask `protobuf:"bytes,8,opt,name=inner_outer_mask,json=innerOuterMask,proto3,oneof"`
 }
 
+type Algorithm_FormatPreservingEncryptionMask_ struct {
+	// Encrypt the value while keeping its length and character classes with the workspace key.
+	FormatPreservingEncryptionMask *Algorithm_FormatPreservingEncryptionMask `protobuf:"bytes,9,opt,name=format_preserving_encryption_mask,json=formatPreservingEncryptionMask,proto3,oneof"`
+}
+
+type Algorithm_TokenizationMask_ struct {
+	// Replace the value with a deterministic token derived from the workspace key,
+	// so the same value gets the same token in all tables.
+	TokenizationMask *Algorithm_TokenizationMask `protobuf:"bytes,10,opt,name=tokenization_mask,json=tokenizationMask,proto3,oneof"`
+}
+
+type Algorithm_DateTruncateMask_ struct {
+	// Truncate dates and timestamps to the year, month, day or hour.
+	DateTruncateMask *Algorithm_DateTruncateMask `protobuf:"bytes,11,opt,name=date_truncate_mask,json=dateTruncateMask,proto3,oneof"`
+}
+
+type Algorithm_NumericBucketMask_ struct {
+	// Round numbers down to buckets.
+	NumericBucketMask *Algorithm_NumericBucketMask `protobuf:"bytes,12,opt,name=numeric_bucket_mask,json=numericBucketMask,proto3,oneof"`
+}
+
+type Algorithm_NumericNoiseMask_ struct {
+	// Add bounded random noise to numbers.
+	NumericNoiseMask *Algorithm_NumericNoiseMask `protobuf:"bytes,13,opt,name=numeric_noise_mask,json=numericNoiseMask,proto3,oneof"`
+}
+
 func (*Algorithm_FullMask_) isAlgorithm_Mask() {}
 
 func (*Algorithm_RangeMask_) isAlgorithm_Mask() {}
@@ -846,6 +1239,16 @@ func (*Algorithm_Md5Mask) isAlgorithm_Mask() {}
 
 func (*Algorithm_InnerOuterMask_) isAlgorithm_Mask() {}
 
+func (*Algorithm_FormatPreservingEncryptionMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_TokenizationMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_DateTruncateMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericBucketMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericNoiseMask_) isAlgorithm_Mask() {}
+
 type AppIMSetting struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Settings      []*AppIMSetting_IMSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
@@ -855,7 +1258,7 @@ type AppIMSetting struct {
 
 func (x *AppIMSetting) Reset() {
 	*x = AppIMSetting{}
-	mi := &file_store_setting_proto_msgTypes[7]
+	mi := &file_store_setting_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1270,7 @@ func (x *AppIMSetting) String() string {
 func (*AppIMSetting) ProtoMessage() {}
 
 func (x *AppIMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[7]
+	mi := &file_store_setting_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,7 +1283,7 @@ func (x *AppIMSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting.ProtoReflect.Descriptor instead.
 func (*AppIMSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7}
+	return file_store_setting_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AppIMSetting) GetSettings() []*AppIMSetting_IMSetting {
@@ -899,7 +1302,7 @@ type SCIMSetting struct {
 
 func (x *SCIMSetting) Reset() {
 	*x = SCIMSetting{}
-	mi := &file_store_setting_proto_msgTypes[8]
+	mi := &file_store_setting_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -911,7 +1314,7 @@ func (x *SCIMSetting) String() string {
 func (*SCIMSetting) ProtoMessage() {}
 
 func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[8]
+	mi := &file_store_setting_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1327,7 @@ func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SCIMSetting.ProtoReflect.Descriptor instead.
 func (*SCIMSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{8}
+	return file_store_setting_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SCIMSetting) GetToken() string {
@@ -956,7 +1359,7 @@ type PasswordRestrictionSetting struct {
 
 func (x *PasswordRestrictionSetting) Reset() {
 	*x = PasswordRestrictionSetting{}
-	mi := &file_store_setting_proto_msgTypes[9]
+	mi := &file_store_setting_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1371,7 @@ func (x *PasswordRestrictionSetting) String() string {
 func (*PasswordRestrictionSetting) ProtoMessage() {}
 
 func (x *PasswordRestrictionSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[9]
+	mi := &file_store_setting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1384,7 @@ func (x *PasswordRestrictionSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PasswordRestrictionSetting.ProtoReflect.Descriptor instead.
 func (*PasswordRestrictionSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{9}
+	return file_store_setting_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PasswordRestrictionSetting) GetMinLength() int32 {
@@ -1047,7 +1450,7 @@ type AISetting struct {
 
 func (x *AISetting) Reset() {
 	*x = AISetting{}
-	mi := &file_store_setting_proto_msgTypes[10]
+	mi := &file_store_setting_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1059,7 +1462,7 @@ func (x *AISetting) String() string {
 func (*AISetting) ProtoMessage() {}
 
 func (x *AISetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[10]
+	mi := &file_store_setting_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1475,7 @@ func (x *AISetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AISetting.ProtoReflect.Descriptor instead.
 func (*AISetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{10}
+	return file_store_setting_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AISetting) GetEnabled() bool {
@@ -1117,16 +1520,114 @@ func (x *AISetting) GetVersion() string {
 	return ""
 }
 
-type EnvironmentSetting struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Environments  []*EnvironmentSetting_Environment `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
+type EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to send email notifications.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The host of the SMTP server.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// The port of the SMTP server.
+	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The username for SMTP authentication. Authentication is skipped if empty.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// The password for SMTP authentication.
+	Password   string                  `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Encryption EmailSetting_Encryption `protobuf:"varint,6,opt,name=encryption,proto3,enum=bytebase.store.EmailSetting_Encryption" json:"encryption,omitempty"`
+	// The sender address, e.g. "Bytebase <noreply@example.com>".
+	From          string `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailSetting) Reset() {
+	*x = EmailSetting{}
+	mi := &file_store_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailSetting) ProtoMessage() {}
+
+func (x *EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailSetting.ProtoReflect.Descriptor instead.
+func (*EmailSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EmailSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EmailSetting) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *EmailSetting) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *EmailSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EmailSetting) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EmailSetting) GetEncryption() EmailSetting_Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return EmailSetting_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *EmailSetting) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type EnvironmentSetting struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Environments  []*EnvironmentSetting_Environment `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EnvironmentSetting) Reset() {
 	*x = EnvironmentSetting{}
-	mi := &file_store_setting_proto_msgTypes[11]
+	mi := &file_store_setting_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1138,7 +1639,7 @@ func (x *EnvironmentSetting) String() string {
 func (*EnvironmentSetting) ProtoMessage() {}
 
 func (x *EnvironmentSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[11]
+	mi := &file_store_setting_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +1652,7 @@ func (x *EnvironmentSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentSetting.ProtoReflect.Descriptor instead.
 func (*EnvironmentSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{11}
+	return file_store_setting_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EnvironmentSetting) GetEnvironments() []*EnvironmentSetting_Environment {
@@ -1161,6 +1662,50 @@ func (x *EnvironmentSetting) GetEnvironments() []*EnvironmentSetting_Environment
 	return nil
 }
 
+type AuditLogSinkSetting struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Sinks         []*AuditLogSinkSetting_Sink `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogSinkSetting) Reset() {
+	*x = AuditLogSinkSetting{}
+	mi := &file_store_setting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogSinkSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSinkSetting) ProtoMessage() {}
+
+func (x *AuditLogSinkSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting.ProtoReflect.Descriptor instead.
+func (*AuditLogSinkSetting) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditLogSinkSetting) GetSinks() []*AuditLogSinkSetting_Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
 type WorkspaceApprovalSetting_Rule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Template      *ApprovalTemplate      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
@@ -1171,7 +1716,7 @@ type WorkspaceApprovalSetting_Rule struct {
 
 func (x *WorkspaceApprovalSetting_Rule) Reset() {
 	*x = WorkspaceApprovalSetting_Rule{}
-	mi := &file_store_setting_proto_msgTypes[12]
+	mi := &file_store_setting_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1183,7 +1728,7 @@ func (x *WorkspaceApprovalSetting_Rule) String() string {
 func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
 
 func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[12]
+	mi := &file_store_setting_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,6 +1758,89 @@ func (x *WorkspaceApprovalSetting_Rule) GetCondition() *expr.Expr {
 	return nil
 }
 
+type WorkspaceExternalApprovalSetting_Node struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the node, referenced by the approval flow as "externalApprovalNodes/{id}".
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The endpoint of the external approval service.
+	// The approval requests are sent by POST to the endpoint,
+	// and the request status is polled by GET from "{endpoint}/{request_id}".
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The token sent as the bearer token to the endpoint and required in the callbacks.
+	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// The approval request is rejected if not decided within the timeout.
+	// No timeout if unset.
+	Timeout       *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) Reset() {
+	*x = WorkspaceExternalApprovalSetting_Node{}
+	mi := &file_store_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceExternalApprovalSetting_Node) ProtoMessage() {}
+
+func (x *WorkspaceExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceExternalApprovalSetting_Node.ProtoReflect.Descriptor instead.
+func (*WorkspaceExternalApprovalSetting_Node) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 type SchemaTemplateSetting_FieldTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1226,7 +1854,7 @@ type SchemaTemplateSetting_FieldTemplate struct {
 
 func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
 	*x = SchemaTemplateSetting_FieldTemplate{}
-	mi := &file_store_setting_proto_msgTypes[13]
+	mi := &file_store_setting_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1238,7 +1866,7 @@ func (x *SchemaTemplateSetting_FieldTemplate) String() string {
 func (*SchemaTemplateSetting_FieldTemplate) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[13]
+	mi := &file_store_setting_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1879,7 @@ func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SchemaTemplateSetting_FieldTemplate.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting_FieldTemplate) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{3, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *SchemaTemplateSetting_FieldTemplate) GetId() string {
@@ -1300,7 +1928,7 @@ type SchemaTemplateSetting_ColumnType struct {
 
 func (x *SchemaTemplateSetting_ColumnType) Reset() {
 	*x = SchemaTemplateSetting_ColumnType{}
-	mi := &file_store_setting_proto_msgTypes[14]
+	mi := &file_store_setting_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1312,7 +1940,7 @@ func (x *SchemaTemplateSetting_ColumnType) String() string {
 func (*SchemaTemplateSetting_ColumnType) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[14]
+	mi := &file_store_setting_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,7 +1953,7 @@ func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaTemplateSetting_ColumnType.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting_ColumnType) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{3, 1}
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *SchemaTemplateSetting_ColumnType) GetEngine() Engine {
@@ -1362,7 +1990,7 @@ type SchemaTemplateSetting_TableTemplate struct {
 
 func (x *SchemaTemplateSetting_TableTemplate) Reset() {
 	*x = SchemaTemplateSetting_TableTemplate{}
-	mi := &file_store_setting_proto_msgTypes[15]
+	mi := &file_store_setting_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1374,7 +2002,7 @@ func (x *SchemaTemplateSetting_TableTemplate) String() string {
 func (*SchemaTemplateSetting_TableTemplate) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[15]
+	mi := &file_store_setting_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,7 +2015,7 @@ func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SchemaTemplateSetting_TableTemplate.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting_TableTemplate) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{3, 2}
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 2}
 }
 
 func (x *SchemaTemplateSetting_TableTemplate) GetId() string {
@@ -1447,7 +2075,7 @@ type DataClassificationSetting_DataClassificationConfig struct {
 
 func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
 	*x = DataClassificationSetting_DataClassificationConfig{}
-	mi := &file_store_setting_proto_msgTypes[16]
+	mi := &file_store_setting_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1459,7 +2087,7 @@ func (x *DataClassificationSetting_DataClassificationConfig) String() string {
 func (*DataClassificationSetting_DataClassificationConfig) ProtoMessage() {}
 
 func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[16]
+	mi := &file_store_setting_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +2100,7 @@ func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() prot
 
 // Deprecated: Use DataClassificationSetting_DataClassificationConfig.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting_DataClassificationConfig) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig) GetId() string {
@@ -1521,7 +2149,7 @@ type DataClassificationSetting_DataClassificationConfig_Level struct {
 
 func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
 	*x = DataClassificationSetting_DataClassificationConfig_Level{}
-	mi := &file_store_setting_proto_msgTypes[17]
+	mi := &file_store_setting_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1533,7 +2161,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) String() stri
 func (*DataClassificationSetting_DataClassificationConfig_Level) ProtoMessage() {}
 
 func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[17]
+	mi := &file_store_setting_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +2174,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect(
 
 // Deprecated: Use DataClassificationSetting_DataClassificationConfig_Level.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting_DataClassificationConfig_Level) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{4, 0, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{5, 0, 0}
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig_Level) GetId() string {
@@ -1583,7 +2211,7 @@ type DataClassificationSetting_DataClassificationConfig_DataClassification struc
 
 func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) Reset() {
 	*x = DataClassificationSetting_DataClassificationConfig_DataClassification{}
-	mi := &file_store_setting_proto_msgTypes[18]
+	mi := &file_store_setting_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +2223,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 func (*DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoMessage() {}
 
 func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[18]
+	mi := &file_store_setting_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +2236,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 
 // Deprecated: Use DataClassificationSetting_DataClassificationConfig_DataClassification.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting_DataClassificationConfig_DataClassification) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{4, 0, 1}
+	return file_store_setting_proto_rawDescGZIP(), []int{5, 0, 1}
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetId() string {
@@ -1656,7 +2284,7 @@ type SemanticTypeSetting_SemanticType struct {
 
 func (x *SemanticTypeSetting_SemanticType) Reset() {
 	*x = SemanticTypeSetting_SemanticType{}
-	mi := &file_store_setting_proto_msgTypes[20]
+	mi := &file_store_setting_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1668,7 +2296,219 @@ func (x *SemanticTypeSetting_SemanticType) String() string {
 func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
 
 func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[20]
+	mi := &file_store_setting_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
+func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *SemanticTypeSetting_SemanticType) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+type Algorithm_FullMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// substitution is the string used to replace the original value, the
+	// max length of the string is 16 bytes.
+	Substitution  string `protobuf:"bytes,1,opt,name=substitution,proto3" json:"substitution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_FullMask) Reset() {
+	*x = Algorithm_FullMask{}
+	mi := &file_store_setting_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_FullMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_FullMask) ProtoMessage() {}
+
+func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_FullMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_FullMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Algorithm_FullMask) GetSubstitution() string {
+	if x != nil {
+		return x.Substitution
+	}
+	return ""
+}
+
+type Algorithm_RangeMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// We store it as a repeated field to face the fact that the original value may have multiple parts should be masked.
+	// But frontend can be started with a single rule easily.
+	Slices        []*Algorithm_RangeMask_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_RangeMask) Reset() {
+	*x = Algorithm_RangeMask{}
+	mi := &file_store_setting_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_RangeMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_RangeMask) ProtoMessage() {}
+
+func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_RangeMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_RangeMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *Algorithm_RangeMask) GetSlices() []*Algorithm_RangeMask_Slice {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+type Algorithm_MD5Mask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// salt is the salt value to generate a different hash that with the word alone.
+	Salt          string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_MD5Mask) Reset() {
+	*x = Algorithm_MD5Mask{}
+	mi := &file_store_setting_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_MD5Mask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_MD5Mask) ProtoMessage() {}
+
+func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
+func (*Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *Algorithm_MD5Mask) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+type Algorithm_InnerOuterMask struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	PrefixLen     int32                             `protobuf:"varint,1,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	SuffixLen     int32                             `protobuf:"varint,2,opt,name=suffix_len,json=suffixLen,proto3" json:"suffix_len,omitempty"`
+	Substitution  string                            `protobuf:"bytes,3,opt,name=substitution,proto3" json:"substitution,omitempty"`
+	Type          Algorithm_InnerOuterMask_MaskType `protobuf:"varint,4,opt,name=type,proto3,enum=bytebase.store.Algorithm_InnerOuterMask_MaskType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_InnerOuterMask) Reset() {
+	*x = Algorithm_InnerOuterMask{}
+	mi := &file_store_setting_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_InnerOuterMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_InnerOuterMask) ProtoMessage() {}
+
+func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,70 +2519,63 @@ func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
-func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use Algorithm_InnerOuterMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_InnerOuterMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 3}
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetId() string {
+func (x *Algorithm_InnerOuterMask) GetPrefixLen() int32 {
 	if x != nil {
-		return x.Id
+		return x.PrefixLen
 	}
-	return ""
+	return 0
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetTitle() string {
+func (x *Algorithm_InnerOuterMask) GetSuffixLen() int32 {
 	if x != nil {
-		return x.Title
+		return x.SuffixLen
 	}
-	return ""
+	return 0
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetDescription() string {
+func (x *Algorithm_InnerOuterMask) GetSubstitution() string {
 	if x != nil {
-		return x.Description
+		return x.Substitution
 	}
 	return ""
 }
 
-func (x *SemanticTypeSetting_SemanticType) GetAlgorithm() *Algorithm {
-	if x != nil {
-		return x.Algorithm
-	}
-	return nil
-}
-
-func (x *SemanticTypeSetting_SemanticType) GetIcon() string {
+func (x *Algorithm_InnerOuterMask) GetType() Algorithm_InnerOuterMask_MaskType {
 	if x != nil {
-		return x.Icon
+		return x.Type
 	}
-	return ""
+	return Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED
 }
 
-type Algorithm_FullMask struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// substitution is the string used to replace the original value, the
-	// max length of the string is 16 bytes.
-	Substitution  string `protobuf:"bytes,1,opt,name=substitution,proto3" json:"substitution,omitempty"`
+type Algorithm_FormatPreservingEncryptionMask struct {
+	state protoimpl.MessageState                        `protogen:"open.v1"`
+	Mode  Algorithm_FormatPreservingEncryptionMask_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=bytebase.store.Algorithm_FormatPreservingEncryptionMask_Mode" json:"mode,omitempty"`
+	// tweak is mixed into the encryption, so the same value is encrypted differently with different tweaks.
+	Tweak         string `protobuf:"bytes,2,opt,name=tweak,proto3" json:"tweak,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithm_FullMask) Reset() {
-	*x = Algorithm_FullMask{}
-	mi := &file_store_setting_proto_msgTypes[21]
+func (x *Algorithm_FormatPreservingEncryptionMask) Reset() {
+	*x = Algorithm_FormatPreservingEncryptionMask{}
+	mi := &file_store_setting_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Algorithm_FullMask) String() string {
+func (x *Algorithm_FormatPreservingEncryptionMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Algorithm_FullMask) ProtoMessage() {}
+func (*Algorithm_FormatPreservingEncryptionMask) ProtoMessage() {}
 
-func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[21]
+func (x *Algorithm_FormatPreservingEncryptionMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1753,42 +2586,48 @@ func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Algorithm_FullMask.ProtoReflect.Descriptor instead.
-func (*Algorithm_FullMask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use Algorithm_FormatPreservingEncryptionMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_FormatPreservingEncryptionMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 4}
 }
 
-func (x *Algorithm_FullMask) GetSubstitution() string {
+func (x *Algorithm_FormatPreservingEncryptionMask) GetMode() Algorithm_FormatPreservingEncryptionMask_Mode {
 	if x != nil {
-		return x.Substitution
+		return x.Mode
+	}
+	return Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED
+}
+
+func (x *Algorithm_FormatPreservingEncryptionMask) GetTweak() string {
+	if x != nil {
+		return x.Tweak
 	}
 	return ""
 }
 
-type Algorithm_RangeMask struct {
+type Algorithm_TokenizationMask struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// We store it as a repeated field to face the fact that the original value may have multiple parts should be masked.
-	// But frontend can be started with a single rule easily.
-	Slices        []*Algorithm_RangeMask_Slice `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+	// prefix is prepended to the tokens, such as "tok_".
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithm_RangeMask) Reset() {
-	*x = Algorithm_RangeMask{}
-	mi := &file_store_setting_proto_msgTypes[22]
+func (x *Algorithm_TokenizationMask) Reset() {
+	*x = Algorithm_TokenizationMask{}
+	mi := &file_store_setting_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Algorithm_RangeMask) String() string {
+func (x *Algorithm_TokenizationMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Algorithm_RangeMask) ProtoMessage() {}
+func (*Algorithm_TokenizationMask) ProtoMessage() {}
 
-func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[22]
+func (x *Algorithm_TokenizationMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,41 +2638,42 @@ func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Algorithm_RangeMask.ProtoReflect.Descriptor instead.
-func (*Algorithm_RangeMask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use Algorithm_TokenizationMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_TokenizationMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 5}
 }
 
-func (x *Algorithm_RangeMask) GetSlices() []*Algorithm_RangeMask_Slice {
+func (x *Algorithm_TokenizationMask) GetPrefix() string {
 	if x != nil {
-		return x.Slices
+		return x.Prefix
 	}
-	return nil
+	return ""
 }
 
-type Algorithm_MD5Mask struct {
+type Algorithm_DateTruncateMask struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// salt is the salt value to generate a different hash that with the word alone.
-	Salt          string `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	// granularity is the unit the dates and timestamps are truncated to,
+	// such as 2024-03-15 10:30:00 to 2024-01-01 00:00:00 with YEAR.
+	Granularity   Algorithm_DateTruncateMask_Granularity `protobuf:"varint,1,opt,name=granularity,proto3,enum=bytebase.store.Algorithm_DateTruncateMask_Granularity" json:"granularity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithm_MD5Mask) Reset() {
-	*x = Algorithm_MD5Mask{}
-	mi := &file_store_setting_proto_msgTypes[23]
+func (x *Algorithm_DateTruncateMask) Reset() {
+	*x = Algorithm_DateTruncateMask{}
+	mi := &file_store_setting_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Algorithm_MD5Mask) String() string {
+func (x *Algorithm_DateTruncateMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Algorithm_MD5Mask) ProtoMessage() {}
+func (*Algorithm_DateTruncateMask) ProtoMessage() {}
 
-func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[23]
+func (x *Algorithm_DateTruncateMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,43 +2684,42 @@ func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
-func (*Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 2}
+// Deprecated: Use Algorithm_DateTruncateMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_DateTruncateMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 6}
 }
 
-func (x *Algorithm_MD5Mask) GetSalt() string {
+func (x *Algorithm_DateTruncateMask) GetGranularity() Algorithm_DateTruncateMask_Granularity {
 	if x != nil {
-		return x.Salt
+		return x.Granularity
 	}
-	return ""
+	return Algorithm_DateTruncateMask_GRANULARITY_UNSPECIFIED
 }
 
-type Algorithm_InnerOuterMask struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	PrefixLen     int32                             `protobuf:"varint,1,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
-	SuffixLen     int32                             `protobuf:"varint,2,opt,name=suffix_len,json=suffixLen,proto3" json:"suffix_len,omitempty"`
-	Substitution  string                            `protobuf:"bytes,3,opt,name=substitution,proto3" json:"substitution,omitempty"`
-	Type          Algorithm_InnerOuterMask_MaskType `protobuf:"varint,4,opt,name=type,proto3,enum=bytebase.store.Algorithm_InnerOuterMask_MaskType" json:"type,omitempty"`
+type Algorithm_NumericBucketMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bucket_size is the width of the buckets, it should be positive.
+	// Numbers are rounded down to the lower bound of their buckets, such as 37 to 30 with the bucket size 10.
+	BucketSize    float64 `protobuf:"fixed64,1,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithm_InnerOuterMask) Reset() {
-	*x = Algorithm_InnerOuterMask{}
-	mi := &file_store_setting_proto_msgTypes[24]
+func (x *Algorithm_NumericBucketMask) Reset() {
+	*x = Algorithm_NumericBucketMask{}
+	mi := &file_store_setting_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Algorithm_InnerOuterMask) String() string {
+func (x *Algorithm_NumericBucketMask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Algorithm_InnerOuterMask) ProtoMessage() {}
+func (*Algorithm_NumericBucketMask) ProtoMessage() {}
 
-func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[24]
+func (x *Algorithm_NumericBucketMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1891,37 +2730,62 @@ func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Algorithm_InnerOuterMask.ProtoReflect.Descriptor instead.
-func (*Algorithm_InnerOuterMask) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 3}
+// Deprecated: Use Algorithm_NumericBucketMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericBucketMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 7}
 }
 
-func (x *Algorithm_InnerOuterMask) GetPrefixLen() int32 {
+func (x *Algorithm_NumericBucketMask) GetBucketSize() float64 {
 	if x != nil {
-		return x.PrefixLen
+		return x.BucketSize
 	}
 	return 0
 }
 
-func (x *Algorithm_InnerOuterMask) GetSuffixLen() int32 {
-	if x != nil {
-		return x.SuffixLen
-	}
-	return 0
+type Algorithm_NumericNoiseMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_noise is the bound of the noise, it should be positive.
+	// The noise in [-max_noise, max_noise] is derived from the keyed hash of the number, so the same number always gets the same noise, and integers stay integers.
+	MaxNoise      float64 `protobuf:"fixed64,1,opt,name=max_noise,json=maxNoise,proto3" json:"max_noise,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithm_InnerOuterMask) GetSubstitution() string {
+func (x *Algorithm_NumericNoiseMask) Reset() {
+	*x = Algorithm_NumericNoiseMask{}
+	mi := &file_store_setting_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericNoiseMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericNoiseMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericNoiseMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[33]
 	if x != nil {
-		return x.Substitution
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Algorithm_InnerOuterMask) GetType() Algorithm_InnerOuterMask_MaskType {
+// Deprecated: Use Algorithm_NumericNoiseMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericNoiseMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 8}
+}
+
+func (x *Algorithm_NumericNoiseMask) GetMaxNoise() float64 {
 	if x != nil {
-		return x.Type
+		return x.MaxNoise
 	}
-	return Algorithm_InnerOuterMask_MASK_TYPE_UNSPECIFIED
+	return 0
 }
 
 type Algorithm_RangeMask_Slice struct {
@@ -1938,7 +2802,7 @@ type Algorithm_RangeMask_Slice struct {
 
 func (x *Algorithm_RangeMask_Slice) Reset() {
 	*x = Algorithm_RangeMask_Slice{}
-	mi := &file_store_setting_proto_msgTypes[25]
+	mi := &file_store_setting_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1950,7 +2814,7 @@ func (x *Algorithm_RangeMask_Slice) String() string {
 func (*Algorithm_RangeMask_Slice) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[25]
+	mi := &file_store_setting_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,7 +2827,7 @@ func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm_RangeMask_Slice.ProtoReflect.Descriptor instead.
 func (*Algorithm_RangeMask_Slice) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{6, 1, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{7, 1, 0}
 }
 
 func (x *Algorithm_RangeMask_Slice) GetStart() int32 {
@@ -1988,15 +2852,17 @@ func (x *Algorithm_RangeMask_Slice) GetSubstitution() string {
 }
 
 type AppIMSetting_Slack struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The signing secret is used to verify the interactive requests from Slack.
+	SigningSecret string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AppIMSetting_Slack) Reset() {
 	*x = AppIMSetting_Slack{}
-	mi := &file_store_setting_proto_msgTypes[26]
+	mi := &file_store_setting_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2008,7 +2874,7 @@ func (x *AppIMSetting_Slack) String() string {
 func (*AppIMSetting_Slack) ProtoMessage() {}
 
 func (x *AppIMSetting_Slack) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[26]
+	mi := &file_store_setting_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,7 +2887,7 @@ func (x *AppIMSetting_Slack) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting_Slack.ProtoReflect.Descriptor instead.
 func (*AppIMSetting_Slack) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *AppIMSetting_Slack) GetToken() string {
@@ -2031,6 +2897,13 @@ func (x *AppIMSetting_Slack) GetToken() string {
 	return ""
 }
 
+func (x *AppIMSetting_Slack) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
 type AppIMSetting_Feishu struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -2041,7 +2914,7 @@ type AppIMSetting_Feishu struct {
 
 func (x *AppIMSetting_Feishu) Reset() {
 	*x = AppIMSetting_Feishu{}
-	mi := &file_store_setting_proto_msgTypes[27]
+	mi := &file_store_setting_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2053,7 +2926,7 @@ func (x *AppIMSetting_Feishu) String() string {
 func (*AppIMSetting_Feishu) ProtoMessage() {}
 
 func (x *AppIMSetting_Feishu) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[27]
+	mi := &file_store_setting_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,7 +2939,7 @@ func (x *AppIMSetting_Feishu) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting_Feishu.ProtoReflect.Descriptor instead.
 func (*AppIMSetting_Feishu) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 1}
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 1}
 }
 
 func (x *AppIMSetting_Feishu) GetAppId() string {
@@ -2094,7 +2967,7 @@ type AppIMSetting_Wecom struct {
 
 func (x *AppIMSetting_Wecom) Reset() {
 	*x = AppIMSetting_Wecom{}
-	mi := &file_store_setting_proto_msgTypes[28]
+	mi := &file_store_setting_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2106,7 +2979,7 @@ func (x *AppIMSetting_Wecom) String() string {
 func (*AppIMSetting_Wecom) ProtoMessage() {}
 
 func (x *AppIMSetting_Wecom) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[28]
+	mi := &file_store_setting_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2119,7 +2992,7 @@ func (x *AppIMSetting_Wecom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting_Wecom.ProtoReflect.Descriptor instead.
 func (*AppIMSetting_Wecom) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 2}
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 2}
 }
 
 func (x *AppIMSetting_Wecom) GetCorpId() string {
@@ -2144,16 +3017,18 @@ func (x *AppIMSetting_Wecom) GetSecret() string {
 }
 
 type AppIMSetting_Lark struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	AppSecret     string                 `protobuf:"bytes,2,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AppId     string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppSecret string                 `protobuf:"bytes,2,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
+	// The verification token is used to verify the message card callbacks from Lark.
+	VerificationToken string `protobuf:"bytes,3,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AppIMSetting_Lark) Reset() {
 	*x = AppIMSetting_Lark{}
-	mi := &file_store_setting_proto_msgTypes[29]
+	mi := &file_store_setting_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2165,7 +3040,7 @@ func (x *AppIMSetting_Lark) String() string {
 func (*AppIMSetting_Lark) ProtoMessage() {}
 
 func (x *AppIMSetting_Lark) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[29]
+	mi := &file_store_setting_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +3053,7 @@ func (x *AppIMSetting_Lark) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting_Lark.ProtoReflect.Descriptor instead.
 func (*AppIMSetting_Lark) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 3}
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 3}
 }
 
 func (x *AppIMSetting_Lark) GetAppId() string {
@@ -2195,6 +3070,13 @@ func (x *AppIMSetting_Lark) GetAppSecret() string {
 	return ""
 }
 
+func (x *AppIMSetting_Lark) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
 type AppIMSetting_DingTalk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
@@ -2206,7 +3088,7 @@ type AppIMSetting_DingTalk struct {
 
 func (x *AppIMSetting_DingTalk) Reset() {
 	*x = AppIMSetting_DingTalk{}
-	mi := &file_store_setting_proto_msgTypes[30]
+	mi := &file_store_setting_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2218,7 +3100,7 @@ func (x *AppIMSetting_DingTalk) String() string {
 func (*AppIMSetting_DingTalk) ProtoMessage() {}
 
 func (x *AppIMSetting_DingTalk) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[30]
+	mi := &file_store_setting_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2231,7 +3113,7 @@ func (x *AppIMSetting_DingTalk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting_DingTalk.ProtoReflect.Descriptor instead.
 func (*AppIMSetting_DingTalk) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 4}
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 4}
 }
 
 func (x *AppIMSetting_DingTalk) GetClientId() string {
@@ -2272,7 +3154,7 @@ type AppIMSetting_IMSetting struct {
 
 func (x *AppIMSetting_IMSetting) Reset() {
 	*x = AppIMSetting_IMSetting{}
-	mi := &file_store_setting_proto_msgTypes[31]
+	mi := &file_store_setting_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2284,7 +3166,7 @@ func (x *AppIMSetting_IMSetting) String() string {
 func (*AppIMSetting_IMSetting) ProtoMessage() {}
 
 func (x *AppIMSetting_IMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[31]
+	mi := &file_store_setting_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +3179,7 @@ func (x *AppIMSetting_IMSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppIMSetting_IMSetting.ProtoReflect.Descriptor instead.
 func (*AppIMSetting_IMSetting) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{7, 5}
+	return file_store_setting_proto_rawDescGZIP(), []int{8, 5}
 }
 
 func (x *AppIMSetting_IMSetting) GetType() ProjectWebhook_Type {
@@ -2409,7 +3291,7 @@ type EnvironmentSetting_Environment struct {
 
 func (x *EnvironmentSetting_Environment) Reset() {
 	*x = EnvironmentSetting_Environment{}
-	mi := &file_store_setting_proto_msgTypes[32]
+	mi := &file_store_setting_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2421,7 +3303,7 @@ func (x *EnvironmentSetting_Environment) String() string {
 func (*EnvironmentSetting_Environment) ProtoMessage() {}
 
 func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[32]
+	mi := &file_store_setting_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2434,7 +3316,7 @@ func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentSetting_Environment.ProtoReflect.Descriptor instead.
 func (*EnvironmentSetting_Environment) Descriptor() ([]byte, []int) {
-	return file_store_setting_proto_rawDescGZIP(), []int{11, 0}
+	return file_store_setting_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *EnvironmentSetting_Environment) GetId() string {
@@ -2465,6 +3347,105 @@ func (x *EnvironmentSetting_Environment) GetColor() string {
 	return ""
 }
 
+type AuditLogSinkSetting_Sink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the sink, which tracks the delivery progress of the sink. A new sink starts from the latest audit log instead of replaying the history.
+	Id   string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type AuditLogSinkSetting_Sink_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.AuditLogSinkSetting_Sink_Type" json:"type,omitempty"`
+	// The "host:port" of the syslog server for SYSLOG, or the URL for HTTP and OTLP.
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Whether to connect to the syslog server with TLS.
+	Tls bool `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	// The token sent in the authorization header of HTTP and OTLP.
+	Token string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	// The format of the HTTP sink. Unspecified means JSON.
+	Format AuditLogSinkSetting_Sink_Format `protobuf:"varint,6,opt,name=format,proto3,enum=bytebase.store.AuditLogSinkSetting_Sink_Format" json:"format,omitempty"`
+	// The filter of the audit logs to deliver, in the same syntax as searching the audit logs.
+	// All audit logs are delivered if empty.
+	Filter        string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogSinkSetting_Sink) Reset() {
+	*x = AuditLogSinkSetting_Sink{}
+	mi := &file_store_setting_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogSinkSetting_Sink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSinkSetting_Sink) ProtoMessage() {}
+
+func (x *AuditLogSinkSetting_Sink) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting_Sink.ProtoReflect.Descriptor instead.
+func (*AuditLogSinkSetting_Sink) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *AuditLogSinkSetting_Sink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogSinkSetting_Sink) GetType() AuditLogSinkSetting_Sink_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AuditLogSinkSetting_Sink_TYPE_UNSPECIFIED
+}
+
+func (x *AuditLogSinkSetting_Sink) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *AuditLogSinkSetting_Sink) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AuditLogSinkSetting_Sink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuditLogSinkSetting_Sink) GetFormat() AuditLogSinkSetting_Sink_Format {
+	if x != nil {
+		return x.Format
+	}
+	return AuditLogSinkSetting_Sink_FORMAT_UNSPECIFIED
+}
+
+func (x *AuditLogSinkSetting_Sink) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 var File_store_setting_proto protoreflect.FileDescriptor
 
 const file_store_setting_proto_rawDesc = "" +
@@ -2500,7 +3481,15 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x05rules\x18\x01 \x03(\v2-.bytebase.store.WorkspaceApprovalSetting.RuleR\x05rules\x1au\n" +
 	"\x04Rule\x12<\n" +
 	"\btemplate\x18\x01 \x01(\v2 .bytebase.store.ApprovalTemplateR\btemplate\x12/\n" +
-	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\"\xd0\x06\n" +
+	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\"\x85\x02\n" +
+	" WorkspaceExternalApprovalSetting\x12K\n" +
+	"\x05nodes\x18\x01 \x03(\v25.bytebase.store.WorkspaceExternalApprovalSetting.NodeR\x05nodes\x1a\x93\x01\n" +
+	"\x04Node\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x123\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xd0\x06\n" +
 	"\x15SchemaTemplateSetting\x12\\\n" +
 	"\x0ffield_templates\x18\x01 \x03(\v23.bytebase.store.SchemaTemplateSetting.FieldTemplateR\x0efieldTemplates\x12S\n" +
 	"\fcolumn_types\x18\x02 \x03(\v20.bytebase.store.SchemaTemplateSetting.ColumnTypeR\vcolumnTypes\x12\\\n" +
@@ -2550,13 +3539,19 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
 	"\talgorithm\x18\x06 \x01(\v2\x19.bytebase.store.AlgorithmR\talgorithm\x12\x12\n" +
-	"\x04icon\x18\a \x01(\tR\x04icon\"\xa0\x06\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\"\xae\x0e\n" +
 	"\tAlgorithm\x12A\n" +
 	"\tfull_mask\x18\x05 \x01(\v2\".bytebase.store.Algorithm.FullMaskH\x00R\bfullMask\x12D\n" +
 	"\n" +
 	"range_mask\x18\x06 \x01(\v2#.bytebase.store.Algorithm.RangeMaskH\x00R\trangeMask\x12>\n" +
 	"\bmd5_mask\x18\a \x01(\v2!.bytebase.store.Algorithm.MD5MaskH\x00R\amd5Mask\x12T\n" +
-	"\x10inner_outer_mask\x18\b \x01(\v2(.bytebase.store.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x1a.\n" +
+	"\x10inner_outer_mask\x18\b \x01(\v2(.bytebase.store.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x12\x85\x01\n" +
+	"!format_preserving_encryption_mask\x18\t \x01(\v28.bytebase.store.Algorithm.FormatPreservingEncryptionMaskH\x00R\x1eformatPreservingEncryptionMask\x12Y\n" +
+	"\x11tokenization_mask\x18\n" +
+	" \x01(\v2*.bytebase.store.Algorithm.TokenizationMaskH\x00R\x10tokenizationMask\x12Z\n" +
+	"\x12date_truncate_mask\x18\v \x01(\v2*.bytebase.store.Algorithm.DateTruncateMaskH\x00R\x10dateTruncateMask\x12]\n" +
+	"\x13numeric_bucket_mask\x18\f \x01(\v2+.bytebase.store.Algorithm.NumericBucketMaskH\x00R\x11numericBucketMask\x12Z\n" +
+	"\x12numeric_noise_mask\x18\r \x01(\v2*.bytebase.store.Algorithm.NumericNoiseMaskH\x00R\x10numericNoiseMask\x1a.\n" +
 	"\bFullMask\x12\"\n" +
 	"\fsubstitution\x18\x01 \x01(\tR\fsubstitution\x1a\xa3\x01\n" +
 	"\tRangeMask\x12A\n" +
@@ -2577,12 +3572,35 @@ const file_store_setting_proto_rawDesc = "" +
 	"\bMaskType\x12\x19\n" +
 	"\x15MASK_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05INNER\x10\x01\x12\t\n" +
-	"\x05OUTER\x10\x02B\x06\n" +
-	"\x04mask\"\xb8\x06\n" +
+	"\x05OUTER\x10\x02\x1a\xbb\x01\n" +
+	"\x1eFormatPreservingEncryptionMask\x12Q\n" +
+	"\x04mode\x18\x01 \x01(\x0e2=.bytebase.store.Algorithm.FormatPreservingEncryptionMask.ModeR\x04mode\x12\x14\n" +
+	"\x05tweak\x18\x02 \x01(\tR\x05tweak\"0\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03FF1\x10\x01\x12\t\n" +
+	"\x05FF3_1\x10\x02\x1a*\n" +
+	"\x10TokenizationMask\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x1a\xc0\x01\n" +
+	"\x10DateTruncateMask\x12X\n" +
+	"\vgranularity\x18\x01 \x01(\x0e26.bytebase.store.Algorithm.DateTruncateMask.GranularityR\vgranularity\"R\n" +
+	"\vGranularity\x12\x1b\n" +
+	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04YEAR\x10\x01\x12\t\n" +
+	"\x05MONTH\x10\x02\x12\a\n" +
+	"\x03DAY\x10\x03\x12\b\n" +
+	"\x04HOUR\x10\x04\x1a4\n" +
+	"\x11NumericBucketMask\x12\x1f\n" +
+	"\vbucket_size\x18\x01 \x01(\x01R\n" +
+	"bucketSize\x1a/\n" +
+	"\x10NumericNoiseMask\x12\x1b\n" +
+	"\tmax_noise\x18\x01 \x01(\x01R\bmaxNoiseB\x06\n" +
+	"\x04mask\"\x8e\a\n" +
 	"\fAppIMSetting\x12B\n" +
-	"\bsettings\x18\x01 \x03(\v2&.bytebase.store.AppIMSetting.IMSettingR\bsettings\x1a\x1d\n" +
+	"\bsettings\x18\x01 \x03(\v2&.bytebase.store.AppIMSetting.IMSettingR\bsettings\x1aD\n" +
 	"\x05Slack\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x1a>\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
+	"\x0esigning_secret\x18\x02 \x01(\tR\rsigningSecret\x1a>\n" +
 	"\x06Feishu\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1d\n" +
 	"\n" +
@@ -2590,11 +3608,12 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x05Wecom\x12\x17\n" +
 	"\acorp_id\x18\x01 \x01(\tR\x06corpId\x12\x19\n" +
 	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12\x16\n" +
-	"\x06secret\x18\x03 \x01(\tR\x06secret\x1a<\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x1ak\n" +
 	"\x04Lark\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1d\n" +
 	"\n" +
-	"app_secret\x18\x02 \x01(\tR\tappSecret\x1ak\n" +
+	"app_secret\x18\x02 \x01(\tR\tappSecret\x12-\n" +
+	"\x12verification_token\x18\x03 \x01(\tR\x11verificationToken\x1ak\n" +
 	"\bDingTalk\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x1d\n" +
@@ -2633,7 +3652,23 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x06CLAUDE\x10\x02\x12\n" +
 	"\n" +
 	"\x06GEMINI\x10\x03\x12\x10\n" +
-	"\fAZURE_OPENAI\x10\x04\"\xbb\x02\n" +
+	"\fAZURE_OPENAI\x10\x04\"\xb4\x02\n" +
+	"\fEmailSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12G\n" +
+	"\n" +
+	"encryption\x18\x06 \x01(\x0e2'.bytebase.store.EmailSetting.EncryptionR\n" +
+	"encryption\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\"M\n" +
+	"\n" +
+	"Encryption\x12\x1a\n" +
+	"\x16ENCRYPTION_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NONE\x10\x01\x12\f\n" +
+	"\bSTARTTLS\x10\x02\x12\v\n" +
+	"\aSSL_TLS\x10\x03\"\xbb\x02\n" +
 	"\x12EnvironmentSetting\x12R\n" +
 	"\fenvironments\x18\x01 \x03(\v2..bytebase.store.EnvironmentSetting.EnvironmentR\fenvironments\x1a\xd0\x01\n" +
 	"\vEnvironment\x12\x0e\n" +
@@ -2643,7 +3678,28 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x05color\x18\x04 \x01(\tR\x05color\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xe9\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd0\x03\n" +
+	"\x13AuditLogSinkSetting\x12>\n" +
+	"\x05sinks\x18\x01 \x03(\v2(.bytebase.store.AuditLogSinkSetting.SinkR\x05sinks\x1a\xf8\x02\n" +
+	"\x04Sink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12A\n" +
+	"\x04type\x18\x02 \x01(\x0e2-.bytebase.store.AuditLogSinkSetting.Sink.TypeR\x04type\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x10\n" +
+	"\x03tls\x18\x04 \x01(\bR\x03tls\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12G\n" +
+	"\x06format\x18\x06 \x01(\x0e2/.bytebase.store.AuditLogSinkSetting.Sink.FormatR\x06format\x12\x16\n" +
+	"\x06filter\x18\a \x01(\tR\x06filter\"<\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06SYSLOG\x10\x01\x12\b\n" +
+	"\x04HTTP\x10\x02\x12\b\n" +
+	"\x04OTLP\x10\x03\":\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\x12\x0e\n" +
+	"\n" +
+	"SPLUNK_HEC\x10\x02*\x88\x03\n" +
 	"\vSettingName\x12\x1c\n" +
 	"\x18SETTING_NAME_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vAUTH_SECRET\x10\x01\x12\x11\n" +
@@ -2663,7 +3719,9 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x0eSEMANTIC_TYPES\x10\x0f\x12\b\n" +
 	"\x04SCIM\x10\x11\x12\x18\n" +
 	"\x14PASSWORD_RESTRICTION\x10\x12\x12\x0f\n" +
-	"\vENVIRONMENT\x10\x13\"\x04\b\x10\x10\x10*T\n" +
+	"\vENVIRONMENT\x10\x13\x12\t\n" +
+	"\x05EMAIL\x10\x14\x12\x12\n" +
+	"\x0eAUDIT_LOG_SINK\x10\x15\"\x04\b\x10\x10\x10*T\n" +
 	"\x12DatabaseChangeMode\x12$\n" +
 	" DATABASE_CHANGE_MODE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bPIPELINE\x10\x01\x12\n" +
@@ -2683,106 +3741,134 @@ func file_store_setting_proto_rawDescGZIP() []byte {
 	return file_store_setting_proto_rawDescData
 }
 
-var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_store_setting_proto_goTypes = []any{
 	(SettingName)(0),                                                 // 0: bytebase.store.SettingName
 	(DatabaseChangeMode)(0),                                          // 1: bytebase.store.DatabaseChangeMode
 	(Announcement_AlertLevel)(0),                                     // 2: bytebase.store.Announcement.AlertLevel
 	(Algorithm_InnerOuterMask_MaskType)(0),                           // 3: bytebase.store.Algorithm.InnerOuterMask.MaskType
-	(AISetting_Provider)(0),                                          // 4: bytebase.store.AISetting.Provider
-	(*WorkspaceProfileSetting)(nil),                                  // 5: bytebase.store.WorkspaceProfileSetting
-	(*Announcement)(nil),                                             // 6: bytebase.store.Announcement
-	(*WorkspaceApprovalSetting)(nil),                                 // 7: bytebase.store.WorkspaceApprovalSetting
-	(*SchemaTemplateSetting)(nil),                                    // 8: bytebase.store.SchemaTemplateSetting
-	(*DataClassificationSetting)(nil),                                // 9: bytebase.store.DataClassificationSetting
-	(*SemanticTypeSetting)(nil),                                      // 10: bytebase.store.SemanticTypeSetting
-	(*Algorithm)(nil),                                                // 11: bytebase.store.Algorithm
-	(*AppIMSetting)(nil),                                             // 12: bytebase.store.AppIMSetting
-	(*SCIMSetting)(nil),                                              // 13: bytebase.store.SCIMSetting
-	(*PasswordRestrictionSetting)(nil),                               // 14: bytebase.store.PasswordRestrictionSetting
-	(*AISetting)(nil),                                                // 15: bytebase.store.AISetting
-	(*EnvironmentSetting)(nil),                                       // 16: bytebase.store.EnvironmentSetting
-	(*WorkspaceApprovalSetting_Rule)(nil),                            // 17: bytebase.store.WorkspaceApprovalSetting.Rule
-	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 18: bytebase.store.SchemaTemplateSetting.FieldTemplate
-	(*SchemaTemplateSetting_ColumnType)(nil),                         // 19: bytebase.store.SchemaTemplateSetting.ColumnType
-	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 20: bytebase.store.SchemaTemplateSetting.TableTemplate
-	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 21: bytebase.store.DataClassificationSetting.DataClassificationConfig
-	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 22: bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 23: bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	nil,                                      // 24: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	(*SemanticTypeSetting_SemanticType)(nil), // 25: bytebase.store.SemanticTypeSetting.SemanticType
-	(*Algorithm_FullMask)(nil),               // 26: bytebase.store.Algorithm.FullMask
-	(*Algorithm_RangeMask)(nil),              // 27: bytebase.store.Algorithm.RangeMask
-	(*Algorithm_MD5Mask)(nil),                // 28: bytebase.store.Algorithm.MD5Mask
-	(*Algorithm_InnerOuterMask)(nil),         // 29: bytebase.store.Algorithm.InnerOuterMask
-	(*Algorithm_RangeMask_Slice)(nil),        // 30: bytebase.store.Algorithm.RangeMask.Slice
-	(*AppIMSetting_Slack)(nil),               // 31: bytebase.store.AppIMSetting.Slack
-	(*AppIMSetting_Feishu)(nil),              // 32: bytebase.store.AppIMSetting.Feishu
-	(*AppIMSetting_Wecom)(nil),               // 33: bytebase.store.AppIMSetting.Wecom
-	(*AppIMSetting_Lark)(nil),                // 34: bytebase.store.AppIMSetting.Lark
-	(*AppIMSetting_DingTalk)(nil),            // 35: bytebase.store.AppIMSetting.DingTalk
-	(*AppIMSetting_IMSetting)(nil),           // 36: bytebase.store.AppIMSetting.IMSetting
-	(*EnvironmentSetting_Environment)(nil),   // 37: bytebase.store.EnvironmentSetting.Environment
-	nil,                                      // 38: bytebase.store.EnvironmentSetting.Environment.TagsEntry
-	(*durationpb.Duration)(nil),              // 39: google.protobuf.Duration
-	(*ApprovalTemplate)(nil),                 // 40: bytebase.store.ApprovalTemplate
-	(*expr.Expr)(nil),                        // 41: google.type.Expr
-	(Engine)(0),                              // 42: bytebase.store.Engine
-	(*ColumnMetadata)(nil),                   // 43: bytebase.store.ColumnMetadata
-	(*ColumnCatalog)(nil),                    // 44: bytebase.store.ColumnCatalog
-	(*TableMetadata)(nil),                    // 45: bytebase.store.TableMetadata
-	(*TableCatalog)(nil),                     // 46: bytebase.store.TableCatalog
-	(ProjectWebhook_Type)(0),                 // 47: bytebase.store.ProjectWebhook.Type
+	(Algorithm_FormatPreservingEncryptionMask_Mode)(0),               // 4: bytebase.store.Algorithm.FormatPreservingEncryptionMask.Mode
+	(Algorithm_DateTruncateMask_Granularity)(0),                      // 5: bytebase.store.Algorithm.DateTruncateMask.Granularity
+	(AISetting_Provider)(0),                                          // 6: bytebase.store.AISetting.Provider
+	(EmailSetting_Encryption)(0),                                     // 7: bytebase.store.EmailSetting.Encryption
+	(AuditLogSinkSetting_Sink_Type)(0),                               // 8: bytebase.store.AuditLogSinkSetting.Sink.Type
+	(AuditLogSinkSetting_Sink_Format)(0),                             // 9: bytebase.store.AuditLogSinkSetting.Sink.Format
+	(*WorkspaceProfileSetting)(nil),                                  // 10: bytebase.store.WorkspaceProfileSetting
+	(*Announcement)(nil),                                             // 11: bytebase.store.Announcement
+	(*WorkspaceApprovalSetting)(nil),                                 // 12: bytebase.store.WorkspaceApprovalSetting
+	(*WorkspaceExternalApprovalSetting)(nil),                         // 13: bytebase.store.WorkspaceExternalApprovalSetting
+	(*SchemaTemplateSetting)(nil),                                    // 14: bytebase.store.SchemaTemplateSetting
+	(*DataClassificationSetting)(nil),                                // 15: bytebase.store.DataClassificationSetting
+	(*SemanticTypeSetting)(nil),                                      // 16: bytebase.store.SemanticTypeSetting
+	(*Algorithm)(nil),                                                // 17: bytebase.store.Algorithm
+	(*AppIMSetting)(nil),                                             // 18: bytebase.store.AppIMSetting
+	(*SCIMSetting)(nil),                                              // 19: bytebase.store.SCIMSetting
+	(*PasswordRestrictionSetting)(nil),                               // 20: bytebase.store.PasswordRestrictionSetting
+	(*AISetting)(nil),                                                // 21: bytebase.store.AISetting
+	(*EmailSetting)(nil),                                             // 22: bytebase.store.EmailSetting
+	(*EnvironmentSetting)(nil),                                       // 23: bytebase.store.EnvironmentSetting
+	(*AuditLogSinkSetting)(nil),                                      // 24: bytebase.store.AuditLogSinkSetting
+	(*WorkspaceApprovalSetting_Rule)(nil),                            // 25: bytebase.store.WorkspaceApprovalSetting.Rule
+	(*WorkspaceExternalApprovalSetting_Node)(nil),                    // 26: bytebase.store.WorkspaceExternalApprovalSetting.Node
+	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 27: bytebase.store.SchemaTemplateSetting.FieldTemplate
+	(*SchemaTemplateSetting_ColumnType)(nil),                         // 28: bytebase.store.SchemaTemplateSetting.ColumnType
+	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 29: bytebase.store.SchemaTemplateSetting.TableTemplate
+	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 30: bytebase.store.DataClassificationSetting.DataClassificationConfig
+	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 31: bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 32: bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	nil,                                              // 33: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	(*SemanticTypeSetting_SemanticType)(nil),         // 34: bytebase.store.SemanticTypeSetting.SemanticType
+	(*Algorithm_FullMask)(nil),                       // 35: bytebase.store.Algorithm.FullMask
+	(*Algorithm_RangeMask)(nil),                      // 36: bytebase.store.Algorithm.RangeMask
+	(*Algorithm_MD5Mask)(nil),                        // 37: bytebase.store.Algorithm.MD5Mask
+	(*Algorithm_InnerOuterMask)(nil),                 // 38: bytebase.store.Algorithm.InnerOuterMask
+	(*Algorithm_FormatPreservingEncryptionMask)(nil), // 39: bytebase.store.Algorithm.FormatPreservingEncryptionMask
+	(*Algorithm_TokenizationMask)(nil),               // 40: bytebase.store.Algorithm.TokenizationMask
+	(*Algorithm_DateTruncateMask)(nil),               // 41: bytebase.store.Algorithm.DateTruncateMask
+	(*Algorithm_NumericBucketMask)(nil),              // 42: bytebase.store.Algorithm.NumericBucketMask
+	(*Algorithm_NumericNoiseMask)(nil),               // 43: bytebase.store.Algorithm.NumericNoiseMask
+	(*Algorithm_RangeMask_Slice)(nil),                // 44: bytebase.store.Algorithm.RangeMask.Slice
+	(*AppIMSetting_Slack)(nil),                       // 45: bytebase.store.AppIMSetting.Slack
+	(*AppIMSetting_Feishu)(nil),                      // 46: bytebase.store.AppIMSetting.Feishu
+	(*AppIMSetting_Wecom)(nil),                       // 47: bytebase.store.AppIMSetting.Wecom
+	(*AppIMSetting_Lark)(nil),                        // 48: bytebase.store.AppIMSetting.Lark
+	(*AppIMSetting_DingTalk)(nil),                    // 49: bytebase.store.AppIMSetting.DingTalk
+	(*AppIMSetting_IMSetting)(nil),                   // 50: bytebase.store.AppIMSetting.IMSetting
+	(*EnvironmentSetting_Environment)(nil),           // 51: bytebase.store.EnvironmentSetting.Environment
+	nil,                                              // 52: bytebase.store.EnvironmentSetting.Environment.TagsEntry
+	(*AuditLogSinkSetting_Sink)(nil),                 // 53: bytebase.store.AuditLogSinkSetting.Sink
+	(*durationpb.Duration)(nil),                      // 54: google.protobuf.Duration
+	(*ApprovalTemplate)(nil),                         // 55: bytebase.store.ApprovalTemplate
+	(*expr.Expr)(nil),                                // 56: google.type.Expr
+	(Engine)(0),                                      // 57: bytebase.store.Engine
+	(*ColumnMetadata)(nil),                           // 58: bytebase.store.ColumnMetadata
+	(*ColumnCatalog)(nil),                            // 59: bytebase.store.ColumnCatalog
+	(*TableMetadata)(nil),                            // 60: bytebase.store.TableMetadata
+	(*TableCatalog)(nil),                             // 61: bytebase.store.TableCatalog
+	(ProjectWebhook_Type)(0),                         // 62: bytebase.store.ProjectWebhook.Type
 }
 var file_store_setting_proto_depIdxs = []int32{
-	39, // 0: bytebase.store.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
-	6,  // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.Announcement
-	39, // 2: bytebase.store.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
+	54, // 0: bytebase.store.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
+	11, // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.Announcement
+	54, // 2: bytebase.store.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
 	1,  // 3: bytebase.store.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.store.DatabaseChangeMode
-	39, // 4: bytebase.store.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
+	54, // 4: bytebase.store.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
 	2,  // 5: bytebase.store.Announcement.level:type_name -> bytebase.store.Announcement.AlertLevel
-	17, // 6: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
-	18, // 7: bytebase.store.SchemaTemplateSetting.field_templates:type_name -> bytebase.store.SchemaTemplateSetting.FieldTemplate
-	19, // 8: bytebase.store.SchemaTemplateSetting.column_types:type_name -> bytebase.store.SchemaTemplateSetting.ColumnType
-	20, // 9: bytebase.store.SchemaTemplateSetting.table_templates:type_name -> bytebase.store.SchemaTemplateSetting.TableTemplate
-	21, // 10: bytebase.store.DataClassificationSetting.configs:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig
-	25, // 11: bytebase.store.SemanticTypeSetting.types:type_name -> bytebase.store.SemanticTypeSetting.SemanticType
-	26, // 12: bytebase.store.Algorithm.full_mask:type_name -> bytebase.store.Algorithm.FullMask
-	27, // 13: bytebase.store.Algorithm.range_mask:type_name -> bytebase.store.Algorithm.RangeMask
-	28, // 14: bytebase.store.Algorithm.md5_mask:type_name -> bytebase.store.Algorithm.MD5Mask
-	29, // 15: bytebase.store.Algorithm.inner_outer_mask:type_name -> bytebase.store.Algorithm.InnerOuterMask
-	36, // 16: bytebase.store.AppIMSetting.settings:type_name -> bytebase.store.AppIMSetting.IMSetting
-	39, // 17: bytebase.store.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
-	4,  // 18: bytebase.store.AISetting.provider:type_name -> bytebase.store.AISetting.Provider
-	37, // 19: bytebase.store.EnvironmentSetting.environments:type_name -> bytebase.store.EnvironmentSetting.Environment
-	40, // 20: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
-	41, // 21: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	42, // 22: bytebase.store.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.store.Engine
-	43, // 23: bytebase.store.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.store.ColumnMetadata
-	44, // 24: bytebase.store.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.store.ColumnCatalog
-	42, // 25: bytebase.store.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.store.Engine
-	42, // 26: bytebase.store.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.store.Engine
-	45, // 27: bytebase.store.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.store.TableMetadata
-	46, // 28: bytebase.store.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.store.TableCatalog
-	22, // 29: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	24, // 30: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	23, // 31: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	11, // 32: bytebase.store.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.store.Algorithm
-	30, // 33: bytebase.store.Algorithm.RangeMask.slices:type_name -> bytebase.store.Algorithm.RangeMask.Slice
-	3,  // 34: bytebase.store.Algorithm.InnerOuterMask.type:type_name -> bytebase.store.Algorithm.InnerOuterMask.MaskType
-	47, // 35: bytebase.store.AppIMSetting.IMSetting.type:type_name -> bytebase.store.ProjectWebhook.Type
-	31, // 36: bytebase.store.AppIMSetting.IMSetting.slack:type_name -> bytebase.store.AppIMSetting.Slack
-	32, // 37: bytebase.store.AppIMSetting.IMSetting.feishu:type_name -> bytebase.store.AppIMSetting.Feishu
-	33, // 38: bytebase.store.AppIMSetting.IMSetting.wecom:type_name -> bytebase.store.AppIMSetting.Wecom
-	34, // 39: bytebase.store.AppIMSetting.IMSetting.lark:type_name -> bytebase.store.AppIMSetting.Lark
-	35, // 40: bytebase.store.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.store.AppIMSetting.DingTalk
-	38, // 41: bytebase.store.EnvironmentSetting.Environment.tags:type_name -> bytebase.store.EnvironmentSetting.Environment.TagsEntry
-	42, // [42:42] is the sub-list for method output_type
-	42, // [42:42] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	25, // 6: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
+	26, // 7: bytebase.store.WorkspaceExternalApprovalSetting.nodes:type_name -> bytebase.store.WorkspaceExternalApprovalSetting.Node
+	27, // 8: bytebase.store.SchemaTemplateSetting.field_templates:type_name -> bytebase.store.SchemaTemplateSetting.FieldTemplate
+	28, // 9: bytebase.store.SchemaTemplateSetting.column_types:type_name -> bytebase.store.SchemaTemplateSetting.ColumnType
+	29, // 10: bytebase.store.SchemaTemplateSetting.table_templates:type_name -> bytebase.store.SchemaTemplateSetting.TableTemplate
+	30, // 11: bytebase.store.DataClassificationSetting.configs:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig
+	34, // 12: bytebase.store.SemanticTypeSetting.types:type_name -> bytebase.store.SemanticTypeSetting.SemanticType
+	35, // 13: bytebase.store.Algorithm.full_mask:type_name -> bytebase.store.Algorithm.FullMask
+	36, // 14: bytebase.store.Algorithm.range_mask:type_name -> bytebase.store.Algorithm.RangeMask
+	37, // 15: bytebase.store.Algorithm.md5_mask:type_name -> bytebase.store.Algorithm.MD5Mask
+	38, // 16: bytebase.store.Algorithm.inner_outer_mask:type_name -> bytebase.store.Algorithm.InnerOuterMask
+	39, // 17: bytebase.store.Algorithm.format_preserving_encryption_mask:type_name -> bytebase.store.Algorithm.FormatPreservingEncryptionMask
+	40, // 18: bytebase.store.Algorithm.tokenization_mask:type_name -> bytebase.store.Algorithm.TokenizationMask
+	41, // 19: bytebase.store.Algorithm.date_truncate_mask:type_name -> bytebase.store.Algorithm.DateTruncateMask
+	42, // 20: bytebase.store.Algorithm.numeric_bucket_mask:type_name -> bytebase.store.Algorithm.NumericBucketMask
+	43, // 21: bytebase.store.Algorithm.numeric_noise_mask:type_name -> bytebase.store.Algorithm.NumericNoiseMask
+	50, // 22: bytebase.store.AppIMSetting.settings:type_name -> bytebase.store.AppIMSetting.IMSetting
+	54, // 23: bytebase.store.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
+	6,  // 24: bytebase.store.AISetting.provider:type_name -> bytebase.store.AISetting.Provider
+	7,  // 25: bytebase.store.EmailSetting.encryption:type_name -> bytebase.store.EmailSetting.Encryption
+	51, // 26: bytebase.store.EnvironmentSetting.environments:type_name -> bytebase.store.EnvironmentSetting.Environment
+	53, // 27: bytebase.store.AuditLogSinkSetting.sinks:type_name -> bytebase.store.AuditLogSinkSetting.Sink
+	55, // 28: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
+	56, // 29: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	54, // 30: bytebase.store.WorkspaceExternalApprovalSetting.Node.timeout:type_name -> google.protobuf.Duration
+	57, // 31: bytebase.store.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.store.Engine
+	58, // 32: bytebase.store.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.store.ColumnMetadata
+	59, // 33: bytebase.store.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.store.ColumnCatalog
+	57, // 34: bytebase.store.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.store.Engine
+	57, // 35: bytebase.store.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.store.Engine
+	60, // 36: bytebase.store.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.store.TableMetadata
+	61, // 37: bytebase.store.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.store.TableCatalog
+	31, // 38: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	33, // 39: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	32, // 40: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	17, // 41: bytebase.store.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.store.Algorithm
+	44, // 42: bytebase.store.Algorithm.RangeMask.slices:type_name -> bytebase.store.Algorithm.RangeMask.Slice
+	3,  // 43: bytebase.store.Algorithm.InnerOuterMask.type:type_name -> bytebase.store.Algorithm.InnerOuterMask.MaskType
+	4,  // 44: bytebase.store.Algorithm.FormatPreservingEncryptionMask.mode:type_name -> bytebase.store.Algorithm.FormatPreservingEncryptionMask.Mode
+	5,  // 45: bytebase.store.Algorithm.DateTruncateMask.granularity:type_name -> bytebase.store.Algorithm.DateTruncateMask.Granularity
+	62, // 46: bytebase.store.AppIMSetting.IMSetting.type:type_name -> bytebase.store.ProjectWebhook.Type
+	45, // 47: bytebase.store.AppIMSetting.IMSetting.slack:type_name -> bytebase.store.AppIMSetting.Slack
+	46, // 48: bytebase.store.AppIMSetting.IMSetting.feishu:type_name -> bytebase.store.AppIMSetting.Feishu
+	47, // 49: bytebase.store.AppIMSetting.IMSetting.wecom:type_name -> bytebase.store.AppIMSetting.Wecom
+	48, // 50: bytebase.store.AppIMSetting.IMSetting.lark:type_name -> bytebase.store.AppIMSetting.Lark
+	49, // 51: bytebase.store.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.store.AppIMSetting.DingTalk
+	52, // 52: bytebase.store.EnvironmentSetting.Environment.tags:type_name -> bytebase.store.EnvironmentSetting.Environment.TagsEntry
+	8,  // 53: bytebase.store.AuditLogSinkSetting.Sink.type:type_name -> bytebase.store.AuditLogSinkSetting.Sink.Type
+	9,  // 54: bytebase.store.AuditLogSinkSetting.Sink.format:type_name -> bytebase.store.AuditLogSinkSetting.Sink.Format
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_store_setting_proto_init() }
@@ -2794,14 +3880,19 @@ func file_store_setting_proto_init() {
 	file_store_common_proto_init()
 	file_store_database_proto_init()
 	file_store_project_webhook_proto_init()
-	file_store_setting_proto_msgTypes[6].OneofWrappers = []any{
+	file_store_setting_proto_msgTypes[7].OneofWrappers = []any{
 		(*Algorithm_FullMask_)(nil),
 		(*Algorithm_RangeMask_)(nil),
 		(*Algorithm_Md5Mask)(nil),
 		(*Algorithm_InnerOuterMask_)(nil),
-	}
-	file_store_setting_proto_msgTypes[18].OneofWrappers = []any{}
-	file_store_setting_proto_msgTypes[31].OneofWrappers = []any{
+		(*Algorithm_FormatPreservingEncryptionMask_)(nil),
+		(*Algorithm_TokenizationMask_)(nil),
+		(*Algorithm_DateTruncateMask_)(nil),
+		(*Algorithm_NumericBucketMask_)(nil),
+		(*Algorithm_NumericNoiseMask_)(nil),
+	}
+	file_store_setting_proto_msgTypes[22].OneofWrappers = []any{}
+	file_store_setting_proto_msgTypes[40].OneofWrappers = []any{
 		(*AppIMSetting_IMSetting_Slack)(nil),
 		(*AppIMSetting_IMSetting_Feishu)(nil),
 		(*AppIMSetting_IMSetting_Wecom)(nil),
@@ -2813,8 +3904,8 @@ func file_store_setting_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_setting_proto_rawDesc), len(file_store_setting_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   34,
+			NumEnums:      10,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -6,6 +6,7 @@ package store
 import (
 	expr "google.golang.org/genproto/googleapis/type/expr"
 	proto "google.golang.org/protobuf/proto"
+	math "math"
 )
 
 func (x *WorkspaceProfileSetting) Equal(y *WorkspaceProfileSetting) bool {
@@ -117,6 +118,49 @@ func (x *WorkspaceApprovalSetting) Equal(y *WorkspaceApprovalSetting) bool {
 	return true
 }
 
+func (x *WorkspaceExternalApprovalSetting_Node) Equal(y *WorkspaceExternalApprovalSetting_Node) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Id != y.Id {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if x.Endpoint != y.Endpoint {
+		return false
+	}
+	if x.Token != y.Token {
+		return false
+	}
+	if p, q := x.Timeout, y.Timeout; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *WorkspaceExternalApprovalSetting) Equal(y *WorkspaceExternalApprovalSetting) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Nodes) != len(y.Nodes) {
+		return false
+	}
+	for i := 0; i < len(x.Nodes); i++ {
+		if !x.Nodes[i].Equal(y.Nodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (x *SchemaTemplateSetting_FieldTemplate) Equal(y *SchemaTemplateSetting_FieldTemplate) bool {
 	if x == y {
 		return true
@@ -451,6 +495,74 @@ func (x *Algorithm_InnerOuterMask) Equal(y *Algorithm_InnerOuterMask) bool {
 	return true
 }
 
+func (x *Algorithm_FormatPreservingEncryptionMask) Equal(y *Algorithm_FormatPreservingEncryptionMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Tweak != y.Tweak {
+		return false
+	}
+	return true
+}
+
+func (x *Algorithm_TokenizationMask) Equal(y *Algorithm_TokenizationMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Prefix != y.Prefix {
+		return false
+	}
+	return true
+}
+
+func (x *Algorithm_DateTruncateMask) Equal(y *Algorithm_DateTruncateMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Granularity != y.Granularity {
+		return false
+	}
+	return true
+}
+
+func (x *Algorithm_NumericBucketMask) Equal(y *Algorithm_NumericBucketMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if (math.IsNaN(float64(x.BucketSize)) && !math.IsNaN(float64(y.BucketSize)) || !math.IsNaN(float64(x.BucketSize)) && math.IsNaN(float64(y.BucketSize))) || (!math.IsNaN(float64(x.BucketSize)) && !math.IsNaN(float64(y.BucketSize)) && x.BucketSize != y.BucketSize) {
+		return false
+	}
+	return true
+}
+
+func (x *Algorithm_NumericNoiseMask) Equal(y *Algorithm_NumericNoiseMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if (math.IsNaN(float64(x.MaxNoise)) && !math.IsNaN(float64(y.MaxNoise)) || !math.IsNaN(float64(x.MaxNoise)) && math.IsNaN(float64(y.MaxNoise))) || (!math.IsNaN(float64(x.MaxNoise)) && !math.IsNaN(float64(y.MaxNoise)) && x.MaxNoise != y.MaxNoise) {
+		return false
+	}
+	return true
+}
+
 func (x *Algorithm) Equal(y *Algorithm) bool {
 	if x == y {
 		return true
@@ -470,6 +582,21 @@ func (x *Algorithm) Equal(y *Algorithm) bool {
 	if !x.GetInnerOuterMask().Equal(y.GetInnerOuterMask()) {
 		return false
 	}
+	if !x.GetFormatPreservingEncryptionMask().Equal(y.GetFormatPreservingEncryptionMask()) {
+		return false
+	}
+	if !x.GetTokenizationMask().Equal(y.GetTokenizationMask()) {
+		return false
+	}
+	if !x.GetDateTruncateMask().Equal(y.GetDateTruncateMask()) {
+		return false
+	}
+	if !x.GetNumericBucketMask().Equal(y.GetNumericBucketMask()) {
+		return false
+	}
+	if !x.GetNumericNoiseMask().Equal(y.GetNumericNoiseMask()) {
+		return false
+	}
 	return true
 }
 
@@ -483,6 +610,9 @@ func (x *AppIMSetting_Slack) Equal(y *AppIMSetting_Slack) bool {
 	if x.Token != y.Token {
 		return false
 	}
+	if x.SigningSecret != y.SigningSecret {
+		return false
+	}
 	return true
 }
 
@@ -534,6 +664,9 @@ func (x *AppIMSetting_Lark) Equal(y *AppIMSetting_Lark) bool {
 	if x.AppSecret != y.AppSecret {
 		return false
 	}
+	if x.VerificationToken != y.VerificationToken {
+		return false
+	}
 	return true
 }
 
@@ -674,6 +807,37 @@ func (x *AISetting) Equal(y *AISetting) bool {
 	return true
 }
 
+func (x *EmailSetting) Equal(y *EmailSetting) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if x.Host != y.Host {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.Username != y.Username {
+		return false
+	}
+	if x.Password != y.Password {
+		return false
+	}
+	if x.Encryption != y.Encryption {
+		return false
+	}
+	if x.From != y.From {
+		return false
+	}
+	return true
+}
+
 func (x *EnvironmentSetting_Environment) Equal(y *EnvironmentSetting_Environment) bool {
 	if x == y {
 		return true
@@ -722,3 +886,52 @@ func (x *EnvironmentSetting) Equal(y *EnvironmentSetting) bool {
 	}
 	return true
 }
+
+func (x *AuditLogSinkSetting_Sink) Equal(y *AuditLogSinkSetting_Sink) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Id != y.Id {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Endpoint != y.Endpoint {
+		return false
+	}
+	if x.Tls != y.Tls {
+		return false
+	}
+	if x.Token != y.Token {
+		return false
+	}
+	if x.Format != y.Format {
+		return false
+	}
+	if x.Filter != y.Filter {
+		return false
+	}
+	return true
+}
+
+func (x *AuditLogSinkSetting) Equal(y *AuditLogSinkSetting) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Sinks) != len(y.Sinks) {
+		return false
+	}
+	for i := 0; i < len(x.Sinks); i++ {
+		if !x.Sinks[i].Equal(y.Sinks[i]) {
+			return false
+		}
+	}
+	return true
+}
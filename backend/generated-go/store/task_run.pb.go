@@ -479,6 +479,7 @@ type SchedulerInfo_WaitingCause struct {
 	//	*SchedulerInfo_WaitingCause_ConnectionLimit
 	//	*SchedulerInfo_WaitingCause_TaskUid
 	//	*SchedulerInfo_WaitingCause_ParallelTasksLimit
+	//	*SchedulerInfo_WaitingCause_MaintenanceWindow_
 	Cause         isSchedulerInfo_WaitingCause_Cause `protobuf_oneof:"cause"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -548,6 +549,15 @@ func (x *SchedulerInfo_WaitingCause) GetParallelTasksLimit() bool {
 	return false
 }
 
+func (x *SchedulerInfo_WaitingCause) GetMaintenanceWindow() *SchedulerInfo_WaitingCause_MaintenanceWindow {
+	if x != nil {
+		if x, ok := x.Cause.(*SchedulerInfo_WaitingCause_MaintenanceWindow_); ok {
+			return x.MaintenanceWindow
+		}
+	}
+	return nil
+}
+
 type isSchedulerInfo_WaitingCause_Cause interface {
 	isSchedulerInfo_WaitingCause_Cause()
 }
@@ -567,12 +577,64 @@ type SchedulerInfo_WaitingCause_ParallelTasksLimit struct {
 	ParallelTasksLimit bool `protobuf:"varint,3,opt,name=parallel_tasks_limit,json=parallelTasksLimit,proto3,oneof"`
 }
 
+type SchedulerInfo_WaitingCause_MaintenanceWindow_ struct {
+	// Task is waiting for the maintenance window of the environment.
+	MaintenanceWindow *SchedulerInfo_WaitingCause_MaintenanceWindow `protobuf:"bytes,4,opt,name=maintenance_window,json=maintenanceWindow,proto3,oneof"`
+}
+
 func (*SchedulerInfo_WaitingCause_ConnectionLimit) isSchedulerInfo_WaitingCause_Cause() {}
 
 func (*SchedulerInfo_WaitingCause_TaskUid) isSchedulerInfo_WaitingCause_Cause() {}
 
 func (*SchedulerInfo_WaitingCause_ParallelTasksLimit) isSchedulerInfo_WaitingCause_Cause() {}
 
+func (*SchedulerInfo_WaitingCause_MaintenanceWindow_) isSchedulerInfo_WaitingCause_Cause() {}
+
+type SchedulerInfo_WaitingCause_MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start time of the next maintenance window.
+	NextWindowTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=next_window_time,json=nextWindowTime,proto3" json:"next_window_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SchedulerInfo_WaitingCause_MaintenanceWindow) Reset() {
+	*x = SchedulerInfo_WaitingCause_MaintenanceWindow{}
+	mi := &file_store_task_run_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulerInfo_WaitingCause_MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerInfo_WaitingCause_MaintenanceWindow) ProtoMessage() {}
+
+func (x *SchedulerInfo_WaitingCause_MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerInfo_WaitingCause_MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*SchedulerInfo_WaitingCause_MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_store_task_run_proto_rawDescGZIP(), []int{3, 0, 0}
+}
+
+func (x *SchedulerInfo_WaitingCause_MaintenanceWindow) GetNextWindowTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextWindowTime
+	}
+	return nil
+}
+
 var File_store_task_run_proto protoreflect.FileDescriptor
 
 const file_store_task_run_proto_rawDesc = "" +
@@ -607,15 +669,18 @@ const file_store_task_run_proto_rawDesc = "" +
 	"\x05Table\x12\x1a\n" +
 	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
 	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
-	"\x05table\x18\x03 \x01(\tR\x05table\"\xb5\x02\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\"\xff\x03\n" +
 	"\rSchedulerInfo\x12;\n" +
 	"\vreport_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"reportTime\x12O\n" +
-	"\rwaiting_cause\x18\x02 \x01(\v2*.bytebase.store.SchedulerInfo.WaitingCauseR\fwaitingCause\x1a\x95\x01\n" +
+	"\rwaiting_cause\x18\x02 \x01(\v2*.bytebase.store.SchedulerInfo.WaitingCauseR\fwaitingCause\x1a\xdf\x02\n" +
 	"\fWaitingCause\x12+\n" +
 	"\x10connection_limit\x18\x01 \x01(\bH\x00R\x0fconnectionLimit\x12\x1b\n" +
 	"\btask_uid\x18\x02 \x01(\x05H\x00R\ataskUid\x122\n" +
-	"\x14parallel_tasks_limit\x18\x03 \x01(\bH\x00R\x12parallelTasksLimitB\a\n" +
+	"\x14parallel_tasks_limit\x18\x03 \x01(\bH\x00R\x12parallelTasksLimit\x12m\n" +
+	"\x12maintenance_window\x18\x04 \x01(\v2<.bytebase.store.SchedulerInfo.WaitingCause.MaintenanceWindowH\x00R\x11maintenanceWindow\x1aY\n" +
+	"\x11MaintenanceWindow\x12D\n" +
+	"\x10next_window_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0enextWindowTimeB\a\n" +
 	"\x05causeB\x8f\x01\n" +
 	"\x12com.bytebase.storeB\fTaskRunProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
@@ -632,35 +697,38 @@ func file_store_task_run_proto_rawDescGZIP() []byte {
 }
 
 var file_store_task_run_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_task_run_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_task_run_proto_goTypes = []any{
-	(TaskRun_Status)(0),                  // 0: bytebase.store.TaskRun.Status
-	(*TaskRun)(nil),                      // 1: bytebase.store.TaskRun
-	(*TaskRunResult)(nil),                // 2: bytebase.store.TaskRunResult
-	(*PriorBackupDetail)(nil),            // 3: bytebase.store.PriorBackupDetail
-	(*SchedulerInfo)(nil),                // 4: bytebase.store.SchedulerInfo
-	(*PriorBackupDetail_Item)(nil),       // 5: bytebase.store.PriorBackupDetail.Item
-	(*PriorBackupDetail_Item_Table)(nil), // 6: bytebase.store.PriorBackupDetail.Item.Table
-	(*SchedulerInfo_WaitingCause)(nil),   // 7: bytebase.store.SchedulerInfo.WaitingCause
-	(*Position)(nil),                     // 8: bytebase.store.Position
-	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
+	(TaskRun_Status)(0),                                  // 0: bytebase.store.TaskRun.Status
+	(*TaskRun)(nil),                                      // 1: bytebase.store.TaskRun
+	(*TaskRunResult)(nil),                                // 2: bytebase.store.TaskRunResult
+	(*PriorBackupDetail)(nil),                            // 3: bytebase.store.PriorBackupDetail
+	(*SchedulerInfo)(nil),                                // 4: bytebase.store.SchedulerInfo
+	(*PriorBackupDetail_Item)(nil),                       // 5: bytebase.store.PriorBackupDetail.Item
+	(*PriorBackupDetail_Item_Table)(nil),                 // 6: bytebase.store.PriorBackupDetail.Item.Table
+	(*SchedulerInfo_WaitingCause)(nil),                   // 7: bytebase.store.SchedulerInfo.WaitingCause
+	(*SchedulerInfo_WaitingCause_MaintenanceWindow)(nil), // 8: bytebase.store.SchedulerInfo.WaitingCause.MaintenanceWindow
+	(*Position)(nil),                                     // 9: bytebase.store.Position
+	(*timestamppb.Timestamp)(nil),                        // 10: google.protobuf.Timestamp
 }
 var file_store_task_run_proto_depIdxs = []int32{
-	8,  // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.Position
-	8,  // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.Position
+	9,  // 0: bytebase.store.TaskRunResult.start_position:type_name -> bytebase.store.Position
+	9,  // 1: bytebase.store.TaskRunResult.end_position:type_name -> bytebase.store.Position
 	3,  // 2: bytebase.store.TaskRunResult.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
 	5,  // 3: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
-	9,  // 4: bytebase.store.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
+	10, // 4: bytebase.store.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
 	7,  // 5: bytebase.store.SchedulerInfo.waiting_cause:type_name -> bytebase.store.SchedulerInfo.WaitingCause
 	6,  // 6: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
 	6,  // 7: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	8,  // 8: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
-	8,  // 9: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 8: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
+	9,  // 9: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
+	8,  // 10: bytebase.store.SchedulerInfo.WaitingCause.maintenance_window:type_name -> bytebase.store.SchedulerInfo.WaitingCause.MaintenanceWindow
+	10, // 11: bytebase.store.SchedulerInfo.WaitingCause.MaintenanceWindow.next_window_time:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_proto_init() }
@@ -673,6 +741,7 @@ func file_store_task_run_proto_init() {
 		(*SchedulerInfo_WaitingCause_ConnectionLimit)(nil),
 		(*SchedulerInfo_WaitingCause_TaskUid)(nil),
 		(*SchedulerInfo_WaitingCause_ParallelTasksLimit)(nil),
+		(*SchedulerInfo_WaitingCause_MaintenanceWindow_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -680,7 +749,7 @@ func file_store_task_run_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_task_run_proto_rawDesc), len(file_store_task_run_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
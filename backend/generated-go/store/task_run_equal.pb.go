@@ -103,6 +103,19 @@ func (x *PriorBackupDetail) Equal(y *PriorBackupDetail) bool {
 	return true
 }
 
+func (x *SchedulerInfo_WaitingCause_MaintenanceWindow) Equal(y *SchedulerInfo_WaitingCause_MaintenanceWindow) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if p, q := x.NextWindowTime, y.NextWindowTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
 func (x *SchedulerInfo_WaitingCause) Equal(y *SchedulerInfo_WaitingCause) bool {
 	if x == y {
 		return true
@@ -119,6 +132,9 @@ func (x *SchedulerInfo_WaitingCause) Equal(y *SchedulerInfo_WaitingCause) bool {
 	if x.GetParallelTasksLimit() != y.GetParallelTasksLimit() {
 		return false
 	}
+	if !x.GetMaintenanceWindow().Equal(y.GetMaintenanceWindow()) {
+		return false
+	}
 	return true
 }
 
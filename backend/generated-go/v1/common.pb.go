@@ -293,6 +293,10 @@ const (
 	ExportFormat_SQL ExportFormat = 3
 	// Microsoft Excel spreadsheet format.
 	ExportFormat_XLSX ExportFormat = 4
+	// Apache Parquet columnar format.
+	ExportFormat_PARQUET ExportFormat = 5
+	// Apache Arrow IPC file format.
+	ExportFormat_ARROW ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -303,6 +307,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "ARROW",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -310,6 +316,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"ARROW":              6,
 	}
 )
 
@@ -403,6 +411,8 @@ const (
 	MigrationType_DML MigrationType = 2
 	// Used for DDL changes using gh-ost.
 	MigrationType_GHOST MigrationType = 3
+	// Used for DDL changes on PostgreSQL using a shadow table.
+	MigrationType_PG_ONLINE MigrationType = 4
 )
 
 // Enum value maps for MigrationType.
@@ -412,12 +422,14 @@ var (
 		1: "DDL",
 		2: "DML",
 		3: "GHOST",
+		4: "PG_ONLINE",
 	}
 	MigrationType_value = map[string]int32{
 		"MIGRATION_TYPE_UNSPECIFIED": 0,
 		"DDL":                        1,
 		"DML":                        2,
 		"GHOST":                      3,
+		"PG_ONLINE":                  4,
 	}
 )
 
@@ -692,22 +704,25 @@ const file_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"\x06GITLAB\x10\x02\x12\r\n" +
 	"\tBITBUCKET\x10\x03\x12\x10\n" +
-	"\fAZURE_DEVOPS\x10\x04*L\n" +
+	"\fAZURE_DEVOPS\x10\x04*d\n" +
 	"\fExportFormat\x12\x16\n" +
 	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03CSV\x10\x01\x12\b\n" +
 	"\x04JSON\x10\x02\x12\a\n" +
 	"\x03SQL\x10\x03\x12\b\n" +
-	"\x04XLSX\x10\x04*P\n" +
+	"\x04XLSX\x10\x04\x12\v\n" +
+	"\aPARQUET\x10\x05\x12\t\n" +
+	"\x05ARROW\x10\x06*P\n" +
 	"\x12DatabaseChangeType\x12$\n" +
 	" DATABASE_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aMIGRATE\x10\x02\x12\a\n" +
-	"\x03SDL\x10\x03*L\n" +
+	"\x03SDL\x10\x03*[\n" +
 	"\rMigrationType\x12\x1e\n" +
 	"\x1aMIGRATION_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03DDL\x10\x01\x12\a\n" +
 	"\x03DML\x10\x02\x12\t\n" +
-	"\x05GHOST\x10\x03*H\n" +
+	"\x05GHOST\x10\x03\x12\r\n" +
+	"\tPG_ONLINE\x10\x04*H\n" +
 	"\tRiskLevel\x12\x1a\n" +
 	"\x16RISK_LEVEL_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03LOW\x10\x01\x12\f\n" +
@@ -563,6 +563,8 @@ const (
 	Changelog_DML Changelog_MigrationType = 2
 	// Used for DDL changes using gh-ost.
 	Changelog_GHOST Changelog_MigrationType = 3
+	// Used for DDL changes on PostgreSQL using a shadow table.
+	Changelog_PG_ONLINE Changelog_MigrationType = 4
 )
 
 // Enum value maps for Changelog_MigrationType.
@@ -572,12 +574,14 @@ var (
 		1: "DDL",
 		2: "DML",
 		3: "GHOST",
+		4: "PG_ONLINE",
 	}
 	Changelog_MigrationType_value = map[string]int32{
 		"MIGRATION_TYPE_UNSPECIFIED": 0,
 		"DDL":                        1,
 		"DML":                        2,
 		"GHOST":                      3,
+		"PG_ONLINE":                  4,
 	}
 )
 
@@ -6628,7 +6632,7 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\x13GetChangelogRequest\x12:\n" +
 	"\x04name\x18\x01 \x01(\tB&\xe0A\x02\xfaA \n" +
 	"\x1ebytebase.com/DatabaseChangelogR\x04name\x12.\n" +
-	"\x04view\x18\x02 \x01(\x0e2\x1a.bytebase.v1.ChangelogViewR\x04view\"\xf2\a\n" +
+	"\x04view\x18\x02 \x01(\x0e2\x1a.bytebase.v1.ChangelogViewR\x04view\"\x81\b\n" +
 	"\tChangelog\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
 	"\vcreate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -6661,12 +6665,13 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bBASELINE\x10\x01\x12\v\n" +
 	"\aMIGRATE\x10\x02\x12\a\n" +
-	"\x03SDL\x10\x03\"L\n" +
+	"\x03SDL\x10\x03\"[\n" +
 	"\rMigrationType\x12\x1e\n" +
 	"\x1aMIGRATION_TYPE_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03DDL\x10\x01\x12\a\n" +
 	"\x03DML\x10\x02\x12\t\n" +
-	"\x05GHOST\x10\x03:e\xeaAb\n" +
+	"\x05GHOST\x10\x03\x12\r\n" +
+	"\tPG_ONLINE\x10\x04:e\xeaAb\n" +
 	"\x1ebytebase.com/DatabaseChangelog\x12@instances/{instance}/databases/{database}/changelogs/{changelog}\"\x97\x03\n" +
 	"\x16GetSchemaStringRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
@@ -267,6 +267,59 @@ func (Issue_Approver_Status) EnumDescriptor() ([]byte, []int) {
 	return file_v1_issue_service_proto_rawDescGZIP(), []int{12, 0, 0}
 }
 
+// Type defines how the approver sets in the step are approved.
+type ApprovalStep_Type int32
+
+const (
+	// Unspecified type, treated as sequential.
+	ApprovalStep_TYPE_UNSPECIFIED ApprovalStep_Type = 0
+	// The approver sets are approved one after another, in order.
+	ApprovalStep_SEQUENTIAL ApprovalStep_Type = 1
+	// The approver sets are approved at the same time.
+	ApprovalStep_PARALLEL ApprovalStep_Type = 2
+)
+
+// Enum value maps for ApprovalStep_Type.
+var (
+	ApprovalStep_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SEQUENTIAL",
+		2: "PARALLEL",
+	}
+	ApprovalStep_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SEQUENTIAL":       1,
+		"PARALLEL":         2,
+	}
+)
+
+func (x ApprovalStep_Type) Enum() *ApprovalStep_Type {
+	p := new(ApprovalStep_Type)
+	*p = x
+	return p
+}
+
+func (x ApprovalStep_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStep_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_issue_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ApprovalStep_Type) Type() protoreflect.EnumType {
+	return &file_v1_issue_service_proto_enumTypes[4]
+}
+
+func (x ApprovalStep_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStep_Type.Descriptor instead.
+func (ApprovalStep_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{16, 0}
+}
+
 // Approval status values.
 type IssueComment_Approval_Status int32
 
@@ -308,11 +361,11 @@ func (x IssueComment_Approval_Status) String() string {
 }
 
 func (IssueComment_Approval_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_issue_service_proto_enumTypes[4].Descriptor()
+	return file_v1_issue_service_proto_enumTypes[5].Descriptor()
 }
 
 func (IssueComment_Approval_Status) Type() protoreflect.EnumType {
-	return &file_v1_issue_service_proto_enumTypes[4]
+	return &file_v1_issue_service_proto_enumTypes[5]
 }
 
 func (x IssueComment_Approval_Status) Number() protoreflect.EnumNumber {
@@ -321,7 +374,7 @@ func (x IssueComment_Approval_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IssueComment_Approval_Status.Descriptor instead.
 func (IssueComment_Approval_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 0, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 0, 0}
 }
 
 // Task status values.
@@ -377,11 +430,11 @@ func (x IssueComment_TaskUpdate_Status) String() string {
 }
 
 func (IssueComment_TaskUpdate_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_issue_service_proto_enumTypes[5].Descriptor()
+	return file_v1_issue_service_proto_enumTypes[6].Descriptor()
 }
 
 func (IssueComment_TaskUpdate_Status) Type() protoreflect.EnumType {
-	return &file_v1_issue_service_proto_enumTypes[5]
+	return &file_v1_issue_service_proto_enumTypes[6]
 }
 
 func (x IssueComment_TaskUpdate_Status) Number() protoreflect.EnumNumber {
@@ -390,7 +443,7 @@ func (x IssueComment_TaskUpdate_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IssueComment_TaskUpdate_Status.Descriptor instead.
 func (IssueComment_TaskUpdate_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 3, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 3, 0}
 }
 
 type GetIssueRequest struct {
@@ -1530,7 +1583,10 @@ func (x *ApprovalTemplate) GetDescription() string {
 type ApprovalFlow struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The roles required for approval in order.
-	Roles         []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// Ignored if the steps are set.
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// The approval steps required for approval in order.
+	Steps         []*ApprovalStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1572,6 +1628,68 @@ func (x *ApprovalFlow) GetRoles() []string {
 	return nil
 }
 
+func (x *ApprovalFlow) GetSteps() []*ApprovalStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// ApprovalStep is a step of the approval flow consisting of one or more approver sets.
+type ApprovalStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How the approver sets are approved.
+	Type ApprovalStep_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.v1.ApprovalStep_Type" json:"type,omitempty"`
+	// All approver sets must approve to complete the step.
+	ApproverSets  []*ApprovalStep_ApproverSet `protobuf:"bytes,2,rep,name=approver_sets,json=approverSets,proto3" json:"approver_sets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStep) Reset() {
+	*x = ApprovalStep{}
+	mi := &file_v1_issue_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStep) ProtoMessage() {}
+
+func (x *ApprovalStep) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_issue_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStep.ProtoReflect.Descriptor instead.
+func (*ApprovalStep) Descriptor() ([]byte, []int) {
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ApprovalStep) GetType() ApprovalStep_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ApprovalStep_TYPE_UNSPECIFIED
+}
+
+func (x *ApprovalStep) GetApproverSets() []*ApprovalStep_ApproverSet {
+	if x != nil {
+		return x.ApproverSets
+	}
+	return nil
+}
+
 type ListIssueCommentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Format: projects/{projects}/issues/{issue}
@@ -1593,7 +1711,7 @@ type ListIssueCommentsRequest struct {
 
 func (x *ListIssueCommentsRequest) Reset() {
 	*x = ListIssueCommentsRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[16]
+	mi := &file_v1_issue_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1605,7 +1723,7 @@ func (x *ListIssueCommentsRequest) String() string {
 func (*ListIssueCommentsRequest) ProtoMessage() {}
 
 func (x *ListIssueCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[16]
+	mi := &file_v1_issue_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,7 +1736,7 @@ func (x *ListIssueCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssueCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListIssueCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{16}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListIssueCommentsRequest) GetParent() string {
@@ -1655,7 +1773,7 @@ type ListIssueCommentsResponse struct {
 
 func (x *ListIssueCommentsResponse) Reset() {
 	*x = ListIssueCommentsResponse{}
-	mi := &file_v1_issue_service_proto_msgTypes[17]
+	mi := &file_v1_issue_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1667,7 +1785,7 @@ func (x *ListIssueCommentsResponse) String() string {
 func (*ListIssueCommentsResponse) ProtoMessage() {}
 
 func (x *ListIssueCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[17]
+	mi := &file_v1_issue_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,7 +1798,7 @@ func (x *ListIssueCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssueCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListIssueCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{17}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListIssueCommentsResponse) GetIssueComments() []*IssueComment {
@@ -1710,7 +1828,7 @@ type CreateIssueCommentRequest struct {
 
 func (x *CreateIssueCommentRequest) Reset() {
 	*x = CreateIssueCommentRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[18]
+	mi := &file_v1_issue_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1722,7 +1840,7 @@ func (x *CreateIssueCommentRequest) String() string {
 func (*CreateIssueCommentRequest) ProtoMessage() {}
 
 func (x *CreateIssueCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[18]
+	mi := &file_v1_issue_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +1853,7 @@ func (x *CreateIssueCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIssueCommentRequest.ProtoReflect.Descriptor instead.
 func (*CreateIssueCommentRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{18}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateIssueCommentRequest) GetParent() string {
@@ -1770,7 +1888,7 @@ type UpdateIssueCommentRequest struct {
 
 func (x *UpdateIssueCommentRequest) Reset() {
 	*x = UpdateIssueCommentRequest{}
-	mi := &file_v1_issue_service_proto_msgTypes[19]
+	mi := &file_v1_issue_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1782,7 +1900,7 @@ func (x *UpdateIssueCommentRequest) String() string {
 func (*UpdateIssueCommentRequest) ProtoMessage() {}
 
 func (x *UpdateIssueCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[19]
+	mi := &file_v1_issue_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,7 +1913,7 @@ func (x *UpdateIssueCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIssueCommentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIssueCommentRequest) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{19}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateIssueCommentRequest) GetParent() string {
@@ -1855,7 +1973,7 @@ type IssueComment struct {
 
 func (x *IssueComment) Reset() {
 	*x = IssueComment{}
-	mi := &file_v1_issue_service_proto_msgTypes[20]
+	mi := &file_v1_issue_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1867,7 +1985,7 @@ func (x *IssueComment) String() string {
 func (*IssueComment) ProtoMessage() {}
 
 func (x *IssueComment) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[20]
+	mi := &file_v1_issue_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,7 +1998,7 @@ func (x *IssueComment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment.ProtoReflect.Descriptor instead.
 func (*IssueComment) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *IssueComment) GetName() string {
@@ -2022,14 +2140,20 @@ type Issue_Approver struct {
 	// The new status.
 	Status Issue_Approver_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bytebase.v1.Issue_Approver_Status" json:"status,omitempty"`
 	// Format: users/hello@world.com
-	Principal     string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// The index of the approval step approved by the approver.
+	// Only used by the approval flows with steps.
+	Step int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	// The index of the approver set in the approval step approved by the approver.
+	// Only used by the approval flows with steps.
+	ApproverSet   int32 `protobuf:"varint,4,opt,name=approver_set,json=approverSet,proto3" json:"approver_set,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Issue_Approver) Reset() {
 	*x = Issue_Approver{}
-	mi := &file_v1_issue_service_proto_msgTypes[21]
+	mi := &file_v1_issue_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2041,7 +2165,7 @@ func (x *Issue_Approver) String() string {
 func (*Issue_Approver) ProtoMessage() {}
 
 func (x *Issue_Approver) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[21]
+	mi := &file_v1_issue_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,6 +2195,77 @@ func (x *Issue_Approver) GetPrincipal() string {
 	return ""
 }
 
+func (x *Issue_Approver) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *Issue_Approver) GetApproverSet() int32 {
+	if x != nil {
+		return x.ApproverSet
+	}
+	return 0
+}
+
+// ApproverSet is a set of approvers of which the required number of approvers must approve.
+type ApprovalStep_ApproverSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The approvers in the format of
+	// "roles/{role}", "groups/{email}", "users/{email}" or "externalApprovalNodes/{id}".
+	Approvers []string `protobuf:"bytes,1,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	// The number of the distinct approvers required to approve the set.
+	// Zero means one.
+	RequiredCount int32 `protobuf:"varint,2,opt,name=required_count,json=requiredCount,proto3" json:"required_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalStep_ApproverSet) Reset() {
+	*x = ApprovalStep_ApproverSet{}
+	mi := &file_v1_issue_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStep_ApproverSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStep_ApproverSet) ProtoMessage() {}
+
+func (x *ApprovalStep_ApproverSet) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_issue_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStep_ApproverSet.ProtoReflect.Descriptor instead.
+func (*ApprovalStep_ApproverSet) Descriptor() ([]byte, []int) {
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *ApprovalStep_ApproverSet) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *ApprovalStep_ApproverSet) GetRequiredCount() int32 {
+	if x != nil {
+		return x.RequiredCount
+	}
+	return 0
+}
+
 // Approval event information.
 type IssueComment_Approval struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2082,7 +2277,7 @@ type IssueComment_Approval struct {
 
 func (x *IssueComment_Approval) Reset() {
 	*x = IssueComment_Approval{}
-	mi := &file_v1_issue_service_proto_msgTypes[23]
+	mi := &file_v1_issue_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2094,7 +2289,7 @@ func (x *IssueComment_Approval) String() string {
 func (*IssueComment_Approval) ProtoMessage() {}
 
 func (x *IssueComment_Approval) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[23]
+	mi := &file_v1_issue_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,7 +2302,7 @@ func (x *IssueComment_Approval) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_Approval.ProtoReflect.Descriptor instead.
 func (*IssueComment_Approval) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *IssueComment_Approval) GetStatus() IssueComment_Approval_Status {
@@ -2134,7 +2329,7 @@ type IssueComment_IssueUpdate struct {
 
 func (x *IssueComment_IssueUpdate) Reset() {
 	*x = IssueComment_IssueUpdate{}
-	mi := &file_v1_issue_service_proto_msgTypes[24]
+	mi := &file_v1_issue_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2146,7 +2341,7 @@ func (x *IssueComment_IssueUpdate) String() string {
 func (*IssueComment_IssueUpdate) ProtoMessage() {}
 
 func (x *IssueComment_IssueUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[24]
+	mi := &file_v1_issue_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2159,7 +2354,7 @@ func (x *IssueComment_IssueUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_IssueUpdate.ProtoReflect.Descriptor instead.
 func (*IssueComment_IssueUpdate) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 1}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *IssueComment_IssueUpdate) GetFromTitle() string {
@@ -2229,7 +2424,7 @@ type IssueComment_StageEnd struct {
 
 func (x *IssueComment_StageEnd) Reset() {
 	*x = IssueComment_StageEnd{}
-	mi := &file_v1_issue_service_proto_msgTypes[25]
+	mi := &file_v1_issue_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2241,7 +2436,7 @@ func (x *IssueComment_StageEnd) String() string {
 func (*IssueComment_StageEnd) ProtoMessage() {}
 
 func (x *IssueComment_StageEnd) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[25]
+	mi := &file_v1_issue_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2254,7 +2449,7 @@ func (x *IssueComment_StageEnd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_StageEnd.ProtoReflect.Descriptor instead.
 func (*IssueComment_StageEnd) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 2}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 2}
 }
 
 func (x *IssueComment_StageEnd) GetStage() string {
@@ -2280,7 +2475,7 @@ type IssueComment_TaskUpdate struct {
 
 func (x *IssueComment_TaskUpdate) Reset() {
 	*x = IssueComment_TaskUpdate{}
-	mi := &file_v1_issue_service_proto_msgTypes[26]
+	mi := &file_v1_issue_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2292,7 +2487,7 @@ func (x *IssueComment_TaskUpdate) String() string {
 func (*IssueComment_TaskUpdate) ProtoMessage() {}
 
 func (x *IssueComment_TaskUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[26]
+	mi := &file_v1_issue_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2500,7 @@ func (x *IssueComment_TaskUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_TaskUpdate.ProtoReflect.Descriptor instead.
 func (*IssueComment_TaskUpdate) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 3}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 3}
 }
 
 func (x *IssueComment_TaskUpdate) GetTasks() []string {
@@ -2355,7 +2550,7 @@ type IssueComment_TaskPriorBackup struct {
 
 func (x *IssueComment_TaskPriorBackup) Reset() {
 	*x = IssueComment_TaskPriorBackup{}
-	mi := &file_v1_issue_service_proto_msgTypes[27]
+	mi := &file_v1_issue_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2367,7 +2562,7 @@ func (x *IssueComment_TaskPriorBackup) String() string {
 func (*IssueComment_TaskPriorBackup) ProtoMessage() {}
 
 func (x *IssueComment_TaskPriorBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[27]
+	mi := &file_v1_issue_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2380,7 +2575,7 @@ func (x *IssueComment_TaskPriorBackup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueComment_TaskPriorBackup.ProtoReflect.Descriptor instead.
 func (*IssueComment_TaskPriorBackup) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 4}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 4}
 }
 
 func (x *IssueComment_TaskPriorBackup) GetTask() string {
@@ -2431,7 +2626,7 @@ type IssueComment_TaskPriorBackup_Table struct {
 
 func (x *IssueComment_TaskPriorBackup_Table) Reset() {
 	*x = IssueComment_TaskPriorBackup_Table{}
-	mi := &file_v1_issue_service_proto_msgTypes[28]
+	mi := &file_v1_issue_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2443,7 +2638,7 @@ func (x *IssueComment_TaskPriorBackup_Table) String() string {
 func (*IssueComment_TaskPriorBackup_Table) ProtoMessage() {}
 
 func (x *IssueComment_TaskPriorBackup_Table) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_issue_service_proto_msgTypes[28]
+	mi := &file_v1_issue_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2456,7 +2651,7 @@ func (x *IssueComment_TaskPriorBackup_Table) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use IssueComment_TaskPriorBackup_Table.ProtoReflect.Descriptor instead.
 func (*IssueComment_TaskPriorBackup_Table) Descriptor() ([]byte, []int) {
-	return file_v1_issue_service_proto_rawDescGZIP(), []int{20, 4, 0}
+	return file_v1_issue_service_proto_rawDescGZIP(), []int{21, 4, 0}
 }
 
 func (x *IssueComment_TaskPriorBackup_Table) GetSchema() string {
@@ -2531,7 +2726,7 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\x13RequestIssueRequest\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
 	"\x12bytebase.com/IssueR\x04name\x12\x18\n" +
-	"\acomment\x18\x02 \x01(\tR\acomment\"\xd8\v\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\"\x8f\f\n" +
 	"\x05Issue\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\x05title\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x05title\x12*\n" +
@@ -2555,10 +2750,12 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\x11task_status_count\x18\x16 \x03(\v2'.bytebase.v1.Issue.TaskStatusCountEntryR\x0ftaskStatusCount\x12\x16\n" +
 	"\x06labels\x18\x17 \x03(\tR\x06labels\x12O\n" +
 	"\x0fapproval_status\x18\x18 \x01(\x0e2!.bytebase.v1.Issue.ApprovalStatusB\x03\xe0A\x03R\x0eapprovalStatus\x127\n" +
-	"\x15approval_status_error\x18\x19 \x01(\tB\x03\xe0A\x03R\x13approvalStatusError\x1a\xaf\x01\n" +
+	"\x15approval_status_error\x18\x19 \x01(\tB\x03\xe0A\x03R\x13approvalStatusError\x1a\xe6\x01\n" +
 	"\bApprover\x12:\n" +
 	"\x06status\x18\x01 \x01(\x0e2\".bytebase.v1.Issue.Approver.StatusR\x06status\x12\x1c\n" +
-	"\tprincipal\x18\x02 \x01(\tR\tprincipal\"I\n" +
+	"\tprincipal\x18\x02 \x01(\tR\tprincipal\x12\x12\n" +
+	"\x04step\x18\x03 \x01(\x05R\x04step\x12!\n" +
+	"\fapprover_set\x18\x04 \x01(\x05R\vapproverSet\"I\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\f\n" +
@@ -2592,9 +2789,21 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\x02id\x18\x04 \x01(\tR\x02id\x12-\n" +
 	"\x04flow\x18\x01 \x01(\v2\x19.bytebase.v1.ApprovalFlowR\x04flow\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"$\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"U\n" +
 	"\fApprovalFlow\x12\x14\n" +
-	"\x05roles\x18\x01 \x03(\tR\x05roles\"\x8a\x01\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\x12/\n" +
+	"\x05steps\x18\x02 \x03(\v2\x19.bytebase.v1.ApprovalStepR\x05steps\"\x9e\x02\n" +
+	"\fApprovalStep\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.bytebase.v1.ApprovalStep.TypeR\x04type\x12J\n" +
+	"\rapprover_sets\x18\x02 \x03(\v2%.bytebase.v1.ApprovalStep.ApproverSetR\fapproverSets\x1aR\n" +
+	"\vApproverSet\x12\x1c\n" +
+	"\tapprovers\x18\x01 \x03(\tR\tapprovers\x12%\n" +
+	"\x0erequired_count\x18\x02 \x01(\x05R\rrequiredCount\":\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SEQUENTIAL\x10\x01\x12\f\n" +
+	"\bPARALLEL\x10\x02\"\x8a\x01\n" +
 	"\x18ListIssueCommentsRequest\x122\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
 	"\x12bytebase.com/IssueR\x06parent\x12\x1b\n" +
@@ -2712,6 +2921,7 @@ const file_v1_issue_service_proto_rawDesc = "" +
 	"\fRequestIssue\x12 .bytebase.v1.RequestIssueRequest\x1a\x12.bytebase.v1.Issue\"9\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{name=projects/*/issues/*}:requestB\xa7\x01\n" +
 	"\x0fcom.bytebase.v1B\x11IssueServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
+// Enum value maps for IssueStatus.
 var (
 	file_v1_issue_service_proto_rawDescOnce sync.Once
 	file_v1_issue_service_proto_rawDescData []byte
@@ -2724,116 +2934,122 @@ func file_v1_issue_service_proto_rawDescGZIP() []byte {
 	return file_v1_issue_service_proto_rawDescData
 }
 
-var file_v1_issue_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_v1_issue_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_v1_issue_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_v1_issue_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_v1_issue_service_proto_goTypes = []any{
 	(IssueStatus)(0),                           // 0: bytebase.v1.IssueStatus
 	(Issue_Type)(0),                            // 1: bytebase.v1.Issue.Type
 	(Issue_ApprovalStatus)(0),                  // 2: bytebase.v1.Issue.ApprovalStatus
 	(Issue_Approver_Status)(0),                 // 3: bytebase.v1.Issue.Approver.Status
-	(IssueComment_Approval_Status)(0),          // 4: bytebase.v1.IssueComment.Approval.Status
-	(IssueComment_TaskUpdate_Status)(0),        // 5: bytebase.v1.IssueComment.TaskUpdate.Status
-	(*GetIssueRequest)(nil),                    // 6: bytebase.v1.GetIssueRequest
-	(*CreateIssueRequest)(nil),                 // 7: bytebase.v1.CreateIssueRequest
-	(*ListIssuesRequest)(nil),                  // 8: bytebase.v1.ListIssuesRequest
-	(*ListIssuesResponse)(nil),                 // 9: bytebase.v1.ListIssuesResponse
-	(*SearchIssuesRequest)(nil),                // 10: bytebase.v1.SearchIssuesRequest
-	(*SearchIssuesResponse)(nil),               // 11: bytebase.v1.SearchIssuesResponse
-	(*UpdateIssueRequest)(nil),                 // 12: bytebase.v1.UpdateIssueRequest
-	(*BatchUpdateIssuesStatusRequest)(nil),     // 13: bytebase.v1.BatchUpdateIssuesStatusRequest
-	(*BatchUpdateIssuesStatusResponse)(nil),    // 14: bytebase.v1.BatchUpdateIssuesStatusResponse
-	(*ApproveIssueRequest)(nil),                // 15: bytebase.v1.ApproveIssueRequest
-	(*RejectIssueRequest)(nil),                 // 16: bytebase.v1.RejectIssueRequest
-	(*RequestIssueRequest)(nil),                // 17: bytebase.v1.RequestIssueRequest
-	(*Issue)(nil),                              // 18: bytebase.v1.Issue
-	(*GrantRequest)(nil),                       // 19: bytebase.v1.GrantRequest
-	(*ApprovalTemplate)(nil),                   // 20: bytebase.v1.ApprovalTemplate
-	(*ApprovalFlow)(nil),                       // 21: bytebase.v1.ApprovalFlow
-	(*ListIssueCommentsRequest)(nil),           // 22: bytebase.v1.ListIssueCommentsRequest
-	(*ListIssueCommentsResponse)(nil),          // 23: bytebase.v1.ListIssueCommentsResponse
-	(*CreateIssueCommentRequest)(nil),          // 24: bytebase.v1.CreateIssueCommentRequest
-	(*UpdateIssueCommentRequest)(nil),          // 25: bytebase.v1.UpdateIssueCommentRequest
-	(*IssueComment)(nil),                       // 26: bytebase.v1.IssueComment
-	(*Issue_Approver)(nil),                     // 27: bytebase.v1.Issue.Approver
-	nil,                                        // 28: bytebase.v1.Issue.TaskStatusCountEntry
-	(*IssueComment_Approval)(nil),              // 29: bytebase.v1.IssueComment.Approval
-	(*IssueComment_IssueUpdate)(nil),           // 30: bytebase.v1.IssueComment.IssueUpdate
-	(*IssueComment_StageEnd)(nil),              // 31: bytebase.v1.IssueComment.StageEnd
-	(*IssueComment_TaskUpdate)(nil),            // 32: bytebase.v1.IssueComment.TaskUpdate
-	(*IssueComment_TaskPriorBackup)(nil),       // 33: bytebase.v1.IssueComment.TaskPriorBackup
-	(*IssueComment_TaskPriorBackup_Table)(nil), // 34: bytebase.v1.IssueComment.TaskPriorBackup.Table
-	(*fieldmaskpb.FieldMask)(nil),              // 35: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),              // 36: google.protobuf.Timestamp
-	(RiskLevel)(0),                             // 37: bytebase.v1.RiskLevel
-	(*expr.Expr)(nil),                          // 38: google.type.Expr
-	(*durationpb.Duration)(nil),                // 39: google.protobuf.Duration
+	(ApprovalStep_Type)(0),                     // 4: bytebase.v1.ApprovalStep.Type
+	(IssueComment_Approval_Status)(0),          // 5: bytebase.v1.IssueComment.Approval.Status
+	(IssueComment_TaskUpdate_Status)(0),        // 6: bytebase.v1.IssueComment.TaskUpdate.Status
+	(*GetIssueRequest)(nil),                    // 7: bytebase.v1.GetIssueRequest
+	(*CreateIssueRequest)(nil),                 // 8: bytebase.v1.CreateIssueRequest
+	(*ListIssuesRequest)(nil),                  // 9: bytebase.v1.ListIssuesRequest
+	(*ListIssuesResponse)(nil),                 // 10: bytebase.v1.ListIssuesResponse
+	(*SearchIssuesRequest)(nil),                // 11: bytebase.v1.SearchIssuesRequest
+	(*SearchIssuesResponse)(nil),               // 12: bytebase.v1.SearchIssuesResponse
+	(*UpdateIssueRequest)(nil),                 // 13: bytebase.v1.UpdateIssueRequest
+	(*BatchUpdateIssuesStatusRequest)(nil),     // 14: bytebase.v1.BatchUpdateIssuesStatusRequest
+	(*BatchUpdateIssuesStatusResponse)(nil),    // 15: bytebase.v1.BatchUpdateIssuesStatusResponse
+	(*ApproveIssueRequest)(nil),                // 16: bytebase.v1.ApproveIssueRequest
+	(*RejectIssueRequest)(nil),                 // 17: bytebase.v1.RejectIssueRequest
+	(*RequestIssueRequest)(nil),                // 18: bytebase.v1.RequestIssueRequest
+	(*Issue)(nil),                              // 19: bytebase.v1.Issue
+	(*GrantRequest)(nil),                       // 20: bytebase.v1.GrantRequest
+	(*ApprovalTemplate)(nil),                   // 21: bytebase.v1.ApprovalTemplate
+	(*ApprovalFlow)(nil),                       // 22: bytebase.v1.ApprovalFlow
+	(*ApprovalStep)(nil),                       // 23: bytebase.v1.ApprovalStep
+	(*ListIssueCommentsRequest)(nil),           // 24: bytebase.v1.ListIssueCommentsRequest
+	(*ListIssueCommentsResponse)(nil),          // 25: bytebase.v1.ListIssueCommentsResponse
+	(*CreateIssueCommentRequest)(nil),          // 26: bytebase.v1.CreateIssueCommentRequest
+	(*UpdateIssueCommentRequest)(nil),          // 27: bytebase.v1.UpdateIssueCommentRequest
+	(*IssueComment)(nil),                       // 28: bytebase.v1.IssueComment
+	(*Issue_Approver)(nil),                     // 29: bytebase.v1.Issue.Approver
+	nil,                                        // 30: bytebase.v1.Issue.TaskStatusCountEntry
+	(*ApprovalStep_ApproverSet)(nil),           // 31: bytebase.v1.ApprovalStep.ApproverSet
+	(*IssueComment_Approval)(nil),              // 32: bytebase.v1.IssueComment.Approval
+	(*IssueComment_IssueUpdate)(nil),           // 33: bytebase.v1.IssueComment.IssueUpdate
+	(*IssueComment_StageEnd)(nil),              // 34: bytebase.v1.IssueComment.StageEnd
+	(*IssueComment_TaskUpdate)(nil),            // 35: bytebase.v1.IssueComment.TaskUpdate
+	(*IssueComment_TaskPriorBackup)(nil),       // 36: bytebase.v1.IssueComment.TaskPriorBackup
+	(*IssueComment_TaskPriorBackup_Table)(nil), // 37: bytebase.v1.IssueComment.TaskPriorBackup.Table
+	(*fieldmaskpb.FieldMask)(nil),              // 38: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),              // 39: google.protobuf.Timestamp
+	(RiskLevel)(0),                             // 40: bytebase.v1.RiskLevel
+	(*expr.Expr)(nil),                          // 41: google.type.Expr
+	(*durationpb.Duration)(nil),                // 42: google.protobuf.Duration
 }
 var file_v1_issue_service_proto_depIdxs = []int32{
-	18, // 0: bytebase.v1.CreateIssueRequest.issue:type_name -> bytebase.v1.Issue
-	18, // 1: bytebase.v1.ListIssuesResponse.issues:type_name -> bytebase.v1.Issue
-	18, // 2: bytebase.v1.SearchIssuesResponse.issues:type_name -> bytebase.v1.Issue
-	18, // 3: bytebase.v1.UpdateIssueRequest.issue:type_name -> bytebase.v1.Issue
-	35, // 4: bytebase.v1.UpdateIssueRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 0: bytebase.v1.CreateIssueRequest.issue:type_name -> bytebase.v1.Issue
+	19, // 1: bytebase.v1.ListIssuesResponse.issues:type_name -> bytebase.v1.Issue
+	19, // 2: bytebase.v1.SearchIssuesResponse.issues:type_name -> bytebase.v1.Issue
+	19, // 3: bytebase.v1.UpdateIssueRequest.issue:type_name -> bytebase.v1.Issue
+	38, // 4: bytebase.v1.UpdateIssueRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 5: bytebase.v1.BatchUpdateIssuesStatusRequest.status:type_name -> bytebase.v1.IssueStatus
 	1,  // 6: bytebase.v1.Issue.type:type_name -> bytebase.v1.Issue.Type
 	0,  // 7: bytebase.v1.Issue.status:type_name -> bytebase.v1.IssueStatus
-	27, // 8: bytebase.v1.Issue.approvers:type_name -> bytebase.v1.Issue.Approver
-	20, // 9: bytebase.v1.Issue.approval_template:type_name -> bytebase.v1.ApprovalTemplate
-	36, // 10: bytebase.v1.Issue.create_time:type_name -> google.protobuf.Timestamp
-	36, // 11: bytebase.v1.Issue.update_time:type_name -> google.protobuf.Timestamp
-	19, // 12: bytebase.v1.Issue.grant_request:type_name -> bytebase.v1.GrantRequest
-	37, // 13: bytebase.v1.Issue.risk_level:type_name -> bytebase.v1.RiskLevel
-	28, // 14: bytebase.v1.Issue.task_status_count:type_name -> bytebase.v1.Issue.TaskStatusCountEntry
+	29, // 8: bytebase.v1.Issue.approvers:type_name -> bytebase.v1.Issue.Approver
+	21, // 9: bytebase.v1.Issue.approval_template:type_name -> bytebase.v1.ApprovalTemplate
+	39, // 10: bytebase.v1.Issue.create_time:type_name -> google.protobuf.Timestamp
+	39, // 11: bytebase.v1.Issue.update_time:type_name -> google.protobuf.Timestamp
+	20, // 12: bytebase.v1.Issue.grant_request:type_name -> bytebase.v1.GrantRequest
+	40, // 13: bytebase.v1.Issue.risk_level:type_name -> bytebase.v1.RiskLevel
+	30, // 14: bytebase.v1.Issue.task_status_count:type_name -> bytebase.v1.Issue.TaskStatusCountEntry
 	2,  // 15: bytebase.v1.Issue.approval_status:type_name -> bytebase.v1.Issue.ApprovalStatus
-	38, // 16: bytebase.v1.GrantRequest.condition:type_name -> google.type.Expr
-	39, // 17: bytebase.v1.GrantRequest.expiration:type_name -> google.protobuf.Duration
-	21, // 18: bytebase.v1.ApprovalTemplate.flow:type_name -> bytebase.v1.ApprovalFlow
-	26, // 19: bytebase.v1.ListIssueCommentsResponse.issue_comments:type_name -> bytebase.v1.IssueComment
-	26, // 20: bytebase.v1.CreateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
-	26, // 21: bytebase.v1.UpdateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
-	35, // 22: bytebase.v1.UpdateIssueCommentRequest.update_mask:type_name -> google.protobuf.FieldMask
-	36, // 23: bytebase.v1.IssueComment.create_time:type_name -> google.protobuf.Timestamp
-	36, // 24: bytebase.v1.IssueComment.update_time:type_name -> google.protobuf.Timestamp
-	29, // 25: bytebase.v1.IssueComment.approval:type_name -> bytebase.v1.IssueComment.Approval
-	30, // 26: bytebase.v1.IssueComment.issue_update:type_name -> bytebase.v1.IssueComment.IssueUpdate
-	31, // 27: bytebase.v1.IssueComment.stage_end:type_name -> bytebase.v1.IssueComment.StageEnd
-	32, // 28: bytebase.v1.IssueComment.task_update:type_name -> bytebase.v1.IssueComment.TaskUpdate
-	33, // 29: bytebase.v1.IssueComment.task_prior_backup:type_name -> bytebase.v1.IssueComment.TaskPriorBackup
-	3,  // 30: bytebase.v1.Issue.Approver.status:type_name -> bytebase.v1.Issue.Approver.Status
-	4,  // 31: bytebase.v1.IssueComment.Approval.status:type_name -> bytebase.v1.IssueComment.Approval.Status
-	0,  // 32: bytebase.v1.IssueComment.IssueUpdate.from_status:type_name -> bytebase.v1.IssueStatus
-	0,  // 33: bytebase.v1.IssueComment.IssueUpdate.to_status:type_name -> bytebase.v1.IssueStatus
-	5,  // 34: bytebase.v1.IssueComment.TaskUpdate.to_status:type_name -> bytebase.v1.IssueComment.TaskUpdate.Status
-	34, // 35: bytebase.v1.IssueComment.TaskPriorBackup.tables:type_name -> bytebase.v1.IssueComment.TaskPriorBackup.Table
-	6,  // 36: bytebase.v1.IssueService.GetIssue:input_type -> bytebase.v1.GetIssueRequest
-	7,  // 37: bytebase.v1.IssueService.CreateIssue:input_type -> bytebase.v1.CreateIssueRequest
-	8,  // 38: bytebase.v1.IssueService.ListIssues:input_type -> bytebase.v1.ListIssuesRequest
-	10, // 39: bytebase.v1.IssueService.SearchIssues:input_type -> bytebase.v1.SearchIssuesRequest
-	12, // 40: bytebase.v1.IssueService.UpdateIssue:input_type -> bytebase.v1.UpdateIssueRequest
-	22, // 41: bytebase.v1.IssueService.ListIssueComments:input_type -> bytebase.v1.ListIssueCommentsRequest
-	24, // 42: bytebase.v1.IssueService.CreateIssueComment:input_type -> bytebase.v1.CreateIssueCommentRequest
-	25, // 43: bytebase.v1.IssueService.UpdateIssueComment:input_type -> bytebase.v1.UpdateIssueCommentRequest
-	13, // 44: bytebase.v1.IssueService.BatchUpdateIssuesStatus:input_type -> bytebase.v1.BatchUpdateIssuesStatusRequest
-	15, // 45: bytebase.v1.IssueService.ApproveIssue:input_type -> bytebase.v1.ApproveIssueRequest
-	16, // 46: bytebase.v1.IssueService.RejectIssue:input_type -> bytebase.v1.RejectIssueRequest
-	17, // 47: bytebase.v1.IssueService.RequestIssue:input_type -> bytebase.v1.RequestIssueRequest
-	18, // 48: bytebase.v1.IssueService.GetIssue:output_type -> bytebase.v1.Issue
-	18, // 49: bytebase.v1.IssueService.CreateIssue:output_type -> bytebase.v1.Issue
-	9,  // 50: bytebase.v1.IssueService.ListIssues:output_type -> bytebase.v1.ListIssuesResponse
-	11, // 51: bytebase.v1.IssueService.SearchIssues:output_type -> bytebase.v1.SearchIssuesResponse
-	18, // 52: bytebase.v1.IssueService.UpdateIssue:output_type -> bytebase.v1.Issue
-	23, // 53: bytebase.v1.IssueService.ListIssueComments:output_type -> bytebase.v1.ListIssueCommentsResponse
-	26, // 54: bytebase.v1.IssueService.CreateIssueComment:output_type -> bytebase.v1.IssueComment
-	26, // 55: bytebase.v1.IssueService.UpdateIssueComment:output_type -> bytebase.v1.IssueComment
-	14, // 56: bytebase.v1.IssueService.BatchUpdateIssuesStatus:output_type -> bytebase.v1.BatchUpdateIssuesStatusResponse
-	18, // 57: bytebase.v1.IssueService.ApproveIssue:output_type -> bytebase.v1.Issue
-	18, // 58: bytebase.v1.IssueService.RejectIssue:output_type -> bytebase.v1.Issue
-	18, // 59: bytebase.v1.IssueService.RequestIssue:output_type -> bytebase.v1.Issue
-	48, // [48:60] is the sub-list for method output_type
-	36, // [36:48] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	41, // 16: bytebase.v1.GrantRequest.condition:type_name -> google.type.Expr
+	42, // 17: bytebase.v1.GrantRequest.expiration:type_name -> google.protobuf.Duration
+	22, // 18: bytebase.v1.ApprovalTemplate.flow:type_name -> bytebase.v1.ApprovalFlow
+	23, // 19: bytebase.v1.ApprovalFlow.steps:type_name -> bytebase.v1.ApprovalStep
+	4,  // 20: bytebase.v1.ApprovalStep.type:type_name -> bytebase.v1.ApprovalStep.Type
+	31, // 21: bytebase.v1.ApprovalStep.approver_sets:type_name -> bytebase.v1.ApprovalStep.ApproverSet
+	28, // 22: bytebase.v1.ListIssueCommentsResponse.issue_comments:type_name -> bytebase.v1.IssueComment
+	28, // 23: bytebase.v1.CreateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
+	28, // 24: bytebase.v1.UpdateIssueCommentRequest.issue_comment:type_name -> bytebase.v1.IssueComment
+	38, // 25: bytebase.v1.UpdateIssueCommentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	39, // 26: bytebase.v1.IssueComment.create_time:type_name -> google.protobuf.Timestamp
+	39, // 27: bytebase.v1.IssueComment.update_time:type_name -> google.protobuf.Timestamp
+	32, // 28: bytebase.v1.IssueComment.approval:type_name -> bytebase.v1.IssueComment.Approval
+	33, // 29: bytebase.v1.IssueComment.issue_update:type_name -> bytebase.v1.IssueComment.IssueUpdate
+	34, // 30: bytebase.v1.IssueComment.stage_end:type_name -> bytebase.v1.IssueComment.StageEnd
+	35, // 31: bytebase.v1.IssueComment.task_update:type_name -> bytebase.v1.IssueComment.TaskUpdate
+	36, // 32: bytebase.v1.IssueComment.task_prior_backup:type_name -> bytebase.v1.IssueComment.TaskPriorBackup
+	3,  // 33: bytebase.v1.Issue.Approver.status:type_name -> bytebase.v1.Issue.Approver.Status
+	5,  // 34: bytebase.v1.IssueComment.Approval.status:type_name -> bytebase.v1.IssueComment.Approval.Status
+	0,  // 35: bytebase.v1.IssueComment.IssueUpdate.from_status:type_name -> bytebase.v1.IssueStatus
+	0,  // 36: bytebase.v1.IssueComment.IssueUpdate.to_status:type_name -> bytebase.v1.IssueStatus
+	6,  // 37: bytebase.v1.IssueComment.TaskUpdate.to_status:type_name -> bytebase.v1.IssueComment.TaskUpdate.Status
+	37, // 38: bytebase.v1.IssueComment.TaskPriorBackup.tables:type_name -> bytebase.v1.IssueComment.TaskPriorBackup.Table
+	7,  // 39: bytebase.v1.IssueService.GetIssue:input_type -> bytebase.v1.GetIssueRequest
+	8,  // 40: bytebase.v1.IssueService.CreateIssue:input_type -> bytebase.v1.CreateIssueRequest
+	9,  // 41: bytebase.v1.IssueService.ListIssues:input_type -> bytebase.v1.ListIssuesRequest
+	11, // 42: bytebase.v1.IssueService.SearchIssues:input_type -> bytebase.v1.SearchIssuesRequest
+	13, // 43: bytebase.v1.IssueService.UpdateIssue:input_type -> bytebase.v1.UpdateIssueRequest
+	24, // 44: bytebase.v1.IssueService.ListIssueComments:input_type -> bytebase.v1.ListIssueCommentsRequest
+	26, // 45: bytebase.v1.IssueService.CreateIssueComment:input_type -> bytebase.v1.CreateIssueCommentRequest
+	27, // 46: bytebase.v1.IssueService.UpdateIssueComment:input_type -> bytebase.v1.UpdateIssueCommentRequest
+	14, // 47: bytebase.v1.IssueService.BatchUpdateIssuesStatus:input_type -> bytebase.v1.BatchUpdateIssuesStatusRequest
+	16, // 48: bytebase.v1.IssueService.ApproveIssue:input_type -> bytebase.v1.ApproveIssueRequest
+	17, // 49: bytebase.v1.IssueService.RejectIssue:input_type -> bytebase.v1.RejectIssueRequest
+	18, // 50: bytebase.v1.IssueService.RequestIssue:input_type -> bytebase.v1.RequestIssueRequest
+	19, // 51: bytebase.v1.IssueService.GetIssue:output_type -> bytebase.v1.Issue
+	19, // 52: bytebase.v1.IssueService.CreateIssue:output_type -> bytebase.v1.Issue
+	10, // 53: bytebase.v1.IssueService.ListIssues:output_type -> bytebase.v1.ListIssuesResponse
+	12, // 54: bytebase.v1.IssueService.SearchIssues:output_type -> bytebase.v1.SearchIssuesResponse
+	19, // 55: bytebase.v1.IssueService.UpdateIssue:output_type -> bytebase.v1.Issue
+	25, // 56: bytebase.v1.IssueService.ListIssueComments:output_type -> bytebase.v1.ListIssueCommentsResponse
+	28, // 57: bytebase.v1.IssueService.CreateIssueComment:output_type -> bytebase.v1.IssueComment
+	28, // 58: bytebase.v1.IssueService.UpdateIssueComment:output_type -> bytebase.v1.IssueComment
+	15, // 59: bytebase.v1.IssueService.BatchUpdateIssuesStatus:output_type -> bytebase.v1.BatchUpdateIssuesStatusResponse
+	19, // 60: bytebase.v1.IssueService.ApproveIssue:output_type -> bytebase.v1.Issue
+	19, // 61: bytebase.v1.IssueService.RejectIssue:output_type -> bytebase.v1.Issue
+	19, // 62: bytebase.v1.IssueService.RequestIssue:output_type -> bytebase.v1.Issue
+	51, // [51:63] is the sub-list for method output_type
+	39, // [39:51] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_v1_issue_service_proto_init() }
@@ -2843,23 +3059,23 @@ func file_v1_issue_service_proto_init() {
 	}
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
-	file_v1_issue_service_proto_msgTypes[20].OneofWrappers = []any{
+	file_v1_issue_service_proto_msgTypes[21].OneofWrappers = []any{
 		(*IssueComment_Approval_)(nil),
 		(*IssueComment_IssueUpdate_)(nil),
 		(*IssueComment_StageEnd_)(nil),
 		(*IssueComment_TaskUpdate_)(nil),
 		(*IssueComment_TaskPriorBackup_)(nil),
 	}
-	file_v1_issue_service_proto_msgTypes[24].OneofWrappers = []any{}
 	file_v1_issue_service_proto_msgTypes[26].OneofWrappers = []any{}
-	file_v1_issue_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_v1_issue_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_v1_issue_service_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_issue_service_proto_rawDesc), len(file_v1_issue_service_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   29,
+			NumEnums:      7,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
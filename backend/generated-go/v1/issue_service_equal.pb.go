@@ -254,6 +254,12 @@ func (x *Issue_Approver) Equal(y *Issue_Approver) bool {
 	if x.Principal != y.Principal {
 		return false
 	}
+	if x.Step != y.Step {
+		return false
+	}
+	if x.ApproverSet != y.ApproverSet {
+		return false
+	}
 	return true
 }
 
@@ -409,6 +415,56 @@ func (x *ApprovalFlow) Equal(y *ApprovalFlow) bool {
 			return false
 		}
 	}
+	if len(x.Steps) != len(y.Steps) {
+		return false
+	}
+	for i := 0; i < len(x.Steps); i++ {
+		if !x.Steps[i].Equal(y.Steps[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *ApprovalStep_ApproverSet) Equal(y *ApprovalStep_ApproverSet) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Approvers) != len(y.Approvers) {
+		return false
+	}
+	for i := 0; i < len(x.Approvers); i++ {
+		if x.Approvers[i] != y.Approvers[i] {
+			return false
+		}
+	}
+	if x.RequiredCount != y.RequiredCount {
+		return false
+	}
+	return true
+}
+
+func (x *ApprovalStep) Equal(y *ApprovalStep) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if len(x.ApproverSets) != len(y.ApproverSets) {
+		return false
+	}
+	for i := 0; i < len(x.ApproverSets); i++ {
+		if !x.ApproverSets[i].Equal(y.ApproverSets[i]) {
+			return false
+		}
+	}
 	return true
 }
 
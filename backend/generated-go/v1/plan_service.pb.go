@@ -40,6 +40,8 @@ const (
 	PlanCheckRun_DATABASE_CONNECT PlanCheckRun_Type = 6
 	// Ghost sync check that validates gh-ost online schema change compatibility.
 	PlanCheckRun_DATABASE_GHOST_SYNC PlanCheckRun_Type = 7
+	// PostgreSQL online migration check that validates the shadow table migration preconditions.
+	PlanCheckRun_DATABASE_PG_ONLINE_CHECK PlanCheckRun_Type = 8
 )
 
 // Enum value maps for PlanCheckRun_Type.
@@ -51,6 +53,7 @@ var (
 		5: "DATABASE_STATEMENT_SUMMARY_REPORT",
 		6: "DATABASE_CONNECT",
 		7: "DATABASE_GHOST_SYNC",
+		8: "DATABASE_PG_ONLINE_CHECK",
 	}
 	PlanCheckRun_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                  0,
@@ -59,6 +62,7 @@ var (
 		"DATABASE_STATEMENT_SUMMARY_REPORT": 5,
 		"DATABASE_CONNECT":                  6,
 		"DATABASE_GHOST_SYNC":               7,
+		"DATABASE_PG_ONLINE_CHECK":          8,
 	}
 )
 
@@ -2006,7 +2010,7 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11bytebase.com/PlanR\x06parent\x12&\n" +
 	"\x0fplan_check_runs\x18\x02 \x03(\tR\rplanCheckRuns\"\"\n" +
-	" BatchCancelPlanCheckRunsResponse\"\x8b\n" +
+	" BatchCancelPlanCheckRunsResponse\"\xa9\n" +
 	"\n" +
 	"\fPlanCheckRun\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
@@ -2032,14 +2036,15 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x0fSqlReviewReport\x12<\n" +
 	"\x0estart_position\x18\x05 \x01(\v2\x15.bytebase.v1.PositionR\rstartPosition\x128\n" +
 	"\fend_position\x18\x06 \x01(\v2\x15.bytebase.v1.PositionR\vendPositionJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05B\b\n" +
-	"\x06report\"\xb5\x01\n" +
+	"\x06report\"\xd3\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eDATABASE_STATEMENT_FAKE_ADVISE\x10\x01\x12\x1d\n" +
 	"\x19DATABASE_STATEMENT_ADVISE\x10\x03\x12%\n" +
 	"!DATABASE_STATEMENT_SUMMARY_REPORT\x10\x05\x12\x14\n" +
 	"\x10DATABASE_CONNECT\x10\x06\x12\x17\n" +
-	"\x13DATABASE_GHOST_SYNC\x10\a\"Q\n" +
+	"\x13DATABASE_GHOST_SYNC\x10\a\x12\x1c\n" +
+	"\x18DATABASE_PG_ONLINE_CHECK\x10\b\"Q\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aRUNNING\x10\x01\x12\b\n" +
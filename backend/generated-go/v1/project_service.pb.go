@@ -11,8 +11,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -45,6 +47,8 @@ const (
 	Webhook_WECOM Webhook_Type = 6
 	// Lark integration.
 	Webhook_LARK Webhook_Type = 8
+	// Custom HTTP endpoint receiving a signed JSON event envelope.
+	Webhook_CUSTOM Webhook_Type = 9
 )
 
 // Enum value maps for Webhook_Type.
@@ -58,6 +62,7 @@ var (
 		5: "FEISHU",
 		6: "WECOM",
 		8: "LARK",
+		9: "CUSTOM",
 	}
 	Webhook_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
@@ -68,6 +73,7 @@ var (
 		"FEISHU":           5,
 		"WECOM":            6,
 		"LARK":             8,
+		"CUSTOM":           9,
 	}
 )
 
@@ -98,6 +104,63 @@ func (Webhook_Type) EnumDescriptor() ([]byte, []int) {
 	return file_v1_project_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
+// Delivery status.
+type WebhookDelivery_Status int32
+
+const (
+	// Unspecified status.
+	WebhookDelivery_STATUS_UNSPECIFIED WebhookDelivery_Status = 0
+	// The delivery is being attempted or waiting for a retry.
+	WebhookDelivery_PENDING WebhookDelivery_Status = 1
+	// The webhook endpoint accepted the delivery.
+	WebhookDelivery_SUCCEEDED WebhookDelivery_Status = 2
+	// The delivery failed and will not be retried automatically.
+	WebhookDelivery_FAILED WebhookDelivery_Status = 3
+)
+
+// Enum value maps for WebhookDelivery_Status.
+var (
+	WebhookDelivery_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	WebhookDelivery_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"SUCCEEDED":          2,
+		"FAILED":             3,
+	}
+)
+
+func (x WebhookDelivery_Status) Enum() *WebhookDelivery_Status {
+	p := new(WebhookDelivery_Status)
+	*p = x
+	return p
+}
+
+func (x WebhookDelivery_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDelivery_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_project_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhookDelivery_Status) Type() protoreflect.EnumType {
+	return &file_v1_project_service_proto_enumTypes[1]
+}
+
+func (x WebhookDelivery_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDelivery_Status.Descriptor instead.
+func (WebhookDelivery_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{23, 0}
+}
+
 // Activity type enumeration.
 type Activity_Type int32
 
@@ -110,6 +173,8 @@ const (
 	Activity_NOTIFY_ISSUE_APPROVED Activity_Type = 23
 	// NOTIFY_PIPELINE_ROLLOUT represents the pipeline rollout notification.
 	Activity_NOTIFY_PIPELINE_ROLLOUT Activity_Type = 24
+	// NOTIFY_GRANT_EXPIRED represents the notification of revoking the expired role granted by the request.
+	Activity_NOTIFY_GRANT_EXPIRED Activity_Type = 25
 	// Issue related activity types.
 	//
 	// ISSUE_CREATE represents creating an issue.
@@ -134,6 +199,7 @@ var (
 		0:  "TYPE_UNSPECIFIED",
 		23: "NOTIFY_ISSUE_APPROVED",
 		24: "NOTIFY_PIPELINE_ROLLOUT",
+		25: "NOTIFY_GRANT_EXPIRED",
 		1:  "ISSUE_CREATE",
 		2:  "ISSUE_COMMENT_CREATE",
 		3:  "ISSUE_FIELD_UPDATE",
@@ -146,6 +212,7 @@ var (
 		"TYPE_UNSPECIFIED":                      0,
 		"NOTIFY_ISSUE_APPROVED":                 23,
 		"NOTIFY_PIPELINE_ROLLOUT":               24,
+		"NOTIFY_GRANT_EXPIRED":                  25,
 		"ISSUE_CREATE":                          1,
 		"ISSUE_COMMENT_CREATE":                  2,
 		"ISSUE_FIELD_UPDATE":                    3,
@@ -167,11 +234,11 @@ func (x Activity_Type) String() string {
 }
 
 func (Activity_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_project_service_proto_enumTypes[1].Descriptor()
+	return file_v1_project_service_proto_enumTypes[2].Descriptor()
 }
 
 func (Activity_Type) Type() protoreflect.EnumType {
-	return &file_v1_project_service_proto_enumTypes[1]
+	return &file_v1_project_service_proto_enumTypes[2]
 }
 
 func (x Activity_Type) Number() protoreflect.EnumNumber {
@@ -180,7 +247,7 @@ func (x Activity_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Activity_Type.Descriptor instead.
 func (Activity_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_project_service_proto_rawDescGZIP(), []int{20, 0}
+	return file_v1_project_service_proto_rawDescGZIP(), []int{24, 0}
 }
 
 type GetProjectRequest struct {
@@ -1486,9 +1553,16 @@ type Webhook struct {
 	// - ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE
 	// - NOTIFY_ISSUE_APPROVED
 	// - NOTIFY_PIPELINE_ROLLOUT
+	// - NOTIFY_GRANT_EXPIRED
 	NotificationTypes []Activity_Type `protobuf:"varint,5,rep,packed,name=notification_types,json=notificationTypes,proto3,enum=bytebase.v1.Activity_Type" json:"notification_types,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// signing_secret is used to sign the event envelope of CUSTOM webhooks.
+	// The signature is the hex encoded HMAC-SHA256 of "{timestamp}.{body}",
+	// sent in the X-Bytebase-Signature header as "sha256={signature}".
+	SigningSecret string `protobuf:"bytes,7,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// headers are additional HTTP headers sent to CUSTOM webhooks.
+	Headers       map[string]string `protobuf:"bytes,8,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Webhook) Reset() {
@@ -1563,6 +1637,327 @@ func (x *Webhook) GetNotificationTypes() []Activity_Type {
 	return nil
 }
 
+func (x *Webhook) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *Webhook) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent webhook.
+	// Format: projects/{project}/webhooks/{webhook}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of deliveries to return. The service may return fewer than this value.
+	// If unspecified, at most 10 deliveries will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListWebhookDeliveries` call.
+	// Provide this to retrieve the subsequent page.
+	//
+	// When paginating, all other parameters provided to `ListWebhookDeliveries` must match
+	// the call that provided the page token.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_v1_project_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deliveries from the specified request, most recent first.
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_v1_project_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RetryWebhookDeliveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The delivery to retry.
+	// Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_v1_project_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// WebhookDelivery is a record of posting an event to a webhook.
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery.
+	// Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The activity type that triggered the delivery.
+	EventType Activity_Type `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=bytebase.v1.Activity_Type" json:"event_type,omitempty"`
+	// The status of the delivery.
+	Status WebhookDelivery_Status `protobuf:"varint,3,opt,name=status,proto3,enum=bytebase.v1.WebhookDelivery_Status" json:"status,omitempty"`
+	// The number of attempts made so far.
+	Attempts int32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The JSON event envelope of the delivery.
+	// It is the request body posted to CUSTOM webhooks.
+	RequestBody string `protobuf:"bytes,5,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	// The error of the latest attempt, empty if the delivery succeeded.
+	Error      string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// The HTTP status code of the latest attempt, zero if no response was received.
+	StatusCode int32 `protobuf:"varint,9,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The latency of the latest attempt.
+	Latency *durationpb.Duration `protobuf:"bytes,10,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The time of the next automatic retry, unset if no retry is scheduled.
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_v1_project_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WebhookDelivery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() Activity_Type {
+	if x != nil {
+		return x.EventType
+	}
+	return Activity_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDelivery_Status {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDelivery_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetRequestBody() string {
+	if x != nil {
+		return x.RequestBody
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
 // Activity types for webhook notifications.
 type Activity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1572,7 +1967,7 @@ type Activity struct {
 
 func (x *Activity) Reset() {
 	*x = Activity{}
-	mi := &file_v1_project_service_proto_msgTypes[20]
+	mi := &file_v1_project_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1584,7 +1979,7 @@ func (x *Activity) String() string {
 func (*Activity) ProtoMessage() {}
 
 func (x *Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_project_service_proto_msgTypes[20]
+	mi := &file_v1_project_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +1992,7 @@ func (x *Activity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Activity.ProtoReflect.Descriptor instead.
 func (*Activity) Descriptor() ([]byte, []int) {
-	return file_v1_project_service_proto_rawDescGZIP(), []int{20}
+	return file_v1_project_service_proto_rawDescGZIP(), []int{24}
 }
 
 // Result for a single project's IAM policy.
@@ -1613,7 +2008,7 @@ type BatchGetIamPolicyResponse_PolicyResult struct {
 
 func (x *BatchGetIamPolicyResponse_PolicyResult) Reset() {
 	*x = BatchGetIamPolicyResponse_PolicyResult{}
-	mi := &file_v1_project_service_proto_msgTypes[21]
+	mi := &file_v1_project_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1625,7 +2020,7 @@ func (x *BatchGetIamPolicyResponse_PolicyResult) String() string {
 func (*BatchGetIamPolicyResponse_PolicyResult) ProtoMessage() {}
 
 func (x *BatchGetIamPolicyResponse_PolicyResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_project_service_proto_msgTypes[21]
+	mi := &file_v1_project_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,7 +2061,7 @@ type Project_ExecutionRetryPolicy struct {
 
 func (x *Project_ExecutionRetryPolicy) Reset() {
 	*x = Project_ExecutionRetryPolicy{}
-	mi := &file_v1_project_service_proto_msgTypes[22]
+	mi := &file_v1_project_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1678,7 +2073,7 @@ func (x *Project_ExecutionRetryPolicy) String() string {
 func (*Project_ExecutionRetryPolicy) ProtoMessage() {}
 
 func (x *Project_ExecutionRetryPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_project_service_proto_msgTypes[22]
+	mi := &file_v1_project_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,7 +2100,7 @@ var File_v1_project_service_proto protoreflect.FileDescriptor
 
 const file_v1_project_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18v1/project_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x13v1/iam_policy.proto\"E\n" +
+	"\x18v1/project_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x13v1/iam_policy.proto\"E\n" +
 	"\x11GetProjectRequest\x120\n" +
 	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x04name\"\x8c\x01\n" +
@@ -1802,14 +2197,19 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x14bytebase.com/ProjectR\aproject\x123\n" +
 	"\awebhook\x18\x02 \x01(\v2\x14.bytebase.v1.WebhookB\x03\xe0A\x02R\awebhook\"+\n" +
 	"\x13TestWebhookResponse\x12\x14\n" +
-	"\x05error\x18\x01 \x01(\tR\x05error\"\xac\x03\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"\xdd\x04\n" +
 	"\aWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x19.bytebase.v1.Webhook.TypeB\x03\xe0A\x02R\x04type\x12\x19\n" +
 	"\x05title\x18\x03 \x01(\tB\x03\xe0A\x02R\x05title\x12\x15\n" +
 	"\x03url\x18\x04 \x01(\tB\x03\xe0A\x02R\x03url\x12%\n" +
 	"\x0edirect_message\x18\x06 \x01(\bR\rdirectMessage\x12N\n" +
-	"\x12notification_types\x18\x05 \x03(\x0e2\x1a.bytebase.v1.Activity.TypeB\x03\xe0A\x06R\x11notificationTypes\"n\n" +
+	"\x12notification_types\x18\x05 \x03(\x0e2\x1a.bytebase.v1.Activity.TypeB\x03\xe0A\x06R\x11notificationTypes\x12*\n" +
+	"\x0esigning_secret\x18\a \x01(\tB\x03\xe0A\x04R\rsigningSecret\x12;\n" +
+	"\aheaders\x18\b \x03(\v2!.bytebase.v1.Webhook.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"z\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05SLACK\x10\x01\x12\v\n" +
@@ -1819,20 +2219,61 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06FEISHU\x10\x05\x12\t\n" +
 	"\x05WECOM\x10\x06\x12\b\n" +
-	"\x04LARK\x10\b:@\xeaA=\n" +
-	"\x14bytebase.com/Webhook\x12%projects/{project}/webhooks/{webhook}\"\xac\x02\n" +
-	"\bActivity\"\x9f\x02\n" +
+	"\x04LARK\x10\b\x12\n" +
+	"\n" +
+	"\x06CUSTOM\x10\t:@\xeaA=\n" +
+	"\x14bytebase.com/Webhook\x12%projects/{project}/webhooks/{webhook}\"\x90\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/WebhookR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12<\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1c.bytebase.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"W\n" +
+	"\x1bRetryWebhookDeliveryRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cbytebase.com/WebhookDeliveryR\x04name\"\xe6\x05\n" +
+	"\x0fWebhookDelivery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e2\x1a.bytebase.v1.Activity.TypeB\x03\xe0A\x03R\teventType\x12@\n" +
+	"\x06status\x18\x03 \x01(\x0e2#.bytebase.v1.WebhookDelivery.StatusB\x03\xe0A\x03R\x06status\x12\x1f\n" +
+	"\battempts\x18\x04 \x01(\x05B\x03\xe0A\x03R\battempts\x12&\n" +
+	"\frequest_body\x18\x05 \x01(\tB\x03\xe0A\x03R\vrequestBody\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x12$\n" +
+	"\vstatus_code\x18\t \x01(\x05B\x03\xe0A\x03R\n" +
+	"statusCode\x128\n" +
+	"\alatency\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x03R\alatency\x12K\n" +
+	"\x11next_attempt_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0fnextAttemptTime\"H\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03:^\xeaA[\n" +
+	"\x1cbytebase.com/WebhookDelivery\x12;projects/{project}/webhooks/{webhook}/deliveries/{delivery}\"\xc6\x02\n" +
+	"\bActivity\"\xb9\x02\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15NOTIFY_ISSUE_APPROVED\x10\x17\x12\x1b\n" +
-	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x10\n" +
+	"\x17NOTIFY_PIPELINE_ROLLOUT\x10\x18\x12\x18\n" +
+	"\x14NOTIFY_GRANT_EXPIRED\x10\x19\x12\x10\n" +
 	"\fISSUE_CREATE\x10\x01\x12\x18\n" +
 	"\x14ISSUE_COMMENT_CREATE\x10\x02\x12\x16\n" +
 	"\x12ISSUE_FIELD_UPDATE\x10\x03\x12\x17\n" +
 	"\x13ISSUE_STATUS_UPDATE\x10\x04\x12\x19\n" +
 	"\x15ISSUE_APPROVAL_NOTIFY\x10\x15\x12&\n" +
 	"\"ISSUE_PIPELINE_STAGE_STATUS_UPDATE\x10\x05\x12)\n" +
-	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x162\x9d\x12\n" +
+	"%ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE\x10\x162\xa7\x15\n" +
 	"\x0eProjectService\x12\x7f\n" +
 	"\n" +
 	"GetProject\x12\x1e.bytebase.v1.GetProjectRequest\x1a\x14.bytebase.v1.Project\";\xdaA\x04name\x8a\xea0\x0fbb.projects.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=projects/*}\x12\x84\x01\n" +
@@ -1850,7 +2291,9 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"AddWebhook\x12\x1e.bytebase.v1.AddWebhookRequest\x1a\x14.bytebase.v1.Project\"H\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/{project=projects/*}:addWebhook\x12\xc1\x01\n" +
 	"\rUpdateWebhook\x12!.bytebase.v1.UpdateWebhookRequest\x1a\x14.bytebase.v1.Project\"w\xdaA\x13webhook,update_mask\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02A:\awebhook26/v1/{webhook.name=projects/*/webhooks/*}:updateWebhook\x12\xa5\x01\n" +
 	"\rRemoveWebhook\x12!.bytebase.v1.RemoveWebhookRequest\x1a\x14.bytebase.v1.Project\"[\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/{webhook.name=projects/*/webhooks/*}:removeWebhook\x12\x9b\x01\n" +
-	"\vTestWebhook\x12\x1f.bytebase.v1.TestWebhookRequest\x1a .bytebase.v1.TestWebhookResponse\"I\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02):\x01*\"$/v1/{project=projects/*}:testWebhookB\xa9\x01\n" +
+	"\vTestWebhook\x12\x1f.bytebase.v1.TestWebhookRequest\x1a .bytebase.v1.TestWebhookResponse\"I\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02):\x01*\"$/v1/{project=projects/*}:testWebhook\x12\xc5\x01\n" +
+	"\x15ListWebhookDeliveries\x12).bytebase.v1.ListWebhookDeliveriesRequest\x1a*.bytebase.v1.ListWebhookDeliveriesResponse\"U\xdaA\x06parent\x8a\xea0\x0fbb.projects.get\x90\xea0\x01\x82\xd3\xe4\x93\x02/\x12-/v1/{parent=projects/*/webhooks/*}/deliveries\x12\xbf\x01\n" +
+	"\x14RetryWebhookDelivery\x12(.bytebase.v1.RetryWebhookDeliveryRequest\x1a\x1c.bytebase.v1.WebhookDelivery\"_\xdaA\x04name\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x028:\x01*\"3/v1/{name=projects/*/webhooks/*/deliveries/*}:retryB\xa9\x01\n" +
 	"\x0fcom.bytebase.v1B\x13ProjectServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -1865,97 +2308,117 @@ func file_v1_project_service_proto_rawDescGZIP() []byte {
 	return file_v1_project_service_proto_rawDescData
 }
 
-var file_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_v1_project_service_proto_goTypes = []any{
 	(Webhook_Type)(0),                              // 0: bytebase.v1.Webhook.Type
-	(Activity_Type)(0),                             // 1: bytebase.v1.Activity.Type
-	(*GetProjectRequest)(nil),                      // 2: bytebase.v1.GetProjectRequest
-	(*ListProjectsRequest)(nil),                    // 3: bytebase.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                   // 4: bytebase.v1.ListProjectsResponse
-	(*SearchProjectsRequest)(nil),                  // 5: bytebase.v1.SearchProjectsRequest
-	(*SearchProjectsResponse)(nil),                 // 6: bytebase.v1.SearchProjectsResponse
-	(*CreateProjectRequest)(nil),                   // 7: bytebase.v1.CreateProjectRequest
-	(*UpdateProjectRequest)(nil),                   // 8: bytebase.v1.UpdateProjectRequest
-	(*DeleteProjectRequest)(nil),                   // 9: bytebase.v1.DeleteProjectRequest
-	(*UndeleteProjectRequest)(nil),                 // 10: bytebase.v1.UndeleteProjectRequest
-	(*BatchDeleteProjectsRequest)(nil),             // 11: bytebase.v1.BatchDeleteProjectsRequest
-	(*BatchGetIamPolicyRequest)(nil),               // 12: bytebase.v1.BatchGetIamPolicyRequest
-	(*BatchGetIamPolicyResponse)(nil),              // 13: bytebase.v1.BatchGetIamPolicyResponse
-	(*Label)(nil),                                  // 14: bytebase.v1.Label
-	(*Project)(nil),                                // 15: bytebase.v1.Project
-	(*AddWebhookRequest)(nil),                      // 16: bytebase.v1.AddWebhookRequest
-	(*UpdateWebhookRequest)(nil),                   // 17: bytebase.v1.UpdateWebhookRequest
-	(*RemoveWebhookRequest)(nil),                   // 18: bytebase.v1.RemoveWebhookRequest
-	(*TestWebhookRequest)(nil),                     // 19: bytebase.v1.TestWebhookRequest
-	(*TestWebhookResponse)(nil),                    // 20: bytebase.v1.TestWebhookResponse
-	(*Webhook)(nil),                                // 21: bytebase.v1.Webhook
-	(*Activity)(nil),                               // 22: bytebase.v1.Activity
-	(*BatchGetIamPolicyResponse_PolicyResult)(nil), // 23: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
-	(*Project_ExecutionRetryPolicy)(nil),           // 24: bytebase.v1.Project.ExecutionRetryPolicy
-	nil,                                            // 25: bytebase.v1.Project.LabelsEntry
-	(*fieldmaskpb.FieldMask)(nil),                  // 26: google.protobuf.FieldMask
-	(State)(0),                                     // 27: bytebase.v1.State
-	(*IamPolicy)(nil),                              // 28: bytebase.v1.IamPolicy
-	(*GetIamPolicyRequest)(nil),                    // 29: bytebase.v1.GetIamPolicyRequest
-	(*SetIamPolicyRequest)(nil),                    // 30: bytebase.v1.SetIamPolicyRequest
-	(*emptypb.Empty)(nil),                          // 31: google.protobuf.Empty
+	(WebhookDelivery_Status)(0),                    // 1: bytebase.v1.WebhookDelivery.Status
+	(Activity_Type)(0),                             // 2: bytebase.v1.Activity.Type
+	(*GetProjectRequest)(nil),                      // 3: bytebase.v1.GetProjectRequest
+	(*ListProjectsRequest)(nil),                    // 4: bytebase.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                   // 5: bytebase.v1.ListProjectsResponse
+	(*SearchProjectsRequest)(nil),                  // 6: bytebase.v1.SearchProjectsRequest
+	(*SearchProjectsResponse)(nil),                 // 7: bytebase.v1.SearchProjectsResponse
+	(*CreateProjectRequest)(nil),                   // 8: bytebase.v1.CreateProjectRequest
+	(*UpdateProjectRequest)(nil),                   // 9: bytebase.v1.UpdateProjectRequest
+	(*DeleteProjectRequest)(nil),                   // 10: bytebase.v1.DeleteProjectRequest
+	(*UndeleteProjectRequest)(nil),                 // 11: bytebase.v1.UndeleteProjectRequest
+	(*BatchDeleteProjectsRequest)(nil),             // 12: bytebase.v1.BatchDeleteProjectsRequest
+	(*BatchGetIamPolicyRequest)(nil),               // 13: bytebase.v1.BatchGetIamPolicyRequest
+	(*BatchGetIamPolicyResponse)(nil),              // 14: bytebase.v1.BatchGetIamPolicyResponse
+	(*Label)(nil),                                  // 15: bytebase.v1.Label
+	(*Project)(nil),                                // 16: bytebase.v1.Project
+	(*AddWebhookRequest)(nil),                      // 17: bytebase.v1.AddWebhookRequest
+	(*UpdateWebhookRequest)(nil),                   // 18: bytebase.v1.UpdateWebhookRequest
+	(*RemoveWebhookRequest)(nil),                   // 19: bytebase.v1.RemoveWebhookRequest
+	(*TestWebhookRequest)(nil),                     // 20: bytebase.v1.TestWebhookRequest
+	(*TestWebhookResponse)(nil),                    // 21: bytebase.v1.TestWebhookResponse
+	(*Webhook)(nil),                                // 22: bytebase.v1.Webhook
+	(*ListWebhookDeliveriesRequest)(nil),           // 23: bytebase.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),          // 24: bytebase.v1.ListWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),            // 25: bytebase.v1.RetryWebhookDeliveryRequest
+	(*WebhookDelivery)(nil),                        // 26: bytebase.v1.WebhookDelivery
+	(*Activity)(nil),                               // 27: bytebase.v1.Activity
+	(*BatchGetIamPolicyResponse_PolicyResult)(nil), // 28: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
+	(*Project_ExecutionRetryPolicy)(nil),           // 29: bytebase.v1.Project.ExecutionRetryPolicy
+	nil,                                            // 30: bytebase.v1.Project.LabelsEntry
+	nil,                                            // 31: bytebase.v1.Webhook.HeadersEntry
+	(*fieldmaskpb.FieldMask)(nil),                  // 32: google.protobuf.FieldMask
+	(State)(0),                                     // 33: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),                  // 34: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                    // 35: google.protobuf.Duration
+	(*IamPolicy)(nil),                              // 36: bytebase.v1.IamPolicy
+	(*GetIamPolicyRequest)(nil),                    // 37: bytebase.v1.GetIamPolicyRequest
+	(*SetIamPolicyRequest)(nil),                    // 38: bytebase.v1.SetIamPolicyRequest
+	(*emptypb.Empty)(nil),                          // 39: google.protobuf.Empty
 }
 var file_v1_project_service_proto_depIdxs = []int32{
-	15, // 0: bytebase.v1.ListProjectsResponse.projects:type_name -> bytebase.v1.Project
-	15, // 1: bytebase.v1.SearchProjectsResponse.projects:type_name -> bytebase.v1.Project
-	15, // 2: bytebase.v1.CreateProjectRequest.project:type_name -> bytebase.v1.Project
-	15, // 3: bytebase.v1.UpdateProjectRequest.project:type_name -> bytebase.v1.Project
-	26, // 4: bytebase.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	23, // 5: bytebase.v1.BatchGetIamPolicyResponse.policy_results:type_name -> bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
-	27, // 6: bytebase.v1.Project.state:type_name -> bytebase.v1.State
-	21, // 7: bytebase.v1.Project.webhooks:type_name -> bytebase.v1.Webhook
-	14, // 8: bytebase.v1.Project.issue_labels:type_name -> bytebase.v1.Label
-	24, // 9: bytebase.v1.Project.execution_retry_policy:type_name -> bytebase.v1.Project.ExecutionRetryPolicy
-	25, // 10: bytebase.v1.Project.labels:type_name -> bytebase.v1.Project.LabelsEntry
-	21, // 11: bytebase.v1.AddWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	21, // 12: bytebase.v1.UpdateWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	26, // 13: bytebase.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	21, // 14: bytebase.v1.RemoveWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	21, // 15: bytebase.v1.TestWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	16, // 0: bytebase.v1.ListProjectsResponse.projects:type_name -> bytebase.v1.Project
+	16, // 1: bytebase.v1.SearchProjectsResponse.projects:type_name -> bytebase.v1.Project
+	16, // 2: bytebase.v1.CreateProjectRequest.project:type_name -> bytebase.v1.Project
+	16, // 3: bytebase.v1.UpdateProjectRequest.project:type_name -> bytebase.v1.Project
+	32, // 4: bytebase.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	28, // 5: bytebase.v1.BatchGetIamPolicyResponse.policy_results:type_name -> bytebase.v1.BatchGetIamPolicyResponse.PolicyResult
+	33, // 6: bytebase.v1.Project.state:type_name -> bytebase.v1.State
+	22, // 7: bytebase.v1.Project.webhooks:type_name -> bytebase.v1.Webhook
+	15, // 8: bytebase.v1.Project.issue_labels:type_name -> bytebase.v1.Label
+	29, // 9: bytebase.v1.Project.execution_retry_policy:type_name -> bytebase.v1.Project.ExecutionRetryPolicy
+	30, // 10: bytebase.v1.Project.labels:type_name -> bytebase.v1.Project.LabelsEntry
+	22, // 11: bytebase.v1.AddWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	22, // 12: bytebase.v1.UpdateWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	32, // 13: bytebase.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	22, // 14: bytebase.v1.RemoveWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	22, // 15: bytebase.v1.TestWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
 	0,  // 16: bytebase.v1.Webhook.type:type_name -> bytebase.v1.Webhook.Type
-	1,  // 17: bytebase.v1.Webhook.notification_types:type_name -> bytebase.v1.Activity.Type
-	28, // 18: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult.policy:type_name -> bytebase.v1.IamPolicy
-	2,  // 19: bytebase.v1.ProjectService.GetProject:input_type -> bytebase.v1.GetProjectRequest
-	3,  // 20: bytebase.v1.ProjectService.ListProjects:input_type -> bytebase.v1.ListProjectsRequest
-	5,  // 21: bytebase.v1.ProjectService.SearchProjects:input_type -> bytebase.v1.SearchProjectsRequest
-	7,  // 22: bytebase.v1.ProjectService.CreateProject:input_type -> bytebase.v1.CreateProjectRequest
-	8,  // 23: bytebase.v1.ProjectService.UpdateProject:input_type -> bytebase.v1.UpdateProjectRequest
-	9,  // 24: bytebase.v1.ProjectService.DeleteProject:input_type -> bytebase.v1.DeleteProjectRequest
-	10, // 25: bytebase.v1.ProjectService.UndeleteProject:input_type -> bytebase.v1.UndeleteProjectRequest
-	11, // 26: bytebase.v1.ProjectService.BatchDeleteProjects:input_type -> bytebase.v1.BatchDeleteProjectsRequest
-	29, // 27: bytebase.v1.ProjectService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
-	12, // 28: bytebase.v1.ProjectService.BatchGetIamPolicy:input_type -> bytebase.v1.BatchGetIamPolicyRequest
-	30, // 29: bytebase.v1.ProjectService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
-	16, // 30: bytebase.v1.ProjectService.AddWebhook:input_type -> bytebase.v1.AddWebhookRequest
-	17, // 31: bytebase.v1.ProjectService.UpdateWebhook:input_type -> bytebase.v1.UpdateWebhookRequest
-	18, // 32: bytebase.v1.ProjectService.RemoveWebhook:input_type -> bytebase.v1.RemoveWebhookRequest
-	19, // 33: bytebase.v1.ProjectService.TestWebhook:input_type -> bytebase.v1.TestWebhookRequest
-	15, // 34: bytebase.v1.ProjectService.GetProject:output_type -> bytebase.v1.Project
-	4,  // 35: bytebase.v1.ProjectService.ListProjects:output_type -> bytebase.v1.ListProjectsResponse
-	6,  // 36: bytebase.v1.ProjectService.SearchProjects:output_type -> bytebase.v1.SearchProjectsResponse
-	15, // 37: bytebase.v1.ProjectService.CreateProject:output_type -> bytebase.v1.Project
-	15, // 38: bytebase.v1.ProjectService.UpdateProject:output_type -> bytebase.v1.Project
-	31, // 39: bytebase.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	15, // 40: bytebase.v1.ProjectService.UndeleteProject:output_type -> bytebase.v1.Project
-	31, // 41: bytebase.v1.ProjectService.BatchDeleteProjects:output_type -> google.protobuf.Empty
-	28, // 42: bytebase.v1.ProjectService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	13, // 43: bytebase.v1.ProjectService.BatchGetIamPolicy:output_type -> bytebase.v1.BatchGetIamPolicyResponse
-	28, // 44: bytebase.v1.ProjectService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	15, // 45: bytebase.v1.ProjectService.AddWebhook:output_type -> bytebase.v1.Project
-	15, // 46: bytebase.v1.ProjectService.UpdateWebhook:output_type -> bytebase.v1.Project
-	15, // 47: bytebase.v1.ProjectService.RemoveWebhook:output_type -> bytebase.v1.Project
-	20, // 48: bytebase.v1.ProjectService.TestWebhook:output_type -> bytebase.v1.TestWebhookResponse
-	34, // [34:49] is the sub-list for method output_type
-	19, // [19:34] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	2,  // 17: bytebase.v1.Webhook.notification_types:type_name -> bytebase.v1.Activity.Type
+	31, // 18: bytebase.v1.Webhook.headers:type_name -> bytebase.v1.Webhook.HeadersEntry
+	26, // 19: bytebase.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> bytebase.v1.WebhookDelivery
+	2,  // 20: bytebase.v1.WebhookDelivery.event_type:type_name -> bytebase.v1.Activity.Type
+	1,  // 21: bytebase.v1.WebhookDelivery.status:type_name -> bytebase.v1.WebhookDelivery.Status
+	34, // 22: bytebase.v1.WebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	34, // 23: bytebase.v1.WebhookDelivery.update_time:type_name -> google.protobuf.Timestamp
+	35, // 24: bytebase.v1.WebhookDelivery.latency:type_name -> google.protobuf.Duration
+	34, // 25: bytebase.v1.WebhookDelivery.next_attempt_time:type_name -> google.protobuf.Timestamp
+	36, // 26: bytebase.v1.BatchGetIamPolicyResponse.PolicyResult.policy:type_name -> bytebase.v1.IamPolicy
+	3,  // 27: bytebase.v1.ProjectService.GetProject:input_type -> bytebase.v1.GetProjectRequest
+	4,  // 28: bytebase.v1.ProjectService.ListProjects:input_type -> bytebase.v1.ListProjectsRequest
+	6,  // 29: bytebase.v1.ProjectService.SearchProjects:input_type -> bytebase.v1.SearchProjectsRequest
+	8,  // 30: bytebase.v1.ProjectService.CreateProject:input_type -> bytebase.v1.CreateProjectRequest
+	9,  // 31: bytebase.v1.ProjectService.UpdateProject:input_type -> bytebase.v1.UpdateProjectRequest
+	10, // 32: bytebase.v1.ProjectService.DeleteProject:input_type -> bytebase.v1.DeleteProjectRequest
+	11, // 33: bytebase.v1.ProjectService.UndeleteProject:input_type -> bytebase.v1.UndeleteProjectRequest
+	12, // 34: bytebase.v1.ProjectService.BatchDeleteProjects:input_type -> bytebase.v1.BatchDeleteProjectsRequest
+	37, // 35: bytebase.v1.ProjectService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
+	13, // 36: bytebase.v1.ProjectService.BatchGetIamPolicy:input_type -> bytebase.v1.BatchGetIamPolicyRequest
+	38, // 37: bytebase.v1.ProjectService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
+	17, // 38: bytebase.v1.ProjectService.AddWebhook:input_type -> bytebase.v1.AddWebhookRequest
+	18, // 39: bytebase.v1.ProjectService.UpdateWebhook:input_type -> bytebase.v1.UpdateWebhookRequest
+	19, // 40: bytebase.v1.ProjectService.RemoveWebhook:input_type -> bytebase.v1.RemoveWebhookRequest
+	20, // 41: bytebase.v1.ProjectService.TestWebhook:input_type -> bytebase.v1.TestWebhookRequest
+	23, // 42: bytebase.v1.ProjectService.ListWebhookDeliveries:input_type -> bytebase.v1.ListWebhookDeliveriesRequest
+	25, // 43: bytebase.v1.ProjectService.RetryWebhookDelivery:input_type -> bytebase.v1.RetryWebhookDeliveryRequest
+	16, // 44: bytebase.v1.ProjectService.GetProject:output_type -> bytebase.v1.Project
+	5,  // 45: bytebase.v1.ProjectService.ListProjects:output_type -> bytebase.v1.ListProjectsResponse
+	7,  // 46: bytebase.v1.ProjectService.SearchProjects:output_type -> bytebase.v1.SearchProjectsResponse
+	16, // 47: bytebase.v1.ProjectService.CreateProject:output_type -> bytebase.v1.Project
+	16, // 48: bytebase.v1.ProjectService.UpdateProject:output_type -> bytebase.v1.Project
+	39, // 49: bytebase.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	16, // 50: bytebase.v1.ProjectService.UndeleteProject:output_type -> bytebase.v1.Project
+	39, // 51: bytebase.v1.ProjectService.BatchDeleteProjects:output_type -> google.protobuf.Empty
+	36, // 52: bytebase.v1.ProjectService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	14, // 53: bytebase.v1.ProjectService.BatchGetIamPolicy:output_type -> bytebase.v1.BatchGetIamPolicyResponse
+	36, // 54: bytebase.v1.ProjectService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	16, // 55: bytebase.v1.ProjectService.AddWebhook:output_type -> bytebase.v1.Project
+	16, // 56: bytebase.v1.ProjectService.UpdateWebhook:output_type -> bytebase.v1.Project
+	16, // 57: bytebase.v1.ProjectService.RemoveWebhook:output_type -> bytebase.v1.Project
+	21, // 58: bytebase.v1.ProjectService.TestWebhook:output_type -> bytebase.v1.TestWebhookResponse
+	24, // 59: bytebase.v1.ProjectService.ListWebhookDeliveries:output_type -> bytebase.v1.ListWebhookDeliveriesResponse
+	26, // 60: bytebase.v1.ProjectService.RetryWebhookDelivery:output_type -> bytebase.v1.WebhookDelivery
+	44, // [44:61] is the sub-list for method output_type
+	27, // [27:44] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_v1_project_service_proto_init() }
@@ -1971,8 +2434,8 @@ func file_v1_project_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_project_service_proto_rawDesc), len(file_v1_project_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   24,
+			NumEnums:      3,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -405,6 +405,7 @@ type Risk struct {
 	// resource.table_name: the table name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
 	// statement.text: the SQL statement, support "contains()", "matches()", "startsWith()", "endsWith()" operations.
 	// request.expiration_days: the role expiration days for the request, support "==", "!=", "<", "<=", ">", ">=" operations.
+	// request.expiration_hours: the role expiration hours for the request, support "==", "!=", "<", "<=", ">", ">=" operations.
 	// request.role: the request role full name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
 	//
 	// When the risk source is DDL/DML, support following variables:
@@ -436,6 +437,7 @@ type Risk struct {
 	// When the risk source is REQUEST_ROLE, support following variables:
 	// resource.project_id
 	// request.expiration_days
+	// request.expiration_hours
 	// request.role
 	Condition     *expr.Expr `protobuf:"bytes,8,opt,name=condition,proto3" json:"condition,omitempty"`
 	unknownFields protoimpl.UnknownFields
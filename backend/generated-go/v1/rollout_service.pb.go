@@ -2711,6 +2711,7 @@ type TaskRun_SchedulerInfo_WaitingCause struct {
 	//	*TaskRun_SchedulerInfo_WaitingCause_ConnectionLimit
 	//	*TaskRun_SchedulerInfo_WaitingCause_Task_
 	//	*TaskRun_SchedulerInfo_WaitingCause_ParallelTasksLimit
+	//	*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_
 	Cause         isTaskRun_SchedulerInfo_WaitingCause_Cause `protobuf_oneof:"cause"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2780,6 +2781,15 @@ func (x *TaskRun_SchedulerInfo_WaitingCause) GetParallelTasksLimit() bool {
 	return false
 }
 
+func (x *TaskRun_SchedulerInfo_WaitingCause) GetMaintenanceWindow() *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow {
+	if x != nil {
+		if x, ok := x.Cause.(*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_); ok {
+			return x.MaintenanceWindow
+		}
+	}
+	return nil
+}
+
 type isTaskRun_SchedulerInfo_WaitingCause_Cause interface {
 	isTaskRun_SchedulerInfo_WaitingCause_Cause()
 }
@@ -2799,6 +2809,11 @@ type TaskRun_SchedulerInfo_WaitingCause_ParallelTasksLimit struct {
 	ParallelTasksLimit bool `protobuf:"varint,3,opt,name=parallel_tasks_limit,json=parallelTasksLimit,proto3,oneof"`
 }
 
+type TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_ struct {
+	// Waiting for the maintenance window of the environment.
+	MaintenanceWindow *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow `protobuf:"bytes,4,opt,name=maintenance_window,json=maintenanceWindow,proto3,oneof"`
+}
+
 func (*TaskRun_SchedulerInfo_WaitingCause_ConnectionLimit) isTaskRun_SchedulerInfo_WaitingCause_Cause() {
 }
 
@@ -2807,6 +2822,9 @@ func (*TaskRun_SchedulerInfo_WaitingCause_Task_) isTaskRun_SchedulerInfo_Waiting
 func (*TaskRun_SchedulerInfo_WaitingCause_ParallelTasksLimit) isTaskRun_SchedulerInfo_WaitingCause_Cause() {
 }
 
+func (*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_) isTaskRun_SchedulerInfo_WaitingCause_Cause() {
+}
+
 // Information about a blocking task.
 type TaskRun_SchedulerInfo_WaitingCause_Task struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2862,6 +2880,52 @@ func (x *TaskRun_SchedulerInfo_WaitingCause_Task) GetIssue() string {
 	return ""
 }
 
+// Information about the maintenance window to wait for.
+type TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start time of the next maintenance window.
+	NextWindowTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=next_window_time,json=nextWindowTime,proto3" json:"next_window_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) Reset() {
+	*x = TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow{}
+	mi := &file_v1_rollout_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) ProtoMessage() {}
+
+func (x *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_rollout_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_v1_rollout_service_proto_rawDescGZIP(), []int{18, 1, 0, 1}
+}
+
+func (x *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) GetNextWindowTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextWindowTime
+	}
+	return nil
+}
+
 // Schema dump operation details.
 type TaskRunLogEntry_SchemaDump struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2877,7 +2941,7 @@ type TaskRunLogEntry_SchemaDump struct {
 
 func (x *TaskRunLogEntry_SchemaDump) Reset() {
 	*x = TaskRunLogEntry_SchemaDump{}
-	mi := &file_v1_rollout_service_proto_msgTypes[34]
+	mi := &file_v1_rollout_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2889,7 +2953,7 @@ func (x *TaskRunLogEntry_SchemaDump) String() string {
 func (*TaskRunLogEntry_SchemaDump) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_SchemaDump) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[34]
+	mi := &file_v1_rollout_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,7 +3008,7 @@ type TaskRunLogEntry_CommandExecute struct {
 
 func (x *TaskRunLogEntry_CommandExecute) Reset() {
 	*x = TaskRunLogEntry_CommandExecute{}
-	mi := &file_v1_rollout_service_proto_msgTypes[35]
+	mi := &file_v1_rollout_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2956,7 +3020,7 @@ func (x *TaskRunLogEntry_CommandExecute) String() string {
 func (*TaskRunLogEntry_CommandExecute) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_CommandExecute) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[35]
+	mi := &file_v1_rollout_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3015,7 +3079,7 @@ type TaskRunLogEntry_DatabaseSync struct {
 
 func (x *TaskRunLogEntry_DatabaseSync) Reset() {
 	*x = TaskRunLogEntry_DatabaseSync{}
-	mi := &file_v1_rollout_service_proto_msgTypes[36]
+	mi := &file_v1_rollout_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3027,7 +3091,7 @@ func (x *TaskRunLogEntry_DatabaseSync) String() string {
 func (*TaskRunLogEntry_DatabaseSync) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_DatabaseSync) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[36]
+	mi := &file_v1_rollout_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3075,7 +3139,7 @@ type TaskRunLogEntry_TaskRunStatusUpdate struct {
 
 func (x *TaskRunLogEntry_TaskRunStatusUpdate) Reset() {
 	*x = TaskRunLogEntry_TaskRunStatusUpdate{}
-	mi := &file_v1_rollout_service_proto_msgTypes[37]
+	mi := &file_v1_rollout_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3087,7 +3151,7 @@ func (x *TaskRunLogEntry_TaskRunStatusUpdate) String() string {
 func (*TaskRunLogEntry_TaskRunStatusUpdate) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_TaskRunStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[37]
+	mi := &file_v1_rollout_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,7 +3187,7 @@ type TaskRunLogEntry_TransactionControl struct {
 
 func (x *TaskRunLogEntry_TransactionControl) Reset() {
 	*x = TaskRunLogEntry_TransactionControl{}
-	mi := &file_v1_rollout_service_proto_msgTypes[38]
+	mi := &file_v1_rollout_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3135,7 +3199,7 @@ func (x *TaskRunLogEntry_TransactionControl) String() string {
 func (*TaskRunLogEntry_TransactionControl) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_TransactionControl) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[38]
+	mi := &file_v1_rollout_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3182,7 +3246,7 @@ type TaskRunLogEntry_PriorBackup struct {
 
 func (x *TaskRunLogEntry_PriorBackup) Reset() {
 	*x = TaskRunLogEntry_PriorBackup{}
-	mi := &file_v1_rollout_service_proto_msgTypes[39]
+	mi := &file_v1_rollout_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3194,7 +3258,7 @@ func (x *TaskRunLogEntry_PriorBackup) String() string {
 func (*TaskRunLogEntry_PriorBackup) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_PriorBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[39]
+	mi := &file_v1_rollout_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,7 +3317,7 @@ type TaskRunLogEntry_RetryInfo struct {
 
 func (x *TaskRunLogEntry_RetryInfo) Reset() {
 	*x = TaskRunLogEntry_RetryInfo{}
-	mi := &file_v1_rollout_service_proto_msgTypes[40]
+	mi := &file_v1_rollout_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3265,7 +3329,7 @@ func (x *TaskRunLogEntry_RetryInfo) String() string {
 func (*TaskRunLogEntry_RetryInfo) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_RetryInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[40]
+	mi := &file_v1_rollout_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3317,7 +3381,7 @@ type TaskRunLogEntry_ComputeDiff struct {
 
 func (x *TaskRunLogEntry_ComputeDiff) Reset() {
 	*x = TaskRunLogEntry_ComputeDiff{}
-	mi := &file_v1_rollout_service_proto_msgTypes[41]
+	mi := &file_v1_rollout_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3329,7 +3393,7 @@ func (x *TaskRunLogEntry_ComputeDiff) String() string {
 func (*TaskRunLogEntry_ComputeDiff) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_ComputeDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[41]
+	mi := &file_v1_rollout_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3384,7 +3448,7 @@ type TaskRunLogEntry_CommandExecute_CommandResponse struct {
 
 func (x *TaskRunLogEntry_CommandExecute_CommandResponse) Reset() {
 	*x = TaskRunLogEntry_CommandExecute_CommandResponse{}
-	mi := &file_v1_rollout_service_proto_msgTypes[42]
+	mi := &file_v1_rollout_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3396,7 +3460,7 @@ func (x *TaskRunLogEntry_CommandExecute_CommandResponse) String() string {
 func (*TaskRunLogEntry_CommandExecute_CommandResponse) ProtoMessage() {}
 
 func (x *TaskRunLogEntry_CommandExecute_CommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[42]
+	mi := &file_v1_rollout_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3455,7 +3519,7 @@ type TaskRunSession_Postgres struct {
 
 func (x *TaskRunSession_Postgres) Reset() {
 	*x = TaskRunSession_Postgres{}
-	mi := &file_v1_rollout_service_proto_msgTypes[43]
+	mi := &file_v1_rollout_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3467,7 +3531,7 @@ func (x *TaskRunSession_Postgres) String() string {
 func (*TaskRunSession_Postgres) ProtoMessage() {}
 
 func (x *TaskRunSession_Postgres) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[43]
+	mi := &file_v1_rollout_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3541,7 +3605,7 @@ type TaskRunSession_Postgres_Session struct {
 
 func (x *TaskRunSession_Postgres_Session) Reset() {
 	*x = TaskRunSession_Postgres_Session{}
-	mi := &file_v1_rollout_service_proto_msgTypes[44]
+	mi := &file_v1_rollout_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3553,7 +3617,7 @@ func (x *TaskRunSession_Postgres_Session) String() string {
 func (*TaskRunSession_Postgres_Session) ProtoMessage() {}
 
 func (x *TaskRunSession_Postgres_Session) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_rollout_service_proto_msgTypes[44]
+	mi := &file_v1_rollout_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3794,7 +3858,7 @@ const file_v1_rollout_service_proto_rawDesc = "" +
 	"\x11bytebase.com/Task\x12Aprojects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}B\t\n" +
 	"\apayloadB\x0e\n" +
 	"\f_update_timeB\v\n" +
-	"\t_run_timeJ\x04\b\x02\x10\x03\"\xb2\x0f\n" +
+	"\t_run_timeJ\x04\b\x02\x10\x03\"\x81\x11\n" +
 	"\aTaskRun\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acreator\x18\x03 \x01(\tR\acreator\x12@\n" +
@@ -3823,18 +3887,21 @@ const file_v1_rollout_service_proto_rawDesc = "" +
 	"\x05Table\x12\x1a\n" +
 	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
 	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
-	"\x05table\x18\x03 \x01(\tR\x05table\x1a\x9b\x03\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x1a\xea\x04\n" +
 	"\rSchedulerInfo\x12;\n" +
 	"\vreport_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"reportTime\x12T\n" +
-	"\rwaiting_cause\x18\x02 \x01(\v2/.bytebase.v1.TaskRun.SchedulerInfo.WaitingCauseR\fwaitingCause\x1a\xf6\x01\n" +
+	"\rwaiting_cause\x18\x02 \x01(\v2/.bytebase.v1.TaskRun.SchedulerInfo.WaitingCauseR\fwaitingCause\x1a\xc5\x03\n" +
 	"\fWaitingCause\x12+\n" +
 	"\x10connection_limit\x18\x01 \x01(\bH\x00R\x0fconnectionLimit\x12J\n" +
 	"\x04task\x18\x02 \x01(\v24.bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.TaskH\x00R\x04task\x122\n" +
-	"\x14parallel_tasks_limit\x18\x03 \x01(\bH\x00R\x12parallelTasksLimit\x1a0\n" +
+	"\x14parallel_tasks_limit\x18\x03 \x01(\bH\x00R\x12parallelTasksLimit\x12r\n" +
+	"\x12maintenance_window\x18\x04 \x01(\v2A.bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindowH\x00R\x11maintenanceWindow\x1a0\n" +
 	"\x04Task\x12\x12\n" +
 	"\x04task\x18\x01 \x01(\tR\x04task\x12\x14\n" +
-	"\x05issue\x18\x02 \x01(\tR\x05issueB\a\n" +
+	"\x05issue\x18\x02 \x01(\tR\x05issue\x1aY\n" +
+	"\x11MaintenanceWindow\x12D\n" +
+	"\x10next_window_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0enextWindowTimeB\a\n" +
 	"\x05cause\"^\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -4012,164 +4079,167 @@ func file_v1_rollout_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_rollout_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_v1_rollout_service_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_v1_rollout_service_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_v1_rollout_service_proto_goTypes = []any{
-	(Task_Status)(0),                                       // 0: bytebase.v1.Task.Status
-	(Task_Type)(0),                                         // 1: bytebase.v1.Task.Type
-	(TaskRun_Status)(0),                                    // 2: bytebase.v1.TaskRun.Status
-	(TaskRun_ExportArchiveStatus)(0),                       // 3: bytebase.v1.TaskRun.ExportArchiveStatus
-	(TaskRunLogEntry_Type)(0),                              // 4: bytebase.v1.TaskRunLogEntry.Type
-	(TaskRunLogEntry_TaskRunStatusUpdate_Status)(0),        // 5: bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate.Status
-	(TaskRunLogEntry_TransactionControl_Type)(0),           // 6: bytebase.v1.TaskRunLogEntry.TransactionControl.Type
-	(*BatchRunTasksRequest)(nil),                           // 7: bytebase.v1.BatchRunTasksRequest
-	(*BatchRunTasksResponse)(nil),                          // 8: bytebase.v1.BatchRunTasksResponse
-	(*BatchSkipTasksRequest)(nil),                          // 9: bytebase.v1.BatchSkipTasksRequest
-	(*BatchSkipTasksResponse)(nil),                         // 10: bytebase.v1.BatchSkipTasksResponse
-	(*BatchCancelTaskRunsRequest)(nil),                     // 11: bytebase.v1.BatchCancelTaskRunsRequest
-	(*BatchCancelTaskRunsResponse)(nil),                    // 12: bytebase.v1.BatchCancelTaskRunsResponse
-	(*GetRolloutRequest)(nil),                              // 13: bytebase.v1.GetRolloutRequest
-	(*ListRolloutsRequest)(nil),                            // 14: bytebase.v1.ListRolloutsRequest
-	(*ListRolloutsResponse)(nil),                           // 15: bytebase.v1.ListRolloutsResponse
-	(*CreateRolloutRequest)(nil),                           // 16: bytebase.v1.CreateRolloutRequest
-	(*PreviewRolloutRequest)(nil),                          // 17: bytebase.v1.PreviewRolloutRequest
-	(*ListTaskRunsRequest)(nil),                            // 18: bytebase.v1.ListTaskRunsRequest
-	(*ListTaskRunsResponse)(nil),                           // 19: bytebase.v1.ListTaskRunsResponse
-	(*GetTaskRunRequest)(nil),                              // 20: bytebase.v1.GetTaskRunRequest
-	(*GetTaskRunLogRequest)(nil),                           // 21: bytebase.v1.GetTaskRunLogRequest
-	(*Rollout)(nil),                                        // 22: bytebase.v1.Rollout
-	(*Stage)(nil),                                          // 23: bytebase.v1.Stage
-	(*Task)(nil),                                           // 24: bytebase.v1.Task
-	(*TaskRun)(nil),                                        // 25: bytebase.v1.TaskRun
-	(*TaskRunLog)(nil),                                     // 26: bytebase.v1.TaskRunLog
-	(*TaskRunLogEntry)(nil),                                // 27: bytebase.v1.TaskRunLogEntry
-	(*GetTaskRunSessionRequest)(nil),                       // 28: bytebase.v1.GetTaskRunSessionRequest
-	(*TaskRunSession)(nil),                                 // 29: bytebase.v1.TaskRunSession
-	(*PreviewTaskRunRollbackRequest)(nil),                  // 30: bytebase.v1.PreviewTaskRunRollbackRequest
-	(*PreviewTaskRunRollbackResponse)(nil),                 // 31: bytebase.v1.PreviewTaskRunRollbackResponse
-	(*Task_DatabaseCreate)(nil),                            // 32: bytebase.v1.Task.DatabaseCreate
-	(*Task_DatabaseUpdate)(nil),                            // 33: bytebase.v1.Task.DatabaseUpdate
-	(*Task_DatabaseDataExport)(nil),                        // 34: bytebase.v1.Task.DatabaseDataExport
-	(*TaskRun_PriorBackupDetail)(nil),                      // 35: bytebase.v1.TaskRun.PriorBackupDetail
-	(*TaskRun_SchedulerInfo)(nil),                          // 36: bytebase.v1.TaskRun.SchedulerInfo
-	(*TaskRun_PriorBackupDetail_Item)(nil),                 // 37: bytebase.v1.TaskRun.PriorBackupDetail.Item
-	(*TaskRun_PriorBackupDetail_Item_Table)(nil),           // 38: bytebase.v1.TaskRun.PriorBackupDetail.Item.Table
-	(*TaskRun_SchedulerInfo_WaitingCause)(nil),             // 39: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause
-	(*TaskRun_SchedulerInfo_WaitingCause_Task)(nil),        // 40: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.Task
-	(*TaskRunLogEntry_SchemaDump)(nil),                     // 41: bytebase.v1.TaskRunLogEntry.SchemaDump
-	(*TaskRunLogEntry_CommandExecute)(nil),                 // 42: bytebase.v1.TaskRunLogEntry.CommandExecute
-	(*TaskRunLogEntry_DatabaseSync)(nil),                   // 43: bytebase.v1.TaskRunLogEntry.DatabaseSync
-	(*TaskRunLogEntry_TaskRunStatusUpdate)(nil),            // 44: bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate
-	(*TaskRunLogEntry_TransactionControl)(nil),             // 45: bytebase.v1.TaskRunLogEntry.TransactionControl
-	(*TaskRunLogEntry_PriorBackup)(nil),                    // 46: bytebase.v1.TaskRunLogEntry.PriorBackup
-	(*TaskRunLogEntry_RetryInfo)(nil),                      // 47: bytebase.v1.TaskRunLogEntry.RetryInfo
-	(*TaskRunLogEntry_ComputeDiff)(nil),                    // 48: bytebase.v1.TaskRunLogEntry.ComputeDiff
-	(*TaskRunLogEntry_CommandExecute_CommandResponse)(nil), // 49: bytebase.v1.TaskRunLogEntry.CommandExecute.CommandResponse
-	(*TaskRunSession_Postgres)(nil),                        // 50: bytebase.v1.TaskRunSession.Postgres
-	(*TaskRunSession_Postgres_Session)(nil),                // 51: bytebase.v1.TaskRunSession.Postgres.Session
-	(*timestamppb.Timestamp)(nil),                          // 52: google.protobuf.Timestamp
-	(*Plan)(nil),                                           // 53: bytebase.v1.Plan
-	(DatabaseChangeType)(0),                                // 54: bytebase.v1.DatabaseChangeType
-	(MigrationType)(0),                                     // 55: bytebase.v1.MigrationType
-	(ExportFormat)(0),                                      // 56: bytebase.v1.ExportFormat
-	(*Position)(nil),                                       // 57: bytebase.v1.Position
+	(Task_Status)(0),                                             // 0: bytebase.v1.Task.Status
+	(Task_Type)(0),                                               // 1: bytebase.v1.Task.Type
+	(TaskRun_Status)(0),                                          // 2: bytebase.v1.TaskRun.Status
+	(TaskRun_ExportArchiveStatus)(0),                             // 3: bytebase.v1.TaskRun.ExportArchiveStatus
+	(TaskRunLogEntry_Type)(0),                                    // 4: bytebase.v1.TaskRunLogEntry.Type
+	(TaskRunLogEntry_TaskRunStatusUpdate_Status)(0),              // 5: bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate.Status
+	(TaskRunLogEntry_TransactionControl_Type)(0),                 // 6: bytebase.v1.TaskRunLogEntry.TransactionControl.Type
+	(*BatchRunTasksRequest)(nil),                                 // 7: bytebase.v1.BatchRunTasksRequest
+	(*BatchRunTasksResponse)(nil),                                // 8: bytebase.v1.BatchRunTasksResponse
+	(*BatchSkipTasksRequest)(nil),                                // 9: bytebase.v1.BatchSkipTasksRequest
+	(*BatchSkipTasksResponse)(nil),                               // 10: bytebase.v1.BatchSkipTasksResponse
+	(*BatchCancelTaskRunsRequest)(nil),                           // 11: bytebase.v1.BatchCancelTaskRunsRequest
+	(*BatchCancelTaskRunsResponse)(nil),                          // 12: bytebase.v1.BatchCancelTaskRunsResponse
+	(*GetRolloutRequest)(nil),                                    // 13: bytebase.v1.GetRolloutRequest
+	(*ListRolloutsRequest)(nil),                                  // 14: bytebase.v1.ListRolloutsRequest
+	(*ListRolloutsResponse)(nil),                                 // 15: bytebase.v1.ListRolloutsResponse
+	(*CreateRolloutRequest)(nil),                                 // 16: bytebase.v1.CreateRolloutRequest
+	(*PreviewRolloutRequest)(nil),                                // 17: bytebase.v1.PreviewRolloutRequest
+	(*ListTaskRunsRequest)(nil),                                  // 18: bytebase.v1.ListTaskRunsRequest
+	(*ListTaskRunsResponse)(nil),                                 // 19: bytebase.v1.ListTaskRunsResponse
+	(*GetTaskRunRequest)(nil),                                    // 20: bytebase.v1.GetTaskRunRequest
+	(*GetTaskRunLogRequest)(nil),                                 // 21: bytebase.v1.GetTaskRunLogRequest
+	(*Rollout)(nil),                                              // 22: bytebase.v1.Rollout
+	(*Stage)(nil),                                                // 23: bytebase.v1.Stage
+	(*Task)(nil),                                                 // 24: bytebase.v1.Task
+	(*TaskRun)(nil),                                              // 25: bytebase.v1.TaskRun
+	(*TaskRunLog)(nil),                                           // 26: bytebase.v1.TaskRunLog
+	(*TaskRunLogEntry)(nil),                                      // 27: bytebase.v1.TaskRunLogEntry
+	(*GetTaskRunSessionRequest)(nil),                             // 28: bytebase.v1.GetTaskRunSessionRequest
+	(*TaskRunSession)(nil),                                       // 29: bytebase.v1.TaskRunSession
+	(*PreviewTaskRunRollbackRequest)(nil),                        // 30: bytebase.v1.PreviewTaskRunRollbackRequest
+	(*PreviewTaskRunRollbackResponse)(nil),                       // 31: bytebase.v1.PreviewTaskRunRollbackResponse
+	(*Task_DatabaseCreate)(nil),                                  // 32: bytebase.v1.Task.DatabaseCreate
+	(*Task_DatabaseUpdate)(nil),                                  // 33: bytebase.v1.Task.DatabaseUpdate
+	(*Task_DatabaseDataExport)(nil),                              // 34: bytebase.v1.Task.DatabaseDataExport
+	(*TaskRun_PriorBackupDetail)(nil),                            // 35: bytebase.v1.TaskRun.PriorBackupDetail
+	(*TaskRun_SchedulerInfo)(nil),                                // 36: bytebase.v1.TaskRun.SchedulerInfo
+	(*TaskRun_PriorBackupDetail_Item)(nil),                       // 37: bytebase.v1.TaskRun.PriorBackupDetail.Item
+	(*TaskRun_PriorBackupDetail_Item_Table)(nil),                 // 38: bytebase.v1.TaskRun.PriorBackupDetail.Item.Table
+	(*TaskRun_SchedulerInfo_WaitingCause)(nil),                   // 39: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause
+	(*TaskRun_SchedulerInfo_WaitingCause_Task)(nil),              // 40: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.Task
+	(*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow)(nil), // 41: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow
+	(*TaskRunLogEntry_SchemaDump)(nil),                           // 42: bytebase.v1.TaskRunLogEntry.SchemaDump
+	(*TaskRunLogEntry_CommandExecute)(nil),                       // 43: bytebase.v1.TaskRunLogEntry.CommandExecute
+	(*TaskRunLogEntry_DatabaseSync)(nil),                         // 44: bytebase.v1.TaskRunLogEntry.DatabaseSync
+	(*TaskRunLogEntry_TaskRunStatusUpdate)(nil),                  // 45: bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate
+	(*TaskRunLogEntry_TransactionControl)(nil),                   // 46: bytebase.v1.TaskRunLogEntry.TransactionControl
+	(*TaskRunLogEntry_PriorBackup)(nil),                          // 47: bytebase.v1.TaskRunLogEntry.PriorBackup
+	(*TaskRunLogEntry_RetryInfo)(nil),                            // 48: bytebase.v1.TaskRunLogEntry.RetryInfo
+	(*TaskRunLogEntry_ComputeDiff)(nil),                          // 49: bytebase.v1.TaskRunLogEntry.ComputeDiff
+	(*TaskRunLogEntry_CommandExecute_CommandResponse)(nil),       // 50: bytebase.v1.TaskRunLogEntry.CommandExecute.CommandResponse
+	(*TaskRunSession_Postgres)(nil),                              // 51: bytebase.v1.TaskRunSession.Postgres
+	(*TaskRunSession_Postgres_Session)(nil),                      // 52: bytebase.v1.TaskRunSession.Postgres.Session
+	(*timestamppb.Timestamp)(nil),                                // 53: google.protobuf.Timestamp
+	(*Plan)(nil),                                                 // 54: bytebase.v1.Plan
+	(DatabaseChangeType)(0),                                      // 55: bytebase.v1.DatabaseChangeType
+	(MigrationType)(0),                                           // 56: bytebase.v1.MigrationType
+	(ExportFormat)(0),                                            // 57: bytebase.v1.ExportFormat
+	(*Position)(nil),                                             // 58: bytebase.v1.Position
 }
 var file_v1_rollout_service_proto_depIdxs = []int32{
-	52, // 0: bytebase.v1.BatchRunTasksRequest.run_time:type_name -> google.protobuf.Timestamp
+	53, // 0: bytebase.v1.BatchRunTasksRequest.run_time:type_name -> google.protobuf.Timestamp
 	22, // 1: bytebase.v1.ListRolloutsResponse.rollouts:type_name -> bytebase.v1.Rollout
 	22, // 2: bytebase.v1.CreateRolloutRequest.rollout:type_name -> bytebase.v1.Rollout
-	53, // 3: bytebase.v1.PreviewRolloutRequest.plan:type_name -> bytebase.v1.Plan
+	54, // 3: bytebase.v1.PreviewRolloutRequest.plan:type_name -> bytebase.v1.Plan
 	25, // 4: bytebase.v1.ListTaskRunsResponse.task_runs:type_name -> bytebase.v1.TaskRun
 	23, // 5: bytebase.v1.Rollout.stages:type_name -> bytebase.v1.Stage
-	52, // 6: bytebase.v1.Rollout.create_time:type_name -> google.protobuf.Timestamp
-	52, // 7: bytebase.v1.Rollout.update_time:type_name -> google.protobuf.Timestamp
+	53, // 6: bytebase.v1.Rollout.create_time:type_name -> google.protobuf.Timestamp
+	53, // 7: bytebase.v1.Rollout.update_time:type_name -> google.protobuf.Timestamp
 	24, // 8: bytebase.v1.Stage.tasks:type_name -> bytebase.v1.Task
 	0,  // 9: bytebase.v1.Task.status:type_name -> bytebase.v1.Task.Status
 	1,  // 10: bytebase.v1.Task.type:type_name -> bytebase.v1.Task.Type
 	32, // 11: bytebase.v1.Task.database_create:type_name -> bytebase.v1.Task.DatabaseCreate
 	33, // 12: bytebase.v1.Task.database_update:type_name -> bytebase.v1.Task.DatabaseUpdate
 	34, // 13: bytebase.v1.Task.database_data_export:type_name -> bytebase.v1.Task.DatabaseDataExport
-	52, // 14: bytebase.v1.Task.update_time:type_name -> google.protobuf.Timestamp
-	52, // 15: bytebase.v1.Task.run_time:type_name -> google.protobuf.Timestamp
-	52, // 16: bytebase.v1.TaskRun.create_time:type_name -> google.protobuf.Timestamp
-	52, // 17: bytebase.v1.TaskRun.update_time:type_name -> google.protobuf.Timestamp
+	53, // 14: bytebase.v1.Task.update_time:type_name -> google.protobuf.Timestamp
+	53, // 15: bytebase.v1.Task.run_time:type_name -> google.protobuf.Timestamp
+	53, // 16: bytebase.v1.TaskRun.create_time:type_name -> google.protobuf.Timestamp
+	53, // 17: bytebase.v1.TaskRun.update_time:type_name -> google.protobuf.Timestamp
 	2,  // 18: bytebase.v1.TaskRun.status:type_name -> bytebase.v1.TaskRun.Status
-	52, // 19: bytebase.v1.TaskRun.start_time:type_name -> google.protobuf.Timestamp
+	53, // 19: bytebase.v1.TaskRun.start_time:type_name -> google.protobuf.Timestamp
 	3,  // 20: bytebase.v1.TaskRun.export_archive_status:type_name -> bytebase.v1.TaskRun.ExportArchiveStatus
 	35, // 21: bytebase.v1.TaskRun.prior_backup_detail:type_name -> bytebase.v1.TaskRun.PriorBackupDetail
 	36, // 22: bytebase.v1.TaskRun.scheduler_info:type_name -> bytebase.v1.TaskRun.SchedulerInfo
-	52, // 23: bytebase.v1.TaskRun.run_time:type_name -> google.protobuf.Timestamp
+	53, // 23: bytebase.v1.TaskRun.run_time:type_name -> google.protobuf.Timestamp
 	27, // 24: bytebase.v1.TaskRunLog.entries:type_name -> bytebase.v1.TaskRunLogEntry
 	4,  // 25: bytebase.v1.TaskRunLogEntry.type:type_name -> bytebase.v1.TaskRunLogEntry.Type
-	52, // 26: bytebase.v1.TaskRunLogEntry.log_time:type_name -> google.protobuf.Timestamp
-	41, // 27: bytebase.v1.TaskRunLogEntry.schema_dump:type_name -> bytebase.v1.TaskRunLogEntry.SchemaDump
-	42, // 28: bytebase.v1.TaskRunLogEntry.command_execute:type_name -> bytebase.v1.TaskRunLogEntry.CommandExecute
-	43, // 29: bytebase.v1.TaskRunLogEntry.database_sync:type_name -> bytebase.v1.TaskRunLogEntry.DatabaseSync
-	44, // 30: bytebase.v1.TaskRunLogEntry.task_run_status_update:type_name -> bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate
-	45, // 31: bytebase.v1.TaskRunLogEntry.transaction_control:type_name -> bytebase.v1.TaskRunLogEntry.TransactionControl
-	46, // 32: bytebase.v1.TaskRunLogEntry.prior_backup:type_name -> bytebase.v1.TaskRunLogEntry.PriorBackup
-	47, // 33: bytebase.v1.TaskRunLogEntry.retry_info:type_name -> bytebase.v1.TaskRunLogEntry.RetryInfo
-	48, // 34: bytebase.v1.TaskRunLogEntry.compute_diff:type_name -> bytebase.v1.TaskRunLogEntry.ComputeDiff
-	50, // 35: bytebase.v1.TaskRunSession.postgres:type_name -> bytebase.v1.TaskRunSession.Postgres
-	54, // 36: bytebase.v1.Task.DatabaseUpdate.database_change_type:type_name -> bytebase.v1.DatabaseChangeType
-	55, // 37: bytebase.v1.Task.DatabaseUpdate.migration_type:type_name -> bytebase.v1.MigrationType
-	56, // 38: bytebase.v1.Task.DatabaseDataExport.format:type_name -> bytebase.v1.ExportFormat
+	53, // 26: bytebase.v1.TaskRunLogEntry.log_time:type_name -> google.protobuf.Timestamp
+	42, // 27: bytebase.v1.TaskRunLogEntry.schema_dump:type_name -> bytebase.v1.TaskRunLogEntry.SchemaDump
+	43, // 28: bytebase.v1.TaskRunLogEntry.command_execute:type_name -> bytebase.v1.TaskRunLogEntry.CommandExecute
+	44, // 29: bytebase.v1.TaskRunLogEntry.database_sync:type_name -> bytebase.v1.TaskRunLogEntry.DatabaseSync
+	45, // 30: bytebase.v1.TaskRunLogEntry.task_run_status_update:type_name -> bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate
+	46, // 31: bytebase.v1.TaskRunLogEntry.transaction_control:type_name -> bytebase.v1.TaskRunLogEntry.TransactionControl
+	47, // 32: bytebase.v1.TaskRunLogEntry.prior_backup:type_name -> bytebase.v1.TaskRunLogEntry.PriorBackup
+	48, // 33: bytebase.v1.TaskRunLogEntry.retry_info:type_name -> bytebase.v1.TaskRunLogEntry.RetryInfo
+	49, // 34: bytebase.v1.TaskRunLogEntry.compute_diff:type_name -> bytebase.v1.TaskRunLogEntry.ComputeDiff
+	51, // 35: bytebase.v1.TaskRunSession.postgres:type_name -> bytebase.v1.TaskRunSession.Postgres
+	55, // 36: bytebase.v1.Task.DatabaseUpdate.database_change_type:type_name -> bytebase.v1.DatabaseChangeType
+	56, // 37: bytebase.v1.Task.DatabaseUpdate.migration_type:type_name -> bytebase.v1.MigrationType
+	57, // 38: bytebase.v1.Task.DatabaseDataExport.format:type_name -> bytebase.v1.ExportFormat
 	37, // 39: bytebase.v1.TaskRun.PriorBackupDetail.items:type_name -> bytebase.v1.TaskRun.PriorBackupDetail.Item
-	52, // 40: bytebase.v1.TaskRun.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
+	53, // 40: bytebase.v1.TaskRun.SchedulerInfo.report_time:type_name -> google.protobuf.Timestamp
 	39, // 41: bytebase.v1.TaskRun.SchedulerInfo.waiting_cause:type_name -> bytebase.v1.TaskRun.SchedulerInfo.WaitingCause
 	38, // 42: bytebase.v1.TaskRun.PriorBackupDetail.Item.source_table:type_name -> bytebase.v1.TaskRun.PriorBackupDetail.Item.Table
 	38, // 43: bytebase.v1.TaskRun.PriorBackupDetail.Item.target_table:type_name -> bytebase.v1.TaskRun.PriorBackupDetail.Item.Table
-	57, // 44: bytebase.v1.TaskRun.PriorBackupDetail.Item.start_position:type_name -> bytebase.v1.Position
-	57, // 45: bytebase.v1.TaskRun.PriorBackupDetail.Item.end_position:type_name -> bytebase.v1.Position
+	58, // 44: bytebase.v1.TaskRun.PriorBackupDetail.Item.start_position:type_name -> bytebase.v1.Position
+	58, // 45: bytebase.v1.TaskRun.PriorBackupDetail.Item.end_position:type_name -> bytebase.v1.Position
 	40, // 46: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.task:type_name -> bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.Task
-	52, // 47: bytebase.v1.TaskRunLogEntry.SchemaDump.start_time:type_name -> google.protobuf.Timestamp
-	52, // 48: bytebase.v1.TaskRunLogEntry.SchemaDump.end_time:type_name -> google.protobuf.Timestamp
-	52, // 49: bytebase.v1.TaskRunLogEntry.CommandExecute.log_time:type_name -> google.protobuf.Timestamp
-	49, // 50: bytebase.v1.TaskRunLogEntry.CommandExecute.response:type_name -> bytebase.v1.TaskRunLogEntry.CommandExecute.CommandResponse
-	52, // 51: bytebase.v1.TaskRunLogEntry.DatabaseSync.start_time:type_name -> google.protobuf.Timestamp
-	52, // 52: bytebase.v1.TaskRunLogEntry.DatabaseSync.end_time:type_name -> google.protobuf.Timestamp
-	5,  // 53: bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate.status:type_name -> bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate.Status
-	6,  // 54: bytebase.v1.TaskRunLogEntry.TransactionControl.type:type_name -> bytebase.v1.TaskRunLogEntry.TransactionControl.Type
-	52, // 55: bytebase.v1.TaskRunLogEntry.PriorBackup.start_time:type_name -> google.protobuf.Timestamp
-	52, // 56: bytebase.v1.TaskRunLogEntry.PriorBackup.end_time:type_name -> google.protobuf.Timestamp
-	35, // 57: bytebase.v1.TaskRunLogEntry.PriorBackup.prior_backup_detail:type_name -> bytebase.v1.TaskRun.PriorBackupDetail
-	52, // 58: bytebase.v1.TaskRunLogEntry.ComputeDiff.start_time:type_name -> google.protobuf.Timestamp
-	52, // 59: bytebase.v1.TaskRunLogEntry.ComputeDiff.end_time:type_name -> google.protobuf.Timestamp
-	52, // 60: bytebase.v1.TaskRunLogEntry.CommandExecute.CommandResponse.log_time:type_name -> google.protobuf.Timestamp
-	51, // 61: bytebase.v1.TaskRunSession.Postgres.session:type_name -> bytebase.v1.TaskRunSession.Postgres.Session
-	51, // 62: bytebase.v1.TaskRunSession.Postgres.blocking_sessions:type_name -> bytebase.v1.TaskRunSession.Postgres.Session
-	51, // 63: bytebase.v1.TaskRunSession.Postgres.blocked_sessions:type_name -> bytebase.v1.TaskRunSession.Postgres.Session
-	52, // 64: bytebase.v1.TaskRunSession.Postgres.Session.backend_start:type_name -> google.protobuf.Timestamp
-	52, // 65: bytebase.v1.TaskRunSession.Postgres.Session.xact_start:type_name -> google.protobuf.Timestamp
-	52, // 66: bytebase.v1.TaskRunSession.Postgres.Session.query_start:type_name -> google.protobuf.Timestamp
-	13, // 67: bytebase.v1.RolloutService.GetRollout:input_type -> bytebase.v1.GetRolloutRequest
-	14, // 68: bytebase.v1.RolloutService.ListRollouts:input_type -> bytebase.v1.ListRolloutsRequest
-	16, // 69: bytebase.v1.RolloutService.CreateRollout:input_type -> bytebase.v1.CreateRolloutRequest
-	17, // 70: bytebase.v1.RolloutService.PreviewRollout:input_type -> bytebase.v1.PreviewRolloutRequest
-	18, // 71: bytebase.v1.RolloutService.ListTaskRuns:input_type -> bytebase.v1.ListTaskRunsRequest
-	20, // 72: bytebase.v1.RolloutService.GetTaskRun:input_type -> bytebase.v1.GetTaskRunRequest
-	21, // 73: bytebase.v1.RolloutService.GetTaskRunLog:input_type -> bytebase.v1.GetTaskRunLogRequest
-	28, // 74: bytebase.v1.RolloutService.GetTaskRunSession:input_type -> bytebase.v1.GetTaskRunSessionRequest
-	7,  // 75: bytebase.v1.RolloutService.BatchRunTasks:input_type -> bytebase.v1.BatchRunTasksRequest
-	9,  // 76: bytebase.v1.RolloutService.BatchSkipTasks:input_type -> bytebase.v1.BatchSkipTasksRequest
-	11, // 77: bytebase.v1.RolloutService.BatchCancelTaskRuns:input_type -> bytebase.v1.BatchCancelTaskRunsRequest
-	30, // 78: bytebase.v1.RolloutService.PreviewTaskRunRollback:input_type -> bytebase.v1.PreviewTaskRunRollbackRequest
-	22, // 79: bytebase.v1.RolloutService.GetRollout:output_type -> bytebase.v1.Rollout
-	15, // 80: bytebase.v1.RolloutService.ListRollouts:output_type -> bytebase.v1.ListRolloutsResponse
-	22, // 81: bytebase.v1.RolloutService.CreateRollout:output_type -> bytebase.v1.Rollout
-	22, // 82: bytebase.v1.RolloutService.PreviewRollout:output_type -> bytebase.v1.Rollout
-	19, // 83: bytebase.v1.RolloutService.ListTaskRuns:output_type -> bytebase.v1.ListTaskRunsResponse
-	25, // 84: bytebase.v1.RolloutService.GetTaskRun:output_type -> bytebase.v1.TaskRun
-	26, // 85: bytebase.v1.RolloutService.GetTaskRunLog:output_type -> bytebase.v1.TaskRunLog
-	29, // 86: bytebase.v1.RolloutService.GetTaskRunSession:output_type -> bytebase.v1.TaskRunSession
-	8,  // 87: bytebase.v1.RolloutService.BatchRunTasks:output_type -> bytebase.v1.BatchRunTasksResponse
-	10, // 88: bytebase.v1.RolloutService.BatchSkipTasks:output_type -> bytebase.v1.BatchSkipTasksResponse
-	12, // 89: bytebase.v1.RolloutService.BatchCancelTaskRuns:output_type -> bytebase.v1.BatchCancelTaskRunsResponse
-	31, // 90: bytebase.v1.RolloutService.PreviewTaskRunRollback:output_type -> bytebase.v1.PreviewTaskRunRollbackResponse
-	79, // [79:91] is the sub-list for method output_type
-	67, // [67:79] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+	41, // 47: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.maintenance_window:type_name -> bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow
+	53, // 48: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow.next_window_time:type_name -> google.protobuf.Timestamp
+	53, // 49: bytebase.v1.TaskRunLogEntry.SchemaDump.start_time:type_name -> google.protobuf.Timestamp
+	53, // 50: bytebase.v1.TaskRunLogEntry.SchemaDump.end_time:type_name -> google.protobuf.Timestamp
+	53, // 51: bytebase.v1.TaskRunLogEntry.CommandExecute.log_time:type_name -> google.protobuf.Timestamp
+	50, // 52: bytebase.v1.TaskRunLogEntry.CommandExecute.response:type_name -> bytebase.v1.TaskRunLogEntry.CommandExecute.CommandResponse
+	53, // 53: bytebase.v1.TaskRunLogEntry.DatabaseSync.start_time:type_name -> google.protobuf.Timestamp
+	53, // 54: bytebase.v1.TaskRunLogEntry.DatabaseSync.end_time:type_name -> google.protobuf.Timestamp
+	5,  // 55: bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate.status:type_name -> bytebase.v1.TaskRunLogEntry.TaskRunStatusUpdate.Status
+	6,  // 56: bytebase.v1.TaskRunLogEntry.TransactionControl.type:type_name -> bytebase.v1.TaskRunLogEntry.TransactionControl.Type
+	53, // 57: bytebase.v1.TaskRunLogEntry.PriorBackup.start_time:type_name -> google.protobuf.Timestamp
+	53, // 58: bytebase.v1.TaskRunLogEntry.PriorBackup.end_time:type_name -> google.protobuf.Timestamp
+	35, // 59: bytebase.v1.TaskRunLogEntry.PriorBackup.prior_backup_detail:type_name -> bytebase.v1.TaskRun.PriorBackupDetail
+	53, // 60: bytebase.v1.TaskRunLogEntry.ComputeDiff.start_time:type_name -> google.protobuf.Timestamp
+	53, // 61: bytebase.v1.TaskRunLogEntry.ComputeDiff.end_time:type_name -> google.protobuf.Timestamp
+	53, // 62: bytebase.v1.TaskRunLogEntry.CommandExecute.CommandResponse.log_time:type_name -> google.protobuf.Timestamp
+	52, // 63: bytebase.v1.TaskRunSession.Postgres.session:type_name -> bytebase.v1.TaskRunSession.Postgres.Session
+	52, // 64: bytebase.v1.TaskRunSession.Postgres.blocking_sessions:type_name -> bytebase.v1.TaskRunSession.Postgres.Session
+	52, // 65: bytebase.v1.TaskRunSession.Postgres.blocked_sessions:type_name -> bytebase.v1.TaskRunSession.Postgres.Session
+	53, // 66: bytebase.v1.TaskRunSession.Postgres.Session.backend_start:type_name -> google.protobuf.Timestamp
+	53, // 67: bytebase.v1.TaskRunSession.Postgres.Session.xact_start:type_name -> google.protobuf.Timestamp
+	53, // 68: bytebase.v1.TaskRunSession.Postgres.Session.query_start:type_name -> google.protobuf.Timestamp
+	13, // 69: bytebase.v1.RolloutService.GetRollout:input_type -> bytebase.v1.GetRolloutRequest
+	14, // 70: bytebase.v1.RolloutService.ListRollouts:input_type -> bytebase.v1.ListRolloutsRequest
+	16, // 71: bytebase.v1.RolloutService.CreateRollout:input_type -> bytebase.v1.CreateRolloutRequest
+	17, // 72: bytebase.v1.RolloutService.PreviewRollout:input_type -> bytebase.v1.PreviewRolloutRequest
+	18, // 73: bytebase.v1.RolloutService.ListTaskRuns:input_type -> bytebase.v1.ListTaskRunsRequest
+	20, // 74: bytebase.v1.RolloutService.GetTaskRun:input_type -> bytebase.v1.GetTaskRunRequest
+	21, // 75: bytebase.v1.RolloutService.GetTaskRunLog:input_type -> bytebase.v1.GetTaskRunLogRequest
+	28, // 76: bytebase.v1.RolloutService.GetTaskRunSession:input_type -> bytebase.v1.GetTaskRunSessionRequest
+	7,  // 77: bytebase.v1.RolloutService.BatchRunTasks:input_type -> bytebase.v1.BatchRunTasksRequest
+	9,  // 78: bytebase.v1.RolloutService.BatchSkipTasks:input_type -> bytebase.v1.BatchSkipTasksRequest
+	11, // 79: bytebase.v1.RolloutService.BatchCancelTaskRuns:input_type -> bytebase.v1.BatchCancelTaskRunsRequest
+	30, // 80: bytebase.v1.RolloutService.PreviewTaskRunRollback:input_type -> bytebase.v1.PreviewTaskRunRollbackRequest
+	22, // 81: bytebase.v1.RolloutService.GetRollout:output_type -> bytebase.v1.Rollout
+	15, // 82: bytebase.v1.RolloutService.ListRollouts:output_type -> bytebase.v1.ListRolloutsResponse
+	22, // 83: bytebase.v1.RolloutService.CreateRollout:output_type -> bytebase.v1.Rollout
+	22, // 84: bytebase.v1.RolloutService.PreviewRollout:output_type -> bytebase.v1.Rollout
+	19, // 85: bytebase.v1.RolloutService.ListTaskRuns:output_type -> bytebase.v1.ListTaskRunsResponse
+	25, // 86: bytebase.v1.RolloutService.GetTaskRun:output_type -> bytebase.v1.TaskRun
+	26, // 87: bytebase.v1.RolloutService.GetTaskRunLog:output_type -> bytebase.v1.TaskRunLog
+	29, // 88: bytebase.v1.RolloutService.GetTaskRunSession:output_type -> bytebase.v1.TaskRunSession
+	8,  // 89: bytebase.v1.RolloutService.BatchRunTasks:output_type -> bytebase.v1.BatchRunTasksResponse
+	10, // 90: bytebase.v1.RolloutService.BatchSkipTasks:output_type -> bytebase.v1.BatchSkipTasksResponse
+	12, // 91: bytebase.v1.RolloutService.BatchCancelTaskRuns:output_type -> bytebase.v1.BatchCancelTaskRunsResponse
+	31, // 92: bytebase.v1.RolloutService.PreviewTaskRunRollback:output_type -> bytebase.v1.PreviewTaskRunRollbackResponse
+	81, // [81:93] is the sub-list for method output_type
+	69, // [69:81] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_v1_rollout_service_proto_init() }
@@ -4196,15 +4266,16 @@ func file_v1_rollout_service_proto_init() {
 		(*TaskRun_SchedulerInfo_WaitingCause_ConnectionLimit)(nil),
 		(*TaskRun_SchedulerInfo_WaitingCause_Task_)(nil),
 		(*TaskRun_SchedulerInfo_WaitingCause_ParallelTasksLimit)(nil),
+		(*TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow_)(nil),
 	}
-	file_v1_rollout_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_v1_rollout_service_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_rollout_service_proto_rawDesc), len(file_v1_rollout_service_proto_rawDesc)),
 			NumEnums:      7,
-			NumMessages:   45,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -518,6 +518,19 @@ func (x *TaskRun_SchedulerInfo_WaitingCause_Task) Equal(y *TaskRun_SchedulerInfo
 	return true
 }
 
+func (x *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) Equal(y *TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if p, q := x.NextWindowTime, y.NextWindowTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
 func (x *TaskRun_SchedulerInfo_WaitingCause) Equal(y *TaskRun_SchedulerInfo_WaitingCause) bool {
 	if x == y {
 		return true
@@ -534,6 +547,9 @@ func (x *TaskRun_SchedulerInfo_WaitingCause) Equal(y *TaskRun_SchedulerInfo_Wait
 	if x.GetParallelTasksLimit() != y.GetParallelTasksLimit() {
 		return false
 	}
+	if !x.GetMaintenanceWindow().Equal(y.GetMaintenanceWindow()) {
+		return false
+	}
 	return true
 }
 
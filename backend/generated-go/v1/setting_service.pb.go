@@ -97,6 +97,8 @@ const (
 	Setting_SCIM                        Setting_SettingName = 17
 	Setting_PASSWORD_RESTRICTION        Setting_SettingName = 18
 	Setting_ENVIRONMENT                 Setting_SettingName = 19
+	Setting_EMAIL                       Setting_SettingName = 20
+	Setting_AUDIT_LOG_SINK              Setting_SettingName = 21
 )
 
 // Enum value maps for Setting_SettingName.
@@ -119,6 +121,8 @@ var (
 		17: "SCIM",
 		18: "PASSWORD_RESTRICTION",
 		19: "ENVIRONMENT",
+		20: "EMAIL",
+		21: "AUDIT_LOG_SINK",
 	}
 	Setting_SettingName_value = map[string]int32{
 		"SETTING_NAME_UNSPECIFIED":    0,
@@ -138,6 +142,8 @@ var (
 		"SCIM":                        17,
 		"PASSWORD_RESTRICTION":        18,
 		"ENVIRONMENT":                 19,
+		"EMAIL":                       20,
+		"AUDIT_LOG_SINK":              21,
 	}
 )
 
@@ -267,7 +273,113 @@ func (x Algorithm_InnerOuterMask_MaskType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Algorithm_InnerOuterMask_MaskType.Descriptor instead.
 func (Algorithm_InnerOuterMask_MaskType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 3, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 3, 0}
+}
+
+type Algorithm_FormatPreservingEncryptionMask_Mode int32
+
+const (
+	Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED Algorithm_FormatPreservingEncryptionMask_Mode = 0
+	// NIST SP 800-38G FF1.
+	Algorithm_FormatPreservingEncryptionMask_FF1 Algorithm_FormatPreservingEncryptionMask_Mode = 1
+	// NIST SP 800-38G Revision 1 FF3-1.
+	Algorithm_FormatPreservingEncryptionMask_FF3_1 Algorithm_FormatPreservingEncryptionMask_Mode = 2
+)
+
+// Enum value maps for Algorithm_FormatPreservingEncryptionMask_Mode.
+var (
+	Algorithm_FormatPreservingEncryptionMask_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "FF1",
+		2: "FF3_1",
+	}
+	Algorithm_FormatPreservingEncryptionMask_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"FF1":              1,
+		"FF3_1":            2,
+	}
+)
+
+func (x Algorithm_FormatPreservingEncryptionMask_Mode) Enum() *Algorithm_FormatPreservingEncryptionMask_Mode {
+	p := new(Algorithm_FormatPreservingEncryptionMask_Mode)
+	*p = x
+	return p
+}
+
+func (x Algorithm_FormatPreservingEncryptionMask_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Algorithm_FormatPreservingEncryptionMask_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[4].Descriptor()
+}
+
+func (Algorithm_FormatPreservingEncryptionMask_Mode) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[4]
+}
+
+func (x Algorithm_FormatPreservingEncryptionMask_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Algorithm_FormatPreservingEncryptionMask_Mode.Descriptor instead.
+func (Algorithm_FormatPreservingEncryptionMask_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 4, 0}
+}
+
+type Algorithm_DateTruncateMask_Granularity int32
+
+const (
+	Algorithm_DateTruncateMask_GRANULARITY_UNSPECIFIED Algorithm_DateTruncateMask_Granularity = 0
+	Algorithm_DateTruncateMask_YEAR                    Algorithm_DateTruncateMask_Granularity = 1
+	Algorithm_DateTruncateMask_MONTH                   Algorithm_DateTruncateMask_Granularity = 2
+	Algorithm_DateTruncateMask_DAY                     Algorithm_DateTruncateMask_Granularity = 3
+	Algorithm_DateTruncateMask_HOUR                    Algorithm_DateTruncateMask_Granularity = 4
+)
+
+// Enum value maps for Algorithm_DateTruncateMask_Granularity.
+var (
+	Algorithm_DateTruncateMask_Granularity_name = map[int32]string{
+		0: "GRANULARITY_UNSPECIFIED",
+		1: "YEAR",
+		2: "MONTH",
+		3: "DAY",
+		4: "HOUR",
+	}
+	Algorithm_DateTruncateMask_Granularity_value = map[string]int32{
+		"GRANULARITY_UNSPECIFIED": 0,
+		"YEAR":                    1,
+		"MONTH":                   2,
+		"DAY":                     3,
+		"HOUR":                    4,
+	}
+)
+
+func (x Algorithm_DateTruncateMask_Granularity) Enum() *Algorithm_DateTruncateMask_Granularity {
+	p := new(Algorithm_DateTruncateMask_Granularity)
+	*p = x
+	return p
+}
+
+func (x Algorithm_DateTruncateMask_Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Algorithm_DateTruncateMask_Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[5].Descriptor()
+}
+
+func (Algorithm_DateTruncateMask_Granularity) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[5]
+}
+
+func (x Algorithm_DateTruncateMask_Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Algorithm_DateTruncateMask_Granularity.Descriptor instead.
+func (Algorithm_DateTruncateMask_Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 6, 0}
 }
 
 type AISetting_Provider int32
@@ -309,11 +421,11 @@ func (x AISetting_Provider) String() string {
 }
 
 func (AISetting_Provider) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[4].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[6].Descriptor()
 }
 
 func (AISetting_Provider) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[4]
+	return &file_v1_setting_service_proto_enumTypes[6]
 }
 
 func (x AISetting_Provider) Number() protoreflect.EnumNumber {
@@ -322,7 +434,168 @@ func (x AISetting_Provider) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AISetting_Provider.Descriptor instead.
 func (AISetting_Provider) EnumDescriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{17, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type EmailSetting_Encryption int32
+
+const (
+	EmailSetting_ENCRYPTION_UNSPECIFIED EmailSetting_Encryption = 0
+	// Plain connection without encryption.
+	EmailSetting_NONE EmailSetting_Encryption = 1
+	// Upgrade the plain connection with STARTTLS.
+	EmailSetting_STARTTLS EmailSetting_Encryption = 2
+	// Implicit TLS from the start of the connection.
+	EmailSetting_SSL_TLS EmailSetting_Encryption = 3
+)
+
+// Enum value maps for EmailSetting_Encryption.
+var (
+	EmailSetting_Encryption_name = map[int32]string{
+		0: "ENCRYPTION_UNSPECIFIED",
+		1: "NONE",
+		2: "STARTTLS",
+		3: "SSL_TLS",
+	}
+	EmailSetting_Encryption_value = map[string]int32{
+		"ENCRYPTION_UNSPECIFIED": 0,
+		"NONE":                   1,
+		"STARTTLS":               2,
+		"SSL_TLS":                3,
+	}
+)
+
+func (x EmailSetting_Encryption) Enum() *EmailSetting_Encryption {
+	p := new(EmailSetting_Encryption)
+	*p = x
+	return p
+}
+
+func (x EmailSetting_Encryption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmailSetting_Encryption) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[7].Descriptor()
+}
+
+func (EmailSetting_Encryption) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[7]
+}
+
+func (x EmailSetting_Encryption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmailSetting_Encryption.Descriptor instead.
+func (EmailSetting_Encryption) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type AuditLogSinkSetting_Sink_Type int32
+
+const (
+	AuditLogSinkSetting_Sink_TYPE_UNSPECIFIED AuditLogSinkSetting_Sink_Type = 0
+	// RFC 5424 syslog messages over TCP, framed by the octet counting of RFC 6587.
+	AuditLogSinkSetting_Sink_SYSLOG AuditLogSinkSetting_Sink_Type = 1
+	// JSON batches posted to the HTTP endpoint, e.g. Splunk HTTP Event Collector.
+	AuditLogSinkSetting_Sink_HTTP AuditLogSinkSetting_Sink_Type = 2
+	// OTLP logs posted to the HTTP endpoint with the JSON encoding.
+	AuditLogSinkSetting_Sink_OTLP AuditLogSinkSetting_Sink_Type = 3
+)
+
+// Enum value maps for AuditLogSinkSetting_Sink_Type.
+var (
+	AuditLogSinkSetting_Sink_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SYSLOG",
+		2: "HTTP",
+		3: "OTLP",
+	}
+	AuditLogSinkSetting_Sink_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SYSLOG":           1,
+		"HTTP":             2,
+		"OTLP":             3,
+	}
+)
+
+func (x AuditLogSinkSetting_Sink_Type) Enum() *AuditLogSinkSetting_Sink_Type {
+	p := new(AuditLogSinkSetting_Sink_Type)
+	*p = x
+	return p
+}
+
+func (x AuditLogSinkSetting_Sink_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogSinkSetting_Sink_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[8].Descriptor()
+}
+
+func (AuditLogSinkSetting_Sink_Type) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[8]
+}
+
+func (x AuditLogSinkSetting_Sink_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting_Sink_Type.Descriptor instead.
+func (AuditLogSinkSetting_Sink_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{21, 0, 0}
+}
+
+type AuditLogSinkSetting_Sink_Format int32
+
+const (
+	AuditLogSinkSetting_Sink_FORMAT_UNSPECIFIED AuditLogSinkSetting_Sink_Format = 0
+	// A JSON array of the audit logs with the bearer token.
+	AuditLogSinkSetting_Sink_JSON AuditLogSinkSetting_Sink_Format = 1
+	// The Splunk HTTP Event Collector events with the "Splunk {token}" authorization.
+	AuditLogSinkSetting_Sink_SPLUNK_HEC AuditLogSinkSetting_Sink_Format = 2
+)
+
+// Enum value maps for AuditLogSinkSetting_Sink_Format.
+var (
+	AuditLogSinkSetting_Sink_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JSON",
+		2: "SPLUNK_HEC",
+	}
+	AuditLogSinkSetting_Sink_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JSON":               1,
+		"SPLUNK_HEC":         2,
+	}
+)
+
+func (x AuditLogSinkSetting_Sink_Format) Enum() *AuditLogSinkSetting_Sink_Format {
+	p := new(AuditLogSinkSetting_Sink_Format)
+	*p = x
+	return p
+}
+
+func (x AuditLogSinkSetting_Sink_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogSinkSetting_Sink_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[9].Descriptor()
+}
+
+func (AuditLogSinkSetting_Sink_Format) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[9]
+}
+
+func (x AuditLogSinkSetting_Sink_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting_Sink_Format.Descriptor instead.
+func (AuditLogSinkSetting_Sink_Format) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{21, 0, 1}
 }
 
 type ListSettingsRequest struct {
@@ -645,6 +918,9 @@ type Value struct {
 	//	*Value_PasswordRestrictionSetting
 	//	*Value_AiSetting
 	//	*Value_EnvironmentSetting
+	//	*Value_EmailSetting
+	//	*Value_WorkspaceExternalApprovalSettingValue
+	//	*Value_AuditLogSinkSetting
 	Value         isValue_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -786,6 +1062,33 @@ func (x *Value) GetEnvironmentSetting() *EnvironmentSetting {
 	return nil
 }
 
+func (x *Value) GetEmailSetting() *EmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*Value_EmailSetting); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetWorkspaceExternalApprovalSettingValue() *WorkspaceExternalApprovalSetting {
+	if x != nil {
+		if x, ok := x.Value.(*Value_WorkspaceExternalApprovalSettingValue); ok {
+			return x.WorkspaceExternalApprovalSettingValue
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetAuditLogSinkSetting() *AuditLogSinkSetting {
+	if x != nil {
+		if x, ok := x.Value.(*Value_AuditLogSinkSetting); ok {
+			return x.AuditLogSinkSetting
+		}
+	}
+	return nil
+}
+
 type isValue_Value interface {
 	isValue_Value()
 }
@@ -836,6 +1139,18 @@ type Value_EnvironmentSetting struct {
 	EnvironmentSetting *EnvironmentSetting `protobuf:"bytes,17,opt,name=environment_setting,json=environmentSetting,proto3,oneof"`
 }
 
+type Value_EmailSetting struct {
+	EmailSetting *EmailSetting `protobuf:"bytes,19,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type Value_WorkspaceExternalApprovalSettingValue struct {
+	WorkspaceExternalApprovalSettingValue *WorkspaceExternalApprovalSetting `protobuf:"bytes,20,opt,name=workspace_external_approval_setting_value,json=workspaceExternalApprovalSettingValue,proto3,oneof"`
+}
+
+type Value_AuditLogSinkSetting struct {
+	AuditLogSinkSetting *AuditLogSinkSetting `protobuf:"bytes,21,opt,name=audit_log_sink_setting,json=auditLogSinkSetting,proto3,oneof"`
+}
+
 func (*Value_StringValue) isValue_Value() {}
 
 func (*Value_AppImSettingValue) isValue_Value() {}
@@ -858,6 +1173,12 @@ func (*Value_AiSetting) isValue_Value() {}
 
 func (*Value_EnvironmentSetting) isValue_Value() {}
 
+func (*Value_EmailSetting) isValue_Value() {}
+
+func (*Value_WorkspaceExternalApprovalSettingValue) isValue_Value() {}
+
+func (*Value_AuditLogSinkSetting) isValue_Value() {}
+
 type AppIMSetting struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Settings      []*AppIMSetting_IMSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
@@ -1163,6 +1484,50 @@ func (x *WorkspaceApprovalSetting) GetRules() []*WorkspaceApprovalSetting_Rule {
 	return nil
 }
 
+type WorkspaceExternalApprovalSetting struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Nodes         []*WorkspaceExternalApprovalSetting_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceExternalApprovalSetting) Reset() {
+	*x = WorkspaceExternalApprovalSetting{}
+	mi := &file_v1_setting_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceExternalApprovalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceExternalApprovalSetting) ProtoMessage() {}
+
+func (x *WorkspaceExternalApprovalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceExternalApprovalSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceExternalApprovalSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkspaceExternalApprovalSetting) GetNodes() []*WorkspaceExternalApprovalSetting_Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
 type SchemaTemplateSetting struct {
 	state          protoimpl.MessageState                 `protogen:"open.v1"`
 	FieldTemplates []*SchemaTemplateSetting_FieldTemplate `protobuf:"bytes,1,rep,name=field_templates,json=fieldTemplates,proto3" json:"field_templates,omitempty"`
@@ -1174,7 +1539,7 @@ type SchemaTemplateSetting struct {
 
 func (x *SchemaTemplateSetting) Reset() {
 	*x = SchemaTemplateSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[11]
+	mi := &file_v1_setting_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1186,7 +1551,7 @@ func (x *SchemaTemplateSetting) String() string {
 func (*SchemaTemplateSetting) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[11]
+	mi := &file_v1_setting_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1564,7 @@ func (x *SchemaTemplateSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaTemplateSetting.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SchemaTemplateSetting) GetFieldTemplates() []*SchemaTemplateSetting_FieldTemplate {
@@ -1232,7 +1597,7 @@ type DataClassificationSetting struct {
 
 func (x *DataClassificationSetting) Reset() {
 	*x = DataClassificationSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[12]
+	mi := &file_v1_setting_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1244,7 +1609,7 @@ func (x *DataClassificationSetting) String() string {
 func (*DataClassificationSetting) ProtoMessage() {}
 
 func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[12]
+	mi := &file_v1_setting_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1257,7 +1622,7 @@ func (x *DataClassificationSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataClassificationSetting.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{12}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DataClassificationSetting) GetConfigs() []*DataClassificationSetting_DataClassificationConfig {
@@ -1276,7 +1641,7 @@ type SemanticTypeSetting struct {
 
 func (x *SemanticTypeSetting) Reset() {
 	*x = SemanticTypeSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[13]
+	mi := &file_v1_setting_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1288,7 +1653,7 @@ func (x *SemanticTypeSetting) String() string {
 func (*SemanticTypeSetting) ProtoMessage() {}
 
 func (x *SemanticTypeSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[13]
+	mi := &file_v1_setting_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,7 +1666,7 @@ func (x *SemanticTypeSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemanticTypeSetting.ProtoReflect.Descriptor instead.
 func (*SemanticTypeSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{13}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SemanticTypeSetting) GetTypes() []*SemanticTypeSetting_SemanticType {
@@ -1319,6 +1684,11 @@ type Algorithm struct {
 	//	*Algorithm_RangeMask_
 	//	*Algorithm_Md5Mask
 	//	*Algorithm_InnerOuterMask_
+	//	*Algorithm_FormatPreservingEncryptionMask_
+	//	*Algorithm_TokenizationMask_
+	//	*Algorithm_DateTruncateMask_
+	//	*Algorithm_NumericBucketMask_
+	//	*Algorithm_NumericNoiseMask_
 	Mask          isAlgorithm_Mask `protobuf_oneof:"mask"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1326,7 +1696,7 @@ type Algorithm struct {
 
 func (x *Algorithm) Reset() {
 	*x = Algorithm{}
-	mi := &file_v1_setting_service_proto_msgTypes[14]
+	mi := &file_v1_setting_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1338,7 +1708,7 @@ func (x *Algorithm) String() string {
 func (*Algorithm) ProtoMessage() {}
 
 func (x *Algorithm) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[14]
+	mi := &file_v1_setting_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,7 +1721,7 @@ func (x *Algorithm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm.ProtoReflect.Descriptor instead.
 func (*Algorithm) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Algorithm) GetMask() isAlgorithm_Mask {
@@ -1397,6 +1767,51 @@ func (x *Algorithm) GetInnerOuterMask() *Algorithm_InnerOuterMask {
 	return nil
 }
 
+func (x *Algorithm) GetFormatPreservingEncryptionMask() *Algorithm_FormatPreservingEncryptionMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_FormatPreservingEncryptionMask_); ok {
+			return x.FormatPreservingEncryptionMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetTokenizationMask() *Algorithm_TokenizationMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_TokenizationMask_); ok {
+			return x.TokenizationMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDateTruncateMask() *Algorithm_DateTruncateMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_DateTruncateMask_); ok {
+			return x.DateTruncateMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericBucketMask() *Algorithm_NumericBucketMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericBucketMask_); ok {
+			return x.NumericBucketMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericNoiseMask() *Algorithm_NumericNoiseMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericNoiseMask_); ok {
+			return x.NumericNoiseMask
+		}
+	}
+	return nil
+}
+
 type isAlgorithm_Mask interface {
 	isAlgorithm_Mask()
 }
@@ -1417,6 +1832,32 @@ type Algorithm_InnerOuterMask_ struct {
 	InnerOuterMask *Algorithm_InnerOuterMask `protobuf:"bytes,8,opt,name=inner_outer_mask,json=innerOuterMask,proto3,oneof"`
 }
 
+type Algorithm_FormatPreservingEncryptionMask_ struct {
+	// Encrypt the value while keeping its length and character classes with the workspace key.
+	FormatPreservingEncryptionMask *Algorithm_FormatPreservingEncryptionMask `protobuf:"bytes,9,opt,name=format_preserving_encryption_mask,json=formatPreservingEncryptionMask,proto3,oneof"`
+}
+
+type Algorithm_TokenizationMask_ struct {
+	// Replace the value with a deterministic token derived from the workspace key,
+	// so the same value gets the same token in all tables.
+	TokenizationMask *Algorithm_TokenizationMask `protobuf:"bytes,10,opt,name=tokenization_mask,json=tokenizationMask,proto3,oneof"`
+}
+
+type Algorithm_DateTruncateMask_ struct {
+	// Truncate dates and timestamps to the year, month, day or hour.
+	DateTruncateMask *Algorithm_DateTruncateMask `protobuf:"bytes,11,opt,name=date_truncate_mask,json=dateTruncateMask,proto3,oneof"`
+}
+
+type Algorithm_NumericBucketMask_ struct {
+	// Round numbers down to buckets.
+	NumericBucketMask *Algorithm_NumericBucketMask `protobuf:"bytes,12,opt,name=numeric_bucket_mask,json=numericBucketMask,proto3,oneof"`
+}
+
+type Algorithm_NumericNoiseMask_ struct {
+	// Add bounded random noise to numbers.
+	NumericNoiseMask *Algorithm_NumericNoiseMask `protobuf:"bytes,13,opt,name=numeric_noise_mask,json=numericNoiseMask,proto3,oneof"`
+}
+
 func (*Algorithm_FullMask_) isAlgorithm_Mask() {}
 
 func (*Algorithm_RangeMask_) isAlgorithm_Mask() {}
@@ -1425,6 +1866,16 @@ func (*Algorithm_Md5Mask) isAlgorithm_Mask() {}
 
 func (*Algorithm_InnerOuterMask_) isAlgorithm_Mask() {}
 
+func (*Algorithm_FormatPreservingEncryptionMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_TokenizationMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_DateTruncateMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericBucketMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericNoiseMask_) isAlgorithm_Mask() {}
+
 type SCIMSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -1434,7 +1885,7 @@ type SCIMSetting struct {
 
 func (x *SCIMSetting) Reset() {
 	*x = SCIMSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[15]
+	mi := &file_v1_setting_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1446,7 +1897,7 @@ func (x *SCIMSetting) String() string {
 func (*SCIMSetting) ProtoMessage() {}
 
 func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[15]
+	mi := &file_v1_setting_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +1910,7 @@ func (x *SCIMSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SCIMSetting.ProtoReflect.Descriptor instead.
 func (*SCIMSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{15}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SCIMSetting) GetToken() string {
@@ -1491,7 +1942,7 @@ type PasswordRestrictionSetting struct {
 
 func (x *PasswordRestrictionSetting) Reset() {
 	*x = PasswordRestrictionSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[16]
+	mi := &file_v1_setting_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1503,7 +1954,7 @@ func (x *PasswordRestrictionSetting) String() string {
 func (*PasswordRestrictionSetting) ProtoMessage() {}
 
 func (x *PasswordRestrictionSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[16]
+	mi := &file_v1_setting_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,7 +1967,7 @@ func (x *PasswordRestrictionSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PasswordRestrictionSetting.ProtoReflect.Descriptor instead.
 func (*PasswordRestrictionSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{16}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PasswordRestrictionSetting) GetMinLength() int32 {
@@ -1582,7 +2033,7 @@ type AISetting struct {
 
 func (x *AISetting) Reset() {
 	*x = AISetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[17]
+	mi := &file_v1_setting_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1594,7 +2045,7 @@ func (x *AISetting) String() string {
 func (*AISetting) ProtoMessage() {}
 
 func (x *AISetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[17]
+	mi := &file_v1_setting_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +2058,7 @@ func (x *AISetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AISetting.ProtoReflect.Descriptor instead.
 func (*AISetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{17}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AISetting) GetEnabled() bool {
@@ -1652,6 +2103,105 @@ func (x *AISetting) GetVersion() string {
 	return ""
 }
 
+type EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to send email notifications.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The host of the SMTP server.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// The port of the SMTP server.
+	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The username for SMTP authentication. Authentication is skipped if empty.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// The password for SMTP authentication.
+	// The existing password is kept if empty on update.
+	Password   string                  `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Encryption EmailSetting_Encryption `protobuf:"varint,6,opt,name=encryption,proto3,enum=bytebase.v1.EmailSetting_Encryption" json:"encryption,omitempty"`
+	// The sender address, e.g. "Bytebase <noreply@example.com>".
+	From          string `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailSetting) Reset() {
+	*x = EmailSetting{}
+	mi := &file_v1_setting_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailSetting) ProtoMessage() {}
+
+func (x *EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailSetting.ProtoReflect.Descriptor instead.
+func (*EmailSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EmailSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EmailSetting) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *EmailSetting) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *EmailSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EmailSetting) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EmailSetting) GetEncryption() EmailSetting_Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return EmailSetting_ENCRYPTION_UNSPECIFIED
+}
+
+func (x *EmailSetting) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
 type EnvironmentSetting struct {
 	state         protoimpl.MessageState            `protogen:"open.v1"`
 	Environments  []*EnvironmentSetting_Environment `protobuf:"bytes,1,rep,name=environments,proto3" json:"environments,omitempty"`
@@ -1661,7 +2211,7 @@ type EnvironmentSetting struct {
 
 func (x *EnvironmentSetting) Reset() {
 	*x = EnvironmentSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[18]
+	mi := &file_v1_setting_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1673,7 +2223,7 @@ func (x *EnvironmentSetting) String() string {
 func (*EnvironmentSetting) ProtoMessage() {}
 
 func (x *EnvironmentSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[18]
+	mi := &file_v1_setting_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,7 +2236,7 @@ func (x *EnvironmentSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentSetting.ProtoReflect.Descriptor instead.
 func (*EnvironmentSetting) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EnvironmentSetting) GetEnvironments() []*EnvironmentSetting_Environment {
@@ -1696,16 +2246,62 @@ func (x *EnvironmentSetting) GetEnvironments() []*EnvironmentSetting_Environment
 	return nil
 }
 
+type AuditLogSinkSetting struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Sinks         []*AuditLogSinkSetting_Sink `protobuf:"bytes,1,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogSinkSetting) Reset() {
+	*x = AuditLogSinkSetting{}
+	mi := &file_v1_setting_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogSinkSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSinkSetting) ProtoMessage() {}
+
+func (x *AuditLogSinkSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting.ProtoReflect.Descriptor instead.
+func (*AuditLogSinkSetting) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuditLogSinkSetting) GetSinks() []*AuditLogSinkSetting_Sink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
 type AppIMSetting_Slack struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The signing secret is used to verify the interactive requests from Slack.
+	SigningSecret string `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AppIMSetting_Slack) Reset() {
 	*x = AppIMSetting_Slack{}
-	mi := &file_v1_setting_service_proto_msgTypes[19]
+	mi := &file_v1_setting_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1717,7 +2313,7 @@ func (x *AppIMSetting_Slack) String() string {
 func (*AppIMSetting_Slack) ProtoMessage() {}
 
 func (x *AppIMSetting_Slack) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[19]
+	mi := &file_v1_setting_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,6 +2336,13 @@ func (x *AppIMSetting_Slack) GetToken() string {
 	return ""
 }
 
+func (x *AppIMSetting_Slack) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
 type AppIMSetting_Feishu struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -1750,7 +2353,7 @@ type AppIMSetting_Feishu struct {
 
 func (x *AppIMSetting_Feishu) Reset() {
 	*x = AppIMSetting_Feishu{}
-	mi := &file_v1_setting_service_proto_msgTypes[20]
+	mi := &file_v1_setting_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1762,7 +2365,7 @@ func (x *AppIMSetting_Feishu) String() string {
 func (*AppIMSetting_Feishu) ProtoMessage() {}
 
 func (x *AppIMSetting_Feishu) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[20]
+	mi := &file_v1_setting_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,7 +2406,7 @@ type AppIMSetting_Wecom struct {
 
 func (x *AppIMSetting_Wecom) Reset() {
 	*x = AppIMSetting_Wecom{}
-	mi := &file_v1_setting_service_proto_msgTypes[21]
+	mi := &file_v1_setting_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1815,7 +2418,7 @@ func (x *AppIMSetting_Wecom) String() string {
 func (*AppIMSetting_Wecom) ProtoMessage() {}
 
 func (x *AppIMSetting_Wecom) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[21]
+	mi := &file_v1_setting_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,16 +2456,18 @@ func (x *AppIMSetting_Wecom) GetSecret() string {
 }
 
 type AppIMSetting_Lark struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	AppSecret     string                 `protobuf:"bytes,2,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AppId     string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppSecret string                 `protobuf:"bytes,2,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
+	// The verification token is used to verify the message card callbacks from Lark.
+	VerificationToken string `protobuf:"bytes,3,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AppIMSetting_Lark) Reset() {
 	*x = AppIMSetting_Lark{}
-	mi := &file_v1_setting_service_proto_msgTypes[22]
+	mi := &file_v1_setting_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1874,7 +2479,7 @@ func (x *AppIMSetting_Lark) String() string {
 func (*AppIMSetting_Lark) ProtoMessage() {}
 
 func (x *AppIMSetting_Lark) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[22]
+	mi := &file_v1_setting_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,6 +2509,13 @@ func (x *AppIMSetting_Lark) GetAppSecret() string {
 	return ""
 }
 
+func (x *AppIMSetting_Lark) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
 type AppIMSetting_DingTalk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
@@ -1915,7 +2527,7 @@ type AppIMSetting_DingTalk struct {
 
 func (x *AppIMSetting_DingTalk) Reset() {
 	*x = AppIMSetting_DingTalk{}
-	mi := &file_v1_setting_service_proto_msgTypes[23]
+	mi := &file_v1_setting_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1927,7 +2539,7 @@ func (x *AppIMSetting_DingTalk) String() string {
 func (*AppIMSetting_DingTalk) ProtoMessage() {}
 
 func (x *AppIMSetting_DingTalk) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[23]
+	mi := &file_v1_setting_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +2593,7 @@ type AppIMSetting_IMSetting struct {
 
 func (x *AppIMSetting_IMSetting) Reset() {
 	*x = AppIMSetting_IMSetting{}
-	mi := &file_v1_setting_service_proto_msgTypes[24]
+	mi := &file_v1_setting_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1993,7 +2605,7 @@ func (x *AppIMSetting_IMSetting) String() string {
 func (*AppIMSetting_IMSetting) ProtoMessage() {}
 
 func (x *AppIMSetting_IMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[24]
+	mi := &file_v1_setting_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,7 +2733,7 @@ type WorkspaceApprovalSetting_Rule struct {
 
 func (x *WorkspaceApprovalSetting_Rule) Reset() {
 	*x = WorkspaceApprovalSetting_Rule{}
-	mi := &file_v1_setting_service_proto_msgTypes[25]
+	mi := &file_v1_setting_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2133,7 +2745,7 @@ func (x *WorkspaceApprovalSetting_Rule) String() string {
 func (*WorkspaceApprovalSetting_Rule) ProtoMessage() {}
 
 func (x *WorkspaceApprovalSetting_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[25]
+	mi := &file_v1_setting_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,6 +2775,91 @@ func (x *WorkspaceApprovalSetting_Rule) GetCondition() *expr.Expr {
 	return nil
 }
 
+type WorkspaceExternalApprovalSetting_Node struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the node.
+	// The approval flow references the node as "externalApprovalNodes/{id}".
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The endpoint of the external approval service.
+	// Bytebase sends the approval requests by POST to the endpoint,
+	// and polls the request status by GET from "{endpoint}/{request_id}".
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The token sent as the bearer token to the endpoint.
+	// The callbacks from the external approval service must carry the same bearer token.
+	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// The approval request is rejected if it is not decided within the timeout.
+	// No timeout if unset.
+	Timeout       *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) Reset() {
+	*x = WorkspaceExternalApprovalSetting_Node{}
+	mi := &file_v1_setting_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceExternalApprovalSetting_Node) ProtoMessage() {}
+
+func (x *WorkspaceExternalApprovalSetting_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceExternalApprovalSetting_Node.ProtoReflect.Descriptor instead.
+func (*WorkspaceExternalApprovalSetting_Node) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WorkspaceExternalApprovalSetting_Node) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 type SchemaTemplateSetting_FieldTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2176,7 +2873,7 @@ type SchemaTemplateSetting_FieldTemplate struct {
 
 func (x *SchemaTemplateSetting_FieldTemplate) Reset() {
 	*x = SchemaTemplateSetting_FieldTemplate{}
-	mi := &file_v1_setting_service_proto_msgTypes[26]
+	mi := &file_v1_setting_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2188,7 +2885,7 @@ func (x *SchemaTemplateSetting_FieldTemplate) String() string {
 func (*SchemaTemplateSetting_FieldTemplate) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[26]
+	mi := &file_v1_setting_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2898,7 @@ func (x *SchemaTemplateSetting_FieldTemplate) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SchemaTemplateSetting_FieldTemplate.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting_FieldTemplate) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *SchemaTemplateSetting_FieldTemplate) GetId() string {
@@ -2250,7 +2947,7 @@ type SchemaTemplateSetting_ColumnType struct {
 
 func (x *SchemaTemplateSetting_ColumnType) Reset() {
 	*x = SchemaTemplateSetting_ColumnType{}
-	mi := &file_v1_setting_service_proto_msgTypes[27]
+	mi := &file_v1_setting_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2262,7 +2959,7 @@ func (x *SchemaTemplateSetting_ColumnType) String() string {
 func (*SchemaTemplateSetting_ColumnType) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[27]
+	mi := &file_v1_setting_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,7 +2972,7 @@ func (x *SchemaTemplateSetting_ColumnType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaTemplateSetting_ColumnType.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting_ColumnType) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 1}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *SchemaTemplateSetting_ColumnType) GetEngine() Engine {
@@ -2312,7 +3009,7 @@ type SchemaTemplateSetting_TableTemplate struct {
 
 func (x *SchemaTemplateSetting_TableTemplate) Reset() {
 	*x = SchemaTemplateSetting_TableTemplate{}
-	mi := &file_v1_setting_service_proto_msgTypes[28]
+	mi := &file_v1_setting_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2324,7 +3021,7 @@ func (x *SchemaTemplateSetting_TableTemplate) String() string {
 func (*SchemaTemplateSetting_TableTemplate) ProtoMessage() {}
 
 func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[28]
+	mi := &file_v1_setting_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2337,7 +3034,7 @@ func (x *SchemaTemplateSetting_TableTemplate) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SchemaTemplateSetting_TableTemplate.ProtoReflect.Descriptor instead.
 func (*SchemaTemplateSetting_TableTemplate) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 2}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 2}
 }
 
 func (x *SchemaTemplateSetting_TableTemplate) GetId() string {
@@ -2396,7 +3093,7 @@ type DataClassificationSetting_DataClassificationConfig struct {
 
 func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
 	*x = DataClassificationSetting_DataClassificationConfig{}
-	mi := &file_v1_setting_service_proto_msgTypes[29]
+	mi := &file_v1_setting_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2408,7 +3105,7 @@ func (x *DataClassificationSetting_DataClassificationConfig) String() string {
 func (*DataClassificationSetting_DataClassificationConfig) ProtoMessage() {}
 
 func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[29]
+	mi := &file_v1_setting_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2421,7 +3118,7 @@ func (x *DataClassificationSetting_DataClassificationConfig) ProtoReflect() prot
 
 // Deprecated: Use DataClassificationSetting_DataClassificationConfig.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting_DataClassificationConfig) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig) GetId() string {
@@ -2470,7 +3167,7 @@ type DataClassificationSetting_DataClassificationConfig_Level struct {
 
 func (x *DataClassificationSetting_DataClassificationConfig_Level) Reset() {
 	*x = DataClassificationSetting_DataClassificationConfig_Level{}
-	mi := &file_v1_setting_service_proto_msgTypes[30]
+	mi := &file_v1_setting_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2482,7 +3179,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) String() stri
 func (*DataClassificationSetting_DataClassificationConfig_Level) ProtoMessage() {}
 
 func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[30]
+	mi := &file_v1_setting_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2495,7 +3192,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_Level) ProtoReflect(
 
 // Deprecated: Use DataClassificationSetting_DataClassificationConfig_Level.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting_DataClassificationConfig_Level) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 0, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 0, 0}
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig_Level) GetId() string {
@@ -2532,7 +3229,7 @@ type DataClassificationSetting_DataClassificationConfig_DataClassification struc
 
 func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) Reset() {
 	*x = DataClassificationSetting_DataClassificationConfig_DataClassification{}
-	mi := &file_v1_setting_service_proto_msgTypes[31]
+	mi := &file_v1_setting_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2544,7 +3241,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 func (*DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoMessage() {}
 
 func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[31]
+	mi := &file_v1_setting_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,7 +3254,7 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 
 // Deprecated: Use DataClassificationSetting_DataClassificationConfig_DataClassification.ProtoReflect.Descriptor instead.
 func (*DataClassificationSetting_DataClassificationConfig_DataClassification) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{12, 0, 1}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 0, 1}
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig_DataClassification) GetId() string {
@@ -2605,7 +3302,7 @@ type SemanticTypeSetting_SemanticType struct {
 
 func (x *SemanticTypeSetting_SemanticType) Reset() {
 	*x = SemanticTypeSetting_SemanticType{}
-	mi := &file_v1_setting_service_proto_msgTypes[33]
+	mi := &file_v1_setting_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2617,7 +3314,7 @@ func (x *SemanticTypeSetting_SemanticType) String() string {
 func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
 
 func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[33]
+	mi := &file_v1_setting_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,7 +3327,7 @@ func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemanticTypeSetting_SemanticType.ProtoReflect.Descriptor instead.
 func (*SemanticTypeSetting_SemanticType) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *SemanticTypeSetting_SemanticType) GetId() string {
@@ -2679,7 +3376,7 @@ type Algorithm_FullMask struct {
 
 func (x *Algorithm_FullMask) Reset() {
 	*x = Algorithm_FullMask{}
-	mi := &file_v1_setting_service_proto_msgTypes[34]
+	mi := &file_v1_setting_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2691,7 +3388,7 @@ func (x *Algorithm_FullMask) String() string {
 func (*Algorithm_FullMask) ProtoMessage() {}
 
 func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[34]
+	mi := &file_v1_setting_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,7 +3401,7 @@ func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm_FullMask.ProtoReflect.Descriptor instead.
 func (*Algorithm_FullMask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *Algorithm_FullMask) GetSubstitution() string {
@@ -2725,7 +3422,7 @@ type Algorithm_RangeMask struct {
 
 func (x *Algorithm_RangeMask) Reset() {
 	*x = Algorithm_RangeMask{}
-	mi := &file_v1_setting_service_proto_msgTypes[35]
+	mi := &file_v1_setting_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2737,7 +3434,7 @@ func (x *Algorithm_RangeMask) String() string {
 func (*Algorithm_RangeMask) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[35]
+	mi := &file_v1_setting_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2750,7 +3447,7 @@ func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm_RangeMask.ProtoReflect.Descriptor instead.
 func (*Algorithm_RangeMask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 1}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 1}
 }
 
 func (x *Algorithm_RangeMask) GetSlices() []*Algorithm_RangeMask_Slice {
@@ -2770,7 +3467,7 @@ type Algorithm_MD5Mask struct {
 
 func (x *Algorithm_MD5Mask) Reset() {
 	*x = Algorithm_MD5Mask{}
-	mi := &file_v1_setting_service_proto_msgTypes[36]
+	mi := &file_v1_setting_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2782,7 +3479,7 @@ func (x *Algorithm_MD5Mask) String() string {
 func (*Algorithm_MD5Mask) ProtoMessage() {}
 
 func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[36]
+	mi := &file_v1_setting_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,7 +3492,7 @@ func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm_MD5Mask.ProtoReflect.Descriptor instead.
 func (*Algorithm_MD5Mask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 2}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 2}
 }
 
 func (x *Algorithm_MD5Mask) GetSalt() string {
@@ -2817,7 +3514,7 @@ type Algorithm_InnerOuterMask struct {
 
 func (x *Algorithm_InnerOuterMask) Reset() {
 	*x = Algorithm_InnerOuterMask{}
-	mi := &file_v1_setting_service_proto_msgTypes[37]
+	mi := &file_v1_setting_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2829,7 +3526,7 @@ func (x *Algorithm_InnerOuterMask) String() string {
 func (*Algorithm_InnerOuterMask) ProtoMessage() {}
 
 func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[37]
+	mi := &file_v1_setting_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2842,7 +3539,7 @@ func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm_InnerOuterMask.ProtoReflect.Descriptor instead.
 func (*Algorithm_InnerOuterMask) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 3}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 3}
 }
 
 func (x *Algorithm_InnerOuterMask) GetPrefixLen() int32 {
@@ -2873,6 +3570,242 @@ func (x *Algorithm_InnerOuterMask) GetSubstitution() string {
 	return ""
 }
 
+type Algorithm_FormatPreservingEncryptionMask struct {
+	state protoimpl.MessageState                        `protogen:"open.v1"`
+	Mode  Algorithm_FormatPreservingEncryptionMask_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=bytebase.v1.Algorithm_FormatPreservingEncryptionMask_Mode" json:"mode,omitempty"`
+	// tweak is mixed into the encryption, so the same value is encrypted differently with different tweaks.
+	Tweak         string `protobuf:"bytes,2,opt,name=tweak,proto3" json:"tweak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_FormatPreservingEncryptionMask) Reset() {
+	*x = Algorithm_FormatPreservingEncryptionMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_FormatPreservingEncryptionMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_FormatPreservingEncryptionMask) ProtoMessage() {}
+
+func (x *Algorithm_FormatPreservingEncryptionMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_FormatPreservingEncryptionMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_FormatPreservingEncryptionMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 4}
+}
+
+func (x *Algorithm_FormatPreservingEncryptionMask) GetMode() Algorithm_FormatPreservingEncryptionMask_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Algorithm_FormatPreservingEncryptionMask_MODE_UNSPECIFIED
+}
+
+func (x *Algorithm_FormatPreservingEncryptionMask) GetTweak() string {
+	if x != nil {
+		return x.Tweak
+	}
+	return ""
+}
+
+type Algorithm_TokenizationMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefix is prepended to the tokens, such as "tok_".
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_TokenizationMask) Reset() {
+	*x = Algorithm_TokenizationMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_TokenizationMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_TokenizationMask) ProtoMessage() {}
+
+func (x *Algorithm_TokenizationMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_TokenizationMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_TokenizationMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 5}
+}
+
+func (x *Algorithm_TokenizationMask) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type Algorithm_DateTruncateMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// granularity is the unit the dates and timestamps are truncated to,
+	// such as 2024-03-15 10:30:00 to 2024-01-01 00:00:00 with YEAR.
+	Granularity   Algorithm_DateTruncateMask_Granularity `protobuf:"varint,1,opt,name=granularity,proto3,enum=bytebase.v1.Algorithm_DateTruncateMask_Granularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_DateTruncateMask) Reset() {
+	*x = Algorithm_DateTruncateMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_DateTruncateMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_DateTruncateMask) ProtoMessage() {}
+
+func (x *Algorithm_DateTruncateMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_DateTruncateMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_DateTruncateMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 6}
+}
+
+func (x *Algorithm_DateTruncateMask) GetGranularity() Algorithm_DateTruncateMask_Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Algorithm_DateTruncateMask_GRANULARITY_UNSPECIFIED
+}
+
+type Algorithm_NumericBucketMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bucket_size is the width of the buckets, it should be positive.
+	// Numbers are rounded down to the lower bound of their buckets, such as 37 to 30 with the bucket size 10.
+	BucketSize    float64 `protobuf:"fixed64,1,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_NumericBucketMask) Reset() {
+	*x = Algorithm_NumericBucketMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericBucketMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericBucketMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericBucketMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_NumericBucketMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericBucketMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 7}
+}
+
+func (x *Algorithm_NumericBucketMask) GetBucketSize() float64 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+type Algorithm_NumericNoiseMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_noise is the bound of the noise, it should be positive.
+	// The noise in [-max_noise, max_noise] is derived from the keyed hash of the number, so the same number always gets the same noise, and integers stay integers.
+	MaxNoise      float64 `protobuf:"fixed64,1,opt,name=max_noise,json=maxNoise,proto3" json:"max_noise,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_NumericNoiseMask) Reset() {
+	*x = Algorithm_NumericNoiseMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericNoiseMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericNoiseMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericNoiseMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_NumericNoiseMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericNoiseMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 8}
+}
+
+func (x *Algorithm_NumericNoiseMask) GetMaxNoise() float64 {
+	if x != nil {
+		return x.MaxNoise
+	}
+	return 0
+}
+
 type Algorithm_RangeMask_Slice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// start is the start index of the original value, start from 0 and should be less than stop.
@@ -2887,7 +3820,7 @@ type Algorithm_RangeMask_Slice struct {
 
 func (x *Algorithm_RangeMask_Slice) Reset() {
 	*x = Algorithm_RangeMask_Slice{}
-	mi := &file_v1_setting_service_proto_msgTypes[38]
+	mi := &file_v1_setting_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2899,7 +3832,7 @@ func (x *Algorithm_RangeMask_Slice) String() string {
 func (*Algorithm_RangeMask_Slice) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[38]
+	mi := &file_v1_setting_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2912,7 +3845,7 @@ func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Algorithm_RangeMask_Slice.ProtoReflect.Descriptor instead.
 func (*Algorithm_RangeMask_Slice) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{14, 1, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{15, 1, 0}
 }
 
 func (x *Algorithm_RangeMask_Slice) GetStart() int32 {
@@ -2956,7 +3889,7 @@ type EnvironmentSetting_Environment struct {
 
 func (x *EnvironmentSetting_Environment) Reset() {
 	*x = EnvironmentSetting_Environment{}
-	mi := &file_v1_setting_service_proto_msgTypes[39]
+	mi := &file_v1_setting_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2968,7 +3901,7 @@ func (x *EnvironmentSetting_Environment) String() string {
 func (*EnvironmentSetting_Environment) ProtoMessage() {}
 
 func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[39]
+	mi := &file_v1_setting_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2981,7 +3914,7 @@ func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentSetting_Environment.ProtoReflect.Descriptor instead.
 func (*EnvironmentSetting_Environment) Descriptor() ([]byte, []int) {
-	return file_v1_setting_service_proto_rawDescGZIP(), []int{18, 0}
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{20, 0}
 }
 
 func (x *EnvironmentSetting_Environment) GetName() string {
@@ -3019,6 +3952,105 @@ func (x *EnvironmentSetting_Environment) GetColor() string {
 	return ""
 }
 
+type AuditLogSinkSetting_Sink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the sink, which tracks the delivery progress of the sink. A new sink starts from the latest audit log instead of replaying the history.
+	Id   string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type AuditLogSinkSetting_Sink_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.v1.AuditLogSinkSetting_Sink_Type" json:"type,omitempty"`
+	// The "host:port" of the syslog server for SYSLOG, or the URL for HTTP and OTLP.
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Whether to connect to the syslog server with TLS.
+	Tls bool `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	// The token sent in the authorization header of HTTP and OTLP.
+	Token string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	// The format of the HTTP sink. Unspecified means JSON.
+	Format AuditLogSinkSetting_Sink_Format `protobuf:"varint,6,opt,name=format,proto3,enum=bytebase.v1.AuditLogSinkSetting_Sink_Format" json:"format,omitempty"`
+	// The filter of the audit logs to deliver, in the same syntax as searching the audit logs.
+	// All audit logs are delivered if empty.
+	Filter        string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogSinkSetting_Sink) Reset() {
+	*x = AuditLogSinkSetting_Sink{}
+	mi := &file_v1_setting_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogSinkSetting_Sink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSinkSetting_Sink) ProtoMessage() {}
+
+func (x *AuditLogSinkSetting_Sink) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSinkSetting_Sink.ProtoReflect.Descriptor instead.
+func (*AuditLogSinkSetting_Sink) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *AuditLogSinkSetting_Sink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogSinkSetting_Sink) GetType() AuditLogSinkSetting_Sink_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AuditLogSinkSetting_Sink_TYPE_UNSPECIFIED
+}
+
+func (x *AuditLogSinkSetting_Sink) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *AuditLogSinkSetting_Sink) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AuditLogSinkSetting_Sink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuditLogSinkSetting_Sink) GetFormat() AuditLogSinkSetting_Sink_Format {
+	if x != nil {
+		return x.Format
+	}
+	return AuditLogSinkSetting_Sink_FORMAT_UNSPECIFIED
+}
+
+func (x *AuditLogSinkSetting_Sink) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 var File_v1_setting_service_proto protoreflect.FileDescriptor
 
 const file_v1_setting_service_proto_rawDesc = "" +
@@ -3037,10 +4069,10 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\rvalidate_only\x18\x02 \x01(\bR\fvalidateOnly\x12#\n" +
 	"\rallow_missing\x18\x03 \x01(\bR\fallowMissing\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"\xe2\x03\n" +
+	"updateMask\"\x81\x04\n" +
 	"\aSetting\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
-	"\x05value\x18\x02 \x01(\v2\x12.bytebase.v1.ValueR\x05value\"\xe3\x02\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.bytebase.v1.ValueR\x05value\"\x82\x03\n" +
 	"\vSettingName\x12\x1c\n" +
 	"\x18SETTING_NAME_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vAUTH_SECRET\x10\x01\x12\x11\n" +
@@ -3060,8 +4092,11 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x0eSEMANTIC_TYPES\x10\x0f\x12\b\n" +
 	"\x04SCIM\x10\x11\x12\x18\n" +
 	"\x14PASSWORD_RESTRICTION\x10\x12\x12\x0f\n" +
-	"\vENVIRONMENT\x10\x13:-\xeaA*\n" +
-	"\x14bytebase.com/Setting\x12\x12settings/{setting}J\x04\b\x10\x10\x11\"\xe4\a\n" +
+	"\vENVIRONMENT\x10\x13\x12\t\n" +
+	"\x05EMAIL\x10\x14\x12\x12\n" +
+	"\x0eAUDIT_LOG_SINK\x10\x15:-\xeaA*\n" +
+	"\x14bytebase.com/Setting\x12\x12settings/{setting}J\x04\b\x10\x10\x11\"\x8b\n" +
+	"\n" +
 	"\x05Value\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12L\n" +
 	"\x14app_im_setting_value\x18\x03 \x01(\v2\x19.bytebase.v1.AppIMSettingH\x00R\x11appImSettingValue\x12m\n" +
@@ -3075,12 +4110,16 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x1cpassword_restriction_setting\x18\x0f \x01(\v2'.bytebase.v1.PasswordRestrictionSettingH\x00R\x1apasswordRestrictionSetting\x127\n" +
 	"\n" +
 	"ai_setting\x18\x10 \x01(\v2\x16.bytebase.v1.AISettingH\x00R\taiSetting\x12R\n" +
-	"\x13environment_setting\x18\x11 \x01(\v2\x1f.bytebase.v1.EnvironmentSettingH\x00R\x12environmentSettingB\a\n" +
-	"\x05valueJ\x04\b\x12\x10\x13\"\xd3\x06\n" +
+	"\x13environment_setting\x18\x11 \x01(\v2\x1f.bytebase.v1.EnvironmentSettingH\x00R\x12environmentSetting\x12@\n" +
+	"\remail_setting\x18\x13 \x01(\v2\x19.bytebase.v1.EmailSettingH\x00R\femailSetting\x12\x89\x01\n" +
+	")workspace_external_approval_setting_value\x18\x14 \x01(\v2-.bytebase.v1.WorkspaceExternalApprovalSettingH\x00R%workspaceExternalApprovalSettingValue\x12W\n" +
+	"\x16audit_log_sink_setting\x18\x15 \x01(\v2 .bytebase.v1.AuditLogSinkSettingH\x00R\x13auditLogSinkSettingB\a\n" +
+	"\x05valueJ\x04\b\x12\x10\x13\"\xb3\a\n" +
 	"\fAppIMSetting\x12?\n" +
-	"\bsettings\x18\x01 \x03(\v2#.bytebase.v1.AppIMSetting.IMSettingR\bsettings\x1a\"\n" +
+	"\bsettings\x18\x01 \x03(\v2#.bytebase.v1.AppIMSetting.IMSettingR\bsettings\x1aN\n" +
 	"\x05Slack\x12\x19\n" +
-	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x04R\x05token\x1aH\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x04R\x05token\x12*\n" +
+	"\x0esigning_secret\x18\x02 \x01(\tB\x03\xe0A\x04R\rsigningSecret\x1aH\n" +
 	"\x06Feishu\x12\x1a\n" +
 	"\x06app_id\x18\x01 \x01(\tB\x03\xe0A\x04R\x05appId\x12\"\n" +
 	"\n" +
@@ -3088,11 +4127,12 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x05Wecom\x12\x1c\n" +
 	"\acorp_id\x18\x01 \x01(\tB\x03\xe0A\x04R\x06corpId\x12\x1e\n" +
 	"\bagent_id\x18\x02 \x01(\tB\x03\xe0A\x04R\aagentId\x12\x1b\n" +
-	"\x06secret\x18\x03 \x01(\tB\x03\xe0A\x04R\x06secret\x1aF\n" +
+	"\x06secret\x18\x03 \x01(\tB\x03\xe0A\x04R\x06secret\x1az\n" +
 	"\x04Lark\x12\x1a\n" +
 	"\x06app_id\x18\x01 \x01(\tB\x03\xe0A\x04R\x05appId\x12\"\n" +
 	"\n" +
-	"app_secret\x18\x02 \x01(\tB\x03\xe0A\x04R\tappSecret\x1az\n" +
+	"app_secret\x18\x02 \x01(\tB\x03\xe0A\x04R\tappSecret\x122\n" +
+	"\x12verification_token\x18\x03 \x01(\tB\x03\xe0A\x04R\x11verificationToken\x1az\n" +
 	"\bDingTalk\x12 \n" +
 	"\tclient_id\x18\x01 \x01(\tB\x03\xe0A\x04R\bclientId\x12(\n" +
 	"\rclient_secret\x18\x02 \x01(\tB\x03\xe0A\x04R\fclientSecret\x12\"\n" +
@@ -3136,7 +4176,15 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x05rules\x18\x01 \x03(\v2*.bytebase.v1.WorkspaceApprovalSetting.RuleR\x05rules\x1ar\n" +
 	"\x04Rule\x129\n" +
 	"\btemplate\x18\x01 \x01(\v2\x1d.bytebase.v1.ApprovalTemplateR\btemplate\x12/\n" +
-	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\"\xb2\x06\n" +
+	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\"\x87\x02\n" +
+	" WorkspaceExternalApprovalSetting\x12H\n" +
+	"\x05nodes\x18\x01 \x03(\v22.bytebase.v1.WorkspaceExternalApprovalSetting.NodeR\x05nodes\x1a\x98\x01\n" +
+	"\x04Node\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x19\n" +
+	"\x05token\x18\x04 \x01(\tB\x03\xe0A\x04R\x05token\x123\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb2\x06\n" +
 	"\x15SchemaTemplateSetting\x12Y\n" +
 	"\x0ffield_templates\x18\x01 \x03(\v20.bytebase.v1.SchemaTemplateSetting.FieldTemplateR\x0efieldTemplates\x12P\n" +
 	"\fcolumn_types\x18\x02 \x03(\v2-.bytebase.v1.SchemaTemplateSetting.ColumnTypeR\vcolumnTypes\x12Y\n" +
@@ -3186,13 +4234,19 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
 	"\talgorithm\x18\x06 \x01(\v2\x16.bytebase.v1.AlgorithmR\talgorithm\x12\x12\n" +
-	"\x04icon\x18\a \x01(\tR\x04icon\"\x8e\x06\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\"\x87\x0e\n" +
 	"\tAlgorithm\x12>\n" +
 	"\tfull_mask\x18\x05 \x01(\v2\x1f.bytebase.v1.Algorithm.FullMaskH\x00R\bfullMask\x12A\n" +
 	"\n" +
 	"range_mask\x18\x06 \x01(\v2 .bytebase.v1.Algorithm.RangeMaskH\x00R\trangeMask\x12;\n" +
 	"\bmd5_mask\x18\a \x01(\v2\x1e.bytebase.v1.Algorithm.MD5MaskH\x00R\amd5Mask\x12Q\n" +
-	"\x10inner_outer_mask\x18\b \x01(\v2%.bytebase.v1.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x1a.\n" +
+	"\x10inner_outer_mask\x18\b \x01(\v2%.bytebase.v1.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x12\x82\x01\n" +
+	"!format_preserving_encryption_mask\x18\t \x01(\v25.bytebase.v1.Algorithm.FormatPreservingEncryptionMaskH\x00R\x1eformatPreservingEncryptionMask\x12V\n" +
+	"\x11tokenization_mask\x18\n" +
+	" \x01(\v2'.bytebase.v1.Algorithm.TokenizationMaskH\x00R\x10tokenizationMask\x12W\n" +
+	"\x12date_truncate_mask\x18\v \x01(\v2'.bytebase.v1.Algorithm.DateTruncateMaskH\x00R\x10dateTruncateMask\x12Z\n" +
+	"\x13numeric_bucket_mask\x18\f \x01(\v2(.bytebase.v1.Algorithm.NumericBucketMaskH\x00R\x11numericBucketMask\x12W\n" +
+	"\x12numeric_noise_mask\x18\r \x01(\v2'.bytebase.v1.Algorithm.NumericNoiseMaskH\x00R\x10numericNoiseMask\x1a.\n" +
 	"\bFullMask\x12\"\n" +
 	"\fsubstitution\x18\x01 \x01(\tR\fsubstitution\x1a\xa0\x01\n" +
 	"\tRangeMask\x12>\n" +
@@ -3213,7 +4267,29 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\bMaskType\x12\x19\n" +
 	"\x15MASK_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05INNER\x10\x01\x12\t\n" +
-	"\x05OUTER\x10\x02B\x06\n" +
+	"\x05OUTER\x10\x02\x1a\xb8\x01\n" +
+	"\x1eFormatPreservingEncryptionMask\x12N\n" +
+	"\x04mode\x18\x01 \x01(\x0e2:.bytebase.v1.Algorithm.FormatPreservingEncryptionMask.ModeR\x04mode\x12\x14\n" +
+	"\x05tweak\x18\x02 \x01(\tR\x05tweak\"0\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03FF1\x10\x01\x12\t\n" +
+	"\x05FF3_1\x10\x02\x1a*\n" +
+	"\x10TokenizationMask\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x1a\xbd\x01\n" +
+	"\x10DateTruncateMask\x12U\n" +
+	"\vgranularity\x18\x01 \x01(\x0e23.bytebase.v1.Algorithm.DateTruncateMask.GranularityR\vgranularity\"R\n" +
+	"\vGranularity\x12\x1b\n" +
+	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04YEAR\x10\x01\x12\t\n" +
+	"\x05MONTH\x10\x02\x12\a\n" +
+	"\x03DAY\x10\x03\x12\b\n" +
+	"\x04HOUR\x10\x04\x1a4\n" +
+	"\x11NumericBucketMask\x12\x1f\n" +
+	"\vbucket_size\x18\x01 \x01(\x01R\n" +
+	"bucketSize\x1a/\n" +
+	"\x10NumericNoiseMask\x12\x1b\n" +
+	"\tmax_noise\x18\x01 \x01(\x01R\bmaxNoiseB\x06\n" +
 	"\x04mask\"#\n" +
 	"\vSCIMSetting\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"\x9a\x03\n" +
@@ -3240,7 +4316,23 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x06CLAUDE\x10\x02\x12\n" +
 	"\n" +
 	"\x06GEMINI\x10\x03\x12\x10\n" +
-	"\fAZURE_OPENAI\x10\x04\"\xce\x02\n" +
+	"\fAZURE_OPENAI\x10\x04\"\xb6\x02\n" +
+	"\fEmailSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1f\n" +
+	"\bpassword\x18\x05 \x01(\tB\x03\xe0A\x04R\bpassword\x12D\n" +
+	"\n" +
+	"encryption\x18\x06 \x01(\x0e2$.bytebase.v1.EmailSetting.EncryptionR\n" +
+	"encryption\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\"M\n" +
+	"\n" +
+	"Encryption\x12\x1a\n" +
+	"\x16ENCRYPTION_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04NONE\x10\x01\x12\f\n" +
+	"\bSTARTTLS\x10\x02\x12\v\n" +
+	"\aSSL_TLS\x10\x03\"\xce\x02\n" +
 	"\x12EnvironmentSetting\x12O\n" +
 	"\fenvironments\x18\x01 \x03(\v2+.bytebase.v1.EnvironmentSetting.EnvironmentR\fenvironments\x1a\xe6\x01\n" +
 	"\vEnvironment\x12\x17\n" +
@@ -3251,7 +4343,28 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x05color\x18\x05 \x01(\tR\x05color\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*T\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcc\x03\n" +
+	"\x13AuditLogSinkSetting\x12;\n" +
+	"\x05sinks\x18\x01 \x03(\v2%.bytebase.v1.AuditLogSinkSetting.SinkR\x05sinks\x1a\xf7\x02\n" +
+	"\x04Sink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
+	"\x04type\x18\x02 \x01(\x0e2*.bytebase.v1.AuditLogSinkSetting.Sink.TypeR\x04type\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x10\n" +
+	"\x03tls\x18\x04 \x01(\bR\x03tls\x12\x19\n" +
+	"\x05token\x18\x05 \x01(\tB\x03\xe0A\x04R\x05token\x12D\n" +
+	"\x06format\x18\x06 \x01(\x0e2,.bytebase.v1.AuditLogSinkSetting.Sink.FormatR\x06format\x12\x16\n" +
+	"\x06filter\x18\a \x01(\tR\x06filter\"<\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06SYSLOG\x10\x01\x12\b\n" +
+	"\x04HTTP\x10\x02\x12\b\n" +
+	"\x04OTLP\x10\x03\":\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\x12\x0e\n" +
+	"\n" +
+	"SPLUNK_HEC\x10\x02*T\n" +
 	"\x12DatabaseChangeMode\x12$\n" +
 	" DATABASE_CHANGE_MODE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bPIPELINE\x10\x01\x12\n" +
@@ -3276,135 +4389,166 @@ func file_v1_setting_service_proto_rawDescGZIP() []byte {
 	return file_v1_setting_service_proto_rawDescData
 }
 
-var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_v1_setting_service_proto_goTypes = []any{
 	(DatabaseChangeMode)(0),                                          // 0: bytebase.v1.DatabaseChangeMode
 	(Setting_SettingName)(0),                                         // 1: bytebase.v1.Setting.SettingName
 	(Announcement_AlertLevel)(0),                                     // 2: bytebase.v1.Announcement.AlertLevel
 	(Algorithm_InnerOuterMask_MaskType)(0),                           // 3: bytebase.v1.Algorithm.InnerOuterMask.MaskType
-	(AISetting_Provider)(0),                                          // 4: bytebase.v1.AISetting.Provider
-	(*ListSettingsRequest)(nil),                                      // 5: bytebase.v1.ListSettingsRequest
-	(*ListSettingsResponse)(nil),                                     // 6: bytebase.v1.ListSettingsResponse
-	(*GetSettingRequest)(nil),                                        // 7: bytebase.v1.GetSettingRequest
-	(*GetSettingResponse)(nil),                                       // 8: bytebase.v1.GetSettingResponse
-	(*UpdateSettingRequest)(nil),                                     // 9: bytebase.v1.UpdateSettingRequest
-	(*Setting)(nil),                                                  // 10: bytebase.v1.Setting
-	(*Value)(nil),                                                    // 11: bytebase.v1.Value
-	(*AppIMSetting)(nil),                                             // 12: bytebase.v1.AppIMSetting
-	(*WorkspaceProfileSetting)(nil),                                  // 13: bytebase.v1.WorkspaceProfileSetting
-	(*Announcement)(nil),                                             // 14: bytebase.v1.Announcement
-	(*WorkspaceApprovalSetting)(nil),                                 // 15: bytebase.v1.WorkspaceApprovalSetting
-	(*SchemaTemplateSetting)(nil),                                    // 16: bytebase.v1.SchemaTemplateSetting
-	(*DataClassificationSetting)(nil),                                // 17: bytebase.v1.DataClassificationSetting
-	(*SemanticTypeSetting)(nil),                                      // 18: bytebase.v1.SemanticTypeSetting
-	(*Algorithm)(nil),                                                // 19: bytebase.v1.Algorithm
-	(*SCIMSetting)(nil),                                              // 20: bytebase.v1.SCIMSetting
-	(*PasswordRestrictionSetting)(nil),                               // 21: bytebase.v1.PasswordRestrictionSetting
-	(*AISetting)(nil),                                                // 22: bytebase.v1.AISetting
-	(*EnvironmentSetting)(nil),                                       // 23: bytebase.v1.EnvironmentSetting
-	(*AppIMSetting_Slack)(nil),                                       // 24: bytebase.v1.AppIMSetting.Slack
-	(*AppIMSetting_Feishu)(nil),                                      // 25: bytebase.v1.AppIMSetting.Feishu
-	(*AppIMSetting_Wecom)(nil),                                       // 26: bytebase.v1.AppIMSetting.Wecom
-	(*AppIMSetting_Lark)(nil),                                        // 27: bytebase.v1.AppIMSetting.Lark
-	(*AppIMSetting_DingTalk)(nil),                                    // 28: bytebase.v1.AppIMSetting.DingTalk
-	(*AppIMSetting_IMSetting)(nil),                                   // 29: bytebase.v1.AppIMSetting.IMSetting
-	(*WorkspaceApprovalSetting_Rule)(nil),                            // 30: bytebase.v1.WorkspaceApprovalSetting.Rule
-	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 31: bytebase.v1.SchemaTemplateSetting.FieldTemplate
-	(*SchemaTemplateSetting_ColumnType)(nil),                         // 32: bytebase.v1.SchemaTemplateSetting.ColumnType
-	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 33: bytebase.v1.SchemaTemplateSetting.TableTemplate
-	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 34: bytebase.v1.DataClassificationSetting.DataClassificationConfig
-	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 35: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 36: bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	nil,                                      // 37: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	(*SemanticTypeSetting_SemanticType)(nil), // 38: bytebase.v1.SemanticTypeSetting.SemanticType
-	(*Algorithm_FullMask)(nil),               // 39: bytebase.v1.Algorithm.FullMask
-	(*Algorithm_RangeMask)(nil),              // 40: bytebase.v1.Algorithm.RangeMask
-	(*Algorithm_MD5Mask)(nil),                // 41: bytebase.v1.Algorithm.MD5Mask
-	(*Algorithm_InnerOuterMask)(nil),         // 42: bytebase.v1.Algorithm.InnerOuterMask
-	(*Algorithm_RangeMask_Slice)(nil),        // 43: bytebase.v1.Algorithm.RangeMask.Slice
-	(*EnvironmentSetting_Environment)(nil),   // 44: bytebase.v1.EnvironmentSetting.Environment
-	nil,                                      // 45: bytebase.v1.EnvironmentSetting.Environment.TagsEntry
-	(*fieldmaskpb.FieldMask)(nil),            // 46: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),              // 47: google.protobuf.Duration
-	(Webhook_Type)(0),                        // 48: bytebase.v1.Webhook.Type
-	(*ApprovalTemplate)(nil),                 // 49: bytebase.v1.ApprovalTemplate
-	(*expr.Expr)(nil),                        // 50: google.type.Expr
-	(Engine)(0),                              // 51: bytebase.v1.Engine
-	(*ColumnMetadata)(nil),                   // 52: bytebase.v1.ColumnMetadata
-	(*ColumnCatalog)(nil),                    // 53: bytebase.v1.ColumnCatalog
-	(*TableMetadata)(nil),                    // 54: bytebase.v1.TableMetadata
-	(*TableCatalog)(nil),                     // 55: bytebase.v1.TableCatalog
+	(Algorithm_FormatPreservingEncryptionMask_Mode)(0),               // 4: bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode
+	(Algorithm_DateTruncateMask_Granularity)(0),                      // 5: bytebase.v1.Algorithm.DateTruncateMask.Granularity
+	(AISetting_Provider)(0),                                          // 6: bytebase.v1.AISetting.Provider
+	(EmailSetting_Encryption)(0),                                     // 7: bytebase.v1.EmailSetting.Encryption
+	(AuditLogSinkSetting_Sink_Type)(0),                               // 8: bytebase.v1.AuditLogSinkSetting.Sink.Type
+	(AuditLogSinkSetting_Sink_Format)(0),                             // 9: bytebase.v1.AuditLogSinkSetting.Sink.Format
+	(*ListSettingsRequest)(nil),                                      // 10: bytebase.v1.ListSettingsRequest
+	(*ListSettingsResponse)(nil),                                     // 11: bytebase.v1.ListSettingsResponse
+	(*GetSettingRequest)(nil),                                        // 12: bytebase.v1.GetSettingRequest
+	(*GetSettingResponse)(nil),                                       // 13: bytebase.v1.GetSettingResponse
+	(*UpdateSettingRequest)(nil),                                     // 14: bytebase.v1.UpdateSettingRequest
+	(*Setting)(nil),                                                  // 15: bytebase.v1.Setting
+	(*Value)(nil),                                                    // 16: bytebase.v1.Value
+	(*AppIMSetting)(nil),                                             // 17: bytebase.v1.AppIMSetting
+	(*WorkspaceProfileSetting)(nil),                                  // 18: bytebase.v1.WorkspaceProfileSetting
+	(*Announcement)(nil),                                             // 19: bytebase.v1.Announcement
+	(*WorkspaceApprovalSetting)(nil),                                 // 20: bytebase.v1.WorkspaceApprovalSetting
+	(*WorkspaceExternalApprovalSetting)(nil),                         // 21: bytebase.v1.WorkspaceExternalApprovalSetting
+	(*SchemaTemplateSetting)(nil),                                    // 22: bytebase.v1.SchemaTemplateSetting
+	(*DataClassificationSetting)(nil),                                // 23: bytebase.v1.DataClassificationSetting
+	(*SemanticTypeSetting)(nil),                                      // 24: bytebase.v1.SemanticTypeSetting
+	(*Algorithm)(nil),                                                // 25: bytebase.v1.Algorithm
+	(*SCIMSetting)(nil),                                              // 26: bytebase.v1.SCIMSetting
+	(*PasswordRestrictionSetting)(nil),                               // 27: bytebase.v1.PasswordRestrictionSetting
+	(*AISetting)(nil),                                                // 28: bytebase.v1.AISetting
+	(*EmailSetting)(nil),                                             // 29: bytebase.v1.EmailSetting
+	(*EnvironmentSetting)(nil),                                       // 30: bytebase.v1.EnvironmentSetting
+	(*AuditLogSinkSetting)(nil),                                      // 31: bytebase.v1.AuditLogSinkSetting
+	(*AppIMSetting_Slack)(nil),                                       // 32: bytebase.v1.AppIMSetting.Slack
+	(*AppIMSetting_Feishu)(nil),                                      // 33: bytebase.v1.AppIMSetting.Feishu
+	(*AppIMSetting_Wecom)(nil),                                       // 34: bytebase.v1.AppIMSetting.Wecom
+	(*AppIMSetting_Lark)(nil),                                        // 35: bytebase.v1.AppIMSetting.Lark
+	(*AppIMSetting_DingTalk)(nil),                                    // 36: bytebase.v1.AppIMSetting.DingTalk
+	(*AppIMSetting_IMSetting)(nil),                                   // 37: bytebase.v1.AppIMSetting.IMSetting
+	(*WorkspaceApprovalSetting_Rule)(nil),                            // 38: bytebase.v1.WorkspaceApprovalSetting.Rule
+	(*WorkspaceExternalApprovalSetting_Node)(nil),                    // 39: bytebase.v1.WorkspaceExternalApprovalSetting.Node
+	(*SchemaTemplateSetting_FieldTemplate)(nil),                      // 40: bytebase.v1.SchemaTemplateSetting.FieldTemplate
+	(*SchemaTemplateSetting_ColumnType)(nil),                         // 41: bytebase.v1.SchemaTemplateSetting.ColumnType
+	(*SchemaTemplateSetting_TableTemplate)(nil),                      // 42: bytebase.v1.SchemaTemplateSetting.TableTemplate
+	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 43: bytebase.v1.DataClassificationSetting.DataClassificationConfig
+	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 44: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 45: bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	nil,                                              // 46: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	(*SemanticTypeSetting_SemanticType)(nil),         // 47: bytebase.v1.SemanticTypeSetting.SemanticType
+	(*Algorithm_FullMask)(nil),                       // 48: bytebase.v1.Algorithm.FullMask
+	(*Algorithm_RangeMask)(nil),                      // 49: bytebase.v1.Algorithm.RangeMask
+	(*Algorithm_MD5Mask)(nil),                        // 50: bytebase.v1.Algorithm.MD5Mask
+	(*Algorithm_InnerOuterMask)(nil),                 // 51: bytebase.v1.Algorithm.InnerOuterMask
+	(*Algorithm_FormatPreservingEncryptionMask)(nil), // 52: bytebase.v1.Algorithm.FormatPreservingEncryptionMask
+	(*Algorithm_TokenizationMask)(nil),               // 53: bytebase.v1.Algorithm.TokenizationMask
+	(*Algorithm_DateTruncateMask)(nil),               // 54: bytebase.v1.Algorithm.DateTruncateMask
+	(*Algorithm_NumericBucketMask)(nil),              // 55: bytebase.v1.Algorithm.NumericBucketMask
+	(*Algorithm_NumericNoiseMask)(nil),               // 56: bytebase.v1.Algorithm.NumericNoiseMask
+	(*Algorithm_RangeMask_Slice)(nil),                // 57: bytebase.v1.Algorithm.RangeMask.Slice
+	(*EnvironmentSetting_Environment)(nil),           // 58: bytebase.v1.EnvironmentSetting.Environment
+	nil,                                              // 59: bytebase.v1.EnvironmentSetting.Environment.TagsEntry
+	(*AuditLogSinkSetting_Sink)(nil),                 // 60: bytebase.v1.AuditLogSinkSetting.Sink
+	(*fieldmaskpb.FieldMask)(nil),                    // 61: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                      // 62: google.protobuf.Duration
+	(Webhook_Type)(0),                                // 63: bytebase.v1.Webhook.Type
+	(*ApprovalTemplate)(nil),                         // 64: bytebase.v1.ApprovalTemplate
+	(*expr.Expr)(nil),                                // 65: google.type.Expr
+	(Engine)(0),                                      // 66: bytebase.v1.Engine
+	(*ColumnMetadata)(nil),                           // 67: bytebase.v1.ColumnMetadata
+	(*ColumnCatalog)(nil),                            // 68: bytebase.v1.ColumnCatalog
+	(*TableMetadata)(nil),                            // 69: bytebase.v1.TableMetadata
+	(*TableCatalog)(nil),                             // 70: bytebase.v1.TableCatalog
 }
 var file_v1_setting_service_proto_depIdxs = []int32{
-	10, // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
-	10, // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
-	10, // 2: bytebase.v1.UpdateSettingRequest.setting:type_name -> bytebase.v1.Setting
-	46, // 3: bytebase.v1.UpdateSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 4: bytebase.v1.Setting.value:type_name -> bytebase.v1.Value
-	12, // 5: bytebase.v1.Value.app_im_setting_value:type_name -> bytebase.v1.AppIMSetting
-	13, // 6: bytebase.v1.Value.workspace_profile_setting_value:type_name -> bytebase.v1.WorkspaceProfileSetting
-	15, // 7: bytebase.v1.Value.workspace_approval_setting_value:type_name -> bytebase.v1.WorkspaceApprovalSetting
-	16, // 8: bytebase.v1.Value.schema_template_setting_value:type_name -> bytebase.v1.SchemaTemplateSetting
-	17, // 9: bytebase.v1.Value.data_classification_setting_value:type_name -> bytebase.v1.DataClassificationSetting
-	18, // 10: bytebase.v1.Value.semantic_type_setting_value:type_name -> bytebase.v1.SemanticTypeSetting
-	20, // 11: bytebase.v1.Value.scim_setting:type_name -> bytebase.v1.SCIMSetting
-	21, // 12: bytebase.v1.Value.password_restriction_setting:type_name -> bytebase.v1.PasswordRestrictionSetting
-	22, // 13: bytebase.v1.Value.ai_setting:type_name -> bytebase.v1.AISetting
-	23, // 14: bytebase.v1.Value.environment_setting:type_name -> bytebase.v1.EnvironmentSetting
-	29, // 15: bytebase.v1.AppIMSetting.settings:type_name -> bytebase.v1.AppIMSetting.IMSetting
-	47, // 16: bytebase.v1.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
-	14, // 17: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
-	47, // 18: bytebase.v1.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
-	0,  // 19: bytebase.v1.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.v1.DatabaseChangeMode
-	47, // 20: bytebase.v1.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
-	2,  // 21: bytebase.v1.Announcement.level:type_name -> bytebase.v1.Announcement.AlertLevel
-	30, // 22: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
-	31, // 23: bytebase.v1.SchemaTemplateSetting.field_templates:type_name -> bytebase.v1.SchemaTemplateSetting.FieldTemplate
-	32, // 24: bytebase.v1.SchemaTemplateSetting.column_types:type_name -> bytebase.v1.SchemaTemplateSetting.ColumnType
-	33, // 25: bytebase.v1.SchemaTemplateSetting.table_templates:type_name -> bytebase.v1.SchemaTemplateSetting.TableTemplate
-	34, // 26: bytebase.v1.DataClassificationSetting.configs:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig
-	38, // 27: bytebase.v1.SemanticTypeSetting.types:type_name -> bytebase.v1.SemanticTypeSetting.SemanticType
-	39, // 28: bytebase.v1.Algorithm.full_mask:type_name -> bytebase.v1.Algorithm.FullMask
-	40, // 29: bytebase.v1.Algorithm.range_mask:type_name -> bytebase.v1.Algorithm.RangeMask
-	41, // 30: bytebase.v1.Algorithm.md5_mask:type_name -> bytebase.v1.Algorithm.MD5Mask
-	42, // 31: bytebase.v1.Algorithm.inner_outer_mask:type_name -> bytebase.v1.Algorithm.InnerOuterMask
-	47, // 32: bytebase.v1.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
-	4,  // 33: bytebase.v1.AISetting.provider:type_name -> bytebase.v1.AISetting.Provider
-	44, // 34: bytebase.v1.EnvironmentSetting.environments:type_name -> bytebase.v1.EnvironmentSetting.Environment
-	48, // 35: bytebase.v1.AppIMSetting.IMSetting.type:type_name -> bytebase.v1.Webhook.Type
-	24, // 36: bytebase.v1.AppIMSetting.IMSetting.slack:type_name -> bytebase.v1.AppIMSetting.Slack
-	25, // 37: bytebase.v1.AppIMSetting.IMSetting.feishu:type_name -> bytebase.v1.AppIMSetting.Feishu
-	26, // 38: bytebase.v1.AppIMSetting.IMSetting.wecom:type_name -> bytebase.v1.AppIMSetting.Wecom
-	27, // 39: bytebase.v1.AppIMSetting.IMSetting.lark:type_name -> bytebase.v1.AppIMSetting.Lark
-	28, // 40: bytebase.v1.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.v1.AppIMSetting.DingTalk
-	49, // 41: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
-	50, // 42: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	51, // 43: bytebase.v1.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.v1.Engine
-	52, // 44: bytebase.v1.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.v1.ColumnMetadata
-	53, // 45: bytebase.v1.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.v1.ColumnCatalog
-	51, // 46: bytebase.v1.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.v1.Engine
-	51, // 47: bytebase.v1.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.v1.Engine
-	54, // 48: bytebase.v1.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.v1.TableMetadata
-	55, // 49: bytebase.v1.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.v1.TableCatalog
-	35, // 50: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	37, // 51: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	36, // 52: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	19, // 53: bytebase.v1.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.v1.Algorithm
-	43, // 54: bytebase.v1.Algorithm.RangeMask.slices:type_name -> bytebase.v1.Algorithm.RangeMask.Slice
-	3,  // 55: bytebase.v1.Algorithm.InnerOuterMask.type:type_name -> bytebase.v1.Algorithm.InnerOuterMask.MaskType
-	45, // 56: bytebase.v1.EnvironmentSetting.Environment.tags:type_name -> bytebase.v1.EnvironmentSetting.Environment.TagsEntry
-	5,  // 57: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
-	7,  // 58: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
-	9,  // 59: bytebase.v1.SettingService.UpdateSetting:input_type -> bytebase.v1.UpdateSettingRequest
-	6,  // 60: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
-	10, // 61: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
-	10, // 62: bytebase.v1.SettingService.UpdateSetting:output_type -> bytebase.v1.Setting
-	60, // [60:63] is the sub-list for method output_type
-	57, // [57:60] is the sub-list for method input_type
-	57, // [57:57] is the sub-list for extension type_name
-	57, // [57:57] is the sub-list for extension extendee
-	0,  // [0:57] is the sub-list for field type_name
+	15, // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
+	15, // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
+	15, // 2: bytebase.v1.UpdateSettingRequest.setting:type_name -> bytebase.v1.Setting
+	61, // 3: bytebase.v1.UpdateSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16, // 4: bytebase.v1.Setting.value:type_name -> bytebase.v1.Value
+	17, // 5: bytebase.v1.Value.app_im_setting_value:type_name -> bytebase.v1.AppIMSetting
+	18, // 6: bytebase.v1.Value.workspace_profile_setting_value:type_name -> bytebase.v1.WorkspaceProfileSetting
+	20, // 7: bytebase.v1.Value.workspace_approval_setting_value:type_name -> bytebase.v1.WorkspaceApprovalSetting
+	22, // 8: bytebase.v1.Value.schema_template_setting_value:type_name -> bytebase.v1.SchemaTemplateSetting
+	23, // 9: bytebase.v1.Value.data_classification_setting_value:type_name -> bytebase.v1.DataClassificationSetting
+	24, // 10: bytebase.v1.Value.semantic_type_setting_value:type_name -> bytebase.v1.SemanticTypeSetting
+	26, // 11: bytebase.v1.Value.scim_setting:type_name -> bytebase.v1.SCIMSetting
+	27, // 12: bytebase.v1.Value.password_restriction_setting:type_name -> bytebase.v1.PasswordRestrictionSetting
+	28, // 13: bytebase.v1.Value.ai_setting:type_name -> bytebase.v1.AISetting
+	30, // 14: bytebase.v1.Value.environment_setting:type_name -> bytebase.v1.EnvironmentSetting
+	29, // 15: bytebase.v1.Value.email_setting:type_name -> bytebase.v1.EmailSetting
+	21, // 16: bytebase.v1.Value.workspace_external_approval_setting_value:type_name -> bytebase.v1.WorkspaceExternalApprovalSetting
+	31, // 17: bytebase.v1.Value.audit_log_sink_setting:type_name -> bytebase.v1.AuditLogSinkSetting
+	37, // 18: bytebase.v1.AppIMSetting.settings:type_name -> bytebase.v1.AppIMSetting.IMSetting
+	62, // 19: bytebase.v1.WorkspaceProfileSetting.token_duration:type_name -> google.protobuf.Duration
+	19, // 20: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
+	62, // 21: bytebase.v1.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
+	0,  // 22: bytebase.v1.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.v1.DatabaseChangeMode
+	62, // 23: bytebase.v1.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
+	2,  // 24: bytebase.v1.Announcement.level:type_name -> bytebase.v1.Announcement.AlertLevel
+	38, // 25: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
+	39, // 26: bytebase.v1.WorkspaceExternalApprovalSetting.nodes:type_name -> bytebase.v1.WorkspaceExternalApprovalSetting.Node
+	40, // 27: bytebase.v1.SchemaTemplateSetting.field_templates:type_name -> bytebase.v1.SchemaTemplateSetting.FieldTemplate
+	41, // 28: bytebase.v1.SchemaTemplateSetting.column_types:type_name -> bytebase.v1.SchemaTemplateSetting.ColumnType
+	42, // 29: bytebase.v1.SchemaTemplateSetting.table_templates:type_name -> bytebase.v1.SchemaTemplateSetting.TableTemplate
+	43, // 30: bytebase.v1.DataClassificationSetting.configs:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig
+	47, // 31: bytebase.v1.SemanticTypeSetting.types:type_name -> bytebase.v1.SemanticTypeSetting.SemanticType
+	48, // 32: bytebase.v1.Algorithm.full_mask:type_name -> bytebase.v1.Algorithm.FullMask
+	49, // 33: bytebase.v1.Algorithm.range_mask:type_name -> bytebase.v1.Algorithm.RangeMask
+	50, // 34: bytebase.v1.Algorithm.md5_mask:type_name -> bytebase.v1.Algorithm.MD5Mask
+	51, // 35: bytebase.v1.Algorithm.inner_outer_mask:type_name -> bytebase.v1.Algorithm.InnerOuterMask
+	52, // 36: bytebase.v1.Algorithm.format_preserving_encryption_mask:type_name -> bytebase.v1.Algorithm.FormatPreservingEncryptionMask
+	53, // 37: bytebase.v1.Algorithm.tokenization_mask:type_name -> bytebase.v1.Algorithm.TokenizationMask
+	54, // 38: bytebase.v1.Algorithm.date_truncate_mask:type_name -> bytebase.v1.Algorithm.DateTruncateMask
+	55, // 39: bytebase.v1.Algorithm.numeric_bucket_mask:type_name -> bytebase.v1.Algorithm.NumericBucketMask
+	56, // 40: bytebase.v1.Algorithm.numeric_noise_mask:type_name -> bytebase.v1.Algorithm.NumericNoiseMask
+	62, // 41: bytebase.v1.PasswordRestrictionSetting.password_rotation:type_name -> google.protobuf.Duration
+	6,  // 42: bytebase.v1.AISetting.provider:type_name -> bytebase.v1.AISetting.Provider
+	7,  // 43: bytebase.v1.EmailSetting.encryption:type_name -> bytebase.v1.EmailSetting.Encryption
+	58, // 44: bytebase.v1.EnvironmentSetting.environments:type_name -> bytebase.v1.EnvironmentSetting.Environment
+	60, // 45: bytebase.v1.AuditLogSinkSetting.sinks:type_name -> bytebase.v1.AuditLogSinkSetting.Sink
+	63, // 46: bytebase.v1.AppIMSetting.IMSetting.type:type_name -> bytebase.v1.Webhook.Type
+	32, // 47: bytebase.v1.AppIMSetting.IMSetting.slack:type_name -> bytebase.v1.AppIMSetting.Slack
+	33, // 48: bytebase.v1.AppIMSetting.IMSetting.feishu:type_name -> bytebase.v1.AppIMSetting.Feishu
+	34, // 49: bytebase.v1.AppIMSetting.IMSetting.wecom:type_name -> bytebase.v1.AppIMSetting.Wecom
+	35, // 50: bytebase.v1.AppIMSetting.IMSetting.lark:type_name -> bytebase.v1.AppIMSetting.Lark
+	36, // 51: bytebase.v1.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.v1.AppIMSetting.DingTalk
+	64, // 52: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
+	65, // 53: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	62, // 54: bytebase.v1.WorkspaceExternalApprovalSetting.Node.timeout:type_name -> google.protobuf.Duration
+	66, // 55: bytebase.v1.SchemaTemplateSetting.FieldTemplate.engine:type_name -> bytebase.v1.Engine
+	67, // 56: bytebase.v1.SchemaTemplateSetting.FieldTemplate.column:type_name -> bytebase.v1.ColumnMetadata
+	68, // 57: bytebase.v1.SchemaTemplateSetting.FieldTemplate.catalog:type_name -> bytebase.v1.ColumnCatalog
+	66, // 58: bytebase.v1.SchemaTemplateSetting.ColumnType.engine:type_name -> bytebase.v1.Engine
+	66, // 59: bytebase.v1.SchemaTemplateSetting.TableTemplate.engine:type_name -> bytebase.v1.Engine
+	69, // 60: bytebase.v1.SchemaTemplateSetting.TableTemplate.table:type_name -> bytebase.v1.TableMetadata
+	70, // 61: bytebase.v1.SchemaTemplateSetting.TableTemplate.catalog:type_name -> bytebase.v1.TableCatalog
+	44, // 62: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	46, // 63: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	45, // 64: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	25, // 65: bytebase.v1.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.v1.Algorithm
+	57, // 66: bytebase.v1.Algorithm.RangeMask.slices:type_name -> bytebase.v1.Algorithm.RangeMask.Slice
+	3,  // 67: bytebase.v1.Algorithm.InnerOuterMask.type:type_name -> bytebase.v1.Algorithm.InnerOuterMask.MaskType
+	4,  // 68: bytebase.v1.Algorithm.FormatPreservingEncryptionMask.mode:type_name -> bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode
+	5,  // 69: bytebase.v1.Algorithm.DateTruncateMask.granularity:type_name -> bytebase.v1.Algorithm.DateTruncateMask.Granularity
+	59, // 70: bytebase.v1.EnvironmentSetting.Environment.tags:type_name -> bytebase.v1.EnvironmentSetting.Environment.TagsEntry
+	8,  // 71: bytebase.v1.AuditLogSinkSetting.Sink.type:type_name -> bytebase.v1.AuditLogSinkSetting.Sink.Type
+	9,  // 72: bytebase.v1.AuditLogSinkSetting.Sink.format:type_name -> bytebase.v1.AuditLogSinkSetting.Sink.Format
+	10, // 73: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
+	12, // 74: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
+	14, // 75: bytebase.v1.SettingService.UpdateSetting:input_type -> bytebase.v1.UpdateSettingRequest
+	11, // 76: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
+	15, // 77: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
+	15, // 78: bytebase.v1.SettingService.UpdateSetting:output_type -> bytebase.v1.Setting
+	76, // [76:79] is the sub-list for method output_type
+	73, // [73:76] is the sub-list for method input_type
+	73, // [73:73] is the sub-list for extension type_name
+	73, // [73:73] is the sub-list for extension extendee
+	0,  // [0:73] is the sub-list for field type_name
 }
 
 func init() { file_v1_setting_service_proto_init() }
@@ -3430,28 +4574,36 @@ func file_v1_setting_service_proto_init() {
 		(*Value_PasswordRestrictionSetting)(nil),
 		(*Value_AiSetting)(nil),
 		(*Value_EnvironmentSetting)(nil),
+		(*Value_EmailSetting)(nil),
+		(*Value_WorkspaceExternalApprovalSettingValue)(nil),
+		(*Value_AuditLogSinkSetting)(nil),
 	}
-	file_v1_setting_service_proto_msgTypes[14].OneofWrappers = []any{
+	file_v1_setting_service_proto_msgTypes[15].OneofWrappers = []any{
 		(*Algorithm_FullMask_)(nil),
 		(*Algorithm_RangeMask_)(nil),
 		(*Algorithm_Md5Mask)(nil),
 		(*Algorithm_InnerOuterMask_)(nil),
+		(*Algorithm_FormatPreservingEncryptionMask_)(nil),
+		(*Algorithm_TokenizationMask_)(nil),
+		(*Algorithm_DateTruncateMask_)(nil),
+		(*Algorithm_NumericBucketMask_)(nil),
+		(*Algorithm_NumericNoiseMask_)(nil),
 	}
-	file_v1_setting_service_proto_msgTypes[24].OneofWrappers = []any{
+	file_v1_setting_service_proto_msgTypes[27].OneofWrappers = []any{
 		(*AppIMSetting_IMSetting_Slack)(nil),
 		(*AppIMSetting_IMSetting_Feishu)(nil),
 		(*AppIMSetting_IMSetting_Wecom)(nil),
 		(*AppIMSetting_IMSetting_Lark)(nil),
 		(*AppIMSetting_IMSetting_Dingtalk)(nil),
 	}
-	file_v1_setting_service_proto_msgTypes[31].OneofWrappers = []any{}
+	file_v1_setting_service_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_setting_service_proto_rawDesc), len(file_v1_setting_service_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   41,
+			NumEnums:      10,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
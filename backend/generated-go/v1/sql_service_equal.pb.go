@@ -559,6 +559,38 @@ func (x *DiffMetadataResponse) Equal(y *DiffMetadataResponse) bool {
 	return true
 }
 
+func (x *FormatRequest) Equal(y *FormatRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Engine != y.Engine {
+		return false
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	if x.Indent != y.Indent {
+		return false
+	}
+	return true
+}
+
+func (x *FormatResponse) Equal(y *FormatResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	return true
+}
+
 func (x *SearchQueryHistoriesRequest) Equal(y *SearchQueryHistoriesRequest) bool {
 	if x == y {
 		return true
@@ -599,6 +631,22 @@ func (x *SearchQueryHistoriesResponse) Equal(y *SearchQueryHistoriesResponse) bo
 	return true
 }
 
+func (x *QueryHistory_RowFilter) Equal(y *QueryHistory_RowFilter) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Predicate != y.Predicate {
+		return false
+	}
+	return true
+}
+
 func (x *QueryHistory) Equal(y *QueryHistory) bool {
 	if x == y {
 		return true
@@ -630,6 +678,14 @@ func (x *QueryHistory) Equal(y *QueryHistory) bool {
 	if x.Type != y.Type {
 		return false
 	}
+	if len(x.RowFilters) != len(y.RowFilters) {
+		return false
+	}
+	for i := 0; i < len(x.RowFilters); i++ {
+		if !x.RowFilters[i].Equal(y.RowFilters[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -23,7 +23,9 @@ const (
 	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
 	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
 	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
+	SQLService_ExportStream_FullMethodName         = "/bytebase.v1.SQLService/ExportStream"
 	SQLService_DiffMetadata_FullMethodName         = "/bytebase.v1.SQLService/DiffMetadata"
+	SQLService_Format_FullMethodName               = "/bytebase.v1.SQLService/Format"
 	SQLService_AICompletion_FullMethodName         = "/bytebase.v1.SQLService/AICompletion"
 )
 
@@ -45,9 +47,16 @@ type SQLServiceClient interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// Exports query results to a file format, and streams the file content in chunks.
+	// The export of a database is not limited by the maximum result size.
+	// Permissions required: bb.databases.get
+	ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error)
+	// Formats SQL statements. Only the whitespaces are changed, the comments are preserved.
+	// Permissions required: None (authenticated users only)
+	Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (*AICompletionResponse, error)
@@ -104,6 +113,25 @@ func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts .
 	return out, nil
 }
 
+func (c *sQLServiceClient) ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_ExportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportStreamClient = grpc.ServerStreamingClient[ExportResponse]
+
 func (c *sQLServiceClient) DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiffMetadataResponse)
@@ -114,6 +142,16 @@ func (c *sQLServiceClient) DiffMetadata(ctx context.Context, in *DiffMetadataReq
 	return out, nil
 }
 
+func (c *sQLServiceClient) Format(ctx context.Context, in *FormatRequest, opts ...grpc.CallOption) (*FormatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FormatResponse)
+	err := c.cc.Invoke(ctx, SQLService_Format_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) AICompletion(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (*AICompletionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AICompletionResponse)
@@ -142,9 +180,16 @@ type SQLServiceServer interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// Exports query results to a file format, and streams the file content in chunks.
+	// The export of a database is not limited by the maximum result size.
+	// Permissions required: bb.databases.get
+	ExportStream(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error)
+	// Formats SQL statements. Only the whitespaces are changed, the comments are preserved.
+	// Permissions required: None (authenticated users only)
+	Format(context.Context, *FormatRequest) (*FormatResponse, error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *AICompletionRequest) (*AICompletionResponse, error)
@@ -170,9 +215,15 @@ func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *Sear
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSQLServiceServer) ExportStream(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStream not implemented")
+}
 func (UnimplementedSQLServiceServer) DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffMetadata not implemented")
 }
+func (UnimplementedSQLServiceServer) Format(context.Context, *FormatRequest) (*FormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Format not implemented")
+}
 func (UnimplementedSQLServiceServer) AICompletion(context.Context, *AICompletionRequest) (*AICompletionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AICompletion not implemented")
 }
@@ -258,6 +309,17 @@ func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ExportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).ExportStream(m, &grpc.GenericServerStream[ExportRequest, ExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportStreamServer = grpc.ServerStreamingServer[ExportResponse]
+
 func _SQLService_DiffMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffMetadataRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +338,24 @@ func _SQLService_DiffMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_Format_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).Format(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_Format_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).Format(ctx, req.(*FormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_AICompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AICompletionRequest)
 	if err := dec(in); err != nil {
@@ -317,6 +397,10 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiffMetadata",
 			Handler:    _SQLService_DiffMetadata_Handler,
 		},
+		{
+			MethodName: "Format",
+			Handler:    _SQLService_Format_Handler,
+		},
 		{
 			MethodName: "AICompletion",
 			Handler:    _SQLService_AICompletion_Handler,
@@ -329,6 +413,11 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportStream",
+			Handler:       _SQLService_ExportStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/sql_service.proto",
 }
@@ -79,6 +79,12 @@ const (
 	// ProjectServiceTestWebhookProcedure is the fully-qualified name of the ProjectService's
 	// TestWebhook RPC.
 	ProjectServiceTestWebhookProcedure = "/bytebase.v1.ProjectService/TestWebhook"
+	// ProjectServiceListWebhookDeliveriesProcedure is the fully-qualified name of the ProjectService's
+	// ListWebhookDeliveries RPC.
+	ProjectServiceListWebhookDeliveriesProcedure = "/bytebase.v1.ProjectService/ListWebhookDeliveries"
+	// ProjectServiceRetryWebhookDeliveryProcedure is the fully-qualified name of the ProjectService's
+	// RetryWebhookDelivery RPC.
+	ProjectServiceRetryWebhookDeliveryProcedure = "/bytebase.v1.ProjectService/RetryWebhookDelivery"
 )
 
 // ProjectServiceClient is a client for the bytebase.v1.ProjectService service.
@@ -129,6 +135,12 @@ type ProjectServiceClient interface {
 	// Tests a webhook by sending a test notification.
 	// Permissions required: bb.projects.update
 	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
+	// Lists the delivery attempts of a webhook.
+	// Permissions required: bb.projects.get
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// Retries a webhook delivery immediately, regardless of its status.
+	// Permissions required: bb.projects.update
+	RetryWebhookDelivery(context.Context, *connect.Request[v1.RetryWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error)
 }
 
 // NewProjectServiceClient constructs a client for the bytebase.v1.ProjectService service. By
@@ -232,26 +244,40 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceMethods.ByName("TestWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+ProjectServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		retryWebhookDelivery: connect.NewClient[v1.RetryWebhookDeliveryRequest, v1.WebhookDelivery](
+			httpClient,
+			baseURL+ProjectServiceRetryWebhookDeliveryProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RetryWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // projectServiceClient implements ProjectServiceClient.
 type projectServiceClient struct {
-	getProject          *connect.Client[v1.GetProjectRequest, v1.Project]
-	listProjects        *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
-	searchProjects      *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
-	createProject       *connect.Client[v1.CreateProjectRequest, v1.Project]
-	updateProject       *connect.Client[v1.UpdateProjectRequest, v1.Project]
-	deleteProject       *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
-	undeleteProject     *connect.Client[v1.UndeleteProjectRequest, v1.Project]
-	batchDeleteProjects *connect.Client[v1.BatchDeleteProjectsRequest, emptypb.Empty]
-	getIamPolicy        *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
-	batchGetIamPolicy   *connect.Client[v1.BatchGetIamPolicyRequest, v1.BatchGetIamPolicyResponse]
-	setIamPolicy        *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
-	addWebhook          *connect.Client[v1.AddWebhookRequest, v1.Project]
-	updateWebhook       *connect.Client[v1.UpdateWebhookRequest, v1.Project]
-	removeWebhook       *connect.Client[v1.RemoveWebhookRequest, v1.Project]
-	testWebhook         *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
+	getProject            *connect.Client[v1.GetProjectRequest, v1.Project]
+	listProjects          *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	searchProjects        *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
+	createProject         *connect.Client[v1.CreateProjectRequest, v1.Project]
+	updateProject         *connect.Client[v1.UpdateProjectRequest, v1.Project]
+	deleteProject         *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
+	undeleteProject       *connect.Client[v1.UndeleteProjectRequest, v1.Project]
+	batchDeleteProjects   *connect.Client[v1.BatchDeleteProjectsRequest, emptypb.Empty]
+	getIamPolicy          *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
+	batchGetIamPolicy     *connect.Client[v1.BatchGetIamPolicyRequest, v1.BatchGetIamPolicyResponse]
+	setIamPolicy          *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
+	addWebhook            *connect.Client[v1.AddWebhookRequest, v1.Project]
+	updateWebhook         *connect.Client[v1.UpdateWebhookRequest, v1.Project]
+	removeWebhook         *connect.Client[v1.RemoveWebhookRequest, v1.Project]
+	testWebhook           *connect.Client[v1.TestWebhookRequest, v1.TestWebhookResponse]
+	listWebhookDeliveries *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	retryWebhookDelivery  *connect.Client[v1.RetryWebhookDeliveryRequest, v1.WebhookDelivery]
 }
 
 // GetProject calls bytebase.v1.ProjectService.GetProject.
@@ -329,6 +355,16 @@ func (c *projectServiceClient) TestWebhook(ctx context.Context, req *connect.Req
 	return c.testWebhook.CallUnary(ctx, req)
 }
 
+// ListWebhookDeliveries calls bytebase.v1.ProjectService.ListWebhookDeliveries.
+func (c *projectServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RetryWebhookDelivery calls bytebase.v1.ProjectService.RetryWebhookDelivery.
+func (c *projectServiceClient) RetryWebhookDelivery(ctx context.Context, req *connect.Request[v1.RetryWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return c.retryWebhookDelivery.CallUnary(ctx, req)
+}
+
 // ProjectServiceHandler is an implementation of the bytebase.v1.ProjectService service.
 type ProjectServiceHandler interface {
 	// GetProject retrieves a project by name.
@@ -377,6 +413,12 @@ type ProjectServiceHandler interface {
 	// Tests a webhook by sending a test notification.
 	// Permissions required: bb.projects.update
 	TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error)
+	// Lists the delivery attempts of a webhook.
+	// Permissions required: bb.projects.get
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	// Retries a webhook delivery immediately, regardless of its status.
+	// Permissions required: bb.projects.update
+	RetryWebhookDelivery(context.Context, *connect.Request[v1.RetryWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error)
 }
 
 // NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -476,6 +518,18 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceMethods.ByName("TestWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		ProjectServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(projectServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRetryWebhookDeliveryHandler := connect.NewUnaryHandler(
+		ProjectServiceRetryWebhookDeliveryProcedure,
+		svc.RetryWebhookDelivery,
+		connect.WithSchema(projectServiceMethods.ByName("RetryWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProjectServiceGetProjectProcedure:
@@ -508,6 +562,10 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceRemoveWebhookHandler.ServeHTTP(w, r)
 		case ProjectServiceTestWebhookProcedure:
 			projectServiceTestWebhookHandler.ServeHTTP(w, r)
+		case ProjectServiceListWebhookDeliveriesProcedure:
+			projectServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case ProjectServiceRetryWebhookDeliveryProcedure:
+			projectServiceRetryWebhookDeliveryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -576,3 +634,11 @@ func (UnimplementedProjectServiceHandler) RemoveWebhook(context.Context, *connec
 func (UnimplementedProjectServiceHandler) TestWebhook(context.Context, *connect.Request[v1.TestWebhookRequest]) (*connect.Response[v1.TestWebhookResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.TestWebhook is not implemented"))
 }
+
+func (UnimplementedProjectServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RetryWebhookDelivery(context.Context, *connect.Request[v1.RetryWebhookDeliveryRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ProjectService.RetryWebhookDelivery is not implemented"))
+}
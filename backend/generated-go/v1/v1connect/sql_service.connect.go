@@ -42,8 +42,12 @@ const (
 	SQLServiceSearchQueryHistoriesProcedure = "/bytebase.v1.SQLService/SearchQueryHistories"
 	// SQLServiceExportProcedure is the fully-qualified name of the SQLService's Export RPC.
 	SQLServiceExportProcedure = "/bytebase.v1.SQLService/Export"
+	// SQLServiceExportStreamProcedure is the fully-qualified name of the SQLService's ExportStream RPC.
+	SQLServiceExportStreamProcedure = "/bytebase.v1.SQLService/ExportStream"
 	// SQLServiceDiffMetadataProcedure is the fully-qualified name of the SQLService's DiffMetadata RPC.
 	SQLServiceDiffMetadataProcedure = "/bytebase.v1.SQLService/DiffMetadata"
+	// SQLServiceFormatProcedure is the fully-qualified name of the SQLService's Format RPC.
+	SQLServiceFormatProcedure = "/bytebase.v1.SQLService/Format"
 	// SQLServiceAICompletionProcedure is the fully-qualified name of the SQLService's AICompletion RPC.
 	SQLServiceAICompletionProcedure = "/bytebase.v1.SQLService/AICompletion"
 )
@@ -62,9 +66,16 @@ type SQLServiceClient interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	// Exports query results to a file format, and streams the file content in chunks.
+	// The export of a database is not limited by the maximum result size.
+	// Permissions required: bb.databases.get
+	ExportStream(context.Context, *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportResponse], error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error)
+	// Formats SQL statements. Only the whitespaces are changed, the comments are preserved.
+	// Permissions required: None (authenticated users only)
+	Format(context.Context, *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error)
@@ -105,12 +116,24 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sQLServiceMethods.ByName("Export")),
 			connect.WithClientOptions(opts...),
 		),
+		exportStream: connect.NewClient[v1.ExportRequest, v1.ExportResponse](
+			httpClient,
+			baseURL+SQLServiceExportStreamProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("ExportStream")),
+			connect.WithClientOptions(opts...),
+		),
 		diffMetadata: connect.NewClient[v1.DiffMetadataRequest, v1.DiffMetadataResponse](
 			httpClient,
 			baseURL+SQLServiceDiffMetadataProcedure,
 			connect.WithSchema(sQLServiceMethods.ByName("DiffMetadata")),
 			connect.WithClientOptions(opts...),
 		),
+		format: connect.NewClient[v1.FormatRequest, v1.FormatResponse](
+			httpClient,
+			baseURL+SQLServiceFormatProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("Format")),
+			connect.WithClientOptions(opts...),
+		),
 		aICompletion: connect.NewClient[v1.AICompletionRequest, v1.AICompletionResponse](
 			httpClient,
 			baseURL+SQLServiceAICompletionProcedure,
@@ -126,7 +149,9 @@ type sQLServiceClient struct {
 	adminExecute         *connect.Client[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
 	searchQueryHistories *connect.Client[v1.SearchQueryHistoriesRequest, v1.SearchQueryHistoriesResponse]
 	export               *connect.Client[v1.ExportRequest, v1.ExportResponse]
+	exportStream         *connect.Client[v1.ExportRequest, v1.ExportResponse]
 	diffMetadata         *connect.Client[v1.DiffMetadataRequest, v1.DiffMetadataResponse]
+	format               *connect.Client[v1.FormatRequest, v1.FormatResponse]
 	aICompletion         *connect.Client[v1.AICompletionRequest, v1.AICompletionResponse]
 }
 
@@ -150,11 +175,21 @@ func (c *sQLServiceClient) Export(ctx context.Context, req *connect.Request[v1.E
 	return c.export.CallUnary(ctx, req)
 }
 
+// ExportStream calls bytebase.v1.SQLService.ExportStream.
+func (c *sQLServiceClient) ExportStream(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportResponse], error) {
+	return c.exportStream.CallServerStream(ctx, req)
+}
+
 // DiffMetadata calls bytebase.v1.SQLService.DiffMetadata.
 func (c *sQLServiceClient) DiffMetadata(ctx context.Context, req *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error) {
 	return c.diffMetadata.CallUnary(ctx, req)
 }
 
+// Format calls bytebase.v1.SQLService.Format.
+func (c *sQLServiceClient) Format(ctx context.Context, req *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error) {
+	return c.format.CallUnary(ctx, req)
+}
+
 // AICompletion calls bytebase.v1.SQLService.AICompletion.
 func (c *sQLServiceClient) AICompletion(ctx context.Context, req *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error) {
 	return c.aICompletion.CallUnary(ctx, req)
@@ -174,9 +209,16 @@ type SQLServiceHandler interface {
 	// Exports query results to a file format.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	// Exports query results to a file format, and streams the file content in chunks.
+	// The export of a database is not limited by the maximum result size.
+	// Permissions required: bb.databases.get
+	ExportStream(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportResponse]) error
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error)
+	// Formats SQL statements. Only the whitespaces are changed, the comments are preserved.
+	// Permissions required: None (authenticated users only)
+	Format(context.Context, *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error)
@@ -213,12 +255,24 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("Export")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceExportStreamHandler := connect.NewServerStreamHandler(
+		SQLServiceExportStreamProcedure,
+		svc.ExportStream,
+		connect.WithSchema(sQLServiceMethods.ByName("ExportStream")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceDiffMetadataHandler := connect.NewUnaryHandler(
 		SQLServiceDiffMetadataProcedure,
 		svc.DiffMetadata,
 		connect.WithSchema(sQLServiceMethods.ByName("DiffMetadata")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceFormatHandler := connect.NewUnaryHandler(
+		SQLServiceFormatProcedure,
+		svc.Format,
+		connect.WithSchema(sQLServiceMethods.ByName("Format")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceAICompletionHandler := connect.NewUnaryHandler(
 		SQLServiceAICompletionProcedure,
 		svc.AICompletion,
@@ -235,8 +289,12 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 			sQLServiceSearchQueryHistoriesHandler.ServeHTTP(w, r)
 		case SQLServiceExportProcedure:
 			sQLServiceExportHandler.ServeHTTP(w, r)
+		case SQLServiceExportStreamProcedure:
+			sQLServiceExportStreamHandler.ServeHTTP(w, r)
 		case SQLServiceDiffMetadataProcedure:
 			sQLServiceDiffMetadataHandler.ServeHTTP(w, r)
+		case SQLServiceFormatProcedure:
+			sQLServiceFormatHandler.ServeHTTP(w, r)
 		case SQLServiceAICompletionProcedure:
 			sQLServiceAICompletionHandler.ServeHTTP(w, r)
 		default:
@@ -264,10 +322,18 @@ func (UnimplementedSQLServiceHandler) Export(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Export is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) ExportStream(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.ExportStream is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.DiffMetadata is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) Format(context.Context, *connect.Request[v1.FormatRequest]) (*connect.Response[v1.FormatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Format is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.AICompletion is not implemented"))
 }
@@ -0,0 +1,17 @@
+-- webhook_delivery records the attempts of posting events to project webhooks.
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    project text NOT NULL REFERENCES project(resource_id),
+    webhook_id integer NOT NULL REFERENCES project_webhook(id) ON DELETE CASCADE,
+    event_type text NOT NULL,
+    status text NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempts integer NOT NULL DEFAULT 0,
+    -- Stored as WebhookDeliveryPayload (proto/store/store/project_webhook.proto)
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
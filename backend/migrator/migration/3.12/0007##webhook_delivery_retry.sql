@@ -0,0 +1,4 @@
+-- next_attempt_at is the time of the next automatic retry of a PENDING delivery.
+ALTER TABLE webhook_delivery ADD COLUMN next_attempt_at timestamptz;
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_at ON webhook_delivery(next_attempt_at) WHERE status = 'PENDING';
@@ -0,0 +1,6 @@
+-- audit_log_sink_cursor records the last audit log delivered to each audit log sink.
+CREATE TABLE audit_log_sink_cursor (
+    sink text PRIMARY KEY,
+    audit_log_id bigint NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
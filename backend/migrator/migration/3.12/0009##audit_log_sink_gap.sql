@@ -0,0 +1,8 @@
+-- audit_log_sink_gap records the audit log IDs skipped by the cursor of each audit log sink, which may be
+-- committed later by the transactions in progress. The gaps are re-scanned until filled or expired.
+CREATE TABLE audit_log_sink_gap (
+    sink text NOT NULL,
+    audit_log_id bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (sink, audit_log_id)
+);
@@ -0,0 +1,4 @@
+-- lease_expire_at is the time until which the delivery is being attempted, so it's not attempted again concurrently.
+ALTER TABLE webhook_delivery ADD COLUMN lease_expire_at timestamptz;
+
+CREATE INDEX idx_webhook_delivery_created_at ON webhook_delivery(created_at);
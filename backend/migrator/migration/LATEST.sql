@@ -116,6 +116,32 @@ CREATE INDEX idx_project_webhook_project ON project_webhook(project);
 
 ALTER SEQUENCE project_webhook_id_seq RESTART WITH 101;
 
+-- webhook_delivery records the attempts of posting events to project webhooks.
+CREATE TABLE webhook_delivery (
+    id bigserial PRIMARY KEY,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    project text NOT NULL REFERENCES project(resource_id),
+    webhook_id integer NOT NULL REFERENCES project_webhook(id) ON DELETE CASCADE,
+    event_type text NOT NULL,
+    status text NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    attempts integer NOT NULL DEFAULT 0,
+    -- Stored as WebhookDeliveryPayload (proto/store/store/project_webhook.proto)
+    payload jsonb NOT NULL DEFAULT '{}',
+    -- next_attempt_at is the time of the next automatic retry of a PENDING delivery.
+    next_attempt_at timestamptz,
+    -- lease_expire_at is the time until which the delivery is being attempted, so it's not attempted again concurrently.
+    lease_expire_at timestamptz
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+CREATE INDEX idx_webhook_delivery_created_at ON webhook_delivery(created_at);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_at ON webhook_delivery(next_attempt_at) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
 -- Instance
 CREATE TABLE instance (
     id serial PRIMARY KEY,
@@ -359,6 +385,22 @@ CREATE INDEX idx_audit_log_payload_user ON audit_log((payload->>'user'));
 
 ALTER SEQUENCE audit_log_id_seq RESTART WITH 101;
 
+-- audit_log_sink_cursor records the last audit log delivered to each audit log sink.
+CREATE TABLE audit_log_sink_cursor (
+    sink text PRIMARY KEY,
+    audit_log_id bigint NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- audit_log_sink_gap records the audit log IDs skipped by the cursor of each audit log sink, which may be
+-- committed later by the transactions in progress. The gaps are re-scanned until filled or expired.
+CREATE TABLE audit_log_sink_gap (
+    sink text NOT NULL,
+    audit_log_id bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (sink, audit_log_id)
+);
+
 CREATE TABLE issue_comment (
     id bigserial PRIMARY KEY,
     creator_id integer NOT NULL REFERENCES principal(id),
@@ -0,0 +1,576 @@
+package mssql
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/tsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	tsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
+)
+
+// sdlDefaultSchema is the schema of the unqualified objects in the SDL files.
+const sdlDefaultSchema = "dbo"
+
+// CheckSDLIntegrity performs integrity checks across SQL Server SDL files.
+// It's the SQL Server equivalent of the PostgreSQL SDL integrity check, so the files are checked together
+// to validate cross-file references (foreign keys, views) and detect duplicate definitions across files.
+//
+// The names are compared case-insensitively as the default collation does, and the unqualified objects
+// are in the dbo schema.
+//
+// Parameters:
+//   - files: map[filePath]sqlContent - All SDL files to check together.
+//
+// Returns:
+//   - map[filePath][]*storepb.Advice - Per-file advice list
+//   - error - System errors
+func CheckSDLIntegrity(files map[string]string) (map[string][]*storepb.Advice, error) {
+	results := make(map[string][]*storepb.Advice)
+	if len(files) == 0 {
+		return results, nil
+	}
+
+	// Sort the files so that the first definition of a duplicate object is deterministic.
+	symbols := &sdlSymbolTable{}
+	for _, filePath := range slices.Sorted(maps.Keys(files)) {
+		parseResult, err := tsqlparser.ParseTSQL(files[filePath])
+		if err != nil {
+			return map[string][]*storepb.Advice{
+				filePath: {{
+					Status:  storepb.Advice_ERROR,
+					Code:    code.StatementSyntaxError.Int32(),
+					Title:   "SQL syntax error",
+					Content: fmt.Sprintf("Failed to parse SQL in file '%s': %v", filePath, err),
+				}},
+			}, nil
+		}
+		results[filePath] = []*storepb.Advice{}
+		if parseResult == nil {
+			continue
+		}
+
+		collector := &sdlSymbolCollector{
+			BaseTSqlParserListener: &parser.BaseTSqlParserListener{},
+			symbols:                symbols,
+			filePath:               filePath,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(collector, parseResult.Tree)
+	}
+
+	for _, advice := range symbols.check() {
+		results[advice.filePath] = append(results[advice.filePath], advice.advice)
+	}
+	return results, nil
+}
+
+// sdlLocation is the place where an object is defined.
+type sdlLocation struct {
+	filePath string
+	line     int
+}
+
+type sdlTable struct {
+	sdlLocation
+	// name is the schema qualified table name.
+	name string
+	// columns maps the lower case column name to the column name.
+	columns     map[string]string
+	columnNames []string
+	primaryKeys []sdlLocation
+}
+
+type sdlView struct {
+	sdlLocation
+	// name is the schema qualified view name.
+	name string
+	// references are the schema qualified tables and views referenced by the view, excluding the CTEs.
+	references []string
+}
+
+// sdlNamedObject is an index or a constraint.
+type sdlNamedObject struct {
+	sdlLocation
+	tableName string
+	name      string
+}
+
+type sdlForeignKey struct {
+	sdlNamedObject
+	referencedTable   string
+	referencedColumns []string
+}
+
+type sdlFileAdvice struct {
+	filePath string
+	advice   *storepb.Advice
+}
+
+type sdlSymbolTable struct {
+	tables      []*sdlTable
+	views       []*sdlView
+	indexes     []*sdlNamedObject
+	constraints []*sdlNamedObject
+	foreignKeys []*sdlForeignKey
+
+	duplicateColumnAdvices []*sdlFileAdvice
+}
+
+// sdlSymbolCollector collects the objects defined in a SDL file.
+type sdlSymbolCollector struct {
+	*parser.BaseTSqlParserListener
+
+	symbols  *sdlSymbolTable
+	filePath string
+
+	currentTable *sdlTable
+	currentView  *sdlView
+	cteNames     map[string]bool
+}
+
+func (c *sdlSymbolCollector) location(ctx antlr.ParserRuleContext) sdlLocation {
+	return sdlLocation{filePath: c.filePath, line: ctx.GetStart().GetLine()}
+}
+
+// EnterCreate_table starts collecting the table with its columns, indexes and constraints.
+func (c *sdlSymbolCollector) EnterCreate_table(ctx *parser.Create_tableContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	table := &sdlTable{
+		sdlLocation: c.location(ctx),
+		name:        normalizeSDLTableName(ctx.Table_name()),
+		columns:     make(map[string]string),
+	}
+	c.symbols.tables = append(c.symbols.tables, table)
+	c.currentTable = table
+
+	for _, index := range ctx.AllTable_indices() {
+		c.addIndex(index, table.name, index.Id_(0))
+	}
+}
+
+// ExitCreate_table finishes collecting the table.
+func (c *sdlSymbolCollector) ExitCreate_table(*parser.Create_tableContext) {
+	c.currentTable = nil
+}
+
+// EnterColumn_definition collects the column with its inline constraints.
+func (c *sdlSymbolCollector) EnterColumn_definition(ctx *parser.Column_definitionContext) {
+	if c.currentTable == nil {
+		return
+	}
+	if !c.addColumn(ctx, ctx.Id_()) {
+		return
+	}
+	for _, element := range ctx.AllColumn_definition_element() {
+		if element.DEFAULT() != nil && element.GetConstraint() != nil {
+			c.addConstraint(element, element.GetConstraint())
+		}
+		constraint := element.Column_constraint()
+		if constraint == nil {
+			continue
+		}
+		switch {
+		case constraint.PRIMARY() != nil:
+			c.currentTable.primaryKeys = append(c.currentTable.primaryKeys, c.location(constraint))
+			c.addConstraint(constraint, constraint.GetConstraint())
+		case constraint.Foreign_key_options() != nil:
+			c.addForeignKey(constraint, constraint.GetConstraint(), constraint.Foreign_key_options())
+		case constraint.UNIQUE() != nil, constraint.Check_constraint() != nil:
+			c.addConstraint(constraint, constraint.GetConstraint())
+		default:
+		}
+	}
+	if index := ctx.Column_index(); index != nil {
+		c.addIndex(index, c.currentTable.name, index.GetIndex_name())
+	}
+}
+
+// EnterMaterialized_column_definition collects the computed column.
+func (c *sdlSymbolCollector) EnterMaterialized_column_definition(ctx *parser.Materialized_column_definitionContext) {
+	if c.currentTable == nil {
+		return
+	}
+	c.addColumn(ctx, ctx.Id_())
+}
+
+// EnterTable_constraint collects the table constraint.
+func (c *sdlSymbolCollector) EnterTable_constraint(ctx *parser.Table_constraintContext) {
+	if c.currentTable == nil {
+		return
+	}
+	switch {
+	case ctx.PRIMARY() != nil:
+		c.currentTable.primaryKeys = append(c.currentTable.primaryKeys, c.location(ctx))
+		c.addConstraint(ctx, ctx.GetConstraint())
+	case ctx.FOREIGN() != nil && ctx.Foreign_key_options() != nil:
+		c.addForeignKey(ctx, ctx.GetConstraint(), ctx.Foreign_key_options())
+	default:
+		c.addConstraint(ctx, ctx.GetConstraint())
+	}
+}
+
+// EnterCreate_index collects the standalone index.
+func (c *sdlSymbolCollector) EnterCreate_index(ctx *parser.Create_indexContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	c.addIndex(ctx, normalizeSDLTableName(ctx.Table_name()), ctx.Id_(0))
+}
+
+// EnterCreate_columnstore_index collects the clustered columnstore index.
+func (c *sdlSymbolCollector) EnterCreate_columnstore_index(ctx *parser.Create_columnstore_indexContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	c.addIndex(ctx, normalizeSDLTableName(ctx.Table_name()), ctx.Id_(0))
+}
+
+// EnterCreate_nonclustered_columnstore_index collects the nonclustered columnstore index.
+func (c *sdlSymbolCollector) EnterCreate_nonclustered_columnstore_index(ctx *parser.Create_nonclustered_columnstore_indexContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	c.addIndex(ctx, normalizeSDLTableName(ctx.Table_name()), ctx.Id_(0))
+}
+
+// EnterCreate_spatial_index collects the spatial index.
+func (c *sdlSymbolCollector) EnterCreate_spatial_index(ctx *parser.Create_spatial_indexContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	c.addIndex(ctx, normalizeSDLTableName(ctx.Table_name()), ctx.Id_(0))
+}
+
+// EnterCreate_xml_index collects the XML index.
+func (c *sdlSymbolCollector) EnterCreate_xml_index(ctx *parser.Create_xml_indexContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	c.addIndex(ctx, normalizeSDLTableName(ctx.Table_name()), ctx.Id_(0))
+}
+
+// EnterCreate_view starts collecting the references of the view.
+func (c *sdlSymbolCollector) EnterCreate_view(ctx *parser.Create_viewContext) {
+	if ctx.Simple_name() == nil {
+		return
+	}
+	schemaName := sdlDefaultSchema
+	if ctx.Simple_name().GetSchema() != nil {
+		schemaName, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.Simple_name().GetSchema())
+	}
+	viewName, _ := tsqlparser.NormalizeTSQLIdentifier(ctx.Simple_name().GetName())
+	c.currentView = &sdlView{sdlLocation: c.location(ctx), name: schemaName + "." + viewName}
+	c.cteNames = make(map[string]bool)
+}
+
+// ExitCreate_view finishes collecting the references of the view.
+func (c *sdlSymbolCollector) ExitCreate_view(*parser.Create_viewContext) {
+	if c.currentView == nil {
+		return
+	}
+	c.currentView.references = slices.DeleteFunc(c.currentView.references, func(name string) bool {
+		return c.cteNames[strings.ToLower(name)]
+	})
+	c.symbols.views = append(c.symbols.views, c.currentView)
+	c.currentView = nil
+	c.cteNames = nil
+}
+
+// EnterCommon_table_expression collects the CTE names which are not references to tables or views.
+func (c *sdlSymbolCollector) EnterCommon_table_expression(ctx *parser.Common_table_expressionContext) {
+	if c.currentView == nil || ctx.GetExpression_name() == nil {
+		return
+	}
+	_, cteName := tsqlparser.NormalizeTSQLIdentifier(ctx.GetExpression_name())
+	c.cteNames[sdlDefaultSchema+"."+cteName] = true
+}
+
+// EnterTable_source_item collects the tables and views referenced by the current view.
+func (c *sdlSymbolCollector) EnterTable_source_item(ctx *parser.Table_source_itemContext) {
+	if c.currentView == nil || ctx.Full_table_name() == nil {
+		return
+	}
+	fullTableName, err := tsqlparser.NormalizeFullTableName(ctx.Full_table_name())
+	if err != nil {
+		return
+	}
+	// Tables in other databases are not managed by the SDL.
+	if fullTableName.LinkedServer != "" || fullTableName.Server != "" || fullTableName.Database != "" {
+		return
+	}
+	schemaName := fullTableName.Schema
+	if schemaName == "" {
+		schemaName = sdlDefaultSchema
+	}
+	reference := schemaName + "." + fullTableName.Table
+	if !slices.Contains(c.currentView.references, reference) {
+		c.currentView.references = append(c.currentView.references, reference)
+	}
+}
+
+// addColumn adds the column to the current table, and returns false if it's a duplicate.
+func (c *sdlSymbolCollector) addColumn(ctx antlr.ParserRuleContext, id parser.IId_Context) bool {
+	columnName, lowerColumnName := tsqlparser.NormalizeTSQLIdentifier(id)
+	if columnName == "" {
+		return false
+	}
+	if _, exists := c.currentTable.columns[lowerColumnName]; exists {
+		c.symbols.addDuplicateColumn(c.currentTable, columnName, c.location(ctx))
+		return false
+	}
+	c.currentTable.columns[lowerColumnName] = columnName
+	c.currentTable.columnNames = append(c.currentTable.columnNames, columnName)
+	return true
+}
+
+func (c *sdlSymbolCollector) addIndex(ctx antlr.ParserRuleContext, tableName string, id parser.IId_Context) {
+	indexName, _ := tsqlparser.NormalizeTSQLIdentifier(id)
+	if indexName == "" {
+		return
+	}
+	c.symbols.indexes = append(c.symbols.indexes, &sdlNamedObject{sdlLocation: c.location(ctx), tableName: tableName, name: indexName})
+}
+
+// addConstraint adds the named constraint of the current table. The unnamed constraints get the system generated names.
+func (c *sdlSymbolCollector) addConstraint(ctx antlr.ParserRuleContext, id parser.IId_Context) {
+	constraintName, _ := tsqlparser.NormalizeTSQLIdentifier(id)
+	if constraintName == "" {
+		return
+	}
+	c.symbols.constraints = append(c.symbols.constraints, &sdlNamedObject{sdlLocation: c.location(ctx), tableName: c.currentTable.name, name: constraintName})
+}
+
+func (c *sdlSymbolCollector) addForeignKey(ctx antlr.ParserRuleContext, id parser.IId_Context, options parser.IForeign_key_optionsContext) {
+	constraintName, _ := tsqlparser.NormalizeTSQLIdentifier(id)
+	fk := &sdlForeignKey{
+		sdlNamedObject: sdlNamedObject{sdlLocation: c.location(ctx), tableName: c.currentTable.name, name: constraintName},
+	}
+	if options.Table_name() != nil {
+		fk.referencedTable = normalizeSDLTableName(options.Table_name())
+		if options.GetPk() != nil {
+			for _, column := range options.GetPk().AllId_() {
+				columnName, _ := tsqlparser.NormalizeTSQLIdentifier(column)
+				fk.referencedColumns = append(fk.referencedColumns, columnName)
+			}
+		}
+		c.symbols.foreignKeys = append(c.symbols.foreignKeys, fk)
+	}
+	if constraintName != "" {
+		c.symbols.constraints = append(c.symbols.constraints, &fk.sdlNamedObject)
+	}
+}
+
+// normalizeSDLTableName returns the schema qualified table name, with the unqualified tables in the dbo schema.
+func normalizeSDLTableName(ctx parser.ITable_nameContext) string {
+	return tsqlparser.NormalizeTSQLTableName(ctx, "" /* fallbackDatabase */, sdlDefaultSchema, false /* caseSensitive */)
+}
+
+// check validates the collected objects.
+func (s *sdlSymbolTable) check() []*sdlFileAdvice {
+	var advices []*sdlFileAdvice
+	advices = append(advices, s.duplicateColumnAdvices...)
+
+	// Tables and views share the same namespace.
+	seenTables := make(map[string]sdlLocation)
+	tables := make(map[string]*sdlTable)
+	for _, table := range s.tables {
+		key := strings.ToLower(table.name)
+		if first, exists := seenTables[key]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateTableName, "table", fmt.Sprintf("Table '%s'", table.name), first, table.sdlLocation,
+				"Each table can only be defined once."))
+			continue
+		}
+		seenTables[key] = table.sdlLocation
+		tables[key] = table
+
+		if len(table.primaryKeys) > 1 {
+			var descriptions []string
+			for i, pk := range table.primaryKeys {
+				descriptions = append(descriptions, fmt.Sprintf("  %d. %s (line %d)", i+1, pk.filePath, pk.line))
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: table.primaryKeys[1].filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLMultiplePrimaryKey.Int32(),
+					Title:  "Multiple primary keys defined",
+					Content: fmt.Sprintf(
+						"Table '%s' has multiple PRIMARY KEY definitions.\n\n"+
+							"Found %d primary key definitions:\n%s\n\n"+
+							"A table can only have one PRIMARY KEY.\n"+
+							"If you need to enforce uniqueness on multiple column combinations, use UNIQUE constraints instead.",
+						table.name, len(table.primaryKeys), strings.Join(descriptions, "\n"),
+					),
+					StartPosition: &storepb.Position{Line: int32(table.primaryKeys[1].line)},
+				},
+			})
+		}
+	}
+	views := make(map[string]*sdlView)
+	for _, view := range s.views {
+		key := strings.ToLower(view.name)
+		if first, exists := seenTables[key]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateTableName, "view", fmt.Sprintf("View '%s'", view.name), first, view.sdlLocation,
+				"Tables and views share the same namespace, so each name can only be defined once."))
+			continue
+		}
+		seenTables[key] = view.sdlLocation
+		views[key] = view
+	}
+
+	// Index names are unique per table.
+	seenIndexes := make(map[string]sdlLocation)
+	for _, index := range s.indexes {
+		key := strings.ToLower(index.tableName + "." + index.name)
+		if first, exists := seenIndexes[key]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateIndexName, "index", fmt.Sprintf("Index '%s' on table '%s'", index.name, index.tableName), first, index.sdlLocation,
+				"Each index name can only be used once per table."))
+			continue
+		}
+		seenIndexes[key] = index.sdlLocation
+	}
+
+	// Constraints are schema scoped objects, so the names are unique per schema.
+	seenConstraints := make(map[string]sdlLocation)
+	for _, constraint := range s.constraints {
+		schemaName, _, _ := strings.Cut(constraint.tableName, ".")
+		key := strings.ToLower(schemaName + "." + constraint.name)
+		if first, exists := seenConstraints[key]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateConstraintName, "constraint", fmt.Sprintf("Constraint '%s' on table '%s'", constraint.name, constraint.tableName), first, constraint.sdlLocation,
+				"Constraint names must be unique within the schema."))
+			continue
+		}
+		seenConstraints[key] = constraint.sdlLocation
+	}
+
+	for _, fk := range s.foreignKeys {
+		refTable := tables[strings.ToLower(fk.referencedTable)]
+		if refTable == nil {
+			advices = append(advices, &sdlFileAdvice{
+				filePath: fk.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLForeignKeyTableNotFound.Int32(),
+					Title:  "Foreign key references non-existent table",
+					Content: fmt.Sprintf(
+						"Foreign key %s on table '%s' references table '%s' which does not exist in any SDL file.\n\n"+
+							"Make sure the referenced table is defined in one of the SDL files.",
+						foreignKeyDisplayName(fk), fk.tableName, fk.referencedTable,
+					),
+					StartPosition: &storepb.Position{Line: int32(fk.line)},
+				},
+			})
+			continue
+		}
+		for _, column := range fk.referencedColumns {
+			if _, exists := refTable.columns[strings.ToLower(column)]; exists {
+				continue
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: fk.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLForeignKeyColumnNotFound.Int32(),
+					Title:  "Foreign key references non-existent column",
+					Content: fmt.Sprintf(
+						"Foreign key %s on table '%s' references column '%s' in table '%s', but this column does not exist.\n\n"+
+							"Available columns in '%s': %s",
+						foreignKeyDisplayName(fk), fk.tableName, column, refTable.name,
+						refTable.name, strings.Join(refTable.columnNames, ", "),
+					),
+					StartPosition: &storepb.Position{Line: int32(fk.line)},
+				},
+			})
+		}
+	}
+
+	for _, view := range s.views {
+		if views[strings.ToLower(view.name)] != view {
+			// The duplicate view is already reported.
+			continue
+		}
+		for _, reference := range view.references {
+			key := strings.ToLower(reference)
+			if tables[key] != nil || views[key] != nil {
+				continue
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: view.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLViewDependencyNotFound.Int32(),
+					Title:  "View references non-existent table or view",
+					Content: fmt.Sprintf(
+						"View '%s' (line %d) references table or view '%s' which does not exist in any SDL file.\n\n"+
+							"Views must reference tables or views that are defined in the SDL project.\n\n"+
+							"Fix: Define table or view '%s' in one of the SDL files, or remove the view if the object is external.",
+						view.name, view.line, reference, reference,
+					),
+					StartPosition: &storepb.Position{Line: int32(view.line)},
+				},
+			})
+		}
+	}
+	return advices
+}
+
+func (s *sdlSymbolTable) addDuplicateColumn(table *sdlTable, columnName string, location sdlLocation) {
+	s.duplicateColumnAdvices = append(s.duplicateColumnAdvices, &sdlFileAdvice{
+		filePath: location.filePath,
+		advice: &storepb.Advice{
+			Status: storepb.Advice_ERROR,
+			Code:   code.SDLDuplicateColumnName.Int32(),
+			Title:  "Duplicate column name",
+			Content: fmt.Sprintf(
+				"Column '%s' is defined multiple times in table '%s'.\n\n"+
+					"Each column can only be defined once per table.",
+				columnName, table.name,
+			),
+			StartPosition: &storepb.Position{Line: int32(location.line)},
+		},
+	})
+}
+
+// duplicateAdvice returns the advice for the duplicate definition. The title tells whether the definitions are in different files.
+func duplicateAdvice(c code.Code, objectType, object string, first, duplicate sdlLocation, rule string) *sdlFileAdvice {
+	title := fmt.Sprintf("Duplicate %s name", objectType)
+	content := fmt.Sprintf(
+		"%s is defined multiple times in the SDL.\n\n"+
+			"First definition at line %d\n"+
+			"Duplicate definition at line %d\n\n%s",
+		object, first.line, duplicate.line, rule,
+	)
+	if first.filePath != duplicate.filePath {
+		title = fmt.Sprintf("Duplicate %s name across files", objectType)
+		content = fmt.Sprintf(
+			"%s is defined in multiple SDL files.\n\n"+
+				"First definition: %s (line %d)\n"+
+				"Duplicate definition: %s (line %d)\n\n%s",
+			object, first.filePath, first.line, duplicate.filePath, duplicate.line, rule,
+		)
+	}
+	return &sdlFileAdvice{
+		filePath: duplicate.filePath,
+		advice: &storepb.Advice{
+			Status:        storepb.Advice_ERROR,
+			Code:          c.Int32(),
+			Title:         title,
+			Content:       content,
+			StartPosition: &storepb.Position{Line: int32(duplicate.line)},
+		},
+	}
+}
+
+func foreignKeyDisplayName(fk *sdlForeignKey) string {
+	if fk.name == "" {
+		return "<unnamed>"
+	}
+	return fmt.Sprintf("'%s'", fk.name)
+}
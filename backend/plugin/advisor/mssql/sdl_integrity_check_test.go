@@ -0,0 +1,134 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+)
+
+func TestCheckSDLIntegrity(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantCodes map[string][]code.Code
+	}{
+		{
+			name: "Valid schema",
+			files: map[string]string{
+				"users.sql": `
+CREATE TABLE [dbo].[users] (
+  [id] INT NOT NULL,
+  [email] NVARCHAR(255) NOT NULL,
+  CONSTRAINT [pk_users] PRIMARY KEY ([id]),
+  CONSTRAINT [uk_users_email] UNIQUE ([email])
+);`,
+				"orders.sql": `
+CREATE TABLE orders (
+  id BIGINT NOT NULL PRIMARY KEY,
+  user_id INT NOT NULL CONSTRAINT fk_orders_user REFERENCES dbo.users (id),
+  INDEX idx_orders_user (user_id)
+);
+GO
+CREATE NONCLUSTERED INDEX idx_orders_id ON orders (id);
+GO
+CREATE VIEW v_orders AS
+WITH recent AS (SELECT * FROM orders)
+SELECT r.id, u.email FROM recent r JOIN [dbo].[Users] u ON r.user_id = u.id;
+GO`,
+			},
+			wantCodes: map[string][]code.Code{},
+		},
+		{
+			name: "Duplicate table across files",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t (id INT);",
+				"b.sql": "CREATE TABLE [dbo].[T] (id INT);",
+			},
+			wantCodes: map[string][]code.Code{"b.sql": {code.SDLDuplicateTableName}},
+		},
+		{
+			name: "Tables in different schemas",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t (id INT);",
+				"b.sql": "CREATE TABLE sales.t (id INT);",
+			},
+			wantCodes: map[string][]code.Code{},
+		},
+		{
+			name: "Duplicate column and multiple primary keys",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE t (
+  id INT PRIMARY KEY,
+  ID INT,
+  name NVARCHAR(10),
+  PRIMARY KEY (name)
+);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLDuplicateColumnName, code.SDLMultiplePrimaryKey}},
+		},
+		{
+			name: "Duplicate index name on the same table",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE t (id INT, name NVARCHAR(10), INDEX idx_t (id));
+GO
+CREATE INDEX IDX_T ON t (name);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLDuplicateIndexName}},
+		},
+		{
+			name: "Duplicate constraint name in the schema",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t1 (id INT, CONSTRAINT chk_id CHECK (id > 0));",
+				"b.sql": "CREATE TABLE t2 (id INT CONSTRAINT CHK_ID DEFAULT 0);",
+			},
+			wantCodes: map[string][]code.Code{"b.sql": {code.SDLDuplicateConstraintName}},
+		},
+		{
+			name: "Foreign key references missing table and column",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE users (id INT PRIMARY KEY);
+CREATE TABLE orders (
+  id INT PRIMARY KEY,
+  user_id INT,
+  account_id INT,
+  CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (uid),
+  CONSTRAINT fk_account FOREIGN KEY (account_id) REFERENCES sales.accounts (id)
+);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLForeignKeyColumnNotFound, code.SDLForeignKeyTableNotFound}},
+		},
+		{
+			name: "View references missing table",
+			files: map[string]string{
+				"a.sql": "CREATE VIEW v AS SELECT * FROM missing;",
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLViewDependencyNotFound}},
+		},
+		{
+			name: "Syntax error",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE (;",
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.StatementSyntaxError}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := CheckSDLIntegrity(tc.files)
+			require.NoError(t, err)
+			for filePath, advices := range results {
+				var gotCodes []code.Code
+				for _, advice := range advices {
+					gotCodes = append(gotCodes, code.Code(advice.Code))
+				}
+				require.ElementsMatch(t, tc.wantCodes[filePath], gotCodes, "file %s: %v", filePath, advices)
+			}
+		})
+	}
+}
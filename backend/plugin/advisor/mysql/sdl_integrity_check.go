@@ -0,0 +1,483 @@
+package mysql
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/mysql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+)
+
+// CheckSDLIntegrity performs integrity checks across MySQL SDL files.
+// It's the MySQL equivalent of the PostgreSQL SDL integrity check, so the files are checked together
+// to validate cross-file references (foreign keys, views) and detect duplicate definitions across files.
+//
+// Table and view names are compared case-sensitively, while column, index and constraint names
+// are compared case-insensitively as MySQL does.
+//
+// Parameters:
+//   - files: map[filePath]sqlContent - All SDL files to check together.
+//
+// Returns:
+//   - map[filePath][]*storepb.Advice - Per-file advice list
+//   - error - System errors
+func CheckSDLIntegrity(files map[string]string) (map[string][]*storepb.Advice, error) {
+	results := make(map[string][]*storepb.Advice)
+	if len(files) == 0 {
+		return results, nil
+	}
+
+	// Sort the files so that the first definition of a duplicate object is deterministic.
+	symbols := &sdlSymbolTable{}
+	for _, filePath := range slices.Sorted(maps.Keys(files)) {
+		parseResults, err := mysqlparser.ParseMySQL(files[filePath])
+		if err != nil {
+			return map[string][]*storepb.Advice{
+				filePath: {{
+					Status:  storepb.Advice_ERROR,
+					Code:    code.StatementSyntaxError.Int32(),
+					Title:   "SQL syntax error",
+					Content: fmt.Sprintf("Failed to parse SQL in file '%s': %v", filePath, err),
+				}},
+			}, nil
+		}
+		results[filePath] = []*storepb.Advice{}
+
+		collector := &sdlSymbolCollector{
+			BaseMySQLParserListener: &mysql.BaseMySQLParserListener{},
+			symbols:                 symbols,
+			filePath:                filePath,
+		}
+		for _, parseResult := range parseResults {
+			collector.baseLine = parseResult.BaseLine
+			antlr.ParseTreeWalkerDefault.Walk(collector, parseResult.Tree)
+		}
+	}
+
+	for _, advice := range symbols.check() {
+		results[advice.filePath] = append(results[advice.filePath], advice.advice)
+	}
+	return results, nil
+}
+
+// sdlLocation is the place where an object is defined.
+type sdlLocation struct {
+	filePath string
+	line     int
+}
+
+type sdlTable struct {
+	sdlLocation
+	name string
+	// columns maps the lower case column name to the column name.
+	columns     map[string]string
+	columnNames []string
+	primaryKeys []sdlLocation
+}
+
+type sdlView struct {
+	sdlLocation
+	name string
+	// references are the tables and views referenced by the view, excluding the CTEs.
+	references []string
+}
+
+// sdlNamedObject is an index or a constraint.
+type sdlNamedObject struct {
+	sdlLocation
+	tableName string
+	name      string
+}
+
+type sdlForeignKey struct {
+	sdlNamedObject
+	referencedTable   string
+	referencedColumns []string
+}
+
+type sdlFileAdvice struct {
+	filePath string
+	advice   *storepb.Advice
+}
+
+type sdlSymbolTable struct {
+	tables      []*sdlTable
+	views       []*sdlView
+	indexes     []*sdlNamedObject
+	constraints []*sdlNamedObject
+	foreignKeys []*sdlForeignKey
+
+	duplicateColumnAdvices []*sdlFileAdvice
+}
+
+// sdlSymbolCollector collects the objects defined in a SDL file.
+type sdlSymbolCollector struct {
+	*mysql.BaseMySQLParserListener
+
+	symbols  *sdlSymbolTable
+	filePath string
+	baseLine int
+
+	currentView *sdlView
+	cteNames    map[string]bool
+}
+
+func (c *sdlSymbolCollector) location(ctx antlr.ParserRuleContext) sdlLocation {
+	return sdlLocation{filePath: c.filePath, line: c.baseLine + ctx.GetStart().GetLine()}
+}
+
+// EnterCreateTable collects the table with its columns, indexes and constraints.
+func (c *sdlSymbolCollector) EnterCreateTable(ctx *mysql.CreateTableContext) {
+	if ctx.TableName() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableName(ctx.TableName())
+	table := &sdlTable{
+		sdlLocation: c.location(ctx),
+		name:        tableName,
+		columns:     make(map[string]string),
+	}
+	c.symbols.tables = append(c.symbols.tables, table)
+	if ctx.TableElementList() == nil {
+		return
+	}
+
+	for _, element := range ctx.TableElementList().AllTableElement() {
+		if columnDef := element.ColumnDefinition(); columnDef != nil && columnDef.ColumnName() != nil {
+			_, _, columnName := mysqlparser.NormalizeMySQLColumnName(columnDef.ColumnName())
+			if _, exists := table.columns[strings.ToLower(columnName)]; exists {
+				c.symbols.addDuplicateColumn(table, columnName, c.location(columnDef))
+				continue
+			}
+			table.columns[strings.ToLower(columnName)] = columnName
+			table.columnNames = append(table.columnNames, columnName)
+			if columnDef.FieldDefinition() != nil {
+				for _, attr := range columnDef.FieldDefinition().AllColumnAttribute() {
+					if attr.PRIMARY_SYMBOL() != nil && attr.KEY_SYMBOL() != nil {
+						table.primaryKeys = append(table.primaryKeys, c.location(attr))
+					}
+				}
+			}
+		}
+		if constraint := element.TableConstraintDef(); constraint != nil {
+			c.collectTableConstraint(table, constraint)
+		}
+	}
+}
+
+func (c *sdlSymbolCollector) collectTableConstraint(table *sdlTable, ctx mysql.ITableConstraintDefContext) {
+	location := c.location(ctx)
+	constraintName := ""
+	if ctx.ConstraintName() != nil {
+		constraintName = mysqlparser.NormalizeConstraintName(ctx.ConstraintName())
+	}
+
+	switch {
+	case ctx.PRIMARY_SYMBOL() != nil:
+		table.primaryKeys = append(table.primaryKeys, location)
+	case ctx.FOREIGN_SYMBOL() != nil:
+		if constraintName == "" && ctx.IndexName() != nil {
+			constraintName = mysqlparser.NormalizeIndexName(ctx.IndexName())
+		}
+		fk := &sdlForeignKey{
+			sdlNamedObject: sdlNamedObject{sdlLocation: location, tableName: table.name, name: constraintName},
+		}
+		if references := ctx.References(); references != nil && references.TableRef() != nil {
+			_, fk.referencedTable = mysqlparser.NormalizeMySQLTableRef(references.TableRef())
+			if references.IdentifierListWithParentheses() != nil {
+				fk.referencedColumns = mysqlparser.NormalizeIdentifierListWithParentheses(references.IdentifierListWithParentheses())
+			}
+			c.symbols.foreignKeys = append(c.symbols.foreignKeys, fk)
+		}
+		if constraintName != "" {
+			c.symbols.constraints = append(c.symbols.constraints, &fk.sdlNamedObject)
+		}
+	case ctx.CheckConstraint() != nil:
+		if constraintName != "" {
+			c.symbols.constraints = append(c.symbols.constraints, &sdlNamedObject{sdlLocation: location, tableName: table.name, name: constraintName})
+		}
+	default:
+		// KEY, INDEX, UNIQUE, FULLTEXT and SPATIAL indexes.
+		indexName := ""
+		if ctx.IndexName() != nil {
+			indexName = mysqlparser.NormalizeIndexName(ctx.IndexName())
+		} else if ctx.IndexNameAndType() != nil && ctx.IndexNameAndType().IndexName() != nil {
+			indexName = mysqlparser.NormalizeIndexName(ctx.IndexNameAndType().IndexName())
+		}
+		if indexName == "" {
+			indexName = constraintName
+		}
+		if indexName != "" {
+			c.symbols.indexes = append(c.symbols.indexes, &sdlNamedObject{sdlLocation: location, tableName: table.name, name: indexName})
+		}
+	}
+}
+
+// EnterCreateIndex collects the standalone index.
+func (c *sdlSymbolCollector) EnterCreateIndex(ctx *mysql.CreateIndexContext) {
+	if ctx.CreateIndexTarget() == nil || ctx.CreateIndexTarget().TableRef() == nil {
+		return
+	}
+	_, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.CreateIndexTarget().TableRef())
+	indexName := ""
+	if ctx.IndexName() != nil {
+		indexName = mysqlparser.NormalizeIndexName(ctx.IndexName())
+	} else if ctx.IndexNameAndType() != nil && ctx.IndexNameAndType().IndexName() != nil {
+		indexName = mysqlparser.NormalizeIndexName(ctx.IndexNameAndType().IndexName())
+	}
+	if indexName == "" {
+		return
+	}
+	c.symbols.indexes = append(c.symbols.indexes, &sdlNamedObject{sdlLocation: c.location(ctx), tableName: tableName, name: indexName})
+}
+
+// EnterCreateView starts collecting the references of the view.
+func (c *sdlSymbolCollector) EnterCreateView(ctx *mysql.CreateViewContext) {
+	if ctx.ViewName() == nil {
+		return
+	}
+	_, viewName := mysqlparser.NormalizeMySQLViewName(ctx.ViewName())
+	c.currentView = &sdlView{sdlLocation: c.location(ctx), name: viewName}
+	c.cteNames = make(map[string]bool)
+}
+
+// ExitCreateView finishes collecting the references of the view.
+func (c *sdlSymbolCollector) ExitCreateView(*mysql.CreateViewContext) {
+	if c.currentView == nil {
+		return
+	}
+	c.currentView.references = slices.DeleteFunc(c.currentView.references, func(name string) bool {
+		return c.cteNames[strings.ToLower(name)]
+	})
+	c.symbols.views = append(c.symbols.views, c.currentView)
+	c.currentView = nil
+	c.cteNames = nil
+}
+
+// EnterCommonTableExpression collects the CTE names which are not references to tables or views.
+func (c *sdlSymbolCollector) EnterCommonTableExpression(ctx *mysql.CommonTableExpressionContext) {
+	if c.currentView == nil || ctx.Identifier() == nil {
+		return
+	}
+	c.cteNames[strings.ToLower(mysqlparser.NormalizeMySQLIdentifier(ctx.Identifier()))] = true
+}
+
+// EnterSingleTable collects the tables and views referenced by the current view.
+func (c *sdlSymbolCollector) EnterSingleTable(ctx *mysql.SingleTableContext) {
+	if c.currentView == nil || ctx.TableRef() == nil {
+		return
+	}
+	databaseName, tableName := mysqlparser.NormalizeMySQLTableRef(ctx.TableRef())
+	// Tables in other databases are not managed by the SDL.
+	if databaseName != "" {
+		return
+	}
+	if !slices.Contains(c.currentView.references, tableName) {
+		c.currentView.references = append(c.currentView.references, tableName)
+	}
+}
+
+// check validates the collected objects.
+func (s *sdlSymbolTable) check() []*sdlFileAdvice {
+	var advices []*sdlFileAdvice
+	advices = append(advices, s.duplicateColumnAdvices...)
+
+	// Tables and views share the same namespace.
+	seenTables := make(map[string]sdlLocation)
+	tables := make(map[string]*sdlTable)
+	for _, table := range s.tables {
+		if first, exists := seenTables[table.name]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateTableName, "table", fmt.Sprintf("Table '%s'", table.name), first, table.sdlLocation,
+				"Each table can only be defined once."))
+			continue
+		}
+		seenTables[table.name] = table.sdlLocation
+		tables[table.name] = table
+
+		if len(table.primaryKeys) > 1 {
+			var descriptions []string
+			for i, pk := range table.primaryKeys {
+				descriptions = append(descriptions, fmt.Sprintf("  %d. %s (line %d)", i+1, pk.filePath, pk.line))
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: table.primaryKeys[1].filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLMultiplePrimaryKey.Int32(),
+					Title:  "Multiple primary keys defined",
+					Content: fmt.Sprintf(
+						"Table '%s' has multiple PRIMARY KEY definitions.\n\n"+
+							"Found %d primary key definitions:\n%s\n\n"+
+							"A table can only have one PRIMARY KEY.\n"+
+							"If you need to enforce uniqueness on multiple column combinations, use UNIQUE keys instead.",
+						table.name, len(table.primaryKeys), strings.Join(descriptions, "\n"),
+					),
+					StartPosition: &storepb.Position{Line: int32(table.primaryKeys[1].line)},
+				},
+			})
+		}
+	}
+	views := make(map[string]*sdlView)
+	for _, view := range s.views {
+		if first, exists := seenTables[view.name]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateTableName, "view", fmt.Sprintf("View '%s'", view.name), first, view.sdlLocation,
+				"Tables and views share the same namespace, so each name can only be defined once."))
+			continue
+		}
+		seenTables[view.name] = view.sdlLocation
+		views[view.name] = view
+	}
+
+	// Index names are unique per table.
+	seenIndexes := make(map[string]sdlLocation)
+	for _, index := range s.indexes {
+		key := index.tableName + "." + strings.ToLower(index.name)
+		if first, exists := seenIndexes[key]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateIndexName, "index", fmt.Sprintf("Index '%s' on table '%s'", index.name, index.tableName), first, index.sdlLocation,
+				"Each index name can only be used once per table."))
+			continue
+		}
+		seenIndexes[key] = index.sdlLocation
+	}
+
+	// Foreign key and check constraint names are unique per database.
+	seenConstraints := make(map[string]sdlLocation)
+	for _, constraint := range s.constraints {
+		key := strings.ToLower(constraint.name)
+		if first, exists := seenConstraints[key]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateConstraintName, "constraint", fmt.Sprintf("Constraint '%s' on table '%s'", constraint.name, constraint.tableName), first, constraint.sdlLocation,
+				"Foreign key and check constraint names must be unique within the database."))
+			continue
+		}
+		seenConstraints[key] = constraint.sdlLocation
+	}
+
+	for _, fk := range s.foreignKeys {
+		refTable := tables[fk.referencedTable]
+		if refTable == nil {
+			advices = append(advices, &sdlFileAdvice{
+				filePath: fk.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLForeignKeyTableNotFound.Int32(),
+					Title:  "Foreign key references non-existent table",
+					Content: fmt.Sprintf(
+						"Foreign key %s on table '%s' references table '%s' which does not exist in any SDL file.\n\n"+
+							"Make sure the referenced table is defined in one of the SDL files.",
+						foreignKeyDisplayName(fk), fk.tableName, fk.referencedTable,
+					),
+					StartPosition: &storepb.Position{Line: int32(fk.line)},
+				},
+			})
+			continue
+		}
+		for _, column := range fk.referencedColumns {
+			if _, exists := refTable.columns[strings.ToLower(column)]; exists {
+				continue
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: fk.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLForeignKeyColumnNotFound.Int32(),
+					Title:  "Foreign key references non-existent column",
+					Content: fmt.Sprintf(
+						"Foreign key %s on table '%s' references column '%s' in table '%s', but this column does not exist.\n\n"+
+							"Available columns in '%s': %s",
+						foreignKeyDisplayName(fk), fk.tableName, column, refTable.name,
+						refTable.name, strings.Join(refTable.columnNames, ", "),
+					),
+					StartPosition: &storepb.Position{Line: int32(fk.line)},
+				},
+			})
+		}
+	}
+
+	for _, view := range s.views {
+		if views[view.name] != view {
+			// The duplicate view is already reported.
+			continue
+		}
+		for _, reference := range view.references {
+			if tables[reference] != nil || views[reference] != nil {
+				continue
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: view.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLViewDependencyNotFound.Int32(),
+					Title:  "View references non-existent table or view",
+					Content: fmt.Sprintf(
+						"View '%s' (line %d) references table or view '%s' which does not exist in any SDL file.\n\n"+
+							"Views must reference tables or views that are defined in the SDL project.\n\n"+
+							"Fix: Define table or view '%s' in one of the SDL files, or remove the view if the object is external.",
+						view.name, view.line, reference, reference,
+					),
+					StartPosition: &storepb.Position{Line: int32(view.line)},
+				},
+			})
+		}
+	}
+	return advices
+}
+
+func (s *sdlSymbolTable) addDuplicateColumn(table *sdlTable, columnName string, location sdlLocation) {
+	s.duplicateColumnAdvices = append(s.duplicateColumnAdvices, &sdlFileAdvice{
+		filePath: location.filePath,
+		advice: &storepb.Advice{
+			Status: storepb.Advice_ERROR,
+			Code:   code.SDLDuplicateColumnName.Int32(),
+			Title:  "Duplicate column name",
+			Content: fmt.Sprintf(
+				"Column '%s' is defined multiple times in table '%s'.\n\n"+
+					"Each column can only be defined once per table.",
+				columnName, table.name,
+			),
+			StartPosition: &storepb.Position{Line: int32(location.line)},
+		},
+	})
+}
+
+// duplicateAdvice returns the advice for the duplicate definition. The title tells whether the definitions are in different files.
+func duplicateAdvice(c code.Code, objectType, object string, first, duplicate sdlLocation, rule string) *sdlFileAdvice {
+	title := fmt.Sprintf("Duplicate %s name", objectType)
+	content := fmt.Sprintf(
+		"%s is defined multiple times in the SDL.\n\n"+
+			"First definition at line %d\n"+
+			"Duplicate definition at line %d\n\n%s",
+		object, first.line, duplicate.line, rule,
+	)
+	if first.filePath != duplicate.filePath {
+		title = fmt.Sprintf("Duplicate %s name across files", objectType)
+		content = fmt.Sprintf(
+			"%s is defined in multiple SDL files.\n\n"+
+				"First definition: %s (line %d)\n"+
+				"Duplicate definition: %s (line %d)\n\n%s",
+			object, first.filePath, first.line, duplicate.filePath, duplicate.line, rule,
+		)
+	}
+	return &sdlFileAdvice{
+		filePath: duplicate.filePath,
+		advice: &storepb.Advice{
+			Status:        storepb.Advice_ERROR,
+			Code:          c.Int32(),
+			Title:         title,
+			Content:       content,
+			StartPosition: &storepb.Position{Line: int32(duplicate.line)},
+		},
+	}
+}
+
+func foreignKeyDisplayName(fk *sdlForeignKey) string {
+	if fk.name == "" {
+		return "<unnamed>"
+	}
+	return fmt.Sprintf("'%s'", fk.name)
+}
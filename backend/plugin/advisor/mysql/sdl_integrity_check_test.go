@@ -0,0 +1,122 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+)
+
+func TestCheckSDLIntegrity(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantCodes map[string][]code.Code
+	}{
+		{
+			name: "Valid schema",
+			files: map[string]string{
+				"users.sql": `
+CREATE TABLE users (
+  id INT NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uk_users_email (email)
+);`,
+				"orders.sql": `
+CREATE TABLE orders (
+  id BIGINT NOT NULL PRIMARY KEY,
+  user_id INT NOT NULL,
+  CONSTRAINT fk_orders_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX idx_orders_user ON orders (user_id);
+CREATE VIEW v_orders AS
+WITH recent AS (SELECT * FROM orders)
+SELECT r.id, u.email FROM recent r JOIN users u ON r.user_id = u.id;`,
+			},
+			wantCodes: map[string][]code.Code{},
+		},
+		{
+			name: "Duplicate table across files",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t (id INT);",
+				"b.sql": "CREATE TABLE t (id INT);",
+			},
+			wantCodes: map[string][]code.Code{"b.sql": {code.SDLDuplicateTableName}},
+		},
+		{
+			name: "Duplicate column and multiple primary keys",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE t (
+  id INT PRIMARY KEY,
+  ID INT,
+  name VARCHAR(10),
+  PRIMARY KEY (name)
+);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLDuplicateColumnName, code.SDLMultiplePrimaryKey}},
+		},
+		{
+			name: "Duplicate index name on the same table",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE t (id INT, name VARCHAR(10), KEY idx_t (id));
+CREATE INDEX IDX_T ON t (name);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLDuplicateIndexName}},
+		},
+		{
+			name: "Duplicate constraint name in the database",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t1 (id INT, CONSTRAINT chk_id CHECK (id > 0));",
+				"b.sql": "CREATE TABLE t2 (id INT, CONSTRAINT chk_id CHECK (id > 0));",
+			},
+			wantCodes: map[string][]code.Code{"b.sql": {code.SDLDuplicateConstraintName}},
+		},
+		{
+			name: "Foreign key references missing table and column",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE users (id INT PRIMARY KEY);
+CREATE TABLE orders (
+  id INT PRIMARY KEY,
+  user_id INT,
+  account_id INT,
+  CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (uid),
+  CONSTRAINT fk_account FOREIGN KEY (account_id) REFERENCES accounts (id)
+);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLForeignKeyColumnNotFound, code.SDLForeignKeyTableNotFound}},
+		},
+		{
+			name: "View references missing table",
+			files: map[string]string{
+				"a.sql": "CREATE VIEW v AS SELECT * FROM missing;",
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLViewDependencyNotFound}},
+		},
+		{
+			name: "Syntax error",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE (;",
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.StatementSyntaxError}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := CheckSDLIntegrity(tc.files)
+			require.NoError(t, err)
+			for filePath, advices := range results {
+				var gotCodes []code.Code
+				for _, advice := range advices {
+					gotCodes = append(gotCodes, code.Code(advice.Code))
+				}
+				require.ElementsMatch(t, tc.wantCodes[filePath], gotCodes, "file %s: %v", filePath, advices)
+			}
+		})
+	}
+}
@@ -0,0 +1,546 @@
+package oracle
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/bytebase/parser/plsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	plsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+)
+
+// CheckSDLIntegrity performs integrity checks across Oracle SDL files.
+// It's the Oracle equivalent of the PostgreSQL SDL integrity check, so the files are checked together
+// to validate cross-file references (foreign keys, views) and detect duplicate definitions across files.
+//
+// The unquoted names are normalized to upper case as Oracle does, and the names are compared after
+// the normalization. Index and constraint names are unique per schema.
+//
+// Parameters:
+//   - files: map[filePath]sqlContent - All SDL files to check together.
+//
+// Returns:
+//   - map[filePath][]*storepb.Advice - Per-file advice list
+//   - error - System errors
+func CheckSDLIntegrity(files map[string]string) (map[string][]*storepb.Advice, error) {
+	results := make(map[string][]*storepb.Advice)
+	if len(files) == 0 {
+		return results, nil
+	}
+
+	// Sort the files so that the first definition of a duplicate object is deterministic.
+	symbols := &sdlSymbolTable{}
+	for _, filePath := range slices.Sorted(maps.Keys(files)) {
+		results[filePath] = []*storepb.Advice{}
+		if strings.TrimSpace(files[filePath]) == "" {
+			// The PL/SQL parser rejects the empty text.
+			continue
+		}
+		parseResults, err := plsqlparser.ParsePLSQL(files[filePath])
+		if err != nil {
+			return map[string][]*storepb.Advice{
+				filePath: {{
+					Status:  storepb.Advice_ERROR,
+					Code:    code.StatementSyntaxError.Int32(),
+					Title:   "SQL syntax error",
+					Content: fmt.Sprintf("Failed to parse SQL in file '%s': %v", filePath, err),
+				}},
+			}, nil
+		}
+
+		collector := &sdlSymbolCollector{
+			BasePlSqlParserListener: &plsql.BasePlSqlParserListener{},
+			symbols:                 symbols,
+			filePath:                filePath,
+		}
+		for _, parseResult := range parseResults {
+			collector.baseLine = parseResult.BaseLine
+			antlr.ParseTreeWalkerDefault.Walk(collector, parseResult.Tree)
+		}
+	}
+
+	for _, advice := range symbols.check() {
+		results[advice.filePath] = append(results[advice.filePath], advice.advice)
+	}
+	return results, nil
+}
+
+// sdlLocation is the place where an object is defined.
+type sdlLocation struct {
+	filePath string
+	line     int
+}
+
+type sdlTable struct {
+	sdlLocation
+	name string
+	// columns is the set of the column names.
+	columns     map[string]bool
+	columnNames []string
+	primaryKeys []sdlLocation
+}
+
+type sdlView struct {
+	sdlLocation
+	name string
+	// references are the tables and views referenced by the view, excluding the CTEs.
+	references []string
+}
+
+// sdlNamedObject is an index or a constraint.
+type sdlNamedObject struct {
+	sdlLocation
+	tableName string
+	// name is the schema qualified name if the schema is specified.
+	name string
+}
+
+type sdlForeignKey struct {
+	sdlNamedObject
+	referencedTable   string
+	referencedColumns []string
+}
+
+type sdlFileAdvice struct {
+	filePath string
+	advice   *storepb.Advice
+}
+
+type sdlSymbolTable struct {
+	tables      []*sdlTable
+	views       []*sdlView
+	indexes     []*sdlNamedObject
+	constraints []*sdlNamedObject
+	foreignKeys []*sdlForeignKey
+
+	duplicateColumnAdvices []*sdlFileAdvice
+}
+
+// sdlSymbolCollector collects the objects defined in a SDL file.
+type sdlSymbolCollector struct {
+	*plsql.BasePlSqlParserListener
+
+	symbols  *sdlSymbolTable
+	filePath string
+	baseLine int
+
+	currentTable *sdlTable
+	currentView  *sdlView
+	cteNames     map[string]bool
+}
+
+func (c *sdlSymbolCollector) location(ctx antlr.ParserRuleContext) sdlLocation {
+	return sdlLocation{filePath: c.filePath, line: c.baseLine + ctx.GetStart().GetLine()}
+}
+
+// EnterCreate_table starts collecting the table with its columns and constraints.
+func (c *sdlSymbolCollector) EnterCreate_table(ctx *plsql.Create_tableContext) {
+	if ctx.Table_name() == nil {
+		return
+	}
+	table := &sdlTable{
+		sdlLocation: c.location(ctx),
+		name:        qualifiedName(plsqlparser.NormalizeSchemaName(ctx.Schema_name()), plsqlparser.NormalizeTableName(ctx.Table_name())),
+		columns:     make(map[string]bool),
+	}
+	c.symbols.tables = append(c.symbols.tables, table)
+	c.currentTable = table
+}
+
+// ExitCreate_table finishes collecting the table.
+func (c *sdlSymbolCollector) ExitCreate_table(*plsql.Create_tableContext) {
+	c.currentTable = nil
+}
+
+// EnterColumn_definition collects the column with its inline constraints.
+func (c *sdlSymbolCollector) EnterColumn_definition(ctx *plsql.Column_definitionContext) {
+	if c.currentTable == nil {
+		return
+	}
+	if c.addColumn(ctx, ctx.Column_name()) {
+		c.collectInlineConstraints(ctx.AllInline_constraint())
+	}
+}
+
+// EnterVirtual_column_definition collects the virtual column with its inline constraints.
+func (c *sdlSymbolCollector) EnterVirtual_column_definition(ctx *plsql.Virtual_column_definitionContext) {
+	if c.currentTable == nil {
+		return
+	}
+	if c.addColumn(ctx, ctx.Column_name()) {
+		c.collectInlineConstraints(ctx.AllInline_constraint())
+	}
+}
+
+func (c *sdlSymbolCollector) collectInlineConstraints(constraints []plsql.IInline_constraintContext) {
+	for _, constraint := range constraints {
+		switch {
+		case constraint.PRIMARY() != nil:
+			c.currentTable.primaryKeys = append(c.currentTable.primaryKeys, c.location(constraint))
+			c.addConstraint(constraint, constraint.Constraint_name())
+		case constraint.References_clause() != nil:
+			c.addForeignKey(constraint, constraint.Constraint_name(), constraint.References_clause())
+		default:
+			c.addConstraint(constraint, constraint.Constraint_name())
+		}
+	}
+}
+
+// EnterOut_of_line_constraint collects the table constraint.
+func (c *sdlSymbolCollector) EnterOut_of_line_constraint(ctx *plsql.Out_of_line_constraintContext) {
+	if c.currentTable == nil {
+		return
+	}
+	switch {
+	case ctx.PRIMARY() != nil:
+		c.currentTable.primaryKeys = append(c.currentTable.primaryKeys, c.location(ctx))
+		c.addConstraint(ctx, ctx.Constraint_name())
+	case ctx.Foreign_key_clause() != nil && ctx.Foreign_key_clause().References_clause() != nil:
+		c.addForeignKey(ctx, ctx.Constraint_name(), ctx.Foreign_key_clause().References_clause())
+	default:
+		c.addConstraint(ctx, ctx.Constraint_name())
+	}
+}
+
+// EnterCreate_index collects the standalone index.
+func (c *sdlSymbolCollector) EnterCreate_index(ctx *plsql.Create_indexContext) {
+	if ctx.Index_name() == nil {
+		return
+	}
+	tableName := ""
+	if ctx.Table_index_clause() != nil && ctx.Table_index_clause().Tableview_name() != nil {
+		_, schemaName, name := plsqlparser.NormalizeTableViewName("", ctx.Table_index_clause().Tableview_name())
+		tableName = qualifiedName(schemaName, name)
+	}
+	c.symbols.indexes = append(c.symbols.indexes, &sdlNamedObject{
+		sdlLocation: c.location(ctx),
+		tableName:   tableName,
+		name:        qualifiedName(plsqlparser.NormalizeIndexName(ctx.Index_name())),
+	})
+}
+
+// EnterCreate_view starts collecting the references of the view.
+func (c *sdlSymbolCollector) EnterCreate_view(ctx *plsql.Create_viewContext) {
+	if ctx.GetV() == nil {
+		return
+	}
+	c.enterView(ctx, qualifiedName(plsqlparser.NormalizeSchemaName(ctx.Schema_name()), plsqlparser.NormalizeIDExpression(ctx.GetV())))
+}
+
+// ExitCreate_view finishes collecting the references of the view.
+func (c *sdlSymbolCollector) ExitCreate_view(*plsql.Create_viewContext) {
+	c.exitView()
+}
+
+// EnterCreate_materialized_view starts collecting the references of the materialized view.
+func (c *sdlSymbolCollector) EnterCreate_materialized_view(ctx *plsql.Create_materialized_viewContext) {
+	if ctx.Tableview_name() == nil {
+		return
+	}
+	_, schemaName, name := plsqlparser.NormalizeTableViewName("", ctx.Tableview_name())
+	c.enterView(ctx, qualifiedName(schemaName, name))
+}
+
+// ExitCreate_materialized_view finishes collecting the references of the materialized view.
+func (c *sdlSymbolCollector) ExitCreate_materialized_view(*plsql.Create_materialized_viewContext) {
+	c.exitView()
+}
+
+func (c *sdlSymbolCollector) enterView(ctx antlr.ParserRuleContext, name string) {
+	c.currentView = &sdlView{sdlLocation: c.location(ctx), name: name}
+	c.cteNames = make(map[string]bool)
+}
+
+func (c *sdlSymbolCollector) exitView() {
+	if c.currentView == nil {
+		return
+	}
+	c.currentView.references = slices.DeleteFunc(c.currentView.references, func(name string) bool {
+		return c.cteNames[name]
+	})
+	c.symbols.views = append(c.symbols.views, c.currentView)
+	c.currentView = nil
+	c.cteNames = nil
+}
+
+// EnterFactoring_element collects the CTE names which are not references to tables or views.
+func (c *sdlSymbolCollector) EnterFactoring_element(ctx *plsql.Factoring_elementContext) {
+	if c.currentView == nil || ctx.Query_name() == nil {
+		return
+	}
+	c.cteNames[plsqlparser.NormalizeIdentifierContext(ctx.Query_name().Identifier())] = true
+}
+
+// EnterDml_table_expression_clause collects the tables and views referenced by the current view.
+func (c *sdlSymbolCollector) EnterDml_table_expression_clause(ctx *plsql.Dml_table_expression_clauseContext) {
+	if c.currentView == nil || ctx.Tableview_name() == nil {
+		return
+	}
+	links, schemaName, name := plsqlparser.NormalizeTableViewName("", ctx.Tableview_name())
+	// Tables in other databases are not managed by the SDL.
+	if len(links) > 0 || name == "" || name == "DUAL" {
+		return
+	}
+	reference := qualifiedName(schemaName, name)
+	if !slices.Contains(c.currentView.references, reference) {
+		c.currentView.references = append(c.currentView.references, reference)
+	}
+}
+
+// addColumn adds the column to the current table, and returns false if it's a duplicate.
+func (c *sdlSymbolCollector) addColumn(ctx antlr.ParserRuleContext, columnNameCtx plsql.IColumn_nameContext) bool {
+	_, _, columnName := plsqlparser.NormalizeColumnName(columnNameCtx)
+	if columnName == "" {
+		return false
+	}
+	if c.currentTable.columns[columnName] {
+		c.symbols.addDuplicateColumn(c.currentTable, columnName, c.location(ctx))
+		return false
+	}
+	c.currentTable.columns[columnName] = true
+	c.currentTable.columnNames = append(c.currentTable.columnNames, columnName)
+	return true
+}
+
+// addConstraint adds the named constraint of the current table. The unnamed constraints get the system generated names.
+func (c *sdlSymbolCollector) addConstraint(ctx antlr.ParserRuleContext, constraintName plsql.IConstraint_nameContext) {
+	name := qualifiedName(plsqlparser.NormalizeConstraintName(constraintName))
+	if name == "" {
+		return
+	}
+	c.symbols.constraints = append(c.symbols.constraints, &sdlNamedObject{sdlLocation: c.location(ctx), tableName: c.currentTable.name, name: name})
+}
+
+func (c *sdlSymbolCollector) addForeignKey(ctx antlr.ParserRuleContext, constraintName plsql.IConstraint_nameContext, references plsql.IReferences_clauseContext) {
+	fk := &sdlForeignKey{
+		sdlNamedObject: sdlNamedObject{
+			sdlLocation: c.location(ctx),
+			tableName:   c.currentTable.name,
+			name:        qualifiedName(plsqlparser.NormalizeConstraintName(constraintName)),
+		},
+	}
+	if references.Tableview_name() != nil {
+		_, schemaName, name := plsqlparser.NormalizeTableViewName("", references.Tableview_name())
+		fk.referencedTable = qualifiedName(schemaName, name)
+		if references.Paren_column_list() != nil && references.Paren_column_list().Column_list() != nil {
+			for _, column := range references.Paren_column_list().Column_list().AllColumn_name() {
+				_, _, columnName := plsqlparser.NormalizeColumnName(column)
+				fk.referencedColumns = append(fk.referencedColumns, columnName)
+			}
+		}
+		c.symbols.foreignKeys = append(c.symbols.foreignKeys, fk)
+	}
+	if fk.name != "" {
+		c.symbols.constraints = append(c.symbols.constraints, &fk.sdlNamedObject)
+	}
+}
+
+// qualifiedName returns the name qualified with the schema if the schema is specified.
+func qualifiedName(schemaName, name string) string {
+	if schemaName == "" || name == "" {
+		return name
+	}
+	return schemaName + "." + name
+}
+
+// check validates the collected objects.
+func (s *sdlSymbolTable) check() []*sdlFileAdvice {
+	var advices []*sdlFileAdvice
+	advices = append(advices, s.duplicateColumnAdvices...)
+
+	// Tables and views share the same namespace.
+	seenTables := make(map[string]sdlLocation)
+	tables := make(map[string]*sdlTable)
+	for _, table := range s.tables {
+		if first, exists := seenTables[table.name]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateTableName, "table", fmt.Sprintf("Table '%s'", table.name), first, table.sdlLocation,
+				"Each table can only be defined once."))
+			continue
+		}
+		seenTables[table.name] = table.sdlLocation
+		tables[table.name] = table
+
+		if len(table.primaryKeys) > 1 {
+			var descriptions []string
+			for i, pk := range table.primaryKeys {
+				descriptions = append(descriptions, fmt.Sprintf("  %d. %s (line %d)", i+1, pk.filePath, pk.line))
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: table.primaryKeys[1].filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLMultiplePrimaryKey.Int32(),
+					Title:  "Multiple primary keys defined",
+					Content: fmt.Sprintf(
+						"Table '%s' has multiple PRIMARY KEY definitions.\n\n"+
+							"Found %d primary key definitions:\n%s\n\n"+
+							"A table can only have one PRIMARY KEY.\n"+
+							"If you need to enforce uniqueness on multiple column combinations, use UNIQUE constraints instead.",
+						table.name, len(table.primaryKeys), strings.Join(descriptions, "\n"),
+					),
+					StartPosition: &storepb.Position{Line: int32(table.primaryKeys[1].line)},
+				},
+			})
+		}
+	}
+	views := make(map[string]*sdlView)
+	for _, view := range s.views {
+		if first, exists := seenTables[view.name]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateTableName, "view", fmt.Sprintf("View '%s'", view.name), first, view.sdlLocation,
+				"Tables and views share the same namespace, so each name can only be defined once."))
+			continue
+		}
+		seenTables[view.name] = view.sdlLocation
+		views[view.name] = view
+	}
+
+	// Index names are unique per schema.
+	seenIndexes := make(map[string]sdlLocation)
+	for _, index := range s.indexes {
+		if first, exists := seenIndexes[index.name]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateIndexName, "index", fmt.Sprintf("Index '%s' on table '%s'", index.name, index.tableName), first, index.sdlLocation,
+				"Index names must be unique within the schema."))
+			continue
+		}
+		seenIndexes[index.name] = index.sdlLocation
+	}
+
+	// Constraint names are unique per schema.
+	seenConstraints := make(map[string]sdlLocation)
+	for _, constraint := range s.constraints {
+		if first, exists := seenConstraints[constraint.name]; exists {
+			advices = append(advices, duplicateAdvice(code.SDLDuplicateConstraintName, "constraint", fmt.Sprintf("Constraint '%s' on table '%s'", constraint.name, constraint.tableName), first, constraint.sdlLocation,
+				"Constraint names must be unique within the schema."))
+			continue
+		}
+		seenConstraints[constraint.name] = constraint.sdlLocation
+	}
+
+	for _, fk := range s.foreignKeys {
+		refTable := tables[fk.referencedTable]
+		if refTable == nil {
+			advices = append(advices, &sdlFileAdvice{
+				filePath: fk.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLForeignKeyTableNotFound.Int32(),
+					Title:  "Foreign key references non-existent table",
+					Content: fmt.Sprintf(
+						"Foreign key %s on table '%s' references table '%s' which does not exist in any SDL file.\n\n"+
+							"Make sure the referenced table is defined in one of the SDL files.",
+						foreignKeyDisplayName(fk), fk.tableName, fk.referencedTable,
+					),
+					StartPosition: &storepb.Position{Line: int32(fk.line)},
+				},
+			})
+			continue
+		}
+		for _, column := range fk.referencedColumns {
+			if refTable.columns[column] {
+				continue
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: fk.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLForeignKeyColumnNotFound.Int32(),
+					Title:  "Foreign key references non-existent column",
+					Content: fmt.Sprintf(
+						"Foreign key %s on table '%s' references column '%s' in table '%s', but this column does not exist.\n\n"+
+							"Available columns in '%s': %s",
+						foreignKeyDisplayName(fk), fk.tableName, column, refTable.name,
+						refTable.name, strings.Join(refTable.columnNames, ", "),
+					),
+					StartPosition: &storepb.Position{Line: int32(fk.line)},
+				},
+			})
+		}
+	}
+
+	for _, view := range s.views {
+		if views[view.name] != view {
+			// The duplicate view is already reported.
+			continue
+		}
+		for _, reference := range view.references {
+			if tables[reference] != nil || views[reference] != nil {
+				continue
+			}
+			advices = append(advices, &sdlFileAdvice{
+				filePath: view.filePath,
+				advice: &storepb.Advice{
+					Status: storepb.Advice_ERROR,
+					Code:   code.SDLViewDependencyNotFound.Int32(),
+					Title:  "View references non-existent table or view",
+					Content: fmt.Sprintf(
+						"View '%s' (line %d) references table or view '%s' which does not exist in any SDL file.\n\n"+
+							"Views must reference tables or views that are defined in the SDL project.\n\n"+
+							"Fix: Define table or view '%s' in one of the SDL files, or remove the view if the object is external.",
+						view.name, view.line, reference, reference,
+					),
+					StartPosition: &storepb.Position{Line: int32(view.line)},
+				},
+			})
+		}
+	}
+	return advices
+}
+
+func (s *sdlSymbolTable) addDuplicateColumn(table *sdlTable, columnName string, location sdlLocation) {
+	s.duplicateColumnAdvices = append(s.duplicateColumnAdvices, &sdlFileAdvice{
+		filePath: location.filePath,
+		advice: &storepb.Advice{
+			Status: storepb.Advice_ERROR,
+			Code:   code.SDLDuplicateColumnName.Int32(),
+			Title:  "Duplicate column name",
+			Content: fmt.Sprintf(
+				"Column '%s' is defined multiple times in table '%s'.\n\n"+
+					"Each column can only be defined once per table.",
+				columnName, table.name,
+			),
+			StartPosition: &storepb.Position{Line: int32(location.line)},
+		},
+	})
+}
+
+// duplicateAdvice returns the advice for the duplicate definition. The title tells whether the definitions are in different files.
+func duplicateAdvice(c code.Code, objectType, object string, first, duplicate sdlLocation, rule string) *sdlFileAdvice {
+	title := fmt.Sprintf("Duplicate %s name", objectType)
+	content := fmt.Sprintf(
+		"%s is defined multiple times in the SDL.\n\n"+
+			"First definition at line %d\n"+
+			"Duplicate definition at line %d\n\n%s",
+		object, first.line, duplicate.line, rule,
+	)
+	if first.filePath != duplicate.filePath {
+		title = fmt.Sprintf("Duplicate %s name across files", objectType)
+		content = fmt.Sprintf(
+			"%s is defined in multiple SDL files.\n\n"+
+				"First definition: %s (line %d)\n"+
+				"Duplicate definition: %s (line %d)\n\n%s",
+			object, first.filePath, first.line, duplicate.filePath, duplicate.line, rule,
+		)
+	}
+	return &sdlFileAdvice{
+		filePath: duplicate.filePath,
+		advice: &storepb.Advice{
+			Status:        storepb.Advice_ERROR,
+			Code:          c.Int32(),
+			Title:         title,
+			Content:       content,
+			StartPosition: &storepb.Position{Line: int32(duplicate.line)},
+		},
+	}
+}
+
+func foreignKeyDisplayName(fk *sdlForeignKey) string {
+	if fk.name == "" {
+		return "<unnamed>"
+	}
+	return fmt.Sprintf("'%s'", fk.name)
+}
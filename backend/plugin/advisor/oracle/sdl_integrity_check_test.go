@@ -0,0 +1,132 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+)
+
+func TestCheckSDLIntegrity(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantCodes map[string][]code.Code
+	}{
+		{
+			name: "Valid schema",
+			files: map[string]string{
+				"tables/USERS.sql": `
+CREATE TABLE "USERS" (
+  "ID" NUMBER NOT NULL,
+  "EMAIL" VARCHAR2(255) NOT NULL,
+  CONSTRAINT "PK_USERS" PRIMARY KEY ("ID"),
+  CONSTRAINT "UK_USERS_EMAIL" UNIQUE ("EMAIL")
+);`,
+				"tables/ORDERS.sql": `
+CREATE TABLE orders (
+  id NUMBER PRIMARY KEY,
+  user_id NUMBER NOT NULL CONSTRAINT fk_orders_user REFERENCES users (id)
+);
+CREATE INDEX idx_orders_user ON orders (user_id);`,
+				"views/V_ORDERS.sql": `
+CREATE VIEW v_orders AS
+WITH recent AS (SELECT * FROM orders)
+SELECT r.id, u.email FROM recent r JOIN "USERS" u ON r.user_id = u.id;`,
+			},
+			wantCodes: map[string][]code.Code{},
+		},
+		{
+			name: "Duplicate table across files",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t (id NUMBER);",
+				"b.sql": `CREATE TABLE "T" (id NUMBER);`,
+			},
+			wantCodes: map[string][]code.Code{"b.sql": {code.SDLDuplicateTableName}},
+		},
+		{
+			name: "Quoted names are case sensitive",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE t (id NUMBER);",
+				"b.sql": `CREATE TABLE "t" (id NUMBER);`,
+			},
+			wantCodes: map[string][]code.Code{},
+		},
+		{
+			name: "Duplicate column and multiple primary keys",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE t (
+  id NUMBER PRIMARY KEY,
+  "ID" NUMBER,
+  name VARCHAR2(10),
+  PRIMARY KEY (name)
+);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLDuplicateColumnName, code.SDLMultiplePrimaryKey}},
+		},
+		{
+			name: "Duplicate index name in the schema",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE t1 (id NUMBER);
+CREATE TABLE t2 (id NUMBER);
+CREATE INDEX idx_id ON t1 (id);
+CREATE INDEX IDX_ID ON t2 (id);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLDuplicateIndexName}},
+		},
+		{
+			name: "Duplicate constraint name in the schema",
+			files: map[string]string{
+				"a.sql": `CREATE TABLE t1 (id NUMBER, CONSTRAINT "CHK_ID" CHECK (id > 0));`,
+				"b.sql": "CREATE TABLE t2 (id NUMBER CONSTRAINT chk_id CHECK (id > 0));",
+			},
+			wantCodes: map[string][]code.Code{"b.sql": {code.SDLDuplicateConstraintName}},
+		},
+		{
+			name: "Foreign key references missing table and column",
+			files: map[string]string{
+				"a.sql": `
+CREATE TABLE users (id NUMBER PRIMARY KEY);
+CREATE TABLE orders (
+  id NUMBER PRIMARY KEY,
+  user_id NUMBER,
+  account_id NUMBER,
+  CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES users (uid),
+  CONSTRAINT fk_account FOREIGN KEY (account_id) REFERENCES accounts (id)
+);`,
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLForeignKeyColumnNotFound, code.SDLForeignKeyTableNotFound}},
+		},
+		{
+			name: "View references missing table",
+			files: map[string]string{
+				"a.sql": "CREATE VIEW v AS SELECT * FROM missing;",
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.SDLViewDependencyNotFound}},
+		},
+		{
+			name: "Syntax error",
+			files: map[string]string{
+				"a.sql": "CREATE TABLE (;",
+			},
+			wantCodes: map[string][]code.Code{"a.sql": {code.StatementSyntaxError}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := CheckSDLIntegrity(tc.files)
+			require.NoError(t, err)
+			for filePath, advices := range results {
+				var gotCodes []code.Code
+				for _, advice := range advices {
+					gotCodes = append(gotCodes, code.Code(advice.Code))
+				}
+				require.ElementsMatch(t, tc.wantCodes[filePath], gotCodes, "file %s: %v", filePath, advices)
+			}
+		})
+	}
+}
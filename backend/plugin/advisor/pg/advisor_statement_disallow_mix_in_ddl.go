@@ -27,7 +27,7 @@ type StatementDisallowMixInDDLAdvisor struct {
 func (*StatementDisallowMixInDDLAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
 	// Only check when change type is DDL
 	switch checkCtx.ChangeType {
-	case storepb.PlanCheckRunConfig_DDL, storepb.PlanCheckRunConfig_SDL, storepb.PlanCheckRunConfig_DDL_GHOST:
+	case storepb.PlanCheckRunConfig_DDL, storepb.PlanCheckRunConfig_SDL, storepb.PlanCheckRunConfig_DDL_GHOST, storepb.PlanCheckRunConfig_DDL_PG_ONLINE:
 	default:
 		return nil, nil
 	}
@@ -0,0 +1,158 @@
+// Package redshift is the advisor for redshift database.
+package redshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/redshift"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	redshiftparser "github.com/bytebase/bytebase/backend/plugin/parser/redshift"
+)
+
+const (
+	defaultSchema = "public"
+)
+
+var (
+	_ advisor.Advisor = (*BuiltinPriorBackupCheckAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_REDSHIFT, advisor.BuiltinRulePriorBackupCheck, &BuiltinPriorBackupCheckAdvisor{})
+}
+
+// BuiltinPriorBackupCheckAdvisor is the advisor checking for the prior backup requirements.
+type BuiltinPriorBackupCheckAdvisor struct {
+}
+
+// Check checks for the prior backup requirements.
+func (*BuiltinPriorBackupCheckAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	if !checkCtx.EnablePriorBackup || checkCtx.ChangeType != storepb.PlanCheckRunConfig_DML {
+		return nil, nil
+	}
+
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to ANTLR Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &priorBackupCheckListener{
+		level:          level,
+		title:          string(checkCtx.Rule.Type),
+		statementTypes: make(map[string][]string),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+	adviceList := listener.adviceList
+
+	// Check if backup schema exists.
+	schemaName := common.BackupDatabaseNameOfEngine(storepb.Engine_REDSHIFT)
+	if checkCtx.OriginalMetadata.GetSchemaMetadata(schemaName) == nil {
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Title:   listener.title,
+			Content: fmt.Sprintf("Need schema %q to do prior backup but it does not exist", schemaName),
+			Code:    code.SchemaNotExists.Int32(),
+		})
+	}
+
+	// Check if the statement type is the same for all statements on the same table.
+	for _, key := range listener.tables {
+		types := listener.statementTypes[key]
+		for _, tp := range types {
+			if tp != types[0] {
+				adviceList = append(adviceList, &storepb.Advice{
+					Status:  level,
+					Title:   listener.title,
+					Content: fmt.Sprintf("The statement type is not the same for all statements on the same table %q", key),
+					Code:    code.BuiltinPriorBackupCheck.Int32(),
+				})
+				break
+			}
+		}
+	}
+
+	return adviceList, nil
+}
+
+type priorBackupCheckListener struct {
+	*parser.BaseRedshiftParserListener
+
+	adviceList []*storepb.Advice
+	level      storepb.Advice_Status
+	title      string
+
+	// tables is the list of the updated or deleted tables in order.
+	tables []string
+	// statementTypes is the statement types by table.
+	statementTypes map[string][]string
+}
+
+// EnterStmt is called when entering a stmt rule.
+func (l *priorBackupCheckListener) EnterStmt(ctx *parser.StmtContext) {
+	if !isTopLevel(ctx.GetParent()) {
+		return
+	}
+
+	switch {
+	case ctx.Updatestmt() != nil:
+		l.addTable(ctx.Updatestmt().Relation_expr_opt_alias(), "UPDATE")
+	case ctx.Deletestmt() != nil:
+		l.addTable(ctx.Deletestmt().Relation_expr_opt_alias(), "DELETE")
+	case ctx.Insertstmt() != nil, ctx.Selectstmt() != nil, ctx.Variablesetstmt() != nil, ctx.Variableresetstmt() != nil:
+		// Allowed statements.
+	default:
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:        l.level,
+			Code:          code.BuiltinPriorBackupCheck.Int32(),
+			Title:         l.title,
+			Content:       fmt.Sprintf("Data change can only run DML, %q is not DML", ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)),
+			StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+		})
+	}
+}
+
+func (l *priorBackupCheckListener) addTable(ctx parser.IRelation_expr_opt_aliasContext, statementType string) {
+	if ctx == nil || ctx.Relation_expr() == nil {
+		return
+	}
+	list := redshiftparser.NormalizeRedshiftQualifiedName(ctx.Relation_expr().Qualified_name())
+	var key string
+	switch len(list) {
+	case 3:
+		key = fmt.Sprintf("%s.%s", list[1], list[2])
+	case 2:
+		key = fmt.Sprintf("%s.%s", list[0], list[1])
+	case 1:
+		key = fmt.Sprintf("%s.%s", defaultSchema, list[0])
+	default:
+		return
+	}
+	if _, ok := l.statementTypes[key]; !ok {
+		l.tables = append(l.tables, key)
+	}
+	l.statementTypes[key] = append(l.statementTypes[key], statementType)
+}
+
+// isTopLevel returns whether the statement is not nested in other statements.
+func isTopLevel(ctx antlr.Tree) bool {
+	switch ctx := ctx.(type) {
+	case *parser.RootContext, *parser.StmtblockContext, nil:
+		return true
+	case *parser.StmtmultiContext:
+		return isTopLevel(ctx.GetParent())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,19 @@
+package redshift
+
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+)
+
+func TestRedshiftRules(t *testing.T) {
+	redshiftRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleTableDropNamingConvention,
+		advisor.BuiltinRulePriorBackupCheck,
+	}
+
+	for _, rule := range redshiftRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_REDSHIFT, false, false /* record */)
+	}
+}
@@ -0,0 +1,183 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/snowflake"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+)
+
+var (
+	_ advisor.Advisor = (*BuiltinPriorBackupCheckAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_SNOWFLAKE, advisor.BuiltinRulePriorBackupCheck, &BuiltinPriorBackupCheckAdvisor{})
+}
+
+// BuiltinPriorBackupCheckAdvisor is the advisor checking for the prior backup requirements.
+type BuiltinPriorBackupCheckAdvisor struct {
+}
+
+// Check checks for the prior backup requirements.
+func (*BuiltinPriorBackupCheckAdvisor) Check(_ context.Context, checkCtx advisor.Context) ([]*storepb.Advice, error) {
+	if !checkCtx.EnablePriorBackup || checkCtx.ChangeType != storepb.PlanCheckRunConfig_DML {
+		return nil, nil
+	}
+
+	tree, ok := checkCtx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(checkCtx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := NewBuiltinPriorBackupCheckRule(level, string(checkCtx.Rule.Type))
+	checker := NewGenericChecker([]Rule{rule})
+
+	antlr.ParseTreeWalkerDefault.Walk(checker, tree)
+
+	adviceList := checker.GetAdviceList()
+
+	schemaName := common.BackupDatabaseNameOfEngine(storepb.Engine_SNOWFLAKE)
+	if checkCtx.OriginalMetadata.GetSchemaMetadata(schemaName) == nil {
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Title:   string(checkCtx.Rule.Type),
+			Content: fmt.Sprintf("Need schema %q to do prior backup but it does not exist", schemaName),
+			Code:    code.SchemaNotExists.Int32(),
+		})
+	}
+
+	adviceList = append(adviceList, rule.checkStatementType()...)
+	return adviceList, nil
+}
+
+type priorBackupStatementType int
+
+const (
+	priorBackupStatementTypeUpdate priorBackupStatementType = iota + 1
+	priorBackupStatementTypeDelete
+)
+
+// BuiltinPriorBackupCheckRule checks that the statements only contain DML and
+// the statements on the same table share the same type.
+type BuiltinPriorBackupCheckRule struct {
+	BaseRule
+
+	// tables is the list of the updated or deleted tables in order.
+	tables []string
+	// statementTypes is the statement types by table.
+	statementTypes map[string][]priorBackupStatementType
+}
+
+// NewBuiltinPriorBackupCheckRule creates a new BuiltinPriorBackupCheckRule.
+func NewBuiltinPriorBackupCheckRule(level storepb.Advice_Status, title string) *BuiltinPriorBackupCheckRule {
+	return &BuiltinPriorBackupCheckRule{
+		BaseRule: BaseRule{
+			level: level,
+			title: title,
+		},
+		statementTypes: make(map[string][]priorBackupStatementType),
+	}
+}
+
+// Name returns the rule name.
+func (*BuiltinPriorBackupCheckRule) Name() string {
+	return "BuiltinPriorBackupCheckRule"
+}
+
+// OnEnter is called when entering a parse tree node.
+func (r *BuiltinPriorBackupCheckRule) OnEnter(ctx antlr.ParserRuleContext, nodeType string) error {
+	switch nodeType {
+	case NodeTypeDdlCommand:
+		r.enterDdlCommand(ctx.(*parser.Ddl_commandContext))
+	case NodeTypeUpdateStatement:
+		c := ctx.(*parser.Update_statementContext)
+		if isTopLevelDML(c.GetParent()) {
+			r.addTable(c.Object_name(), priorBackupStatementTypeUpdate)
+		}
+	case NodeTypeDeleteStatement:
+		c := ctx.(*parser.Delete_statementContext)
+		if isTopLevelDML(c.GetParent()) {
+			r.addTable(c.Object_name(), priorBackupStatementTypeDelete)
+		}
+	default:
+		// Ignore other node types
+	}
+	return nil
+}
+
+// OnExit is called when exiting a parse tree node.
+func (*BuiltinPriorBackupCheckRule) OnExit(_ antlr.ParserRuleContext, _ string) error {
+	// This rule doesn't need exit processing
+	return nil
+}
+
+func (r *BuiltinPriorBackupCheckRule) enterDdlCommand(ctx *parser.Ddl_commandContext) {
+	r.AddAdvice(&storepb.Advice{
+		Status:        r.level,
+		Code:          code.BuiltinPriorBackupCheck.Int32(),
+		Title:         r.title,
+		Content:       fmt.Sprintf("Data change can only run DML, %q is not DML", ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx)),
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	})
+}
+
+func (r *BuiltinPriorBackupCheckRule) addTable(ctx parser.IObject_nameContext, statementType priorBackupStatementType) {
+	schema := "PUBLIC"
+	if s := ctx.GetS(); s != nil {
+		schema = snowsqlparser.NormalizeSnowSQLObjectNamePart(s)
+	}
+	key := fmt.Sprintf("%s.%s", schema, snowsqlparser.NormalizeSnowSQLObjectNamePart(ctx.GetO()))
+	if d := ctx.GetD(); d != nil {
+		key = fmt.Sprintf("%s.%s", snowsqlparser.NormalizeSnowSQLObjectNamePart(d), key)
+	}
+	if _, ok := r.statementTypes[key]; !ok {
+		r.tables = append(r.tables, key)
+	}
+	r.statementTypes[key] = append(r.statementTypes[key], statementType)
+}
+
+// checkStatementType checks if the statement type is the same for all statements on the same table.
+func (r *BuiltinPriorBackupCheckRule) checkStatementType() []*storepb.Advice {
+	var adviceList []*storepb.Advice
+	for _, key := range r.tables {
+		types := r.statementTypes[key]
+		for _, tp := range types {
+			if tp != types[0] {
+				adviceList = append(adviceList, &storepb.Advice{
+					Status:  r.level,
+					Title:   r.title,
+					Content: fmt.Sprintf("The statement type is not the same for all statements on the same table %q", key),
+					Code:    code.BuiltinPriorBackupCheck.Int32(),
+				})
+				break
+			}
+		}
+	}
+	return adviceList
+}
+
+// isTopLevelDML returns whether the DML statement is not nested in other statements.
+func isTopLevelDML(ctx antlr.Tree) bool {
+	switch ctx := ctx.(type) {
+	case *parser.Dml_commandContext, *parser.Sql_commandContext, *parser.BatchContext:
+		return isTopLevelDML(ctx.GetParent())
+	case *parser.Snowflake_fileContext, nil:
+		return true
+	default:
+		return false
+	}
+}
@@ -24,6 +24,7 @@ func TestSnowflakeRules(t *testing.T) {
 		advisor.SchemaRuleStatementNoSelectAll,
 		advisor.SchemaRuleTableDropNamingConvention,
 		advisor.SchemaRuleSchemaBackwardCompatibility,
+		advisor.BuiltinRulePriorBackupCheck,
 	}
 
 	for _, rule := range snowflakeRules {
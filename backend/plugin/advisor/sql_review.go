@@ -16,7 +16,9 @@ import (
 	"github.com/bytebase/bytebase/backend/store/model"
 
 	// Register walk-through implementations
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/mssql"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/tidb"
 )
@@ -538,7 +540,7 @@ func SQLReviewCheck(
 
 	if !builtinOnly && checkContext.FinalMetadata != nil {
 		switch checkContext.DBType {
-		case storepb.Engine_TIDB, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES, storepb.Engine_OCEANBASE:
+		case storepb.Engine_TIDB, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_POSTGRES, storepb.Engine_OCEANBASE, storepb.Engine_ORACLE, storepb.Engine_MSSQL:
 			if advice := schema.WalkThrough(checkContext.DBType, checkContext.FinalMetadata, asts); advice != nil {
 				return []*storepb.Advice{advice}, nil
 			}
@@ -122,6 +122,32 @@ var (
 			},
 		},
 	}
+	// MockOracleDatabase is the mock Oracle database for test.
+	MockOracleDatabase = &storepb.DatabaseSchemaMetadata{
+		Name: "TEST_DB",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				// Oracle syncs the objects of the current database into the schema whose name is empty.
+				Name: "",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "TECH_BOOK",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER"},
+							{Name: "NAME", Type: "VARCHAR2(255)", Nullable: true},
+							{Name: "AUTHOR_ID", Type: "NUMBER", Nullable: true},
+						},
+					},
+					{
+						Name: "AUTHOR",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Type: "NUMBER"},
+						},
+					},
+				},
+			},
+		},
+	}
 	MockMSSQLDatabase = &storepb.DatabaseSchemaMetadata{
 		Name: "master",
 		Schemas: []*storepb.SchemaMetadata{
@@ -130,6 +156,15 @@ var (
 				Tables: []*storepb.TableMetadata{
 					{
 						Name: "pokes",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "c1", Type: "int"},
+							{Name: "c2", Type: "int"},
+							{Name: "c3", Type: "int"},
+							{Name: "c10", Type: "int"},
+							{Name: "c20", Type: "int"},
+							{Name: "c100", Type: "int"},
+							{Name: "c200", Type: "int"},
+						},
 						Indexes: []*storepb.IndexMetadata{
 							{
 								Name:        "idx_0",
@@ -143,8 +178,40 @@ var (
 					},
 					{Name: "pokes2"},
 					{Name: "pokes3"},
+					{
+						Name: "MyTable",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "Id", Type: "int"},
+							{Name: "Name", Type: "varchar(50)"},
+							{Name: "a", Type: "varchar(10)", Nullable: true},
+							{Name: "MyColumnOne", Type: "int", Nullable: true},
+							{Name: "MyColumnTwo", Type: "int", Nullable: true},
+							{Name: "MyColumnThree", Type: "int", Nullable: true},
+							{Name: "MyColumnFour", Type: "int", Nullable: true},
+							{Name: "creator_id", Type: "int", Nullable: true},
+							{Name: "created_ts", Type: "datetime", Nullable: true},
+						},
+					},
+					{
+						Name: "MyTableTwo",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "MyColumnTwo", Type: "int"},
+						},
+					},
+					{Name: "Foo"},
+					{Name: "Bar_delete"},
+					{
+						Name: "tech_book",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "int"},
+							{Name: "a", Type: "int", Nullable: true},
+						},
+					},
 				},
 			},
+			{
+				Name: "MySchema",
+			},
 		},
 	}
 )
@@ -200,15 +267,21 @@ func RunSQLReviewRuleTest(t *testing.T, rule SQLReviewRuleType, dbType storepb.E
 		// Use the schemaMetadata if available, otherwise use mock database for catalog creation
 		catalogMetadata := schemaMetadata
 		if catalogMetadata == nil {
-			if dbType == storepb.Engine_POSTGRES {
+			switch dbType {
+			case storepb.Engine_POSTGRES:
 				catalogMetadata = MockPostgreSQLDatabase
-			} else {
+			case storepb.Engine_MSSQL:
+				curDB = "master"
+				catalogMetadata = MockMSSQLDatabase
+			case storepb.Engine_ORACLE:
+				catalogMetadata = MockOracleDatabase
+			default:
 				catalogMetadata = MockMySQLDatabase
 			}
 		}
 
 		isCaseSensitive := false
-		if dbType == storepb.Engine_POSTGRES {
+		if dbType == storepb.Engine_POSTGRES || dbType == storepb.Engine_ORACLE {
 			isCaseSensitive = true
 		}
 
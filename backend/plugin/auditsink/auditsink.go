@@ -0,0 +1,61 @@
+// Package auditsink delivers the audit logs to the external SIEM sinks.
+package auditsink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// sendTimeout is the timeout of delivering a batch of audit logs to the sink.
+const sendTimeout = 30 * time.Second
+
+// Sink delivers the audit logs to an external SIEM.
+type Sink interface {
+	// Send delivers the audit logs in the order of their IDs.
+	// The whole batch is delivered again if it fails, so the sink may receive duplicates.
+	Send(ctx context.Context, logs []*store.AuditLog) error
+}
+
+// New creates the sink from the setting.
+func New(setting *storepb.AuditLogSinkSetting_Sink) (Sink, error) {
+	switch setting.GetType() {
+	case storepb.AuditLogSinkSetting_Sink_SYSLOG:
+		return &syslogSink{endpoint: setting.GetEndpoint(), tls: setting.GetTls()}, nil
+	case storepb.AuditLogSinkSetting_Sink_HTTP:
+		if setting.GetFormat() == storepb.AuditLogSinkSetting_Sink_SPLUNK_HEC && setting.GetToken() == "" {
+			return nil, errors.New("token is required for Splunk HTTP Event Collector")
+		}
+		return &httpSink{endpoint: setting.GetEndpoint(), token: setting.GetToken(), format: setting.GetFormat(), client: &http.Client{Timeout: sendTimeout}}, nil
+	case storepb.AuditLogSinkSetting_Sink_OTLP:
+		return &otlpSink{endpoint: setting.GetEndpoint(), token: setting.GetToken(), client: &http.Client{Timeout: sendTimeout}}, nil
+	default:
+		return nil, errors.Errorf("unsupported audit log sink type %v", setting.GetType())
+	}
+}
+
+// Entry is the JSON representation of the audit log delivered to the sinks.
+type Entry struct {
+	ID         int             `json:"id"`
+	CreateTime string          `json:"createTime"`
+	AuditLog   json.RawMessage `json:"auditLog"`
+}
+
+func newEntry(l *store.AuditLog) (*Entry, error) {
+	auditLog, err := protojson.Marshal(l.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal audit log %d", l.ID)
+	}
+	return &Entry{
+		ID:         l.ID,
+		CreateTime: l.CreatedAt.UTC().Format(time.RFC3339Nano),
+		AuditLog:   auditLog,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package auditsink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func newTestAuditLogs() []*store.AuditLog {
+	return []*store.AuditLog{
+		{
+			ID:        101,
+			CreatedAt: time.Date(2026, 1, 2, 12, 0, 0, 123456000, time.UTC),
+			Payload: &storepb.AuditLog{
+				Parent:   "projects/p1",
+				Method:   "/bytebase.v1.SQLService/Query",
+				Resource: "instances/i1/databases/d1",
+				User:     "users/101",
+				Severity: storepb.AuditLog_INFO,
+			},
+		},
+		{
+			ID:        102,
+			CreatedAt: time.Date(2026, 1, 2, 12, 0, 1, 0, time.UTC),
+			Payload: &storepb.AuditLog{
+				Parent:   "workspaces/-",
+				Method:   "/bytebase.v1.SettingService/UpdateSetting",
+				Severity: storepb.AuditLog_WARNING,
+			},
+		},
+	}
+}
+
+func TestFormatSyslogFrame(t *testing.T) {
+	a := require.New(t)
+	frame, err := formatSyslogFrame("bb host", newTestAuditLogs()[0])
+	a.NoError(err)
+
+	length, msg, ok := strings.Cut(string(frame), " ")
+	a.True(ok)
+	a.Equal(length, strconv.Itoa(len(msg)))
+	// The facility is 13 (log audit) and the severity is 6 (informational).
+	a.True(strings.HasPrefix(msg, "<110>1 2026-01-02T12:00:00.123456Z bbhost bytebase - audit - {"), msg)
+
+	var entry Entry
+	a.NoError(json.Unmarshal([]byte(msg[strings.Index(msg, "{"):]), &entry))
+	a.Equal(101, entry.ID)
+	a.Equal("2026-01-02T12:00:00.123456Z", entry.CreateTime)
+	auditLog := &storepb.AuditLog{}
+	a.NoError(protojson.Unmarshal(entry.AuditLog, auditLog))
+	a.Equal("/bytebase.v1.SQLService/Query", auditLog.Method)
+}
+
+func TestHTTPSink(t *testing.T) {
+	a := require.New(t)
+	var authorization string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	sink, err := New(&storepb.AuditLogSinkSetting_Sink{Type: storepb.AuditLogSinkSetting_Sink_HTTP, Endpoint: server.URL, Token: "secret"})
+	a.NoError(err)
+	a.NoError(sink.Send(context.Background(), newTestAuditLogs()))
+	a.Equal("Bearer secret", authorization)
+	var entries []*Entry
+	a.NoError(json.Unmarshal(body, &entries))
+	a.Len(entries, 2)
+	a.Equal(102, entries[1].ID)
+
+	sink, err = New(&storepb.AuditLogSinkSetting_Sink{Type: storepb.AuditLogSinkSetting_Sink_HTTP, Endpoint: server.URL, Token: "secret", Format: storepb.AuditLogSinkSetting_Sink_SPLUNK_HEC})
+	a.NoError(err)
+	a.NoError(sink.Send(context.Background(), newTestAuditLogs()))
+	a.Equal("Splunk secret", authorization)
+	// The events are concatenated as a batch.
+	decoder := json.NewDecoder(strings.NewReader(string(body)))
+	var events []*splunkEvent
+	for decoder.More() {
+		var event splunkEvent
+		a.NoError(decoder.Decode(&event))
+		events = append(events, &event)
+	}
+	a.Len(events, 2)
+	a.Equal("bytebase:audit", events[0].SourceType)
+	a.InDelta(1767355200.123456, events[0].Time, 1e-6)
+	a.Equal(101, events[0].Event.ID)
+}
+
+func TestSplunkSinkWithoutToken(t *testing.T) {
+	a := require.New(t)
+	_, err := New(&storepb.AuditLogSinkSetting_Sink{Type: storepb.AuditLogSinkSetting_Sink_HTTP, Endpoint: "http://localhost:8088", Format: storepb.AuditLogSinkSetting_Sink_SPLUNK_HEC})
+	a.Error(err)
+}
+
+func TestHTTPSinkError(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink, err := New(&storepb.AuditLogSinkSetting_Sink{Type: storepb.AuditLogSinkSetting_Sink_HTTP, Endpoint: server.URL})
+	a.NoError(err)
+	a.Error(sink.Send(context.Background(), newTestAuditLogs()))
+}
+
+func TestOTLPRequest(t *testing.T) {
+	a := require.New(t)
+	request, err := newOTLPRequest("host", newTestAuditLogs())
+	a.NoError(err)
+	body, err := otlpMarshaler.Marshal(request)
+	a.NoError(err)
+
+	var got struct {
+		ResourceLogs []struct {
+			ScopeLogs []struct {
+				Scope struct {
+					Name string `json:"name"`
+				} `json:"scope"`
+				LogRecords []struct {
+					TimeUnixNano   string `json:"timeUnixNano"`
+					SeverityNumber int    `json:"severityNumber"`
+					SeverityText   string `json:"severityText"`
+					Body           struct {
+						StringValue string `json:"stringValue"`
+					} `json:"body"`
+				} `json:"logRecords"`
+			} `json:"scopeLogs"`
+		} `json:"resourceLogs"`
+	}
+	a.NoError(json.Unmarshal(body, &got))
+	a.Len(got.ResourceLogs, 1)
+	a.Len(got.ResourceLogs[0].ScopeLogs, 1)
+	scopeLogs := got.ResourceLogs[0].ScopeLogs[0]
+	a.Equal("bytebase.audit", scopeLogs.Scope.Name)
+	a.Len(scopeLogs.LogRecords, 2)
+	a.Equal("1767355200123456000", scopeLogs.LogRecords[0].TimeUnixNano)
+	// The enums are encoded as integers.
+	a.Equal(9, scopeLogs.LogRecords[0].SeverityNumber)
+	a.Equal(13, scopeLogs.LogRecords[1].SeverityNumber)
+	a.Equal("WARNING", scopeLogs.LogRecords[1].SeverityText)
+	auditLog := &storepb.AuditLog{}
+	a.NoError(protojson.Unmarshal([]byte(scopeLogs.LogRecords[0].Body.StringValue), auditLog))
+	a.Equal("projects/p1", auditLog.Parent)
+}
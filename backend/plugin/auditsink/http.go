@@ -0,0 +1,121 @@
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// splunkSourceType is the source type of the Splunk HTTP Event Collector events.
+const splunkSourceType = "bytebase:audit"
+
+// httpSink posts the audit logs to the HTTP endpoint in batches.
+type httpSink struct {
+	endpoint string
+	token    string
+	format   storepb.AuditLogSinkSetting_Sink_Format
+	client   *http.Client
+}
+
+// splunkEvent is the event of the Splunk HTTP Event Collector.
+type splunkEvent struct {
+	// Time is the epoch time in seconds.
+	Time       float64 `json:"time"`
+	Source     string  `json:"source"`
+	SourceType string  `json:"sourcetype"`
+	Event      *Entry  `json:"event"`
+}
+
+func (s *httpSink) Send(ctx context.Context, logs []*store.AuditLog) error {
+	var body []byte
+	var authorization string
+	switch s.format {
+	case storepb.AuditLogSinkSetting_Sink_SPLUNK_HEC:
+		b, err := formatSplunkEvents(logs)
+		if err != nil {
+			return err
+		}
+		body = b
+		authorization = "Splunk " + s.token
+	default:
+		b, err := formatJSONEntries(logs)
+		if err != nil {
+			return err
+		}
+		body = b
+		if s.token != "" {
+			authorization = "Bearer " + s.token
+		}
+	}
+	return post(ctx, s.client, s.endpoint, authorization, body)
+}
+
+// formatJSONEntries formats the audit logs as a JSON array of the entries.
+func formatJSONEntries(logs []*store.AuditLog) ([]byte, error) {
+	entries := []*Entry{}
+	for _, l := range logs {
+		entry, err := newEntry(l)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal audit logs")
+	}
+	return b, nil
+}
+
+// formatSplunkEvents formats the audit logs as the concatenated events, which are accepted by the
+// Splunk HTTP Event Collector as a batch.
+func formatSplunkEvents(logs []*store.AuditLog) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, l := range logs {
+		entry, err := newEntry(l)
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(&splunkEvent{
+			Time:       float64(l.CreatedAt.UnixMicro()) / 1e6,
+			Source:     syslogAppName,
+			SourceType: splunkSourceType,
+			Event:      entry,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal audit log %d", l.ID)
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
+
+func post(ctx context.Context, client *http.Client, endpoint, authorization string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct POST request to %s", endpoint)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST to %s", endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("failed to POST to %s, status code: %d, response body: %s", endpoint, resp.StatusCode, b)
+	}
+	return nil
+}
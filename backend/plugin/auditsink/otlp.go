@@ -0,0 +1,126 @@
+package auditsink
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// otlpScopeName is the instrumentation scope of the audit logs.
+const otlpScopeName = "bytebase.audit"
+
+// otlpMarshaler follows the OTLP/JSON encoding, which requires the enums to be encoded as integers.
+var otlpMarshaler = protojson.MarshalOptions{UseEnumNumbers: true}
+
+// otlpSink posts the audit logs to the OTLP/HTTP logs endpoint, e.g. http://collector:4318/v1/logs.
+type otlpSink struct {
+	endpoint string
+	token    string
+	client   *http.Client
+}
+
+func (s *otlpSink) Send(ctx context.Context, logs []*store.AuditLog) error {
+	hostname, _ := os.Hostname()
+	request, err := newOTLPRequest(hostname, logs)
+	if err != nil {
+		return err
+	}
+	body, err := otlpMarshaler.Marshal(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal OTLP logs")
+	}
+	var authorization string
+	if s.token != "" {
+		authorization = "Bearer " + s.token
+	}
+	return post(ctx, s.client, s.endpoint, authorization, body)
+}
+
+// newOTLPRequest converts the audit logs to the log records, with the marshaled audit log as the body and
+// the searchable fields as the attributes.
+func newOTLPRequest(hostname string, logs []*store.AuditLog) (*collogspb.ExportLogsServiceRequest, error) {
+	var records []*logspb.LogRecord
+	for _, l := range logs {
+		body, err := protojson.Marshal(l.Payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal audit log %d", l.ID)
+		}
+		record := &logspb.LogRecord{
+			TimeUnixNano:   uint64(l.CreatedAt.UnixNano()),
+			SeverityNumber: otlpSeverityNumber(l.Payload.GetSeverity()),
+			Body:           stringValue(string(body)),
+			Attributes: []*commonpb.KeyValue{
+				{Key: "audit.id", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(l.ID)}}},
+				{Key: "audit.parent", Value: stringValue(l.Payload.GetParent())},
+				{Key: "audit.method", Value: stringValue(l.Payload.GetMethod())},
+			},
+		}
+		if v := l.Payload.GetSeverity(); v != storepb.AuditLog_SEVERITY_UNSPECIFIED {
+			record.SeverityText = v.String()
+		}
+		if v := l.Payload.GetResource(); v != "" {
+			record.Attributes = append(record.Attributes, &commonpb.KeyValue{Key: "audit.resource", Value: stringValue(v)})
+		}
+		if v := l.Payload.GetUser(); v != "" {
+			record.Attributes = append(record.Attributes, &commonpb.KeyValue{Key: "audit.user", Value: stringValue(v)})
+		}
+		records = append(records, record)
+	}
+
+	resource := &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{
+			{Key: "service.name", Value: stringValue(syslogAppName)},
+		},
+	}
+	if hostname != "" {
+		resource.Attributes = append(resource.Attributes, &commonpb.KeyValue{Key: "host.name", Value: stringValue(hostname)})
+	}
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: resource,
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func stringValue(s string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: s}}
+}
+
+func otlpSeverityNumber(severity storepb.AuditLog_Severity) logspb.SeverityNumber {
+	switch severity {
+	case storepb.AuditLog_DEBUG:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case storepb.AuditLog_NOTICE:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO2
+	case storepb.AuditLog_WARNING:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case storepb.AuditLog_ERROR:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	case storepb.AuditLog_CRITICAL:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR2
+	case storepb.AuditLog_ALERT:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR3
+	case storepb.AuditLog_EMERGENCY:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	}
+}
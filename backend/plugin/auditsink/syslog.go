@@ -0,0 +1,128 @@
+package auditsink
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// syslogFacility is the "log audit" facility of RFC 5424.
+	syslogFacility = 13
+	syslogAppName  = "bytebase"
+	syslogMsgID    = "audit"
+)
+
+// syslogSink sends the RFC 5424 messages framed by the octet counting of RFC 6587.
+// It connects to the syslog server for each batch, because the writes to a connection closed by the
+// server may succeed silently and lose the audit logs.
+type syslogSink struct {
+	endpoint string
+	tls      bool
+}
+
+func (s *syslogSink) Send(ctx context.Context, logs []*store.AuditLog) error {
+	hostname, _ := os.Hostname()
+	var frames []byte
+	for _, l := range logs {
+		frame, err := formatSyslogFrame(hostname, l)
+		if err != nil {
+			return err
+		}
+		frames = append(frames, frame...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	var dialer interface {
+		DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+	} = &net.Dialer{}
+	if s.tls {
+		host, _, err := net.SplitHostPort(s.endpoint)
+		if err != nil {
+			return errors.Wrapf(err, "invalid syslog endpoint %q", s.endpoint)
+		}
+		dialer = &tls.Dialer{Config: &tls.Config{ServerName: host}}
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", s.endpoint)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", s.endpoint)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return errors.Wrapf(err, "failed to set deadline")
+		}
+	}
+	if _, err := conn.Write(frames); err != nil {
+		return errors.Wrapf(err, "failed to write to %s", s.endpoint)
+	}
+	return nil
+}
+
+// formatSyslogFrame formats the audit log as "MSG-LEN SP SYSLOG-MSG", where the SYSLOG-MSG is
+// "<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG" with the JSON entry as the MSG.
+func formatSyslogFrame(hostname string, l *store.AuditLog) ([]byte, error) {
+	entry, err := newEntry(l)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := json.Marshal(entry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal audit log %d", l.ID)
+	}
+	pri := syslogFacility*8 + syslogSeverity(l.Payload.GetSeverity())
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ", pri, l.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000Z07:00"), syslogHostname(hostname), syslogAppName, syslogMsgID)
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(len(header) + len(msg)))
+	b.WriteString(" ")
+	b.WriteString(header)
+	b.Write(msg)
+	return []byte(b.String()), nil
+}
+
+// syslogSeverity maps the audit log severity to the syslog severity, from 0 (emergency) to 7 (debug).
+func syslogSeverity(severity storepb.AuditLog_Severity) int {
+	switch severity {
+	case storepb.AuditLog_EMERGENCY:
+		return 0
+	case storepb.AuditLog_ALERT:
+		return 1
+	case storepb.AuditLog_CRITICAL:
+		return 2
+	case storepb.AuditLog_ERROR:
+		return 3
+	case storepb.AuditLog_WARNING:
+		return 4
+	case storepb.AuditLog_NOTICE:
+		return 5
+	case storepb.AuditLog_DEBUG:
+		return 7
+	default:
+		return 6
+	}
+}
+
+// syslogHostname keeps the printable ASCII characters of the hostname, up to 255 characters.
+func syslogHostname(hostname string) string {
+	var b strings.Builder
+	for _, c := range hostname {
+		if c > 32 && c < 127 && b.Len() < 255 {
+			b.WriteRune(c)
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
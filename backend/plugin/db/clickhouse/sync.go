@@ -193,6 +193,7 @@ func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetad
 			if sortingKey != "" {
 				table.SortingKeys = strings.Split(sortingKey, ", ")
 			}
+			table.Ttl = getTableTTL(definition)
 			schemaMetadata.Tables = append(schemaMetadata.Tables, table)
 		}
 	}
@@ -206,6 +207,28 @@ func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetad
 	}, nil
 }
 
+// getTableTTL extracts the table TTL expression from the create_table_query of system.tables, such as
+// "CREATE TABLE db.t (...) ENGINE = MergeTree ORDER BY id TTL ts + toIntervalDay(1) SETTINGS index_granularity = 8192".
+// The column TTL expressions are in the column list before the ENGINE clause.
+func getTableTTL(createTableQuery string) string {
+	engineIndex := strings.Index(createTableQuery, " ENGINE = ")
+	if engineIndex < 0 {
+		return ""
+	}
+	tableOptions := createTableQuery[engineIndex:]
+	ttlIndex := strings.Index(tableOptions, " TTL ")
+	if ttlIndex < 0 {
+		return ""
+	}
+	ttl := tableOptions[ttlIndex+len(" TTL "):]
+	for _, clause := range []string{" SETTINGS ", " COMMENT "} {
+		if i := strings.Index(ttl, clause); i >= 0 {
+			ttl = ttl[:i]
+		}
+	}
+	return strings.TrimSpace(ttl)
+}
+
 func (d *Driver) getDataSkippingIndices(ctx context.Context, database string, table string) ([]*storepb.IndexMetadata, error) {
 	// Select basic fields of the data skipping index.
 	// References:
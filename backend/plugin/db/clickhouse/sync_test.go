@@ -0,0 +1,35 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTableTTL(t *testing.T) {
+	testCases := []struct {
+		createTableQuery string
+		want             string
+	}{
+		{
+			createTableQuery: "CREATE TABLE db.t (`id` UInt64, `ts` DateTime) ENGINE = MergeTree ORDER BY id SETTINGS index_granularity = 8192",
+			want:             "",
+		},
+		{
+			createTableQuery: "CREATE TABLE db.t (`id` UInt64, `ts` DateTime) ENGINE = MergeTree ORDER BY id TTL ts + toIntervalDay(1) SETTINGS index_granularity = 8192",
+			want:             "ts + toIntervalDay(1)",
+		},
+		{
+			createTableQuery: "CREATE TABLE db.t (`id` UInt64, `ts` DateTime TTL ts + toIntervalHour(1)) ENGINE = MergeTree ORDER BY id TTL ts + toIntervalMonth(1) DELETE COMMENT 'events'",
+			want:             "ts + toIntervalMonth(1) DELETE",
+		},
+		{
+			createTableQuery: "CREATE TABLE db.t (`id` UInt64, `ts` DateTime TTL ts + toIntervalHour(1)) ENGINE = MergeTree ORDER BY id",
+			want:             "",
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, getTableTTL(tc.createTableQuery))
+	}
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// RowIterator is a forward-only iterator over the rows of a single query result.
+// Only the current row is held in memory, so callers can process results of any size.
+type RowIterator interface {
+	ColumnNames() []string
+	ColumnTypeNames() []string
+	// Next advances to the next row. It returns false when there are no more rows or an error occurs.
+	Next() bool
+	// Row returns the current row.
+	Row() *v1pb.QueryRow
+	// Err returns the error encountered during the iteration, if any.
+	Err() error
+	Close() error
+}
+
+// StreamQuerier is implemented by the drivers which can stream the rows of a query.
+type StreamQuerier interface {
+	// QueryConnStream executes a single statement and returns the iterator over its rows.
+	// The iteration fails once the total byte size of the rows exceeds the limit of the query context.
+	// The caller must close the iterator.
+	QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext QueryContext) (RowIterator, error)
+}
+
+// NewQueryResultIterator returns a row iterator over a materialized query result.
+// It is used for the drivers which do not implement StreamQuerier.
+func NewQueryResultIterator(result *v1pb.QueryResult) RowIterator {
+	return &queryResultIterator{result: result, index: -1}
+}
+
+type queryResultIterator struct {
+	result *v1pb.QueryResult
+	index  int
+}
+
+func (it *queryResultIterator) ColumnNames() []string {
+	return it.result.ColumnNames
+}
+
+func (it *queryResultIterator) ColumnTypeNames() []string {
+	return it.result.ColumnTypeNames
+}
+
+func (it *queryResultIterator) Next() bool {
+	if it.index+1 >= len(it.result.Rows) {
+		return false
+	}
+	it.index++
+	return true
+}
+
+func (it *queryResultIterator) Row() *v1pb.QueryRow {
+	if it.index < 0 || it.index >= len(it.result.Rows) {
+		return nil
+	}
+	return it.result.Rows[it.index]
+}
+
+func (*queryResultIterator) Err() error {
+	return nil
+}
+
+func (*queryResultIterator) Close() error {
+	return nil
+}
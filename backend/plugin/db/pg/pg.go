@@ -36,7 +36,8 @@ var (
 	// driverName is the driver name that our driver dependence register, now is "pgx".
 	driverName = "pgx"
 
-	_ db.Driver = (*Driver)(nil)
+	_ db.Driver        = (*Driver)(nil)
+	_ db.StreamQuerier = (*Driver)(nil)
 )
 
 func init() {
@@ -888,6 +889,60 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryConnStream executes a single statement and streams its rows.
+func (d *Driver) QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) (db.RowIterator, error) {
+	if queryContext.Limit > 0 {
+		statement = getStatementWithResultLimit(statement, queryContext.Limit)
+	}
+	_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_POSTGRES, statement)
+	if err != nil {
+		return nil, err
+	}
+	if queryContext.Schema != "" {
+		// Sanitize the schema name by escaping any quotes.
+		safeSchemeName := strings.ReplaceAll(queryContext.Schema, "\"", "\"\"")
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf(`SET search_path TO "%s";`, safeSchemeName)); err != nil {
+			return nil, err
+		}
+	}
+
+	if !allQuery {
+		sqlResult, err := conn.ExecContext(ctx, statement)
+		if err != nil {
+			return nil, err
+		}
+		affectedRows, err := sqlResult.RowsAffected()
+		if err != nil {
+			slog.Info("rowsAffected returns error", log.BBError(err))
+		}
+		return db.NewQueryResultIterator(util.BuildAffectedRowsResult(affectedRows, d.PushAndClearMessages())), nil
+	}
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	it, err := util.NewSQLRowIterator(rows, makeValueByTypeName, convertValue)
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+	it.SetMaximumSize(queryContext.MaximumSQLResultSize)
+	return &streamRowIterator{SQLRowIterator: it, driver: d}, nil
+}
+
+// streamRowIterator clears the messages received while the rows are streamed once it is closed,
+// so that they are not returned with the results of the next query.
+type streamRowIterator struct {
+	*util.SQLRowIterator
+	driver *Driver
+}
+
+func (it *streamRowIterator) Close() error {
+	err := it.SQLRowIterator.Close()
+	it.driver.PushAndClearMessages()
+	return err
+}
+
 func getPgError(e error) *v1pb.QueryResult_PostgresError_ {
 	if e == nil {
 		return nil
@@ -76,3 +76,31 @@ func TestBuildSnowflakeDSN(t *testing.T) {
 		require.Equal(t, testCase.want, got)
 	}
 }
+
+func TestParseClusteringKey(t *testing.T) {
+	testCases := []struct {
+		clusteringKey string
+		want          []string
+	}{
+		{
+			clusteringKey: "",
+			want:          nil,
+		},
+		{
+			clusteringKey: "LINEAR(C1)",
+			want:          []string{"C1"},
+		},
+		{
+			clusteringKey: "LINEAR(C1, TO_DATE(C2), SUBSTRING(C3, 1, 5))",
+			want:          []string{"C1", "TO_DATE(C2)", "SUBSTRING(C3, 1, 5)"},
+		},
+		{
+			clusteringKey: "LINEAR(C1, COALESCE(C2, 'a,b'))",
+			want:          []string{"C1", "COALESCE(C2, 'a,b')"},
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, parseClusteringKey(tc.clusteringKey))
+	}
+}
@@ -261,6 +261,103 @@ func (d *Driver) getStreamSchema(ctx context.Context, database string) (map[stri
 	return streamMap, nil
 }
 
+// getTableKeys appends the primary keys or the unique keys of the given database to the key map.
+func (d *Driver) getTableKeys(ctx context.Context, database string, primary bool, keyMap map[db.TableKey][]*storepb.IndexMetadata) error {
+	keyQuery := fmt.Sprintf(`SHOW UNIQUE KEYS IN DATABASE "%s";`, database)
+	if primary {
+		keyQuery = fmt.Sprintf(`SHOW PRIMARY KEYS IN DATABASE "%s";`, database)
+	}
+	keyRows, err := d.db.QueryContext(ctx, keyQuery)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, keyQuery)
+	}
+	defer keyRows.Close()
+	columns, err := keyRows.Columns()
+	if err != nil {
+		return errors.Wrapf(err, "cannot get keys from %q query", keyQuery)
+	}
+	var schemaNameIndex, tableNameIndex, columnNameIndex, keySequenceIndex, constraintNameIndex int
+	// https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys#output
+	for i, n := range columns {
+		switch strings.ToLower(n) {
+		case "schema_name":
+			schemaNameIndex = i
+		case "table_name":
+			tableNameIndex = i
+		case "column_name":
+			columnNameIndex = i
+		case "key_sequence":
+			keySequenceIndex = i
+		case "constraint_name":
+			constraintNameIndex = i
+		default:
+			// Ignore other columns
+		}
+	}
+
+	type keyColumn struct {
+		name     string
+		sequence int
+	}
+	keyColumnMap := make(map[db.TableKey]map[string][]keyColumn)
+	var keyList []db.TableKey
+	for keyRows.Next() {
+		cols := make([]any, len(columns))
+		var schemaName, tableName, columnName, constraintName string
+		var keySequence int
+		var unused any
+		cols[schemaNameIndex] = &schemaName
+		cols[tableNameIndex] = &tableName
+		cols[columnNameIndex] = &columnName
+		cols[keySequenceIndex] = &keySequence
+		cols[constraintNameIndex] = &constraintName
+		for i, v := range cols {
+			if v == nil {
+				cols[i] = &unused
+			}
+		}
+		if err := keyRows.Scan(cols...); err != nil {
+			return err
+		}
+		if _, ok := systemSchemas[strings.ToLower(schemaName)]; ok {
+			continue
+		}
+		tableKey := db.TableKey{Schema: schemaName, Table: tableName}
+		if _, ok := keyColumnMap[tableKey]; !ok {
+			keyColumnMap[tableKey] = make(map[string][]keyColumn)
+			keyList = append(keyList, tableKey)
+		}
+		keyColumnMap[tableKey][constraintName] = append(keyColumnMap[tableKey][constraintName], keyColumn{name: columnName, sequence: keySequence})
+	}
+	if err := keyRows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, keyQuery)
+	}
+
+	for _, tableKey := range keyList {
+		var constraintNames []string
+		for constraintName := range keyColumnMap[tableKey] {
+			constraintNames = append(constraintNames, constraintName)
+		}
+		slices.Sort(constraintNames)
+		for _, constraintName := range constraintNames {
+			keyColumns := keyColumnMap[tableKey][constraintName]
+			slices.SortFunc(keyColumns, func(i, j keyColumn) int {
+				return i.sequence - j.sequence
+			})
+			index := &storepb.IndexMetadata{
+				Name:    constraintName,
+				Primary: primary,
+				Unique:  true,
+			}
+			for _, column := range keyColumns {
+				index.Expressions = append(index.Expressions, column.name)
+			}
+			keyMap[tableKey] = append(keyMap[tableKey], index)
+		}
+	}
+	return nil
+}
+
 // ArrayString is a custom type for scanning array of string.
 type ArrayString []string
 
@@ -475,13 +572,23 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 		return nil, nil, util.FormatErrorWithQuery(err, columnQuery)
 	}
 
+	keyMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+	// Snowflake does not enforce the primary and unique keys, but they are used to generate the rollback statements.
+	for _, primary := range []bool{true, false} {
+		if err := d.getTableKeys(ctx, database, primary, keyMap); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	tableQuery := fmt.Sprintf(`
 		SELECT
 			TABLE_SCHEMA,
 			TABLE_NAME,
 			ROW_COUNT,
 			BYTES,
-			IFNULL(COMMENT, '')
+			IFNULL(COMMENT, ''),
+			IFNULL(CLUSTERING_KEY, ''),
+			IS_TRANSIENT
 		FROM "%s".INFORMATION_SCHEMA.TABLES
 		WHERE TABLE_TYPE = 'BASE TABLE' AND %s
 		ORDER BY TABLE_SCHEMA, TABLE_NAME`, database, excludeWhere)
@@ -491,7 +598,7 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 	}
 	defer tableRows.Close()
 	for tableRows.Next() {
-		var schemaName string
+		var schemaName, clusteringKey, transient string
 		table := &storepb.TableMetadata{}
 		if err := tableRows.Scan(
 			&schemaName,
@@ -499,12 +606,21 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 			&table.RowCount,
 			&table.DataSize,
 			&table.Comment,
+			&clusteringKey,
+			&transient,
 		); err != nil {
 			return nil, nil, err
 		}
+		table.ClusteringKeys = parseClusteringKey(clusteringKey)
+		isTransient, err := util.ConvertYesNo(transient)
+		if err != nil {
+			return nil, nil, err
+		}
+		table.Transient = isTransient
 		if columns, ok := columnMap[db.TableKey{Schema: schemaName, Table: table.Name}]; ok {
 			table.Columns = columns
 		}
+		table.Indexes = keyMap[db.TableKey{Schema: schemaName, Table: table.Name}]
 
 		tableMap[schemaName] = append(tableMap[schemaName], table)
 	}
@@ -558,3 +674,35 @@ func (d *Driver) getTableSchema(ctx context.Context, database string) (map[strin
 
 	return tableMap, viewMap, nil
 }
+
+// parseClusteringKey parses the CLUSTERING_KEY of INFORMATION_SCHEMA.TABLES, such as "LINEAR(C1, TO_DATE(C2))",
+// into the list of clustering key expressions.
+func parseClusteringKey(clusteringKey string) []string {
+	clusteringKey = strings.TrimSpace(clusteringKey)
+	if clusteringKey == "" {
+		return nil
+	}
+	if strings.HasPrefix(strings.ToUpper(clusteringKey), "LINEAR(") && strings.HasSuffix(clusteringKey, ")") {
+		clusteringKey = clusteringKey[len("LINEAR(") : len(clusteringKey)-1]
+	}
+
+	var keys []string
+	depth, start := 0, 0
+	var inQuote bool
+	for i, r := range clusteringKey {
+		switch {
+		case r == '\'':
+			inQuote = !inQuote
+		case inQuote:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			keys = append(keys, strings.TrimSpace(clusteringKey[start:i]))
+			start = i + 1
+		default:
+		}
+	}
+	return append(keys, strings.TrimSpace(clusteringKey[start:]))
+}
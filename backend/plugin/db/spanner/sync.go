@@ -2,7 +2,9 @@ package spanner
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"strings"
 
 	"cloud.google.com/go/spanner"
 	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
@@ -81,6 +83,10 @@ func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetad
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get views from database %q", d.databaseName)
 	}
+	changeStreamMap, err := getChangeStream(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get change streams from database %q", d.databaseName)
+	}
 
 	schemaNameMap := make(map[string]bool)
 	for schemaName := range tableMap {
@@ -89,6 +95,9 @@ func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetad
 	for schemaName := range viewMap {
 		schemaNameMap[schemaName] = true
 	}
+	for schemaName := range changeStreamMap {
+		schemaNameMap[schemaName] = true
+	}
 	var schemaNames []string
 	for schemaName := range schemaNameMap {
 		schemaNames = append(schemaNames, schemaName)
@@ -96,9 +105,10 @@ func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetad
 	slices.Sort(schemaNames)
 	for _, schemaName := range schemaNames {
 		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
-			Name:   schemaName,
-			Tables: tableMap[schemaName],
-			Views:  viewMap[schemaName],
+			Name:          schemaName,
+			Tables:        tableMap[schemaName],
+			Views:         viewMap[schemaName],
+			ChangeStreams: changeStreamMap[schemaName],
 		})
 	}
 
@@ -130,7 +140,9 @@ func getTable(ctx context.Context, tx *spanner.ReadOnlyTransaction, columnMap ma
 	query := `
     SELECT
       TABLE_SCHEMA,
-      TABLE_NAME
+      TABLE_NAME,
+      PARENT_TABLE_NAME,
+      ON_DELETE_ACTION
     FROM INFORMATION_SCHEMA.TABLES
     WHERE TABLE_SCHEMA NOT IN ('INFORMATION_SCHEMA', 'SPANNER_SYS') AND TABLE_TYPE = 'BASE TABLE'
     ORDER BY TABLE_SCHEMA, TABLE_NAME
@@ -146,9 +158,14 @@ func getTable(ctx context.Context, tx *spanner.ReadOnlyTransaction, columnMap ma
 		}
 		var table storepb.TableMetadata
 		var schema string
-		if err := row.Columns(&schema, &table.Name); err != nil {
+		var parentTableName, onDeleteAction spanner.NullString
+		if err := row.Columns(&schema, &table.Name, &parentTableName, &onDeleteAction); err != nil {
 			return nil, err
 		}
+		if parentTableName.Valid {
+			table.InterleaveParent = parentTableName.StringVal
+			table.InterleaveOnDelete = onDeleteAction.StringVal
+		}
 		key := db.TableKey{Schema: schema, Table: table.Name}
 		table.Columns = columnMap[key]
 		table.Indexes = indexMap[key]
@@ -369,3 +386,121 @@ func getView(ctx context.Context, tx *spanner.ReadOnlyTransaction, columnMap map
 	}
 	return viewMap, nil
 }
+
+func getChangeStream(ctx context.Context, tx *spanner.ReadOnlyTransaction) (map[string][]*storepb.ChangeStreamMetadata, error) {
+	changeStreamMap := make(map[string][]*storepb.ChangeStreamMetadata)
+	changeStreams := make(map[db.TableKey]*storepb.ChangeStreamMetadata)
+	// ALL is a reserved keyword, so it must be quoted with backticks.
+	query := `
+    SELECT
+      CHANGE_STREAM_SCHEMA,
+      CHANGE_STREAM_NAME,
+      ` + "`ALL`" + `
+    FROM INFORMATION_SCHEMA.CHANGE_STREAMS
+    ORDER BY CHANGE_STREAM_SCHEMA, CHANGE_STREAM_NAME
+  `
+	iter := tx.Query(ctx, spanner.NewStatement(query))
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		changeStream := &storepb.ChangeStreamMetadata{}
+		var schema string
+		var all bool
+		if err := row.Columns(&schema, &changeStream.Name, &all); err != nil {
+			return nil, err
+		}
+		if all {
+			changeStream.ForClause = "ALL"
+		}
+		changeStreams[db.TableKey{Schema: schema, Table: changeStream.Name}] = changeStream
+		changeStreamMap[schema] = append(changeStreamMap[schema], changeStream)
+	}
+	if len(changeStreams) == 0 {
+		return changeStreamMap, nil
+	}
+
+	// The tracked tables and columns of the change streams which are not FOR ALL.
+	tableQuery := `
+    SELECT
+      t.CHANGE_STREAM_SCHEMA,
+      t.CHANGE_STREAM_NAME,
+      t.TABLE_NAME,
+      t.ALL_COLUMNS,
+      ARRAY (
+        SELECT COLUMN_NAME FROM INFORMATION_SCHEMA.CHANGE_STREAM_COLUMNS AS c
+        WHERE c.CHANGE_STREAM_SCHEMA = t.CHANGE_STREAM_SCHEMA AND c.CHANGE_STREAM_NAME = t.CHANGE_STREAM_NAME AND c.TABLE_NAME = t.TABLE_NAME
+        ORDER BY c.COLUMN_NAME
+      )
+    FROM INFORMATION_SCHEMA.CHANGE_STREAM_TABLES AS t
+    ORDER BY t.CHANGE_STREAM_SCHEMA, t.CHANGE_STREAM_NAME, t.TABLE_NAME
+  `
+	tableIter := tx.Query(ctx, spanner.NewStatement(tableQuery))
+	for {
+		row, err := tableIter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var schema, name, tableName string
+		var allColumns bool
+		var columns []string
+		if err := row.Columns(&schema, &name, &tableName, &allColumns, &columns); err != nil {
+			return nil, err
+		}
+		changeStream, ok := changeStreams[db.TableKey{Schema: schema, Table: name}]
+		if !ok || changeStream.ForClause == "ALL" {
+			continue
+		}
+		if !allColumns {
+			tableName = fmt.Sprintf("%s(%s)", tableName, strings.Join(columns, ", "))
+		}
+		if changeStream.ForClause != "" {
+			changeStream.ForClause += ", "
+		}
+		changeStream.ForClause += tableName
+	}
+
+	optionQuery := `
+    SELECT
+      CHANGE_STREAM_SCHEMA,
+      CHANGE_STREAM_NAME,
+      OPTION_NAME,
+      OPTION_TYPE,
+      OPTION_VALUE
+    FROM INFORMATION_SCHEMA.CHANGE_STREAM_OPTIONS
+    ORDER BY CHANGE_STREAM_SCHEMA, CHANGE_STREAM_NAME, OPTION_NAME
+  `
+	optionIter := tx.Query(ctx, spanner.NewStatement(optionQuery))
+	for {
+		row, err := optionIter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var schema, name, optionName, optionType, optionValue string
+		if err := row.Columns(&schema, &name, &optionName, &optionType, &optionValue); err != nil {
+			return nil, err
+		}
+		changeStream, ok := changeStreams[db.TableKey{Schema: schema, Table: name}]
+		if !ok {
+			continue
+		}
+		if optionType == "STRING" {
+			optionValue = fmt.Sprintf("'%s'", optionValue)
+		}
+		if changeStream.Options != "" {
+			changeStream.Options += ", "
+		}
+		changeStream.Options += fmt.Sprintf("%s = %s", optionName, optionValue)
+	}
+	return changeStreamMap, nil
+}
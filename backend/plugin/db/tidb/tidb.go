@@ -34,7 +34,8 @@ var (
 	baseTableType = "BASE TABLE"
 	viewTableType = "VIEW"
 
-	_ db.Driver = (*Driver)(nil)
+	_ db.Driver        = (*Driver)(nil)
+	_ db.StreamQuerier = (*Driver)(nil)
 )
 
 func init() {
@@ -496,6 +497,40 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryConnStream executes a single statement and streams its rows.
+func (*Driver) QueryConnStream(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) (db.RowIterator, error) {
+	if queryContext.Limit > 0 {
+		statement = getStatementWithResultLimit(statement, queryContext.Limit)
+	}
+	_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_TIDB, statement)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allQuery {
+		sqlResult, err := conn.ExecContext(ctx, statement)
+		if err != nil {
+			return nil, err
+		}
+		affectedRows, err := sqlResult.RowsAffected()
+		if err != nil {
+			slog.Info("rowsAffected returns error", log.BBError(err))
+		}
+		return db.NewQueryResultIterator(util.BuildAffectedRowsResult(affectedRows, nil)), nil
+	}
+	rows, err := conn.QueryContext(ctx, util.MySQLPrependBytebaseAppComment(statement))
+	if err != nil {
+		return nil, err
+	}
+	it, err := util.NewSQLRowIterator(rows, makeValueByTypeName, convertValue)
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+	it.SetMaximumSize(queryContext.MaximumSQLResultSize)
+	return it, nil
+}
+
 func (d *Driver) StopConnectionByID(id string) error {
 	// We cannot use placeholder parameter because TiDB doesn't accept it.
 	_, err := d.db.Exec(fmt.Sprintf("KILL QUERY %s", id))
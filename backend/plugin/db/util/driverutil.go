@@ -49,6 +49,48 @@ var NullRowValue = &v1pb.RowValue{
 }
 
 func RowsToQueryResult(rows *sql.Rows, valueMaker func(string, *sql.ColumnType) any, rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue, limit int64) (*v1pb.QueryResult, error) {
+	it, err := NewSQLRowIterator(rows, valueMaker, rowValueConverter)
+	if err != nil {
+		return nil, err
+	}
+	result := &v1pb.QueryResult{
+		ColumnNames:     it.ColumnNames(),
+		ColumnTypeNames: it.ColumnTypeNames(),
+	}
+	for it.Next() {
+		result.Rows = append(result.Rows, it.Row())
+		n := len(result.Rows)
+		if (n&(n-1) == 0) && int64(proto.Size(result)) > limit {
+			result.Error = common.FormatMaximumSQLResultSizeMessage(limit)
+			break
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	result.RowsCount = int64(len(result.Rows))
+	return result, nil
+}
+
+// SQLRowIterator converts the rows of database/sql to query rows one at a time.
+// It implements db.RowIterator.
+type SQLRowIterator struct {
+	rows              *sql.Rows
+	columnNames       []string
+	columnTypeNames   []string
+	columnTypes       []*sql.ColumnType
+	valueMaker        func(string, *sql.ColumnType) any
+	rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue
+	// maximumSize is the maximum total byte size of the rows, and <= 0 means no limit.
+	maximumSize int64
+
+	size int64
+	row  *v1pb.QueryRow
+	err  error
+}
+
+// NewSQLRowIterator creates a row iterator over the rows. Closing the iterator closes the rows.
+func NewSQLRowIterator(rows *sql.Rows, valueMaker func(string, *sql.ColumnType) any, rowValueConverter func(string, *sql.ColumnType, any) *v1pb.RowValue) (*SQLRowIterator, error) {
 	columnNames, err := rows.Columns()
 	if err != nil {
 		return nil, err
@@ -63,42 +105,74 @@ func RowsToQueryResult(rows *sql.Rows, valueMaker func(string, *sql.ColumnType)
 	for _, v := range columnTypes {
 		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
 	}
-	result := &v1pb.QueryResult{
-		ColumnNames:     columnNames,
-		ColumnTypeNames: columnTypeNames,
-	}
-	columnLength := len(columnNames)
+	return &SQLRowIterator{
+		rows:              rows,
+		columnNames:       columnNames,
+		columnTypeNames:   columnTypeNames,
+		columnTypes:       columnTypes,
+		valueMaker:        valueMaker,
+		rowValueConverter: rowValueConverter,
+	}, nil
+}
 
-	if columnLength > 0 {
-		for rows.Next() {
-			values := make([]any, columnLength)
-			for i, v := range columnTypeNames {
-				values[i] = valueMaker(v, columnTypes[i])
-			}
+// SetMaximumSize makes the iteration fail once the total byte size of the rows exceeds the limit.
+// The limit <= 0 means no limit.
+func (it *SQLRowIterator) SetMaximumSize(limit int64) {
+	it.maximumSize = limit
+}
 
-			if err := rows.Scan(values...); err != nil {
-				return nil, err
-			}
+func (it *SQLRowIterator) ColumnNames() []string {
+	return it.columnNames
+}
 
-			row := &v1pb.QueryRow{}
-			for i := range columnLength {
-				row.Values = append(row.Values, rowValueConverter(columnTypeNames[i], columnTypes[i], values[i]))
-			}
+func (it *SQLRowIterator) ColumnTypeNames() []string {
+	return it.columnTypeNames
+}
 
-			result.Rows = append(result.Rows, row)
-			n := len(result.Rows)
-			if (n&(n-1) == 0) && int64(proto.Size(result)) > limit {
-				result.Error = common.FormatMaximumSQLResultSizeMessage(limit)
-				break
-			}
+func (it *SQLRowIterator) Next() bool {
+	it.row = nil
+	if it.err != nil || len(it.columnNames) == 0 {
+		return false
+	}
+	if !it.rows.Next() {
+		return false
+	}
+	values := make([]any, len(it.columnNames))
+	for i, v := range it.columnTypeNames {
+		values[i] = it.valueMaker(v, it.columnTypes[i])
+	}
+	if err := it.rows.Scan(values...); err != nil {
+		it.err = err
+		return false
+	}
+	row := &v1pb.QueryRow{}
+	for i := range values {
+		row.Values = append(row.Values, it.rowValueConverter(it.columnTypeNames[i], it.columnTypes[i], values[i]))
+	}
+	if it.maximumSize > 0 {
+		it.size += int64(proto.Size(row))
+		if it.size > it.maximumSize {
+			it.err = errors.New(common.FormatMaximumSQLResultSizeMessage(it.maximumSize))
+			return false
 		}
 	}
+	it.row = row
+	return true
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+func (it *SQLRowIterator) Row() *v1pb.QueryRow {
+	return it.row
+}
+
+func (it *SQLRowIterator) Err() error {
+	if it.err != nil {
+		return it.err
 	}
-	result.RowsCount = int64(len(result.Rows))
-	return result, nil
+	return it.rows.Err()
+}
+
+func (it *SQLRowIterator) Close() error {
+	return it.rows.Close()
 }
 
 func MakeCommonValueByTypeName(typeName string, _ *sql.ColumnType) any {
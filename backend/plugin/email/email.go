@@ -0,0 +1,185 @@
+// Package email provides the SMTP email notification channel.
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// Timeout is the timeout for sending an email, including connecting to the SMTP server.
+var Timeout = 10 * time.Second
+
+// Message is an email message.
+type Message struct {
+	To      []string
+	Subject string
+	// Text is the plain text body.
+	Text string
+	// HTML is the HTML body, optional.
+	HTML string
+}
+
+// Send sends the message with the SMTP server in setting.
+func Send(ctx context.Context, setting *storepb.EmailSetting, message *Message) error {
+	from, err := mail.ParseAddress(setting.GetFrom())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender address %q", setting.GetFrom())
+	}
+	if len(message.To) == 0 {
+		return errors.New("no recipients")
+	}
+	for _, to := range message.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return errors.Wrapf(err, "invalid recipient address %q", to)
+		}
+	}
+	body, err := buildMessage(from, message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+	client, err := dial(ctx, setting)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if setting.GetUsername() != "" {
+		if err := client.Auth(smtp.PlainAuth("", setting.GetUsername(), setting.GetPassword(), setting.GetHost())); err != nil {
+			return errors.Wrapf(err, "failed to authenticate")
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return errors.Wrapf(err, "failed to set sender")
+	}
+	for _, to := range message.To {
+		if err := client.Rcpt(to); err != nil {
+			return errors.Wrapf(err, "failed to set recipient %q", to)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrapf(err, "failed to start data")
+	}
+	if _, err := w.Write(body); err != nil {
+		return errors.Wrapf(err, "failed to write message")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrapf(err, "failed to send message")
+	}
+	return client.Quit()
+}
+
+// Validate validates the setting by sending a test email to the recipient.
+func Validate(ctx context.Context, setting *storepb.EmailSetting, to string) error {
+	return Send(ctx, setting, &Message{
+		To:      []string{to},
+		Subject: "Bytebase test email",
+		Text:    "This is a test email from Bytebase. Your SMTP setting works.",
+	})
+}
+
+// GetPort returns the port of the SMTP server, defaulting by the encryption.
+func GetPort(setting *storepb.EmailSetting) int {
+	if setting.GetPort() != 0 {
+		return int(setting.GetPort())
+	}
+	switch setting.GetEncryption() {
+	case storepb.EmailSetting_SSL_TLS:
+		return 465
+	case storepb.EmailSetting_STARTTLS:
+		return 587
+	default:
+		return 25
+	}
+}
+
+func dial(ctx context.Context, setting *storepb.EmailSetting) (*smtp.Client, error) {
+	addr := net.JoinHostPort(setting.GetHost(), strconv.Itoa(GetPort(setting)))
+	tlsConfig := &tls.Config{ServerName: setting.GetHost()}
+
+	var dialer interface {
+		DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+	} = &net.Dialer{}
+	if setting.GetEncryption() == storepb.EmailSetting_SSL_TLS {
+		dialer = &tls.Dialer{Config: tlsConfig}
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to %s", addr)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "failed to set deadline")
+		}
+	}
+
+	client, err := smtp.NewClient(conn, setting.GetHost())
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to create SMTP client")
+	}
+	if setting.GetEncryption() == storepb.EmailSetting_STARTTLS {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, errors.Wrapf(err, "failed to start TLS")
+		}
+	}
+	return client, nil
+}
+
+// buildMessage builds the MIME message, with a multipart/alternative body if the message has HTML.
+func buildMessage(from *mail.Address, message *Message) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(message.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if message.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		buf.WriteString(message.Text)
+		return buf.Bytes(), nil
+	}
+
+	w := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", w.Boundary())
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{contentType: "text/plain; charset=utf-8", body: message.Text},
+		{contentType: "text/html; charset=utf-8", body: message.HTML},
+	} {
+		pw, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create message part")
+		}
+		if _, err := pw.Write([]byte(part.body)); err != nil {
+			return nil, errors.Wrapf(err, "failed to write message part")
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrapf(err, "failed to close message")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,155 @@
+package email
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// smtpMessage is a message received by the fake SMTP server.
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// startFakeSMTPServer starts a minimal SMTP server that accepts one message.
+func startFakeSMTPServer(t *testing.T) (int, <-chan *smtpMessage) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan *smtpMessage, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+		message := &smtpMessage{}
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				_ = tp.PrintfLine("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				message.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+				_ = tp.PrintfLine("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				message.to = append(message.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+				_ = tp.PrintfLine("250 OK")
+			case command == "DATA":
+				_ = tp.PrintfLine("354 Go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				message.data = string(data)
+				_ = tp.PrintfLine("250 OK")
+				messages <- message
+			case command == "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, messages
+}
+
+func TestSend(t *testing.T) {
+	a := require.New(t)
+	port, messages := startFakeSMTPServer(t)
+
+	setting := &storepb.EmailSetting{
+		Enabled:    true,
+		Host:       "127.0.0.1",
+		Port:       int32(port),
+		Encryption: storepb.EmailSetting_NONE,
+		From:       "Bytebase <noreply@example.com>",
+	}
+	a.NoError(Send(t.Context(), setting, &Message{
+		To:      []string{"alice@example.com", "bob@example.com"},
+		Subject: "Approval needed",
+		Text:    "Please approve.",
+		HTML:    "<p>Please approve.</p>",
+	}))
+
+	message := <-messages
+	a.Equal("noreply@example.com", message.from)
+	a.Equal([]string{"alice@example.com", "bob@example.com"}, message.to)
+	a.Contains(message.data, `From: "Bytebase" <noreply@example.com>`)
+	a.Contains(message.data, "To: alice@example.com, bob@example.com")
+	a.Contains(message.data, "Subject: Approval needed")
+	a.Contains(message.data, "Content-Type: multipart/alternative")
+	a.Contains(message.data, "Please approve.")
+	a.Contains(message.data, "<p>Please approve.</p>")
+}
+
+func TestSendInvalidAddress(t *testing.T) {
+	a := require.New(t)
+	setting := &storepb.EmailSetting{Host: "127.0.0.1", From: "Bytebase <noreply@example.com>"}
+	a.Error(Send(t.Context(), setting, &Message{To: []string{"not an address"}}))
+	a.Error(Send(t.Context(), &storepb.EmailSetting{Host: "127.0.0.1"}, &Message{To: []string{"alice@example.com"}}))
+}
+
+func TestNewMessage(t *testing.T) {
+	a := require.New(t)
+	context := webhook.Context{
+		EventType: storepb.Activity_ISSUE_APPROVAL_NOTIFY.String(),
+		Title:     "Issue approval needed",
+		Link:      "https://bytebase.example.com/projects/p1/issues/add-index-101",
+		ActorName: "Bob",
+		Project:   &webhook.Project{Name: "projects/p1", Title: "Project 1"},
+		Issue: &webhook.Issue{
+			ID:      101,
+			Name:    "Add index",
+			Creator: &store.UserMessage{Name: "Bob", Email: "bob@example.com"},
+		},
+	}
+	message, err := NewMessage(context, []string{"alice@example.com"})
+	a.NoError(err)
+	a.Equal("[Project 1] Approval needed: Add index", message.Subject)
+	a.Contains(message.Text, `Bob requests your approval for issue "Add index".`)
+	a.Contains(message.Text, "View in Bytebase: https://bytebase.example.com/projects/p1/issues/add-index-101")
+	a.Contains(message.HTML, `<a href="https://bytebase.example.com/projects/p1/issues/add-index-101">`)
+
+	// Only task failures are sent.
+	context.EventType = storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE.String()
+	context.TaskResult = &webhook.TaskResult{Name: "Migrate db1", Status: storepb.TaskRun_DONE.String()}
+	message, err = NewMessage(context, []string{"alice@example.com"})
+	a.NoError(err)
+	a.Nil(message)
+	context.TaskResult.Status = storepb.TaskRun_FAILED.String()
+	message, err = NewMessage(context, []string{"alice@example.com"})
+	a.NoError(err)
+	a.Equal("[Project 1] Task failed: Migrate db1", message.Subject)
+
+	// Unsupported events are not sent.
+	context.EventType = storepb.Activity_ISSUE_CREATE.String()
+	message, err = NewMessage(context, []string{"alice@example.com"})
+	a.NoError(err)
+	a.Nil(message)
+}
+
+func TestGetPort(t *testing.T) {
+	a := require.New(t)
+	a.Equal(25, GetPort(&storepb.EmailSetting{Encryption: storepb.EmailSetting_NONE}))
+	a.Equal(587, GetPort(&storepb.EmailSetting{Encryption: storepb.EmailSetting_STARTTLS}))
+	a.Equal(465, GetPort(&storepb.EmailSetting{Encryption: storepb.EmailSetting_SSL_TLS}))
+	a.Equal(2525, GetPort(&storepb.EmailSetting{Port: 2525}))
+}
@@ -0,0 +1,137 @@
+package email
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+)
+
+// eventTemplate is the template of the email for an event.
+// The subject and intro are executed with the webhook context.
+type eventTemplate struct {
+	subject *texttemplate.Template
+	intro   *texttemplate.Template
+}
+
+func newEventTemplate(subject, intro string) eventTemplate {
+	return eventTemplate{
+		subject: texttemplate.Must(texttemplate.New("subject").Parse(subject)),
+		intro:   texttemplate.Must(texttemplate.New("intro").Parse(intro)),
+	}
+}
+
+var eventTemplates = map[string]eventTemplate{
+	storepb.Activity_ISSUE_APPROVAL_NOTIFY.String(): newEventTemplate(
+		`[{{.Project.Title}}] Approval needed: {{.Issue.Name}}`,
+		`{{.ActorName}} requests your approval for issue "{{.Issue.Name}}".`,
+	),
+	storepb.Activity_NOTIFY_ISSUE_APPROVED.String(): newEventTemplate(
+		`[{{.Project.Title}}] Issue approved: {{.Issue.Name}}`,
+		`Issue "{{.Issue.Name}}" has been approved.`,
+	),
+	storepb.Activity_NOTIFY_PIPELINE_ROLLOUT.String(): newEventTemplate(
+		`[{{.Project.Title}}] Ready for rollout: {{.Issue.Name}}`,
+		`Issue "{{.Issue.Name}}" is waiting for you to roll out.`,
+	),
+	storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE.String(): newEventTemplate(
+		`[{{.Project.Title}}] Task failed: {{if .TaskResult.Name}}{{.TaskResult.Name}}{{else}}{{.Title}}{{end}}`,
+		`A task {{if .Issue}}of issue "{{.Issue.Name}}" {{end}}failed.`,
+	),
+}
+
+var textTemplate = texttemplate.Must(texttemplate.New("text").Parse(`{{.Intro}}
+{{if .Context.Description}}
+{{.Context.Description}}
+{{end}}
+{{range .Meta}}{{.Name}}: {{.Value}}
+{{end}}{{if .Context.Link}}
+View in Bytebase: {{.Context.Link}}
+{{end}}`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #1f2937;">
+<h2>{{.Context.Title}}</h2>
+<p>{{.Intro}}</p>
+{{if .Context.Description}}<blockquote>{{.Context.Description}}</blockquote>{{end}}
+<table>
+{{range .Meta}}<tr><td style="padding-right: 16px; color: #6b7280;">{{.Name}}</td><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{if .Context.Link}}<p><a href="{{.Context.Link}}">View in Bytebase</a></p>{{end}}
+</body>
+</html>
+`))
+
+// SupportEvent returns whether there is an email template for the event type.
+func SupportEvent(eventType storepb.Activity_Type) bool {
+	_, ok := eventTemplates[eventType.String()]
+	return ok
+}
+
+// NewMessage renders the email message of the event in the webhook context for the recipients.
+// It returns nil if the event should not be sent by email.
+func NewMessage(context webhook.Context, to []string) (*Message, error) {
+	t, ok := eventTemplates[context.EventType]
+	if !ok {
+		return nil, nil
+	}
+	// Only task failures are sent by email.
+	if context.EventType == storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE.String() &&
+		(context.TaskResult == nil || context.TaskResult.Status != storepb.TaskRun_FAILED.String()) {
+		return nil, nil
+	}
+	// The templates refer to the project and issue, so fill them in for events without.
+	if context.Project == nil {
+		context.Project = &webhook.Project{}
+	}
+	if context.Issue == nil && context.EventType != storepb.Activity_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE.String() {
+		return nil, errors.Errorf("issue is required for event %s", context.EventType)
+	}
+
+	subject, err := execute(t.subject, context)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render subject")
+	}
+	intro, err := execute(t.intro, context)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render intro")
+	}
+	data := struct {
+		Context webhook.Context
+		Intro   string
+		Meta    []webhook.Meta
+	}{
+		Context: context,
+		Intro:   intro,
+		Meta:    context.GetMetaList(),
+	}
+
+	var text, html bytes.Buffer
+	if err := textTemplate.Execute(&text, data); err != nil {
+		return nil, errors.Wrapf(err, "failed to render text body")
+	}
+	if err := htmlTemplate.Execute(&html, data); err != nil {
+		return nil, errors.Wrapf(err, "failed to render html body")
+	}
+	return &Message{
+		To: to,
+		// Header values must be on a single line.
+		Subject: strings.Join(strings.Fields(subject), " "),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+func execute(t *texttemplate.Template, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,359 @@
+package base
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+)
+
+// FormatOptions is the options for formatting SQL statements.
+type FormatOptions struct {
+	// Indent is the string of one indentation level. Defaults to two spaces.
+	Indent string
+	// BaseIndent is the prefix of the lines after the first one, which aligns the statements to the column they start in.
+	// Only the whitespaces between the tokens are indented, the string literals and the comments are kept as is.
+	BaseIndent string
+}
+
+// FormatTokenKind is the kind of the token for formatting.
+type FormatTokenKind int
+
+const (
+	// FormatTokenCode is the token of keywords, identifiers, literals, operators and punctuations.
+	FormatTokenCode FormatTokenKind = iota
+	// FormatTokenWhitespace is the whitespace between tokens, which is replaced by the formatter.
+	FormatTokenWhitespace
+	// FormatTokenLineComment is the comment ending at the line end, such as `-- comment`.
+	FormatTokenLineComment
+	// FormatTokenBlockComment is the comment such as `/* comment */`.
+	FormatTokenBlockComment
+	// FormatTokenLine is the token standing alone on its line, such as the batch separator `GO` of SQL Server
+	// and the SQL*Plus commands of Oracle.
+	FormatTokenLine
+)
+
+// FormatToken is the token for formatting.
+type FormatToken struct {
+	Kind FormatTokenKind
+	Text string
+	// Space is the whitespace before the token in the original statement.
+	Space string
+}
+
+// FormatLexFunc lexes the statement into the format tokens without the whitespace tokens.
+type FormatLexFunc func(statement string) ([]FormatToken, error)
+
+// NewFormatTokens converts the ANTLR tokens to the format tokens, the whitespace tokens are merged into the Space of the following token.
+func NewFormatTokens(tokens []antlr.Token, classify func(antlr.Token) FormatTokenKind) []FormatToken {
+	var result []FormatToken
+	var space strings.Builder
+	for _, token := range tokens {
+		if token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		kind := classify(token)
+		text := token.GetText()
+		if kind == FormatTokenWhitespace {
+			space.WriteString(text)
+			continue
+		}
+		result = append(result, FormatToken{Kind: kind, Text: text, Space: space.String()})
+		space.Reset()
+		if kind == FormatTokenLineComment || kind == FormatTokenLine {
+			// Some lexers include the line break in the token, move it to the whitespace.
+			trimmed := strings.TrimRight(text, "\r\n")
+			result[len(result)-1].Text = trimmed
+			space.WriteString(text[len(trimmed):])
+		}
+	}
+	return result
+}
+
+// FormatWithLexer formats the statement with the tokens from the lexer.
+// Only the whitespaces between the tokens are changed, so the comments are preserved and the semantics are unchanged.
+// The formatted statement is lexed again to make sure the tokens are unchanged.
+func FormatWithLexer(statement string, lex FormatLexFunc, options FormatOptions) (string, error) {
+	tokens, err := lex(statement)
+	if err != nil {
+		return "", err
+	}
+	if options.Indent == "" {
+		options.Indent = "  "
+	}
+	f := &formatter{options: options}
+	formatted := f.format(tokens)
+
+	formattedTokens, err := lex(formatted)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to lex the formatted statement")
+	}
+	if len(tokens) != len(formattedTokens) {
+		return "", errors.Errorf("formatting changes the number of tokens from %d to %d", len(tokens), len(formattedTokens))
+	}
+	for i, token := range tokens {
+		if token.Kind != formattedTokens[i].Kind || token.Text != formattedTokens[i].Text {
+			return "", errors.Errorf("formatting changes the token %q to %q", token.Text, formattedTokens[i].Text)
+		}
+	}
+
+	if formatted != "" && strings.HasSuffix(statement, "\n") {
+		formatted += "\n"
+	}
+	return formatted, nil
+}
+
+type formatFrame struct {
+	// subquery is true if the parentheses enclose a query, whose clauses start on new lines.
+	subquery bool
+	// multiline is true if the content in the parentheses starts on a new line in the original statement,
+	// such as the column definitions of CREATE TABLE.
+	multiline bool
+	// indent is the indentation level of the lines in the parentheses.
+	indent int
+}
+
+type formatter struct {
+	options FormatOptions
+	buf     strings.Builder
+	frames  []*formatFrame
+	// statementKeyword is the first keyword of the current statement in upper case, empty at the start of a statement.
+	statementKeyword string
+	// lastCode is the last code token written.
+	lastCode *FormatToken
+}
+
+func (f *formatter) format(tokens []FormatToken) string {
+	for i := range tokens {
+		token := &tokens[i]
+		if i > 0 {
+			f.buf.WriteString(f.separator(&tokens[i-1], token, tokens[i+1:]))
+		}
+		f.buf.WriteString(token.Text)
+		f.update(token, tokens[i+1:])
+	}
+	return f.buf.String()
+}
+
+func (f *formatter) update(token *FormatToken, rest []FormatToken) {
+	switch token.Kind {
+	case FormatTokenLine:
+		f.frames = nil
+		f.statementKeyword = ""
+		f.lastCode = nil
+		return
+	case FormatTokenCode:
+	default:
+		return
+	}
+
+	f.lastCode = token
+	switch token.Text {
+	case "(":
+		frame := &formatFrame{indent: f.indent()}
+		if next := nextCodeToken(rest); next != nil {
+			switch strings.ToUpper(next.Text) {
+			case "SELECT", "WITH":
+				frame.subquery = true
+			default:
+			}
+		}
+		if !frame.subquery && len(rest) > 0 && strings.Contains(rest[0].Space, "\n") {
+			frame.multiline = true
+		}
+		if frame.subquery || frame.multiline {
+			frame.indent++
+		}
+		f.frames = append(f.frames, frame)
+	case ")":
+		if len(f.frames) > 0 {
+			f.frames = f.frames[:len(f.frames)-1]
+		}
+	case ";":
+		f.frames = nil
+		f.statementKeyword = ""
+		f.lastCode = nil
+	default:
+		if f.statementKeyword == "" {
+			f.statementKeyword = strings.ToUpper(token.Text)
+		}
+	}
+}
+
+// separator returns the whitespace between the previous token and the token.
+func (f *formatter) separator(prev, token *FormatToken, rest []FormatToken) string {
+	hasNewline := strings.Contains(token.Space, "\n")
+	switch {
+	case token.Kind == FormatTokenLine || prev.Kind == FormatTokenLine:
+		return f.newline(0, token.Space)
+	case prev.Kind == FormatTokenLineComment:
+		return f.newline(f.lineIndent(token, rest), token.Space)
+	case token.Kind == FormatTokenLineComment || token.Kind == FormatTokenBlockComment || prev.Kind == FormatTokenBlockComment:
+		if hasNewline || f.isClauseStart(token, rest) {
+			return f.newline(f.lineIndent(token, rest), token.Space)
+		}
+		if token.Space != "" {
+			return " "
+		}
+		return ""
+	case f.lastCode == nil:
+		// The start of a statement.
+		return f.newline(0, token.Space)
+	case token.Text == ";" || token.Text == "," || token.Text == "." || prev.Text == ".":
+		return ""
+	case token.Text == ")":
+		if frame := f.top(); frame != nil && (frame.subquery || frame.multiline) {
+			return f.newline(f.outerIndent(), "")
+		}
+		return ""
+	case prev.Text == "(":
+		if frame := f.top(); frame != nil && (frame.subquery || frame.multiline) {
+			return f.newline(frame.indent, "")
+		}
+		return ""
+	case f.isClauseStart(token, rest):
+		return f.newline(f.indent(), "")
+	case hasNewline:
+		return f.newline(f.lineIndent(token, rest), "")
+	case prev.Text == ",":
+		return " "
+	case token.Space == "":
+		return ""
+	default:
+		return " "
+	}
+}
+
+// lineIndent returns the indentation level of the token starting a new line.
+// The comments are indented as the following code token.
+func (f *formatter) lineIndent(token *FormatToken, rest []FormatToken) int {
+	if token.Kind != FormatTokenCode {
+		i := slices.IndexFunc(rest, func(t FormatToken) bool { return t.Kind == FormatTokenCode })
+		if i < 0 {
+			return f.indent()
+		}
+		token, rest = &rest[i], rest[i+1:]
+	}
+	if f.lastCode == nil {
+		return f.indent()
+	}
+	if token.Text == ";" {
+		return f.indent()
+	}
+	if token.Text == ")" {
+		if frame := f.top(); frame != nil && (frame.subquery || frame.multiline) {
+			return f.outerIndent()
+		}
+	}
+	if f.isClauseStart(token, rest) {
+		return f.indent()
+	}
+	switch f.lastCode.Text {
+	case ",", "(":
+		// The items in the multiline parentheses are aligned.
+		if frame := f.top(); frame != nil && frame.multiline {
+			return f.indent()
+		}
+	default:
+	}
+	// Continuation lines.
+	return f.indent() + 1
+}
+
+// isClauseStart returns true if the token starts a clause of the query, such as FROM and WHERE.
+func (f *formatter) isClauseStart(token *FormatToken, rest []FormatToken) bool {
+	if token.Kind != FormatTokenCode || f.lastCode == nil {
+		return false
+	}
+	if frame := f.top(); frame != nil && !frame.subquery {
+		return false
+	}
+	prev := strings.ToUpper(f.lastCode.Text)
+	next := ""
+	if t := nextCodeToken(rest); t != nil {
+		next = strings.ToUpper(t.Text)
+	}
+	switch strings.ToUpper(token.Text) {
+	case "SELECT", "WHERE", "HAVING", "LIMIT", "OFFSET", "UNION", "INTERSECT", "EXCEPT", "MINUS", "RETURNING", "WINDOW":
+		return true
+	case "FROM":
+		// IS [NOT] DISTINCT FROM.
+		return prev != "DISTINCT"
+	case "VALUES":
+		// INSERT INTO t DEFAULT VALUES.
+		return prev != "DEFAULT"
+	case "GROUP", "ORDER":
+		return next == "BY"
+	case "JOIN", "STRAIGHT_JOIN":
+		switch prev {
+		case "LEFT", "RIGHT", "FULL", "INNER", "CROSS", "OUTER", "NATURAL", "HASH", "MERGE", "LOOP", "SEMI", "ANTI":
+			return false
+		default:
+			return true
+		}
+	case "LEFT", "RIGHT", "FULL", "INNER", "CROSS":
+		if prev == "NATURAL" {
+			return false
+		}
+		switch next {
+		case "JOIN", "OUTER", "APPLY", "HASH", "MERGE", "LOOP", "SEMI", "ANTI":
+			return true
+		default:
+			return false
+		}
+	case "NATURAL":
+		switch next {
+		case "JOIN", "LEFT", "RIGHT", "FULL", "INNER":
+			return true
+		default:
+			return false
+		}
+	case "OUTER":
+		// OUTER APPLY of SQL Server.
+		return next == "APPLY"
+	case "SET":
+		return f.statementKeyword == "UPDATE"
+	default:
+		return false
+	}
+}
+
+func (f *formatter) newline(indent int, space string) string {
+	// Keep one blank line between statements.
+	if f.lastCode == nil && strings.Count(space, "\n") > 1 {
+		return "\n\n" + f.options.BaseIndent + strings.Repeat(f.options.Indent, indent)
+	}
+	return "\n" + f.options.BaseIndent + strings.Repeat(f.options.Indent, indent)
+}
+
+func (f *formatter) top() *formatFrame {
+	if len(f.frames) == 0 {
+		return nil
+	}
+	return f.frames[len(f.frames)-1]
+}
+
+func (f *formatter) indent() int {
+	if frame := f.top(); frame != nil {
+		return frame.indent
+	}
+	return 0
+}
+
+// outerIndent returns the indentation level out of the innermost parentheses.
+func (f *formatter) outerIndent() int {
+	if len(f.frames) < 2 {
+		return 0
+	}
+	return f.frames[len(f.frames)-2].indent
+}
+
+func nextCodeToken(tokens []FormatToken) *FormatToken {
+	for i := range tokens {
+		if tokens[i].Kind == FormatTokenCode {
+			return &tokens[i]
+		}
+	}
+	return nil
+}
@@ -29,6 +29,9 @@ var (
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
 	parsers                 = make(map[storepb.Engine]ParseFunc)
+	formatters              = make(map[storepb.Engine]FormatFunc)
+	rowFilterRewriters      = make(map[storepb.Engine]RewriteRowFilterFunc)
+	cteDefinitionGetters    = make(map[storepb.Engine]GetCTEDefinitionsFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, bool, error)
@@ -41,6 +44,9 @@ type StatementRangeFunc func(ctx context.Context, sCtx StatementRangeContext, st
 // GetQuerySpanFunc is the interface of getting the query span for a query.
 type GetQuerySpanFunc func(ctx context.Context, gCtx GetQuerySpanContext, statement, database, schema string, ignoreCaseSensitive bool) (*QuerySpan, error)
 
+// GetCTEDefinitionsFunc is the interface of getting the common table expressions defined in a statement.
+type GetCTEDefinitionsFunc func(statement string) ([]CTEDefinition, error)
+
 // TransformDMLToSelectFunc is the interface of transforming DML statements to SELECT statements.
 type TransformDMLToSelectFunc func(ctx context.Context, tCtx TransformContext, statement string, sourceDatabase string, targetDatabase string, tablePrefix string) ([]BackupStatement, error)
 
@@ -51,6 +57,9 @@ type GenerateRestoreSQLFunc func(ctx context.Context, rCtx RestoreContext, state
 // Common types: []ast.Node, antlr.Tree, []*ParseResult, statements.Statements.
 type ParseFunc func(statement string) (any, error)
 
+// FormatFunc is the interface for formatting SQL statements.
+type FormatFunc func(statement string, options FormatOptions) (string, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -218,6 +227,26 @@ func GetQuerySpan(ctx context.Context, gCtx GetQuerySpanContext, engine storepb.
 	return results, nil
 }
 
+// RegisterGetCTEDefinitions registers the GetCTEDefinitions function for the engine.
+func RegisterGetCTEDefinitions(engine storepb.Engine, f GetCTEDefinitionsFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := cteDefinitionGetters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	cteDefinitionGetters[engine] = f
+}
+
+// GetCTEDefinitions gets the common table expressions defined in the statement, including the nested ones.
+// The statement may be incomplete, the common table expressions are extracted from the recovered parse tree.
+func GetCTEDefinitions(engine storepb.Engine, statement string) ([]CTEDefinition, error) {
+	f, ok := cteDefinitionGetters[engine]
+	if !ok {
+		return nil, nil
+	}
+	return f(statement)
+}
+
 // RegisterTransformDMLToSelect registers the transformDMLToSelect function for the engine.
 func RegisterTransformDMLToSelect(engine storepb.Engine, f TransformDMLToSelectFunc) {
 	mux.Lock()
@@ -283,6 +312,43 @@ func Parse(engine storepb.Engine, statement string) (any, error) {
 	return f(statement)
 }
 
+func RegisterFormatFunc(engine storepb.Engine, f FormatFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := formatters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	formatters[engine] = f
+}
+
+// Format formats the SQL statement. Only the whitespaces between the tokens are changed,
+// so the comments are preserved and the semantics are unchanged.
+func Format(engine storepb.Engine, statement string, options FormatOptions) (string, error) {
+	f, ok := formatters[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, options)
+}
+
+func RegisterRewriteRowFilterFunc(engine storepb.Engine, f RewriteRowFilterFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterRewriters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterRewriters[engine] = f
+}
+
+// RewriteRowFilter rewrites the query statement so that the filtered tables only return the rows satisfying the row filters.
+func RewriteRowFilter(engine storepb.Engine, statement string, rCtx RowFilterContext) (*RowFilterResult, error) {
+	f, ok := rowFilterRewriters[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, rCtx)
+}
+
 type ChangeSummary struct {
 	ChangedResources *model.ChangedResources
 	SampleDMLS       []string
@@ -0,0 +1,65 @@
+package base
+
+import (
+	"strings"
+)
+
+// RowFilter is the row filter on a table.
+type RowFilter struct {
+	Database string
+	Schema   string
+	Table    string
+	// Predicate is the SQL predicate that the readable rows must satisfy.
+	Predicate string
+}
+
+// RowFilterContext is the context for rewriting the query statement with the row filters.
+type RowFilterContext struct {
+	// DefaultDatabase is the database of the unqualified table references.
+	DefaultDatabase string
+	// DefaultSchema is the schema of the unqualified table references.
+	DefaultSchema string
+	// Filters is the row filters to apply.
+	Filters []*RowFilter
+	// IgnoreCaseSensitive is whether the table names are compared case-insensitively.
+	IgnoreCaseSensitive bool
+}
+
+// RowFilterResult is the result of rewriting the query statement with the row filters.
+type RowFilterResult struct {
+	// Statement is the rewritten statement.
+	Statement string
+	// Applied is the row filters applied to the statement.
+	Applied []*RowFilter
+	// Tables is the relations referenced by the statement, excluding the common table expressions.
+	Tables []SchemaResource
+}
+
+// RewriteRowFilterFunc is the interface of rewriting the query statement with the row filters.
+type RewriteRowFilterFunc func(statement string, rCtx RowFilterContext) (*RowFilterResult, error)
+
+// MatchFilters returns the row filters on the table.
+func (c RowFilterContext) MatchFilters(database, schema, table string) []*RowFilter {
+	equal := func(a, b string) bool {
+		if c.IgnoreCaseSensitive {
+			return strings.EqualFold(a, b)
+		}
+		return a == b
+	}
+	var filters []*RowFilter
+	for _, filter := range c.Filters {
+		if equal(filter.Database, database) && equal(filter.Schema, schema) && equal(filter.Table, table) {
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
+
+// RowFilterCondition returns the condition combining the predicates of the row filters with AND.
+func RowFilterCondition(filters []*RowFilter) string {
+	var conditions []string
+	for _, filter := range filters {
+		conditions = append(conditions, "("+filter.Predicate+")")
+	}
+	return strings.Join(conditions, " AND ")
+}
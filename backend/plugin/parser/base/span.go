@@ -139,6 +139,16 @@ type baseTableSource struct {
 // isTableSource implements the TableSource interface.
 func (baseTableSource) isTableSource() {}
 
+// CTEDefinition is the name of a common table expression defined in the statement.
+type CTEDefinition struct {
+	// Name is the normalized name of the common table expression.
+	Name string
+	// Start and End are the character offsets of the name in the statement, the End is exclusive.
+	// They count the runes instead of the bytes, the same as the ANTLR token indexes.
+	Start int
+	End   int
+}
+
 // PseudoTable is the resource of table, it's useful for some pseudo/temporary tables likes CTE, AS.
 type PseudoTable struct {
 	baseTableSource
@@ -0,0 +1,47 @@
+package mysql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/mysql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterGetCTEDefinitions(storepb.Engine_MYSQL, GetCTEDefinitions)
+	base.RegisterGetCTEDefinitions(storepb.Engine_MARIADB, GetCTEDefinitions)
+	base.RegisterGetCTEDefinitions(storepb.Engine_OCEANBASE, GetCTEDefinitions)
+}
+
+// GetCTEDefinitions returns the common table expressions defined in the statement.
+func GetCTEDefinitions(statement string) ([]base.CTEDefinition, error) {
+	lexer := parser.NewMySQLLexer(antlr.NewInputStream(statement))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewMySQLParser(stream)
+	// The statement may be incomplete while typing, so the syntax errors are ignored and the recovered parse tree is used.
+	lexer.RemoveErrorListeners()
+	p.RemoveErrorListeners()
+	p.BuildParseTrees = true
+
+	listener := &cteDefinitionListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, p.Script())
+	return listener.definitions, nil
+}
+
+type cteDefinitionListener struct {
+	*parser.BaseMySQLParserListener
+
+	definitions []base.CTEDefinition
+}
+
+func (l *cteDefinitionListener) EnterCommonTableExpression(ctx *parser.CommonTableExpressionContext) {
+	if ctx.Identifier() == nil {
+		return
+	}
+	l.definitions = append(l.definitions, base.CTEDefinition{
+		Name:  NormalizeMySQLIdentifier(ctx.Identifier()),
+		Start: ctx.Identifier().GetStart().GetStart(),
+		End:   ctx.Identifier().GetStop().GetStop() + 1,
+	})
+}
@@ -0,0 +1,54 @@
+package mysql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/mysql"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_MYSQL, Format)
+	base.RegisterFormatFunc(storepb.Engine_MARIADB, Format)
+	base.RegisterFormatFunc(storepb.Engine_OCEANBASE, Format)
+}
+
+// Format formats the MySQL statement.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	return base.FormatWithLexer(statement, lexForFormat, options)
+}
+
+func lexForFormat(statement string) ([]base.FormatToken, error) {
+	lexer := parser.NewMySQLLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{
+		Statement: statement,
+	}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return nil, lexerErrorListener.Err
+	}
+	tokens := stream.GetAllTokens()
+	for _, token := range tokens {
+		// The statements after DELIMITER are terminated by the custom delimiter, which are not tokenized by the lexer.
+		if token.GetTokenType() == parser.MySQLLexerDELIMITER_SYMBOL {
+			return nil, errors.Errorf("formatting the statements with DELIMITER is not supported")
+		}
+	}
+	return base.NewFormatTokens(tokens, func(token antlr.Token) base.FormatTokenKind {
+		switch token.GetTokenType() {
+		case parser.MySQLLexerWHITESPACE:
+			return base.FormatTokenWhitespace
+		case parser.MySQLLexerPOUND_COMMENT, parser.MySQLLexerDASHDASH_COMMENT:
+			return base.FormatTokenLineComment
+		case parser.MySQLLexerBLOCK_COMMENT:
+			return base.FormatTokenBlockComment
+		default:
+			return base.FormatTokenCode
+		}
+	}), nil
+}
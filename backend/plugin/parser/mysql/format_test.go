@@ -0,0 +1,65 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		err       string
+	}{
+		{
+			statement: "select a, b from t1 join t2 on t1.id = t2.id where a = 1 and b in (select x from y where z = 2) group by a order by b limit 10;",
+			want: `select a, b
+from t1
+join t2 on t1.id = t2.id
+where a = 1 and b in (
+  select x
+  from y
+  where z = 2
+)
+group by a
+order by b
+limit 10;`,
+		},
+		{
+			statement: "-- head\nCREATE TABLE t (\nid int NOT NULL, -- id\n    name varchar(10),\nPRIMARY KEY (id)\n) ENGINE=InnoDB;\n\n\ninsert into t values (1, 'a'), (2, 'b');\n",
+			want: `-- head
+CREATE TABLE t (
+  id int NOT NULL, -- id
+  name varchar(10),
+  PRIMARY KEY (id)
+) ENGINE=InnoDB;
+
+insert into t
+values (1, 'a'), (2, 'b');
+`,
+		},
+		{
+			statement: "update t set a = 1 /* keep */ where id = count(*)",
+			want: `update t
+set a = 1 /* keep */
+where id = count(*)`,
+		},
+		{
+			statement: "DELIMITER ;;\nselect 1;;",
+			err:       "formatting the statements with DELIMITER is not supported",
+		},
+	}
+
+	for _, test := range tests {
+		got, err := Format(test.statement, base.FormatOptions{})
+		if test.err != "" {
+			require.ErrorContains(t, err, test.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,254 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/mysql"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterRewriteRowFilterFunc(storepb.Engine_MYSQL, RewriteRowFilter)
+	base.RegisterRewriteRowFilterFunc(storepb.Engine_MARIADB, RewriteRowFilter)
+}
+
+// RewriteRowFilter rewrites the MySQL query statement so that every filtered table in the FROM clauses
+// is replaced by a derived table selecting the rows satisfying the row filters, e.g.
+// "SELECT * FROM t" is rewritten to "SELECT * FROM (SELECT * FROM t WHERE (region = 'us')) AS `t`;".
+func RewriteRowFilter(statement string, rCtx base.RowFilterContext) (*base.RowFilterResult, error) {
+	for _, filter := range rCtx.Filters {
+		if err := validateRowFilterPredicate(filter.Predicate); err != nil {
+			return nil, errors.Wrapf(err, "invalid row filter on table %q", filter.Table)
+		}
+	}
+
+	parseResults, err := ParseMySQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(parseResults) != 1 {
+		return nil, errors.Errorf("expected exactly one statement, but got %d", len(parseResults))
+	}
+	parseResult := parseResults[0]
+	// The other statements, such as CALL, DO and EXECUTE, may run the queries which cannot be rewritten.
+	if script, ok := parseResult.Tree.(*parser.ScriptContext); ok {
+		queries := script.AllQuery()
+		if len(queries) != 1 || queries[0].SimpleStatement() == nil || queries[0].SimpleStatement().SelectStatement() == nil {
+			return nil, errors.Errorf("only SELECT statements are allowed in the queries with row filters")
+		}
+	}
+
+	cteCollector := &cteNameCollector{cteNames: make(map[string]bool)}
+	antlr.ParseTreeWalkerDefault.Walk(cteCollector, parseResult.Tree)
+
+	rewriter := &rowFilterRewriter{
+		rCtx:         rCtx,
+		cteNames:     cteCollector.cteNames,
+		rewriter:     antlr.NewTokenStreamRewriter(parseResult.Tokens),
+		rewrittenRef: make(map[*parser.TableRefContext]bool),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(rewriter, parseResult.Tree)
+	if rewriter.err != nil {
+		return nil, rewriter.err
+	}
+
+	result := &base.RowFilterResult{
+		Statement: statement,
+		Applied:   rewriter.applied,
+		Tables:    rewriter.tables,
+	}
+	if len(rewriter.applied) == 0 {
+		return result, nil
+	}
+	result.Statement = rewriter.rewriter.GetTextDefault()
+	// Make sure the rewritten statement is still a single valid statement.
+	rewrittenResults, err := ParseMySQL(result.Statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the statement rewritten with the row filters")
+	}
+	if len(rewrittenResults) != 1 {
+		return nil, errors.Errorf("the statement rewritten with the row filters is not a single statement")
+	}
+	return result, nil
+}
+
+// validateRowFilterPredicate checks that the predicate cannot escape from the WHERE clause of the derived table.
+func validateRowFilterPredicate(predicate string) error {
+	lexer := parser.NewMySQLLexer(antlr.NewInputStream(predicate))
+	lexerErrorListener := &base.ParseErrorListener{
+		Statement: predicate,
+	}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return lexerErrorListener.Err
+	}
+
+	depth := 0
+	for _, token := range stream.GetAllTokens() {
+		switch token.GetTokenType() {
+		case parser.MySQLLexerSEMICOLON_SYMBOL:
+			return errors.Errorf("predicate %q must not contain semicolons", predicate)
+		case parser.MySQLLexerOPEN_PAR_SYMBOL:
+			depth++
+		case parser.MySQLLexerCLOSE_PAR_SYMBOL:
+			depth--
+			if depth < 0 {
+				return errors.Errorf("predicate %q has unbalanced parentheses", predicate)
+			}
+		default:
+		}
+	}
+	if depth != 0 {
+		return errors.Errorf("predicate %q has unbalanced parentheses", predicate)
+	}
+	return nil
+}
+
+// rowFilterAllowedFunctions is the built-in functions allowed in the queries with row filters.
+// The other functions, such as the stored functions, may run the queries by themselves and read the
+// filtered tables without the row filters.
+var rowFilterAllowedFunctions = map[string]bool{
+	// Aggregate and window functions.
+	"bit_and": true, "bit_or": true, "bit_xor": true, "std": true, "stddev": true, "stddev_pop": true,
+	"stddev_samp": true, "var_pop": true, "var_samp": true, "variance": true, "json_arrayagg": true,
+	"json_objectagg": true, "row_number": true, "rank": true, "dense_rank": true, "percent_rank": true,
+	"cume_dist": true, "ntile": true, "lag": true, "lead": true, "first_value": true, "last_value": true,
+	"nth_value": true,
+	// Mathematical functions.
+	"abs": true, "ceil": true, "ceiling": true, "floor": true, "round": true, "sign": true, "sqrt": true,
+	"pow": true, "power": true, "exp": true, "ln": true, "log": true, "log10": true, "log2": true,
+	"greatest": true, "least": true,
+	// String functions.
+	"concat": true, "concat_ws": true, "lower": true, "lcase": true, "upper": true, "ucase": true,
+	"length": true, "char_length": true, "character_length": true, "ltrim": true, "rtrim": true,
+	"lpad": true, "rpad": true, "substring_index": true, "locate": true, "instr": true, "md5": true,
+	"sha1": true, "sha2": true, "hex": true, "unhex": true, "field": true, "find_in_set": true,
+	"regexp_replace": true, "regexp_substr": true, "regexp_like": true,
+	// Date and time functions.
+	"now": true, "date_format": true, "str_to_date": true, "datediff": true, "timestampdiff": true,
+	"unix_timestamp": true, "from_unixtime": true, "dayofweek": true, "dayofmonth": true, "dayofyear": true,
+	"weekday": true, "last_day": true, "to_days": true, "makedate": true,
+	// Control flow and JSON functions.
+	"ifnull": true, "nullif": true, "isnull": true, "json_extract": true, "json_unquote": true,
+	"json_object": true, "json_array": true, "json_length": true, "json_contains": true,
+}
+
+type cteNameCollector struct {
+	*parser.BaseMySQLParserListener
+
+	cteNames map[string]bool
+}
+
+func (c *cteNameCollector) EnterCommonTableExpression(ctx *parser.CommonTableExpressionContext) {
+	c.cteNames[strings.ToLower(NormalizeMySQLIdentifier(ctx.Identifier()))] = true
+}
+
+type rowFilterRewriter struct {
+	*parser.BaseMySQLParserListener
+
+	rCtx     base.RowFilterContext
+	cteNames map[string]bool
+	rewriter *antlr.TokenStreamRewriter
+	// rewrittenRef is the table references in the FROM clauses, which are handled by EnterSingleTable.
+	rewrittenRef map[*parser.TableRefContext]bool
+
+	applied []*base.RowFilter
+	tables  []base.SchemaResource
+	err     error
+}
+
+// EnterSingleTable replaces the filtered table in the FROM clause with the filtered derived table.
+func (r *rowFilterRewriter) EnterSingleTable(ctx *parser.SingleTableContext) {
+	if r.err != nil {
+		return
+	}
+	tableRef, ok := ctx.TableRef().(*parser.TableRefContext)
+	if !ok || tableRef == nil {
+		return
+	}
+	r.rewrittenRef[tableRef] = true
+
+	resource, qualified := r.resolveTableRef(tableRef)
+	if !qualified && r.cteNames[strings.ToLower(resource.Table)] {
+		if len(r.rCtx.MatchFilters(resource.Database, resource.Schema, resource.Table)) > 0 {
+			r.err = errors.Errorf("common table expression %q has the same name as the filtered table", resource.Table)
+		}
+		return
+	}
+	r.tables = append(r.tables, resource)
+
+	filters := r.rCtx.MatchFilters(resource.Database, resource.Schema, resource.Table)
+	if len(filters) == 0 {
+		return
+	}
+
+	tokens := r.rewriter.GetTokenStream()
+	source := tokens.GetTextFromInterval(tableRef.GetSourceInterval())
+	if partition := ctx.UsePartition(); partition != nil {
+		source += " " + tokens.GetTextFromInterval(partition.GetSourceInterval())
+	}
+	if indexHints := ctx.IndexHintList(); indexHints != nil {
+		source += " " + tokens.GetTextFromInterval(indexHints.GetSourceInterval())
+	}
+	alias := fmt.Sprintf("AS `%s`", strings.ReplaceAll(resource.Table, "`", "``"))
+	if tableAlias := ctx.TableAlias(); tableAlias != nil {
+		alias = tokens.GetTextFromInterval(tableAlias.GetSourceInterval())
+	}
+	r.rewriter.ReplaceDefault(
+		ctx.GetStart().GetTokenIndex(),
+		ctx.GetStop().GetTokenIndex(),
+		fmt.Sprintf("(SELECT * FROM %s WHERE %s) %s", source, base.RowFilterCondition(filters), alias),
+	)
+	r.applied = append(r.applied, filters...)
+}
+
+// EnterTableFunction rejects the table functions, such as JSON_TABLE.
+func (r *rowFilterRewriter) EnterTableFunction(ctx *parser.TableFunctionContext) {
+	if r.err != nil {
+		return
+	}
+	r.err = errors.Errorf("table function %q is not allowed in the queries with row filters", ctx.GetText())
+}
+
+// EnterFunctionCall rejects the functions not in rowFilterAllowedFunctions.
+// The functions qualified by the database are always the stored functions.
+func (r *rowFilterRewriter) EnterFunctionCall(ctx *parser.FunctionCallContext) {
+	if r.err != nil {
+		return
+	}
+	if ctx.PureIdentifier() != nil && rowFilterAllowedFunctions[strings.ToLower(NormalizeMySQLPureIdentifier(ctx.PureIdentifier()))] {
+		return
+	}
+	r.err = errors.Errorf("function %q is not allowed in the queries with row filters", ctx.GetText())
+}
+
+// EnterTableRef rejects the filtered tables referenced outside the FROM clauses, such as the DML targets.
+func (r *rowFilterRewriter) EnterTableRef(ctx *parser.TableRefContext) {
+	if r.err != nil || r.rewrittenRef[ctx] {
+		return
+	}
+	resource, qualified := r.resolveTableRef(ctx)
+	if !qualified && r.cteNames[strings.ToLower(resource.Table)] {
+		return
+	}
+	if len(r.rCtx.MatchFilters(resource.Database, resource.Schema, resource.Table)) > 0 {
+		r.err = errors.Errorf("table %q with row filters can only be referenced in the FROM clause", resource.Table)
+	}
+}
+
+// resolveTableRef returns the table resource and whether the table reference is qualified by the database.
+func (r *rowFilterRewriter) resolveTableRef(ctx *parser.TableRefContext) (base.SchemaResource, bool) {
+	database, table := NormalizeMySQLTableRef(ctx)
+	if database == "" {
+		return base.SchemaResource{Database: r.rCtx.DefaultDatabase, Table: table}, false
+	}
+	return base.SchemaResource{Database: database, Table: table}, true
+}
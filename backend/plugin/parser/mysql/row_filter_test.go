@@ -0,0 +1,107 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestRewriteRowFilter(t *testing.T) {
+	filters := []*base.RowFilter{
+		{Database: "db", Table: "orders", Predicate: "region = 'us'"},
+		{Database: "db", Table: "orders", Predicate: "deleted = 0"},
+		{Database: "crm", Table: "customers", Predicate: "tier <> 'vip'"},
+	}
+	tests := []struct {
+		statement string
+		want      string
+		applied   int
+		wantErr   bool
+	}{
+		{
+			statement: "SELECT * FROM orders",
+			want:      "SELECT * FROM (SELECT * FROM orders WHERE (region = 'us') AND (deleted = 0)) AS `orders`;",
+			applied:   2,
+		},
+		{
+			statement: "SELECT o.id, c.name FROM db.orders o USE INDEX (idx_region) JOIN crm.customers AS c ON o.customer_id = c.id;",
+			want:      "SELECT o.id, c.name FROM (SELECT * FROM db.orders USE INDEX (idx_region) WHERE (region = 'us') AND (deleted = 0)) o JOIN (SELECT * FROM crm.customers WHERE (tier <> 'vip')) AS c ON o.customer_id = c.id;",
+			applied:   3,
+		},
+		{
+			// The tables of other databases are not filtered.
+			statement: "SELECT * FROM other.orders, customers;",
+			want:      "SELECT * FROM other.orders, customers;",
+		},
+		{
+			statement: "WITH x AS (SELECT * FROM orders) SELECT * FROM x;",
+			want:      "WITH x AS (SELECT * FROM (SELECT * FROM orders WHERE (region = 'us') AND (deleted = 0)) AS `orders`) SELECT * FROM x;",
+			applied:   2,
+		},
+		{
+			// The CTE with the same name as the filtered table is ambiguous.
+			statement: "WITH orders AS (SELECT 1) SELECT * FROM orders;",
+			wantErr:   true,
+		},
+		{
+			statement: "DELETE FROM orders;",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT 1; SELECT 2;",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT COUNT(*), LOWER(region), IFNULL(note, ''), SUBSTRING(name, 1, 2) FROM orders",
+			want:      "SELECT COUNT(*), LOWER(region), IFNULL(note, ''), SUBSTRING(name, 1, 2) FROM (SELECT * FROM orders WHERE (region = 'us') AND (deleted = 0)) AS `orders`;",
+			applied:   2,
+		},
+		{
+			// The stored functions may run the queries reading the filtered tables without the row filters.
+			statement: "SELECT read_orders()",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT db.read_orders() FROM orders",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT * FROM JSON_TABLE('[1]', '$[*]' COLUMNS (id INT PATH '$')) j JOIN orders ON orders.id = j.id",
+			wantErr:   true,
+		},
+		{
+			statement: "CALL read_orders()",
+			wantErr:   true,
+		},
+		{
+			statement: "EXECUTE stmt",
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := RewriteRowFilter(test.statement, base.RowFilterContext{
+			DefaultDatabase: "db",
+			Filters:         filters,
+		})
+		if test.wantErr {
+			require.Error(t, err, test.statement)
+			continue
+		}
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, result.Statement, test.statement)
+		require.Len(t, result.Applied, test.applied, test.statement)
+	}
+}
+
+func TestRewriteRowFilterInvalidPredicate(t *testing.T) {
+	for _, predicate := range []string{"1) OR (1", "1; DROP TABLE orders", "(1"} {
+		_, err := RewriteRowFilter("SELECT * FROM orders", base.RowFilterContext{
+			DefaultDatabase: "db",
+			Filters:         []*base.RowFilter{{Database: "db", Table: "orders", Predicate: predicate}},
+		})
+		require.Error(t, err, predicate)
+	}
+}
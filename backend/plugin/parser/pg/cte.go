@@ -0,0 +1,45 @@
+package pg
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/postgresql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterGetCTEDefinitions(storepb.Engine_POSTGRES, GetCTEDefinitions)
+}
+
+// GetCTEDefinitions returns the common table expressions defined in the statement.
+func GetCTEDefinitions(statement string) ([]base.CTEDefinition, error) {
+	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewPostgreSQLParser(stream)
+	// The statement may be incomplete while typing, so the syntax errors are ignored and the recovered parse tree is used.
+	lexer.RemoveErrorListeners()
+	p.RemoveErrorListeners()
+	p.BuildParseTrees = true
+
+	listener := &cteDefinitionListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, p.Root())
+	return listener.definitions, nil
+}
+
+type cteDefinitionListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	definitions []base.CTEDefinition
+}
+
+func (l *cteDefinitionListener) EnterCommon_table_expr(ctx *parser.Common_table_exprContext) {
+	if ctx.Name() == nil {
+		return
+	}
+	l.definitions = append(l.definitions, base.CTEDefinition{
+		Name:  normalizePostgreSQLName(ctx.Name()),
+		Start: ctx.Name().GetStart().GetStart(),
+		End:   ctx.Name().GetStop().GetStop() + 1,
+	})
+}
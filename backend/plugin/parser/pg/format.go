@@ -0,0 +1,73 @@
+package pg
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/parser/postgresql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_POSTGRES, Format)
+	base.RegisterFormatFunc(storepb.Engine_COCKROACHDB, Format)
+}
+
+// Format formats the PostgreSQL statement.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	return base.FormatWithLexer(statement, lexForFormat, options)
+}
+
+func lexForFormat(statement string) ([]base.FormatToken, error) {
+	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{
+		Statement: statement,
+	}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return nil, lexerErrorListener.Err
+	}
+
+	// The dollar-quoted string is lexed into the begin, text and end tokens, merge them into one token.
+	var tokens []antlr.Token
+	var dollarString *strings.Builder
+	for _, token := range stream.GetAllTokens() {
+		switch token.GetTokenType() {
+		case parser.PostgreSQLLexerBeginDollarStringConstant:
+			dollarString = &strings.Builder{}
+			dollarString.WriteString(token.GetText())
+			tokens = append(tokens, token)
+		case parser.PostgreSQLLexerDollarText, parser.PostgreSQLLexerEndDollarStringConstant:
+			if dollarString == nil {
+				tokens = append(tokens, token)
+				continue
+			}
+			dollarString.WriteString(token.GetText())
+			tokens[len(tokens)-1].SetText(dollarString.String())
+			if token.GetTokenType() == parser.PostgreSQLLexerEndDollarStringConstant {
+				dollarString = nil
+			}
+		default:
+			tokens = append(tokens, token)
+		}
+	}
+
+	return base.NewFormatTokens(tokens, func(token antlr.Token) base.FormatTokenKind {
+		switch token.GetTokenType() {
+		case parser.PostgreSQLLexerWhitespace, parser.PostgreSQLLexerNewline:
+			return base.FormatTokenWhitespace
+		case parser.PostgreSQLLexerLineComment:
+			return base.FormatTokenLineComment
+		case parser.PostgreSQLLexerBlockComment, parser.PostgreSQLLexerUnterminatedBlockComment:
+			return base.FormatTokenBlockComment
+		default:
+			return base.FormatTokenCode
+		}
+	}), nil
+}
@@ -0,0 +1,59 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+	}{
+		{
+			statement: "with x as (select 1 as a) select a::text, b from x natural join y where a is distinct from b union all select 1, 2;",
+			want: `with x as (
+  select 1 as a
+)
+select a::text, b
+from x
+natural join y
+where a is distinct from b
+union all
+select 1, 2;`,
+		},
+		{
+			// The dollar-quoted function body is kept as is.
+			statement: "CREATE FUNCTION f() RETURNS int AS $$\nbegin\n    return 1;\nend;\n$$ LANGUAGE plpgsql;\nselect 1",
+			want: `CREATE FUNCTION f() RETURNS int AS $$
+begin
+    return 1;
+end;
+$$ LANGUAGE plpgsql;
+select 1`,
+		},
+		{
+			statement: "insert into t default values returning id;",
+			want: `insert into t default values
+returning id;`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := Format(test.statement, base.FormatOptions{Indent: "  "})
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
+
+func TestFormatBaseIndent(t *testing.T) {
+	// The lines are indented by the base indent, but the multi-line string literal and comment are kept as is.
+	statement := "select 'a\nb' as x, /* c\nd */ y from t where x = 1;\n"
+	want := "select 'a\nb' as x, /* c\nd */ y\n    from t\n    where x = 1;\n"
+	got, err := Format(statement, base.FormatOptions{Indent: "  ", BaseIndent: "    "})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
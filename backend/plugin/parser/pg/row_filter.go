@@ -0,0 +1,292 @@
+package pg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/parser/postgresql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterRewriteRowFilterFunc(storepb.Engine_POSTGRES, RewriteRowFilter)
+}
+
+// RewriteRowFilter rewrites the PostgreSQL query statement so that every filtered table in the FROM clauses
+// is replaced by a subquery selecting the rows satisfying the row filters, e.g.
+// "SELECT * FROM t" is rewritten to "SELECT * FROM (SELECT * FROM t WHERE (region = 'us')) AS "t"".
+func RewriteRowFilter(statement string, rCtx base.RowFilterContext) (*base.RowFilterResult, error) {
+	for _, filter := range rCtx.Filters {
+		if err := validateRowFilterPredicate(filter.Predicate); err != nil {
+			return nil, errors.Wrapf(err, "invalid row filter on table %q", filter.Table)
+		}
+	}
+	if rCtx.DefaultSchema == "" {
+		rCtx.DefaultSchema = "public"
+	}
+
+	parseResults, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(parseResults) != 1 {
+		return nil, errors.Errorf("expected exactly one statement, but got %d", len(parseResults))
+	}
+	parseResult := parseResults[0]
+	// The other statements, such as DO, CALL, EXECUTE and EXPLAIN ANALYZE, may run the queries which cannot be rewritten.
+	if root, ok := parseResult.Tree.(*parser.RootContext); ok {
+		stmts := root.Stmtblock().Stmtmulti().AllStmt()
+		if len(stmts) != 1 || stmts[0].Selectstmt() == nil {
+			return nil, errors.Errorf("only SELECT statements are allowed in the queries with row filters")
+		}
+	}
+
+	cteCollector := &cteNameCollector{cteNames: make(map[string]bool)}
+	antlr.ParseTreeWalkerDefault.Walk(cteCollector, parseResult.Tree)
+
+	rewriter := &rowFilterRewriter{
+		rCtx:         rCtx,
+		cteNames:     cteCollector.cteNames,
+		rewriter:     antlr.NewTokenStreamRewriter(parseResult.Tokens),
+		rewrittenRef: make(map[*parser.Qualified_nameContext]bool),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(rewriter, parseResult.Tree)
+	if rewriter.err != nil {
+		return nil, rewriter.err
+	}
+
+	result := &base.RowFilterResult{
+		Statement: statement,
+		Applied:   rewriter.applied,
+		Tables:    rewriter.tables,
+	}
+	if len(rewriter.applied) == 0 {
+		return result, nil
+	}
+	result.Statement = rewriter.rewriter.GetTextDefault()
+	// Make sure the rewritten statement is still a single valid statement.
+	rewrittenResults, err := ParsePostgreSQL(result.Statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the statement rewritten with the row filters")
+	}
+	if len(rewrittenResults) != 1 {
+		return nil, errors.Errorf("the statement rewritten with the row filters is not a single statement")
+	}
+	return result, nil
+}
+
+// validateRowFilterPredicate checks that the predicate cannot escape from the WHERE clause of the subquery.
+func validateRowFilterPredicate(predicate string) error {
+	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(predicate))
+	lexerErrorListener := &base.ParseErrorListener{
+		Statement: predicate,
+	}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return lexerErrorListener.Err
+	}
+
+	depth := 0
+	for _, token := range stream.GetAllTokens() {
+		switch token.GetTokenType() {
+		case parser.PostgreSQLLexerSEMI:
+			return errors.Errorf("predicate %q must not contain semicolons", predicate)
+		case parser.PostgreSQLLexerOPEN_PAREN:
+			depth++
+		case parser.PostgreSQLLexerCLOSE_PAREN:
+			depth--
+			if depth < 0 {
+				return errors.Errorf("predicate %q has unbalanced parentheses", predicate)
+			}
+		default:
+		}
+	}
+	if depth != 0 {
+		return errors.Errorf("predicate %q has unbalanced parentheses", predicate)
+	}
+	return nil
+}
+
+// rowFilterAllowedFunctions is the built-in functions allowed in the queries with row filters.
+// The other functions may run the queries by themselves and read the filtered tables without the row filters,
+// e.g. "SELECT query_to_xml('SELECT * FROM t', true, false, 'ns')".
+var rowFilterAllowedFunctions = map[string]bool{
+	// Aggregate functions.
+	"count": true, "sum": true, "avg": true, "min": true, "max": true, "bool_and": true, "bool_or": true,
+	"every": true, "string_agg": true, "array_agg": true, "json_agg": true, "jsonb_agg": true,
+	"json_object_agg": true, "jsonb_object_agg": true, "stddev": true, "stddev_pop": true, "stddev_samp": true,
+	"variance": true, "var_pop": true, "var_samp": true, "percentile_cont": true, "percentile_disc": true, "mode": true,
+	// Window functions.
+	"row_number": true, "rank": true, "dense_rank": true, "percent_rank": true, "cume_dist": true, "ntile": true,
+	"lag": true, "lead": true, "first_value": true, "last_value": true, "nth_value": true,
+	// Mathematical functions.
+	"abs": true, "ceil": true, "ceiling": true, "floor": true, "round": true, "trunc": true, "mod": true,
+	"power": true, "sqrt": true, "exp": true, "ln": true, "log": true, "sign": true, "greatest": true, "least": true,
+	// String functions.
+	"lower": true, "upper": true, "length": true, "char_length": true, "octet_length": true, "btrim": true,
+	"ltrim": true, "rtrim": true, "concat": true, "concat_ws": true, "left": true, "right": true, "lpad": true,
+	"rpad": true, "replace": true, "split_part": true, "strpos": true, "substr": true, "initcap": true,
+	"reverse": true, "repeat": true, "md5": true, "to_char": true, "to_number": true, "format": true,
+	"regexp_replace": true, "regexp_match": true,
+	// Date and time functions.
+	"now": true, "age": true, "date_trunc": true, "date_part": true, "to_date": true, "to_timestamp": true,
+	"make_date": true, "make_interval": true, "clock_timestamp": true, "statement_timestamp": true,
+	// JSON and array functions.
+	"to_json": true, "to_jsonb": true, "json_build_object": true, "jsonb_build_object": true,
+	"json_build_array": true, "jsonb_build_array": true, "jsonb_extract_path_text": true,
+	"json_extract_path_text": true, "array_length": true, "array_to_string": true, "cardinality": true,
+}
+
+type cteNameCollector struct {
+	*parser.BasePostgreSQLParserListener
+
+	cteNames map[string]bool
+}
+
+func (c *cteNameCollector) EnterCommon_table_expr(ctx *parser.Common_table_exprContext) {
+	c.cteNames[NormalizePostgreSQLName(ctx.Name())] = true
+}
+
+type rowFilterRewriter struct {
+	*parser.BasePostgreSQLParserListener
+
+	rCtx     base.RowFilterContext
+	cteNames map[string]bool
+	rewriter *antlr.TokenStreamRewriter
+	// rewrittenRef is the qualified names in the FROM clauses, which are handled by EnterTable_ref.
+	rewrittenRef map[*parser.Qualified_nameContext]bool
+
+	applied []*base.RowFilter
+	tables  []base.SchemaResource
+	err     error
+}
+
+// EnterTable_ref replaces the filtered table in the FROM clause with the filtered subquery.
+func (r *rowFilterRewriter) EnterTable_ref(ctx *parser.Table_refContext) {
+	if r.err != nil || ctx.Relation_expr() == nil {
+		return
+	}
+	qualifiedName, ok := ctx.Relation_expr().Qualified_name().(*parser.Qualified_nameContext)
+	if !ok || qualifiedName == nil {
+		return
+	}
+	r.rewrittenRef[qualifiedName] = true
+
+	resource, parts, err := r.resolveQualifiedName(qualifiedName)
+	if err != nil {
+		r.err = err
+		return
+	}
+	if parts == 1 && r.cteNames[resource.Table] {
+		if len(r.rCtx.MatchFilters(resource.Database, resource.Schema, resource.Table)) > 0 {
+			r.err = errors.Errorf("common table expression %q has the same name as the filtered table", resource.Table)
+		}
+		return
+	}
+	r.tables = append(r.tables, resource)
+
+	filters := r.rCtx.MatchFilters(resource.Database, resource.Schema, resource.Table)
+	if len(filters) == 0 {
+		return
+	}
+
+	tokens := r.rewriter.GetTokenStream()
+	relationText := tokens.GetTextFromInterval(ctx.Relation_expr().GetSourceInterval())
+	stop := ctx.Relation_expr().GetStop().GetTokenIndex()
+	source := relationText
+	if sample := ctx.Tablesample_clause(); sample != nil {
+		source += " " + tokens.GetTextFromInterval(sample.GetSourceInterval())
+		stop = max(stop, sample.GetStop().GetTokenIndex())
+	}
+	alias := fmt.Sprintf(`AS "%s"`, strings.ReplaceAll(resource.Table, `"`, `""`))
+	if aliasClause := ctx.Opt_alias_clause(); aliasClause != nil {
+		alias = tokens.GetTextFromInterval(aliasClause.GetSourceInterval())
+		stop = max(stop, aliasClause.GetStop().GetTokenIndex())
+	}
+	r.rewriter.ReplaceDefault(
+		ctx.Relation_expr().GetStart().GetTokenIndex(),
+		stop,
+		fmt.Sprintf("(SELECT * FROM %s WHERE %s) %s", source, base.RowFilterCondition(filters), alias),
+	)
+	r.applied = append(r.applied, filters...)
+}
+
+// EnterFunc_table rejects the table functions, such as "SELECT * FROM query_to_xml(...)" and "ROWS FROM (...)".
+func (r *rowFilterRewriter) EnterFunc_table(ctx *parser.Func_tableContext) {
+	if r.err != nil {
+		return
+	}
+	r.err = errors.Errorf("table function %q is not allowed in the queries with row filters", ctx.GetText())
+}
+
+// EnterFunc_application rejects the functions not in rowFilterAllowedFunctions.
+func (r *rowFilterRewriter) EnterFunc_application(ctx *parser.Func_applicationContext) {
+	if r.err != nil {
+		return
+	}
+	name := NormalizePostgreSQLFuncName(ctx.Func_name())
+	if len(name) == 2 && name[0] == "pg_catalog" {
+		name = name[1:]
+	}
+	if len(name) != 1 || !rowFilterAllowedFunctions[name[0]] {
+		r.err = errors.Errorf("function %q is not allowed in the queries with row filters", strings.Join(name, "."))
+	}
+}
+
+// EnterQualified_name rejects the filtered tables referenced outside the FROM clauses, such as the DML targets.
+func (r *rowFilterRewriter) EnterQualified_name(ctx *parser.Qualified_nameContext) {
+	if r.err != nil || r.rewrittenRef[ctx] {
+		return
+	}
+	resource, parts, err := r.resolveQualifiedName(ctx)
+	if err != nil {
+		// The qualified names of the other objects may not be in the form of the table names.
+		return
+	}
+	if parts == 1 && r.cteNames[resource.Table] {
+		return
+	}
+	if len(r.rCtx.MatchFilters(resource.Database, resource.Schema, resource.Table)) > 0 {
+		r.err = errors.Errorf("table %q with row filters can only be referenced in the FROM clause", resource.Table)
+	}
+}
+
+// resolveQualifiedName returns the table resource and the number of the parts of the qualified name.
+func (r *rowFilterRewriter) resolveQualifiedName(ctx *parser.Qualified_nameContext) (base.SchemaResource, int, error) {
+	parts := []string{NormalizePostgreSQLColid(ctx.Colid())}
+	if ctx.Indirection() != nil {
+		for _, el := range ctx.Indirection().AllIndirection_el() {
+			if el.Attr_name() == nil {
+				return base.SchemaResource{}, 0, errors.Errorf("improper qualified name: %s", ctx.GetText())
+			}
+			parts = append(parts, normalizePostgreSQLAttrName(el.Attr_name()))
+		}
+	}
+
+	resource := base.SchemaResource{
+		Database: r.rCtx.DefaultDatabase,
+		Schema:   r.rCtx.DefaultSchema,
+	}
+	switch len(parts) {
+	case 1:
+		resource.Table = parts[0]
+	case 2:
+		resource.Schema = parts[0]
+		resource.Table = parts[1]
+	case 3:
+		resource.Database = parts[0]
+		resource.Schema = parts[1]
+		resource.Table = parts[2]
+	default:
+		return base.SchemaResource{}, 0, errors.Errorf("improper qualified name (too many dotted names): %s", ctx.GetText())
+	}
+	return resource, len(parts), nil
+}
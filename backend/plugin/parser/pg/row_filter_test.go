@@ -0,0 +1,120 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestRewriteRowFilter(t *testing.T) {
+	filters := []*base.RowFilter{
+		{Database: "db", Schema: "public", Table: "orders", Predicate: "region = 'us'"},
+		{Database: "db", Schema: "public", Table: "orders", Predicate: "deleted = false"},
+		{Database: "db", Schema: "sales", Table: "customers", Predicate: "tier <> 'vip'"},
+	}
+	tests := []struct {
+		statement string
+		want      string
+		applied   int
+		wantErr   bool
+	}{
+		{
+			statement: "SELECT * FROM orders",
+			want:      `SELECT * FROM (SELECT * FROM orders WHERE (region = 'us') AND (deleted = false)) AS "orders"`,
+			applied:   2,
+		},
+		{
+			statement: "SELECT o.id, c.name FROM public.orders o JOIN sales.customers AS c ON o.customer_id = c.id;",
+			want:      `SELECT o.id, c.name FROM (SELECT * FROM public.orders WHERE (region = 'us') AND (deleted = false)) o JOIN (SELECT * FROM sales.customers WHERE (tier <> 'vip')) AS c ON o.customer_id = c.id;`,
+			applied:   3,
+		},
+		{
+			statement: "SELECT count(*) FROM (SELECT * FROM db.public.orders) t WHERE id IN (SELECT order_id FROM items)",
+			want:      `SELECT count(*) FROM (SELECT * FROM (SELECT * FROM db.public.orders WHERE (region = 'us') AND (deleted = false)) AS "orders") t WHERE id IN (SELECT order_id FROM items)`,
+			applied:   2,
+		},
+		{
+			// The tables of other databases and schemas are not filtered.
+			statement: "SELECT * FROM other.public.orders, sales.orders, customers",
+			want:      "SELECT * FROM other.public.orders, sales.orders, customers",
+		},
+		{
+			statement: "WITH x AS (SELECT * FROM orders) SELECT * FROM x",
+			want:      `WITH x AS (SELECT * FROM (SELECT * FROM orders WHERE (region = 'us') AND (deleted = false)) AS "orders") SELECT * FROM x`,
+			applied:   2,
+		},
+		{
+			// The CTE with the same name as the filtered table is ambiguous.
+			statement: "WITH orders AS (SELECT 1) SELECT * FROM orders",
+			wantErr:   true,
+		},
+		{
+			statement: "TABLE orders",
+			wantErr:   true,
+		},
+		{
+			statement: "DELETE FROM orders",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT 1; SELECT 2",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT count(*), lower(region), pg_catalog.upper(name), COALESCE(note, '') FROM orders",
+			want:      `SELECT count(*), lower(region), pg_catalog.upper(name), COALESCE(note, '') FROM (SELECT * FROM orders WHERE (region = 'us') AND (deleted = false)) AS "orders"`,
+			applied:   2,
+		},
+		{
+			// The functions may run the queries reading the filtered tables without the row filters.
+			statement: "SELECT query_to_xml('select * from orders', true, false, '')",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT * FROM query_to_xml('select * from public.orders', true, false, '') x",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT * FROM generate_series(1, 10) g JOIN orders ON orders.id = g",
+			wantErr:   true,
+		},
+		{
+			statement: "SELECT public.read_orders() FROM orders",
+			wantErr:   true,
+		},
+		{
+			statement: "DO $$ BEGIN PERFORM 1; END $$",
+			wantErr:   true,
+		},
+		{
+			statement: "EXECUTE read_orders",
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		result, err := RewriteRowFilter(test.statement, base.RowFilterContext{
+			DefaultDatabase: "db",
+			Filters:         filters,
+		})
+		if test.wantErr {
+			require.Error(t, err, test.statement)
+			continue
+		}
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, result.Statement, test.statement)
+		require.Len(t, result.Applied, test.applied, test.statement)
+	}
+}
+
+func TestRewriteRowFilterInvalidPredicate(t *testing.T) {
+	for _, predicate := range []string{"true) OR (true", "true; DROP TABLE orders", "(true"} {
+		_, err := RewriteRowFilter("SELECT * FROM orders", base.RowFilterContext{
+			DefaultDatabase: "db",
+			Filters:         []*base.RowFilter{{Database: "db", Schema: "public", Table: "orders", Predicate: predicate}},
+		})
+		require.Error(t, err, predicate)
+	}
+}
@@ -0,0 +1,46 @@
+package plsql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/plsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterGetCTEDefinitions(storepb.Engine_ORACLE, GetCTEDefinitions)
+}
+
+// GetCTEDefinitions returns the common table expressions defined in the statement.
+func GetCTEDefinitions(statement string) ([]base.CTEDefinition, error) {
+	lexer := parser.NewPlSqlLexer(antlr.NewInputStream(statement))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewPlSqlParser(stream)
+	p.SetVersion12(true)
+	// The statement may be incomplete while typing, so the syntax errors are ignored and the recovered parse tree is used.
+	lexer.RemoveErrorListeners()
+	p.RemoveErrorListeners()
+	p.BuildParseTrees = true
+
+	listener := &cteDefinitionListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, p.Sql_script())
+	return listener.definitions, nil
+}
+
+type cteDefinitionListener struct {
+	*parser.BasePlSqlParserListener
+
+	definitions []base.CTEDefinition
+}
+
+func (l *cteDefinitionListener) EnterFactoring_element(ctx *parser.Factoring_elementContext) {
+	if ctx.Query_name() == nil {
+		return
+	}
+	l.definitions = append(l.definitions, base.CTEDefinition{
+		Name:  NormalizeIdentifierContext(ctx.Query_name().Identifier()),
+		Start: ctx.Query_name().GetStart().GetStart(),
+		End:   ctx.Query_name().GetStop().GetStop() + 1,
+	})
+}
@@ -0,0 +1,89 @@
+package plsql
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/plsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_ORACLE, Format)
+}
+
+// Format formats the Oracle statement.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	return base.FormatWithLexer(statement, lexForFormat, options)
+}
+
+func lexForFormat(statement string) ([]base.FormatToken, error) {
+	lexer := parser.NewPlSqlLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{
+		Statement: statement,
+	}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return nil, lexerErrorListener.Err
+	}
+	tokens := stream.GetAllTokens()
+
+	// The slash terminating the PL/SQL blocks must stand alone on its line.
+	terminators := make(map[int]bool)
+	for i, token := range tokens {
+		if token.GetTokenType() == parser.PlSqlLexerSOLIDUS && isAloneOnLine(tokens, i) {
+			terminators[i] = true
+		}
+	}
+
+	return base.NewFormatTokens(tokens, func(token antlr.Token) base.FormatTokenKind {
+		switch token.GetTokenType() {
+		case parser.PlSqlLexerSPACES:
+			return base.FormatTokenWhitespace
+		case parser.PlSqlLexerSINGLE_LINE_COMMENT:
+			return base.FormatTokenLineComment
+		case parser.PlSqlLexerMULTI_LINE_COMMENT:
+			return base.FormatTokenBlockComment
+		case parser.PlSqlLexerREMARK_COMMENT, parser.PlSqlLexerPROMPT_MESSAGE, parser.PlSqlLexerSTART_CMD:
+			// SQL*Plus commands.
+			return base.FormatTokenLine
+		case parser.PlSqlLexerSOLIDUS:
+			if terminators[token.GetTokenIndex()] {
+				return base.FormatTokenLine
+			}
+			return base.FormatTokenCode
+		default:
+			return base.FormatTokenCode
+		}
+	}), nil
+}
+
+// isAloneOnLine returns true if there are only whitespaces between the token and the line breaks before and after it.
+func isAloneOnLine(tokens []antlr.Token, index int) bool {
+	for i := index - 1; i >= 0; i-- {
+		if tokens[i].GetTokenType() != parser.PlSqlLexerSPACES {
+			// The single line comment ends with the line break.
+			return tokens[i].GetTokenType() == parser.PlSqlLexerSINGLE_LINE_COMMENT
+		}
+		if strings.Contains(tokens[i].GetText(), "\n") {
+			break
+		}
+	}
+	for i := index + 1; i < len(tokens); i++ {
+		if tokens[i].GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		if tokens[i].GetTokenType() != parser.PlSqlLexerSPACES {
+			return false
+		}
+		if strings.Contains(tokens[i].GetText(), "\n") {
+			break
+		}
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+package plsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	statement := "REM hello\nselect a / 2 from dual where rownum < 2 minus select 1 from dual;\nbegin\nnull;\nend;\n/\nPROMPT done\nselect 1 from dual -- x\n;"
+	want := `REM hello
+select a / 2
+from dual
+where rownum < 2
+minus
+select 1
+from dual;
+begin
+  null;
+end;
+/
+PROMPT done
+select 1
+from dual -- x
+;`
+	got, err := Format(statement, base.FormatOptions{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -23,6 +23,46 @@ func GetStatementTypes(asts any) ([]string, error) {
 	return sqlTypes, nil
 }
 
+// StatementTypeWithPosition contains statement type and its position information.
+type StatementTypeWithPosition struct {
+	Type string
+	// Line is the one-based line number where the statement ends.
+	Line int
+	Text string
+}
+
+// GetStatementTypesWithPositions returns the type of each statement with position information.
+// The line numbers are one-based. The statements of unknown types are skipped.
+func GetStatementTypesWithPositions(asts any) ([]StatementTypeWithPosition, error) {
+	nodes, ok := asts.([]*ParseResult)
+	if !ok {
+		return nil, errors.Errorf("invalid ast type %T", asts)
+	}
+	var results []StatementTypeWithPosition
+	for _, node := range nodes {
+		if node == nil || node.Tree == nil {
+			return nil, errors.New("invalid parse result")
+		}
+		for _, child := range node.Tree.GetChildren() {
+			// The SQL*Plus commands and EOF are skipped.
+			ctx, ok := child.(*parser.Unit_statementContext)
+			if !ok {
+				continue
+			}
+			t := getStatementType(ctx)
+			if t == "UNKNOWN" {
+				continue
+			}
+			results = append(results, StatementTypeWithPosition{
+				Type: t,
+				Line: node.BaseLine + ctx.GetStop().GetLine(),
+				Text: node.Tokens.GetTextFromRuleContext(ctx),
+			})
+		}
+	}
+	return results, nil
+}
+
 func getStatementType(node antlr.Tree) string {
 	switch ctx := node.(type) {
 	case *parser.Sql_scriptContext, *parser.Unit_statementContext, *parser.Data_manipulation_language_statementsContext:
@@ -43,6 +83,36 @@ func getStatementType(node antlr.Tree) string {
 		return "CREATE_TABLE"
 	case *parser.Create_viewContext:
 		return "CREATE_VIEW"
+	case *parser.Create_materialized_viewContext:
+		return "CREATE_MATERIALIZED_VIEW"
+	case *parser.Create_sequenceContext:
+		return "CREATE_SEQUENCE"
+	case *parser.Create_function_bodyContext:
+		return "CREATE_FUNCTION"
+	case *parser.Create_procedure_bodyContext:
+		return "CREATE_PROCEDURE"
+	case *parser.Create_triggerContext:
+		return "CREATE_TRIGGER"
+	case *parser.Comment_on_tableContext, *parser.Comment_on_columnContext, *parser.Comment_on_materializedContext:
+		return "COMMENT"
+	case *parser.Alter_sequenceContext:
+		return "ALTER_SEQUENCE"
+	case *parser.Alter_viewContext:
+		return "ALTER_VIEW"
+	case *parser.Alter_functionContext:
+		return "ALTER_FUNCTION"
+	case *parser.Alter_procedureContext:
+		return "ALTER_PROCEDURE"
+	case *parser.Drop_sequenceContext:
+		return "DROP_SEQUENCE"
+	case *parser.Drop_functionContext:
+		return "DROP_FUNCTION"
+	case *parser.Drop_procedureContext:
+		return "DROP_PROCEDURE"
+	case *parser.Drop_materialized_viewContext:
+		return "DROP_MATERIALIZED_VIEW"
+	case *parser.Rename_objectContext:
+		return "RENAME"
 	case *parser.Drop_databaseContext:
 		return "DROP_DATABASE"
 	case *parser.Drop_indexContext:
@@ -38,3 +38,25 @@ func TestGetStatementType(t *testing.T) {
 		a.Equal(test.Want, sqlType)
 	}
 }
+
+func TestGetStatementTypesWithPositions(t *testing.T) {
+	a := require.New(t)
+	statement := `CREATE TABLE t (id NUMBER);
+
+CREATE SEQUENCE s;
+COMMENT ON TABLE t IS 'the table';
+ALTER TABLE t
+  ADD name VARCHAR2(10);
+GRANT SELECT ON t TO u;
+`
+	results, err := ParsePLSQL(statement)
+	a.NoError(err)
+	stmts, err := GetStatementTypesWithPositions(results)
+	a.NoError(err)
+	a.Equal([]StatementTypeWithPosition{
+		{Type: "CREATE_TABLE", Line: 1, Text: "CREATE TABLE t (id NUMBER);"},
+		{Type: "CREATE_SEQUENCE", Line: 3, Text: "CREATE SEQUENCE s;"},
+		{Type: "COMMENT", Line: 4, Text: "COMMENT ON TABLE t IS 'the table'"},
+		{Type: "ALTER_TABLE", Line: 6, Text: "ALTER TABLE t\n  ADD name VARCHAR2(10);"},
+	}, stmts)
+}
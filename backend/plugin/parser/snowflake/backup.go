@@ -0,0 +1,310 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/parser/snowflake"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	maxTableNameLength = 255
+	defaultSchema      = "PUBLIC"
+)
+
+type StatementType int
+
+const (
+	StatementTypeUnknown StatementType = iota
+	StatementTypeUpdate
+	StatementTypeInsert
+	StatementTypeDelete
+)
+
+type TableReference struct {
+	Database      string
+	Schema        string
+	Table         string
+	StatementType StatementType
+}
+
+type statementInfo struct {
+	offset    int
+	statement string
+	tree      antlr.ParserRuleContext
+	table     *TableReference
+	// objectName is the table name as written in the statement.
+	objectName string
+}
+
+func init() {
+	base.RegisterTransformDMLToSelect(storepb.Engine_SNOWFLAKE, TransformDMLToSelect)
+}
+
+func TransformDMLToSelect(_ context.Context, _ base.TransformContext, statement string, sourceDatabase string, targetSchema string, tablePrefix string) ([]base.BackupStatement, error) {
+	statementInfoList, err := prepareTransformation(sourceDatabase, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to prepare transformation")
+	}
+
+	return generateSQL(statementInfoList, targetSchema, tablePrefix)
+}
+
+func generateSQL(statementInfoList []statementInfo, targetSchema string, tablePrefix string) ([]base.BackupStatement, error) {
+	groupByTable := make(map[string][]statementInfo)
+	for _, item := range statementInfoList {
+		key := fmt.Sprintf("%s.%s.%s", item.table.Database, item.table.Schema, item.table.Table)
+		groupByTable[key] = append(groupByTable[key], item)
+	}
+
+	// Check if the statement type is the same for all statements on the same table.
+	for key, list := range groupByTable {
+		statementType := StatementTypeUnknown
+		for _, item := range list {
+			if statementType == StatementTypeUnknown {
+				statementType = item.table.StatementType
+			}
+			if statementType != item.table.StatementType {
+				return nil, errors.Errorf("The statement type is not the same for all statements on the same table %q", key)
+			}
+		}
+	}
+
+	var result []base.BackupStatement
+	for key, list := range groupByTable {
+		backupStatement, err := generateSQLForTable(list, targetSchema, tablePrefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate SQL for table %q", key)
+		}
+		result = append(result, *backupStatement)
+	}
+
+	slices.SortFunc(result, func(a, b base.BackupStatement) int {
+		if a.StartPosition.Line != b.StartPosition.Line {
+			if a.StartPosition.Line < b.StartPosition.Line {
+				return -1
+			}
+			return 1
+		}
+		if a.StartPosition.Column != b.StartPosition.Column {
+			if a.StartPosition.Column < b.StartPosition.Column {
+				return -1
+			}
+			return 1
+		}
+		if a.SourceTableName < b.SourceTableName {
+			return -1
+		}
+		if a.SourceTableName > b.SourceTableName {
+			return 1
+		}
+		return 0
+	})
+
+	return result, nil
+}
+
+func generateSQLForTable(statementInfoList []statementInfo, targetSchema string, tablePrefix string) (*base.BackupStatement, error) {
+	table := statementInfoList[0].table
+
+	targetTable := fmt.Sprintf("%s_%s_%s", tablePrefix, table.Table, table.Schema)
+	targetTable, _ = common.TruncateString(targetTable, maxTableNameLength)
+	var buf strings.Builder
+	if _, err := fmt.Fprintf(&buf, `CREATE TABLE "%s"."%s" AS`+"\n", targetSchema, targetTable); err != nil {
+		return nil, errors.Wrap(err, "failed to write to buffer")
+	}
+
+	for i, item := range statementInfoList {
+		if i != 0 {
+			if _, err := buf.WriteString("\n  UNION\n"); err != nil {
+				return nil, errors.Wrap(err, "failed to write to buffer")
+			}
+		}
+		// Snowflake does not support aliases for the target table of UPDATE and DELETE,
+		// so the table name as written in the statement is used to select the columns.
+		if _, err := fmt.Fprintf(&buf, `  SELECT %s.* `, item.objectName); err != nil {
+			return nil, errors.Wrap(err, "failed to write to buffer")
+		}
+		if err := writeSuffixSelectClause(&buf, item.tree); err != nil {
+			return nil, errors.Wrap(err, "failed to write suffix select clause")
+		}
+	}
+
+	if _, err := buf.WriteString(";"); err != nil {
+		return nil, errors.Wrap(err, "failed to write to buffer")
+	}
+
+	return &base.BackupStatement{
+		Statement:       buf.String(),
+		SourceSchema:    table.Schema,
+		SourceTableName: table.Table,
+		TargetTableName: targetTable,
+		StartPosition: &storepb.Position{
+			Line:   int32(statementInfoList[0].tree.GetStart().GetLine()),
+			Column: int32(statementInfoList[0].tree.GetStart().GetColumn()),
+		},
+		EndPosition: &storepb.Position{
+			Line:   int32(statementInfoList[len(statementInfoList)-1].tree.GetStop().GetLine()),
+			Column: int32(statementInfoList[len(statementInfoList)-1].tree.GetStop().GetColumn()),
+		},
+	}, nil
+}
+
+func writeSuffixSelectClause(buf *strings.Builder, tree antlr.Tree) error {
+	extractor := &suffixSelectClauseExtractor{
+		buf: buf,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(extractor, tree)
+	return extractor.err
+}
+
+type suffixSelectClauseExtractor struct {
+	*parser.BaseSnowflakeParserListener
+
+	buf *strings.Builder
+	err error
+}
+
+func (e *suffixSelectClauseExtractor) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if e.err != nil || !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	tokens := ctx.GetParser().GetTokenStream()
+
+	if _, err := fmt.Fprintf(e.buf, "FROM %s", tokens.GetTextFromRuleContext(ctx.Object_name())); err != nil {
+		e.err = errors.Wrap(err, "failed to write to buffer")
+		return
+	}
+
+	if ctx.Table_sources() != nil {
+		if _, err := fmt.Fprintf(e.buf, ", %s", tokens.GetTextFromRuleContext(ctx.Table_sources())); err != nil {
+			e.err = errors.Wrap(err, "failed to write to buffer")
+			return
+		}
+	}
+
+	if ctx.Search_condition() != nil {
+		if _, err := fmt.Fprintf(e.buf, " WHERE %s", tokens.GetTextFromRuleContext(ctx.Search_condition())); err != nil {
+			e.err = errors.Wrap(err, "failed to write to buffer")
+		}
+	}
+}
+
+func (e *suffixSelectClauseExtractor) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if e.err != nil || !isTopLevel(ctx.GetParent()) {
+		return
+	}
+	tokens := ctx.GetParser().GetTokenStream()
+
+	if _, err := fmt.Fprintf(e.buf, "FROM %s", tokens.GetTextFromRuleContext(ctx.Object_name())); err != nil {
+		e.err = errors.Wrap(err, "failed to write to buffer")
+		return
+	}
+
+	for _, item := range ctx.AllTable_or_query() {
+		if _, err := fmt.Fprintf(e.buf, ", %s", tokens.GetTextFromRuleContext(item)); err != nil {
+			e.err = errors.Wrap(err, "failed to write to buffer")
+			return
+		}
+	}
+
+	if ctx.Search_condition() != nil {
+		if _, err := fmt.Fprintf(e.buf, " WHERE %s", tokens.GetTextFromRuleContext(ctx.Search_condition())); err != nil {
+			e.err = errors.Wrap(err, "failed to write to buffer")
+		}
+	}
+}
+
+func prepareTransformation(databaseName, statement string) ([]statementInfo, error) {
+	parseResult, err := ParseSnowSQL(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+
+	extractor := &dmlExtractor{
+		databaseName: databaseName,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(extractor, parseResult.Tree)
+	return extractor.dmls, nil
+}
+
+// isTopLevel returns whether the statement is not nested in other statements.
+func isTopLevel(ctx antlr.Tree) bool {
+	if ctx == nil {
+		return true
+	}
+	switch ctx := ctx.(type) {
+	case *parser.Dml_commandContext,
+		*parser.Sql_commandContext,
+		*parser.BatchContext:
+		return isTopLevel(ctx.GetParent())
+	case *parser.Snowflake_fileContext:
+		return true
+	default:
+		return false
+	}
+}
+
+type dmlExtractor struct {
+	*parser.BaseSnowflakeParserListener
+
+	databaseName string
+	dmls         []statementInfo
+	offset       int
+}
+
+func (e *dmlExtractor) ExitBatch(*parser.BatchContext) {
+	e.offset++
+}
+
+func (e *dmlExtractor) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if isTopLevel(ctx.GetParent()) {
+		table := e.extractTableReference(ctx.Object_name())
+		table.StatementType = StatementTypeUpdate
+		e.dmls = append(e.dmls, statementInfo{
+			offset:     e.offset,
+			statement:  ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx),
+			tree:       ctx,
+			table:      table,
+			objectName: ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx.Object_name()),
+		})
+	}
+}
+
+func (e *dmlExtractor) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if isTopLevel(ctx.GetParent()) {
+		table := e.extractTableReference(ctx.Object_name())
+		table.StatementType = StatementTypeDelete
+		e.dmls = append(e.dmls, statementInfo{
+			offset:     e.offset,
+			statement:  ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx),
+			tree:       ctx,
+			table:      table,
+			objectName: ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx.Object_name()),
+		})
+	}
+}
+
+func (e *dmlExtractor) extractTableReference(ctx parser.IObject_nameContext) *TableReference {
+	table := &TableReference{
+		Database: e.databaseName,
+		Schema:   defaultSchema,
+	}
+	if d := ctx.GetD(); d != nil {
+		table.Database = NormalizeSnowSQLObjectNamePart(d)
+	}
+	if s := ctx.GetS(); s != nil {
+		table.Schema = NormalizeSnowSQLObjectNamePart(s)
+	}
+	table.Table = NormalizeSnowSQLObjectNamePart(ctx.GetO())
+	return table
+}
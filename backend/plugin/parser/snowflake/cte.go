@@ -0,0 +1,45 @@
+package snowflake
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/snowflake"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterGetCTEDefinitions(storepb.Engine_SNOWFLAKE, GetCTEDefinitions)
+}
+
+// GetCTEDefinitions returns the common table expressions defined in the statement.
+func GetCTEDefinitions(statement string) ([]base.CTEDefinition, error) {
+	lexer := parser.NewSnowflakeLexer(antlr.NewInputStream(statement))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewSnowflakeParser(stream)
+	// The statement may be incomplete while typing, so the syntax errors are ignored and the recovered parse tree is used.
+	lexer.RemoveErrorListeners()
+	p.RemoveErrorListeners()
+	p.BuildParseTrees = true
+
+	listener := &cteDefinitionListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, p.Snowflake_file())
+	return listener.definitions, nil
+}
+
+type cteDefinitionListener struct {
+	*parser.BaseSnowflakeParserListener
+
+	definitions []base.CTEDefinition
+}
+
+func (l *cteDefinitionListener) EnterCommon_table_expression(ctx *parser.Common_table_expressionContext) {
+	if ctx.Id_() == nil {
+		return
+	}
+	l.definitions = append(l.definitions, base.CTEDefinition{
+		Name:  NormalizeSnowSQLObjectNamePart(ctx.Id_()),
+		Start: ctx.Id_().GetStart().GetStart(),
+		End:   ctx.Id_().GetStop().GetStop() + 1,
+	})
+}
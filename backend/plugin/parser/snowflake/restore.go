@@ -0,0 +1,244 @@
+package snowflake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/parser/snowflake"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	maxCommentLength = 1000
+)
+
+func init() {
+	base.RegisterGenerateRestoreSQL(storepb.Engine_SNOWFLAKE, GenerateRestoreSQL)
+}
+
+func GenerateRestoreSQL(ctx context.Context, rCtx base.RestoreContext, statement string, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	if backupItem == nil {
+		return "", errors.Errorf("backup item is nil")
+	}
+
+	parseResult, err := ParseSnowSQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	originalSQL := extractSingleSQL(parseResult, backupItem)
+	if len(originalSQL) == 0 {
+		return "", errors.Errorf("no original SQL")
+	}
+
+	sqlForComment, truncated := common.TruncateString(originalSQL, maxCommentLength)
+	if truncated {
+		sqlForComment += "..."
+	}
+	return doGenerate(ctx, rCtx, sqlForComment, parseResult, backupItem)
+}
+
+func doGenerate(ctx context.Context, rCtx base.RestoreContext, sqlForComment string, tree *ParseResult, backupItem *storepb.PriorBackupDetail_Item) (string, error) {
+	_, sourceDatabase, err := common.GetInstanceDatabaseID(backupItem.SourceTable.Database)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get source database ID for %s", backupItem.SourceTable.Database)
+	}
+
+	if rCtx.GetDatabaseMetadataFunc == nil {
+		return "", errors.Errorf("GetDatabaseMetadataFunc is required")
+	}
+
+	_, metadata, err := rCtx.GetDatabaseMetadataFunc(ctx, rCtx.InstanceID, sourceDatabase)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get database metadata for %s", sourceDatabase)
+	}
+
+	if metadata == nil {
+		return "", errors.Errorf("database metadata not found for %s", sourceDatabase)
+	}
+
+	schema := backupItem.SourceTable.Schema
+	if schema == "" {
+		schema = defaultSchema
+	}
+	schemaMetadata := metadata.GetSchemaMetadata(schema)
+	if schemaMetadata == nil {
+		return "", errors.Errorf("schema metadata not found for %s", schema)
+	}
+
+	tableMetadata := schemaMetadata.GetTable(backupItem.SourceTable.Table)
+	if tableMetadata == nil {
+		return "", errors.Errorf("table metadata not found for %s.%s", schema, backupItem.SourceTable.Table)
+	}
+
+	g := &generator{
+		backupSchema:   backupItem.TargetTable.Schema,
+		backupTable:    backupItem.TargetTable.Table,
+		originalSchema: schema,
+		originalTable:  backupItem.SourceTable.Table,
+		table:          tableMetadata,
+		startPos:       backupItem.StartPosition,
+		endPos:         backupItem.EndPosition,
+		isFirst:        true,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(g, tree.Tree)
+	if g.err != nil {
+		return "", g.err
+	}
+	if g.result == "" {
+		return "", errors.Errorf("could not find statement at position (line %d:%d - %d:%d)",
+			backupItem.StartPosition.GetLine(), backupItem.StartPosition.GetColumn(),
+			backupItem.EndPosition.GetLine(), backupItem.EndPosition.GetColumn())
+	}
+
+	return fmt.Sprintf("/*\nOriginal SQL:\n%s\n*/\n%s", sqlForComment, g.result), nil
+}
+
+type generator struct {
+	*parser.BaseSnowflakeParserListener
+
+	backupSchema   string
+	backupTable    string
+	originalSchema string
+	originalTable  string
+	table          *model.TableMetadata
+	startPos       *storepb.Position
+	endPos         *storepb.Position
+
+	isFirst bool
+	result  string
+	err     error
+}
+
+func (g *generator) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if isTopLevel(ctx.GetParent()) && g.isFirst && inRange(ctx, g.startPos, g.endPos) {
+		g.isFirst = false
+		g.result = fmt.Sprintf(`INSERT INTO "%s"."%s" SELECT * FROM "%s"."%s";`, g.originalSchema, g.originalTable, g.backupSchema, g.backupTable)
+	}
+}
+
+func (g *generator) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if !isTopLevel(ctx.GetParent()) || !g.isFirst || !inRange(ctx, g.startPos, g.endPos) {
+		return
+	}
+	g.isFirst = false
+
+	var fields []string
+	for _, column := range ctx.AllColumn_name() {
+		fields = append(fields, NormalizeSnowSQLObjectNamePart(column.Id_()))
+	}
+
+	uk, err := g.findDisjointUniqueKey(fields)
+	if err != nil {
+		g.err = err
+		return
+	}
+
+	// Snowflake does not support INSERT ... ON CONFLICT, so MERGE is used to update the matched rows and insert the deleted ones.
+	var conditions []string
+	for _, column := range uk {
+		conditions = append(conditions, fmt.Sprintf(`t."%s" = b."%s"`, column, column))
+	}
+	var sets []string
+	for _, field := range fields {
+		sets = append(sets, fmt.Sprintf(`"%s" = b."%s"`, field, field))
+	}
+	var columns, values []string
+	for _, column := range g.table.GetProto().GetColumns() {
+		columns = append(columns, fmt.Sprintf(`"%s"`, column.Name))
+		values = append(values, fmt.Sprintf(`b."%s"`, column.Name))
+	}
+	g.result = fmt.Sprintf(`MERGE INTO "%s"."%s" AS t USING "%s"."%s" AS b ON %s WHEN MATCHED THEN UPDATE SET %s WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s);`,
+		g.originalSchema, g.originalTable, g.backupSchema, g.backupTable,
+		strings.Join(conditions, " AND "),
+		strings.Join(sets, ", "),
+		strings.Join(columns, ", "),
+		strings.Join(values, ", "),
+	)
+}
+
+func disjoint(a []string, b map[string]bool) bool {
+	for _, item := range a {
+		if _, ok := b[item]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// findDisjointUniqueKey returns the columns of the primary key or a unique key which are not updated.
+func (g *generator) findDisjointUniqueKey(fields []string) ([]string, error) {
+	columnMap := make(map[string]bool)
+	for _, field := range fields {
+		columnMap[field] = true
+	}
+	pk := g.table.GetPrimaryKey()
+	if pk != nil {
+		if disjoint(pk.GetProto().Expressions, columnMap) {
+			return pk.GetProto().Expressions, nil
+		}
+	}
+	for _, index := range g.table.GetProto().Indexes {
+		if index.Primary {
+			continue
+		}
+		if !index.Unique {
+			continue
+		}
+		if disjoint(index.Expressions, columnMap) {
+			return index.Expressions, nil
+		}
+	}
+
+	return nil, errors.Errorf("no disjoint unique key found for %s.%s", g.originalSchema, g.originalTable)
+}
+
+func extractSingleSQL(parseResult *ParseResult, backupItem *storepb.PriorBackupDetail_Item) string {
+	l := &originalSQLExtractor{
+		startPos: backupItem.StartPosition,
+		endPos:   backupItem.EndPosition,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(l, parseResult.Tree)
+	return strings.Join(l.originalSQL, ";\n")
+}
+
+type originalSQLExtractor struct {
+	*parser.BaseSnowflakeParserListener
+
+	originalSQL []string
+	startPos    *storepb.Position
+	endPos      *storepb.Position
+}
+
+func (l *originalSQLExtractor) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	if isTopLevel(ctx.GetParent()) && inRange(ctx, l.startPos, l.endPos) {
+		l.originalSQL = append(l.originalSQL, ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx))
+	}
+}
+
+func (l *originalSQLExtractor) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	if isTopLevel(ctx.GetParent()) && inRange(ctx, l.startPos, l.endPos) {
+		l.originalSQL = append(l.originalSQL, ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx))
+	}
+}
+
+// inRange returns whether the statement is within the target range.
+func inRange(ctx antlr.ParserRuleContext, targetStart, targetEnd *storepb.Position) bool {
+	startLine, startColumn := int32(ctx.GetStart().GetLine()), int32(ctx.GetStart().GetColumn())
+	endLine, endColumn := int32(ctx.GetStop().GetLine()), int32(ctx.GetStop().GetColumn())
+	if startLine < targetStart.GetLine() || (startLine == targetStart.GetLine() && startColumn < targetStart.GetColumn()) {
+		return false
+	}
+	if endLine > targetEnd.GetLine() || (endLine == targetEnd.GetLine() && endColumn > targetEnd.GetColumn()) {
+		return false
+	}
+	return true
+}
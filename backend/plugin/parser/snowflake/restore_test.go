@@ -0,0 +1,123 @@
+package snowflake
+
+import (
+	"context"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+type restoreCase struct {
+	Input            string
+	BackupDatabase   string
+	BackupTable      string
+	OriginalDatabase string
+	OriginalTable    string
+	Result           string
+}
+
+func TestRestore(t *testing.T) {
+	tests := []restoreCase{}
+
+	const (
+		record = false
+	)
+	var (
+		filepath = "test-data/test_restore.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(yamlFile.Close())
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	for i, t := range tests {
+		result, err := GenerateRestoreSQL(context.Background(), base.RestoreContext{
+			GetDatabaseMetadataFunc: fixedMockDatabaseMetadataGetter,
+		}, t.Input, &store.PriorBackupDetail_Item{
+			SourceTable: &store.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/" + t.OriginalDatabase,
+				Schema:   "PUBLIC",
+				Table:    t.OriginalTable,
+			},
+			TargetTable: &store.PriorBackupDetail_Item_Table{
+				Database: "instances/i1/databases/" + t.OriginalDatabase,
+				Schema:   t.BackupDatabase,
+				Table:    t.BackupTable,
+			},
+			StartPosition: &store.Position{
+				Line:   1,
+				Column: 0,
+			},
+			EndPosition: &store.Position{
+				Line:   math.MaxInt32,
+				Column: 1,
+			},
+		})
+		a.NoError(err)
+
+		if record {
+			tests[i].Result = result
+		} else {
+			a.Equal(t.Result, result, t.Input)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
+
+func fixedMockDatabaseMetadataGetter(_ context.Context, _ string, database string) (string, *model.DatabaseMetadata, error) {
+	return database, model.NewDatabaseMetadata(&store.DatabaseSchemaMetadata{
+		Name: database,
+		Schemas: []*store.SchemaMetadata{
+			{
+				Name: "PUBLIC",
+				Tables: []*store.TableMetadata{
+					{
+						Name: "TEST",
+						Columns: []*store.ColumnMetadata{
+							{
+								Name: "A",
+							},
+							{
+								Name: "B",
+							},
+							{
+								Name: "c",
+							},
+						},
+						Indexes: []*store.IndexMetadata{
+							{
+								Name:        "TEST_PK",
+								Expressions: []string{"A"},
+								Primary:     true,
+								Unique:      true,
+							},
+							{
+								Name:        "TEST_UK",
+								Expressions: []string{"B"},
+								Unique:      true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil, nil, store.Engine_SNOWFLAKE, true /* isObjectCaseSensitive */), nil
+}
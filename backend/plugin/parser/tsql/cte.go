@@ -0,0 +1,46 @@
+package tsql
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/tsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterGetCTEDefinitions(storepb.Engine_MSSQL, GetCTEDefinitions)
+}
+
+// GetCTEDefinitions returns the common table expressions defined in the statement.
+func GetCTEDefinitions(statement string) ([]base.CTEDefinition, error) {
+	lexer := parser.NewTSqlLexer(antlr.NewInputStream(statement))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewTSqlParser(stream)
+	// The statement may be incomplete while typing, so the syntax errors are ignored and the recovered parse tree is used.
+	lexer.RemoveErrorListeners()
+	p.RemoveErrorListeners()
+	p.BuildParseTrees = true
+
+	listener := &cteDefinitionListener{}
+	antlr.ParseTreeWalkerDefault.Walk(listener, p.Tsql_file())
+	return listener.definitions, nil
+}
+
+type cteDefinitionListener struct {
+	*parser.BaseTSqlParserListener
+
+	definitions []base.CTEDefinition
+}
+
+func (l *cteDefinitionListener) EnterCommon_table_expression(ctx *parser.Common_table_expressionContext) {
+	if ctx.Id_() == nil {
+		return
+	}
+	name, _ := NormalizeTSQLIdentifier(ctx.Id_())
+	l.definitions = append(l.definitions, base.CTEDefinition{
+		Name:  name,
+		Start: ctx.Id_().GetStart().GetStart(),
+		End:   ctx.Id_().GetStop().GetStop() + 1,
+	})
+}
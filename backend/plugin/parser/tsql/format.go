@@ -0,0 +1,85 @@
+package tsql
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/tsql"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_MSSQL, Format)
+}
+
+// Format formats the T-SQL statement.
+func Format(statement string, options base.FormatOptions) (string, error) {
+	return base.FormatWithLexer(statement, lexForFormat, options)
+}
+
+func lexForFormat(statement string) ([]base.FormatToken, error) {
+	lexer := parser.NewTSqlLexer(antlr.NewInputStream(statement))
+	lexerErrorListener := &base.ParseErrorListener{
+		Statement: statement,
+	}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(lexerErrorListener)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	if lexerErrorListener.Err != nil {
+		return nil, lexerErrorListener.Err
+	}
+	tokens := stream.GetAllTokens()
+
+	// The batch separator GO must stand alone on its line.
+	batchSeparators := make(map[int]bool)
+	for i, token := range tokens {
+		if token.GetTokenType() == parser.TSqlLexerGO && isAloneOnLine(tokens, i) {
+			batchSeparators[i] = true
+		}
+	}
+
+	return base.NewFormatTokens(tokens, func(token antlr.Token) base.FormatTokenKind {
+		switch token.GetTokenType() {
+		case parser.TSqlLexerSPACE:
+			return base.FormatTokenWhitespace
+		case parser.TSqlLexerLINE_COMMENT:
+			return base.FormatTokenLineComment
+		case parser.TSqlLexerCOMMENT:
+			return base.FormatTokenBlockComment
+		case parser.TSqlLexerGO:
+			if batchSeparators[token.GetTokenIndex()] {
+				return base.FormatTokenLine
+			}
+			return base.FormatTokenCode
+		default:
+			return base.FormatTokenCode
+		}
+	}), nil
+}
+
+// isAloneOnLine returns true if there are only whitespaces between the token and the line breaks before and after it.
+func isAloneOnLine(tokens []antlr.Token, index int) bool {
+	for i := index - 1; i >= 0; i-- {
+		if tokens[i].GetTokenType() != parser.TSqlLexerSPACE {
+			return false
+		}
+		if strings.Contains(tokens[i].GetText(), "\n") {
+			break
+		}
+	}
+	for i := index + 1; i < len(tokens); i++ {
+		if tokens[i].GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		if tokens[i].GetTokenType() != parser.TSqlLexerSPACE {
+			return false
+		}
+		if strings.Contains(tokens[i].GetText(), "\n") {
+			break
+		}
+	}
+	return true
+}
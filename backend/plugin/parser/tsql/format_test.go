@@ -0,0 +1,28 @@
+package tsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestFormat(t *testing.T) {
+	statement := "select top 10 [a], b from dbo.t cross apply f(t.id) x outer apply g(1) y where a = N'x'\nGO\nupdate t set a = 1 from t inner hash join u on t.id = u.id\n  GO  \nselect 1"
+	want := `select top 10 [a], b
+from dbo.t
+cross apply f(t.id) x
+outer apply g(1) y
+where a = N'x'
+GO
+update t
+set a = 1
+from t
+inner hash join u on t.id = u.id
+GO
+select 1`
+	got, err := Format(statement, base.FormatOptions{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
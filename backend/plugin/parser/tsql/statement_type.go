@@ -28,6 +28,47 @@ func GetStatementTypes(asts any) ([]string, error) {
 	return sqlTypes, nil
 }
 
+// StatementTypeWithPosition contains statement type and its position information.
+type StatementTypeWithPosition struct {
+	Type string
+	// Line is the one-based line number where the statement ends.
+	Line int
+	Text string
+}
+
+// GetStatementTypesWithPositions returns the type of each statement with position information.
+// The line numbers are one-based. The statements of unknown types are skipped.
+func GetStatementTypesWithPositions(asts any) ([]StatementTypeWithPosition, error) {
+	node, ok := asts.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("invalid ast type %T", asts)
+	}
+	var results []StatementTypeWithPosition
+	for _, child := range node.GetChildren() {
+		// The GO statements and EOF are skipped.
+		batch, ok := child.(*parser.Batch_without_goContext)
+		if !ok {
+			continue
+		}
+		for _, statement := range batch.GetChildren() {
+			ctx, ok := statement.(antlr.ParserRuleContext)
+			if !ok {
+				continue
+			}
+			t := getStatementType(ctx)
+			if t == "UNKNOWN" {
+				continue
+			}
+			results = append(results, StatementTypeWithPosition{
+				Type: t,
+				Line: ctx.GetStop().GetLine(),
+				Text: ctx.GetStart().GetInputStream().GetTextFromInterval(antlr.NewInterval(ctx.GetStart().GetStart(), ctx.GetStop().GetStop())),
+			})
+		}
+	}
+	return results, nil
+}
+
 func getStatementType(node antlr.Tree) string {
 	switch ctx := node.(type) {
 	case *parser.Tsql_fileContext, *parser.Batch_without_goContext, *parser.Batch_level_statementContext:
@@ -62,7 +103,26 @@ func getStatementType(node antlr.Tree) string {
 	case *parser.Create_tableContext:
 		return "CREATE_TABLE"
 	case *parser.Create_viewContext:
+		if ctx.CREATE() == nil {
+			return "ALTER_VIEW"
+		}
 		return "CREATE_VIEW"
+	case *parser.Create_columnstore_indexContext, *parser.Create_nonclustered_columnstore_indexContext, *parser.Create_spatial_indexContext, *parser.Create_xml_indexContext:
+		return "CREATE_INDEX"
+	case *parser.Create_sequenceContext:
+		return "CREATE_SEQUENCE"
+	case *parser.Create_or_alter_functionContext:
+		if ctx.CREATE() == nil {
+			return "ALTER_FUNCTION"
+		}
+		return "CREATE_FUNCTION"
+	case *parser.Create_or_alter_procedureContext:
+		if ctx.CREATE() == nil {
+			return "ALTER_PROCEDURE"
+		}
+		return "CREATE_PROCEDURE"
+	case *parser.Create_or_alter_triggerContext:
+		return "CREATE_TRIGGER"
 	case *parser.Drop_databaseContext:
 		return "DROP_DATABASE"
 	case *parser.Drop_indexContext:
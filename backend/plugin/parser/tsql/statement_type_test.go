@@ -37,3 +37,26 @@ func TestGetStatementType(t *testing.T) {
 		a.Equal(test.Want, sqlType)
 	}
 }
+
+func TestGetStatementTypesWithPositions(t *testing.T) {
+	a := require.New(t)
+	statement := `CREATE TABLE t (id INT);
+GO
+CREATE PROCEDURE p AS
+SELECT 1;
+GO
+ALTER TABLE t
+  ADD name NVARCHAR(10);
+SET NOCOUNT ON;
+GO
+`
+	result, err := ParseTSQL(statement)
+	a.NoError(err)
+	stmts, err := GetStatementTypesWithPositions(result.Tree)
+	a.NoError(err)
+	a.Equal([]StatementTypeWithPosition{
+		{Type: "CREATE_TABLE", Line: 1, Text: "CREATE TABLE t (id INT);"},
+		{Type: "CREATE_PROCEDURE", Line: 4, Text: "CREATE PROCEDURE p AS\nSELECT 1;"},
+		{Type: "ALTER_TABLE", Line: 7, Text: "ALTER TABLE t\n  ADD name NVARCHAR(10);"},
+	}, stmts)
+}
@@ -161,7 +161,8 @@ func NormalizeTSQLIdentifierText(text string) (original string, lowercase string
 		return "", ""
 	}
 	if text[0] == '[' && text[len(text)-1] == ']' {
-		text = text[1 : len(text)-1]
+		// The closing brackets in the delimited identifier are escaped by doubling them.
+		text = strings.ReplaceAll(text[1:len(text)-1], "]]", "]")
 	}
 
 	s := ""
@@ -0,0 +1,260 @@
+package clickhouse
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_CLICKHOUSE, generateMigration)
+}
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// Safe order for migrations:
+	// 1. Drop the views, because they depend on the tables.
+	// 2. Rename the tables and columns detected by the SDL diff.
+	// 3. Drop, create and alter the tables.
+	// 4. Create the views.
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop {
+			writeDropView(&buf, viewDiff.ViewName)
+		}
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter && tableDiff.OldTableName != "" {
+			writeRenameTable(&buf, tableDiff.OldTableName, tableDiff.TableName)
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, columnDiff := range tableDiff.ColumnChanges {
+			if columnDiff.Action == schema.MetadataDiffActionAlter && columnDiff.OldColumn.Name != columnDiff.NewColumn.Name {
+				writeRenameColumn(&buf, tableDiff.TableName, columnDiff.OldColumn.Name, columnDiff.NewColumn.Name)
+			}
+		}
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionDrop {
+			writeDropTable(&buf, tableDiff.TableName)
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			if err := writeCreateTable(&buf, tableDiff.TableName, tableDiff.NewTable); err != nil {
+				return "", err
+			}
+		case schema.MetadataDiffActionAlter:
+			if err := writeAlterTable(&buf, tableDiff); err != nil {
+				return "", err
+			}
+		default:
+		}
+	}
+
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			if err := convertToViewState(0, viewDiff.NewView).toString(&buf); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return buf.String(), nil
+}
+
+func writeCreateTable(buf *strings.Builder, tableName string, table *storepb.TableMetadata) error {
+	tableState := convertToTableState(0, table)
+	tableState.name = tableName
+	return tableState.toString(buf)
+}
+
+func writeAlterTable(buf *strings.Builder, tableDiff *schema.TableDiff) error {
+	oldTable, newTable := tableDiff.OldTable, tableDiff.NewTable
+	if requireRecreateTable(tableDiff) {
+		return writeRecreateTable(buf, tableDiff)
+	}
+
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", quoteIdentifier(tableDiff.TableName), quoteIdentifier(columnDiff.OldColumn.Name))
+		}
+	}
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		switch columnDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			if err := writeColumnClause(buf, tableDiff.TableName, "ADD COLUMN", columnDiff.NewColumn); err != nil {
+				return err
+			}
+		case schema.MetadataDiffActionAlter:
+			if columnDefinitionChanged(columnDiff.OldColumn, columnDiff.NewColumn) {
+				if err := writeColumnClause(buf, tableDiff.TableName, "MODIFY COLUMN", columnDiff.NewColumn); err != nil {
+					return err
+				}
+			}
+		default:
+		}
+	}
+
+	// The primary key changes require recreating the table, so only the data skipping indexes are left here.
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action == schema.MetadataDiffActionDrop && !indexDiff.OldIndex.Primary {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP INDEX %s;\n", quoteIdentifier(tableDiff.TableName), quoteIdentifier(indexDiff.OldIndex.Name))
+		}
+	}
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action == schema.MetadataDiffActionCreate && !indexDiff.NewIndex.Primary {
+			writeAddIndex(buf, tableDiff.TableName, indexDiff.NewIndex)
+		}
+	}
+
+	if !slices.Equal(oldTable.SortingKeys, newTable.SortingKeys) {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s MODIFY ORDER BY (%s);\n", quoteIdentifier(tableDiff.TableName), strings.Join(newTable.SortingKeys, ", "))
+	}
+	if oldTable.Ttl != newTable.Ttl {
+		if newTable.Ttl == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s REMOVE TTL;\n", quoteIdentifier(tableDiff.TableName))
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s MODIFY TTL %s;\n", quoteIdentifier(tableDiff.TableName), newTable.Ttl)
+		}
+	}
+	if oldTable.Comment != newTable.Comment {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s MODIFY COMMENT '%s';\n", quoteIdentifier(tableDiff.TableName), escapeString(newTable.Comment))
+	}
+	return nil
+}
+
+// requireRecreateTable checks if the table changes can't be applied by ALTER TABLE.
+// ClickHouse doesn't support changing the table engine or the primary key, and the sorting key can only be
+// extended with the columns added in the same ALTER.
+// Reference: https://clickhouse.com/docs/en/sql-reference/statements/alter/order-by
+func requireRecreateTable(tableDiff *schema.TableDiff) bool {
+	oldTable, newTable := tableDiff.OldTable, tableDiff.NewTable
+	if !strings.EqualFold(oldTable.Engine, newTable.Engine) {
+		return true
+	}
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if (indexDiff.OldIndex != nil && indexDiff.OldIndex.Primary) || (indexDiff.NewIndex != nil && indexDiff.NewIndex.Primary) {
+			return true
+		}
+	}
+	if slices.Equal(oldTable.SortingKeys, newTable.SortingKeys) {
+		return false
+	}
+	if len(newTable.SortingKeys) < len(oldTable.SortingKeys) || !slices.Equal(oldTable.SortingKeys, newTable.SortingKeys[:len(oldTable.SortingKeys)]) {
+		return true
+	}
+	addedColumns := make(map[string]bool)
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionCreate {
+			addedColumns[columnDiff.NewColumn.Name] = true
+		}
+	}
+	for _, key := range newTable.SortingKeys[len(oldTable.SortingKeys):] {
+		if !addedColumns[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// writeRecreateTable creates the table with the new definition, copies the data of the common columns and swaps
+// the tables. The EXCHANGE TABLES statement requires the Atomic database engine, which is the default one.
+func writeRecreateTable(buf *strings.Builder, tableDiff *schema.TableDiff) error {
+	tableName := tableDiff.TableName
+	newTableName := fmt.Sprintf("_%s_bb_new", tableName)
+	if err := writeCreateTable(buf, newTableName, tableDiff.NewTable); err != nil {
+		return err
+	}
+
+	// The renamed columns already have the new names.
+	oldColumns := make(map[string]bool)
+	for _, column := range tableDiff.OldTable.Columns {
+		oldColumns[column.Name] = true
+	}
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionAlter {
+			oldColumns[columnDiff.NewColumn.Name] = true
+		}
+	}
+	var columns []string
+	for _, column := range tableDiff.NewTable.Columns {
+		if oldColumns[column.Name] {
+			columns = append(columns, quoteIdentifier(column.Name))
+		}
+	}
+	if len(columns) > 0 {
+		columnList := strings.Join(columns, ", ")
+		_, _ = fmt.Fprintf(buf, "INSERT INTO %s (%s) SELECT %s FROM %s;\n", quoteIdentifier(newTableName), columnList, columnList, quoteIdentifier(tableName))
+	}
+	_, _ = fmt.Fprintf(buf, "EXCHANGE TABLES %s AND %s;\n", quoteIdentifier(tableName), quoteIdentifier(newTableName))
+	writeDropTable(buf, newTableName)
+	return nil
+}
+
+func writeColumnClause(buf *strings.Builder, tableName, clause string, column *storepb.ColumnMetadata) error {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s %s ", quoteIdentifier(tableName), clause)
+	if err := convertToColumnState(0, column).toString(buf); err != nil {
+		return err
+	}
+	_, _ = buf.WriteString(";\n")
+	return nil
+}
+
+func writeAddIndex(buf *strings.Builder, tableName string, index *storepb.IndexMetadata) {
+	expression := strings.Join(index.Expressions, ", ")
+	if len(index.Expressions) > 1 {
+		expression = fmt.Sprintf("(%s)", expression)
+	}
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD INDEX %s %s TYPE %s", quoteIdentifier(tableName), quoteIdentifier(index.Name), expression, index.Type)
+	if index.Granularity > 0 {
+		_, _ = fmt.Fprintf(buf, " GRANULARITY %d", index.Granularity)
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeRenameTable(buf *strings.Builder, oldTableName, newTableName string) {
+	_, _ = fmt.Fprintf(buf, "RENAME TABLE %s TO %s;\n", quoteIdentifier(oldTableName), quoteIdentifier(newTableName))
+}
+
+func writeRenameColumn(buf *strings.Builder, tableName, oldColumnName, newColumnName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s RENAME COLUMN %s TO %s;\n", quoteIdentifier(tableName), quoteIdentifier(oldColumnName), quoteIdentifier(newColumnName))
+}
+
+func writeDropTable(buf *strings.Builder, tableName string) {
+	_, _ = fmt.Fprintf(buf, "DROP TABLE %s;\n", quoteIdentifier(tableName))
+}
+
+func writeDropView(buf *strings.Builder, viewName string) {
+	_, _ = fmt.Fprintf(buf, "DROP VIEW %s;\n", quoteIdentifier(viewName))
+}
+
+func columnDefinitionChanged(oldColumn, newColumn *storepb.ColumnMetadata) bool {
+	return oldColumn.Type != newColumn.Type ||
+		oldColumn.Nullable != newColumn.Nullable ||
+		oldColumn.Default != newColumn.Default ||
+		oldColumn.Comment != newColumn.Comment
+}
+
+// escapeString escapes the string to be enclosed in single quotes.
+// The backslashes are escaped first, so the backslashes escaping the quotes are kept.
+func escapeString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	return strings.ReplaceAll(s, "'", "\\'")
+}
+
+// quoteIdentifier quotes the identifier with backticks.
+func quoteIdentifier(identifier string) string {
+	identifier = strings.ReplaceAll(identifier, "\\", "\\\\")
+	return "`" + strings.ReplaceAll(identifier, "`", "\\`") + "`"
+}
@@ -0,0 +1,144 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	newEventsTable := func() *storepb.TableMetadata {
+		return &storepb.TableMetadata{
+			Name:   "events",
+			Engine: "MergeTree",
+			Columns: []*storepb.ColumnMetadata{
+				{Name: "id", Type: "UInt64", Default: "NULL"},
+				{Name: "ts", Type: "DateTime", Default: "NULL"},
+			},
+			SortingKeys: []string{"id"},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		oldTable *storepb.TableMetadata
+		newTable *storepb.TableMetadata
+		want     string
+	}{
+		{
+			name:     "create table",
+			newTable: newEventsTable(),
+			want: "CREATE TABLE `events` (\n" +
+				"  `id` UInt64 NOT NULL,\n" +
+				"  `ts` DateTime NOT NULL\n" +
+				")\n" +
+				"ENGINE = MergeTree\n" +
+				"ORDER BY (id);\n",
+		},
+		{
+			name:     "drop table",
+			oldTable: newEventsTable(),
+			want:     "DROP TABLE `events`;\n",
+		},
+		{
+			name:     "add column and modify ttl",
+			oldTable: newEventsTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newEventsTable()
+				table.Columns = append(table.Columns, &storepb.ColumnMetadata{Name: "payload", Type: "Nullable(String)", Nullable: true, Default: "NULL"})
+				table.Ttl = "ts + toIntervalDay(30)"
+				return table
+			}(),
+			want: "ALTER TABLE `events` ADD COLUMN `payload` Nullable(String) DEFAULT NULL;\n" +
+				"ALTER TABLE `events` MODIFY TTL ts + toIntervalDay(30);\n",
+		},
+		{
+			name: "remove ttl",
+			oldTable: func() *storepb.TableMetadata {
+				table := newEventsTable()
+				table.Ttl = "ts + toIntervalDay(30)"
+				return table
+			}(),
+			newTable: newEventsTable(),
+			want:     "ALTER TABLE `events` REMOVE TTL;\n",
+		},
+		{
+			name:     "extend order by with new column",
+			oldTable: newEventsTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newEventsTable()
+				table.Columns = append(table.Columns, &storepb.ColumnMetadata{Name: "kind", Type: "String", Default: "NULL"})
+				table.SortingKeys = []string{"id", "kind"}
+				return table
+			}(),
+			want: "ALTER TABLE `events` ADD COLUMN `kind` String NOT NULL;\n" +
+				"ALTER TABLE `events` MODIFY ORDER BY (id, kind);\n",
+		},
+		{
+			name:     "change engine recreates table",
+			oldTable: newEventsTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newEventsTable()
+				table.Engine = "ReplacingMergeTree"
+				table.SortingKeys = []string{"ts", "id"}
+				return table
+			}(),
+			want: "CREATE TABLE `_events_bb_new` (\n" +
+				"  `id` UInt64 NOT NULL,\n" +
+				"  `ts` DateTime NOT NULL\n" +
+				")\n" +
+				"ENGINE = ReplacingMergeTree\n" +
+				"ORDER BY (ts, id);\n" +
+				"INSERT INTO `_events_bb_new` (`id`, `ts`) SELECT `id`, `ts` FROM `events`;\n" +
+				"EXCHANGE TABLES `events` AND `_events_bb_new`;\n" +
+				"DROP TABLE `_events_bb_new`;\n",
+		},
+		{
+			name:     "add data skipping index",
+			oldTable: newEventsTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newEventsTable()
+				table.Indexes = []*storepb.IndexMetadata{{Name: "idx_ts", Type: "minmax", Expressions: []string{"ts"}, Granularity: 4}}
+				return table
+			}(),
+			want: "ALTER TABLE `events` ADD INDEX `idx_ts` ts TYPE minmax GRANULARITY 4;\n",
+		},
+		{
+			name:     "escape the identifiers and comments",
+			oldTable: newEventsTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newEventsTable()
+				table.Columns = append(table.Columns, &storepb.ColumnMetadata{Name: "we`ird", Type: "String", Default: "NULL", Comment: `it's a \ path`})
+				table.Comment = `C:\'s events`
+				return table
+			}(),
+			want: "ALTER TABLE `events` ADD COLUMN `we\\`ird` String NOT NULL COMMENT 'it\\'s a \\\\ path';\n" +
+				"ALTER TABLE `events` MODIFY COMMENT 'C:\\\\\\'s events';\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSchema := &storepb.SchemaMetadata{}
+			if tc.oldTable != nil {
+				oldSchema.Tables = append(oldSchema.Tables, tc.oldTable)
+			}
+			newSchema := &storepb.SchemaMetadata{}
+			if tc.newTable != nil {
+				newSchema.Tables = append(newSchema.Tables, tc.newTable)
+			}
+			oldMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{oldSchema}}, nil, nil, storepb.Engine_CLICKHOUSE, true)
+			newMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{newSchema}}, nil, nil, storepb.Engine_CLICKHOUSE, true)
+
+			diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_CLICKHOUSE, oldMetadata, newMetadata)
+			require.NoError(t, err)
+			got, err := schema.GenerateMigration(storepb.Engine_CLICKHOUSE, diff)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
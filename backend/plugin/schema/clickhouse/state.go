@@ -61,12 +61,13 @@ type tableState struct {
 	columns     map[string]*columnState
 	sortingKeys []string
 	primaryKeys []string
+	ttl         string
 	comment     string
 	engine      string
 }
 
 func (t *tableState) toString(buf *strings.Builder) error {
-	if _, err := fmt.Fprintf(buf, "CREATE TABLE %s (\n  ", t.name); err != nil {
+	if _, err := fmt.Fprintf(buf, "CREATE TABLE %s (\n  ", quoteIdentifier(t.name)); err != nil {
 		return err
 	}
 	columns := []*columnState{}
@@ -118,8 +119,13 @@ func (t *tableState) toString(buf *strings.Builder) error {
 			return err
 		}
 	}
+	if t.ttl != "" {
+		if _, err := fmt.Fprintf(buf, "\nTTL %s", t.ttl); err != nil {
+			return err
+		}
+	}
 	if t.comment != "" {
-		if _, err := fmt.Fprintf(buf, "\nCOMMENT '%s'", t.comment); err != nil {
+		if _, err := fmt.Fprintf(buf, "\nCOMMENT '%s'", escapeString(t.comment)); err != nil {
 			return err
 		}
 	}
@@ -142,6 +148,7 @@ func convertToTableState(id int, table *storepb.TableMetadata) *tableState {
 	state.comment = table.Comment
 	state.engine = table.Engine
 	state.sortingKeys = table.SortingKeys
+	state.ttl = table.Ttl
 	for i, column := range table.Columns {
 		state.columns[column.Name] = convertToColumnState(i, column)
 	}
@@ -191,7 +198,7 @@ type columnState struct {
 }
 
 func (c *columnState) toString(buf *strings.Builder) error {
-	if _, err := fmt.Fprintf(buf, "%s ", c.name); err != nil {
+	if _, err := fmt.Fprintf(buf, "%s ", quoteIdentifier(c.name)); err != nil {
 		return err
 	}
 	if _, err := buf.WriteString(c.tp); err != nil {
@@ -208,7 +215,7 @@ func (c *columnState) toString(buf *strings.Builder) error {
 		}
 	}
 	if c.comment != "" {
-		if _, err := fmt.Fprintf(buf, " COMMENT '%s'", c.comment); err != nil {
+		if _, err := fmt.Fprintf(buf, " COMMENT '%s'", escapeString(c.comment)); err != nil {
 			return err
 		}
 	}
@@ -225,7 +232,11 @@ func convertToColumnState(id int, column *storepb.ColumnMetadata) *columnState {
 	}
 	// Handle default values using the unified Default field
 	if column.Default == "NULL" {
-		result.defaultValue = &defaultValueNull{}
+		// The columns without default value are synced with the NULL default,
+		// which is invalid for the non-nullable columns.
+		if column.Nullable {
+			result.defaultValue = &defaultValueNull{}
+		}
 	} else if column.Default != "" {
 		// Check if it's an expression or a literal value
 		// Simple heuristic: if it contains parentheses, operators, or functions, treat as expression
@@ -268,9 +279,9 @@ func convertToViewState(id int, view *storepb.ViewMetadata) *viewState {
 }
 
 func (v *viewState) toString(buf io.StringWriter) error {
-	stmt := fmt.Sprintf("CREATE OR REPLACE VIEW %s AS (%s)", v.name, util.TrimStatement(v.definition))
+	stmt := fmt.Sprintf("CREATE OR REPLACE VIEW %s AS (%s)", quoteIdentifier(v.name), util.TrimStatement(v.definition))
 	if v.comment != "" {
-		stmt += fmt.Sprintf(" COMMENT '%s'", v.comment)
+		stmt += fmt.Sprintf(" COMMENT '%s'", escapeString(v.comment))
 	}
 	stmt += ";\n"
 	if _, err := buf.WriteString(stmt); err != nil {
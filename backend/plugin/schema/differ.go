@@ -56,6 +56,9 @@ type MetadataDiff struct {
 	// Event changes
 	EventChanges []*EventDiff
 
+	// Change stream changes (Spanner specific)
+	ChangeStreamChanges []*ChangeStreamDiff
+
 	// Comment changes
 	CommentChanges []*CommentDiff
 }
@@ -77,6 +80,10 @@ type TableDiff struct {
 	OldTable   *storepb.TableMetadata
 	NewTable   *storepb.TableMetadata
 
+	// OldTableName is the previous name of the table if the table is renamed to TableName.
+	// It is only set by the metadata based SDL diff.
+	OldTableName string
+
 	// AST nodes for DDL analysis and generation
 	OldASTNode *parser.CreatestmtContext // Previous user CREATE TABLE AST node
 	NewASTNode *parser.CreatestmtContext // Current user CREATE TABLE AST node
@@ -110,6 +117,7 @@ type TableDiff struct {
 }
 
 // ColumnDiff represents changes to a column.
+// A column rename is an ALTER action whose OldColumn and NewColumn have different names.
 type ColumnDiff struct {
 	Action    MetadataDiffAction
 	OldColumn *storepb.ColumnMetadata
@@ -285,6 +293,15 @@ type EventDiff struct {
 	NewEvent  *storepb.EventMetadata
 }
 
+// ChangeStreamDiff represents changes to a change stream.
+type ChangeStreamDiff struct {
+	Action           MetadataDiffAction
+	SchemaName       string
+	ChangeStreamName string
+	OldChangeStream  *storepb.ChangeStreamMetadata
+	NewChangeStream  *storepb.ChangeStreamMetadata
+}
+
 // CommentObjectType represents the type of database object that has a comment.
 type CommentObjectType string
 
@@ -491,6 +508,16 @@ func addNewSchemaObjects(diff *MetadataDiff, schemaName string, schema *model.Sc
 			NewEvent:  eventProto,
 		})
 	}
+
+	// Add all change streams
+	for _, changeStreamProto := range schemaProto.ChangeStreams {
+		diff.ChangeStreamChanges = append(diff.ChangeStreamChanges, &ChangeStreamDiff{
+			Action:           MetadataDiffActionCreate,
+			SchemaName:       schemaName,
+			ChangeStreamName: changeStreamProto.Name,
+			NewChangeStream:  changeStreamProto,
+		})
+	}
 }
 
 // compareSchemaObjects compares objects between two schemas.
@@ -558,6 +585,9 @@ func compareSchemaObjects(engine storepb.Engine, diff *MetadataDiff, schemaName
 
 	// Compare events
 	compareEvents(diff, schemaName, oldSchema, newSchema)
+
+	// Compare change streams
+	compareChangeStreams(diff, schemaName, oldSchema, newSchema)
 }
 
 // compareTableDetails compares the details of two tables.
@@ -619,6 +649,11 @@ func compareTableDetails(engine storepb.Engine, schemaName, tableName string, ol
 		hasChanges = true
 	}
 
+	// Compare engine specific table options
+	if !tableOptionsEqual(engine, oldTable.GetProto(), newTable.GetProto()) {
+		hasChanges = true
+	}
+
 	if !hasChanges {
 		return nil
 	}
@@ -626,6 +661,30 @@ func compareTableDetails(engine storepb.Engine, schemaName, tableName string, ol
 	return tableDiff
 }
 
+// tableOptionsEqual checks if the engine specific table options are equal, such as the ClickHouse table engine,
+// ORDER BY and TTL, the Snowflake clustering keys and transient tables, and the Spanner interleaved tables.
+func tableOptionsEqual(engine storepb.Engine, table1, table2 *storepb.TableMetadata) bool {
+	// Only the ClickHouse migration manages the table engine and the sorting keys.
+	if engine == storepb.Engine_CLICKHOUSE {
+		if !strings.EqualFold(table1.Engine, table2.Engine) || !slices.Equal(table1.SortingKeys, table2.SortingKeys) {
+			return false
+		}
+	}
+	if table1.Ttl != table2.Ttl {
+		return false
+	}
+	if !slices.Equal(table1.ClusteringKeys, table2.ClusteringKeys) {
+		return false
+	}
+	if table1.Transient != table2.Transient {
+		return false
+	}
+	if table1.InterleaveParent != table2.InterleaveParent {
+		return false
+	}
+	return table1.InterleaveOnDelete == table2.InterleaveOnDelete
+}
+
 // compareColumns compares columns between two tables.
 func compareColumns(engine storepb.Engine, oldTable, newTable *model.TableMetadata) []*ColumnDiff {
 	var changes []*ColumnDiff
@@ -825,6 +884,9 @@ func indexesEqual(engine storepb.Engine, idx1, idx2 *storepb.IndexMetadata) bool
 	if idx1.Primary != idx2.Primary {
 		return false
 	}
+	if idx1.Granularity != idx2.Granularity {
+		return false
+	}
 	if len(idx1.Expressions) != len(idx2.Expressions) {
 		return false
 	}
@@ -2078,6 +2140,52 @@ func compareEvents(diff *MetadataDiff, _ string, oldSchema, newSchema *model.Sch
 	}
 }
 
+// compareChangeStreams compares change streams between two schemas.
+func compareChangeStreams(diff *MetadataDiff, schemaName string, oldSchema, newSchema *model.SchemaMetadata) {
+	oldChangeStreamMap := make(map[string]*storepb.ChangeStreamMetadata)
+	for _, changeStream := range oldSchema.GetProto().ChangeStreams {
+		oldChangeStreamMap[changeStream.Name] = changeStream
+	}
+
+	newChangeStreamMap := make(map[string]*storepb.ChangeStreamMetadata)
+	for _, changeStream := range newSchema.GetProto().ChangeStreams {
+		newChangeStreamMap[changeStream.Name] = changeStream
+	}
+
+	// Check for dropped change streams
+	for changeStreamName, oldChangeStream := range oldChangeStreamMap {
+		if _, exists := newChangeStreamMap[changeStreamName]; !exists {
+			diff.ChangeStreamChanges = append(diff.ChangeStreamChanges, &ChangeStreamDiff{
+				Action:           MetadataDiffActionDrop,
+				SchemaName:       schemaName,
+				ChangeStreamName: changeStreamName,
+				OldChangeStream:  oldChangeStream,
+			})
+		}
+	}
+
+	// Check for new and modified change streams
+	for changeStreamName, newChangeStream := range newChangeStreamMap {
+		oldChangeStream, exists := oldChangeStreamMap[changeStreamName]
+		if !exists {
+			diff.ChangeStreamChanges = append(diff.ChangeStreamChanges, &ChangeStreamDiff{
+				Action:           MetadataDiffActionCreate,
+				SchemaName:       schemaName,
+				ChangeStreamName: changeStreamName,
+				NewChangeStream:  newChangeStream,
+			})
+		} else if oldChangeStream.ForClause != newChangeStream.ForClause || oldChangeStream.Options != newChangeStream.Options {
+			diff.ChangeStreamChanges = append(diff.ChangeStreamChanges, &ChangeStreamDiff{
+				Action:           MetadataDiffActionAlter,
+				SchemaName:       schemaName,
+				ChangeStreamName: changeStreamName,
+				OldChangeStream:  oldChangeStream,
+				NewChangeStream:  newChangeStream,
+			})
+		}
+	}
+}
+
 // FilterPostgresArchiveSchema filters out schema diff objects related to bbdataarchive schema.
 func FilterPostgresArchiveSchema(diff *MetadataDiff) *MetadataDiff {
 	if diff == nil {
@@ -2388,6 +2496,17 @@ func sortDiffLists(diff *MetadataDiff) {
 		return strings.Compare(string(a.Action), string(b.Action))
 	})
 
+	// Sort change stream changes by schema name, then change stream name, then action
+	slices.SortFunc(diff.ChangeStreamChanges, func(a, b *ChangeStreamDiff) int {
+		if a.SchemaName != b.SchemaName {
+			return strings.Compare(a.SchemaName, b.SchemaName)
+		}
+		if a.ChangeStreamName != b.ChangeStreamName {
+			return strings.Compare(a.ChangeStreamName, b.ChangeStreamName)
+		}
+		return strings.Compare(string(a.Action), string(b.Action))
+	})
+
 	// Sort sub-object changes within table diffs
 	for _, tableDiff := range diff.TableChanges {
 		sortTableSubObjectChanges(tableDiff)
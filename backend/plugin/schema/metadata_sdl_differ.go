@@ -0,0 +1,277 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// GetSDLDiffByMetadata computes the SDL diff for the engines without a chunk based SDL differ, such as MySQL, TiDB,
+// SQL Server and Oracle.
+// The current schema is converted to the schema definition and parsed back with the engine's GetDatabaseMetadata,
+// so that both sides of the diff share the same representation. The diff is then computed on the database metadata.
+//
+// The previous SDL text is used to detect renames. A table or column is renamed if the previous SDL declared it under
+// the old name, and the current SDL declares the same definition under a new name which is not in the previous SDL.
+// The renamed objects are reported as ALTER actions instead of a drop and a create, so the data is kept.
+func GetSDLDiffByMetadata(engine storepb.Engine, currentSDLText, previousUserSDLText string, currentSchema *model.DatabaseMetadata) (*MetadataDiff, error) {
+	isObjectCaseSensitive := false
+	sourceMetadata := emptySDLMetadata(engine)
+	sourceText := ""
+	if currentSchema != nil {
+		isObjectCaseSensitive = currentSchema.GetIsObjectCaseSensitive()
+		generatedSDL, err := GetDatabaseDefinition(engine, GetDefinitionContext{}, currentSchema.GetProto())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert current schema to SDL format")
+		}
+		if strings.TrimSpace(currentSDLText) == strings.TrimSpace(generatedSDL) {
+			// No changes detected between current SDL and database schema.
+			return &MetadataDiff{}, nil
+		}
+		sourceMetadata, err = getSDLMetadata(engine, generatedSDL)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse current schema definition")
+		}
+		sourceText = generatedSDL
+	}
+
+	targetMetadata, err := getSDLMetadata(engine, currentSDLText)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse current SDL text")
+	}
+	// The SDL text doesn't carry the database name.
+	targetMetadata.Name = sourceMetadata.Name
+
+	source := model.NewDatabaseMetadata(sourceMetadata, []byte(sourceText), &storepb.DatabaseConfig{}, engine, isObjectCaseSensitive)
+	target := model.NewDatabaseMetadata(targetMetadata, []byte(currentSDLText), &storepb.DatabaseConfig{}, engine, isObjectCaseSensitive)
+	diff, err := GetDatabaseSchemaDiff(engine, source, target)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute schema diff")
+	}
+	if diff == nil {
+		return &MetadataDiff{}, nil
+	}
+
+	if strings.TrimSpace(previousUserSDLText) != "" {
+		previousMetadata, err := getSDLMetadata(engine, previousUserSDLText)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse previous SDL text")
+		}
+		previous := model.NewDatabaseMetadata(previousMetadata, []byte(previousUserSDLText), &storepb.DatabaseConfig{}, engine, isObjectCaseSensitive)
+		detectTableRenames(engine, diff, source, target, previous)
+		for _, tableDiff := range diff.TableChanges {
+			if tableDiff.Action == MetadataDiffActionAlter {
+				detectColumnRenames(engine, tableDiff, previous)
+			}
+		}
+	}
+	return diff, nil
+}
+
+// getSDLMetadata parses the SDL text into the database metadata, with the objects in the default schema placed
+// in the schema the database metadata is synced with.
+func getSDLMetadata(engine storepb.Engine, sdlText string) (*storepb.DatabaseSchemaMetadata, error) {
+	if strings.TrimSpace(sdlText) == "" {
+		// Some parsers reject the empty text, e.g. Oracle.
+		return emptySDLMetadata(engine), nil
+	}
+	metadata, err := GetDatabaseMetadata(engine, sdlText)
+	if err != nil {
+		return nil, err
+	}
+	if engine == storepb.Engine_ORACLE {
+		// The Oracle parser puts the unqualified objects in the PUBLIC schema, while the synced metadata
+		// uses the empty schema name for the objects owned by the database user.
+		for _, schema := range metadata.Schemas {
+			if schema.Name == "PUBLIC" {
+				schema.Name = ""
+			}
+		}
+	}
+	return metadata, nil
+}
+
+// emptySDLMetadata returns the database metadata of an empty SDL text, which only has the default schema.
+func emptySDLMetadata(engine storepb.Engine) *storepb.DatabaseSchemaMetadata {
+	schemaName := ""
+	if engine == storepb.Engine_MSSQL {
+		schemaName = "dbo"
+	}
+	return &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{{Name: schemaName}},
+	}
+}
+
+// detectTableRenames replaces the pairs of dropped and created tables which are renames with ALTER actions.
+func detectTableRenames(engine storepb.Engine, diff *MetadataDiff, source, target, previous *model.DatabaseMetadata) {
+	var dropped, created []*TableDiff
+	for _, tableDiff := range diff.TableChanges {
+		previousSchema := previous.GetSchemaMetadata(tableDiff.SchemaName)
+		if previousSchema == nil {
+			continue
+		}
+		switch tableDiff.Action {
+		case MetadataDiffActionDrop:
+			if previousSchema.GetTable(tableDiff.TableName) != nil {
+				dropped = append(dropped, tableDiff)
+			}
+		case MetadataDiffActionCreate:
+			if previousSchema.GetTable(tableDiff.TableName) == nil {
+				created = append(created, tableDiff)
+			}
+		default:
+		}
+	}
+
+	renames := make(map[*TableDiff]*TableDiff)
+	replaced := make(map[*TableDiff]bool)
+	for _, createDiff := range created {
+		var candidates []*TableDiff
+		for _, dropDiff := range dropped {
+			if dropDiff.SchemaName == createDiff.SchemaName && tableColumnsEqual(engine, dropDiff.OldTable, createDiff.NewTable) {
+				candidates = append(candidates, dropDiff)
+			}
+		}
+		// Only rename if the match is unambiguous in both directions.
+		if len(candidates) != 1 || replaced[candidates[0]] {
+			continue
+		}
+		dropDiff := candidates[0]
+		matches := 0
+		for _, other := range created {
+			if other.SchemaName == dropDiff.SchemaName && tableColumnsEqual(engine, dropDiff.OldTable, other.NewTable) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			continue
+		}
+
+		oldTable := source.GetSchemaMetadata(dropDiff.SchemaName).GetTable(dropDiff.TableName)
+		newTable := target.GetSchemaMetadata(createDiff.SchemaName).GetTable(createDiff.TableName)
+		renameDiff := compareTableDetails(engine, createDiff.SchemaName, createDiff.TableName, oldTable, newTable)
+		if renameDiff == nil {
+			renameDiff = &TableDiff{
+				Action:     MetadataDiffActionAlter,
+				SchemaName: createDiff.SchemaName,
+				TableName:  createDiff.TableName,
+				OldTable:   dropDiff.OldTable,
+				NewTable:   createDiff.NewTable,
+			}
+		}
+		renameDiff.OldTableName = dropDiff.TableName
+		renames[createDiff] = renameDiff
+		replaced[dropDiff] = true
+	}
+	if len(renames) == 0 {
+		return
+	}
+
+	var tableChanges []*TableDiff
+	for _, tableDiff := range diff.TableChanges {
+		if replaced[tableDiff] {
+			continue
+		}
+		if renameDiff, ok := renames[tableDiff]; ok {
+			tableChanges = append(tableChanges, renameDiff)
+			continue
+		}
+		tableChanges = append(tableChanges, tableDiff)
+	}
+	diff.TableChanges = tableChanges
+}
+
+// detectColumnRenames replaces the pairs of dropped and created columns which are renames with ALTER actions.
+// The renamed column must keep its position and definition.
+func detectColumnRenames(engine storepb.Engine, tableDiff *TableDiff, previous *model.DatabaseMetadata) {
+	previousSchema := previous.GetSchemaMetadata(tableDiff.SchemaName)
+	if previousSchema == nil {
+		return
+	}
+	previousTableName := tableDiff.TableName
+	if tableDiff.OldTableName != "" {
+		previousTableName = tableDiff.OldTableName
+	}
+	previousTable := previousSchema.GetTable(previousTableName)
+	if previousTable == nil {
+		return
+	}
+
+	var dropped, created []*ColumnDiff
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		switch columnDiff.Action {
+		case MetadataDiffActionDrop:
+			if previousTable.GetColumn(columnDiff.OldColumn.Name) != nil {
+				dropped = append(dropped, columnDiff)
+			}
+		case MetadataDiffActionCreate:
+			if previousTable.GetColumn(columnDiff.NewColumn.Name) == nil {
+				created = append(created, columnDiff)
+			}
+		default:
+		}
+	}
+
+	renames := make(map[*ColumnDiff]*ColumnDiff)
+	replaced := make(map[*ColumnDiff]bool)
+	for _, createDiff := range created {
+		position := columnPosition(tableDiff.NewTable, createDiff.NewColumn.Name)
+		for _, dropDiff := range dropped {
+			if replaced[dropDiff] || columnPosition(tableDiff.OldTable, dropDiff.OldColumn.Name) != position {
+				continue
+			}
+			if !columnsEqual(engine, dropDiff.OldColumn, createDiff.NewColumn) {
+				continue
+			}
+			renames[createDiff] = &ColumnDiff{
+				Action:    MetadataDiffActionAlter,
+				OldColumn: dropDiff.OldColumn,
+				NewColumn: createDiff.NewColumn,
+			}
+			replaced[dropDiff] = true
+			break
+		}
+	}
+	if len(renames) == 0 {
+		return
+	}
+
+	var columnChanges []*ColumnDiff
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if replaced[columnDiff] {
+			continue
+		}
+		if renameDiff, ok := renames[columnDiff]; ok {
+			columnChanges = append(columnChanges, renameDiff)
+			continue
+		}
+		columnChanges = append(columnChanges, columnDiff)
+	}
+	tableDiff.ColumnChanges = columnChanges
+}
+
+// tableColumnsEqual checks if two tables have the same columns in the same order.
+func tableColumnsEqual(engine storepb.Engine, table1, table2 *storepb.TableMetadata) bool {
+	if table1 == nil || table2 == nil || len(table1.Columns) != len(table2.Columns) {
+		return false
+	}
+	for i, col1 := range table1.Columns {
+		col2 := table2.Columns[i]
+		if !strings.EqualFold(col1.Name, col2.Name) || !columnsEqual(engine, col1, col2) {
+			return false
+		}
+	}
+	return true
+}
+
+func columnPosition(table *storepb.TableMetadata, columnName string) int {
+	for i, column := range table.GetColumns() {
+		if strings.EqualFold(column.Name, columnName) {
+			return i
+		}
+	}
+	return -1
+}
@@ -52,6 +52,9 @@ func generateMigration(diff *schema.MetadataDiff) (string, error) {
 	//    - Create views (in topological order)
 	//    - Create functions/procedures
 
+	// Phase 0: Rename tables and columns first, so that the following statements use the new names
+	renameObjects(diff, &buf)
+
 	// Phase 1: Drop dependent objects
 	// 1.1 Drop foreign keys first (they depend on tables)
 	for _, tableDiff := range diff.TableChanges {
@@ -492,6 +495,43 @@ func generateMigration(diff *schema.MetadataDiff) (string, error) {
 	return buf.String(), nil
 }
 
+// renameObjects renames the tables and columns detected by the SDL diff with sp_rename.
+func renameObjects(diff *schema.MetadataDiff, buf *strings.Builder) {
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter && tableDiff.OldTableName != "" {
+			_, _ = buf.WriteString("EXEC sp_rename ")
+			_, _ = buf.WriteString(quoteUnicodeString(quoteIdentifier(tableDiff.SchemaName) + "." + quoteIdentifier(tableDiff.OldTableName)))
+			_, _ = buf.WriteString(", ")
+			_, _ = buf.WriteString(quoteUnicodeString(tableDiff.TableName))
+			_, _ = buf.WriteString(";\n")
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, colDiff := range tableDiff.ColumnChanges {
+			if colDiff.Action == schema.MetadataDiffActionAlter && colDiff.OldColumn.Name != colDiff.NewColumn.Name {
+				_, _ = buf.WriteString("EXEC sp_rename ")
+				_, _ = buf.WriteString(quoteUnicodeString(quoteIdentifier(tableDiff.SchemaName) + "." + quoteIdentifier(tableDiff.TableName) + "." + quoteIdentifier(colDiff.OldColumn.Name)))
+				_, _ = buf.WriteString(", ")
+				_, _ = buf.WriteString(quoteUnicodeString(colDiff.NewColumn.Name))
+				_, _ = buf.WriteString(", N'COLUMN';\n")
+			}
+		}
+	}
+}
+
+// quoteIdentifier quotes the identifier with brackets and escapes the closing brackets in it, same as QUOTENAME.
+func quoteIdentifier(identifier string) string {
+	return "[" + strings.ReplaceAll(identifier, "]", "]]") + "]"
+}
+
+// quoteUnicodeString quotes the string as a Unicode string literal.
+func quoteUnicodeString(s string) string {
+	return "N'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 func generateCreateTable(schemaName, tableName string, table *storepb.TableMetadata) string {
 	var buf strings.Builder
 
@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -127,3 +128,28 @@ func TestGenerateMigration_Views(t *testing.T) {
 func TestGenerateMigration_SafeOrder(t *testing.T) {
 	runMigrationTest(t, "test_migration_safe_order.yaml")
 }
+
+func TestRenameObjects(t *testing.T) {
+	diff := &schema.MetadataDiff{
+		TableChanges: []*schema.TableDiff{
+			{
+				Action:       schema.MetadataDiffActionAlter,
+				SchemaName:   "dbo",
+				TableName:    "o'rders",
+				OldTableName: "[orders]",
+				ColumnChanges: []*schema.ColumnDiff{
+					{
+						Action:    schema.MetadataDiffActionAlter,
+						OldColumn: &storepb.ColumnMetadata{Name: "na]me"},
+						NewColumn: &storepb.ColumnMetadata{Name: "full]name"},
+					},
+				},
+			},
+		},
+	}
+	var buf strings.Builder
+	renameObjects(diff, &buf)
+	require.Equal(t, `EXEC sp_rename N'[dbo].[[orders]]]', N'o''rders';
+EXEC sp_rename N'[dbo].[o''rders].[na]]me]', N'full]name', N'COLUMN';
+`, buf.String())
+}
@@ -20,6 +20,7 @@ func init() {
 	schema.RegisterGetViewDefinition(storepb.Engine_MSSQL, GetViewDefinition)
 	schema.RegisterGetFunctionDefinition(storepb.Engine_MSSQL, GetFunctionDefinition)
 	schema.RegisterGetProcedureDefinition(storepb.Engine_MSSQL, GetProcedureDefinition)
+	schema.RegisterGetMultiFileDatabaseDefinition(storepb.Engine_MSSQL, GetMultiFileDatabaseDefinition)
 }
 
 func GetDatabaseDefinition(_ schema.GetDefinitionContext, to *storepb.DatabaseSchemaMetadata) (string, error) {
@@ -65,6 +66,63 @@ func GetDatabaseDefinition(_ schema.GetDefinitionContext, to *storepb.DatabaseSc
 	return buf.String(), nil
 }
 
+// GetMultiFileDatabaseDefinition generates multi-file SDL schema for MSSQL.
+// Each table, view, function and procedure is written to its own file under schemas/<schema>/.
+func GetMultiFileDatabaseDefinition(_ schema.GetDefinitionContext, metadata *storepb.DatabaseSchemaMetadata) (*schema.MultiFileSchemaResult, error) {
+	if metadata == nil || len(metadata.Schemas) == 0 {
+		return &schema.MultiFileSchemaResult{Files: []schema.File{}}, nil
+	}
+
+	var files []schema.File
+	for _, schemaMetadata := range metadata.Schemas {
+		schemaName := schemaMetadata.Name
+		if schemaName != defaultSchema {
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/schema.sql", schemaName),
+				Content: fmt.Sprintf("CREATE SCHEMA [%s];\nGO\n", schemaName),
+			})
+		}
+
+		for _, table := range schemaMetadata.Tables {
+			var buf strings.Builder
+			writeTable(&buf, schemaName, table)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/tables/%s.sql", schemaName, table.Name),
+				Content: buf.String(),
+			})
+		}
+
+		for _, view := range schemaMetadata.Views {
+			var buf strings.Builder
+			writeView(&buf, schemaName, view)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/views/%s.sql", schemaName, view.Name),
+				Content: buf.String(),
+			})
+		}
+
+		for _, function := range schemaMetadata.Functions {
+			var buf strings.Builder
+			writeFunction(&buf, schemaName, function)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/functions/%s.sql", schemaName, function.Name),
+				Content: buf.String(),
+			})
+		}
+
+		for _, procedure := range schemaMetadata.Procedures {
+			var buf strings.Builder
+			writeProcedure(&buf, schemaName, procedure)
+			files = append(files, schema.File{
+				Name:    fmt.Sprintf("schemas/%s/procedures/%s.sql", schemaName, procedure.Name),
+				Content: buf.String(),
+			})
+		}
+	}
+
+	return &schema.MultiFileSchemaResult{Files: files}, nil
+}
+
 func GetTableDefinition(schemaName string, table *storepb.TableMetadata, _ []*storepb.SequenceMetadata) (string, error) {
 	var buf strings.Builder
 	writeTable(&buf, schemaName, table)
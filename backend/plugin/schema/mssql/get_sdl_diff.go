@@ -0,0 +1,17 @@
+package mssql
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func init() {
+	schema.RegisterGetSDLDiff(storepb.Engine_MSSQL, GetSDLDiff)
+}
+
+// GetSDLDiff computes the diff between the current SDL text and the current database schema.
+// The previous database schema is not needed because the diff is computed on the database metadata directly.
+func GetSDLDiff(currentSDLText, previousUserSDLText string, currentSchema, _ *model.DatabaseMetadata) (*schema.MetadataDiff, error) {
+	return schema.GetSDLDiffByMetadata(storepb.Engine_MSSQL, currentSDLText, previousUserSDLText, currentSchema)
+}
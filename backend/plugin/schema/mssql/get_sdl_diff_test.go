@@ -0,0 +1,184 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/plugin/schema/schematest"
+)
+
+func TestGetSDLDiff(t *testing.T) {
+	const baseSDL = `CREATE TABLE [dbo].[users] (
+  [id] int NOT NULL,
+  [name] nvarchar(64) NOT NULL,
+  CONSTRAINT [PK_users] PRIMARY KEY CLUSTERED ([id])
+);
+`
+	testCases := []schematest.SDLDiffTestCase{
+		{
+			Name:        "no changes",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL:  baseSDL,
+		},
+		{
+			Name:        "add column and index",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE [dbo].[users] (
+  [id] int NOT NULL,
+  [name] nvarchar(64) NOT NULL,
+  [email] nvarchar(255) NULL,
+  CONSTRAINT [PK_users] PRIMARY KEY CLUSTERED ([id])
+);
+CREATE NONCLUSTERED INDEX [IX_users_name] ON [dbo].[users] ([name]);
+`,
+			Want: `ALTER TABLE [dbo].[users] ADD [email] nvarchar(255) NULL;
+CREATE NONCLUSTERED INDEX [IX_users_name] ON [dbo].[users] ([name]);
+
+`,
+		},
+		{
+			Name:        "rename table",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE [dbo].[accounts] (
+  [id] int NOT NULL,
+  [name] nvarchar(64) NOT NULL,
+  CONSTRAINT [PK_users] PRIMARY KEY CLUSTERED ([id])
+);
+`,
+			Want: `EXEC sp_rename N'[dbo].[users]', N'accounts';
+
+`,
+		},
+		{
+			Name:        "rename column",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE [dbo].[users] (
+  [id] int NOT NULL,
+  [full_name] nvarchar(64) NOT NULL,
+  CONSTRAINT [PK_users] PRIMARY KEY CLUSTERED ([id])
+);
+`,
+			Want: `EXEC sp_rename N'[dbo].[users].[name]', N'full_name', N'COLUMN';
+
+`,
+		},
+		{
+			Name: "rename table with brackets and quotes in the names",
+			Schema: `CREATE TABLE [dbo].[o'rders] (
+  [id] int NOT NULL
+);
+`,
+			PreviousSDL: `CREATE TABLE [dbo].[o'rders] (
+  [id] int NOT NULL
+);
+`,
+			CurrentSDL: `CREATE TABLE [dbo].[[orders]]] (
+  [id] int NOT NULL
+);
+`,
+			Want: "EXEC sp_rename N'[dbo].[o''rders]', N'[orders]';\n\n",
+		},
+		{
+			Name: "rename column with brackets and quotes in the names",
+			Schema: `CREATE TABLE [dbo].[orders] (
+  [na'me] nvarchar(64) NOT NULL
+);
+`,
+			PreviousSDL: `CREATE TABLE [dbo].[orders] (
+  [na'me] nvarchar(64) NOT NULL
+);
+`,
+			CurrentSDL: `CREATE TABLE [dbo].[orders] (
+  [full]]name] nvarchar(64) NOT NULL
+);
+`,
+			Want: "EXEC sp_rename N'[dbo].[orders].[na''me]', N'full]name', N'COLUMN';\n\n",
+		},
+		{
+			Name:   "rename without previous SDL is drop and create",
+			Schema: baseSDL,
+			CurrentSDL: `CREATE TABLE [dbo].[accounts] (
+  [id] int NOT NULL,
+  [name] nvarchar(64) NOT NULL,
+  CONSTRAINT [PK_accounts] PRIMARY KEY CLUSTERED ([id])
+);
+`,
+			Want: `DROP TABLE [dbo].[users];
+
+CREATE TABLE [dbo].[accounts] (
+  [id] int NOT NULL,
+  [name] nvarchar(64) NOT NULL,
+  CONSTRAINT [PK_accounts] PRIMARY KEY CLUSTERED ([id])
+);
+`,
+		},
+		{
+			Name:        "create table in new schema",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: baseSDL + `GO
+CREATE SCHEMA [finance];
+GO
+CREATE TABLE [finance].[ledger] (
+  [id] int NOT NULL
+);
+`,
+			Want: `CREATE SCHEMA [finance];
+GO
+
+CREATE TABLE [finance].[ledger] (
+  [id] int NOT NULL
+);
+`,
+		},
+	}
+
+	schematest.RunSDLDiffTests(t, schematest.SDLDiffEngine{
+		Engine: storepb.Engine_MSSQL,
+		GetDatabaseMetadata: func(text string) (*storepb.DatabaseSchemaMetadata, error) {
+			metadata, err := GetDatabaseMetadata(text)
+			if err != nil {
+				return nil, err
+			}
+			metadata.Name = "db"
+			return metadata, nil
+		},
+		IsObjectCaseSensitive: false,
+	}, testCases)
+}
+
+func TestGetMultiFileDatabaseDefinition(t *testing.T) {
+	metadata, err := GetDatabaseMetadata(`CREATE SCHEMA [finance];
+GO
+CREATE TABLE [dbo].[users] (
+  [id] int NOT NULL
+);
+CREATE TABLE [finance].[ledger] (
+  [id] int NOT NULL
+);
+GO
+CREATE VIEW [dbo].[v_users] AS SELECT [id] FROM [dbo].[users];
+GO
+`)
+	require.NoError(t, err)
+
+	result, err := GetMultiFileDatabaseDefinition(schema.GetDefinitionContext{}, metadata)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range result.Files {
+		files[file.Name] = file.Content
+	}
+	require.Contains(t, files, "schemas/finance/schema.sql")
+	require.NotContains(t, files, "schemas/dbo/schema.sql")
+	require.Contains(t, files["schemas/dbo/tables/users.sql"], "CREATE TABLE [dbo].[users]")
+	require.Contains(t, files["schemas/finance/tables/ledger.sql"], "CREATE TABLE [finance].[ledger]")
+	require.Contains(t, files["schemas/dbo/views/v_users.sql"], "v_users")
+}
@@ -0,0 +1,538 @@
+package mssql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/tsql"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	tsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	// defaultSchemaName is the schema used for the unqualified object names.
+	defaultSchemaName = "dbo"
+)
+
+func init() {
+	schema.RegisterWalkThrough(storepb.Engine_MSSQL, WalkThrough)
+}
+
+// WalkThrough walks through the T-SQL parse tree and updates the database metadata.
+// Statements on objects of other databases, temporary tables and the statements in the
+// bodies of procedures, functions and triggers are ignored.
+func WalkThrough(d *model.DatabaseMetadata, ast any) *storepb.Advice {
+	tree, ok := ast.(antlr.Tree)
+	if !ok {
+		return &storepb.Advice{
+			Status:  storepb.Advice_ERROR,
+			Code:    code.Internal.Int32(),
+			Title:   fmt.Sprintf("MSSQL walk-through expects antlr.Tree, got %T", ast),
+			Content: fmt.Sprintf("MSSQL walk-through expects antlr.Tree, got %T", ast),
+			StartPosition: &storepb.Position{
+				Line: 0,
+			},
+		}
+	}
+
+	// The dbo schema always exists in SQL Server databases.
+	if d.GetSchemaMetadata(defaultSchemaName) == nil {
+		d.CreateSchema(defaultSchemaName)
+	}
+
+	listener := &mssqlCatalogListener{
+		BaseTSqlParserListener: &parser.BaseTSqlParserListener{},
+		databaseMetadata:       d,
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+	return listener.advice
+}
+
+// mssqlCatalogListener replays the DDL statements on the database metadata.
+type mssqlCatalogListener struct {
+	*parser.BaseTSqlParserListener
+
+	databaseMetadata *model.DatabaseMetadata
+	advice           *storepb.Advice
+}
+
+func (l *mssqlCatalogListener) setError(c code.Code, ctx antlr.ParserRuleContext, format string, a ...any) {
+	content := fmt.Sprintf(format, a...)
+	l.advice = &storepb.Advice{
+		Status:        storepb.Advice_ERROR,
+		Code:          c.Int32(),
+		Title:         content,
+		Content:       content,
+		StartPosition: common.ConvertANTLRLineToPosition(ctx.GetStart().GetLine()),
+	}
+}
+
+// shouldSkip returns true if an advice is already found or the statement is not a top-level statement.
+func (l *mssqlCatalogListener) shouldSkip(ctx antlr.Tree) bool {
+	return l.advice != nil || !isTopLevel(ctx.GetParent())
+}
+
+// isTopLevel returns true if the node is not nested in the body of procedures, functions or triggers.
+func isTopLevel(ctx antlr.Tree) bool {
+	if ctx == nil {
+		return true
+	}
+	switch ctx := ctx.(type) {
+	case *parser.Ddl_clauseContext,
+		*parser.Sql_clausesContext,
+		*parser.Batch_without_goContext,
+		*parser.Create_schemaContext:
+		return isTopLevel(ctx.GetParent())
+	case *parser.Tsql_fileContext:
+		return true
+	default:
+		return false
+	}
+}
+
+// getSchema returns the schema, or nil if the database is not the current database.
+// It sets the advice if the schema does not exist.
+func (l *mssqlCatalogListener) getSchema(ctx antlr.ParserRuleContext, databaseName, schemaName string) *model.SchemaMetadata {
+	if databaseName != "" && !strings.EqualFold(databaseName, l.databaseMetadata.DatabaseName()) {
+		return nil
+	}
+	if schemaName == "" {
+		schemaName = defaultSchemaName
+	}
+	schema := l.databaseMetadata.GetSchemaMetadata(schemaName)
+	if schema == nil {
+		l.setError(code.SchemaNotExists, ctx, "Schema %q does not exist", schemaName)
+		return nil
+	}
+	return schema
+}
+
+// normalizeTableName returns the database, schema and table names of the table name.
+func normalizeTableName(ctx parser.ITable_nameContext, fallbackSchemaName string) (string, string, string) {
+	var databaseName, schemaName, tableName string
+	if ctx.GetDatabase() != nil {
+		databaseName, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.GetDatabase())
+	}
+	schemaName = fallbackSchemaName
+	if ctx.GetSchema() != nil {
+		schemaName, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.GetSchema())
+	}
+	if ctx.GetTable() != nil {
+		tableName, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.GetTable())
+	}
+	return databaseName, schemaName, tableName
+}
+
+// findTable returns the table referenced by the statement.
+// It returns a nil table if the table is not tracked, and sets the advice if the table does not exist and ifExists is false.
+func (l *mssqlCatalogListener) findTable(ctx antlr.ParserRuleContext, databaseName, schemaName, tableName string, ifExists bool) (*model.SchemaMetadata, *model.TableMetadata) {
+	if tableName == "" || isTemporaryTable(tableName) {
+		return nil, nil
+	}
+	schema := l.getSchema(ctx, databaseName, schemaName)
+	if schema == nil {
+		return nil, nil
+	}
+	table := schema.GetTable(tableName)
+	if table == nil && !ifExists {
+		l.setError(code.TableNotExists, ctx, "Table %q does not exist in schema %q", tableName, schema.GetProto().Name)
+	}
+	return schema, table
+}
+
+// EnterCreate_schema is called when production create_schema is entered.
+func (l *mssqlCatalogListener) EnterCreate_schema(ctx *parser.Create_schemaContext) {
+	if l.shouldSkip(ctx) || ctx.GetSchema_name() == nil {
+		return
+	}
+	schemaName, _ := tsqlparser.NormalizeTSQLIdentifier(ctx.GetSchema_name())
+	if l.databaseMetadata.GetSchemaMetadata(schemaName) == nil {
+		l.databaseMetadata.CreateSchema(schemaName)
+	}
+}
+
+// EnterDrop_schema is called when production drop_schema is entered.
+func (l *mssqlCatalogListener) EnterDrop_schema(ctx *parser.Drop_schemaContext) {
+	if l.shouldSkip(ctx) || ctx.GetSchema_name() == nil {
+		return
+	}
+	schemaName, _ := tsqlparser.NormalizeTSQLIdentifier(ctx.GetSchema_name())
+	if l.databaseMetadata.GetSchemaMetadata(schemaName) == nil {
+		if ctx.IF() == nil {
+			l.setError(code.SchemaNotExists, ctx, "Schema %q does not exist", schemaName)
+		}
+		return
+	}
+	if err := l.databaseMetadata.DropSchema(schemaName); err != nil {
+		l.setError(code.SchemaNotExists, ctx, "%s", err.Error())
+	}
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *mssqlCatalogListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	if l.shouldSkip(ctx) || ctx.Table_name() == nil {
+		return
+	}
+	fallbackSchemaName := defaultSchemaName
+	// The tables created in CREATE SCHEMA belong to the new schema.
+	if createSchema, ok := ctx.GetParent().(*parser.Create_schemaContext); ok && createSchema.GetSchema_name() != nil {
+		fallbackSchemaName, _ = tsqlparser.NormalizeTSQLIdentifier(createSchema.GetSchema_name())
+	}
+	databaseName, schemaName, tableName := normalizeTableName(ctx.Table_name(), fallbackSchemaName)
+	if tableName == "" || isTemporaryTable(tableName) {
+		return
+	}
+	schema := l.getSchema(ctx, databaseName, schemaName)
+	if schema == nil {
+		return
+	}
+	if schema.GetTable(tableName) != nil {
+		l.setError(code.TableExists, ctx, "Table %q already exists in schema %q", tableName, schema.GetProto().Name)
+		return
+	}
+
+	tableProto := &storepb.TableMetadata{Name: tableName}
+	extractor := &metadataExtractor{currentSchema: schema.GetProto().Name}
+	if ctx.Column_def_table_constraints() != nil {
+		extractor.extractTableElements(ctx.Column_def_table_constraints(), tableProto, schema.GetProto().Name)
+	}
+	for _, tableIndex := range ctx.AllTable_indices() {
+		extractor.extractTableIndex(tableIndex, tableProto)
+	}
+
+	table, err := schema.CreateTable(tableName)
+	if err != nil {
+		l.setError(code.TableExists, ctx, "%s", err.Error())
+		return
+	}
+	l.createColumns(ctx, table, tableProto.Columns)
+	if l.advice != nil {
+		return
+	}
+	l.createConstraints(ctx, table, tableProto)
+}
+
+// EnterDrop_table is called when production drop_table is entered.
+func (l *mssqlCatalogListener) EnterDrop_table(ctx *parser.Drop_tableContext) {
+	if l.shouldSkip(ctx) {
+		return
+	}
+	for _, tableNameCtx := range ctx.AllTable_name() {
+		databaseName, schemaName, tableName := normalizeTableName(tableNameCtx, defaultSchemaName)
+		schema, table := l.findTable(ctx, databaseName, schemaName, tableName, ctx.IF() != nil)
+		if l.advice != nil {
+			return
+		}
+		if table == nil {
+			continue
+		}
+		if err := schema.DropTable(table.GetProto().Name); err != nil {
+			l.setError(code.TableNotExists, ctx, "%s", err.Error())
+			return
+		}
+	}
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *mssqlCatalogListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	if l.shouldSkip(ctx) || ctx.Table_name(0) == nil {
+		return
+	}
+	databaseName, schemaName, tableName := normalizeTableName(ctx.Table_name(0), defaultSchemaName)
+	schema, table := l.findTable(ctx, databaseName, schemaName, tableName, false /* ifExists */)
+	if table == nil {
+		return
+	}
+
+	switch {
+	case ctx.ADD() != nil && ctx.Column_def_table_constraints() != nil:
+		scratch := newScratchTable(table)
+		extractor := &metadataExtractor{currentSchema: schema.GetProto().Name}
+		extractor.extractTableElements(ctx.Column_def_table_constraints(), scratch, schema.GetProto().Name)
+		l.createColumns(ctx, table, scratch.Columns[len(table.GetProto().Columns):])
+		if l.advice != nil {
+			return
+		}
+		l.createConstraints(ctx, table, newConstraints(table, scratch))
+	case ctx.ADD() != nil && ctx.FOREIGN() != nil:
+		scratch := newScratchTable(table)
+		extractor := &metadataExtractor{currentSchema: schema.GetProto().Name}
+		foreignKey := &storepb.ForeignKeyMetadata{ReferencedSchema: schema.GetProto().Name}
+		if ctx.GetConstraint() != nil {
+			foreignKey.Name, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.GetConstraint())
+		}
+		if ctx.GetFk() != nil {
+			extractColumnNames(ctx.GetFk(), &foreignKey.Columns)
+		}
+		if ctx.Table_name(1) != nil {
+			foreignKey.ReferencedSchema, foreignKey.ReferencedTable = extractor.normalizeTableNameSeparated(ctx.Table_name(1), "", schema.GetProto().Name)
+		}
+		if ctx.GetPk() != nil {
+			extractColumnNames(ctx.GetPk(), &foreignKey.ReferencedColumns)
+		}
+		scratch.ForeignKeys = append(scratch.ForeignKeys, foreignKey)
+		l.createConstraints(ctx, table, newConstraints(table, scratch))
+	case ctx.ADD() != nil && ctx.CHECK(0) != nil:
+		check := &storepb.CheckConstraintMetadata{}
+		if ctx.GetConstraint() != nil {
+			check.Name, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.GetConstraint())
+		}
+		if ctx.Search_condition() != nil {
+			check.Expression = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx.Search_condition())
+		}
+		table.GetProto().CheckConstraints = append(table.GetProto().CheckConstraints, check)
+	case ctx.ALTER(1) != nil && ctx.COLUMN() != nil && ctx.Column_definition() != nil:
+		l.alterColumn(ctx, table, ctx.Column_definition())
+	case ctx.ALTER(1) != nil && ctx.COLUMN() != nil && ctx.Column_modifier() != nil:
+		columnName, _ := tsqlparser.NormalizeTSQLIdentifier(ctx.Column_modifier().Id_())
+		l.checkColumnsExist(ctx, table, []string{columnName})
+	case ctx.DROP() != nil && ctx.COLUMN() != nil:
+		for _, id := range ctx.AllId_() {
+			columnName, _ := tsqlparser.NormalizeTSQLIdentifier(id)
+			if !l.checkColumnsExist(ctx, table, []string{columnName}) {
+				return
+			}
+			if err := table.DropColumn(columnName); err != nil {
+				l.setError(code.Internal, ctx, "failed to drop column: %v", err)
+				return
+			}
+		}
+	case ctx.DROP() != nil && ctx.GetConstraint() != nil:
+		constraintName, _ := tsqlparser.NormalizeTSQLIdentifier(ctx.GetConstraint())
+		l.dropConstraint(ctx, table, constraintName)
+	default:
+		// Other alterations do not change the catalog.
+	}
+}
+
+// EnterCreate_index is called when production create_index is entered.
+func (l *mssqlCatalogListener) EnterCreate_index(ctx *parser.Create_indexContext) {
+	if l.shouldSkip(ctx) || ctx.Table_name() == nil || len(ctx.AllId_()) == 0 {
+		return
+	}
+	databaseName, schemaName, tableName := normalizeTableName(ctx.Table_name(), defaultSchemaName)
+	_, table := l.findTable(ctx, databaseName, schemaName, tableName, false /* ifExists */)
+	if table == nil {
+		return
+	}
+
+	index := &storepb.IndexMetadata{
+		Unique: ctx.UNIQUE() != nil,
+	}
+	index.Name, _ = tsqlparser.NormalizeTSQLIdentifier(ctx.Id_(0))
+	if clustered := ctx.Clustered(); clustered != nil {
+		if clustered.CLUSTERED() != nil {
+			index.Type = "CLUSTERED"
+		} else if clustered.NONCLUSTERED() != nil {
+			index.Type = "NONCLUSTERED"
+		}
+	}
+	if ctx.Column_name_list_with_order() != nil {
+		(&metadataExtractor{}).extractIndexColumns(ctx.Column_name_list_with_order(), index)
+	}
+	if !l.checkColumnsExist(ctx, table, index.Expressions) {
+		return
+	}
+	l.createIndex(ctx, table, index)
+}
+
+// EnterDrop_index is called when production drop_index is entered.
+func (l *mssqlCatalogListener) EnterDrop_index(ctx *parser.Drop_indexContext) {
+	if l.shouldSkip(ctx) {
+		return
+	}
+	ifExists := ctx.IF() != nil
+	for _, dropIndex := range ctx.AllDrop_relational_or_xml_or_spatial_index() {
+		if dropIndex.GetIndex_name() == nil || dropIndex.Full_table_name() == nil {
+			continue
+		}
+		fullTableName, err := tsqlparser.NormalizeFullTableName(dropIndex.Full_table_name())
+		if err != nil {
+			continue
+		}
+		indexName, _ := tsqlparser.NormalizeTSQLIdentifier(dropIndex.GetIndex_name())
+		l.dropIndex(ctx, fullTableName.Database, fullTableName.Schema, fullTableName.Table, indexName, ifExists)
+		if l.advice != nil {
+			return
+		}
+	}
+	for _, dropIndex := range ctx.AllDrop_backward_compatible_index() {
+		if dropIndex.GetTable_or_view_name() == nil || dropIndex.GetIndex_name() == nil {
+			continue
+		}
+		var schemaName string
+		if dropIndex.GetOwner_name() != nil {
+			schemaName, _ = tsqlparser.NormalizeTSQLIdentifier(dropIndex.GetOwner_name())
+		}
+		tableName, _ := tsqlparser.NormalizeTSQLIdentifier(dropIndex.GetTable_or_view_name())
+		indexName, _ := tsqlparser.NormalizeTSQLIdentifier(dropIndex.GetIndex_name())
+		l.dropIndex(ctx, "", schemaName, tableName, indexName, ifExists)
+		if l.advice != nil {
+			return
+		}
+	}
+}
+
+func (l *mssqlCatalogListener) dropIndex(ctx antlr.ParserRuleContext, databaseName, schemaName, tableName, indexName string, ifExists bool) {
+	_, table := l.findTable(ctx, databaseName, schemaName, tableName, ifExists)
+	if table == nil {
+		return
+	}
+	if table.GetIndex(indexName) == nil {
+		if !ifExists {
+			l.setError(code.IndexNotExists, ctx, "Index %q does not exist in table %q", indexName, table.GetProto().Name)
+		}
+		return
+	}
+	if err := table.DropIndex(indexName); err != nil {
+		l.setError(code.IndexNotExists, ctx, "%s", err.Error())
+	}
+}
+
+func (l *mssqlCatalogListener) alterColumn(ctx antlr.ParserRuleContext, table *model.TableMetadata, definition parser.IColumn_definitionContext) {
+	scratch := &storepb.TableMetadata{Name: table.GetProto().Name}
+	(&metadataExtractor{}).extractColumn(definition, scratch)
+	if len(scratch.Columns) == 0 {
+		return
+	}
+	newColumn := scratch.Columns[0]
+	column := table.GetColumn(newColumn.Name)
+	if column == nil {
+		l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", newColumn.Name, table.GetProto().Name)
+		return
+	}
+	// ALTER COLUMN cannot change the default constraint of the column.
+	column.Type = newColumn.Type
+	column.Nullable = newColumn.Nullable
+	column.Collation = newColumn.Collation
+}
+
+func (l *mssqlCatalogListener) dropConstraint(ctx antlr.ParserRuleContext, table *model.TableMetadata, constraintName string) {
+	if table.GetIndex(constraintName) != nil {
+		if err := table.DropIndex(constraintName); err != nil {
+			l.setError(code.Internal, ctx, "failed to drop constraint: %v", err)
+		}
+		return
+	}
+	// Default constraints are not tracked, so unknown constraint names are ignored.
+	proto := table.GetProto()
+	proto.ForeignKeys = slices.DeleteFunc(proto.ForeignKeys, func(foreignKey *storepb.ForeignKeyMetadata) bool {
+		return strings.EqualFold(foreignKey.Name, constraintName)
+	})
+	proto.CheckConstraints = slices.DeleteFunc(proto.CheckConstraints, func(check *storepb.CheckConstraintMetadata) bool {
+		return strings.EqualFold(check.Name, constraintName)
+	})
+}
+
+func (l *mssqlCatalogListener) createColumns(ctx antlr.ParserRuleContext, table *model.TableMetadata, columns []*storepb.ColumnMetadata) {
+	for _, column := range columns {
+		if table.GetColumn(column.Name) != nil {
+			l.setError(code.ColumnExists, ctx, "Column %q already exists in table %q", column.Name, table.GetProto().Name)
+			return
+		}
+		column.Position = int32(len(table.GetProto().Columns) + 1)
+		if err := table.CreateColumn(column); err != nil {
+			l.setError(code.Internal, ctx, "failed to create column: %v", err)
+			return
+		}
+	}
+}
+
+// createConstraints adds the indexes, foreign keys and check constraints of the constraint table to the table.
+func (l *mssqlCatalogListener) createConstraints(ctx antlr.ParserRuleContext, table *model.TableMetadata, constraints *storepb.TableMetadata) {
+	tableName := table.GetProto().Name
+	for _, index := range constraints.Indexes {
+		if index.Primary && table.GetPrimaryKey() != nil {
+			l.setError(code.PrimaryKeyExists, ctx, "Primary key already exists in table %q", tableName)
+			return
+		}
+		if index.Name == "" {
+			// SQL Server generates the names for the unnamed constraints.
+			switch {
+			case index.Primary:
+				index.Name = fmt.Sprintf("PK_%s", tableName)
+			case index.Unique:
+				index.Name = fmt.Sprintf("UQ_%s_%d", tableName, len(table.GetProto().Indexes)+1)
+			default:
+				index.Name = fmt.Sprintf("IX_%s_%d", tableName, len(table.GetProto().Indexes)+1)
+			}
+		}
+		if !l.checkColumnsExist(ctx, table, index.Expressions) {
+			return
+		}
+		if !l.createIndex(ctx, table, index) {
+			return
+		}
+	}
+	for _, foreignKey := range constraints.ForeignKeys {
+		if !l.checkColumnsExist(ctx, table, foreignKey.Columns) {
+			return
+		}
+	}
+	proto := table.GetProto()
+	proto.ForeignKeys = append(proto.ForeignKeys, constraints.ForeignKeys...)
+	proto.CheckConstraints = append(proto.CheckConstraints, constraints.CheckConstraints...)
+}
+
+func (l *mssqlCatalogListener) createIndex(ctx antlr.ParserRuleContext, table *model.TableMetadata, index *storepb.IndexMetadata) bool {
+	if table.GetIndex(index.Name) != nil {
+		l.setError(code.IndexExists, ctx, "Index %q already exists in table %q", index.Name, table.GetProto().Name)
+		return false
+	}
+	if err := table.CreateIndex(index); err != nil {
+		l.setError(code.IndexExists, ctx, "%s", err.Error())
+		return false
+	}
+	return true
+}
+
+func (l *mssqlCatalogListener) checkColumnsExist(ctx antlr.ParserRuleContext, table *model.TableMetadata, columns []string) bool {
+	for _, column := range columns {
+		if table.GetColumn(column) == nil {
+			l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", column, table.GetProto().Name)
+			return false
+		}
+	}
+	return true
+}
+
+// newScratchTable returns a copy of the table for the metadata extractor to append columns and constraints to.
+func newScratchTable(table *model.TableMetadata) *storepb.TableMetadata {
+	proto := table.GetProto()
+	return &storepb.TableMetadata{
+		Name:             proto.Name,
+		Columns:          slices.Clone(proto.Columns),
+		Indexes:          slices.Clone(proto.Indexes),
+		ForeignKeys:      slices.Clone(proto.ForeignKeys),
+		CheckConstraints: slices.Clone(proto.CheckConstraints),
+	}
+}
+
+// newConstraints returns the constraints appended to the scratch table.
+func newConstraints(table *model.TableMetadata, scratch *storepb.TableMetadata) *storepb.TableMetadata {
+	proto := table.GetProto()
+	return &storepb.TableMetadata{
+		Indexes:          scratch.Indexes[len(proto.Indexes):],
+		ForeignKeys:      scratch.ForeignKeys[len(proto.ForeignKeys):],
+		CheckConstraints: scratch.CheckConstraints[len(proto.CheckConstraints):],
+	}
+}
+
+func extractColumnNames(ctx parser.IColumn_name_listContext, columns *[]string) {
+	for _, id := range ctx.AllId_() {
+		columnName, _ := tsqlparser.NormalizeTSQLIdentifier(id)
+		*columns = append(*columns, columnName)
+	}
+}
+
+// isTemporaryTable returns true for the local and global temporary tables.
+func isTemporaryTable(tableName string) bool {
+	return strings.HasPrefix(tableName, "#")
+}
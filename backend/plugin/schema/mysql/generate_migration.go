@@ -27,6 +27,11 @@ func generateMigration(diff *schema.MetadataDiff) (string, error) {
 	// MySQL doesn't have schemas like PostgreSQL, so we skip schema-level changes
 	// We'll focus on table-level changes
 
+	// Phase 0: Rename tables and columns first, so that the following statements use the new names
+	if err := renameObjects(diff, &buf); err != nil {
+		return "", err
+	}
+
 	// Phase 1: Drop dependent objects first
 	if err := dropObjectsInOrder(diff, &buf); err != nil {
 		return "", err
@@ -48,6 +53,35 @@ func generateMigration(diff *schema.MetadataDiff) (string, error) {
 	return buf.String(), nil
 }
 
+// renameObjects renames the tables and columns detected by the SDL diff.
+func renameObjects(diff *schema.MetadataDiff, buf *strings.Builder) error {
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter && tableDiff.OldTableName != "" {
+			if err := writeRenameTable(buf, tableDiff.OldTableName, tableDiff.TableName); err != nil {
+				return err
+			}
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, colDiff := range tableDiff.ColumnChanges {
+			if isColumnRename(colDiff) {
+				if err := writeChangeColumn(buf, tableDiff.TableName, colDiff.OldColumn.Name, colDiff.NewColumn); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isColumnRename returns true if the column diff renames the column.
+func isColumnRename(colDiff *schema.ColumnDiff) bool {
+	return colDiff.Action == schema.MetadataDiffActionAlter && !strings.EqualFold(colDiff.OldColumn.Name, colDiff.NewColumn.Name)
+}
+
 // dropObjectsInOrder drops all objects in the correct order
 func dropObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) error {
 	// Drop triggers first (they depend on tables)
@@ -364,9 +398,9 @@ func generateAlterTable(tableDiff *schema.TableDiff, buf *strings.Builder) error
 		}
 	}
 
-	// Modify columns
+	// Modify columns, the renamed columns are already changed
 	for _, colDiff := range tableDiff.ColumnChanges {
-		if colDiff.Action == schema.MetadataDiffActionAlter {
+		if colDiff.Action == schema.MetadataDiffActionAlter && !isColumnRename(colDiff) {
 			if err := writeModifyColumn(buf, tableDiff.TableName, colDiff.NewColumn); err != nil {
 				return err
 			}
@@ -401,6 +435,13 @@ func generateAlterTable(tableDiff *schema.TableDiff, buf *strings.Builder) error
 		}
 	}
 
+	// Repartition the table after the indexes are in place
+	if len(tableDiff.PartitionChanges) > 0 && tableDiff.NewTable != nil {
+		if err := writeAlterTablePartitions(buf, tableDiff.TableName, tableDiff.NewTable.Partitions); err != nil {
+			return err
+		}
+	}
+
 	// Add foreign keys last
 	for _, fkDiff := range tableDiff.ForeignKeyChanges {
 		if fkDiff.Action == schema.MetadataDiffActionCreate {
@@ -476,6 +517,32 @@ func writeDropEvent(buf *strings.Builder, event string) error {
 	return nil
 }
 
+func writeRenameTable(buf *strings.Builder, oldTable, newTable string) error {
+	_, _ = buf.WriteString("RENAME TABLE `")
+	_, _ = buf.WriteString(oldTable)
+	_, _ = buf.WriteString("` TO `")
+	_, _ = buf.WriteString(newTable)
+	_, _ = buf.WriteString("`;\n\n")
+	return nil
+}
+
+// writeAlterTablePartitions rewrites the partitioning of the table. MySQL copies the rows into the new partitions,
+// so the data is kept, unlike dropping and adding the changed partitions.
+func writeAlterTablePartitions(buf *strings.Builder, table string, partitions []*storepb.TablePartitionMetadata) error {
+	_, _ = buf.WriteString("ALTER TABLE `")
+	_, _ = buf.WriteString(table)
+	_, _ = buf.WriteString("`")
+	if len(partitions) == 0 {
+		_, _ = buf.WriteString(" REMOVE PARTITIONING;\n")
+		return nil
+	}
+	if err := printPartitionClause(buf, partitions); err != nil {
+		return err
+	}
+	_, _ = buf.WriteString(";\n")
+	return nil
+}
+
 func writeDropTable(buf *strings.Builder, table string) error {
 	_, _ = buf.WriteString("DROP TABLE IF EXISTS `")
 	_, _ = buf.WriteString(table)
@@ -650,6 +717,12 @@ func writeCreateTableWithoutForeignKeys(buf *strings.Builder, tableName string,
 		_, _ = buf.WriteString("'")
 	}
 
+	if len(table.Partitions) > 0 {
+		if err := printPartitionClause(buf, table.Partitions); err != nil {
+			return err
+		}
+	}
+
 	_, _ = buf.WriteString(";\n")
 
 	// Create non-unique indexes separately
@@ -736,6 +809,22 @@ func writeModifyColumn(buf *strings.Builder, table string, column *storepb.Colum
 	_, _ = buf.WriteString("` MODIFY COLUMN `")
 	_, _ = buf.WriteString(column.Name)
 	_, _ = buf.WriteString("` ")
+	return writeAlterColumnDefinition(buf, column)
+}
+
+func writeChangeColumn(buf *strings.Builder, table, oldName string, column *storepb.ColumnMetadata) error {
+	_, _ = buf.WriteString("ALTER TABLE `")
+	_, _ = buf.WriteString(table)
+	_, _ = buf.WriteString("` CHANGE COLUMN `")
+	_, _ = buf.WriteString(oldName)
+	_, _ = buf.WriteString("` `")
+	_, _ = buf.WriteString(column.Name)
+	_, _ = buf.WriteString("` ")
+	return writeAlterColumnDefinition(buf, column)
+}
+
+// writeAlterColumnDefinition writes the column definition of MODIFY COLUMN and CHANGE COLUMN.
+func writeAlterColumnDefinition(buf *strings.Builder, column *storepb.ColumnMetadata) error {
 	_, _ = buf.WriteString(column.Type)
 
 	if column.CharacterSet != "" {
@@ -876,9 +876,18 @@ func (*metadataExtractor) extractPartitions(ctx mysql.IPartitionClauseContext, t
 			}
 
 			// Extract partition value (for RANGE/LIST partitions)
-			if partDef.PartitionValueItemListParen() != nil {
-				// This contains VALUES LESS THAN or VALUES IN
-				partition.Value = partDef.PartitionValueItemListParen().GetText()
+			// The value is stored without the enclosing parentheses, the same as the value synced from the database.
+			switch {
+			case partDef.PartitionValueItemListParen() != nil:
+				// VALUES LESS THAN (...)
+				partition.Value = trimEnclosingParentheses(partDef.PartitionValueItemListParen().GetText())
+			case partDef.MAXVALUE_SYMBOL() != nil:
+				// VALUES LESS THAN MAXVALUE
+				partition.Value = "MAXVALUE"
+			case partDef.PartitionValuesIn() != nil:
+				// VALUES IN (...)
+				partition.Value = trimEnclosingParentheses(partDef.PartitionValuesIn().GetText())
+			default:
 			}
 
 			table.Partitions = append(table.Partitions, partition)
@@ -901,6 +910,13 @@ func (*metadataExtractor) extractPartitions(ctx mysql.IPartitionClauseContext, t
 	}
 }
 
+func trimEnclosingParentheses(s string) string {
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
 // EnterCreateView is called when entering a CREATE VIEW statement
 func (e *metadataExtractor) EnterCreateView(ctx *mysql.CreateViewContext) {
 	if e.err != nil {
@@ -918,20 +934,9 @@ func (e *metadataExtractor) EnterCreateView(ctx *mysql.CreateViewContext) {
 	}
 
 	// Extract view definition
-	// For MySQL, the view definition is the entire CREATE VIEW statement
-	if ctx.GetStart() != nil && ctx.GetStop() != nil {
-		startIndex := ctx.GetStart().GetTokenIndex()
-		stopIndex := ctx.GetStop().GetTokenIndex()
-		tokens := ctx.GetParser().GetTokenStream()
-
-		var definitionParts []string
-		for i := startIndex; i <= stopIndex; i++ {
-			token := tokens.Get(i)
-			if token != nil {
-				definitionParts = append(definitionParts, token.GetText())
-			}
-		}
-		view.Definition = strings.Join(definitionParts, " ")
+	// The definition is the query of the view, the same as the VIEW_DEFINITION synced from the database.
+	if ctx.ViewTail() != nil && ctx.ViewTail().ViewSelect() != nil {
+		view.Definition = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx.ViewTail().ViewSelect())
 	}
 
 	e.views[viewName] = view
@@ -1025,6 +1030,15 @@ func (e *metadataExtractor) EnterCreateTrigger(ctx *mysql.CreateTriggerContext)
 	trigger := &storepb.TriggerMetadata{
 		Name: triggerName,
 	}
+	if ctx.GetTiming() != nil {
+		trigger.Timing = strings.ToUpper(ctx.GetTiming().GetText())
+	}
+	if ctx.GetEvent() != nil {
+		trigger.Event = strings.ToUpper(ctx.GetEvent().GetText())
+	}
+	if ctx.CompoundStatement() != nil {
+		trigger.Body = ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx.CompoundStatement())
+	}
 
 	// Add trigger to the appropriate table
 	table := e.getOrCreateTable(tableName)
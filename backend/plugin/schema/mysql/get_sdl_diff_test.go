@@ -0,0 +1,123 @@
+package mysql
+
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema/schematest"
+)
+
+func TestGetSDLDiff(t *testing.T) {
+	const baseSDL = `CREATE TABLE users (
+  id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`
+	testCases := []schematest.SDLDiffTestCase{
+		{
+			Name:        "no changes",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL:  baseSDL,
+		},
+		{
+			Name:        "add column and index",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE users (
+  id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  email VARCHAR(255) NULL,
+  PRIMARY KEY (id),
+  KEY idx_users_name (name)
+);
+`,
+			Want: "ALTER TABLE `users` ADD COLUMN `email` varchar(255) DEFAULT NULL;\nCREATE INDEX `idx_users_name` ON `users` (`name`);\n",
+		},
+		{
+			Name:        "rename table",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE accounts (
+  id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`,
+			Want: "RENAME TABLE `users` TO `accounts`;\n\n\n",
+		},
+		{
+			Name:        "rename column",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE users (
+  id INT NOT NULL,
+  full_name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`,
+			Want: "ALTER TABLE `users` CHANGE COLUMN `name` `full_name` varchar(64) NOT NULL;\n\n",
+		},
+		{
+			Name:   "rename without previous SDL is drop and create",
+			Schema: baseSDL,
+			CurrentSDL: `CREATE TABLE accounts (
+  id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`,
+			Want: "DROP TABLE IF EXISTS `users`;\n\n\nCREATE TABLE IF NOT EXISTS `accounts` (\n  `id` int NOT NULL,\n  `name` varchar(64) NOT NULL,\n  PRIMARY KEY (`id`)\n);\n",
+		},
+		{
+			Name:        "drop table not in SDL",
+			Schema:      baseSDL + "CREATE TABLE logs (id INT NOT NULL);\n",
+			PreviousSDL: baseSDL,
+			CurrentSDL:  baseSDL,
+			Want:        "DROP TABLE IF EXISTS `logs`;\n\n",
+		},
+		{
+			Name: "change partitions",
+			Schema: `CREATE TABLE events (
+  id INT NOT NULL,
+  created_year INT NOT NULL
+)
+PARTITION BY RANGE (created_year)
+(PARTITION p2023 VALUES LESS THAN (2024),
+ PARTITION p2024 VALUES LESS THAN (2025));
+`,
+			CurrentSDL: `CREATE TABLE events (
+  id INT NOT NULL,
+  created_year INT NOT NULL
+)
+PARTITION BY RANGE (created_year)
+(PARTITION p2023 VALUES LESS THAN (2024),
+ PARTITION p2024 VALUES LESS THAN (2025),
+ PARTITION p2025 VALUES LESS THAN (2026));
+`,
+			Want: "ALTER TABLE `events`\n/*!50100 PARTITION BY RANGE (created_year)\n(PARTITION p2023 VALUES LESS THAN (2024) ENGINE=InnoDB,\n PARTITION p2024 VALUES LESS THAN (2025) ENGINE=InnoDB,\n PARTITION p2025 VALUES LESS THAN (2026) ENGINE=InnoDB) */;\n",
+		},
+		{
+			Name:   "create view and trigger",
+			Schema: baseSDL,
+			CurrentSDL: baseSDL + `CREATE VIEW v_users AS SELECT id, name FROM users;
+CREATE TRIGGER trg_users_insert BEFORE INSERT ON users FOR EACH ROW SET NEW.name = TRIM(NEW.name);
+`,
+			Want: "CREATE VIEW `v_users` AS SELECT 1;\nCREATE TRIGGER `trg_users_insert` BEFORE INSERT ON `users` FOR EACH ROW SET NEW.name = TRIM(NEW.name);\nCREATE OR REPLACE VIEW `v_users` AS SELECT id, name FROM users;\n",
+		},
+	}
+
+	schematest.RunSDLDiffTests(t, schematest.SDLDiffEngine{
+		Engine: storepb.Engine_MYSQL,
+		GetDatabaseMetadata: func(text string) (*storepb.DatabaseSchemaMetadata, error) {
+			metadata, err := GetDatabaseMetadata(text)
+			if err != nil {
+				return nil, err
+			}
+			metadata.Name = "db"
+			return metadata, nil
+		},
+		IsObjectCaseSensitive: false,
+	}, testCases)
+}
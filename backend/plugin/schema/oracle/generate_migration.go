@@ -18,11 +18,15 @@ func generateMigration(diff *schema.MetadataDiff) (string, error) {
 	var buf strings.Builder
 
 	// Safe order for migrations:
+	// 0. Rename tables and columns detected by the SDL diff
 	// 1. Drop dependent objects first (in reverse dependency order)
 	//    - Use topological sort to drop in safe order
 	// 2. Create/Alter objects (in dependency order)
 	//    - Use topological sort to create in safe order
 
+	// Phase 0: Rename tables and columns first, so that the following statements use the new names
+	renameObjects(diff, &buf)
+
 	// Phase 1: Drop dependent objects using topological sort
 	dropObjectsInOrder(diff, &buf)
 
@@ -40,6 +44,25 @@ func generateMigration(diff *schema.MetadataDiff) (string, error) {
 	return buf.String(), nil
 }
 
+// renameObjects renames the tables and columns detected by the SDL diff.
+func renameObjects(diff *schema.MetadataDiff, buf *strings.Builder) {
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter && tableDiff.OldTableName != "" {
+			writeRenameTable(buf, tableDiff.SchemaName, tableDiff.OldTableName, tableDiff.TableName)
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, colDiff := range tableDiff.ColumnChanges {
+			if colDiff.Action == schema.MetadataDiffActionAlter && colDiff.OldColumn.Name != colDiff.NewColumn.Name {
+				writeRenameColumn(buf, tableDiff.SchemaName, tableDiff.TableName, colDiff.OldColumn.Name, colDiff.NewColumn.Name)
+			}
+		}
+	}
+}
+
 // dropObjectsInOrder drops all objects in reverse topological order (most dependent first)
 func dropObjectsInOrder(diff *schema.MetadataDiff, buf *strings.Builder) {
 	// Build dependency graph for all objects being dropped or altered
@@ -1004,6 +1027,38 @@ func writeDropTable(out *strings.Builder, schema, table string) {
 	_, _ = out.WriteString("\n")
 }
 
+func writeRenameTable(out *strings.Builder, schema, oldTable, newTable string) {
+	_, _ = out.WriteString(`ALTER TABLE `)
+	if schema != "" {
+		_, _ = out.WriteString(`"`)
+		_, _ = out.WriteString(schema)
+		_, _ = out.WriteString(`".`)
+	}
+	_, _ = out.WriteString(`"`)
+	_, _ = out.WriteString(oldTable)
+	_, _ = out.WriteString(`" RENAME TO "`)
+	_, _ = out.WriteString(newTable)
+	_, _ = out.WriteString(`";`)
+	_, _ = out.WriteString("\n")
+}
+
+func writeRenameColumn(out *strings.Builder, schema, table, oldColumn, newColumn string) {
+	_, _ = out.WriteString(`ALTER TABLE `)
+	if schema != "" {
+		_, _ = out.WriteString(`"`)
+		_, _ = out.WriteString(schema)
+		_, _ = out.WriteString(`".`)
+	}
+	_, _ = out.WriteString(`"`)
+	_, _ = out.WriteString(table)
+	_, _ = out.WriteString(`" RENAME COLUMN "`)
+	_, _ = out.WriteString(oldColumn)
+	_, _ = out.WriteString(`" TO "`)
+	_, _ = out.WriteString(newColumn)
+	_, _ = out.WriteString(`";`)
+	_, _ = out.WriteString("\n")
+}
+
 func writeDropSequence(out *strings.Builder, schema, sequence string) {
 	_, _ = out.WriteString(`DROP SEQUENCE `)
 	if schema != "" {
@@ -1,8 +1,11 @@
 package oracle
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
+
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
@@ -11,6 +14,7 @@ import (
 func init() {
 	schema.RegisterGetDatabaseDefinition(storepb.Engine_ORACLE, GetDatabaseDefinition)
 	schema.RegisterGetTableDefinition(storepb.Engine_ORACLE, GetTableDefinition)
+	schema.RegisterGetMultiFileDatabaseDefinition(storepb.Engine_ORACLE, GetMultiFileDatabaseDefinition)
 }
 
 func GetDatabaseDefinition(_ schema.GetDefinitionContext, to *storepb.DatabaseSchemaMetadata) (string, error) {
@@ -166,6 +170,92 @@ func GetDatabaseDefinition(_ schema.GetDefinitionContext, to *storepb.DatabaseSc
 	return buf.String(), nil
 }
 
+// GetMultiFileDatabaseDefinition generates multi-file SDL schema for Oracle.
+// Oracle databases have a single schema, so the files are organized by object type at the top level,
+// and the sequences are written together to sequences.sql.
+func GetMultiFileDatabaseDefinition(_ schema.GetDefinitionContext, metadata *storepb.DatabaseSchemaMetadata) (*schema.MultiFileSchemaResult, error) {
+	if len(metadata.Schemas) == 0 {
+		return &schema.MultiFileSchemaResult{Files: []schema.File{}}, nil
+	}
+
+	var files []schema.File
+	schemaMetadata := metadata.Schemas[0]
+
+	var sequenceBuf strings.Builder
+	for _, sequence := range schemaMetadata.Sequences {
+		// Skip system-generated sequences
+		if strings.HasPrefix(sequence.Name, "ISEQ$$_") {
+			continue
+		}
+		if err := writeSequence(&sequenceBuf, sequence); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate sequence SDL for %s", sequence.Name)
+		}
+	}
+	if sequenceBuf.Len() > 0 {
+		files = append(files, schema.File{
+			Name:    "sequences.sql",
+			Content: sequenceBuf.String(),
+		})
+	}
+
+	for _, table := range schemaMetadata.Tables {
+		var buf strings.Builder
+		if err := writeTable(&buf, schemaMetadata.Name, table); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate table SDL for %s", table.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("tables/%s.sql", table.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, view := range schemaMetadata.Views {
+		var buf strings.Builder
+		if err := writeView(&buf, schemaMetadata.Name, view); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate view SDL for %s", view.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("views/%s.sql", view.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, view := range schemaMetadata.MaterializedViews {
+		var buf strings.Builder
+		if err := writeMaterializedView(&buf, schemaMetadata.Name, view); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate materialized view SDL for %s", view.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("materialized_views/%s.sql", view.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, function := range schemaMetadata.Functions {
+		var buf strings.Builder
+		if err := writeFunction(&buf, schemaMetadata.Name, function); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate function SDL for %s", function.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("functions/%s.sql", function.Name),
+			Content: buf.String(),
+		})
+	}
+
+	for _, procedure := range schemaMetadata.Procedures {
+		var buf strings.Builder
+		if err := writeProcedure(&buf, schemaMetadata.Name, procedure); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate procedure SDL for %s", procedure.Name)
+		}
+		files = append(files, schema.File{
+			Name:    fmt.Sprintf("procedures/%s.sql", procedure.Name),
+			Content: buf.String(),
+		})
+	}
+
+	return &schema.MultiFileSchemaResult{Files: files}, nil
+}
+
 func GetTableDefinition(schemaName string, table *storepb.TableMetadata, _ []*storepb.SequenceMetadata) (string, error) {
 	var buf strings.Builder
 	if err := writeTable(&buf, schemaName, table); err != nil {
@@ -0,0 +1,125 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/plugin/schema/schematest"
+)
+
+func TestGetSDLDiff(t *testing.T) {
+	const baseSDL = `CREATE TABLE "USERS" (
+  "ID" NUMBER(10) NOT NULL,
+  "NAME" VARCHAR2(64) NOT NULL,
+  CONSTRAINT "PK_USERS" PRIMARY KEY ("ID")
+);
+`
+	testCases := []schematest.SDLDiffTestCase{
+		{
+			Name:        "no changes",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL:  baseSDL,
+		},
+		{
+			Name:        "add column",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE "USERS" (
+  "ID" NUMBER(10) NOT NULL,
+  "NAME" VARCHAR2(64) NOT NULL,
+  "EMAIL" VARCHAR2(255),
+  CONSTRAINT "PK_USERS" PRIMARY KEY ("ID")
+);
+`,
+			Want: `ALTER TABLE "USERS" ADD "EMAIL" VARCHAR2(255 BYTE);
+`,
+		},
+		{
+			Name:        "rename table",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE "ACCOUNTS" (
+  "ID" NUMBER(10) NOT NULL,
+  "NAME" VARCHAR2(64) NOT NULL,
+  CONSTRAINT "PK_USERS" PRIMARY KEY ("ID")
+);
+`,
+			Want: `ALTER TABLE "USERS" RENAME TO "ACCOUNTS";
+
+`,
+		},
+		{
+			Name:        "rename column",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE "USERS" (
+  "ID" NUMBER(10) NOT NULL,
+  "FULL_NAME" VARCHAR2(64) NOT NULL,
+  CONSTRAINT "PK_USERS" PRIMARY KEY ("ID")
+);
+`,
+			Want: `ALTER TABLE "USERS" RENAME COLUMN "NAME" TO "FULL_NAME";
+
+`,
+		},
+		{
+			Name:   "rename without previous SDL is drop and create",
+			Schema: baseSDL,
+			CurrentSDL: `CREATE TABLE "ACCOUNTS" (
+  "ID" NUMBER(10) NOT NULL,
+  "NAME" VARCHAR2(64) NOT NULL,
+  CONSTRAINT "PK_ACCOUNTS" PRIMARY KEY ("ID")
+);
+`,
+			Want: `DROP TABLE "USERS";
+
+CREATE TABLE "ACCOUNTS" (
+    "ID" NUMBER(10) NOT NULL,
+    "NAME" VARCHAR2(64 BYTE) NOT NULL
+);
+ALTER TABLE "ACCOUNTS" ADD CONSTRAINT "PK_ACCOUNTS" PRIMARY KEY (ID);
+
+`,
+		},
+	}
+
+	schematest.RunSDLDiffTests(t, schematest.SDLDiffEngine{
+		Engine: storepb.Engine_ORACLE,
+		GetDatabaseMetadata: func(text string) (*storepb.DatabaseSchemaMetadata, error) {
+			metadata, err := GetDatabaseMetadata(text)
+			if err != nil {
+				return nil, err
+			}
+			// The synced metadata keeps the objects of the database user in the unnamed schema.
+			metadata.Name = "DB"
+			metadata.Schemas[0].Name = ""
+			return metadata, nil
+		},
+		IsObjectCaseSensitive: true,
+	}, testCases)
+}
+
+func TestGetMultiFileDatabaseDefinition(t *testing.T) {
+	metadata, err := GetDatabaseMetadata(`CREATE SEQUENCE "ORDER_SEQ" START WITH 1 INCREMENT BY 1;
+CREATE TABLE "ORDERS" (
+  "ID" NUMBER(10) NOT NULL
+);
+CREATE VIEW "V_ORDERS" AS SELECT "ID" FROM "ORDERS";
+`)
+	require.NoError(t, err)
+
+	result, err := GetMultiFileDatabaseDefinition(schema.GetDefinitionContext{}, metadata)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range result.Files {
+		files[file.Name] = file.Content
+	}
+	require.Contains(t, files["sequences.sql"], "ORDER_SEQ")
+	require.Contains(t, files["tables/ORDERS.sql"], `CREATE TABLE "ORDERS"`)
+	require.Contains(t, files["views/V_ORDERS.sql"], `CREATE VIEW "V_ORDERS"`)
+}
@@ -0,0 +1,652 @@
+package oracle
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/parser/plsql"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	oracleparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func init() {
+	schema.RegisterWalkThrough(storepb.Engine_ORACLE, WalkThrough)
+}
+
+// WalkThrough walks through the Oracle parse results and updates the database metadata.
+// Only the objects owned by the current database (schema) are tracked, statements on objects
+// of other schemas or behind database links are ignored.
+func WalkThrough(d *model.DatabaseMetadata, ast any) *storepb.Advice {
+	parseResults, ok := ast.([]*oracleparser.ParseResult)
+	if !ok {
+		return &storepb.Advice{
+			Status:  storepb.Advice_ERROR,
+			Code:    code.Internal.Int32(),
+			Title:   fmt.Sprintf("Oracle walk-through expects []*plsql.ParseResult, got %T", ast),
+			Content: fmt.Sprintf("Oracle walk-through expects []*plsql.ParseResult, got %T", ast),
+			StartPosition: &storepb.Position{
+				Line: 0,
+			},
+		}
+	}
+
+	// Oracle syncs the objects of the current database into the schema whose name is empty.
+	if d.GetSchemaMetadata("") == nil {
+		d.CreateSchema("")
+	}
+
+	for _, parseResult := range parseResults {
+		if parseResult.Tree == nil {
+			continue
+		}
+		listener := &oracleCatalogListener{
+			BasePlSqlParserListener: &parser.BasePlSqlParserListener{},
+			baseLine:                parseResult.BaseLine,
+			databaseMetadata:        d,
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, parseResult.Tree)
+		if listener.advice != nil {
+			return listener.advice
+		}
+	}
+	return nil
+}
+
+// oracleCatalogListener replays the DDL statements on the database metadata.
+type oracleCatalogListener struct {
+	*parser.BasePlSqlParserListener
+
+	baseLine         int
+	databaseMetadata *model.DatabaseMetadata
+	advice           *storepb.Advice
+}
+
+func (l *oracleCatalogListener) setError(c code.Code, ctx antlr.ParserRuleContext, format string, a ...any) {
+	content := fmt.Sprintf(format, a...)
+	l.advice = &storepb.Advice{
+		Status:        storepb.Advice_ERROR,
+		Code:          c.Int32(),
+		Title:         content,
+		Content:       content,
+		StartPosition: common.ConvertANTLRLineToPosition(l.baseLine + ctx.GetStart().GetLine()),
+	}
+}
+
+// getSchema returns the schema holding the objects of the owner, or nil if the owner is not the current database.
+func (l *oracleCatalogListener) getSchema(owner string) *model.SchemaMetadata {
+	if owner != "" && owner != l.databaseMetadata.DatabaseName() {
+		return nil
+	}
+	return l.databaseMetadata.GetSchemaMetadata("")
+}
+
+// findTable returns the table referenced by the statement.
+// It returns a nil table if the table is not tracked, and sets the advice if the table does not exist.
+func (l *oracleCatalogListener) findTable(ctx antlr.ParserRuleContext, tableView parser.ITableview_nameContext) (*model.SchemaMetadata, *model.TableMetadata) {
+	if tableView == nil {
+		return nil, nil
+	}
+	links, owner, tableName := oracleparser.NormalizeTableViewName("", tableView)
+	if len(links) > 0 || tableName == "" {
+		return nil, nil
+	}
+	schema := l.getSchema(owner)
+	if schema == nil {
+		return nil, nil
+	}
+	table := schema.GetTable(tableName)
+	if table == nil {
+		l.setError(code.TableNotExists, ctx, "Table %q does not exist", tableName)
+		return nil, nil
+	}
+	return schema, table
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *oracleCatalogListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	if l.advice != nil || ctx.Table_name() == nil {
+		return
+	}
+	schema := l.getSchema(oracleparser.NormalizeSchemaName(ctx.Schema_name()))
+	if schema == nil {
+		return
+	}
+	tableName := oracleparser.NormalizeTableName(ctx.Table_name())
+	if tableName == "" {
+		return
+	}
+	if schema.GetTable(tableName) != nil {
+		l.setError(code.TableExists, ctx, "Table %q already exists", tableName)
+		return
+	}
+
+	tableProto := &storepb.TableMetadata{Name: tableName}
+	if ctx.Relational_table() != nil {
+		extractor := newTableExtractor(tableName)
+		extractor.extractRelationalTable(ctx.Relational_table(), tableProto)
+		extractor.processInlineConstraints(tableName, tableProto)
+	}
+
+	table, err := schema.CreateTable(tableName)
+	if err != nil {
+		l.setError(code.TableExists, ctx, "%s", err.Error())
+		return
+	}
+	for _, column := range tableProto.Columns {
+		if table.GetColumn(column.Name) != nil {
+			l.setError(code.ColumnExists, ctx, "Column %q already exists in table %q", column.Name, tableName)
+			return
+		}
+		if err := table.CreateColumn(column); err != nil {
+			l.setError(code.Internal, ctx, "failed to create column: %v", err)
+			return
+		}
+	}
+	l.createConstraints(ctx, schema, table, tableProto)
+}
+
+// EnterDrop_table is called when production drop_table is entered.
+func (l *oracleCatalogListener) EnterDrop_table(ctx *parser.Drop_tableContext) {
+	if l.advice != nil {
+		return
+	}
+	schema, table := l.findTable(ctx, ctx.Tableview_name())
+	if table == nil {
+		return
+	}
+	if err := schema.DropTable(table.GetProto().Name); err != nil {
+		l.setError(code.TableNotExists, ctx, "%s", err.Error())
+	}
+}
+
+// EnterRename_object is called when production rename_object is entered.
+func (l *oracleCatalogListener) EnterRename_object(ctx *parser.Rename_objectContext) {
+	if l.advice != nil || len(ctx.AllObject_name()) != 2 {
+		return
+	}
+	schema := l.databaseMetadata.GetSchemaMetadata("")
+	oldName := normalizeObjectName(ctx.Object_name(0))
+	// RENAME also applies to views, sequences and synonyms which are not tracked.
+	if schema.GetTable(oldName) == nil {
+		return
+	}
+	l.renameTable(ctx, schema, oldName, normalizeObjectName(ctx.Object_name(1)))
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *oracleCatalogListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	if l.advice != nil {
+		return
+	}
+	schema, table := l.findTable(ctx, ctx.Tableview_name())
+	if table == nil {
+		return
+	}
+
+	switch {
+	case ctx.Alter_table_properties() != nil:
+		properties := ctx.Alter_table_properties()
+		if properties.RENAME() != nil && properties.Tableview_name() != nil {
+			_, _, newName := oracleparser.NormalizeTableViewName("", properties.Tableview_name())
+			l.renameTable(ctx, schema, table.GetProto().Name, newName)
+		}
+	case ctx.Constraint_clauses() != nil:
+		l.alterConstraints(ctx, schema, table, ctx.Constraint_clauses())
+	case ctx.Column_clauses() != nil:
+		columnClauses := ctx.Column_clauses()
+		if columnClauses.Rename_column_clause() != nil {
+			l.renameColumn(ctx, table, columnClauses.Rename_column_clause())
+			return
+		}
+		if columnClauses.Add_modify_drop_column_clauses() == nil {
+			return
+		}
+		for _, child := range columnClauses.Add_modify_drop_column_clauses().GetChildren() {
+			if l.advice != nil {
+				return
+			}
+			switch clause := child.(type) {
+			case *parser.Constraint_clausesContext:
+				l.alterConstraints(ctx, schema, table, clause)
+			case *parser.Add_column_clauseContext:
+				l.addColumns(ctx, schema, table, clause)
+			case *parser.Modify_column_clausesContext:
+				l.modifyColumns(ctx, table, clause)
+			case *parser.Drop_column_clauseContext:
+				l.dropColumns(ctx, table, clause)
+			default:
+			}
+		}
+	default:
+		// Partitioning, MOVE and the other table options do not change the catalog.
+	}
+}
+
+// EnterCreate_index is called when production create_index is entered.
+func (l *oracleCatalogListener) EnterCreate_index(ctx *parser.Create_indexContext) {
+	if l.advice != nil || ctx.Index_name() == nil || ctx.Table_index_clause() == nil {
+		return
+	}
+	schema, table := l.findTable(ctx, ctx.Table_index_clause().Tableview_name())
+	if table == nil {
+		return
+	}
+	_, indexName := oracleparser.NormalizeIndexName(ctx.Index_name())
+	if indexName == "" {
+		return
+	}
+
+	index := &storepb.IndexMetadata{
+		Name:    indexName,
+		Unique:  ctx.UNIQUE() != nil,
+		Type:    "NORMAL",
+		Visible: true,
+	}
+	if ctx.BITMAP() != nil {
+		index.Type = "BITMAP"
+	}
+	var columns []string
+	for _, option := range ctx.Table_index_clause().AllIndex_expr_option() {
+		if option.Index_expr() != nil && option.Index_expr().Column_name() != nil {
+			columns = append(columns, normalizeColumnName(option.Index_expr().Column_name()))
+		}
+	}
+	if !l.checkColumnsExist(ctx, table, columns) {
+		return
+	}
+	newTableExtractor(table.GetProto().Name).extractIndexExpressions(ctx.Table_index_clause(), index)
+	l.createIndex(ctx, schema, table, index)
+}
+
+// EnterDrop_index is called when production drop_index is entered.
+func (l *oracleCatalogListener) EnterDrop_index(ctx *parser.Drop_indexContext) {
+	if l.advice != nil || ctx.Index_name() == nil {
+		return
+	}
+	owner, indexName := oracleparser.NormalizeIndexName(ctx.Index_name())
+	schema := l.getSchema(owner)
+	if schema == nil {
+		return
+	}
+	table := findIndexTable(schema, indexName)
+	if table == nil {
+		l.setError(code.IndexNotExists, ctx, "Index %q does not exist", indexName)
+		return
+	}
+	if err := table.DropIndex(indexName); err != nil {
+		l.setError(code.IndexNotExists, ctx, "%s", err.Error())
+	}
+}
+
+// EnterAlter_index is called when production alter_index is entered.
+func (l *oracleCatalogListener) EnterAlter_index(ctx *parser.Alter_indexContext) {
+	if l.advice != nil || ctx.Index_name() == nil {
+		return
+	}
+	operation := ctx.Alter_index_ops_set2()
+	if operation == nil || operation.RENAME() == nil || operation.New_index_name() == nil {
+		return
+	}
+	owner, indexName := oracleparser.NormalizeIndexName(ctx.Index_name())
+	schema := l.getSchema(owner)
+	if schema == nil {
+		return
+	}
+	table := findIndexTable(schema, indexName)
+	if table == nil {
+		l.setError(code.IndexNotExists, ctx, "Index %q does not exist", indexName)
+		return
+	}
+	_, newIndexName := oracleparser.NormalizeIndexName(operation.New_index_name().Index_name())
+	if schema.GetIndex(newIndexName) != nil {
+		l.setError(code.IndexExists, ctx, "Index %q already exists", newIndexName)
+		return
+	}
+	if err := table.RenameIndex(indexName, newIndexName); err != nil {
+		l.setError(code.IndexNotExists, ctx, "%s", err.Error())
+	}
+}
+
+// EnterComment_on_table is called when production comment_on_table is entered.
+func (l *oracleCatalogListener) EnterComment_on_table(ctx *parser.Comment_on_tableContext) {
+	if l.advice != nil || ctx.Tableview_name() == nil || ctx.Quoted_string() == nil {
+		return
+	}
+	links, owner, tableName := oracleparser.NormalizeTableViewName("", ctx.Tableview_name())
+	schema := l.getSchema(owner)
+	if len(links) > 0 || schema == nil {
+		return
+	}
+	// COMMENT ON TABLE also applies to views, which are not tracked.
+	if table := schema.GetTable(tableName); table != nil {
+		table.GetProto().Comment = oracleparser.NormalizeQuotedString(ctx.Quoted_string())
+	}
+}
+
+// EnterComment_on_column is called when production comment_on_column is entered.
+func (l *oracleCatalogListener) EnterComment_on_column(ctx *parser.Comment_on_columnContext) {
+	if l.advice != nil || ctx.Column_name() == nil || ctx.Quoted_string() == nil {
+		return
+	}
+	owner, tableName, columnName := oracleparser.NormalizeColumnName(ctx.Column_name())
+	schema := l.getSchema(owner)
+	if schema == nil || tableName == "" {
+		return
+	}
+	table := schema.GetTable(tableName)
+	if table == nil {
+		return
+	}
+	column := table.GetColumn(columnName)
+	if column == nil {
+		l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", columnName, tableName)
+		return
+	}
+	column.Comment = oracleparser.NormalizeQuotedString(ctx.Quoted_string())
+}
+
+func (l *oracleCatalogListener) renameTable(ctx antlr.ParserRuleContext, schema *model.SchemaMetadata, oldName, newName string) {
+	if newName == "" {
+		return
+	}
+	if schema.GetTable(newName) != nil {
+		l.setError(code.TableExists, ctx, "Table %q already exists", newName)
+		return
+	}
+	if err := schema.RenameTable(oldName, newName); err != nil {
+		l.setError(code.TableNotExists, ctx, "%s", err.Error())
+	}
+}
+
+func (l *oracleCatalogListener) addColumns(ctx antlr.ParserRuleContext, schema *model.SchemaMetadata, table *model.TableMetadata, clause *parser.Add_column_clauseContext) {
+	tableName := table.GetProto().Name
+	extractor := newTableExtractor(tableName)
+	var columns []*storepb.ColumnMetadata
+	for _, child := range clause.GetChildren() {
+		switch definition := child.(type) {
+		case *parser.Column_definitionContext:
+			columns = append(columns, extractor.extractColumnDefinition(definition))
+		case *parser.Virtual_column_definitionContext:
+			columns = append(columns, extractor.extractVirtualColumnDefinition(definition))
+		default:
+		}
+	}
+	for _, column := range columns {
+		if column == nil {
+			continue
+		}
+		if table.GetColumn(column.Name) != nil {
+			l.setError(code.ColumnExists, ctx, "Column %q already exists in table %q", column.Name, tableName)
+			return
+		}
+		column.Position = int32(len(table.GetProto().Columns) + 1)
+		if err := table.CreateColumn(column); err != nil {
+			l.setError(code.Internal, ctx, "failed to create column: %v", err)
+			return
+		}
+	}
+
+	if len(extractor.inlinePrimaryKeys[tableName]) > 0 && table.GetPrimaryKey() != nil {
+		l.setError(code.PrimaryKeyExists, ctx, "Primary key already exists in table %q", tableName)
+		return
+	}
+	scratch := newScratchTable(table)
+	extractor.processInlineConstraints(tableName, scratch)
+	l.createConstraints(ctx, schema, table, newConstraints(table, scratch))
+}
+
+func (l *oracleCatalogListener) modifyColumns(ctx antlr.ParserRuleContext, table *model.TableMetadata, clause *parser.Modify_column_clausesContext) {
+	extractor := newTableExtractor(table.GetProto().Name)
+	for _, property := range clause.AllModify_col_properties() {
+		columnName := normalizeColumnName(property.Column_name())
+		column := table.GetColumn(columnName)
+		if column == nil {
+			l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", columnName, table.GetProto().Name)
+			return
+		}
+		if property.Datatype() != nil {
+			column.Type = extractor.extractDataType(property.Datatype())
+		}
+		if property.DEFAULT() != nil && property.Expression() != nil {
+			column.Default = getTextFromContext(property.Expression())
+		}
+		for _, constraint := range property.AllInline_constraint() {
+			switch {
+			case constraint.NOT() != nil && constraint.NULL_() != nil:
+				column.Nullable = false
+			case constraint.NULL_() != nil:
+				column.Nullable = true
+			default:
+			}
+		}
+	}
+}
+
+func (l *oracleCatalogListener) dropColumns(ctx antlr.ParserRuleContext, table *model.TableMetadata, clause *parser.Drop_column_clauseContext) {
+	// DROP UNUSED COLUMNS only removes the columns already marked as unused.
+	for _, columnNameCtx := range clause.AllColumn_name() {
+		columnName := normalizeColumnName(columnNameCtx)
+		if table.GetColumn(columnName) == nil {
+			l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", columnName, table.GetProto().Name)
+			return
+		}
+		if err := table.DropColumn(columnName); err != nil {
+			l.setError(code.Internal, ctx, "failed to drop column: %v", err)
+			return
+		}
+	}
+}
+
+func (l *oracleCatalogListener) renameColumn(ctx antlr.ParserRuleContext, table *model.TableMetadata, clause parser.IRename_column_clauseContext) {
+	if clause.Old_column_name() == nil || clause.New_column_name() == nil {
+		return
+	}
+	tableName := table.GetProto().Name
+	oldName := normalizeColumnName(clause.Old_column_name().Column_name())
+	newName := normalizeColumnName(clause.New_column_name().Column_name())
+	if table.GetColumn(oldName) == nil {
+		l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", oldName, tableName)
+		return
+	}
+	if table.GetColumn(newName) != nil {
+		l.setError(code.ColumnExists, ctx, "Column %q already exists in table %q", newName, tableName)
+		return
+	}
+	if err := table.RenameColumn(oldName, newName); err != nil {
+		l.setError(code.Internal, ctx, "failed to rename column: %v", err)
+	}
+}
+
+func (l *oracleCatalogListener) alterConstraints(ctx antlr.ParserRuleContext, schema *model.SchemaMetadata, table *model.TableMetadata, clause parser.IConstraint_clausesContext) {
+	tableName := table.GetProto().Name
+	switch {
+	case clause.ADD() != nil:
+		extractor := newTableExtractor(tableName)
+		scratch := newScratchTable(table)
+		for _, constraint := range clause.AllOut_of_line_constraint() {
+			extractor.extractOutOfLineConstraint(constraint, scratch)
+		}
+		if clause.Out_of_line_ref_constraint() != nil {
+			extractor.extractOutOfLineRefConstraint(clause.Out_of_line_ref_constraint(), scratch)
+		}
+		l.createConstraints(ctx, schema, table, newConstraints(table, scratch))
+	case clause.RENAME() != nil:
+		if clause.Old_constraint_name() == nil || clause.New_constraint_name() == nil {
+			return
+		}
+		oldName := normalizeConstraintName(clause.Old_constraint_name().Constraint_name())
+		newName := normalizeConstraintName(clause.New_constraint_name().Constraint_name())
+		if table.GetIndex(oldName) != nil {
+			if err := table.RenameIndex(oldName, newName); err != nil {
+				l.setError(code.IndexExists, ctx, "%s", err.Error())
+			}
+			return
+		}
+		for _, foreignKey := range table.GetProto().ForeignKeys {
+			if foreignKey.Name == oldName {
+				foreignKey.Name = newName
+			}
+		}
+		for _, check := range table.GetProto().CheckConstraints {
+			if check.Name == oldName {
+				check.Name = newName
+			}
+		}
+	default:
+		for _, drop := range clause.AllDrop_constraint_clause() {
+			if drop.Drop_primary_key_or_unique_or_generic_clause() == nil {
+				continue
+			}
+			l.dropConstraint(ctx, table, drop.Drop_primary_key_or_unique_or_generic_clause())
+			if l.advice != nil {
+				return
+			}
+		}
+	}
+}
+
+func (l *oracleCatalogListener) dropConstraint(ctx antlr.ParserRuleContext, table *model.TableMetadata, clause parser.IDrop_primary_key_or_unique_or_generic_clauseContext) {
+	tableName := table.GetProto().Name
+	switch {
+	case clause.PRIMARY() != nil:
+		primaryKey := table.GetPrimaryKey()
+		if primaryKey == nil {
+			l.setError(code.PrimaryKeyNotExists, ctx, "Primary key does not exist in table %q", tableName)
+			return
+		}
+		if err := table.DropIndex(primaryKey.GetProto().Name); err != nil {
+			l.setError(code.Internal, ctx, "failed to drop primary key: %v", err)
+		}
+	case clause.UNIQUE() != nil:
+		var columns []string
+		for _, columnName := range clause.AllColumn_name() {
+			columns = append(columns, normalizeColumnName(columnName))
+		}
+		for _, index := range table.ListIndexes() {
+			if index.GetProto().Unique && !index.GetProto().Primary && slices.Equal(index.GetProto().Expressions, columns) {
+				if err := table.DropIndex(index.GetProto().Name); err != nil {
+					l.setError(code.Internal, ctx, "failed to drop unique key: %v", err)
+				}
+				return
+			}
+		}
+		l.setError(code.IndexNotExists, ctx, "Unique key on %v does not exist in table %q", columns, tableName)
+	case clause.Constraint_name() != nil:
+		// Constraints such as NOT NULL are named by Oracle and are not tracked, so unknown names are ignored.
+		constraintName := normalizeConstraintName(clause.Constraint_name())
+		if table.GetIndex(constraintName) != nil {
+			if err := table.DropIndex(constraintName); err != nil {
+				l.setError(code.Internal, ctx, "failed to drop constraint: %v", err)
+			}
+			return
+		}
+		proto := table.GetProto()
+		proto.ForeignKeys = slices.DeleteFunc(proto.ForeignKeys, func(foreignKey *storepb.ForeignKeyMetadata) bool {
+			return foreignKey.Name == constraintName
+		})
+		proto.CheckConstraints = slices.DeleteFunc(proto.CheckConstraints, func(check *storepb.CheckConstraintMetadata) bool {
+			return check.Name == constraintName
+		})
+	default:
+	}
+}
+
+// createConstraints adds the indexes, foreign keys and check constraints of the constraint table to the table.
+func (l *oracleCatalogListener) createConstraints(ctx antlr.ParserRuleContext, schema *model.SchemaMetadata, table *model.TableMetadata, constraints *storepb.TableMetadata) {
+	for _, index := range constraints.Indexes {
+		if index.Primary && table.GetPrimaryKey() != nil {
+			l.setError(code.PrimaryKeyExists, ctx, "Primary key already exists in table %q", table.GetProto().Name)
+			return
+		}
+		if !l.checkColumnsExist(ctx, table, index.Expressions) {
+			return
+		}
+		if !l.createIndex(ctx, schema, table, index) {
+			return
+		}
+	}
+	for _, foreignKey := range constraints.ForeignKeys {
+		if !l.checkColumnsExist(ctx, table, foreignKey.Columns) {
+			return
+		}
+	}
+	proto := table.GetProto()
+	proto.ForeignKeys = append(proto.ForeignKeys, constraints.ForeignKeys...)
+	proto.CheckConstraints = append(proto.CheckConstraints, constraints.CheckConstraints...)
+}
+
+func (l *oracleCatalogListener) createIndex(ctx antlr.ParserRuleContext, schema *model.SchemaMetadata, table *model.TableMetadata, index *storepb.IndexMetadata) bool {
+	// Index names are unique within an Oracle schema.
+	if schema.GetIndex(index.Name) != nil {
+		l.setError(code.IndexExists, ctx, "Index %q already exists", index.Name)
+		return false
+	}
+	if err := table.CreateIndex(index); err != nil {
+		l.setError(code.IndexExists, ctx, "%s", err.Error())
+		return false
+	}
+	return true
+}
+
+func (l *oracleCatalogListener) checkColumnsExist(ctx antlr.ParserRuleContext, table *model.TableMetadata, columns []string) bool {
+	for _, column := range columns {
+		if table.GetColumn(column) == nil {
+			l.setError(code.ColumnNotExists, ctx, "Column %q does not exist in table %q", column, table.GetProto().Name)
+			return false
+		}
+	}
+	return true
+}
+
+func newTableExtractor(tableName string) *metadataExtractor {
+	return &metadataExtractor{
+		currentTable:      tableName,
+		inlinePrimaryKeys: make(map[string][]string),
+		inlineUniqueKeys:  make(map[string][]string),
+	}
+}
+
+// newScratchTable returns a copy of the table for the metadata extractor to append constraints to.
+func newScratchTable(table *model.TableMetadata) *storepb.TableMetadata {
+	proto := table.GetProto()
+	return &storepb.TableMetadata{
+		Name:             proto.Name,
+		Columns:          proto.Columns,
+		Indexes:          slices.Clone(proto.Indexes),
+		ForeignKeys:      slices.Clone(proto.ForeignKeys),
+		CheckConstraints: slices.Clone(proto.CheckConstraints),
+	}
+}
+
+// newConstraints returns the constraints appended to the scratch table.
+func newConstraints(table *model.TableMetadata, scratch *storepb.TableMetadata) *storepb.TableMetadata {
+	proto := table.GetProto()
+	return &storepb.TableMetadata{
+		Indexes:          scratch.Indexes[len(proto.Indexes):],
+		ForeignKeys:      scratch.ForeignKeys[len(proto.ForeignKeys):],
+		CheckConstraints: scratch.CheckConstraints[len(proto.CheckConstraints):],
+	}
+}
+
+func findIndexTable(schema *model.SchemaMetadata, indexName string) *model.TableMetadata {
+	for _, tableName := range schema.ListTableNames() {
+		if table := schema.GetTable(tableName); table != nil && table.GetIndex(indexName) != nil {
+			return table
+		}
+	}
+	return nil
+}
+
+func normalizeObjectName(ctx parser.IObject_nameContext) string {
+	ids := ctx.AllId_expression()
+	if len(ids) == 0 {
+		return ""
+	}
+	return oracleparser.NormalizeIDExpression(ids[len(ids)-1])
+}
@@ -0,0 +1,92 @@
+package oracle
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+type walkThroughCase struct {
+	Statement string
+	Want      string
+	Advice    *storepb.Advice
+}
+
+func TestWalkThrough(t *testing.T) {
+	tests := []walkThroughCase{}
+	const (
+		record = false
+	)
+	var (
+		filepath = "testdata/walk_through.yaml"
+	)
+
+	a := require.New(t)
+	yamlFile, err := os.Open(filepath)
+	a.NoError(err)
+	defer yamlFile.Close()
+
+	byteValue, err := io.ReadAll(yamlFile)
+	a.NoError(err)
+	a.NoError(yaml.Unmarshal(byteValue, &tests))
+
+	originDatabase := &storepb.DatabaseSchemaMetadata{
+		Name: "TEST",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "BOOK",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "ID", Position: 1, Type: "NUMBER"},
+							{Name: "TITLE", Position: 2, Type: "VARCHAR2(100)", Nullable: true},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PK_BOOK", Expressions: []string{"ID"}, Primary: true, Unique: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	sm := sheet.NewManager(nil)
+	for i, tc := range tests {
+		protoData, ok := proto.Clone(originDatabase).(*storepb.DatabaseSchemaMetadata)
+		a.True(ok)
+		state := model.NewDatabaseMetadata(protoData, nil, nil, storepb.Engine_ORACLE, true /* isObjectCaseSensitive */)
+
+		asts, _ := sm.GetASTsForChecks(storepb.Engine_ORACLE, tc.Statement)
+		advice := WalkThrough(state, asts)
+		want := ""
+		if advice == nil {
+			jsonBytes, err := json.MarshalIndent(state.GetProto(), "", "  ")
+			a.NoError(err)
+			want = string(jsonBytes)
+		}
+
+		if record {
+			tests[i].Want = want
+			tests[i].Advice = advice
+		} else {
+			a.Equal(tc.Want, want, tc.Statement)
+			a.Equal(tc.Advice.GetCode(), advice.GetCode(), tc.Statement)
+			a.Equal(tc.Advice.GetContent(), advice.GetContent(), tc.Statement)
+			a.Equal(tc.Advice.GetStartPosition().GetLine(), advice.GetStartPosition().GetLine(), tc.Statement)
+		}
+	}
+	if record {
+		byteValue, err := yaml.Marshal(tests)
+		a.NoError(err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		a.NoError(err)
+	}
+}
@@ -0,0 +1,50 @@
+// Package schematest provides the shared test runners of the schema plugins.
+package schematest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// SDLDiffTestCase is a test case of the migration generated from the SDL diff.
+type SDLDiffTestCase struct {
+	Name string
+	// Schema is the schema of the current database.
+	Schema      string
+	PreviousSDL string
+	CurrentSDL  string
+	// Want is the expected migration.
+	Want string
+}
+
+// SDLDiffEngine is the engine under the SDL diff test.
+type SDLDiffEngine struct {
+	Engine storepb.Engine
+	// GetDatabaseMetadata parses the schema into the metadata as it's synced from the database.
+	GetDatabaseMetadata   func(schema string) (*storepb.DatabaseSchemaMetadata, error)
+	IsObjectCaseSensitive bool
+}
+
+// RunSDLDiffTests generates the migration of each test case from the SDL diff against the current database,
+// and compares it with the expected one.
+func RunSDLDiffTests(t *testing.T, engine SDLDiffEngine, testCases []SDLDiffTestCase) {
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			metadata, err := engine.GetDatabaseMetadata(tc.Schema)
+			require.NoError(t, err)
+			currentSchema := model.NewDatabaseMetadata(metadata, []byte(tc.Schema), &storepb.DatabaseConfig{}, engine.Engine, engine.IsObjectCaseSensitive)
+
+			diff, err := schema.GetSDLDiff(engine.Engine, tc.CurrentSDL, tc.PreviousSDL, currentSchema, nil)
+			require.NoError(t, err)
+			migration, err := schema.GenerateMigration(engine.Engine, diff)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.Want, migration)
+		})
+	}
+}
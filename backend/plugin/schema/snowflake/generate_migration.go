@@ -0,0 +1,332 @@
+package snowflake
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_SNOWFLAKE, generateMigration)
+}
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// Safe order for migrations:
+	// 1. Drop the views, because they depend on the tables.
+	// 2. Rename the tables and columns detected by the SDL diff.
+	// 3. Drop the tables, then the schemas.
+	// 4. Create the schemas, then create and alter the tables.
+	// 5. Create the views.
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop {
+			writeDropView(&buf, viewDiff.SchemaName, viewDiff.ViewName)
+		}
+	}
+
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter && tableDiff.OldTableName != "" {
+			_, _ = fmt.Fprintf(&buf, "ALTER TABLE %s RENAME TO %s;\n", getTableIdentifier(tableDiff.SchemaName, tableDiff.OldTableName), getTableIdentifier(tableDiff.SchemaName, tableDiff.TableName))
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, columnDiff := range tableDiff.ColumnChanges {
+			if columnDiff.Action == schema.MetadataDiffActionAlter && columnDiff.OldColumn.Name != columnDiff.NewColumn.Name {
+				_, _ = fmt.Fprintf(&buf, "ALTER TABLE %s RENAME COLUMN %s TO %s;\n", getTableIdentifier(tableDiff.SchemaName, tableDiff.TableName), quoteIdentifier(columnDiff.OldColumn.Name), quoteIdentifier(columnDiff.NewColumn.Name))
+			}
+		}
+	}
+
+	// Drop the foreign keys before dropping the referenced tables.
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter {
+			continue
+		}
+		for _, fkDiff := range tableDiff.ForeignKeyChanges {
+			if fkDiff.Action == schema.MetadataDiffActionDrop {
+				writeDropConstraint(&buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.OldForeignKey.Name)
+			}
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(&buf, "DROP TABLE %s;\n", getTableIdentifier(tableDiff.SchemaName, tableDiff.TableName))
+		}
+	}
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(&buf, "DROP SCHEMA %s;\n", quoteIdentifier(schemaDiff.SchemaName))
+		}
+	}
+
+	for _, schemaDiff := range diff.SchemaChanges {
+		if schemaDiff.Action == schema.MetadataDiffActionCreate {
+			_, _ = fmt.Fprintf(&buf, "CREATE SCHEMA %s;\n", quoteIdentifier(schemaDiff.SchemaName))
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			writeCreateTable(&buf, tableDiff.SchemaName, tableDiff.TableName, tableDiff.NewTable)
+		case schema.MetadataDiffActionAlter:
+			writeAlterTable(&buf, tableDiff)
+		default:
+		}
+	}
+	// Add the foreign keys after all the tables are created.
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			for _, fk := range tableDiff.NewTable.ForeignKeys {
+				writeAddForeignKey(&buf, tableDiff.SchemaName, tableDiff.TableName, fk)
+			}
+		case schema.MetadataDiffActionAlter:
+			for _, fkDiff := range tableDiff.ForeignKeyChanges {
+				if fkDiff.Action == schema.MetadataDiffActionCreate {
+					writeAddForeignKey(&buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.NewForeignKey)
+				}
+			}
+		default:
+		}
+	}
+
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			writeCreateView(&buf, viewDiff.SchemaName, viewDiff.ViewName, viewDiff.NewView)
+		}
+	}
+
+	return buf.String(), nil
+}
+
+func writeCreateTable(buf *strings.Builder, schemaName, tableName string, table *storepb.TableMetadata) {
+	_, _ = buf.WriteString("CREATE ")
+	if table.Transient {
+		_, _ = buf.WriteString("TRANSIENT ")
+	}
+	_, _ = fmt.Fprintf(buf, "TABLE %s (\n", getTableIdentifier(schemaName, tableName))
+	var definitions []string
+	for _, column := range table.Columns {
+		definitions = append(definitions, getColumnDefinition(column))
+	}
+	for _, index := range table.Indexes {
+		if index.Primary || index.Unique {
+			definitions = append(definitions, getKeyConstraintDefinition(index))
+		}
+	}
+	_, _ = fmt.Fprintf(buf, "  %s\n)", strings.Join(definitions, ",\n  "))
+	if len(table.ClusteringKeys) > 0 {
+		_, _ = fmt.Fprintf(buf, " CLUSTER BY (%s)", strings.Join(table.ClusteringKeys, ", "))
+	}
+	if table.Comment != "" {
+		_, _ = fmt.Fprintf(buf, " COMMENT = '%s'", escapeString(table.Comment))
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeAlterTable(buf *strings.Builder, tableDiff *schema.TableDiff) {
+	oldTable, newTable := tableDiff.OldTable, tableDiff.NewTable
+	// A permanent table can't be converted to a transient table in place, and vice versa.
+	if oldTable.Transient != newTable.Transient {
+		writeRecreateTable(buf, tableDiff)
+		return
+	}
+
+	table := getTableIdentifier(tableDiff.SchemaName, tableDiff.TableName)
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action != schema.MetadataDiffActionDrop {
+			continue
+		}
+		if indexDiff.OldIndex.Primary {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP PRIMARY KEY;\n", table)
+		} else if indexDiff.OldIndex.Unique {
+			writeDropConstraint(buf, tableDiff.SchemaName, tableDiff.TableName, indexDiff.OldIndex.Name)
+		}
+	}
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", table, quoteIdentifier(columnDiff.OldColumn.Name))
+		}
+	}
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		switch columnDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN %s;\n", table, getColumnDefinition(columnDiff.NewColumn))
+		case schema.MetadataDiffActionAlter:
+			writeAlterColumn(buf, table, columnDiff.OldColumn, columnDiff.NewColumn)
+		default:
+		}
+	}
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if indexDiff.Action == schema.MetadataDiffActionCreate && (indexDiff.NewIndex.Primary || indexDiff.NewIndex.Unique) {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD %s;\n", table, getKeyConstraintDefinition(indexDiff.NewIndex))
+		}
+	}
+
+	if !slices.Equal(oldTable.ClusteringKeys, newTable.ClusteringKeys) {
+		if len(newTable.ClusteringKeys) == 0 {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP CLUSTERING KEY;\n", table)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s CLUSTER BY (%s);\n", table, strings.Join(newTable.ClusteringKeys, ", "))
+		}
+	}
+	if oldTable.Comment != newTable.Comment {
+		if newTable.Comment == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s UNSET COMMENT;\n", table)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s SET COMMENT = '%s';\n", table, escapeString(newTable.Comment))
+		}
+	}
+}
+
+// writeRecreateTable creates the table with the new definition, copies the data of the common columns and swaps
+// the tables.
+func writeRecreateTable(buf *strings.Builder, tableDiff *schema.TableDiff) {
+	table := getTableIdentifier(tableDiff.SchemaName, tableDiff.TableName)
+	newTableName := fmt.Sprintf("_%s_BB_NEW", tableDiff.TableName)
+	newTable := getTableIdentifier(tableDiff.SchemaName, newTableName)
+	writeCreateTable(buf, tableDiff.SchemaName, newTableName, tableDiff.NewTable)
+
+	// The renamed columns already have the new names.
+	oldColumns := make(map[string]bool)
+	for _, column := range tableDiff.OldTable.Columns {
+		oldColumns[column.Name] = true
+	}
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionAlter {
+			oldColumns[columnDiff.NewColumn.Name] = true
+		}
+	}
+	var columns []string
+	for _, column := range tableDiff.NewTable.Columns {
+		if oldColumns[column.Name] {
+			columns = append(columns, quoteIdentifier(column.Name))
+		}
+	}
+	if len(columns) > 0 {
+		columnList := strings.Join(columns, ", ")
+		_, _ = fmt.Fprintf(buf, "INSERT INTO %s (%s) SELECT %s FROM %s;\n", newTable, columnList, columnList, table)
+	}
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s SWAP WITH %s;\n", table, newTable)
+	_, _ = fmt.Fprintf(buf, "DROP TABLE %s;\n", newTable)
+}
+
+func writeAlterColumn(buf *strings.Builder, table string, oldColumn, newColumn *storepb.ColumnMetadata) {
+	column := quoteIdentifier(newColumn.Name)
+	if oldColumn.Type != newColumn.Type {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET DATA TYPE %s;\n", table, column, newColumn.Type)
+	}
+	if oldColumn.Nullable != newColumn.Nullable {
+		if newColumn.Nullable {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL;\n", table, column)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;\n", table, column)
+		}
+	}
+	if oldColumn.Default != newColumn.Default {
+		// Snowflake only supports setting the sequence default on the existing columns.
+		if newColumn.Default == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;\n", table, column)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;\n", table, column, newColumn.Default)
+		}
+	}
+	if oldColumn.Comment != newColumn.Comment {
+		if newColumn.Comment == "" {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s UNSET COMMENT;\n", table, column)
+		} else {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s COMMENT '%s';\n", table, column, escapeString(newColumn.Comment))
+		}
+	}
+}
+
+func writeAddForeignKey(buf *strings.Builder, schemaName, tableName string, fk *storepb.ForeignKeyMetadata) {
+	var columns, referencedColumns []string
+	for _, column := range fk.Columns {
+		columns = append(columns, quoteIdentifier(column))
+	}
+	for _, column := range fk.ReferencedColumns {
+		referencedColumns = append(referencedColumns, quoteIdentifier(column))
+	}
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s);\n",
+		getTableIdentifier(schemaName, tableName),
+		quoteIdentifier(fk.Name),
+		strings.Join(columns, ", "),
+		getTableIdentifier(fk.ReferencedSchema, fk.ReferencedTable),
+		strings.Join(referencedColumns, ", "),
+	)
+}
+
+func writeDropConstraint(buf *strings.Builder, schemaName, tableName, constraintName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP CONSTRAINT %s;\n", getTableIdentifier(schemaName, tableName), quoteIdentifier(constraintName))
+}
+
+func writeDropView(buf *strings.Builder, schemaName, viewName string) {
+	_, _ = fmt.Fprintf(buf, "DROP VIEW %s;\n", getTableIdentifier(schemaName, viewName))
+}
+
+func writeCreateView(buf *strings.Builder, schemaName, viewName string, view *storepb.ViewMetadata) {
+	definition := strings.TrimRight(strings.TrimSpace(view.Definition), ";")
+	// The VIEW_DEFINITION of INFORMATION_SCHEMA.VIEWS is the whole CREATE VIEW statement.
+	upperDefinition := strings.ToUpper(definition)
+	if strings.HasPrefix(upperDefinition, "CREATE ") {
+		if !strings.HasPrefix(upperDefinition, "CREATE OR REPLACE ") {
+			definition = "CREATE OR REPLACE " + strings.TrimSpace(definition[len("CREATE "):])
+		}
+		_, _ = fmt.Fprintf(buf, "%s;\n", definition)
+		return
+	}
+	_, _ = fmt.Fprintf(buf, "CREATE OR REPLACE VIEW %s AS %s;\n", getTableIdentifier(schemaName, viewName), definition)
+}
+
+func getColumnDefinition(column *storepb.ColumnMetadata) string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "%s %s", quoteIdentifier(column.Name), column.Type)
+	if column.Comment != "" {
+		_, _ = fmt.Fprintf(&buf, " COMMENT '%s'", escapeString(column.Comment))
+	}
+	if column.Default != "" {
+		_, _ = fmt.Fprintf(&buf, " DEFAULT %s", column.Default)
+	}
+	if !column.Nullable {
+		_, _ = buf.WriteString(" NOT NULL")
+	}
+	return buf.String()
+}
+
+func getKeyConstraintDefinition(index *storepb.IndexMetadata) string {
+	var columns []string
+	for _, expression := range index.Expressions {
+		columns = append(columns, quoteIdentifier(expression))
+	}
+	keyType := "UNIQUE"
+	if index.Primary {
+		keyType = "PRIMARY KEY"
+	}
+	if index.Name == "" {
+		return fmt.Sprintf("%s (%s)", keyType, strings.Join(columns, ", "))
+	}
+	return fmt.Sprintf("CONSTRAINT %s %s (%s)", quoteIdentifier(index.Name), keyType, strings.Join(columns, ", "))
+}
+
+func getTableIdentifier(schemaName, tableName string) string {
+	if schemaName == "" {
+		return quoteIdentifier(tableName)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schemaName), quoteIdentifier(tableName))
+}
+
+func quoteIdentifier(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
+
+func escapeString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
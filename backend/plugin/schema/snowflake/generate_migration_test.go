@@ -0,0 +1,129 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	newOrdersTable := func() *storepb.TableMetadata {
+		return &storepb.TableMetadata{
+			Name: "ORDERS",
+			Columns: []*storepb.ColumnMetadata{
+				{Name: "ID", Type: "NUMBER"},
+				{Name: "CREATED_AT", Type: "TIMESTAMP_NTZ", Nullable: true},
+			},
+			Indexes: []*storepb.IndexMetadata{
+				{Name: "PK_ORDERS", Primary: true, Unique: true, Expressions: []string{"ID"}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		oldTable *storepb.TableMetadata
+		newTable *storepb.TableMetadata
+		want     string
+	}{
+		{
+			name: "create transient table with clustering keys",
+			newTable: func() *storepb.TableMetadata {
+				table := newOrdersTable()
+				table.Transient = true
+				table.ClusteringKeys = []string{"TO_DATE(CREATED_AT)"}
+				table.Comment = "customer's orders"
+				return table
+			}(),
+			want: `CREATE TRANSIENT TABLE "PUBLIC"."ORDERS" (
+  "ID" NUMBER NOT NULL,
+  "CREATED_AT" TIMESTAMP_NTZ,
+  CONSTRAINT "PK_ORDERS" PRIMARY KEY ("ID")
+) CLUSTER BY (TO_DATE(CREATED_AT)) COMMENT = 'customer''s orders';
+`,
+		},
+		{
+			name:     "drop table",
+			oldTable: newOrdersTable(),
+			want:     "DROP TABLE \"PUBLIC\".\"ORDERS\";\n",
+		},
+		{
+			name:     "add clustering keys",
+			oldTable: newOrdersTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newOrdersTable()
+				table.ClusteringKeys = []string{"ID", "TO_DATE(CREATED_AT)"}
+				return table
+			}(),
+			want: "ALTER TABLE \"PUBLIC\".\"ORDERS\" CLUSTER BY (ID, TO_DATE(CREATED_AT));\n",
+		},
+		{
+			name: "drop clustering keys",
+			oldTable: func() *storepb.TableMetadata {
+				table := newOrdersTable()
+				table.ClusteringKeys = []string{"ID"}
+				return table
+			}(),
+			newTable: newOrdersTable(),
+			want:     "ALTER TABLE \"PUBLIC\".\"ORDERS\" DROP CLUSTERING KEY;\n",
+		},
+		{
+			name:     "alter columns",
+			oldTable: newOrdersTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newOrdersTable()
+				table.Columns[1].Nullable = false
+				table.Columns[1].Comment = "creation time"
+				table.Columns = append(table.Columns, &storepb.ColumnMetadata{Name: "AMOUNT", Type: "NUMBER(10,2)", Default: "0", Nullable: true})
+				return table
+			}(),
+			want: `ALTER TABLE "PUBLIC"."ORDERS" ADD COLUMN "AMOUNT" NUMBER(10,2) DEFAULT 0;
+ALTER TABLE "PUBLIC"."ORDERS" ALTER COLUMN "CREATED_AT" SET NOT NULL;
+ALTER TABLE "PUBLIC"."ORDERS" ALTER COLUMN "CREATED_AT" COMMENT 'creation time';
+`,
+		},
+		{
+			name:     "convert to transient table",
+			oldTable: newOrdersTable(),
+			newTable: func() *storepb.TableMetadata {
+				table := newOrdersTable()
+				table.Transient = true
+				return table
+			}(),
+			want: `CREATE TRANSIENT TABLE "PUBLIC"."_ORDERS_BB_NEW" (
+  "ID" NUMBER NOT NULL,
+  "CREATED_AT" TIMESTAMP_NTZ,
+  CONSTRAINT "PK_ORDERS" PRIMARY KEY ("ID")
+);
+INSERT INTO "PUBLIC"."_ORDERS_BB_NEW" ("ID", "CREATED_AT") SELECT "ID", "CREATED_AT" FROM "PUBLIC"."ORDERS";
+ALTER TABLE "PUBLIC"."ORDERS" SWAP WITH "PUBLIC"."_ORDERS_BB_NEW";
+DROP TABLE "PUBLIC"."_ORDERS_BB_NEW";
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSchema := &storepb.SchemaMetadata{Name: "PUBLIC"}
+			if tc.oldTable != nil {
+				oldSchema.Tables = append(oldSchema.Tables, tc.oldTable)
+			}
+			newSchema := &storepb.SchemaMetadata{Name: "PUBLIC"}
+			if tc.newTable != nil {
+				newSchema.Tables = append(newSchema.Tables, tc.newTable)
+			}
+			oldMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{oldSchema}}, nil, nil, storepb.Engine_SNOWFLAKE, true)
+			newMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{newSchema}}, nil, nil, storepb.Engine_SNOWFLAKE, true)
+
+			diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_SNOWFLAKE, oldMetadata, newMetadata)
+			require.NoError(t, err)
+			got, err := schema.GenerateMigration(storepb.Engine_SNOWFLAKE, diff)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
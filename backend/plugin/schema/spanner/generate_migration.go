@@ -0,0 +1,374 @@
+package spanner
+
+import (
+	"fmt"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+)
+
+func init() {
+	schema.RegisterGenerateMigration(storepb.Engine_SPANNER, generateMigration)
+}
+
+func generateMigration(diff *schema.MetadataDiff) (string, error) {
+	var buf strings.Builder
+
+	// Safe order for migrations:
+	// 1. Drop the change streams and stop the altered change streams from watching the tables.
+	// 2. Drop the views, foreign keys and indexes, because the tables can't be dropped while they are referenced.
+	// 3. Drop the tables, the interleaved child tables before their parents.
+	// 4. Create the tables, the parent tables before their interleaved children, then alter the tables.
+	// 5. Create the indexes, foreign keys and views.
+	// 6. Create and alter the change streams.
+	// Spanner doesn't support changing the primary key or the parent of an interleaved table, such tables are recreated.
+	for _, changeStreamDiff := range diff.ChangeStreamChanges {
+		switch changeStreamDiff.Action {
+		case schema.MetadataDiffActionDrop:
+			_, _ = fmt.Fprintf(&buf, "DROP CHANGE STREAM %s;\n", getObjectName(changeStreamDiff.SchemaName, changeStreamDiff.ChangeStreamName))
+		case schema.MetadataDiffActionAlter:
+			if changeStreamDiff.OldChangeStream.ForClause != changeStreamDiff.NewChangeStream.ForClause && changeStreamDiff.OldChangeStream.ForClause != "" {
+				_, _ = fmt.Fprintf(&buf, "ALTER CHANGE STREAM %s DROP FOR ALL;\n", getObjectName(changeStreamDiff.SchemaName, changeStreamDiff.ChangeStreamName))
+			}
+		default:
+		}
+	}
+
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(&buf, "DROP VIEW %s;\n", getObjectName(viewDiff.SchemaName, viewDiff.ViewName))
+		}
+	}
+
+	var droppedTables, createdTables []*tableToMigrate
+	for _, tableDiff := range diff.TableChanges {
+		switch tableDiff.Action {
+		case schema.MetadataDiffActionDrop:
+			droppedTables = append(droppedTables, &tableToMigrate{schemaName: tableDiff.SchemaName, table: tableDiff.OldTable})
+		case schema.MetadataDiffActionCreate:
+			createdTables = append(createdTables, &tableToMigrate{schemaName: tableDiff.SchemaName, table: tableDiff.NewTable})
+		case schema.MetadataDiffActionAlter:
+			if requireRecreateTable(tableDiff) {
+				droppedTables = append(droppedTables, &tableToMigrate{schemaName: tableDiff.SchemaName, table: tableDiff.OldTable})
+				createdTables = append(createdTables, &tableToMigrate{schemaName: tableDiff.SchemaName, table: tableDiff.NewTable})
+			}
+		default:
+		}
+	}
+
+	for _, t := range droppedTables {
+		for _, fk := range t.table.ForeignKeys {
+			writeDropConstraint(&buf, t.schemaName, t.table.Name, fk.Name)
+		}
+		for _, index := range t.table.Indexes {
+			if !index.Primary {
+				writeDropIndex(&buf, t.schemaName, index.Name)
+			}
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter || requireRecreateTable(tableDiff) {
+			continue
+		}
+		for _, fkDiff := range tableDiff.ForeignKeyChanges {
+			if fkDiff.Action == schema.MetadataDiffActionDrop {
+				writeDropConstraint(&buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.OldForeignKey.Name)
+			}
+		}
+		for _, indexDiff := range tableDiff.IndexChanges {
+			if indexDiff.Action == schema.MetadataDiffActionDrop && !indexDiff.OldIndex.Primary {
+				writeDropIndex(&buf, tableDiff.SchemaName, indexDiff.OldIndex.Name)
+			}
+		}
+	}
+
+	for _, t := range sortTablesByInterleave(droppedTables, false /* parentFirst */) {
+		_, _ = fmt.Fprintf(&buf, "DROP TABLE %s;\n", getObjectName(t.schemaName, t.table.Name))
+	}
+	for _, t := range sortTablesByInterleave(createdTables, true /* parentFirst */) {
+		writeCreateTable(&buf, t.schemaName, t.table)
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action == schema.MetadataDiffActionAlter && !requireRecreateTable(tableDiff) {
+			writeAlterTable(&buf, tableDiff)
+		}
+	}
+
+	for _, t := range createdTables {
+		for _, index := range t.table.Indexes {
+			if !index.Primary {
+				writeCreateIndex(&buf, t.schemaName, t.table.Name, index)
+			}
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter || requireRecreateTable(tableDiff) {
+			continue
+		}
+		for _, indexDiff := range tableDiff.IndexChanges {
+			if indexDiff.Action == schema.MetadataDiffActionCreate && !indexDiff.NewIndex.Primary {
+				writeCreateIndex(&buf, tableDiff.SchemaName, tableDiff.TableName, indexDiff.NewIndex)
+			}
+		}
+	}
+	for _, t := range createdTables {
+		for _, fk := range t.table.ForeignKeys {
+			writeAddForeignKey(&buf, t.schemaName, t.table.Name, fk)
+		}
+	}
+	for _, tableDiff := range diff.TableChanges {
+		if tableDiff.Action != schema.MetadataDiffActionAlter || requireRecreateTable(tableDiff) {
+			continue
+		}
+		for _, fkDiff := range tableDiff.ForeignKeyChanges {
+			if fkDiff.Action == schema.MetadataDiffActionCreate {
+				writeAddForeignKey(&buf, tableDiff.SchemaName, tableDiff.TableName, fkDiff.NewForeignKey)
+			}
+		}
+	}
+
+	for _, viewDiff := range diff.ViewChanges {
+		if viewDiff.Action == schema.MetadataDiffActionCreate || viewDiff.Action == schema.MetadataDiffActionAlter {
+			_, _ = fmt.Fprintf(&buf, "CREATE OR REPLACE VIEW %s SQL SECURITY INVOKER AS %s;\n", getObjectName(viewDiff.SchemaName, viewDiff.ViewName), strings.TrimRight(strings.TrimSpace(viewDiff.NewView.Definition), ";"))
+		}
+	}
+
+	for _, changeStreamDiff := range diff.ChangeStreamChanges {
+		switch changeStreamDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			writeCreateChangeStream(&buf, changeStreamDiff.SchemaName, changeStreamDiff.NewChangeStream)
+		case schema.MetadataDiffActionAlter:
+			writeAlterChangeStream(&buf, changeStreamDiff)
+		default:
+		}
+	}
+
+	return buf.String(), nil
+}
+
+type tableToMigrate struct {
+	schemaName string
+	table      *storepb.TableMetadata
+}
+
+// requireRecreateTable checks if the table changes can't be applied by ALTER TABLE.
+func requireRecreateTable(tableDiff *schema.TableDiff) bool {
+	if tableDiff.OldTable.InterleaveParent != tableDiff.NewTable.InterleaveParent {
+		return true
+	}
+	for _, indexDiff := range tableDiff.IndexChanges {
+		if (indexDiff.OldIndex != nil && indexDiff.OldIndex.Primary) || (indexDiff.NewIndex != nil && indexDiff.NewIndex.Primary) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortTablesByInterleave sorts the tables by the interleaving hierarchy, keeping the original order otherwise.
+func sortTablesByInterleave(tables []*tableToMigrate, parentFirst bool) []*tableToMigrate {
+	pending := make(map[string]bool)
+	for _, t := range tables {
+		pending[getObjectName(t.schemaName, t.table.Name)] = true
+	}
+	var result []*tableToMigrate
+	for len(result) < len(tables) {
+		progress := false
+		for _, t := range tables {
+			name := getObjectName(t.schemaName, t.table.Name)
+			if !pending[name] {
+				continue
+			}
+			ready := true
+			if parentFirst {
+				ready = t.table.InterleaveParent == "" || !pending[getObjectName(t.schemaName, t.table.InterleaveParent)]
+			} else {
+				for _, other := range tables {
+					if pending[getObjectName(other.schemaName, other.table.Name)] && other.schemaName == t.schemaName && other.table.InterleaveParent == t.table.Name {
+						ready = false
+						break
+					}
+				}
+			}
+			if ready {
+				result = append(result, t)
+				delete(pending, name)
+				progress = true
+			}
+		}
+		if !progress {
+			// The interleaving hierarchy has a cycle, which is invalid. Keep the remaining tables in the original order.
+			for _, t := range tables {
+				if pending[getObjectName(t.schemaName, t.table.Name)] {
+					result = append(result, t)
+				}
+			}
+			break
+		}
+	}
+	return result
+}
+
+func writeCreateTable(buf *strings.Builder, schemaName string, table *storepb.TableMetadata) {
+	_, _ = fmt.Fprintf(buf, "CREATE TABLE %s (\n", getObjectName(schemaName, table.Name))
+	var columns []string
+	for _, column := range table.Columns {
+		columns = append(columns, getColumnDefinition(column))
+	}
+	_, _ = fmt.Fprintf(buf, "  %s\n)", strings.Join(columns, ",\n  "))
+	var primaryKeys []string
+	for _, index := range table.Indexes {
+		if index.Primary {
+			primaryKeys = quoteIdentifiers(index.Expressions)
+			break
+		}
+	}
+	_, _ = fmt.Fprintf(buf, " PRIMARY KEY (%s)", strings.Join(primaryKeys, ", "))
+	if table.InterleaveParent != "" {
+		_, _ = fmt.Fprintf(buf, ",\n  INTERLEAVE IN PARENT %s", getObjectName(schemaName, table.InterleaveParent))
+		if table.InterleaveOnDelete != "" {
+			_, _ = fmt.Fprintf(buf, " ON DELETE %s", table.InterleaveOnDelete)
+		}
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeAlterTable(buf *strings.Builder, tableDiff *schema.TableDiff) {
+	table := getObjectName(tableDiff.SchemaName, tableDiff.TableName)
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		if columnDiff.Action == schema.MetadataDiffActionDrop {
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP COLUMN %s;\n", table, quoteIdentifier(columnDiff.OldColumn.Name))
+		}
+	}
+	for _, columnDiff := range tableDiff.ColumnChanges {
+		switch columnDiff.Action {
+		case schema.MetadataDiffActionCreate:
+			_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD COLUMN %s;\n", table, getColumnDefinition(columnDiff.NewColumn))
+		case schema.MetadataDiffActionAlter:
+			oldColumn, newColumn := columnDiff.OldColumn, columnDiff.NewColumn
+			if oldColumn.Type != newColumn.Type || oldColumn.Nullable != newColumn.Nullable || oldColumn.Default != newColumn.Default {
+				_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ALTER COLUMN %s;\n", table, getColumnDefinition(newColumn))
+			}
+		default:
+		}
+	}
+	if tableDiff.OldTable.InterleaveOnDelete != tableDiff.NewTable.InterleaveOnDelete && tableDiff.NewTable.InterleaveOnDelete != "" {
+		_, _ = fmt.Fprintf(buf, "ALTER TABLE %s SET ON DELETE %s;\n", table, tableDiff.NewTable.InterleaveOnDelete)
+	}
+}
+
+func writeCreateIndex(buf *strings.Builder, schemaName, tableName string, index *storepb.IndexMetadata) {
+	_, _ = buf.WriteString("CREATE ")
+	if index.Unique {
+		_, _ = buf.WriteString("UNIQUE ")
+	}
+	_, _ = fmt.Fprintf(buf, "INDEX %s ON %s (%s);\n", getObjectName(schemaName, index.Name), getObjectName(schemaName, tableName), strings.Join(quoteIdentifiers(index.Expressions), ", "))
+}
+
+func writeDropIndex(buf *strings.Builder, schemaName, indexName string) {
+	_, _ = fmt.Fprintf(buf, "DROP INDEX %s;\n", getObjectName(schemaName, indexName))
+}
+
+func writeAddForeignKey(buf *strings.Builder, schemaName, tableName string, fk *storepb.ForeignKeyMetadata) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		getObjectName(schemaName, tableName),
+		quoteIdentifier(fk.Name),
+		strings.Join(quoteIdentifiers(fk.Columns), ", "),
+		getObjectName(fk.ReferencedSchema, fk.ReferencedTable),
+		strings.Join(quoteIdentifiers(fk.ReferencedColumns), ", "),
+	)
+	if fk.OnDelete == "CASCADE" {
+		_, _ = buf.WriteString(" ON DELETE CASCADE")
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeDropConstraint(buf *strings.Builder, schemaName, tableName, constraintName string) {
+	_, _ = fmt.Fprintf(buf, "ALTER TABLE %s DROP CONSTRAINT %s;\n", getObjectName(schemaName, tableName), quoteIdentifier(constraintName))
+}
+
+func writeCreateChangeStream(buf *strings.Builder, schemaName string, changeStream *storepb.ChangeStreamMetadata) {
+	_, _ = fmt.Fprintf(buf, "CREATE CHANGE STREAM %s", getObjectName(schemaName, changeStream.Name))
+	if changeStream.ForClause != "" {
+		_, _ = fmt.Fprintf(buf, " FOR %s", changeStream.ForClause)
+	}
+	if changeStream.Options != "" {
+		_, _ = fmt.Fprintf(buf, " OPTIONS (%s)", changeStream.Options)
+	}
+	_, _ = buf.WriteString(";\n")
+}
+
+func writeAlterChangeStream(buf *strings.Builder, changeStreamDiff *schema.ChangeStreamDiff) {
+	name := getObjectName(changeStreamDiff.SchemaName, changeStreamDiff.ChangeStreamName)
+	oldChangeStream, newChangeStream := changeStreamDiff.OldChangeStream, changeStreamDiff.NewChangeStream
+	// The old FOR clause is dropped before the tables are changed.
+	if oldChangeStream.ForClause != newChangeStream.ForClause && newChangeStream.ForClause != "" {
+		_, _ = fmt.Fprintf(buf, "ALTER CHANGE STREAM %s SET FOR %s;\n", name, newChangeStream.ForClause)
+	}
+	if oldChangeStream.Options != newChangeStream.Options {
+		_, _ = fmt.Fprintf(buf, "ALTER CHANGE STREAM %s SET OPTIONS (%s);\n", name, getResetOptions(oldChangeStream.Options, newChangeStream.Options))
+	}
+}
+
+// getResetOptions returns the new options, and resets the removed options to the default value with NULL.
+func getResetOptions(oldOptions, newOptions string) string {
+	newOptionNames := make(map[string]bool)
+	for _, option := range splitOptions(newOptions) {
+		newOptionNames[getOptionName(option)] = true
+	}
+	options := splitOptions(newOptions)
+	for _, option := range splitOptions(oldOptions) {
+		if name := getOptionName(option); !newOptionNames[name] {
+			options = append(options, fmt.Sprintf("%s = NULL", name))
+		}
+	}
+	return strings.Join(options, ", ")
+}
+
+func splitOptions(options string) []string {
+	var result []string
+	for _, option := range strings.Split(options, ", ") {
+		if strings.TrimSpace(option) != "" {
+			result = append(result, strings.TrimSpace(option))
+		}
+	}
+	return result
+}
+
+func getOptionName(option string) string {
+	name, _, _ := strings.Cut(option, "=")
+	return strings.TrimSpace(name)
+}
+
+func getColumnDefinition(column *storepb.ColumnMetadata) string {
+	definition := fmt.Sprintf("%s %s", quoteIdentifier(column.Name), column.Type)
+	if !column.Nullable {
+		definition += " NOT NULL"
+	}
+	if column.Default != "" {
+		definition += fmt.Sprintf(" DEFAULT (%s)", column.Default)
+	}
+	return definition
+}
+
+func getObjectName(schemaName, name string) string {
+	if schemaName == "" {
+		return quoteIdentifier(name)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schemaName), quoteIdentifier(name))
+}
+
+// quoteIdentifier quotes the identifier with backticks, the quoted identifiers share the escape sequences with
+// the string literals in GoogleSQL.
+func quoteIdentifier(identifier string) string {
+	identifier = strings.ReplaceAll(identifier, "\\", "\\\\")
+	return "`" + strings.ReplaceAll(identifier, "`", "\\`") + "`"
+}
+
+func quoteIdentifiers(identifiers []string) []string {
+	var result []string
+	for _, identifier := range identifiers {
+		result = append(result, quoteIdentifier(identifier))
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestGenerateMigration(t *testing.T) {
+	singers := &storepb.TableMetadata{
+		Name: "Singers",
+		Columns: []*storepb.ColumnMetadata{
+			{Name: "SingerId", Type: "INT64"},
+			{Name: "Name", Type: "STRING(MAX)", Nullable: true},
+		},
+		Indexes: []*storepb.IndexMetadata{
+			{Name: "PRIMARY_KEY", Primary: true, Unique: true, Expressions: []string{"SingerId"}},
+		},
+	}
+	newAlbums := func() *storepb.TableMetadata {
+		return &storepb.TableMetadata{
+			Name: "Albums",
+			Columns: []*storepb.ColumnMetadata{
+				{Name: "SingerId", Type: "INT64"},
+				{Name: "AlbumId", Type: "INT64"},
+				{Name: "Title", Type: "STRING(MAX)", Nullable: true},
+			},
+			Indexes: []*storepb.IndexMetadata{
+				{Name: "PRIMARY_KEY", Primary: true, Unique: true, Expressions: []string{"SingerId", "AlbumId"}},
+				{Name: "AlbumsByTitle", Expressions: []string{"Title"}},
+			},
+			InterleaveParent:   "Singers",
+			InterleaveOnDelete: "CASCADE",
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		oldSchema *storepb.SchemaMetadata
+		newSchema *storepb.SchemaMetadata
+		want      string
+	}{
+		{
+			name:      "create interleaved tables",
+			oldSchema: &storepb.SchemaMetadata{},
+			newSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers, newAlbums()}},
+			want: "CREATE TABLE `Singers` (\n" +
+				"  `SingerId` INT64 NOT NULL,\n" +
+				"  `Name` STRING(MAX)\n" +
+				") PRIMARY KEY (`SingerId`);\n" +
+				"CREATE TABLE `Albums` (\n" +
+				"  `SingerId` INT64 NOT NULL,\n" +
+				"  `AlbumId` INT64 NOT NULL,\n" +
+				"  `Title` STRING(MAX)\n" +
+				") PRIMARY KEY (`SingerId`, `AlbumId`),\n" +
+				"  INTERLEAVE IN PARENT `Singers` ON DELETE CASCADE;\n" +
+				"CREATE INDEX `AlbumsByTitle` ON `Albums` (`Title`);\n",
+		},
+		{
+			name:      "drop interleaved tables",
+			oldSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers, newAlbums()}},
+			newSchema: &storepb.SchemaMetadata{},
+			want: "DROP INDEX `AlbumsByTitle`;\n" +
+				"DROP TABLE `Albums`;\n" +
+				"DROP TABLE `Singers`;\n",
+		},
+		{
+			name:      "change on delete action and add column",
+			oldSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers, newAlbums()}},
+			newSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers, func() *storepb.TableMetadata {
+				table := newAlbums()
+				table.InterleaveOnDelete = "NO ACTION"
+				table.Columns = append(table.Columns, &storepb.ColumnMetadata{Name: "Rating", Type: "INT64", Default: "0"})
+				return table
+			}()}},
+			want: "ALTER TABLE `Albums` ADD COLUMN `Rating` INT64 NOT NULL DEFAULT (0);\n" +
+				"ALTER TABLE `Albums` SET ON DELETE NO ACTION;\n",
+		},
+		{
+			name:      "create change stream",
+			oldSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers}},
+			newSchema: &storepb.SchemaMetadata{
+				Tables: []*storepb.TableMetadata{singers},
+				ChangeStreams: []*storepb.ChangeStreamMetadata{
+					{Name: "SingerStream", ForClause: "Singers(Name)", Options: "retention_period = '7d'"},
+				},
+			},
+			want: "CREATE CHANGE STREAM `SingerStream` FOR Singers(Name) OPTIONS (retention_period = '7d');\n",
+		},
+		{
+			name: "alter change stream",
+			oldSchema: &storepb.SchemaMetadata{
+				Tables: []*storepb.TableMetadata{singers},
+				ChangeStreams: []*storepb.ChangeStreamMetadata{
+					{Name: "SingerStream", ForClause: "Singers(Name)", Options: "retention_period = '7d', value_capture_type = 'NEW_VALUES'"},
+				},
+			},
+			newSchema: &storepb.SchemaMetadata{
+				Tables: []*storepb.TableMetadata{singers},
+				ChangeStreams: []*storepb.ChangeStreamMetadata{
+					{Name: "SingerStream", ForClause: "ALL", Options: "retention_period = '3d'"},
+				},
+			},
+			want: "ALTER CHANGE STREAM `SingerStream` DROP FOR ALL;\n" +
+				"ALTER CHANGE STREAM `SingerStream` SET FOR ALL;\n" +
+				"ALTER CHANGE STREAM `SingerStream` SET OPTIONS (retention_period = '3d', value_capture_type = NULL);\n",
+		},
+		{
+			name: "drop change stream before table",
+			oldSchema: &storepb.SchemaMetadata{
+				Tables: []*storepb.TableMetadata{singers},
+				ChangeStreams: []*storepb.ChangeStreamMetadata{
+					{Name: "SingerStream", ForClause: "Singers"},
+				},
+			},
+			newSchema: &storepb.SchemaMetadata{},
+			want: "DROP CHANGE STREAM `SingerStream`;\n" +
+				"DROP TABLE `Singers`;\n",
+		},
+		{
+			name:      "escape the identifiers",
+			oldSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers, newAlbums()}},
+			newSchema: &storepb.SchemaMetadata{Tables: []*storepb.TableMetadata{singers, func() *storepb.TableMetadata {
+				table := newAlbums()
+				table.Columns = table.Columns[:2]
+				table.Indexes = table.Indexes[:1]
+				table.ForeignKeys = []*storepb.ForeignKeyMetadata{
+					{Name: "FK_`Singer`\\Id", Columns: []string{"SingerId"}, ReferencedTable: "Singers", ReferencedColumns: []string{"SingerId"}},
+				}
+				return table
+			}()}},
+			want: "DROP INDEX `AlbumsByTitle`;\n" +
+				"ALTER TABLE `Albums` DROP COLUMN `Title`;\n" +
+				"ALTER TABLE `Albums` ADD CONSTRAINT `FK_\\`Singer\\`\\\\Id` FOREIGN KEY (`SingerId`) REFERENCES `Singers` (`SingerId`);\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{tc.oldSchema}}, nil, nil, storepb.Engine_SPANNER, true)
+			newMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Schemas: []*storepb.SchemaMetadata{tc.newSchema}}, nil, nil, storepb.Engine_SPANNER, true)
+
+			diff, err := schema.GetDatabaseSchemaDiff(storepb.Engine_SPANNER, oldMetadata, newMetadata)
+			require.NoError(t, err)
+			got, err := schema.GenerateMigration(storepb.Engine_SPANNER, diff)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
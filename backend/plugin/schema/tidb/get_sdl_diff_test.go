@@ -0,0 +1,55 @@
+package tidb
+
+import (
+	"testing"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/schema/schematest"
+)
+
+func TestGetSDLDiff(t *testing.T) {
+	const baseSDL = `CREATE TABLE users (
+  id BIGINT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`
+	testCases := []schematest.SDLDiffTestCase{
+		{
+			Name:        "no changes",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL:  baseSDL,
+		},
+		{
+			Name:        "rename table",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE accounts (
+  id BIGINT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`,
+			Want: "RENAME TABLE `users` TO `accounts`;\n\n\n",
+		},
+		{
+			Name:        "rename column",
+			Schema:      baseSDL,
+			PreviousSDL: baseSDL,
+			CurrentSDL: `CREATE TABLE users (
+  id BIGINT NOT NULL,
+  full_name VARCHAR(64) NOT NULL,
+  PRIMARY KEY (id)
+);
+`,
+			Want: "ALTER TABLE `users` CHANGE COLUMN `name` `full_name` varchar(64) NOT NULL;\n\n",
+		},
+	}
+
+	schematest.RunSDLDiffTests(t, schematest.SDLDiffEngine{
+		Engine:                storepb.Engine_TIDB,
+		GetDatabaseMetadata:   GetDatabaseMetadata,
+		IsObjectCaseSensitive: false,
+	}, testCases)
+}
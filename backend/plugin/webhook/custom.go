@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// CustomEventVersion is the version of the CustomEvent envelope.
+// Fields may be added within a version, but are never renamed or removed.
+const CustomEventVersion = "v1"
+
+const (
+	// CustomEventHeader is the header carrying the event type.
+	CustomEventHeader = "X-Bytebase-Event"
+	// CustomDeliveryHeader is the header carrying the delivery ID.
+	CustomDeliveryHeader = "X-Bytebase-Delivery"
+	// CustomTimestampHeader is the header carrying the unix timestamp used in the signature.
+	CustomTimestampHeader = "X-Bytebase-Timestamp"
+	// CustomSignatureHeader is the header carrying the signature of the request.
+	// The value is "sha256=" followed by the hex encoded HMAC-SHA256 of "{timestamp}.{body}".
+	CustomSignatureHeader = "X-Bytebase-Signature"
+)
+
+var (
+	headerNameRegexp = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+	// reservedHeaders are set by Bytebase and cannot be overridden by custom headers.
+	reservedHeaders = []string{"Content-Type", "Content-Length", "Host"}
+)
+
+// CustomEvent is the JSON envelope posted to CUSTOM webhooks.
+type CustomEvent struct {
+	Version      string                 `json:"version"`
+	Type         string                 `json:"type"`
+	Level        Level                  `json:"level"`
+	Title        string                 `json:"title"`
+	Description  string                 `json:"description,omitempty"`
+	Link         string                 `json:"link,omitempty"`
+	CreateTime   string                 `json:"createTime"`
+	Actor        *CustomEventUser       `json:"actor"`
+	Project      *CustomEventProject    `json:"project,omitempty"`
+	Issue        *CustomEventIssue      `json:"issue,omitempty"`
+	Rollout      *CustomEventRollout    `json:"rollout,omitempty"`
+	Stage        *CustomEventStage      `json:"stage,omitempty"`
+	TaskResult   *CustomEventTaskResult `json:"taskResult,omitempty"`
+	MentionUsers []*CustomEventUser     `json:"mentionUsers,omitempty"`
+}
+
+// CustomEventUser is the user in the CustomEvent envelope.
+type CustomEventUser struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// CustomEventProject is the project in the CustomEvent envelope.
+type CustomEventProject struct {
+	// Format: projects/{project}
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// CustomEventIssue is the issue in the CustomEvent envelope.
+type CustomEventIssue struct {
+	UID         int              `json:"uid"`
+	Title       string           `json:"title"`
+	Status      string           `json:"status"`
+	Type        string           `json:"type"`
+	Description string           `json:"description,omitempty"`
+	Creator     *CustomEventUser `json:"creator,omitempty"`
+}
+
+// CustomEventRollout is the rollout in the CustomEvent envelope.
+type CustomEventRollout struct {
+	UID   int    `json:"uid"`
+	Title string `json:"title,omitempty"`
+}
+
+// CustomEventStage is the stage in the CustomEvent envelope.
+type CustomEventStage struct {
+	Title string `json:"title"`
+}
+
+// CustomEventTaskResult is the task result in the CustomEvent envelope.
+type CustomEventTaskResult struct {
+	Title         string `json:"title,omitempty"`
+	Status        string `json:"status"`
+	Detail        string `json:"detail,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+}
+
+func init() {
+	Register(storepb.ProjectWebhook_CUSTOM, &CustomReceiver{})
+}
+
+// CustomReceiver is the receiver for CUSTOM webhooks.
+type CustomReceiver struct {
+}
+
+func (*CustomReceiver) Post(context Context) error {
+	body, err := json.Marshal(NewCustomEvent(context))
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+	}
+	return PostCustomEvent(context, body)
+}
+
+// NewCustomEvent builds the event envelope from the webhook context.
+func NewCustomEvent(context Context) *CustomEvent {
+	event := &CustomEvent{
+		Version:     CustomEventVersion,
+		Type:        context.EventType,
+		Level:       context.Level,
+		Title:       context.Title,
+		Description: context.Description,
+		Link:        context.Link,
+		CreateTime:  time.Unix(context.CreatedTS, 0).UTC().Format(time.RFC3339),
+		Actor: &CustomEventUser{
+			Name:  context.ActorName,
+			Email: context.ActorEmail,
+		},
+	}
+	if p := context.Project; p != nil {
+		event.Project = &CustomEventProject{
+			Name:  p.Name,
+			Title: p.Title,
+		}
+	}
+	if i := context.Issue; i != nil {
+		event.Issue = &CustomEventIssue{
+			UID:         i.ID,
+			Title:       i.Name,
+			Status:      i.Status,
+			Type:        i.Type,
+			Description: i.Description,
+			Creator:     newCustomEventUser(i.Creator),
+		}
+	}
+	if r := context.Rollout; r != nil {
+		event.Rollout = &CustomEventRollout{
+			UID:   r.UID,
+			Title: r.Title,
+		}
+	}
+	if s := context.Stage; s != nil {
+		event.Stage = &CustomEventStage{
+			Title: s.Name,
+		}
+	}
+	if t := context.TaskResult; t != nil {
+		event.TaskResult = &CustomEventTaskResult{
+			Title:         t.Name,
+			Status:        t.Status,
+			Detail:        t.Detail,
+			SkippedReason: t.SkippedReason,
+		}
+	}
+	for _, u := range context.MentionEndUsers {
+		event.MentionUsers = append(event.MentionUsers, newCustomEventUser(u))
+	}
+	return event
+}
+
+func newCustomEventUser(u *store.UserMessage) *CustomEventUser {
+	if u == nil {
+		return nil
+	}
+	return &CustomEventUser{
+		Name:  u.Name,
+		Email: u.Email,
+	}
+}
+
+// PostCustomEvent posts the marshaled event envelope to the CUSTOM webhook in context.
+// The body is posted as-is, so recorded deliveries can be replayed with a fresh signature.
+func PostCustomEvent(context Context, body []byte) error {
+	req, err := http.NewRequest("POST", context.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	for name, value := range context.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(CustomEventHeader, context.EventType)
+	if context.DeliveryID != 0 {
+		req.Header.Set(CustomDeliveryHeader, strconv.FormatInt(context.DeliveryID, 10))
+	}
+	if context.SigningSecret != "" {
+		timestamp := time.Now().Unix()
+		req.Header.Set(CustomTimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(CustomSignatureHeader, SignCustomEvent(context.SigningSecret, timestamp, body))
+	}
+
+	resp, err := do(customClient, context, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+	}
+	return nil
+}
+
+// SignCustomEvent returns the value of the signature header for the body sent at timestamp.
+func SignCustomEvent(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateCustomHeaders validates the custom headers of a CUSTOM webhook.
+func ValidateCustomHeaders(headers map[string]string) error {
+	for name, value := range headers {
+		if !headerNameRegexp.MatchString(name) {
+			return errors.Errorf("invalid header name %q", name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return errors.Errorf("invalid value for header %q", name)
+		}
+		if strings.HasPrefix(strings.ToLower(name), "x-bytebase-") {
+			return errors.Errorf("header %q is reserved", name)
+		}
+		for _, reserved := range reservedHeaders {
+			if strings.EqualFold(name, reserved) {
+				return errors.Errorf("header %q is reserved", name)
+			}
+		}
+	}
+	return nil
+}
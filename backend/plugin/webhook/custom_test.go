@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// allowLoopback allows the CUSTOM webhooks to post to the test servers on the loopback addresses.
+func allowLoopback(t *testing.T) {
+	require.NoError(t, SetAllowedPrivateNetworks([]string{"127.0.0.0/8", "::1/128"}))
+	t.Cleanup(func() {
+		allowedPrivateNetworks = nil
+	})
+}
+
+func TestCustomReceiverPost(t *testing.T) {
+	a := require.New(t)
+	allowLoopback(t)
+
+	var gotHeader http.Header
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		body, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		gotBody = body
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	context := Context{
+		URL:        server.URL,
+		Level:      WebhookSuccess,
+		EventType:  "ISSUE_CREATE",
+		Title:      "Issue created",
+		Link:       "https://bytebase.example.com/projects/p1/issues/i-101",
+		ActorName:  "Alice",
+		ActorEmail: "alice@example.com",
+		CreatedTS:  1700000000,
+		Project: &Project{
+			Name:  "projects/p1",
+			Title: "Project 1",
+		},
+		Issue: &Issue{
+			ID:      101,
+			Name:    "Add index",
+			Status:  "OPEN",
+			Type:    "DATABASE_CHANGE",
+			Creator: &store.UserMessage{Name: "Bob", Email: "bob@example.com"},
+		},
+		SigningSecret: "secret",
+		Headers:       map[string]string{"Authorization": "Bearer token"},
+		DeliveryID:    42,
+		Result:        &Result{},
+	}
+	a.NoError((&CustomReceiver{}).Post(context))
+	a.Equal(http.StatusAccepted, context.Result.StatusCode)
+
+	a.Equal("application/json", gotHeader.Get("Content-Type"))
+	a.Equal("Bearer token", gotHeader.Get("Authorization"))
+	a.Equal("ISSUE_CREATE", gotHeader.Get(CustomEventHeader))
+	a.Equal("42", gotHeader.Get(CustomDeliveryHeader))
+	timestamp, err := strconv.ParseInt(gotHeader.Get(CustomTimestampHeader), 10, 64)
+	a.NoError(err)
+	a.Equal(SignCustomEvent("secret", timestamp, gotBody), gotHeader.Get(CustomSignatureHeader))
+
+	var event CustomEvent
+	a.NoError(json.Unmarshal(gotBody, &event))
+	a.Equal(CustomEventVersion, event.Version)
+	a.Equal("ISSUE_CREATE", event.Type)
+	a.Equal(WebhookSuccess, event.Level)
+	a.Equal("2023-11-14T22:13:20Z", event.CreateTime)
+	a.Equal(&CustomEventUser{Name: "Alice", Email: "alice@example.com"}, event.Actor)
+	a.Equal(&CustomEventProject{Name: "projects/p1", Title: "Project 1"}, event.Project)
+	a.Equal(101, event.Issue.UID)
+	a.Equal(&CustomEventUser{Name: "Bob", Email: "bob@example.com"}, event.Issue.Creator)
+	a.Nil(event.Rollout)
+}
+
+func TestCustomReceiverPostError(t *testing.T) {
+	a := require.New(t)
+	allowLoopback(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	result := &Result{}
+	err := (&CustomReceiver{}).Post(Context{URL: server.URL, EventType: "ISSUE_CREATE", Result: result})
+	a.ErrorContains(err, "status code: 500")
+	a.Equal(http.StatusInternalServerError, result.StatusCode)
+}
+
+func TestSignCustomEvent(t *testing.T) {
+	a := require.New(t)
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	a.Equal("sha256=b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163", SignCustomEvent("secret", 1700000000, []byte("{}")))
+}
+
+func TestValidateCustomHeaders(t *testing.T) {
+	tests := []struct {
+		headers map[string]string
+		wantErr bool
+	}{
+		{headers: map[string]string{"Authorization": "Bearer token", "X-Team": "dba"}},
+		{headers: map[string]string{"Bad Header": "v"}, wantErr: true},
+		{headers: map[string]string{"X-Injected": "a\r\nHost: evil.com"}, wantErr: true},
+		{headers: map[string]string{"content-type": "text/plain"}, wantErr: true},
+		{headers: map[string]string{"X-Bytebase-Signature": "forged"}, wantErr: true},
+	}
+	for _, tc := range tests {
+		err := ValidateCustomHeaders(tc.headers)
+		if tc.wantErr {
+			require.Error(t, err, tc.headers)
+		} else {
+			require.NoError(t, err, tc.headers)
+		}
+	}
+}
+
+func TestCustomReceiverPostLoopback(t *testing.T) {
+	a := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The address is checked on dialing, even if the URL has passed the validation.
+	err := (&CustomReceiver{}).Post(Context{URL: server.URL, EventType: "ISSUE_CREATE", Result: &Result{}})
+	a.ErrorContains(err, "is private, loopback or link-local")
+}
+
+func TestCustomClientIgnoresProxy(t *testing.T) {
+	// The dial check must see the address of the webhook endpoint instead of the proxy.
+	require.Nil(t, customClient.Transport.(*http.Transport).Proxy)
+}
@@ -196,10 +196,7 @@ func sendMessage(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
-	resp, err := client.Do(req)
+	resp, err := webhook.Do(context, req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
@@ -68,6 +68,16 @@ type sendMessageRequest struct {
 	Content   string `json:"content"`
 }
 
+type getUserResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		User struct {
+			Email string `json:"email"`
+		} `json:"user"`
+	} `json:"data"`
+}
+
 type generalResponse struct {
 	Code int    `json:"code"`
 	Msg  string `json:"msg"`
@@ -269,6 +279,28 @@ func (p *provider) sendMessage(ctx context.Context, userID string, messageCard *
 	return nil
 }
 
+// getUserEmail gets the email of the user by the open id.
+// https://open.larksuite.com/document/server-docs/contact-v3/user/get
+func (p *provider) getUserEmail(ctx context.Context, openID string) (string, error) {
+	url := fmt.Sprintf("https://open.larksuite.com/open-apis/contact/v3/users/%s?user_id_type=open_id", openID)
+	b, err := p.do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get user")
+	}
+
+	var response getUserResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal response")
+	}
+	if response.Code != 0 {
+		return "", errors.Errorf("failed to get user, code %d, msg %q", response.Code, response.Msg)
+	}
+	if response.Data.User.Email == "" {
+		return "", errors.Errorf("the email of user %s is not visible", openID)
+	}
+	return response.Data.User.Email, nil
+}
+
 const maxRetries = 3
 
 func (p *provider) do(ctx context.Context, method, url string, data []byte) ([]byte, error) {
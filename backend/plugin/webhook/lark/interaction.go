@@ -0,0 +1,217 @@
+package lark
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	// ActionApprove is the name of the approve button in the approval card.
+	ActionApprove = "approve_issue"
+	// ActionReject is the name of the reject button in the approval card.
+	ActionReject = "reject_issue"
+
+	commentInputName = "comment"
+
+	// signatureMaxAge is the max age of the callbacks to prevent the replay attacks.
+	signatureMaxAge = 5 * time.Minute
+)
+
+// WebhookCardText is the API message for Lark webhook card plain text.
+type WebhookCardText struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+// WebhookCardFormElement is the API message for Lark webhook card input and button in the form.
+type WebhookCardFormElement struct {
+	Tag         string           `json:"tag"`
+	Name        string           `json:"name"`
+	Text        *WebhookCardText `json:"text,omitempty"`
+	Placeholder *WebhookCardText `json:"placeholder,omitempty"`
+	InputType   string           `json:"input_type,omitempty"`
+	Type        string           `json:"type,omitempty"`
+	ActionType  string           `json:"action_type,omitempty"`
+	Value       *approvalValue   `json:"value,omitempty"`
+}
+
+// WebhookCardForm is the API message for Lark webhook card form container.
+type WebhookCardForm struct {
+	Tag      string                   `json:"tag"`
+	Name     string                   `json:"name"`
+	Elements []WebhookCardFormElement `json:"elements"`
+}
+
+// approvalValue is the value of the approval buttons posted back in the callback.
+type approvalValue struct {
+	Issue string `json:"issue"`
+	Title string `json:"title"`
+	Link  string `json:"link"`
+}
+
+// getApprovalForm returns the form of the comment input and the approve/reject buttons.
+func getApprovalForm(value *approvalValue) WebhookCardForm {
+	return WebhookCardForm{
+		Tag:  "form",
+		Name: "approval",
+		Elements: []WebhookCardFormElement{
+			{
+				Tag:  "input",
+				Name: commentInputName,
+				Placeholder: &WebhookCardText{
+					Tag:     "plain_text",
+					Content: "Comment",
+				},
+				InputType: "multiline_text",
+			},
+			{
+				Tag:  "button",
+				Name: ActionApprove,
+				Text: &WebhookCardText{
+					Tag:     "plain_text",
+					Content: "Approve",
+				},
+				Type:       "primary",
+				ActionType: "form_submit",
+				Value:      value,
+			},
+			{
+				Tag:  "button",
+				Name: ActionReject,
+				Text: &WebhookCardText{
+					Tag:     "plain_text",
+					Content: "Reject",
+				},
+				Type:       "danger",
+				ActionType: "form_submit",
+				Value:      value,
+			},
+		},
+	}
+}
+
+// callbackRequest is the message card callback.
+// https://open.larksuite.com/document/uAjLw4CM/ukzMukzMukzM/feishu-cards/card-callback-communication
+type callbackRequest struct {
+	// Type and Challenge are only set for the URL verification.
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Token     string `json:"token"`
+	OpenID    string `json:"open_id"`
+	Action    struct {
+		Name      string            `json:"name"`
+		Value     approvalValue     `json:"value"`
+		FormValue map[string]string `json:"form_value"`
+	} `json:"action"`
+}
+
+// Interaction is the approval action taken in the interactive Lark card.
+type Interaction struct {
+	// Challenge is set if the callback is the URL verification, which should be echoed back.
+	Challenge string
+	// IssueName is the name of the issue to approve or reject.
+	IssueName string
+	Approve   bool
+	Comment   string
+	// OpenID is the Lark open ID of the approver.
+	OpenID string
+
+	title string
+	link  string
+}
+
+// ParseInteraction verifies the message card callback from Lark and parses the approval action.
+func ParseInteraction(setting *storepb.AppIMSetting, header http.Header, body []byte, now time.Time) (*Interaction, error) {
+	token := getLarkConfig(setting).GetVerificationToken()
+	if token == "" {
+		return nil, errors.New("the verification token of the lark app is not configured")
+	}
+	var request callbackRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal body")
+	}
+	if subtle.ConstantTimeCompare([]byte(request.Token), []byte(token)) != 1 {
+		return nil, errors.New("invalid verification token")
+	}
+	if request.Type == "url_verification" {
+		return &Interaction{Challenge: request.Challenge}, nil
+	}
+	if err := verifySignature(token, header.Get("X-Lark-Request-Timestamp"), header.Get("X-Lark-Request-Nonce"), header.Get("X-Lark-Signature"), body, now); err != nil {
+		return nil, err
+	}
+	if request.Action.Name != ActionApprove && request.Action.Name != ActionReject {
+		return nil, errors.Errorf("unsupported action %q", request.Action.Name)
+	}
+	return &Interaction{
+		IssueName: request.Action.Value.Issue,
+		Approve:   request.Action.Name == ActionApprove,
+		Comment:   request.Action.FormValue[commentInputName],
+		OpenID:    request.OpenID,
+		title:     request.Action.Value.Title,
+		link:      request.Action.Value.Link,
+	}, nil
+}
+
+// verifySignature verifies the signature of the message card callback signed by the verification token.
+func verifySignature(token, timestamp, nonce, signature string, body []byte, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > signatureMaxAge || age < -signatureMaxAge {
+		return errors.Errorf("request timestamp %q is expired", timestamp)
+	}
+	h := sha1.New()
+	_, _ = h.Write([]byte(timestamp + nonce + token))
+	_, _ = h.Write(body)
+	expected := hex.EncodeToString(h.Sum(nil))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) != 1 {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+// GetUserEmail gets the email of the Lark user by the open ID.
+func GetUserEmail(ctx context.Context, setting *storepb.AppIMSetting, openID string) (string, error) {
+	lark := getLarkConfig(setting)
+	if lark == nil {
+		return "", errors.New("the lark app is not configured")
+	}
+	return newProvider(lark.AppId, lark.AppSecret).getUserEmail(ctx, openID)
+}
+
+// GetUpdatedCard returns the card replacing the approval card with the outcome.
+// The card is returned in the response of the callback to update the message in place.
+func GetUpdatedCard(interaction *Interaction, outcome string) *WebhookCard {
+	return &WebhookCard{
+		Config: WebhookCardConfig{
+			WideScreenMode: true,
+			EnableForward:  true,
+		},
+		Header: WebhookCardHeader{
+			Title: WebhookCardHeaderTitle{
+				Content: interaction.title,
+				Tag:     "plain_text",
+			},
+		},
+		I18nElements: WebhookCardI18nElements{
+			English: []any{
+				WebhookMarkdownSection{
+					Tag:     "markdown",
+					Content: fmt.Sprintf("%s\n[View in Bytebase](%s)", outcome, interaction.link),
+				},
+			},
+		},
+	}
+}
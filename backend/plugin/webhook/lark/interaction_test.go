@@ -0,0 +1,71 @@
+package lark
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestParseInteraction(t *testing.T) {
+	a := require.New(t)
+	setting := &storepb.AppIMSetting{
+		Settings: []*storepb.AppIMSetting_IMSetting{
+			{
+				Type: storepb.ProjectWebhook_LARK,
+				Payload: &storepb.AppIMSetting_IMSetting_Lark{
+					Lark: &storepb.AppIMSetting_Lark{AppId: "app", AppSecret: "secret", VerificationToken: "token"},
+				},
+			},
+		},
+	}
+	now := time.Unix(1700000000, 0)
+	sign := func(token string, body []byte, ts time.Time) http.Header {
+		timestamp := strconv.FormatInt(ts.Unix(), 10)
+		h := sha1.New()
+		_, _ = h.Write([]byte(timestamp + "nonce" + token))
+		_, _ = h.Write(body)
+		header := http.Header{}
+		header.Set("X-Lark-Request-Timestamp", timestamp)
+		header.Set("X-Lark-Request-Nonce", "nonce")
+		header.Set("X-Lark-Signature", hex.EncodeToString(h.Sum(nil)))
+		return header
+	}
+
+	// The URL verification.
+	body := []byte(`{"type": "url_verification", "challenge": "c1", "token": "token"}`)
+	interaction, err := ParseInteraction(setting, http.Header{}, body, now)
+	a.NoError(err)
+	a.Equal("c1", interaction.Challenge)
+
+	body = []byte(`{
+		"open_id": "ou_1",
+		"token": "token",
+		"action": {
+			"name": "approve_issue",
+			"value": {"issue": "projects/p1/issues/101", "title": "Issue approval needed", "link": "https://bytebase.example.com"},
+			"form_value": {"comment": "LGTM"}
+		}
+	}`)
+	interaction, err = ParseInteraction(setting, sign("token", body, now), body, now)
+	a.NoError(err)
+	a.Equal("projects/p1/issues/101", interaction.IssueName)
+	a.True(interaction.Approve)
+	a.Equal("LGTM", interaction.Comment)
+	a.Equal("ou_1", interaction.OpenID)
+	a.Equal("Issue approval needed", GetUpdatedCard(interaction, "Approved").Header.Title.Content)
+
+	// The signature is signed by another token.
+	_, err = ParseInteraction(setting, sign("other", body, now), body, now)
+	a.Error(err)
+
+	// The callback is replayed after the max age.
+	_, err = ParseInteraction(setting, sign("token", body, now.Add(-10*time.Minute)), body, now)
+	a.Error(err)
+}
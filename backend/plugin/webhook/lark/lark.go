@@ -79,8 +79,9 @@ type WebhookMarkdownSection struct {
 }
 
 // WebhookCardI18nElements is the API message for Lark webhook card i18n content.
+// The elements are either WebhookMarkdownSection or WebhookCardForm.
 type WebhookCardI18nElements struct {
-	English []WebhookMarkdownSection `json:"en_us"`
+	English []any `json:"en_us"`
 }
 
 // WebhookCardHeaderTitle is the API message for Lark webhook card header title.
@@ -134,6 +135,16 @@ func postDirectMessage(webhookCtx webhook.Context) bool {
 
 	ctx := context.Background()
 
+	card := getMessageCard(webhookCtx)
+	// The approvers can approve or reject the issue in the card if the message card request URL of the Lark app is configured.
+	if issueName := webhookCtx.GetApprovalIssueName(); issueName != "" && lark.VerificationToken != "" {
+		card.I18nElements.English = append(card.I18nElements.English, getApprovalForm(&approvalValue{
+			Issue: issueName,
+			Title: webhookCtx.Title,
+			Link:  webhookCtx.Link,
+		}))
+	}
+
 	sent := map[string]bool{}
 
 	if err := common.Retry(ctx, func() error {
@@ -160,7 +171,7 @@ func postDirectMessage(webhookCtx webhook.Context) bool {
 			if !ok {
 				continue
 			}
-			err := p.sendMessage(ctx, id, getMessageCard(webhookCtx))
+			err := p.sendMessage(ctx, id, card)
 			if err != nil {
 				err = errors.Wrapf(err, "failed to send message")
 				multierr.AppendInto(&errs, err)
@@ -192,10 +203,7 @@ func postMessage(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
-	resp, err := client.Do(req)
+	resp, err := webhook.Do(context, req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
@@ -247,8 +255,8 @@ func getMessageCard(context webhook.Context) *WebhookCard {
 			},
 		},
 		I18nElements: WebhookCardI18nElements{
-			English: []WebhookMarkdownSection{
-				{
+			English: []any{
+				WebhookMarkdownSection{
 					Tag:     "markdown",
 					Content: markdownBuf.String(),
 				},
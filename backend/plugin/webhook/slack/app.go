@@ -56,6 +56,16 @@ type chatPostMessageResponse struct {
 	Error string `json:"error"`
 }
 
+type usersInfoResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	User  struct {
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
 // https://api.slack.com/methods/auth.test
 func (p *provider) authTest(ctx context.Context) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://slack.com/api/auth.test", nil)
@@ -199,6 +209,10 @@ func (p *provider) openConversation(ctx context.Context, userID string) (string,
 // https://api.slack.com/methods/chat.postMessage
 func (p *provider) chatPostMessage(ctx context.Context, channelID string, webhookContext webhook.Context) error {
 	blocks := GetBlocks(webhookContext)
+	// The approvers can approve or reject the issue in the message if the interactivity of the Slack app is configured.
+	if issueName := webhookContext.GetApprovalIssueName(); issueName != "" && getSlackConfig(webhookContext.IMSetting).GetSigningSecret() != "" {
+		blocks = append(blocks, getApprovalBlocks(issueName)...)
+	}
 	blocksJSON, err := json.Marshal(blocks)
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal blocks")
@@ -239,3 +253,42 @@ func (p *provider) chatPostMessage(ctx context.Context, channelID string, webhoo
 
 	return nil
 }
+
+// https://api.slack.com/methods/users.info
+func (p *provider) getUserEmail(ctx context.Context, userID string) (string, error) {
+	q := url.Values{}
+	q.Set("user", userID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://slack.com/api/users.info", nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to new request")
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Add("Authorization", "Bearer "+p.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to send GET request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read body")
+	}
+	var res usersInfoResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+	if !res.OK {
+		return "", errors.Errorf("failed to get user, error: %v", res.Error)
+	}
+	if res.User.Profile.Email == "" {
+		return "", errors.Errorf("the email of user %s is not visible, the users:read.email scope is required", userID)
+	}
+	return res.User.Profile.Email, nil
+}
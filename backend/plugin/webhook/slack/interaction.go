@@ -0,0 +1,227 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const (
+	// ActionApprove is the action ID of the approve button in the approval message.
+	ActionApprove = "approve_issue"
+	// ActionReject is the action ID of the reject button in the approval message.
+	ActionReject = "reject_issue"
+
+	commentBlockID  = "approval_comment"
+	commentActionID = "comment"
+	actionsBlockID  = "approval_actions"
+
+	// signatureMaxAge is the max age of the interactive requests to prevent the replay attacks.
+	signatureMaxAge = 5 * time.Minute
+)
+
+// getApprovalBlocks returns the comment input and the approve/reject buttons of the approval message.
+func getApprovalBlocks(issueName string) []Block {
+	return []Block{
+		{
+			Type:    "input",
+			BlockID: commentBlockID,
+			Label: &BlockMarkdown{
+				Type: "plain_text",
+				Text: "Comment",
+			},
+			Element: &InputElement{
+				Type:      "plain_text_input",
+				ActionID:  commentActionID,
+				Multiline: true,
+			},
+			Optional: true,
+		},
+		{
+			Type:    "actions",
+			BlockID: actionsBlockID,
+			ElementList: []Element{
+				{
+					Type: "button",
+					Button: ElementButton{
+						Type: "plain_text",
+						Text: "Approve",
+					},
+					Style:    "primary",
+					ActionID: ActionApprove,
+					Value:    issueName,
+				},
+				{
+					Type: "button",
+					Button: ElementButton{
+						Type: "plain_text",
+						Text: "Reject",
+					},
+					Style:    "danger",
+					ActionID: ActionReject,
+					Value:    issueName,
+				},
+			},
+		},
+	}
+}
+
+// interactionPayload is the payload of the block actions.
+// https://api.slack.com/reference/interaction-payloads/block-actions
+type interactionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	ResponseURL string `json:"response_url"`
+	Actions     []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	State struct {
+		Values map[string]map[string]struct {
+			Value string `json:"value"`
+		} `json:"values"`
+	} `json:"state"`
+	Message struct {
+		Blocks []json.RawMessage `json:"blocks"`
+	} `json:"message"`
+}
+
+// Interaction is the approval action taken in the interactive Slack message.
+type Interaction struct {
+	// IssueName is the name of the issue to approve or reject.
+	IssueName string
+	Approve   bool
+	Comment   string
+	// UserID is the Slack user ID of the approver.
+	UserID string
+
+	responseURL string
+	blocks      []json.RawMessage
+}
+
+// ParseInteraction verifies the signature of the interactive request from Slack and parses the approval action.
+func ParseInteraction(setting *storepb.AppIMSetting, header http.Header, body []byte, now time.Time) (*Interaction, error) {
+	signingSecret := getSlackConfig(setting).GetSigningSecret()
+	if signingSecret == "" {
+		return nil, errors.New("the signing secret of the slack app is not configured")
+	}
+	if err := verifySignature(signingSecret, header.Get("X-Slack-Request-Timestamp"), header.Get("X-Slack-Signature"), body, now); err != nil {
+		return nil, err
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse body")
+	}
+	var payload interactionPayload
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	if payload.Type != "block_actions" {
+		return nil, errors.Errorf("unsupported interaction type %q", payload.Type)
+	}
+	for _, action := range payload.Actions {
+		if action.ActionID != ActionApprove && action.ActionID != ActionReject {
+			continue
+		}
+		return &Interaction{
+			IssueName:   action.Value,
+			Approve:     action.ActionID == ActionApprove,
+			Comment:     payload.State.Values[commentBlockID][commentActionID].Value,
+			UserID:      payload.User.ID,
+			responseURL: payload.ResponseURL,
+			blocks:      payload.Message.Blocks,
+		}, nil
+	}
+	return nil, errors.New("approval action not found")
+}
+
+// verifySignature verifies the request signature signed by the signing secret.
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func verifySignature(signingSecret, timestamp, signature string, body []byte, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > signatureMaxAge || age < -signatureMaxAge {
+		return errors.Errorf("request timestamp %q is expired", timestamp)
+	}
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	_, _ = mac.Write([]byte("v0:" + timestamp + ":"))
+	_, _ = mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("invalid request signature")
+	}
+	return nil
+}
+
+// GetUserEmail gets the email of the Slack user.
+func GetUserEmail(ctx context.Context, setting *storepb.AppIMSetting, userID string) (string, error) {
+	token := getSlackToken(setting)
+	if token == "" {
+		return "", errors.New("the token of the slack app is not configured")
+	}
+	return newProvider(token).getUserEmail(ctx, userID)
+}
+
+// UpdateMessage replaces the approval actions of the interactive message with the outcome.
+// https://api.slack.com/interactivity/handling#updating_message_response
+func UpdateMessage(ctx context.Context, interaction *Interaction, outcome string) error {
+	var blocks []any
+	for _, b := range interaction.blocks {
+		var block struct {
+			BlockID string `json:"block_id"`
+		}
+		if err := json.Unmarshal(b, &block); err == nil && (block.BlockID == commentBlockID || block.BlockID == actionsBlockID) {
+			continue
+		}
+		blocks = append(blocks, b)
+	}
+	blocks = append(blocks, Block{
+		Type: "section",
+		Text: &BlockMarkdown{
+			Type: "mrkdwn",
+			Text: outcome,
+		},
+	})
+	body, err := json.Marshal(map[string]any{
+		"replace_original": true,
+		"text":             outcome,
+		"blocks":           blocks,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal message")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, interaction.responseURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("received non-200 status code %d, response body: %s", resp.StatusCode, b)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestParseInteraction(t *testing.T) {
+	a := require.New(t)
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	setting := &storepb.AppIMSetting{
+		Settings: []*storepb.AppIMSetting_IMSetting{
+			{
+				Type: storepb.ProjectWebhook_SLACK,
+				Payload: &storepb.AppIMSetting_IMSetting_Slack{
+					Slack: &storepb.AppIMSetting_Slack{Token: "xoxb", SigningSecret: "secret"},
+				},
+			},
+		},
+	}
+	payload := `{
+		"type": "block_actions",
+		"user": {"id": "U1"},
+		"response_url": "https://hooks.slack.com/actions/1",
+		"actions": [{"action_id": "reject_issue", "value": "projects/p1/issues/101"}],
+		"state": {"values": {"approval_comment": {"comment": {"value": "Missing rollback plan"}}}},
+		"message": {"blocks": [{"type": "section"}, {"type": "actions", "block_id": "approval_actions"}]}
+	}`
+	body := []byte(url.Values{"payload": {payload}}.Encode())
+	sign := func(secret string, timestamp time.Time) http.Header {
+		ts := strconv.FormatInt(timestamp.Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write([]byte("v0:" + ts + ":"))
+		_, _ = mac.Write(body)
+		header := http.Header{}
+		header.Set("X-Slack-Request-Timestamp", ts)
+		header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+		return header
+	}
+
+	interaction, err := ParseInteraction(setting, sign("secret", now), body, now)
+	a.NoError(err)
+	a.Equal("projects/p1/issues/101", interaction.IssueName)
+	a.False(interaction.Approve)
+	a.Equal("Missing rollback plan", interaction.Comment)
+	a.Equal("U1", interaction.UserID)
+	a.Equal("https://hooks.slack.com/actions/1", interaction.responseURL)
+	a.Len(interaction.blocks, 2)
+
+	// The signature is signed by another secret.
+	_, err = ParseInteraction(setting, sign("other", now), body, now)
+	a.Error(err)
+
+	// The request is too old.
+	_, err = ParseInteraction(setting, sign("secret", now.Add(-10*time.Minute)), body, now)
+	a.Error(err)
+
+	// The signing secret is not configured.
+	_, err = ParseInteraction(&storepb.AppIMSetting{}, sign("secret", now), body, now)
+	a.Error(err)
+}
@@ -20,17 +20,20 @@ import (
 
 // getSlackToken extracts the Slack token from the AppIMSetting.
 func getSlackToken(setting *storepb.AppIMSetting) string {
+	return getSlackConfig(setting).GetToken()
+}
+
+// getSlackConfig extracts the Slack configuration from the AppIMSetting.
+func getSlackConfig(setting *storepb.AppIMSetting) *storepb.AppIMSetting_Slack {
 	if setting == nil {
-		return ""
+		return nil
 	}
 	for _, s := range setting.Settings {
 		if s.Type == storepb.ProjectWebhook_SLACK {
-			if slack := s.GetSlack(); slack != nil {
-				return slack.Token
-			}
+			return s.GetSlack()
 		}
 	}
-	return ""
+	return nil
 }
 
 // BlockMarkdown is the API message for Slack webhook block markdown.
@@ -47,16 +50,30 @@ type ElementButton struct {
 
 // Element is the API message for Slack webhook element.
 type Element struct {
-	Type   string        `json:"type"`
-	Button ElementButton `json:"text,omitempty"`
-	URL    string        `json:"url,omitempty"`
+	Type     string        `json:"type"`
+	Button   ElementButton `json:"text,omitempty"`
+	URL      string        `json:"url,omitempty"`
+	Style    string        `json:"style,omitempty"`
+	ActionID string        `json:"action_id,omitempty"`
+	Value    string        `json:"value,omitempty"`
+}
+
+// InputElement is the API message for Slack plain-text input element.
+type InputElement struct {
+	Type      string `json:"type"`
+	ActionID  string `json:"action_id"`
+	Multiline bool   `json:"multiline,omitempty"`
 }
 
 // Block is the API message for Slack webhook block.
 type Block struct {
 	Type        string         `json:"type"`
+	BlockID     string         `json:"block_id,omitempty"`
 	Text        *BlockMarkdown `json:"text,omitempty"`
 	ElementList []Element      `json:"elements,omitempty"`
+	Label       *BlockMarkdown `json:"label,omitempty"`
+	Element     *InputElement  `json:"element,omitempty"`
+	Optional    bool           `json:"optional,omitempty"`
 }
 
 // MessagePayload is the API message for Slack webhook.
@@ -168,10 +185,7 @@ func postMessage(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
-	resp, err := client.Do(req)
+	resp, err := webhook.Do(context, req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
@@ -103,10 +103,7 @@ func (*TeamsReceiver) Post(context Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: Timeout,
-	}
-	resp, err := client.Do(req)
+	resp, err := Do(context, req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
@@ -1,8 +1,11 @@
 package webhook
 
 import (
+	"context"
+	"net"
 	"net/url"
 	"strings"
+	"syscall"
 
 	"github.com/pkg/errors"
 
@@ -42,8 +45,26 @@ var (
 	// TestOnlyAllowedDomains contains additional domains allowed for testing purposes only.
 	// This should only be modified in test files.
 	TestOnlyAllowedDomains = map[storepb.ProjectWebhook_Type][]string{}
+
+	// allowedPrivateNetworks is the private networks which the CUSTOM webhooks are allowed to post to.
+	allowedPrivateNetworks []*net.IPNet
 )
 
+// SetAllowedPrivateNetworks sets the private networks in CIDR notation, e.g. 10.0.0.0/8,
+// which the CUSTOM webhooks are allowed to post to. It should be called once on startup.
+func SetAllowedPrivateNetworks(cidrs []string) error {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid CIDR %q", cidr)
+		}
+		networks = append(networks, network)
+	}
+	allowedPrivateNetworks = networks
+	return nil
+}
+
 // ValidateWebhookURL validates that the webhook URL matches the allowed domains for the webhook type.
 func ValidateWebhookURL(webhookType storepb.ProjectWebhook_Type, webhookURL string) error {
 	// Parse URL
@@ -57,6 +78,14 @@ func ValidateWebhookURL(webhookType storepb.ProjectWebhook_Type, webhookURL stri
 		return errors.Errorf("invalid URL scheme: %s (only http and https are allowed)", u.Scheme)
 	}
 
+	// Custom webhooks post to user-owned endpoints, so any public host is allowed.
+	if webhookType == storepb.ProjectWebhook_CUSTOM {
+		if u.Hostname() == "" {
+			return errors.Errorf("invalid URL: missing host")
+		}
+		return validateCustomWebhookHost(u.Hostname())
+	}
+
 	// Get allowed domains for this webhook type
 	allowedDomainsForType, ok := allowedDomains[webhookType]
 	if !ok {
@@ -90,3 +119,47 @@ func ValidateWebhookURL(webhookType storepb.ProjectWebhook_Type, webhookURL stri
 	return errors.Errorf("webhook URL domain %q is not allowed for webhook type %s (allowed domains: %v)",
 		hostname, webhookType, allowedDomainsForType)
 }
+
+// validateCustomWebhookHost resolves the host, and rejects it if any of its addresses is not allowed.
+func validateCustomWebhookHost(host string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve host %q", host)
+	}
+	for _, addr := range addrs {
+		if err := checkCustomWebhookIP(addr.IP); err != nil {
+			return errors.Wrapf(err, "host %q is not allowed", host)
+		}
+	}
+	return nil
+}
+
+// checkCustomWebhookIP rejects the private, loopback and link-local addresses to prevent SSRF,
+// unless they are in the allowed private networks.
+func checkCustomWebhookIP(ip net.IP) error {
+	if !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsUnspecified() {
+		return nil
+	}
+	for _, network := range allowedPrivateNetworks {
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+	return errors.Errorf("address %s is private, loopback or link-local", ip)
+}
+
+// controlCustomWebhookDial checks the resolved address before connecting to it, so that the host can't be
+// rebound to a disallowed address after the validation, nor redirect to one.
+func controlCustomWebhookDial(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("invalid address %q", address)
+	}
+	return checkCustomWebhookIP(ip)
+}
@@ -3,6 +3,8 @@ package webhook
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 )
 
@@ -165,6 +167,49 @@ func TestValidateWebhookURL(t *testing.T) {
 			webhookURL:  "file:///etc/passwd",
 			wantErr:     true,
 		},
+		// Custom tests
+		{
+			name:        "valid custom URL",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "https://203.0.113.10/hooks/bytebase",
+			wantErr:     false,
+		},
+		{
+			name:        "custom loopback host",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "http://127.0.0.1:8080/hooks/bytebase",
+			wantErr:     true,
+		},
+		{
+			name:        "custom private host",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "http://10.0.0.1/hooks/bytebase",
+			wantErr:     true,
+		},
+		{
+			name:        "custom link-local metadata host",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "http://169.254.169.254/latest/meta-data",
+			wantErr:     true,
+		},
+		{
+			name:        "custom IPv6 loopback host",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "http://[::1]/hooks/bytebase",
+			wantErr:     true,
+		},
+		{
+			name:        "invalid custom scheme",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "ftp://incident.internal.example.com/hooks/bytebase",
+			wantErr:     true,
+		},
+		{
+			name:        "custom URL without host",
+			webhookType: storepb.ProjectWebhook_CUSTOM,
+			webhookURL:  "https:///hooks/bytebase",
+			wantErr:     true,
+		},
 		// Unknown webhook type
 		{
 			name:        "unknown webhook type",
@@ -184,6 +229,19 @@ func TestValidateWebhookURL(t *testing.T) {
 	}
 }
 
+func TestValidateWebhookURL_AllowedPrivateNetworks(t *testing.T) {
+	a := require.New(t)
+	a.Error(SetAllowedPrivateNetworks([]string{"10.0.0.1"}))
+
+	a.NoError(SetAllowedPrivateNetworks([]string{"10.0.0.0/8"}))
+	t.Cleanup(func() {
+		allowedPrivateNetworks = nil
+	})
+	a.NoError(ValidateWebhookURL(storepb.ProjectWebhook_CUSTOM, "http://10.1.2.3/hooks/bytebase"))
+	a.Error(ValidateWebhookURL(storepb.ProjectWebhook_CUSTOM, "http://192.168.1.1/hooks/bytebase"))
+	a.Error(ValidateWebhookURL(storepb.ProjectWebhook_CUSTOM, "http://169.254.169.254/latest/meta-data"))
+}
+
 func TestValidateWebhookURL_CaseInsensitive(t *testing.T) {
 	// Test that domain matching is case-insensitive
 	tests := []struct {
@@ -3,6 +3,8 @@ package webhook
 
 import (
 	"fmt"
+	"net"
+	"net/http"
 	"sync"
 	"time"
 
@@ -99,6 +101,21 @@ type Context struct {
 
 	DirectMessage bool
 	IMSetting     *storepb.AppIMSetting
+
+	// Settings of CUSTOM webhooks.
+	SigningSecret string
+	Headers       map[string]string
+	// DeliveryID identifies the delivery record of the post, zero if not recorded.
+	DeliveryID int64
+
+	// Result receives the result of the post if set.
+	Result *Result
+}
+
+// Result is the result of posting to the webhook endpoint.
+type Result struct {
+	// StatusCode is the HTTP status code of the response, zero if no response was received.
+	StatusCode int
 }
 
 // Receiver is the webhook receiver.
@@ -176,6 +193,19 @@ func (c *Context) GetMetaList() []Meta {
 	return m
 }
 
+// GetApprovalIssueName returns the issue name for the interactive approval actions of the approval notification.
+// It returns "" for the other events.
+func (c *Context) GetApprovalIssueName() string {
+	if c.EventType != storepb.Activity_ISSUE_APPROVAL_NOTIFY.String() || c.Issue == nil || c.Project == nil {
+		return ""
+	}
+	projectID, err := common.GetProjectID(c.Project.Name)
+	if err != nil {
+		return ""
+	}
+	return common.FormatIssue(projectID, c.Issue.ID)
+}
+
 func (c *Context) GetMetaListZh() []Meta {
 	m := []Meta{}
 
@@ -271,3 +301,38 @@ func Post(webhookType storepb.ProjectWebhook_Type, context Context) error {
 	}
 	return r.Post(context)
 }
+
+// customClient is the client of the CUSTOM webhooks, which only connects to the allowed addresses.
+var customClient = newCustomClient()
+
+func newCustomClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// The proxy from the environment is not used, otherwise the dial check would only see the proxy address,
+	// and the proxy would connect to the disallowed addresses on our behalf.
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   Timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   controlCustomWebhookDial,
+	}).DialContext
+	return &http.Client{
+		Timeout:   Timeout,
+		Transport: transport,
+	}
+}
+
+// Do sends the request to the webhook endpoint, and records the response status code in context.Result.
+func Do(context Context, req *http.Request) (*http.Response, error) {
+	return do(&http.Client{Timeout: Timeout}, context, req)
+}
+
+func do(client *http.Client, context Context, req *http.Request) (*http.Response, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if context.Result != nil {
+		context.Result.StatusCode = resp.StatusCode
+	}
+	return resp, nil
+}
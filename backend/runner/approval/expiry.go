@@ -0,0 +1,197 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const grantExpiryInterval = 1 * time.Minute
+
+// grantExpiryResource is the cluster resource claimed while revoking the expired grants.
+const grantExpiryResource = "grant_expiry"
+
+// grantIssueRegexp matches the condition description of the bindings created by the grant request issues, e.g. "#123".
+var grantIssueRegexp = regexp.MustCompile(`^#(\d+)$`)
+
+// revokeExpiredGrants removes the expired bindings granted by the grant request issues from the project IAM policies.
+func (r *Runner) revokeExpiredGrants(ctx context.Context) {
+	// Only one replica revokes the expired grants in the HA deployment, so the audit logs and webhooks are sent once.
+	claimed, err := r.stateCfg.TryClaim(ctx, grantExpiryResource)
+	if err != nil {
+		slog.Error("failed to claim the grant expiry", log.BBError(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+	defer r.stateCfg.Release(ctx, grantExpiryResource)
+
+	resourceType := storepb.Policy_PROJECT
+	policyType := storepb.Policy_IAM
+	policies, err := r.store.ListPoliciesV2(ctx, &store.FindPolicyMessage{
+		ResourceType: &resourceType,
+		Type:         &policyType,
+	})
+	if err != nil {
+		slog.Error("failed to list project iam policies", log.BBError(err))
+		return
+	}
+
+	now := time.Now()
+	for _, policy := range policies {
+		projectID, err := common.GetProjectID(policy.Resource)
+		if err != nil {
+			slog.Error("invalid project iam policy resource", slog.String("resource", policy.Resource), log.BBError(err))
+			continue
+		}
+		if err := r.revokeExpiredGrantsInProject(ctx, projectID, now); err != nil {
+			slog.Error("failed to revoke expired grants", slog.String("resource", policy.Resource), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) revokeExpiredGrantsInProject(ctx context.Context, projectID string, now time.Time) error {
+	iamPolicy, err := r.store.GetProjectIamPolicy(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	expired, remaining := findExpiredGrantBindings(iamPolicy.Policy.Bindings, now)
+	if len(expired) == 0 {
+		return nil
+	}
+
+	// The policy is updated only if it's not changed since it's read, otherwise the expired grants are revoked in the next round.
+	policy := proto.CloneOf(iamPolicy.Policy)
+	policy.Bindings = remaining
+	if _, err := r.store.UpdateProjectIamPolicyWithEtag(ctx, projectID, iamPolicy.Etag, policy); err != nil {
+		if common.ErrorCode(err) == common.Conflict {
+			slog.Debug("project iam policy changed while revoking expired grants", slog.String("project", projectID))
+			return nil
+		}
+		return errors.Wrapf(err, "failed to update iam policy")
+	}
+
+	systemBot := r.store.GetSystemBotUser(ctx)
+	if err := r.createGrantExpireAuditLog(ctx, projectID, systemBot, expired); err != nil {
+		slog.Warn("failed to create audit log for expired grants", slog.String("project", projectID), log.BBError(err))
+	}
+	for _, binding := range expired {
+		r.createGrantExpireEvent(ctx, systemBot, binding)
+	}
+	return nil
+}
+
+func (r *Runner) createGrantExpireAuditLog(ctx context.Context, projectID string, systemBot *store.UserMessage, expired []*storepb.Binding) error {
+	var deltas []*v1pb.BindingDelta
+	for _, binding := range expired {
+		for _, member := range binding.Members {
+			deltas = append(deltas, &v1pb.BindingDelta{
+				Action:    v1pb.BindingDelta_REMOVE,
+				Role:      binding.Role,
+				Member:    member,
+				Condition: binding.Condition,
+			})
+		}
+	}
+	serviceData, err := anypb.New(&v1pb.AuditData{
+		PolicyDelta: &v1pb.PolicyDelta{
+			BindingDeltas: deltas,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return r.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:      common.FormatProject(projectID),
+		Method:      store.AuditLogMethodProjectGrantExpire.String(),
+		Resource:    common.FormatProject(projectID),
+		Severity:    storepb.AuditLog_INFO,
+		User:        common.FormatUserUID(systemBot.ID),
+		ServiceData: serviceData,
+	})
+}
+
+func (r *Runner) createGrantExpireEvent(ctx context.Context, systemBot *store.UserMessage, binding *storepb.Binding) {
+	matches := grantIssueRegexp.FindStringSubmatch(binding.GetCondition().GetDescription())
+	issueUID, err := strconv.Atoi(matches[1])
+	if err != nil {
+		slog.Warn("invalid grant request issue", slog.String("description", binding.GetCondition().GetDescription()), log.BBError(err))
+		return
+	}
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		slog.Warn("failed to get grant request issue", slog.Int("issue", issueUID), log.BBError(err))
+		return
+	}
+	if issue == nil {
+		return
+	}
+
+	var members []*store.UserMessage
+	for _, member := range binding.Members {
+		userID, err := common.GetUserID(member)
+		if err != nil {
+			continue
+		}
+		user, err := r.store.GetUserByID(ctx, userID)
+		if err != nil {
+			slog.Warn("failed to get user", slog.String("member", member), log.BBError(err))
+			continue
+		}
+		if user != nil {
+			members = append(members, user)
+		}
+	}
+
+	r.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   systemBot,
+		Type:    storepb.Activity_NOTIFY_GRANT_EXPIRED,
+		Comment: fmt.Sprintf("The role %s granted by the issue has expired and been revoked.", binding.Role),
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+		GrantExpire: &webhook.EventGrantExpire{
+			Role:    binding.Role,
+			Members: members,
+		},
+	})
+}
+
+// findExpiredGrantBindings splits the bindings into the expired bindings granted by the grant request issues and the remaining ones.
+// The bindings not created by the grant request issues are never revoked even if they are expired.
+func findExpiredGrantBindings(bindings []*storepb.Binding, now time.Time) ([]*storepb.Binding, []*storepb.Binding) {
+	var expired, remaining []*storepb.Binding
+	for _, binding := range bindings {
+		if !grantIssueRegexp.MatchString(binding.GetCondition().GetDescription()) {
+			remaining = append(remaining, binding)
+			continue
+		}
+		ok, err := common.EvalBindingCondition(binding.GetCondition().GetExpression(), now)
+		if err != nil {
+			// Keep the binding if we cannot tell whether it is expired.
+			slog.Warn("failed to evaluate binding condition", slog.String("role", binding.Role), log.BBError(err))
+			remaining = append(remaining, binding)
+			continue
+		}
+		if ok {
+			remaining = append(remaining, binding)
+			continue
+		}
+		expired = append(expired, binding)
+	}
+	return expired, remaining
+}
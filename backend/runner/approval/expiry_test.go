@@ -0,0 +1,34 @@
+package approval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestFindExpiredGrantBindings(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	newBinding := func(description, expression string) *storepb.Binding {
+		return &storepb.Binding{
+			Role:    "roles/sqlEditorUser",
+			Members: []string{"users/101"},
+			Condition: &expr.Expr{
+				Description: description,
+				Expression:  expression,
+			},
+		}
+	}
+	expiredGrant := newBinding("#1", `resource.database in ["instances/i1/databases/db1"] && request.time < timestamp("2026-01-02T08:00:00Z")`)
+	activeGrant := newBinding("#2", `resource.database in ["instances/i1/databases/db1"] && request.time < timestamp("2026-01-02T20:00:00Z")`)
+	permanentGrant := newBinding("#3", `resource.database in ["instances/i1/databases/db1"]`)
+	expiredManual := newBinding("manual", `request.time < timestamp("2026-01-01T00:00:00Z")`)
+	invalidGrant := newBinding("#4", `request.time <`)
+
+	expired, remaining := findExpiredGrantBindings([]*storepb.Binding{expiredGrant, activeGrant, permanentGrant, expiredManual, invalidGrant}, now)
+	require.Equal(t, []*storepb.Binding{expiredGrant}, expired)
+	require.Equal(t, []*storepb.Binding{activeGrant, permanentGrant, expiredManual, invalidGrant}, remaining)
+}
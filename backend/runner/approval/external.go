@@ -0,0 +1,545 @@
+package approval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const (
+	externalApprovalInterval = 10 * time.Second
+	// externalApprovalRequestTimeout is the timeout of the HTTP requests to the external approval services.
+	externalApprovalRequestTimeout = 10 * time.Second
+	// externalApprovalStatementLimit is the maximum characters of each statement sent to the external approval services.
+	externalApprovalStatementLimit = 1024
+
+	// ExternalApprovalCallbackPath is the path of the callback endpoint under the webhook API prefix.
+	ExternalApprovalCallbackPath = "/external-approval"
+)
+
+// ErrExternalApprovalInProgress is returned if the external approvals of the issue are being processed by another replica or request.
+var ErrExternalApprovalInProgress = errors.New("the external approvals of the issue are being processed")
+
+// The status of the approval requests in the external approval services.
+const (
+	ExternalApprovalStatusPending  = "PENDING"
+	ExternalApprovalStatusApproved = "APPROVED"
+	ExternalApprovalStatusRejected = "REJECTED"
+)
+
+// externalApprovalRequest is the approval request sent to the external approval service.
+type externalApprovalRequest struct {
+	// Issue is the issue name in the format of projects/{project}/issues/{issue}.
+	Issue       string `json:"issue"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// Creator is the email of the issue creator.
+	Creator string `json:"creator"`
+	// Plan is the plan name in the format of projects/{project}/plans/{plan}.
+	Plan       string                       `json:"plan,omitempty"`
+	RiskLevel  string                       `json:"riskLevel"`
+	Statements []*externalApprovalStatement `json:"statements,omitempty"`
+	// CallbackURL is the URL for the external approval service to post the decision.
+	// It's empty if the external URL of the workspace isn't set up, and the decision is polled instead.
+	CallbackURL string `json:"callbackUrl,omitempty"`
+}
+
+type externalApprovalStatement struct {
+	Targets   []string `json:"targets"`
+	Statement string   `json:"statement"`
+	Truncated bool     `json:"truncated"`
+}
+
+type externalApprovalCreateResponse struct {
+	ID string `json:"id"`
+}
+
+type externalApprovalStatusResponse struct {
+	Status  string `json:"status"`
+	Comment string `json:"comment"`
+}
+
+// pendingExternalApproval is a pending approver set decided by an external approval node.
+type pendingExternalApproval struct {
+	nodeID      string
+	step        int
+	approverSet int
+}
+
+// processExternalApprovals sends the approval requests to the external approval nodes,
+// and polls the decisions of the sent requests.
+func (r *Runner) processExternalApprovals(ctx context.Context) {
+	if r.licenseService.IsFeatureEnabled(v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW) != nil {
+		return
+	}
+	setting, err := r.store.GetWorkspaceExternalApprovalSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace external approval setting", log.BBError(err))
+		return
+	}
+	if len(setting.Nodes) == 0 {
+		return
+	}
+	nodes := make(map[string]*storepb.WorkspaceExternalApprovalSetting_Node)
+	for _, node := range setting.Nodes {
+		nodes[node.Id] = node
+	}
+
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		StatusList: []storepb.Issue_Status{storepb.Issue_OPEN},
+	})
+	if err != nil {
+		slog.Error("failed to list open issues", log.BBError(err))
+		return
+	}
+	for _, issue := range issues {
+		// The approver sets of a parallel step may be decided by several external approval nodes.
+		for _, pending := range getPendingExternalApprovals(issue.Payload.GetApproval()) {
+			if err := r.processExternalApproval(ctx, issue.UID, pending, nodes); err != nil {
+				slog.Error("failed to process external approval", slog.Int("issue", issue.UID), slog.String("node", pending.nodeID), log.BBError(err))
+			}
+		}
+	}
+}
+
+func (r *Runner) processExternalApproval(ctx context.Context, issueUID int, pending *pendingExternalApproval, nodes map[string]*storepb.WorkspaceExternalApprovalSetting_Node) error {
+	// The issue is claimed across the replicas, so that the approval request is sent only once.
+	// Skip the issue if it's being processed by another replica or a callback.
+	release, err := r.claimExternalApproval(ctx, issueUID)
+	if err != nil {
+		if errors.Is(err, ErrExternalApprovalInProgress) {
+			return nil
+		}
+		return err
+	}
+	defer release()
+
+	// Get the issue again in case that the decision has been posted back.
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %v", issueUID)
+	}
+	if issue == nil || issue.Status != storepb.Issue_OPEN {
+		return nil
+	}
+	approval := issue.Payload.GetApproval()
+	pending = findPendingExternalApproval(approval, pending.nodeID)
+	if pending == nil {
+		return nil
+	}
+	nodeID, step := pending.nodeID, pending.step
+	node, ok := nodes[nodeID]
+	if !ok {
+		return errors.Errorf("external approval node %q not found", nodeID)
+	}
+
+	externalApproval := findExternalApproval(approval, step, nodeID)
+	if externalApproval == nil {
+		requestID, err := r.createExternalApprovalRequest(ctx, issue, node)
+		if err != nil {
+			return errors.Wrapf(err, "failed to send approval request to external approval node %q", nodeID)
+		}
+		approval.ExternalApprovals = append(approval.ExternalApprovals, &storepb.IssuePayloadApproval_ExternalApproval{
+			Step:       int32(step),
+			NodeId:     nodeID,
+			RequestId:  requestID,
+			CreateTime: timestamppb.Now(),
+		})
+		return updateIssueApprovalPayload(ctx, r.store, issue, approval)
+	}
+
+	if isExternalApprovalTimeout(externalApproval, node, time.Now()) {
+		comment := fmt.Sprintf("The approval request to %q is rejected because it's not decided within %v.", node.Title, node.Timeout.AsDuration())
+		return r.applyExternalApprovalDecision(ctx, issue, node, pending, externalApproval, false, comment)
+	}
+
+	status, err := getExternalApprovalRequestStatus(ctx, node, externalApproval.RequestId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get approval request status from external approval node %q", nodeID)
+	}
+	switch status.Status {
+	case ExternalApprovalStatusApproved:
+		return r.applyExternalApprovalDecision(ctx, issue, node, pending, externalApproval, true, status.Comment)
+	case ExternalApprovalStatusRejected:
+		return r.applyExternalApprovalDecision(ctx, issue, node, pending, externalApproval, false, status.Comment)
+	default:
+		return nil
+	}
+}
+
+// DecideExternalApproval records the decision of the approval request posted back by the external approval node.
+// ErrExternalApprovalInProgress is returned if the issue is being processed, and the decision should be posted again later.
+func (r *Runner) DecideExternalApproval(ctx context.Context, issueName string, node *storepb.WorkspaceExternalApprovalSetting_Node, requestID string, approved bool, comment string) error {
+	_, issueUID, err := common.GetProjectIDIssueUID(issueName)
+	if err != nil {
+		return err
+	}
+	release, err := r.claimExternalApproval(ctx, issueUID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %q", issueName)
+	}
+	if issue == nil || issue.Status != storepb.Issue_OPEN {
+		return errors.Errorf("open issue %q not found", issueName)
+	}
+	approval := issue.Payload.GetApproval()
+	pending := findPendingExternalApproval(approval, node.Id)
+	if pending == nil {
+		return errors.Errorf("issue %q is not pending on external approval node %q", issueName, node.Id)
+	}
+	externalApproval := findExternalApproval(approval, pending.step, pending.nodeID)
+	if externalApproval == nil || externalApproval.RequestId != requestID {
+		return errors.Errorf("approval request %q not found in issue %q", requestID, issueName)
+	}
+	return r.applyExternalApprovalDecision(ctx, issue, node, pending, externalApproval, approved, comment)
+}
+
+// claimExternalApproval claims the external approvals of the issue in the process and across the replicas,
+// which serializes the requests and decisions from polling and callbacks. The returned function releases the claim.
+func (r *Runner) claimExternalApproval(ctx context.Context, issueUID int) (func(), error) {
+	resource := fmt.Sprintf("external_approvals/%d", issueUID)
+	claimed, err := r.stateCfg.TryClaim(ctx, resource)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to claim the external approvals of issue %v", issueUID)
+	}
+	if !claimed {
+		return nil, ErrExternalApprovalInProgress
+	}
+	return func() {
+		r.stateCfg.Release(ctx, resource)
+	}, nil
+}
+
+// applyExternalApprovalDecision approves or rejects the pending approver set of the external approval node on behalf of the system bot.
+func (r *Runner) applyExternalApprovalDecision(ctx context.Context, issue *store.IssueMessage, node *storepb.WorkspaceExternalApprovalSetting_Node, pending *pendingExternalApproval, externalApproval *storepb.IssuePayloadApproval_ExternalApproval, approved bool, comment string) error {
+	systemBot := r.store.GetSystemBotUser(ctx)
+	approval := issue.Payload.GetApproval()
+	pendingApproverSets := utils.FindPendingApproverSets(approval)
+	status := storepb.IssuePayloadApproval_Approver_REJECTED
+	if approved {
+		status = storepb.IssuePayloadApproval_Approver_APPROVED
+	}
+	approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+		Status:      status,
+		PrincipalId: int32(systemBot.ID),
+		Step:        int32(pending.step),
+		ApproverSet: int32(pending.approverSet),
+	})
+	issueApproved, err := utils.CheckApprovalApproved(approval)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if the approval is approved")
+	}
+	newApprovers, err := utils.HandleIncomingApprovalSteps(approval)
+	if err != nil {
+		return errors.Wrap(err, "failed to handle incoming approval steps")
+	}
+	approval.Approvers = append(approval.Approvers, newApprovers...)
+
+	issue, err = r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.Issue{
+			Approval: approval,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to update issue")
+	}
+
+	if issueApproved && issue.Type == storepb.Issue_GRANT_REQUEST {
+		if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, r.store, issue, issue.Payload.GrantRequest); err != nil {
+			return err
+		}
+	}
+
+	if _, err := r.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+		IssueUID: issue.UID,
+		Payload: &storepb.IssueCommentPayload{
+			Comment: comment,
+			Event: &storepb.IssueCommentPayload_Approval_{
+				Approval: &storepb.IssueCommentPayload_Approval{
+					Status: status,
+				},
+			},
+		},
+	}, systemBot.ID); err != nil {
+		slog.Warn("failed to create issue comment", log.BBError(err))
+	}
+	if err := r.createExternalApprovalAuditLog(ctx, issue, node, externalApproval, status, comment, systemBot); err != nil {
+		slog.Warn("failed to create audit log for external approval", slog.Int("issue", issue.UID), log.BBError(err))
+	}
+	if !approved {
+		return nil
+	}
+
+	if approvers := utils.FindPendingApprovers(approval, pendingApproverSets); len(approvers) > 0 {
+		r.webhookManager.CreateEvent(ctx, &webhook.Event{
+			Actor:   systemBot,
+			Type:    storepb.Activity_ISSUE_APPROVAL_NOTIFY,
+			Issue:   webhook.NewIssue(issue),
+			Project: webhook.NewProject(issue.Project),
+			IssueApprovalCreate: &webhook.EventIssueApprovalCreate{
+				Approvers: approvers,
+			},
+		})
+	}
+	if !issueApproved {
+		return nil
+	}
+	r.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   systemBot,
+		Type:    storepb.Activity_NOTIFY_ISSUE_APPROVED,
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+	})
+	if err := r.notifyPipelineRollout(ctx, issue, systemBot); err != nil {
+		slog.Error("failed to create rollout release notification activity", log.BBError(err))
+	}
+	// The grant request issue is closed once approved.
+	if issue.Type == storepb.Issue_GRANT_REQUEST {
+		if err := webhook.ChangeIssueStatus(ctx, r.store, r.webhookManager, issue, storepb.Issue_DONE, systemBot, ""); err != nil {
+			slog.Debug("failed to update issue status to done if grant request issue is approved", log.BBError(err))
+		}
+	}
+	return nil
+}
+
+func (r *Runner) notifyPipelineRollout(ctx context.Context, issue *store.IssueMessage, user *store.UserMessage) error {
+	if issue.PipelineUID == nil {
+		return nil
+	}
+	tasks, err := r.store.ListTasks(ctx, &store.TaskFind{PipelineID: issue.PipelineUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list tasks")
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+	firstEnvironment := tasks[0].Environment
+	policy, err := apiv1.GetValidRolloutPolicyForEnvironment(ctx, r.store, firstEnvironment)
+	if err != nil {
+		return err
+	}
+	r.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Actor:   user,
+		Type:    storepb.Activity_NOTIFY_PIPELINE_ROLLOUT,
+		Issue:   webhook.NewIssue(issue),
+		Project: webhook.NewProject(issue.Project),
+		IssueRolloutReady: &webhook.EventIssueRolloutReady{
+			RolloutPolicy: policy,
+			StageName:     firstEnvironment,
+		},
+	})
+	return nil
+}
+
+func (r *Runner) createExternalApprovalAuditLog(ctx context.Context, issue *store.IssueMessage, node *storepb.WorkspaceExternalApprovalSetting_Node, externalApproval *storepb.IssuePayloadApproval_ExternalApproval, status storepb.IssuePayloadApproval_Approver_Status, comment string, systemBot *store.UserMessage) error {
+	request, err := json.Marshal(map[string]string{
+		"node":      common.FormatExternalApprovalNode(node.Id),
+		"requestId": externalApproval.RequestId,
+	})
+	if err != nil {
+		return err
+	}
+	response, err := json.Marshal(map[string]string{
+		"status":  status.String(),
+		"comment": comment,
+	})
+	if err != nil {
+		return err
+	}
+	return r.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:   common.FormatProject(issue.Project.ResourceID),
+		Method:   store.AuditLogMethodIssueExternalApproval.String(),
+		Resource: common.FormatIssue(issue.Project.ResourceID, issue.UID),
+		Severity: storepb.AuditLog_INFO,
+		User:     common.FormatUserUID(systemBot.ID),
+		Request:  string(request),
+		Response: string(response),
+	})
+}
+
+func (r *Runner) createExternalApprovalRequest(ctx context.Context, issue *store.IssueMessage, node *storepb.WorkspaceExternalApprovalSetting_Node) (string, error) {
+	request := &externalApprovalRequest{
+		Issue:       common.FormatIssue(issue.Project.ResourceID, issue.UID),
+		Title:       issue.Title,
+		Description: issue.Description,
+		Creator:     issue.Creator.Email,
+		RiskLevel:   issue.Payload.GetApproval().GetRiskLevel().String(),
+	}
+	if issue.PlanUID != nil {
+		request.Plan = common.FormatPlan(issue.Project.ResourceID, *issue.PlanUID)
+		plan, err := r.store.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get plan %v", *issue.PlanUID)
+		}
+		if plan != nil {
+			statements, err := r.getExternalApprovalStatements(ctx, plan)
+			if err != nil {
+				return "", err
+			}
+			request.Statements = statements
+		}
+	}
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get workspace general setting")
+	}
+	if setting.ExternalUrl != "" {
+		request.CallbackURL = fmt.Sprintf("%s/hook%s/%s", strings.TrimSuffix(setting.ExternalUrl, "/"), ExternalApprovalCallbackPath, node.Id)
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	resp := &externalApprovalCreateResponse{}
+	if err := doExternalApprovalRequest(ctx, node, http.MethodPost, node.Endpoint, body, resp); err != nil {
+		return "", err
+	}
+	if resp.ID == "" {
+		return "", errors.Errorf("empty approval request id in the response")
+	}
+	return resp.ID, nil
+}
+
+// getExternalApprovalStatements returns the truncated statements of the plan specs.
+func (r *Runner) getExternalApprovalStatements(ctx context.Context, plan *store.PlanMessage) ([]*externalApprovalStatement, error) {
+	var statements []*externalApprovalStatement
+	for _, spec := range plan.Config.GetSpecs() {
+		config := spec.GetChangeDatabaseConfig()
+		if config == nil || config.Sheet == "" {
+			continue
+		}
+		_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid sheet %q", config.Sheet)
+		}
+		statement, err := r.store.GetSheetStatementByID(ctx, sheetUID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get statement in sheet %v", sheetUID)
+		}
+		truncatedStatement, truncated := common.TruncateString(statement, externalApprovalStatementLimit)
+		statements = append(statements, &externalApprovalStatement{
+			Targets:   config.Targets,
+			Statement: truncatedStatement,
+			Truncated: truncated,
+		})
+	}
+	return statements, nil
+}
+
+func getExternalApprovalRequestStatus(ctx context.Context, node *storepb.WorkspaceExternalApprovalSetting_Node, requestID string) (*externalApprovalStatusResponse, error) {
+	resp := &externalApprovalStatusResponse{}
+	endpoint := fmt.Sprintf("%s/%s", strings.TrimSuffix(node.Endpoint, "/"), url.PathEscape(requestID))
+	if err := doExternalApprovalRequest(ctx, node, http.MethodGet, endpoint, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func doExternalApprovalRequest(ctx context.Context, node *storepb.WorkspaceExternalApprovalSetting_Node, method, endpoint string, body []byte, response any) error {
+	ctx, cancel := context.WithTimeout(ctx, externalApprovalRequestTimeout)
+	defer cancel()
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct %s %s", method, endpoint)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+node.Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s %s", method, endpoint)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("%s %s responded with status %d: %s", method, endpoint, resp.StatusCode, b)
+	}
+	if err := json.Unmarshal(b, response); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response body %q", b)
+	}
+	return nil
+}
+
+// getPendingExternalApprovals returns the pending approver sets of the current approval step decided by the external approval nodes.
+func getPendingExternalApprovals(approval *storepb.IssuePayloadApproval) []*pendingExternalApproval {
+	if approval == nil || !approval.ApprovalFindingDone || approval.ApprovalFindingError != "" || approval.ApprovalTemplate == nil {
+		return nil
+	}
+	if utils.IsApprovalRejected(approval) {
+		return nil
+	}
+	var pendings []*pendingExternalApproval
+	for _, approverSet := range utils.FindPendingApproverSets(approval) {
+		for _, approver := range approverSet.Approvers {
+			if !strings.HasPrefix(approver, common.ExternalApprovalNodePrefix) {
+				continue
+			}
+			nodeID, err := common.GetExternalApprovalNodeID(approver)
+			if err != nil {
+				continue
+			}
+			pendings = append(pendings, &pendingExternalApproval{
+				nodeID:      nodeID,
+				step:        approverSet.Step,
+				approverSet: approverSet.ApproverSet,
+			})
+		}
+	}
+	return pendings
+}
+
+func findPendingExternalApproval(approval *storepb.IssuePayloadApproval, nodeID string) *pendingExternalApproval {
+	for _, pending := range getPendingExternalApprovals(approval) {
+		if pending.nodeID == nodeID {
+			return pending
+		}
+	}
+	return nil
+}
+
+func findExternalApproval(approval *storepb.IssuePayloadApproval, step int, nodeID string) *storepb.IssuePayloadApproval_ExternalApproval {
+	for _, externalApproval := range approval.GetExternalApprovals() {
+		if int(externalApproval.Step) == step && externalApproval.NodeId == nodeID {
+			return externalApproval
+		}
+	}
+	return nil
+}
+
+func isExternalApprovalTimeout(externalApproval *storepb.IssuePayloadApproval_ExternalApproval, node *storepb.WorkspaceExternalApprovalSetting_Node, now time.Time) bool {
+	if node.Timeout == nil || node.Timeout.AsDuration() <= 0 || externalApproval.CreateTime == nil {
+		return false
+	}
+	return now.Sub(externalApproval.CreateTime.AsTime()) > node.Timeout.AsDuration()
+}
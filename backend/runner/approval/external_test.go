@@ -0,0 +1,132 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestGetPendingExternalApprovals(t *testing.T) {
+	a := require.New(t)
+	newApproval := func(approvers ...storepb.IssuePayloadApproval_Approver_Status) *storepb.IssuePayloadApproval {
+		approval := &storepb.IssuePayloadApproval{
+			ApprovalFindingDone: true,
+			ApprovalTemplate: &storepb.ApprovalTemplate{
+				Flow: &storepb.ApprovalFlow{
+					Roles: []string{"roles/projectOwner", "externalApprovalNodes/change-board", "roles/workspaceDBA"},
+				},
+			},
+		}
+		for _, status := range approvers {
+			approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+				Status:      status,
+				PrincipalId: 101,
+			})
+		}
+		return approval
+	}
+
+	// The pending step is a role.
+	a.Empty(getPendingExternalApprovals(newApproval()))
+
+	// The pending step is an external approval node.
+	pendings := getPendingExternalApprovals(newApproval(storepb.IssuePayloadApproval_Approver_APPROVED))
+	a.Len(pendings, 1)
+	a.Equal(&pendingExternalApproval{nodeID: "change-board", step: 1, approverSet: 0}, pendings[0])
+
+	// The external approval node has approved.
+	a.Empty(getPendingExternalApprovals(newApproval(storepb.IssuePayloadApproval_Approver_APPROVED, storepb.IssuePayloadApproval_Approver_APPROVED)))
+
+	// The issue has been rejected.
+	a.Empty(getPendingExternalApprovals(newApproval(storepb.IssuePayloadApproval_Approver_REJECTED)))
+
+	// The approval finding is not done.
+	approval := newApproval(storepb.IssuePayloadApproval_Approver_APPROVED)
+	approval.ApprovalFindingDone = false
+	a.Empty(getPendingExternalApprovals(approval))
+
+	// No approval is required.
+	a.Empty(getPendingExternalApprovals(&storepb.IssuePayloadApproval{ApprovalFindingDone: true}))
+
+	// The external approval nodes of a parallel step are pending at the same time.
+	approval = &storepb.IssuePayloadApproval{
+		ApprovalFindingDone: true,
+		ApprovalTemplate: &storepb.ApprovalTemplate{
+			Flow: &storepb.ApprovalFlow{
+				Steps: []*storepb.ApprovalStep{
+					{
+						Type: storepb.ApprovalStep_PARALLEL,
+						ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+							{Approvers: []string{"roles/projectOwner"}},
+							{Approvers: []string{"externalApprovalNodes/change-board"}},
+							{Approvers: []string{"externalApprovalNodes/security"}},
+						},
+					},
+				},
+			},
+		},
+		Approvers: []*storepb.IssuePayloadApproval_Approver{
+			{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 1, Step: 0, ApproverSet: 1},
+		},
+	}
+	pendings = getPendingExternalApprovals(approval)
+	a.Len(pendings, 1)
+	a.Equal(&pendingExternalApproval{nodeID: "security", step: 0, approverSet: 2}, pendings[0])
+	a.Nil(findPendingExternalApproval(approval, "change-board"))
+	a.NotNil(findPendingExternalApproval(approval, "security"))
+}
+
+func TestFindExternalApproval(t *testing.T) {
+	a := require.New(t)
+	approval := &storepb.IssuePayloadApproval{
+		ExternalApprovals: []*storepb.IssuePayloadApproval_ExternalApproval{
+			{Step: 0, NodeId: "change-board", RequestId: "r0"},
+			{Step: 2, NodeId: "change-board", RequestId: "r2"},
+		},
+	}
+	a.Equal("r2", findExternalApproval(approval, 2, "change-board").GetRequestId())
+	a.Nil(findExternalApproval(approval, 1, "change-board"))
+	a.Nil(findExternalApproval(approval, 0, "security"))
+}
+
+func TestIsExternalApprovalTimeout(t *testing.T) {
+	a := require.New(t)
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	externalApproval := &storepb.IssuePayloadApproval_ExternalApproval{
+		CreateTime: timestamppb.New(now.Add(-2 * time.Hour)),
+	}
+
+	a.False(isExternalApprovalTimeout(externalApproval, &storepb.WorkspaceExternalApprovalSetting_Node{}, now))
+	a.False(isExternalApprovalTimeout(externalApproval, &storepb.WorkspaceExternalApprovalSetting_Node{Timeout: durationpb.New(3 * time.Hour)}, now))
+	a.True(isExternalApprovalTimeout(externalApproval, &storepb.WorkspaceExternalApprovalSetting_Node{Timeout: durationpb.New(time.Hour)}, now))
+}
+
+func TestDoExternalApprovalRequest(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&externalApprovalStatusResponse{Status: ExternalApprovalStatusApproved, Comment: "LGTM"})
+	}))
+	defer server.Close()
+
+	var response externalApprovalStatusResponse
+	err := doExternalApprovalRequest(context.Background(), &storepb.WorkspaceExternalApprovalSetting_Node{Token: "secret"}, http.MethodGet, server.URL+"/r1", nil, &response)
+	a.NoError(err)
+	a.Equal(ExternalApprovalStatusApproved, response.Status)
+	a.Equal("LGTM", response.Comment)
+
+	err = doExternalApprovalRequest(context.Background(), &storepb.WorkspaceExternalApprovalSetting_Node{Token: "wrong"}, http.MethodGet, server.URL+"/r1", nil, &response)
+	a.Error(err)
+}
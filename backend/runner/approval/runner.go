@@ -58,6 +58,10 @@ const approvalRunnerInterval = 1 * time.Second
 func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	ticker := time.NewTicker(approvalRunnerInterval)
 	defer ticker.Stop()
+	grantExpiryTicker := time.NewTicker(grantExpiryInterval)
+	defer grantExpiryTicker.Stop()
+	externalApprovalTicker := time.NewTicker(externalApprovalInterval)
+	defer externalApprovalTicker.Stop()
 	defer wg.Done()
 	slog.Debug(fmt.Sprintf("Approval runner started and will run every %v", approvalRunnerInterval))
 	r.retryFindApprovalTemplate(ctx)
@@ -77,6 +81,32 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 				}()
 				r.runOnce(ctx)
 			}()
+		case <-grantExpiryTicker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Grant expiry PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.revokeExpiredGrants(ctx)
+			}()
+		case <-externalApprovalTicker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("External approval PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.processExternalApprovals(ctx)
+			}()
 		case <-ctx.Done():
 			return
 		}
@@ -248,8 +278,8 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 		if payload.Approval.ApprovalTemplate == nil {
 			return
 		}
-		role := utils.FindNextPendingRole(payload.Approval.ApprovalTemplate, payload.Approval.Approvers)
-		if role == "" {
+		approvers := utils.FindPendingApprovers(payload.Approval, nil)
+		if len(approvers) == 0 {
 			return
 		}
 		r.webhookManager.CreateEvent(ctx, &webhook.Event{
@@ -259,7 +289,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 			Issue:   webhook.NewIssue(issue),
 			Project: webhook.NewProject(issue.Project),
 			IssueApprovalCreate: &webhook.EventIssueApprovalCreate{
-				Role: role,
+				Approvers: approvers,
 			},
 		})
 	}()
@@ -642,8 +672,10 @@ func (r *Runner) getGrantRequestIssueRisk(ctx context.Context, issue *store.Issu
 	}
 	// Default to max float64 if expiration is not set. AKA no expiration.
 	expirationDays := math.MaxFloat64
+	expirationHours := math.MaxFloat64
 	if payload.GrantRequest.Expiration != nil {
-		expirationDays = payload.GrantRequest.Expiration.AsDuration().Hours() / 24
+		expirationHours = payload.GrantRequest.Expiration.AsDuration().Hours()
+		expirationDays = expirationHours / 24
 	}
 
 	databaseMap, err := r.getDatabaseMap(ctx, factors.Databases)
@@ -674,9 +706,10 @@ func (r *Runner) getGrantRequestIssueRisk(ctx context.Context, issue *store.Issu
 		}
 
 		args := map[string]any{
-			common.CELAttributeResourceProjectID:     issue.Project.ResourceID,
-			common.CELAttributeRequestExpirationDays: expirationDays,
-			common.CELAttributeRequestRole:           payload.GrantRequest.Role,
+			common.CELAttributeResourceProjectID:      issue.Project.ResourceID,
+			common.CELAttributeRequestExpirationDays:  expirationDays,
+			common.CELAttributeRequestExpirationHours: expirationHours,
+			common.CELAttributeRequestRole:            payload.GrantRequest.Role,
 		}
 		if len(factors.Databases) == 0 {
 			environments, err := r.store.GetEnvironmentSetting(ctx)
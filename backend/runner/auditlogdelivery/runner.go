@@ -0,0 +1,235 @@
+// Package auditlogdelivery is the runner for streaming the audit logs to the external SIEM sinks.
+package auditlogdelivery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	"github.com/bytebase/bytebase/backend/component/state"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/auditsink"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	auditLogDeliveryRunnerInterval = 5 * time.Second
+	// auditLogDeliveryBatchSize is the maximum number of audit logs delivered to a sink in each batch.
+	auditLogDeliveryBatchSize = 100
+	// auditLogDeliveryMaxBatches is the maximum number of batches delivered to a sink in each run,
+	// so that a sink catching up doesn't hold the runner.
+	auditLogDeliveryMaxBatches = 10
+	// auditLogGapTimeout is how long the audit log IDs skipped by the cursor are re-scanned.
+	// The audit logs may be committed out of the order of their IDs, and the IDs of the rolled back
+	// transactions are never filled.
+	auditLogGapTimeout = 10 * time.Minute
+)
+
+// Runner is the runner for streaming the audit logs to the external SIEM sinks.
+// The audit logs are buffered in the audit_log table, and the progress of each sink is persisted
+// as a cursor, so the delivery never blocks the API requests and resumes after restarts.
+// A new sink starts from the latest audit log when it's added, instead of replaying the whole history.
+type Runner struct {
+	store    *store.Store
+	stateCfg *state.State
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, stateCfg *state.State) *Runner {
+	return &Runner{
+		store:    store,
+		stateCfg: stateCfg,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(auditLogDeliveryRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Audit log delivery runner started and will run every %v", auditLogDeliveryRunnerInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Audit log delivery runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runOnce(ctx)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	setting, err := r.store.GetAuditLogSinkSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get audit log sink setting", log.BBError(err))
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, sink := range setting.Sinks {
+		wg.Add(1)
+		go func(sink *storepb.AuditLogSinkSetting_Sink) {
+			defer wg.Done()
+			if err := r.deliver(ctx, sink); err != nil {
+				slog.Warn("failed to deliver audit logs", slog.String("sink", sink.Id), log.BBError(err))
+			}
+		}(sink)
+	}
+	wg.Wait()
+}
+
+// deliver delivers the audit logs in the gaps and after the cursor of the sink, and advances the cursor after each batch.
+func (r *Runner) deliver(ctx context.Context, setting *storepb.AuditLogSinkSetting_Sink) error {
+	// Only one replica delivers to the sink in the HA deployment.
+	resource := fmt.Sprintf("audit_log_sinks/%s", setting.Id)
+	claimed, err := r.stateCfg.TryClaim(ctx, resource)
+	if err != nil {
+		return errors.Wrapf(err, "failed to claim the sink")
+	}
+	if !claimed {
+		return nil
+	}
+	defer r.stateCfg.Release(ctx, resource)
+
+	maxID, err := r.store.GetMaxAuditLogID(ctx)
+	if err != nil {
+		return err
+	}
+	cursor, ok, err := r.store.GetAuditLogSinkCursor(ctx, setting.Id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// A new sink starts from the latest audit log instead of replaying the whole history.
+		return r.store.UpsertAuditLogSinkCursor(ctx, setting.Id, maxID)
+	}
+
+	sink, err := auditsink.New(setting)
+	if err != nil {
+		return err
+	}
+	filterQ, err := store.GetSearchAuditLogsFilter(ctx, r.store, setting.Filter)
+	if err != nil {
+		return errors.Wrapf(err, "invalid filter %q", setting.Filter)
+	}
+
+	if err := r.deliverGaps(ctx, setting.Id, sink, filterQ); err != nil {
+		return err
+	}
+
+	limit := auditLogDeliveryBatchSize
+	for range auditLogDeliveryMaxBatches {
+		if cursor >= maxID {
+			return nil
+		}
+		// The batch is decided by the IDs regardless of the filter, so that the IDs skipped by the cursor
+		// are found. An audit log committed after listing the IDs is recorded as a gap and re-scanned,
+		// even if it's also delivered in this batch.
+		ids, err := r.store.ListAuditLogIDs(ctx, &store.AuditLogFind{
+			AfterID: &cursor,
+			MaxID:   &maxID,
+			Limit:   &limit,
+		})
+		if err != nil {
+			return err
+		}
+		next := maxID
+		if len(ids) == auditLogDeliveryBatchSize {
+			next = ids[len(ids)-1]
+		}
+		gaps := getGaps(cursor, next, ids)
+
+		logs, err := r.store.SearchAuditLogs(ctx, &store.AuditLogFind{
+			FilterQ:     filterQ,
+			AfterID:     &cursor,
+			MaxID:       &next,
+			OrderByKeys: []store.OrderByKey{{Key: "id", SortOrder: store.ASC}},
+		})
+		if err != nil {
+			return err
+		}
+		if len(logs) > 0 {
+			if err := sink.Send(ctx, logs); err != nil {
+				return err
+			}
+		}
+		if err := r.store.CreateAuditLogSinkGaps(ctx, setting.Id, gaps); err != nil {
+			return err
+		}
+		if err := r.store.UpsertAuditLogSinkCursor(ctx, setting.Id, next); err != nil {
+			return err
+		}
+		cursor = next
+	}
+	return nil
+}
+
+// deliverGaps delivers the audit logs committed in the gaps of the sink, and deletes the filled gaps.
+func (r *Runner) deliverGaps(ctx context.Context, sinkID string, sink auditsink.Sink, filterQ *qb.Query) error {
+	if err := r.store.DeleteExpiredAuditLogSinkGaps(ctx, sinkID, auditLogGapTimeout); err != nil {
+		return err
+	}
+	gaps, err := r.store.ListAuditLogSinkGaps(ctx, sinkID)
+	if err != nil {
+		return err
+	}
+	if len(gaps) == 0 {
+		return nil
+	}
+	// Only the filled gaps listed here are delivered and deleted, the gaps filled afterwards are left to the next run.
+	filled, err := r.store.ListAuditLogIDs(ctx, &store.AuditLogFind{IDs: &gaps})
+	if err != nil {
+		return err
+	}
+	if len(filled) == 0 {
+		return nil
+	}
+	logs, err := r.store.SearchAuditLogs(ctx, &store.AuditLogFind{
+		FilterQ:     filterQ,
+		IDs:         &filled,
+		OrderByKeys: []store.OrderByKey{{Key: "id", SortOrder: store.ASC}},
+	})
+	if err != nil {
+		return err
+	}
+	if len(logs) > 0 {
+		if err := sink.Send(ctx, logs); err != nil {
+			return err
+		}
+	}
+	return r.store.DeleteAuditLogSinkGaps(ctx, sinkID, filled)
+}
+
+// getGaps returns the IDs in (after, upTo] missing from the ascending IDs.
+func getGaps(after, upTo int, ids []int) []int {
+	var gaps []int
+	next := after + 1
+	for _, id := range ids {
+		for ; next < id; next++ {
+			gaps = append(gaps, next)
+		}
+		next = id + 1
+	}
+	for ; next <= upTo; next++ {
+		gaps = append(gaps, next)
+	}
+	return gaps
+}
@@ -0,0 +1,24 @@
+package auditlogdelivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGaps(t *testing.T) {
+	tests := []struct {
+		after int
+		upTo  int
+		ids   []int
+		want  []int
+	}{
+		{after: 100, upTo: 103, ids: []int{101, 102, 103}, want: nil},
+		{after: 100, upTo: 105, ids: []int{102, 105}, want: []int{101, 103, 104}},
+		{after: 100, upTo: 103, ids: []int{101}, want: []int{102, 103}},
+		{after: 100, upTo: 100, ids: nil, want: nil},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, getGaps(tc.after, tc.upTo, tc.ids))
+	}
+}
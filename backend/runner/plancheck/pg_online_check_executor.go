@@ -0,0 +1,115 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// NewPgOnlineCheckExecutor creates a PostgreSQL online migration check executor.
+func NewPgOnlineCheckExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &PgOnlineCheckExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// PgOnlineCheckExecutor is the PostgreSQL online migration check executor.
+// It validates the preconditions to migrate the table with a shadow table.
+type PgOnlineCheckExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run runs the PostgreSQL online migration check executor.
+func (e *PgOnlineCheckExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &config.InstanceId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance %s", config.InstanceId)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %s not found", config.InstanceId)
+	}
+	if engine := instance.Metadata.GetEngine(); engine != storepb.Engine_POSTGRES {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Unsupported engine",
+				Content: fmt.Sprintf("Online migration with a shadow table only supports PostgreSQL, got %s", engine),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	sheetUID := int(config.SheetUid)
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+	pgStatement, err := pgonline.ParseStatement(statement)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Unsupported statement",
+				Content: err.Error(),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Failed to connect to database",
+				Content: fmt.Sprintf("Cannot establish connection: %v", err),
+				Code:    common.Internal.Int32(),
+			},
+		}, nil
+	}
+	defer driver.Close(ctx)
+
+	problems, err := pgonline.Validate(ctx, driver.GetDB(), pgStatement)
+	if err != nil {
+		return nil, err
+	}
+	if len(problems) > 0 {
+		var results []*storepb.PlanCheckRunResult_Result
+		for _, problem := range problems {
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.Advice_ERROR,
+				Title:   "Online migration precondition not met",
+				Content: problem,
+				Code:    common.Internal.Int32(),
+			})
+		}
+		return results, nil
+	}
+
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.Advice_SUCCESS,
+			Title:   "OK",
+			Content: fmt.Sprintf("Table %q.%q can be migrated online", pgStatement.Schema, pgStatement.Table),
+			Code:    common.Ok.Int32(),
+		},
+	}, nil
+}
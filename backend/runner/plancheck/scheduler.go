@@ -105,6 +105,34 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 	if _, ok := s.stateCfg.RunningPlanChecks.Load(planCheckRun.UID); ok {
 		return
 	}
+	// Skip the plan check run if it is running by another replica in the HA deployment.
+	claimed, err := s.stateCfg.TryClaim(ctx, state.PlanCheckRunResource(planCheckRun.UID))
+	if err != nil {
+		slog.Error("failed to claim plan check run", slog.Int("uid", planCheckRun.UID), log.BBError(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+	// release the plan check run if we return below.
+	releasePlanCheckRun := true
+	defer func() {
+		if releasePlanCheckRun {
+			s.stateCfg.Release(ctx, state.PlanCheckRunResource(planCheckRun.UID))
+		}
+	}()
+	// The plan check run may be done by another replica after it's listed.
+	latestPlanCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		UIDs:   &[]int{planCheckRun.UID},
+		Status: &[]store.PlanCheckRunStatus{store.PlanCheckRunStatusRunning},
+	})
+	if err != nil {
+		slog.Error("failed to list plan check runs", slog.Int("uid", planCheckRun.UID), log.BBError(err))
+		return
+	}
+	if len(latestPlanCheckRuns) == 0 {
+		return
+	}
 
 	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &planCheckRun.Config.InstanceId})
 	if err != nil {
@@ -116,16 +144,18 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 	if maximumConnections <= 0 {
 		maximumConnections = common.DefaultInstanceMaximumConnections
 	}
-	if s.stateCfg.InstanceOutstandingConnections.Increment(instance.ResourceID, maximumConnections) {
+	if s.stateCfg.InstanceOutstandingConnections.Increment(ctx, instance.ResourceID, maximumConnections) {
 		return
 	}
 
 	s.stateCfg.RunningPlanChecks.Store(planCheckRun.UID, true)
+	releasePlanCheckRun = false
 	go func() {
 		defer func() {
 			s.stateCfg.RunningPlanChecks.Delete(planCheckRun.UID)
 			s.stateCfg.RunningPlanCheckRunsCancelFunc.Delete(planCheckRun.UID)
-			s.stateCfg.InstanceOutstandingConnections.Decrement(instance.ResourceID)
+			s.stateCfg.InstanceOutstandingConnections.Decrement(ctx, instance.ResourceID)
+			s.stateCfg.Release(ctx, state.PlanCheckRunResource(planCheckRun.UID))
 		}()
 
 		ctxWithCancel, cancel := context.WithCancel(ctx)
@@ -117,14 +117,14 @@ func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
 					if maximumConnections <= 0 {
 						maximumConnections = common.DefaultInstanceMaximumConnections
 					}
-					if s.stateCfg.InstanceOutstandingConnections.Increment(instance.ResourceID, maximumConnections) {
+					if s.stateCfg.InstanceOutstandingConnections.Increment(ctx, instance.ResourceID, maximumConnections) {
 						return true
 					}
 
 					s.databaseSyncMap.Delete(key)
 					dbwp.Go(func() {
 						defer func() {
-							s.stateCfg.InstanceOutstandingConnections.Decrement(instance.ResourceID)
+							s.stateCfg.InstanceOutstandingConnections.Decrement(ctx, instance.ResourceID)
 						}()
 						slog.Debug("Sync database schema", slog.String("instance", database.InstanceID), slog.String("database", database.DatabaseName))
 						if err := s.SyncDatabaseSchema(ctx, database); err != nil {
@@ -523,12 +523,12 @@ func (s *Syncer) databaseBackupAvailable(ctx context.Context, instance *store.In
 		return false
 	}
 	switch instance.Metadata.GetEngine() {
-	case storepb.Engine_POSTGRES:
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE:
 		if dbMetadata == nil {
 			return false
 		}
 		for _, schema := range dbMetadata.Schemas {
-			if schema.GetName() == common.BackupDatabaseNameOfEngine(storepb.Engine_POSTGRES) {
+			if schema.GetName() == common.BackupDatabaseNameOfEngine(instance.Metadata.GetEngine()) {
 				return true
 			}
 		}
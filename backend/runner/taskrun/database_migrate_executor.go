@@ -18,6 +18,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
+	"github.com/bytebase/bytebase/backend/component/pgonline"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -65,6 +66,8 @@ func (exec *DatabaseMigrateExecutor) RunOnce(ctx context.Context, driverCtx cont
 		return exec.runDMLMigration(ctx, driverCtx, task, taskRunUID)
 	case storepb.MigrationType_GHOST:
 		return exec.runGhostMigration(ctx, driverCtx, task, taskRunUID)
+	case storepb.MigrationType_PG_ONLINE:
+		return exec.runPgOnlineMigration(ctx, driverCtx, task, taskRunUID)
 	case storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
 		// Execute SQL without backup, same as DDL
 		return exec.runDDLMigration(ctx, driverCtx, task, taskRunUID)
@@ -268,6 +271,35 @@ func (exec *DatabaseMigrateExecutor) runGhostMigration(ctx context.Context, driv
 	return runMigrationWithFunc(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, statement, task.Payload.GetSchemaVersion(), &sheetID, execFunc)
 }
 
+func (exec *DatabaseMigrateExecutor) runPgOnlineMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (bool, *storepb.TaskRunResult, error) {
+	sheetID := int(task.Payload.GetSheetId())
+	statement, err := exec.store.GetSheetStatementByID(ctx, sheetID)
+	if err != nil {
+		return true, nil, err
+	}
+
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if instance == nil {
+		return true, nil, errors.Errorf("instance %s not found", task.InstanceID)
+	}
+	if engine := instance.Metadata.GetEngine(); engine != storepb.Engine_POSTGRES {
+		return true, nil, errors.Errorf("online migration with a shadow table only supports PostgreSQL, got %s", engine)
+	}
+
+	execFunc := func(execCtx context.Context, execStatement string, driver db.Driver, _ db.ExecuteOptions) error {
+		pgStatement, err := pgonline.ParseStatement(execStatement)
+		if err != nil {
+			return err
+		}
+		return pgonline.NewMigrator(driver.GetDB(), pgStatement, task.ID).Run(execCtx)
+	}
+
+	return runMigrationWithFunc(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.schemaSyncer, exec.profile, task, taskRunUID, statement, task.Payload.GetSchemaVersion(), &sheetID, execFunc)
+}
+
 func (exec *DatabaseMigrateExecutor) shouldSkipBackupError(ctx context.Context, task *store.TaskMessage) (bool, error) {
 	pipeline, pipelineErr := exec.store.GetPipelineV2ByID(ctx, task.PipelineID)
 	if pipelineErr != nil {
@@ -312,7 +344,7 @@ func (exec *DatabaseMigrateExecutor) backupData(
 		return nil, errors.Wrap(err, "failed to parse backup database")
 	}
 
-	if instance.Metadata.GetEngine() != storepb.Engine_POSTGRES {
+	if !backupInSourceDatabase(instance.Metadata.GetEngine()) {
 		backupDatabase, err = exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &backupInstanceID, DatabaseName: &backupDatabaseName})
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to get backup database")
@@ -412,6 +444,22 @@ func (exec *DatabaseMigrateExecutor) backupData(
 			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."%s" IS '%s, source table (%s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, database.DatabaseName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
 				return nil, errors.Wrap(err, "failed to set table comment")
 			}
+		case storepb.Engine_REDSHIFT:
+			schemaName := statement.SourceSchema
+			if schemaName == "" {
+				schemaName = "public"
+			}
+			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."%s" IS '%s, source table (%s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, schemaName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
+				return nil, errors.Wrap(err, "failed to set table comment")
+			}
+		case storepb.Engine_SNOWFLAKE:
+			schemaName := statement.SourceSchema
+			if schemaName == "" {
+				schemaName = "PUBLIC"
+			}
+			if _, err := driver.Execute(driverCtx, fmt.Sprintf(`COMMENT ON TABLE "%s"."%s" IS '%s, source table (%s, %s)'`, backupDatabaseName, statement.TargetTableName, bbSource, schemaName, statement.SourceTableName), db.ExecuteOptions{}); err != nil {
+				return nil, errors.Wrap(err, "failed to set table comment")
+			}
 		default:
 			// No action needed for other database engines
 		}
@@ -430,10 +478,10 @@ func (exec *DatabaseMigrateExecutor) backupData(
 			StartPosition: statement.StartPosition,
 			EndPosition:   statement.EndPosition,
 		}
-		if instance.Metadata.GetEngine() == storepb.Engine_POSTGRES {
+		if backupInSourceDatabase(instance.Metadata.GetEngine()) {
 			item.TargetTable = &storepb.PriorBackupDetail_Item_Table{
 				Database: sourceDatabaseName,
-				// postgres uses schema as the backup database name currently.
+				// postgres, redshift and snowflake use schema as the backup database name currently.
 				Schema: backupDatabaseName,
 				Table:  statement.TargetTableName,
 			}
@@ -463,7 +511,7 @@ func (exec *DatabaseMigrateExecutor) backupData(
 		}
 	}
 
-	if instance.Metadata.GetEngine() != storepb.Engine_POSTGRES {
+	if !backupInSourceDatabase(instance.Metadata.GetEngine()) {
 		if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, backupDatabase); err != nil {
 			slog.Error("failed to sync backup database schema",
 				slog.String("database", targetDatabaseName),
@@ -482,6 +530,17 @@ func (exec *DatabaseMigrateExecutor) backupData(
 	return priorBackupDetail, nil
 }
 
+// backupInSourceDatabase returns whether the prior backup tables are created in a schema of the source database
+// rather than in a separate backup database.
+func backupInSourceDatabase(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SNOWFLAKE:
+		return true
+	default:
+		return false
+	}
+}
+
 func buildGetDatabaseMetadataFunc(storeInstance *store.Store) parserbase.GetDatabaseMetadataFunc {
 	return func(ctx context.Context, instanceID, databaseName string) (string, *model.DatabaseMetadata, error) {
 		database, err := storeInstance.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
@@ -632,6 +632,8 @@ func convertTaskType(t *store.TaskMessage) (storepb.ChangelogPayload_Type, store
 			return storepb.ChangelogPayload_MIGRATE, storepb.MigrationType_DDL
 		case storepb.MigrationType_GHOST:
 			return storepb.ChangelogPayload_MIGRATE, storepb.MigrationType_GHOST
+		case storepb.MigrationType_PG_ONLINE:
+			return storepb.ChangelogPayload_MIGRATE, storepb.MigrationType_PG_ONLINE
 		case storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
 			return storepb.ChangelogPayload_MIGRATE, storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED
 		default:
@@ -301,7 +301,9 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 	// here, we move pending taskruns to running taskruns which means they are ready to be executed.
 	// pending taskruns remain pending if
 	// 1. taskRun.RunAt not met.
-	// 2. for versioned tasks, there are other versioned tasks on the same database with
+	// 2. the environment has a maintenance window policy and we are outside the windows.
+	// task runs with an explicit run time are exempt because BatchRunTasks checks the run time against the windows.
+	// 3. for versioned tasks, there are other versioned tasks on the same database with
 	// a smaller version not finished yet. we need to wait for those first.
 	task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
 	if err != nil {
@@ -311,6 +313,16 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 		return nil
 	}
 
+	if taskRun.RunAt == nil {
+		inWindow, err := s.checkMaintenanceWindow(ctx, taskRun, task)
+		if err != nil {
+			return errors.Wrapf(err, "failed to check maintenance window")
+		}
+		if !inWindow {
+			return nil
+		}
+	}
+
 	doSchedule, err := func() (bool, error) {
 		if task.DatabaseName == nil {
 			return true, nil
@@ -362,6 +374,41 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 	return nil
 }
 
+// checkMaintenanceWindow returns whether the task run is within the maintenance window of the task environment.
+// If not, it reports the next window as the waiting cause.
+func (s *SchedulerV2) checkMaintenanceWindow(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage) (bool, error) {
+	if task.Environment == "" {
+		return true, nil
+	}
+	policy, err := s.store.GetMaintenanceWindowPolicy(ctx, task.Environment)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get maintenance window policy")
+	}
+	if policy == nil {
+		return true, nil
+	}
+	window, err := common.NewMaintenanceWindow(policy)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid maintenance window policy for environment %s", task.Environment)
+	}
+	now := time.Now()
+	if window.Contains(now) {
+		s.stateCfg.TaskRunSchedulerInfo.Delete(taskRun.ID)
+		return true, nil
+	}
+	s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
+		ReportTime: timestamppb.Now(),
+		WaitingCause: &storepb.SchedulerInfo_WaitingCause{
+			Cause: &storepb.SchedulerInfo_WaitingCause_MaintenanceWindow_{
+				MaintenanceWindow: &storepb.SchedulerInfo_WaitingCause_MaintenanceWindow{
+					NextWindowTime: timestamppb.New(window.Next(now)),
+				},
+			},
+		},
+	})
+	return false, nil
+}
+
 func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
 		Status: &[]storepb.TaskRun_Status{storepb.TaskRun_RUNNING},
@@ -410,6 +457,30 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	if _, ok := s.stateCfg.RunningTaskRuns.Load(taskRun.ID); ok {
 		return nil
 	}
+	// Skip the task run if it is executed by another replica in the HA deployment.
+	claimed, err := s.stateCfg.TryClaim(ctx, state.TaskRunResource(taskRun.ID))
+	if err != nil {
+		return errors.Wrapf(err, "failed to claim task run")
+	}
+	if !claimed {
+		return nil
+	}
+	// release the task run if we return below.
+	releaseTaskRun := true
+	defer func() {
+		if releaseTaskRun {
+			s.stateCfg.Release(ctx, state.TaskRunResource(taskRun.ID))
+		}
+	}()
+	// The task run may be done by another replica after it's listed.
+	latestTaskRun, err := s.store.GetTaskRunByUID(ctx, taskRun.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get task run")
+	}
+	if latestTaskRun == nil || latestTaskRun.Status != storepb.TaskRun_RUNNING {
+		return nil
+	}
+
 	task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get task")
@@ -459,7 +530,7 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	if maximumConnections <= 0 {
 		maximumConnections = common.DefaultInstanceMaximumConnections
 	}
-	if s.stateCfg.InstanceOutstandingConnections.Increment(task.InstanceID, maximumConnections) {
+	if s.stateCfg.InstanceOutstandingConnections.Increment(ctx, task.InstanceID, maximumConnections) {
 		s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
 			ReportTime: timestamppb.Now(),
 			WaitingCause: &storepb.SchedulerInfo_WaitingCause{
@@ -474,7 +545,7 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	revertInstanceConnectionsIncrement := true
 	defer func() {
 		if revertInstanceConnectionsIncrement {
-			s.stateCfg.InstanceOutstandingConnections.Decrement(task.InstanceID)
+			s.stateCfg.InstanceOutstandingConnections.Decrement(ctx, task.InstanceID)
 		}
 	}()
 
@@ -500,7 +571,7 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	if maxRunningTaskRunsPerRollout <= 0 {
 		maxRunningTaskRunsPerRollout = defaultRolloutMaxRunningTaskRuns
 	}
-	if s.stateCfg.RolloutOutstandingTasks.Increment(rolloutID+"/"+task.InstanceID, maxRunningTaskRunsPerRollout) {
+	if s.stateCfg.RolloutOutstandingTasks.Increment(ctx, rolloutID+"/"+task.InstanceID, maxRunningTaskRunsPerRollout) {
 		s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
 			ReportTime: timestamppb.Now(),
 			WaitingCause: &storepb.SchedulerInfo_WaitingCause{
@@ -516,7 +587,7 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	revertRolloutConnectionsIncrement := true
 	defer func() {
 		if revertRolloutConnectionsIncrement {
-			s.stateCfg.RolloutOutstandingTasks.Decrement(rolloutID + "/" + task.InstanceID)
+			s.stateCfg.RolloutOutstandingTasks.Decrement(ctx, rolloutID+"/"+task.InstanceID)
 		}
 	}()
 
@@ -542,9 +613,10 @@ func (s *SchedulerV2) scheduleRunningTaskRun(ctx context.Context, taskRun *store
 	})
 
 	// We are sure that we will run the task.
-	// The executor will decrement them.
+	// The executor will decrement them and release the task run.
 	revertInstanceConnectionsIncrement = false
 	revertRolloutConnectionsIncrement = false
+	releaseTaskRun = false
 	go s.runTaskRunOnce(ctx, taskRun, task, executor)
 	return nil
 }
@@ -565,8 +637,9 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		if task.DatabaseName != nil {
 			s.stateCfg.RunningDatabaseMigration.Delete(getDatabaseKey(task.InstanceID, *task.DatabaseName))
 		}
-		s.stateCfg.InstanceOutstandingConnections.Decrement(task.InstanceID)
-		s.stateCfg.RolloutOutstandingTasks.Decrement(strconv.Itoa(task.PipelineID) + "/" + task.InstanceID)
+		s.stateCfg.InstanceOutstandingConnections.Decrement(ctx, task.InstanceID)
+		s.stateCfg.RolloutOutstandingTasks.Decrement(ctx, strconv.Itoa(task.PipelineID)+"/"+task.InstanceID)
+		s.stateCfg.Release(ctx, state.TaskRunResource(taskRun.ID))
 	}()
 
 	driverCtx, cancel := context.WithCancel(ctx)
@@ -1022,7 +1095,7 @@ func isSequentialTask(task *store.TaskMessage) bool {
 	case storepb.Task_DATABASE_MIGRATE:
 		// DDL, GHOST, and MIGRATE_TYPE_UNSPECIFIED (treated as DDL) operations should be sequential
 		switch task.Payload.GetMigrateType() {
-		case storepb.MigrationType_DDL, storepb.MigrationType_GHOST, storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
+		case storepb.MigrationType_DDL, storepb.MigrationType_GHOST, storepb.MigrationType_PG_ONLINE, storepb.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
 			return true
 		case storepb.MigrationType_DML:
 			return false
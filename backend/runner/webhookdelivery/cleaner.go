@@ -0,0 +1,67 @@
+package webhookdelivery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	webhookDeliveryCleanupInterval = 1 * time.Hour
+	webhookDeliveryRetentionPeriod = 30 * 24 * time.Hour
+)
+
+// Cleaner is the cleaner for expired webhook deliveries.
+// It runs periodically to delete webhook deliveries older than the retention period.
+type Cleaner struct {
+	store *store.Store
+}
+
+// NewCleaner creates a new Cleaner.
+func NewCleaner(store *store.Store) *Cleaner {
+	return &Cleaner{
+		store: store,
+	}
+}
+
+// Run starts the Cleaner.
+func (c *Cleaner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(webhookDeliveryCleanupInterval)
+	defer ticker.Stop()
+	slog.Debug(fmt.Sprintf("Webhook delivery cleaner started and will run every %v", webhookDeliveryCleanupInterval))
+
+	// Run once immediately on startup
+	if err := c.cleanup(ctx); err != nil {
+		slog.Error("Failed to run webhook delivery cleanup on startup", log.BBError(err))
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.cleanup(ctx); err != nil {
+				slog.Error("Failed to run webhook delivery cleanup", log.BBError(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Cleaner) cleanup(ctx context.Context) error {
+	rowsAffected, err := c.store.DeleteExpiredWebhookDeliveries(ctx, webhookDeliveryRetentionPeriod)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		slog.Info("Cleaned up expired webhook deliveries", slog.Int64("count", rowsAffected))
+	}
+
+	return nil
+}
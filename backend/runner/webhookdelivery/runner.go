@@ -0,0 +1,93 @@
+// Package webhookdelivery is the runner for retrying failed webhook deliveries.
+package webhookdelivery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	webhookDeliveryRunnerInterval = 10 * time.Second
+	// webhookDeliveryBatchSize is the maximum number of deliveries retried in each run.
+	webhookDeliveryBatchSize = 50
+)
+
+// Runner is the runner for retrying failed webhook deliveries.
+type Runner struct {
+	store          *store.Store
+	webhookManager *webhook.Manager
+}
+
+// NewRunner creates a new runner.
+func NewRunner(store *store.Store, webhookManager *webhook.Manager) *Runner {
+	return &Runner{
+		store:          store,
+		webhookManager: webhookManager,
+	}
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(webhookDeliveryRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", webhookDeliveryRunnerInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						err, ok := r.(error)
+						if !ok {
+							err = errors.Errorf("%v", r)
+						}
+						slog.Error("Webhook delivery runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+					}
+				}()
+				r.runOnce(ctx)
+			}()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	// Claimed deliveries are postponed by the lease, so other replicas won't retry them concurrently.
+	deliveries, err := r.store.ClaimWebhookDeliveries(ctx, webhook.DeliveryLease, webhookDeliveryBatchSize)
+	if err != nil {
+		slog.Error("failed to claim webhook deliveries", log.BBError(err))
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		hook, err := r.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{ID: &delivery.WebhookID})
+		if err != nil {
+			slog.Error("failed to get project webhook", slog.Int("webhook", delivery.WebhookID), log.BBError(err))
+			continue
+		}
+		if hook == nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.webhookManager.RetryDelivery(ctx, hook, delivery); err != nil {
+				slog.Error("failed to retry webhook delivery", slog.Int64("delivery", delivery.ID), log.BBError(err))
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -13,16 +13,21 @@ import (
 	connectcors "connectrpc.com/cors"
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
+	externalapproval "github.com/bytebase/bytebase/backend/api/external-approval"
+	iminteraction "github.com/bytebase/bytebase/backend/api/im-interaction"
 	"github.com/bytebase/bytebase/backend/api/lsp"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/runner/approval"
 )
 
 func configureEchoRouters(
 	e *echo.Echo,
 	lspServer *lsp.Server,
 	directorySyncServer *directorysync.Service,
+	externalApprovalServer *externalapproval.Service,
+	imInteractionServer *iminteraction.Service,
 	profile *config.Profile,
 ) {
 	e.Use(recoverMiddleware)
@@ -77,6 +82,10 @@ func configureEchoRouters(
 	hookGroup := e.Group(webhookAPIPrefix)
 	scimGroup := hookGroup.Group(scimAPIPrefix)
 	directorySyncServer.RegisterDirectorySyncRoutes(scimGroup)
+	externalApprovalGroup := hookGroup.Group(approval.ExternalApprovalCallbackPath)
+	externalApprovalServer.RegisterExternalApprovalRoutes(externalApprovalGroup)
+	imGroup := hookGroup.Group(imAPIPrefix)
+	imInteractionServer.RegisterIMInteractionRoutes(imGroup)
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
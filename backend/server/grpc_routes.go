@@ -85,7 +85,7 @@ func configureGrpcRouters(
 	issueService := apiv1.NewIssueService(stores, webhookManager, stateCfg, licenseService, profile, iamManager, metricReporter)
 	orgPolicyService := apiv1.NewOrgPolicyService(stores, licenseService)
 	planService := apiv1.NewPlanService(stores, sheetManager, licenseService, dbFactory, stateCfg, profile, iamManager)
-	projectService := apiv1.NewProjectService(stores, profile, iamManager, licenseService)
+	projectService := apiv1.NewProjectService(stores, profile, iamManager, licenseService, webhookManager)
 	releaseService := apiv1.NewReleaseService(stores, sheetManager, schemaSyncer, dbFactory, iamManager)
 	reviewConfigService := apiv1.NewReviewConfigService(stores, licenseService)
 	revisionService := apiv1.NewRevisionService(stores)
@@ -16,7 +16,10 @@ import (
 	"golang.org/x/net/http2"
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
+	externalapproval "github.com/bytebase/bytebase/backend/api/external-approval"
+	iminteraction "github.com/bytebase/bytebase/backend/api/im-interaction"
 	"github.com/bytebase/bytebase/backend/api/lsp"
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
@@ -33,12 +36,14 @@ import (
 	"github.com/bytebase/bytebase/backend/migrator"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/auditlogdelivery"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	runnermigrator "github.com/bytebase/bytebase/backend/runner/migrator"
 	"github.com/bytebase/bytebase/backend/runner/monitor"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/runner/webhookdelivery"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -46,6 +51,7 @@ const (
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
 	scimAPIPrefix    = "/scim"
+	imAPIPrefix      = "/im"
 	// lspAPI is the API for Bytebase Language Server Protocol.
 	lspAPI                 = "/lsp"
 	gracefulShutdownPeriod = 10 * time.Second
@@ -54,13 +60,16 @@ const (
 // Server is the Bytebase server.
 type Server struct {
 	// Asynchronous runners.
-	taskSchedulerV2      *taskrun.SchedulerV2
-	planCheckScheduler   *plancheck.Scheduler
-	metricReporter       *metricreport.Reporter
-	schemaSyncer         *schemasync.Syncer
-	approvalRunner       *approval.Runner
-	exportArchiveCleaner *runnermigrator.ExportArchiveCleaner
-	runnerWG             sync.WaitGroup
+	taskSchedulerV2        *taskrun.SchedulerV2
+	planCheckScheduler     *plancheck.Scheduler
+	metricReporter         *metricreport.Reporter
+	schemaSyncer           *schemasync.Syncer
+	approvalRunner         *approval.Runner
+	exportArchiveCleaner   *runnermigrator.ExportArchiveCleaner
+	webhookDeliveryRunner  *webhookdelivery.Runner
+	webhookDeliveryCleaner *webhookdelivery.Cleaner
+	auditLogDeliveryRunner *auditlogdelivery.Runner
+	runnerWG               sync.WaitGroup
 
 	webhookManager        *webhook.Manager
 	iamManager            *iam.Manager
@@ -125,7 +134,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	}
 
 	// Connect to the instance that stores bytebase's own metadata.
-	stores, err := store.New(ctx, pgURL, !profile.HA)
+	stores, err := store.New(ctx, pgURL, profile.HA)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to new store")
 	}
@@ -149,7 +158,12 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		slog.Warn("failed to start sample instances", log.BBError(err))
 	}
 
-	s.stateCfg, err = state.New()
+	// In the HA deployment, the replicas coordinate the runner states through the metadata database.
+	var cluster state.Cluster
+	if profile.HA {
+		cluster = stores
+	}
+	s.stateCfg, err = state.New(cluster)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create state config")
 	}
@@ -188,6 +202,8 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile)
 	s.schemaSyncer = schemasync.NewSyncer(stores, s.dbFactory, s.profile, s.stateCfg, s.licenseService)
 	s.approvalRunner = approval.NewRunner(stores, sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.licenseService)
+	s.webhookDeliveryRunner = webhookdelivery.NewRunner(stores, s.webhookManager)
+	s.auditLogDeliveryRunner = auditlogdelivery.NewRunner(stores, s.stateCfg)
 
 	s.taskSchedulerV2 = taskrun.NewSchedulerV2(stores, s.stateCfg, s.webhookManager, profile, s.licenseService)
 	s.taskSchedulerV2.Register(storepb.Task_DATABASE_CREATE, taskrun.NewDatabaseCreateExecutor(stores, s.dbFactory, s.schemaSyncer, s.stateCfg, profile))
@@ -202,11 +218,15 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementAdvise, statementAdviseExecutor)
 	ghostSyncExecutor := plancheck.NewGhostSyncExecutor(stores, s.dbFactory)
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
+	pgOnlineCheckExecutor := plancheck.NewPgOnlineCheckExecutor(stores, s.dbFactory)
+	s.planCheckScheduler.Register(store.PlanCheckDatabasePgOnlineCheck, pgOnlineCheckExecutor)
 	statementReportExecutor := plancheck.NewStatementReportExecutor(stores, sheetManager, s.dbFactory)
 	s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
 
 	// Export archive cleaner
 	s.exportArchiveCleaner = runnermigrator.NewExportArchiveCleaner(stores)
+	// Webhook delivery cleaner
+	s.webhookDeliveryCleaner = webhookdelivery.NewCleaner(stores)
 
 	// Metric reporter
 	s.initMetricReporter()
@@ -215,11 +235,13 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.lspServer = lsp.NewServer(s.store, profile, secret, s.stateCfg, s.iamManager, s.licenseService)
 
 	directorySyncServer := directorysync.NewService(s.store, s.licenseService, s.iamManager)
+	externalApprovalServer := externalapproval.NewService(s.store, s.licenseService, s.approvalRunner)
+	imInteractionServer := iminteraction.NewService(s.store, s.licenseService, apiv1.NewIssueService(s.store, s.webhookManager, s.stateCfg, s.licenseService, s.profile, s.iamManager, s.metricReporter))
 
 	if err := configureGrpcRouters(ctx, s.echoServer, s.store, sheetManager, s.dbFactory, s.licenseService, s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.webhookManager, s.iamManager, secret, s.sampleInstanceManager); err != nil {
 		return nil, errors.Wrapf(err, "failed to configure gRPC routers")
 	}
-	configureEchoRouters(s.echoServer, s.lspServer, directorySyncServer, profile)
+	configureEchoRouters(s.echoServer, s.lspServer, directorySyncServer, externalApprovalServer, imInteractionServer, profile)
 
 	serverStarted = true
 	return s, nil
@@ -231,11 +253,17 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.cancel = cancel
 	// runnerWG waits for all goroutines to complete.
 	s.runnerWG.Add(1)
+	go s.stateCfg.ListenCluster(ctx, &s.runnerWG)
+	s.runnerWG.Add(1)
 	go s.taskSchedulerV2.Run(ctx, &s.runnerWG)
 	s.runnerWG.Add(1)
 	go s.schemaSyncer.Run(ctx, &s.runnerWG)
 	s.runnerWG.Add(1)
 	go s.approvalRunner.Run(ctx, &s.runnerWG)
+	s.runnerWG.Add(1)
+	go s.webhookDeliveryRunner.Run(ctx, &s.runnerWG)
+	s.runnerWG.Add(1)
+	go s.auditLogDeliveryRunner.Run(ctx, &s.runnerWG)
 
 	s.runnerWG.Add(1)
 	go s.metricReporter.Run(ctx, &s.runnerWG)
@@ -246,6 +274,9 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.runnerWG.Add(1)
 	go s.exportArchiveCleaner.Run(ctx, &s.runnerWG)
 
+	s.runnerWG.Add(1)
+	go s.webhookDeliveryCleaner.Run(ctx, &s.runnerWG)
+
 	s.runnerWG.Add(1)
 	mmm := monitor.NewMemoryMonitor(s.profile)
 	go mmm.Run(ctx, &s.runnerWG)
@@ -50,6 +50,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oceanbase"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/pg"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/redshift"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/tidb"
 
@@ -60,6 +61,8 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/oracle"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/pg"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/redshift"
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/snowflake"
+	_ "github.com/bytebase/bytebase/backend/plugin/schema/spanner"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/tidb"
 	_ "github.com/bytebase/bytebase/backend/plugin/schema/trino"
 
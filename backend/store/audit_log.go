@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -20,7 +21,13 @@ import (
 type AuditLogMethod string
 
 // The methods other than v1 api.
-const AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+const (
+	AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+	// AuditLogMethodProjectGrantExpire is the method of revoking the expired roles granted by the grant requests.
+	AuditLogMethodProjectGrantExpire AuditLogMethod = "bb.project.grant.expire"
+	// AuditLogMethodIssueExternalApproval is the method of recording the decisions of the external approval nodes.
+	AuditLogMethodIssueExternalApproval AuditLogMethod = "bb.issue.external-approval"
+)
 
 func (m AuditLogMethod) String() string {
 	return string(m)
@@ -33,8 +40,14 @@ type AuditLog struct {
 }
 
 type AuditLogFind struct {
-	Project     *string
-	FilterQ     *qb.Query
+	Project *string
+	FilterQ *qb.Query
+	// AfterID finds the audit logs with the ID greater than it.
+	AfterID *int
+	// MaxID finds the audit logs with the ID less than or equal to it.
+	MaxID *int
+	// IDs finds the audit logs with the IDs.
+	IDs         *[]int
 	Limit       *int
 	Offset      *int
 	OrderByKeys []OrderByKey
@@ -74,6 +87,15 @@ func (s *Store) SearchAuditLogs(ctx context.Context, find *AuditLogFind) ([]*Aud
 	if v := find.Project; v != nil {
 		q.And("payload->>'parent' = ?", *v)
 	}
+	if v := find.AfterID; v != nil {
+		q.And("id > ?", *v)
+	}
+	if v := find.MaxID; v != nil {
+		q.And("id <= ?", *v)
+	}
+	if v := find.IDs; v != nil {
+		q.And("id = ANY(?)", *v)
+	}
 
 	if len(find.OrderByKeys) > 0 {
 		orderBy := []string{}
@@ -131,6 +153,201 @@ func (s *Store) SearchAuditLogs(ctx context.Context, find *AuditLogFind) ([]*Aud
 	return logs, nil
 }
 
+// GetMaxAuditLogID gets the ID of the latest audit log, 0 if there is no audit log.
+func (s *Store) GetMaxAuditLogID(ctx context.Context) (int, error) {
+	var id int
+	if err := s.GetDB().QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM audit_log").Scan(&id); err != nil {
+		return 0, errors.Wrapf(err, "failed to get max audit log id")
+	}
+	return id, nil
+}
+
+// ListAuditLogIDs lists the IDs of the audit logs in ascending order, ignoring the filter.
+func (s *Store) ListAuditLogIDs(ctx context.Context, find *AuditLogFind) ([]int, error) {
+	q := qb.Q().Space("SELECT id FROM audit_log WHERE TRUE")
+	if v := find.AfterID; v != nil {
+		q.And("id > ?", *v)
+	}
+	if v := find.MaxID; v != nil {
+		q.And("id <= ?", *v)
+	}
+	if v := find.IDs; v != nil {
+		q.And("id = ANY(?)", *v)
+	}
+	q.Space("ORDER BY id ASC")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list audit log ids")
+	}
+	defer rows.Close()
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to query")
+	}
+	return ids, nil
+}
+
+// GetAuditLogSinkCursor gets the ID of the last audit log delivered to the sink.
+// It returns false if the sink has never delivered any audit log.
+func (s *Store) GetAuditLogSinkCursor(ctx context.Context, sink string) (int, bool, error) {
+	q := qb.Q().Space("SELECT audit_log_id FROM audit_log_sink_cursor WHERE sink = ?", sink)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "failed to build sql")
+	}
+
+	var id int
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, errors.Wrapf(err, "failed to get audit log sink cursor")
+	}
+	return id, true, nil
+}
+
+// UpsertAuditLogSinkCursor records the ID of the last audit log delivered to the sink.
+func (s *Store) UpsertAuditLogSinkCursor(ctx context.Context, sink string, auditLogID int) error {
+	q := qb.Q().Space(`
+		INSERT INTO audit_log_sink_cursor (sink, audit_log_id) VALUES (?, ?)
+		ON CONFLICT (sink) DO UPDATE SET
+			audit_log_id = EXCLUDED.audit_log_id,
+			updated_at = now()
+	`, sink, auditLogID)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to upsert audit log sink cursor")
+	}
+	return nil
+}
+
+// ListAuditLogSinkGaps lists the audit log IDs skipped by the cursor of the sink.
+func (s *Store) ListAuditLogSinkGaps(ctx context.Context, sink string) ([]int, error) {
+	q := qb.Q().Space("SELECT audit_log_id FROM audit_log_sink_gap WHERE sink = ? ORDER BY audit_log_id", sink)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list audit log sink gaps")
+	}
+	defer rows.Close()
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to query")
+	}
+	return ids, nil
+}
+
+// CreateAuditLogSinkGaps records the audit log IDs skipped by the cursor of the sink.
+func (s *Store) CreateAuditLogSinkGaps(ctx context.Context, sink string, auditLogIDs []int) error {
+	if len(auditLogIDs) == 0 {
+		return nil
+	}
+	q := qb.Q().Space(`
+		INSERT INTO audit_log_sink_gap (sink, audit_log_id)
+		SELECT ?, unnest(?::BIGINT[])
+		ON CONFLICT DO NOTHING
+	`, sink, auditLogIDs)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to create audit log sink gaps")
+	}
+	return nil
+}
+
+// DeleteAuditLogSinkGaps deletes the gaps of the sink which are filled.
+func (s *Store) DeleteAuditLogSinkGaps(ctx context.Context, sink string, auditLogIDs []int) error {
+	if len(auditLogIDs) == 0 {
+		return nil
+	}
+	q := qb.Q().Space("DELETE FROM audit_log_sink_gap WHERE sink = ? AND audit_log_id = ANY(?)", sink, auditLogIDs)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete audit log sink gaps")
+	}
+	return nil
+}
+
+// DeleteExpiredAuditLogSinkGaps deletes the gaps of the sink recorded longer than the timeout ago,
+// which are left by the rolled back transactions. The timeout is measured by the clock of the database.
+func (s *Store) DeleteExpiredAuditLogSinkGaps(ctx context.Context, sink string, timeout time.Duration) error {
+	q := qb.Q().Space("DELETE FROM audit_log_sink_gap WHERE sink = ? AND created_at < now() - make_interval(secs => ?)", sink, timeout.Seconds())
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete expired audit log sink gaps")
+	}
+	return nil
+}
+
+// DeleteAuditLogSinkState deletes the cursor and the gaps of the sink, so a sink re-created with the same ID
+// starts from the latest audit log instead of the stale cursor.
+func (s *Store) DeleteAuditLogSinkState(ctx context.Context, sink string) error {
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	for _, q := range []*qb.Query{
+		qb.Q().Space("DELETE FROM audit_log_sink_cursor WHERE sink = ?", sink),
+		qb.Q().Space("DELETE FROM audit_log_sink_gap WHERE sink = ?", sink),
+	} {
+		query, args, err := q.ToSQL()
+		if err != nil {
+			return errors.Wrapf(err, "failed to build sql")
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return errors.Wrapf(err, "failed to delete audit log sink state")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	return nil
+}
+
 func GetSearchAuditLogsFilter(ctx context.Context, s *Store, filter string) (*qb.Query, error) {
 	if filter == "" {
 		return nil, nil
@@ -0,0 +1,430 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/qb"
+)
+
+const (
+	// clusterChannel is the LISTEN/NOTIFY channel shared by the replicas in the HA deployment.
+	clusterChannel = "bytebase_cluster"
+	// clusterEventMaxPayload is the max payload size of the notification, which is 8000 bytes by default in PostgreSQL.
+	clusterEventMaxPayload = 7900
+	// clusterListenRetryInterval is the interval to reconnect after the listening connection is broken.
+	clusterListenRetryInterval = 5 * time.Second
+	// clusterRelockTimeout is the timeout to lock the slots again after the lock connection is reset.
+	clusterRelockTimeout = 10 * time.Second
+)
+
+// ClusterEventType is the type of the event broadcast to the other replicas.
+type ClusterEventType string
+
+const (
+	// clusterEventCacheInvalidation invalidates the cache entries.
+	clusterEventCacheInvalidation ClusterEventType = "CACHE_INVALIDATION"
+	// ClusterEventCancelTaskRuns cancels the task runs executed by the replica.
+	ClusterEventCancelTaskRuns ClusterEventType = "CANCEL_TASK_RUNS"
+	// ClusterEventCancelPlanCheckRuns cancels the plan check runs executed by the replica.
+	ClusterEventCancelPlanCheckRuns ClusterEventType = "CANCEL_PLAN_CHECK_RUNS"
+	// ClusterEventTickleTaskRunScheduler tickles the task run scheduler.
+	ClusterEventTickleTaskRunScheduler ClusterEventType = "TICKLE_TASK_RUN_SCHEDULER"
+	// ClusterEventTicklePlanCheckScheduler tickles the plan check scheduler.
+	ClusterEventTicklePlanCheckScheduler ClusterEventType = "TICKLE_PLAN_CHECK_SCHEDULER"
+)
+
+// The names of the caches invalidated across the replicas.
+const (
+	userIDCacheName          = "user_id"
+	userEmailCacheName       = "user_email"
+	instanceCacheName        = "instance"
+	databaseCacheName        = "database"
+	projectCacheName         = "project"
+	policyCacheName          = "policy"
+	issueCacheName           = "issue"
+	issueByPipelineCacheName = "issue_by_pipeline"
+	pipelineCacheName        = "pipeline"
+	settingCacheName         = "setting"
+	idpCacheName             = "idp"
+	risksCacheName           = "risks"
+	databaseGroupCacheName   = "database_group"
+	rolesCacheName           = "roles"
+	groupCacheName           = "group"
+	sheetCacheName           = "sheet"
+	sheetStatementCacheName  = "sheet_statement"
+	dbMetadataCacheName      = "db_metadata"
+)
+
+// ClusterEvent is the event broadcast to the other replicas in the HA deployment.
+type ClusterEvent struct {
+	Type ClusterEventType `json:"type"`
+	// IDs are the IDs of the task runs or plan check runs to cancel.
+	IDs []int `json:"ids,omitempty"`
+	// Cache is the name of the cache to invalidate.
+	Cache string `json:"cache,omitempty"`
+	// Keys are the keys of the cache entries to invalidate. Empty keys purge the whole cache.
+	Keys []string `json:"keys,omitempty"`
+	// Origin is the replica sending the event, which ignores the event itself.
+	Origin string `json:"origin"`
+}
+
+// NotifyClusterEvent broadcasts the event to the other replicas.
+// It is a no-op if the deployment is not HA.
+func (s *Store) NotifyClusterEvent(ctx context.Context, event *ClusterEvent) error {
+	if !s.ha {
+		return nil
+	}
+	event.Origin = s.replicaID
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal cluster event")
+	}
+	if len(payload) > clusterEventMaxPayload {
+		if event.Type != clusterEventCacheInvalidation {
+			return errors.Errorf("cluster event %s is too large", event.Type)
+		}
+		// Purge the whole cache instead.
+		event.Keys = nil
+		if payload, err = json.Marshal(event); err != nil {
+			return errors.Wrapf(err, "failed to marshal cluster event")
+		}
+	}
+
+	q := qb.Q().Space("SELECT pg_notify(?, ?)", clusterChannel, string(payload))
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to notify cluster event")
+	}
+	return nil
+}
+
+// ListenClusterEvents listens to the events broadcast by the other replicas until the context is done.
+// The cache invalidation events are handled by the store, and the other events are passed to the handler.
+func (s *Store) ListenClusterEvents(ctx context.Context, handler func(*ClusterEvent)) {
+	for {
+		if err := s.listenClusterEvents(ctx, handler); err != nil && ctx.Err() == nil {
+			slog.Error("failed to listen cluster events", log.BBError(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(clusterListenRetryInterval):
+		}
+	}
+}
+
+func (s *Store) listenClusterEvents(ctx context.Context, handler func(*ClusterEvent)) error {
+	conn, err := s.GetDB().Conn(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("LISTEN %s", clusterChannel)); err != nil {
+		return errors.Wrapf(err, "failed to listen channel %s", clusterChannel)
+	}
+	// The events may be missed while the connection is broken, so the caches may be stale.
+	s.purgeCaches()
+
+	return conn.Raw(func(driverConn any) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.Errorf("unexpected driver connection type %T", driverConn)
+		}
+		for {
+			notification, err := pgxConn.Conn().WaitForNotification(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "failed to wait for notification")
+			}
+			var event ClusterEvent
+			if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+				slog.Warn("failed to unmarshal cluster event", slog.String("payload", notification.Payload), log.BBError(err))
+				continue
+			}
+			if event.Origin == s.replicaID {
+				continue
+			}
+			if event.Type == clusterEventCacheInvalidation {
+				s.invalidateCache(event.Cache, event.Keys)
+				continue
+			}
+			handler(&event)
+		}
+	})
+}
+
+// TryLockClusterResource tries to lock one of the slots of the resource across the replicas, and returns the locked slot.
+// The lock is the session-level advisory lock held by the dedicated connection of the replica,
+// so that it is released once the replica goes down.
+func (s *Store) TryLockClusterResource(ctx context.Context, resource string, slots int) (int, bool, error) {
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+
+	conn, err := s.getLockConn(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	// The advisory locks are re-entrant in the same session, so the slots locked by the replica must be skipped.
+	locked := []int{}
+	for slot := range s.lockedSlots[resource] {
+		locked = append(locked, slot)
+	}
+	// The filter is evaluated row by row until the first slot is locked, so that no other slots are locked.
+	q := qb.Q().Space("SELECT slot FROM generate_series(0, ?::INTEGER) AS slot WHERE CASE WHEN slot = ANY(?::INTEGER[]) THEN false ELSE pg_try_advisory_lock(hashtext(?), slot) END LIMIT 1", slots-1, locked, resource)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "failed to build sql")
+	}
+	var slot int
+	if err := conn.QueryRowContext(ctx, query, args...).Scan(&slot); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		s.resetLockConn(ctx)
+		return 0, false, errors.Wrapf(err, "failed to lock cluster resource %s", resource)
+	}
+	if s.lockedSlots[resource] == nil {
+		s.lockedSlots[resource] = map[int]bool{}
+	}
+	s.lockedSlots[resource][slot] = true
+	return slot, true, nil
+}
+
+// UnlockClusterResource unlocks the slot of the resource locked by TryLockClusterResource.
+func (s *Store) UnlockClusterResource(ctx context.Context, resource string, slot int) error {
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+
+	if !s.lockedSlots[resource][slot] {
+		// The lock is lost after the lock connection is reset.
+		return nil
+	}
+	delete(s.lockedSlots[resource], slot)
+	if len(s.lockedSlots[resource]) == 0 {
+		delete(s.lockedSlots, resource)
+	}
+
+	conn, err := s.getLockConn(ctx)
+	if err != nil {
+		return err
+	}
+	q := qb.Q().Space("SELECT pg_advisory_unlock(hashtext(?), ?::INTEGER)", resource, slot)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := conn.ExecContext(ctx, query, args...); err != nil {
+		s.resetLockConn(ctx)
+		return errors.Wrapf(err, "failed to unlock cluster resource %s", resource)
+	}
+	return nil
+}
+
+// SetClusterLockLostHandler sets the handler of the cluster resources whose locks are lost,
+// so that the replica stops executing them.
+func (s *Store) SetClusterLockLostHandler(handler func(resources []string)) {
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+	s.lockLostHandler = handler
+}
+
+// getLockConn returns the dedicated connection holding the advisory locks.
+// The caller must hold the lockMu.
+func (s *Store) getLockConn(ctx context.Context) (*sql.Conn, error) {
+	if s.lockConn != nil {
+		return s.lockConn, nil
+	}
+	conn, err := s.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection")
+	}
+	s.lockConn = conn
+	return conn, nil
+}
+
+// resetLockConn closes the broken lock connection, which releases all the advisory locks held by it,
+// and locks the slots locked by the replica again on a new connection.
+// The resources whose slots are locked by the other replicas in the meantime are passed to the lockLostHandler.
+// The caller must hold the lockMu.
+func (s *Store) resetLockConn(ctx context.Context) {
+	if s.lockConn == nil {
+		return
+	}
+	slog.Warn("the cluster lock connection is reset, and the locks held by the replica are locked again")
+	_ = s.lockConn.Close()
+	s.lockConn = nil
+
+	// The context of the caller may be canceled, which breaks the connection.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), clusterRelockTimeout)
+	defer cancel()
+	var lost []string
+	conn, err := s.getLockConn(ctx)
+	if err != nil {
+		slog.Error("failed to get the cluster lock connection", log.BBError(err))
+	}
+	for resource, slots := range s.lockedSlots {
+		for slot := range slots {
+			if conn != nil {
+				q := qb.Q().Space("SELECT pg_try_advisory_lock(hashtext(?), ?::INTEGER)", resource, slot)
+				query, args, err := q.ToSQL()
+				if err != nil {
+					slog.Error("failed to build sql", log.BBError(err))
+				} else {
+					var ok bool
+					if err := conn.QueryRowContext(ctx, query, args...).Scan(&ok); err != nil {
+						slog.Error("failed to lock cluster resource again", slog.String("resource", resource), log.BBError(err))
+					} else if ok {
+						continue
+					}
+				}
+			}
+			delete(slots, slot)
+			lost = append(lost, resource)
+		}
+		if len(slots) == 0 {
+			delete(s.lockedSlots, resource)
+		}
+	}
+	if len(lost) > 0 && s.lockLostHandler != nil {
+		slog.Warn("the cluster locks are lost", slog.Any("resources", lost))
+		// The handler may release the resources, which requires the lockMu.
+		go s.lockLostHandler(lost)
+	}
+}
+
+// notifyCacheInvalidation broadcasts the invalidation of the cache entries to the other replicas.
+func (s *Store) notifyCacheInvalidation(ctx context.Context, cache string, keys ...any) {
+	if !s.ha || len(keys) == 0 {
+		return
+	}
+	event := &ClusterEvent{
+		Type:  clusterEventCacheInvalidation,
+		Cache: cache,
+	}
+	for _, key := range keys {
+		event.Keys = append(event.Keys, fmt.Sprint(key))
+	}
+	if err := s.NotifyClusterEvent(ctx, event); err != nil {
+		slog.Warn("failed to notify cache invalidation", slog.String("cache", cache), log.BBError(err))
+	}
+}
+
+// notifyCachePurge broadcasts the purge of the whole cache to the other replicas.
+func (s *Store) notifyCachePurge(ctx context.Context, cache string) {
+	if !s.ha {
+		return
+	}
+	event := &ClusterEvent{
+		Type:  clusterEventCacheInvalidation,
+		Cache: cache,
+	}
+	if err := s.NotifyClusterEvent(ctx, event); err != nil {
+		slog.Warn("failed to notify cache purge", slog.String("cache", cache), log.BBError(err))
+	}
+}
+
+// invalidateCache removes the cache entries invalidated by the other replicas.
+func (s *Store) invalidateCache(cache string, keys []string) {
+	switch cache {
+	case userIDCacheName:
+		removeIntCacheKeys(s.userIDCache, keys)
+	case userEmailCacheName:
+		removeCacheKeys(s.userEmailCache, keys)
+	case instanceCacheName:
+		removeCacheKeys(s.instanceCache, keys)
+	case databaseCacheName:
+		removeCacheKeys(s.databaseCache, keys)
+	case projectCacheName:
+		removeCacheKeys(s.projectCache, keys)
+	case policyCacheName:
+		removeCacheKeys(s.policyCache, keys)
+	case issueCacheName:
+		removeIntCacheKeys(s.issueCache, keys)
+	case issueByPipelineCacheName:
+		removeIntCacheKeys(s.issueByPipelineCache, keys)
+	case pipelineCacheName:
+		removeIntCacheKeys(s.pipelineCache, keys)
+	case settingCacheName:
+		// The setting cache is small, so purge it instead of parsing the setting names.
+		s.settingCache.Purge()
+	case idpCacheName:
+		removeCacheKeys(s.idpCache, keys)
+	case risksCacheName:
+		s.risksCache.Purge()
+	case databaseGroupCacheName:
+		removeCacheKeys(s.databaseGroupCache, keys)
+	case rolesCacheName:
+		removeCacheKeys(s.rolesCache, keys)
+	case groupCacheName:
+		removeCacheKeys(s.groupCache, keys)
+	case sheetCacheName:
+		removeIntCacheKeys(s.sheetCache, keys)
+	case sheetStatementCacheName:
+		removeIntCacheKeys(s.sheetStatementCache, keys)
+	case dbMetadataCacheName:
+		removeCacheKeys(s.dbMetadataCache, keys)
+	default:
+		slog.Warn("unknown cache to invalidate", slog.String("cache", cache))
+	}
+}
+
+// purgeCaches purges all the caches.
+func (s *Store) purgeCaches() {
+	s.userIDCache.Purge()
+	s.userEmailCache.Purge()
+	s.instanceCache.Purge()
+	s.databaseCache.Purge()
+	s.projectCache.Purge()
+	s.policyCache.Purge()
+	s.issueCache.Purge()
+	s.issueByPipelineCache.Purge()
+	s.pipelineCache.Purge()
+	s.settingCache.Purge()
+	s.idpCache.Purge()
+	s.risksCache.Purge()
+	s.databaseGroupCache.Purge()
+	s.rolesCache.Purge()
+	s.groupCache.Purge()
+	s.sheetCache.Purge()
+	s.sheetStatementCache.Purge()
+	s.dbMetadataCache.Purge()
+}
+
+func removeCacheKeys[V any](cache *lru.Cache[string, V], keys []string) {
+	if len(keys) == 0 {
+		cache.Purge()
+		return
+	}
+	for _, key := range keys {
+		cache.Remove(key)
+	}
+}
+
+func removeIntCacheKeys[V any](cache *lru.Cache[int, V], keys []string) {
+	if len(keys) == 0 {
+		cache.Purge()
+		return
+	}
+	for _, key := range keys {
+		id, err := strconv.Atoi(key)
+		if err != nil {
+			cache.Purge()
+			return
+		}
+		cache.Remove(id)
+	}
+}
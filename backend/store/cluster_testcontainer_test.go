@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common/testcontainer"
+)
+
+func TestClusterResourceLockWithTestcontainer(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	container := testcontainer.GetTestPgContainer(ctx, t)
+	defer container.Close(ctx)
+
+	pgURL := fmt.Sprintf("postgres://postgres:root-password@%s:%s/postgres", container.GetHost(), container.GetPort())
+	replica1, err := New(ctx, pgURL, true)
+	a.NoError(err)
+	defer replica1.Close()
+	replica2, err := New(ctx, pgURL, true)
+	a.NoError(err)
+	defer replica2.Close()
+
+	// The advisory locks are re-entrant in the same session, so the replica must not lock the same slot twice.
+	slot, ok, err := replica1.TryLockClusterResource(ctx, "instance_connections/prod", 2)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(0, slot)
+	slot, ok, err = replica1.TryLockClusterResource(ctx, "instance_connections/prod", 2)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(1, slot)
+	_, ok, err = replica1.TryLockClusterResource(ctx, "instance_connections/prod", 2)
+	a.NoError(err)
+	a.False(ok)
+	_, ok, err = replica2.TryLockClusterResource(ctx, "instance_connections/prod", 2)
+	a.NoError(err)
+	a.False(ok)
+
+	a.NoError(replica1.UnlockClusterResource(ctx, "instance_connections/prod", 0))
+	slot, ok, err = replica2.TryLockClusterResource(ctx, "instance_connections/prod", 2)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(0, slot)
+
+	// The locks are locked again after the lock connection is reset.
+	var lost []string
+	replica1.SetClusterLockLostHandler(func(resources []string) { lost = resources })
+	replica1.lockMu.Lock()
+	replica1.resetLockConn(ctx)
+	replica1.lockMu.Unlock()
+	a.Empty(lost)
+	_, ok, err = replica2.TryLockClusterResource(ctx, "instance_connections/prod", 2)
+	a.NoError(err)
+	a.False(ok)
+}
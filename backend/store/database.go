@@ -78,7 +78,7 @@ type FindDatabaseMessage struct {
 // GetDatabaseV2 gets a database.
 func (s *Store) GetDatabaseV2(ctx context.Context, find *FindDatabaseMessage) (*DatabaseMessage, error) {
 	if find.InstanceID != nil && find.DatabaseName != nil {
-		if v, ok := s.databaseCache.Get(getDatabaseCacheKey(*find.InstanceID, *find.DatabaseName)); ok && s.enableCache {
+		if v, ok := s.databaseCache.Get(getDatabaseCacheKey(*find.InstanceID, *find.DatabaseName)); ok {
 			return v, nil
 		}
 	}
@@ -150,6 +150,7 @@ func (s *Store) CreateDatabaseDefault(ctx context.Context, create *DatabaseMessa
 
 	// Invalidate an update the cache.
 	s.databaseCache.Remove(getDatabaseCacheKey(create.InstanceID, create.DatabaseName))
+	s.notifyCacheInvalidation(ctx, databaseCacheName, getDatabaseCacheKey(create.InstanceID, create.DatabaseName))
 	return s.GetDatabaseV2(ctx, &FindDatabaseMessage{InstanceID: &create.InstanceID, DatabaseName: &create.DatabaseName, ShowDeleted: true})
 }
 
@@ -241,6 +242,7 @@ func (s *Store) UpsertDatabase(ctx context.Context, create *DatabaseMessage) (*D
 
 	// Invalidate and update the cache.
 	s.databaseCache.Remove(getDatabaseCacheKey(create.InstanceID, create.DatabaseName))
+	s.notifyCacheInvalidation(ctx, databaseCacheName, getDatabaseCacheKey(create.InstanceID, create.DatabaseName))
 	return s.GetDatabaseV2(ctx, &FindDatabaseMessage{InstanceID: &create.InstanceID, DatabaseName: &create.DatabaseName, ShowDeleted: true})
 }
 
@@ -307,6 +309,7 @@ func (s *Store) UpdateDatabase(ctx context.Context, patch *UpdateDatabaseMessage
 
 	// Invalidate and update database cache.
 	s.databaseCache.Remove(getDatabaseCacheKey(patch.InstanceID, patch.DatabaseName))
+	s.notifyCacheInvalidation(ctx, databaseCacheName, getDatabaseCacheKey(patch.InstanceID, patch.DatabaseName))
 	return s.GetDatabaseV2(ctx, &FindDatabaseMessage{InstanceID: &patch.InstanceID, DatabaseName: &patch.DatabaseName, ShowDeleted: true})
 }
 
@@ -369,6 +372,7 @@ func (s *Store) BatchUpdateDatabases(ctx context.Context, databases []*DatabaseM
 	}
 
 	var updatedDatabases []*DatabaseMessage
+	var cacheKeys []any
 	for _, database := range databases {
 		updatedDatabase := *database
 		// Update cache for project field.
@@ -390,8 +394,10 @@ func (s *Store) BatchUpdateDatabases(ctx context.Context, databases []*DatabaseM
 			}
 		}
 		s.databaseCache.Add(getDatabaseCacheKey(database.InstanceID, database.DatabaseName), &updatedDatabase)
+		cacheKeys = append(cacheKeys, getDatabaseCacheKey(database.InstanceID, database.DatabaseName))
 		updatedDatabases = append(updatedDatabases, &updatedDatabase)
 	}
+	s.notifyCacheInvalidation(ctx, databaseCacheName, cacheKeys...)
 	return updatedDatabases, nil
 }
 
@@ -52,6 +52,7 @@ func (s *Store) DeleteDatabaseGroup(ctx context.Context, projectID, resourceID s
 		return errors.Wrapf(err, "failed to commit transaction")
 	}
 	s.databaseGroupCache.Remove(getDatabaseGroupCacheKey(projectID, resourceID))
+	s.notifyCacheInvalidation(ctx, databaseGroupCacheName, getDatabaseGroupCacheKey(projectID, resourceID))
 	return nil
 }
 
@@ -81,7 +82,7 @@ func (s *Store) ListDatabaseGroups(ctx context.Context, find *FindDatabaseGroupM
 // GetDatabaseGroup gets a database group.
 func (s *Store) GetDatabaseGroup(ctx context.Context, find *FindDatabaseGroupMessage) (*DatabaseGroupMessage, error) {
 	if find.ProjectID != nil && find.ResourceID != nil {
-		if v, ok := s.databaseGroupCache.Get(getDatabaseGroupCacheKey(*find.ProjectID, *find.ResourceID)); ok && s.enableCache {
+		if v, ok := s.databaseGroupCache.Get(getDatabaseGroupCacheKey(*find.ProjectID, *find.ResourceID)); ok {
 			return v, nil
 		}
 	}
@@ -218,6 +219,7 @@ func (s *Store) UpdateDatabaseGroup(ctx context.Context, projectID, resourceID s
 	}
 	updatedDatabaseGroup.Expression = &expression
 	s.databaseGroupCache.Add(getDatabaseGroupCacheKey(updatedDatabaseGroup.ProjectID, updatedDatabaseGroup.ResourceID), &updatedDatabaseGroup)
+	s.notifyCacheInvalidation(ctx, databaseGroupCacheName, getDatabaseGroupCacheKey(updatedDatabaseGroup.ProjectID, updatedDatabaseGroup.ResourceID))
 	return &updatedDatabaseGroup, nil
 }
 
@@ -256,5 +258,6 @@ func (s *Store) CreateDatabaseGroup(ctx context.Context, create *DatabaseGroupMe
 	}
 
 	s.databaseGroupCache.Add(getDatabaseGroupCacheKey(create.ProjectID, create.ResourceID), create)
+	s.notifyCacheInvalidation(ctx, databaseGroupCacheName, getDatabaseGroupCacheKey(create.ProjectID, create.ResourceID))
 	return create, nil
 }
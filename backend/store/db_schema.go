@@ -25,7 +25,7 @@ type FindDBSchemaMessage struct {
 
 // GetDBSchema gets the schema for a database.
 func (s *Store) GetDBSchema(ctx context.Context, find *FindDBSchemaMessage) (*model.DatabaseMetadata, error) {
-	if v, ok := s.dbMetadataCache.Get(getDatabaseCacheKey(find.InstanceID, find.DatabaseName)); ok && s.enableCache {
+	if v, ok := s.dbMetadataCache.Get(getDatabaseCacheKey(find.InstanceID, find.DatabaseName)); ok {
 		return v, nil
 	}
 
@@ -139,6 +139,7 @@ func (s *Store) UpsertDBSchema(
 	}
 
 	s.dbMetadataCache.Add(getDatabaseCacheKey(instanceID, databaseName), updatedDBSchema)
+	s.notifyCacheInvalidation(ctx, dbMetadataCacheName, getDatabaseCacheKey(instanceID, databaseName))
 	return nil
 }
 
@@ -176,6 +177,7 @@ func (s *Store) UpdateDBSchema(ctx context.Context, instanceID, databaseName str
 	}
 	// Invalid the cache and read the value again.
 	s.dbMetadataCache.Remove(getDatabaseCacheKey(instanceID, databaseName))
+	s.notifyCacheInvalidation(ctx, dbMetadataCacheName, getDatabaseCacheKey(instanceID, databaseName))
 	return nil
 }
 
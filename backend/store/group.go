@@ -44,7 +44,7 @@ type GroupMessage struct {
 
 // GetGroup gets a group.
 func (s *Store) GetGroup(ctx context.Context, email string) (*GroupMessage, error) {
-	if v, ok := s.groupCache.Get(email); ok && s.enableCache {
+	if v, ok := s.groupCache.Get(email); ok {
 		return v, nil
 	}
 
@@ -220,6 +220,7 @@ func (s *Store) CreateGroup(ctx context.Context, create *GroupMessage) (*GroupMe
 	}
 
 	s.groupCache.Add(create.Email, create)
+	s.notifyCacheInvalidation(ctx, groupCacheName, create.Email)
 	return create, nil
 }
 
@@ -286,6 +287,7 @@ func (s *Store) UpdateGroup(ctx context.Context, email string, patch *UpdateGrou
 	}
 
 	s.groupCache.Add(group.Email, &group)
+	s.notifyCacheInvalidation(ctx, groupCacheName, group.Email)
 	return &group, nil
 }
 
@@ -312,6 +314,7 @@ func (s *Store) DeleteGroup(ctx context.Context, email string) error {
 	}
 
 	s.groupCache.Remove(email)
+	s.notifyCacheInvalidation(ctx, groupCacheName, email)
 	return nil
 }
 
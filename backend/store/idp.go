@@ -94,13 +94,14 @@ func (s *Store) CreateIdentityProvider(ctx context.Context, create *IdentityProv
 	}
 
 	s.idpCache.Add(identityProvider.ResourceID, identityProvider)
+	s.notifyCacheInvalidation(ctx, idpCacheName, identityProvider.ResourceID)
 	return identityProvider, nil
 }
 
 // GetIdentityProvider gets an identity provider.
 func (s *Store) GetIdentityProvider(ctx context.Context, find *FindIdentityProviderMessage) (*IdentityProviderMessage, error) {
 	if find.ResourceID != nil {
-		if v, ok := s.idpCache.Get(*find.ResourceID); ok && s.enableCache {
+		if v, ok := s.idpCache.Get(*find.ResourceID); ok {
 			return v, nil
 		}
 	}
@@ -169,6 +170,7 @@ func (s *Store) UpdateIdentityProvider(ctx context.Context, patch *UpdateIdentit
 	}
 
 	s.idpCache.Add(identityProvider.ResourceID, identityProvider)
+	s.notifyCacheInvalidation(ctx, idpCacheName, identityProvider.ResourceID)
 	return identityProvider, nil
 }
 
@@ -305,6 +307,7 @@ func (s *Store) DeleteIdentityProvider(ctx context.Context, resourceID string) e
 	}
 
 	s.idpCache.Remove(resourceID)
+	s.notifyCacheInvalidation(ctx, idpCacheName, resourceID)
 	return nil
 }
 
@@ -52,7 +52,7 @@ type FindInstanceMessage struct {
 // GetInstanceV2 gets an instance by the resource_id.
 func (s *Store) GetInstanceV2(ctx context.Context, find *FindInstanceMessage) (*InstanceMessage, error) {
 	if find.ResourceID != nil {
-		if v, ok := s.instanceCache.Get(getInstanceCacheKey(*find.ResourceID)); ok && s.enableCache {
+		if v, ok := s.instanceCache.Get(getInstanceCacheKey(*find.ResourceID)); ok {
 			return v, nil
 		}
 	}
@@ -148,6 +148,7 @@ func (s *Store) CreateInstanceV2(ctx context.Context, instanceCreate *InstanceMe
 		Metadata:      instanceCreate.Metadata,
 	}
 	s.instanceCache.Add(getInstanceCacheKey(instance.ResourceID), instance)
+	s.notifyCacheInvalidation(ctx, instanceCacheName, getInstanceCacheKey(instance.ResourceID))
 	return instance, nil
 }
 
@@ -218,6 +219,7 @@ func (s *Store) UpdateInstanceV2(ctx context.Context, patch *UpdateInstanceMessa
 
 	if v := patch.ResourceID; v != nil {
 		s.instanceCache.Remove(getInstanceCacheKey(*v))
+		s.notifyCacheInvalidation(ctx, instanceCacheName, getInstanceCacheKey(*v))
 		return s.GetInstanceV2(ctx, &FindInstanceMessage{ResourceID: v})
 	}
 
@@ -714,6 +716,7 @@ func (s *Store) DeleteInstance(ctx context.Context, resourceID string) error {
 
 	// Clear the instance from cache
 	s.instanceCache.Remove(getInstanceCacheKey(resourceID))
+	s.notifyCacheInvalidation(ctx, instanceCacheName, getInstanceCacheKey(resourceID))
 
 	return nil
 }
@@ -102,12 +102,12 @@ type FindIssueMessage struct {
 // GetIssueV2 gets issue by issue UID.
 func (s *Store) GetIssueV2(ctx context.Context, find *FindIssueMessage) (*IssueMessage, error) {
 	if find.UID != nil {
-		if v, ok := s.issueCache.Get(*find.UID); ok && s.enableCache {
+		if v, ok := s.issueCache.Get(*find.UID); ok {
 			return v, nil
 		}
 	}
 	if find.PipelineID != nil {
-		if v, ok := s.issueByPipelineCache.Get(*find.PipelineID); ok && s.enableCache {
+		if v, ok := s.issueByPipelineCache.Get(*find.PipelineID); ok {
 			return v, nil
 		}
 	}
@@ -255,8 +255,10 @@ func (s *Store) UpdateIssueV2(ctx context.Context, uid int, patch *UpdateIssueMe
 
 	// Invalid the cache and read the value again.
 	s.issueCache.Remove(uid)
+	s.notifyCacheInvalidation(ctx, issueCacheName, uid)
 	if oldIssue.PipelineUID != nil {
 		s.issueByPipelineCache.Remove(*oldIssue.PipelineUID)
+		s.notifyCacheInvalidation(ctx, issueByPipelineCacheName, *oldIssue.PipelineUID)
 	}
 	return s.GetIssueV2(ctx, &FindIssueMessage{UID: &uid})
 }
@@ -548,12 +550,17 @@ func (s *Store) BatchUpdateIssueStatuses(ctx context.Context, issueUIDs []int, s
 	}
 
 	// Invalidate caches
+	var issueKeys, pipelineKeys []any
 	for _, info := range infos {
 		s.issueCache.Remove(info.id)
+		issueKeys = append(issueKeys, info.id)
 		if info.pipelineUID != nil {
 			s.issueByPipelineCache.Remove(*info.pipelineUID)
+			pipelineKeys = append(pipelineKeys, *info.pipelineUID)
 		}
 	}
+	s.notifyCacheInvalidation(ctx, issueCacheName, issueKeys...)
+	s.notifyCacheInvalidation(ctx, issueByPipelineCacheName, pipelineKeys...)
 
 	return nil
 }
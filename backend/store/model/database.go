@@ -478,8 +478,9 @@ func (s *SchemaMetadata) ListTableNames() []string {
 		result = append(result, table.GetProto().GetName())
 	}
 
+	// Partitions are indexed as tables sharing the proto of the partitioned table, so the names are deduplicated.
 	slices.Sort(result)
-	return result
+	return slices.Compact(result)
 }
 
 // ListProcedureNames lists the procedure names.
@@ -205,7 +205,7 @@ func (*Store) createPipeline(ctx context.Context, txn *sql.Tx, create *PipelineM
 // GetPipelineV2 gets the pipeline.
 func (s *Store) GetPipelineV2(ctx context.Context, find *PipelineFind) (*PipelineMessage, error) {
 	if find.ID != nil {
-		if v, ok := s.pipelineCache.Get(*find.ID); ok && s.enableCache {
+		if v, ok := s.pipelineCache.Get(*find.ID); ok {
 			return v, nil
 		}
 	}
@@ -28,6 +28,8 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabasePgOnlineCheck is the plan check type for the PostgreSQL online migration preconditions.
+	PlanCheckDatabasePgOnlineCheck PlanCheckRunType = "bb.plan-check.database.pg-online.check"
 )
 
 // PlanCheckRunStatus is the status of a plan check run.
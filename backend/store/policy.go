@@ -129,6 +129,61 @@ func (s *Store) getIamPolicy(ctx context.Context, find *FindPolicyMessage) (*Iam
 	}, nil
 }
 
+// UpdateProjectIamPolicyWithEtag updates the project IAM policy if it's not changed since the etag.
+// The policy is re-read with the row locked and written in one transaction, so the concurrent updates are not overwritten.
+// It returns a conflict error if the etag doesn't match.
+func (s *Store) UpdateProjectIamPolicyWithEtag(ctx context.Context, projectID string, etag string, policy *storepb.IamPolicy) (*PolicyMessage, error) {
+	payload, err := protojson.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	resource := common.FormatProject(projectID)
+	query, args, err := qb.Q().Space("SELECT updated_at FROM policy WHERE resource_type = ? AND resource = ? AND type = ? FOR UPDATE",
+		storepb.Policy_PROJECT.String(), resource, storepb.Policy_IAM.String()).ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var updatedAt time.Time
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&updatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("iam policy for project %q not found", projectID)}
+		}
+		return nil, err
+	}
+	if generateEtag(updatedAt) != etag {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("iam policy for project %q has been updated concurrently", projectID)}
+	}
+
+	updated, err := upsertPolicyV2Impl(ctx, tx, &PolicyMessage{
+		Resource:          resource,
+		ResourceType:      storepb.Policy_PROJECT,
+		Payload:           string(payload),
+		Type:              storepb.Policy_IAM,
+		InheritFromParent: false,
+		// Enforce cannot be false while creating a policy.
+		Enforce: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.policyCache.Add(getPolicyCacheKey(updated.ResourceType, updated.Resource, updated.Type), updated)
+	s.notifyCacheInvalidation(ctx, policyCacheName, getPolicyCacheKey(updated.ResourceType, updated.Resource, updated.Type))
+
+	return updated, nil
+}
+
 // GetDefaultRolloutPolicy returns the default rollout policy when no custom policy exists.
 // This is used as a fallback for both API and store layers to ensure consistent defaults.
 // Default values:
@@ -173,6 +228,56 @@ func (s *Store) GetRolloutPolicy(ctx context.Context, environment string) (*stor
 	return p, nil
 }
 
+// GetMaintenanceWindowPolicy gets the maintenance window policy of the environment.
+// It returns nil if there is no enforced policy.
+func (s *Store) GetMaintenanceWindowPolicy(ctx context.Context, environment string) (*storepb.MaintenanceWindowPolicy, error) {
+	resource := common.FormatEnvironment(environment)
+	resourceType := storepb.Policy_ENVIRONMENT
+	pType := storepb.Policy_MAINTENANCE_WINDOW
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		Resource:     &resource,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get policy")
+	}
+	if policy == nil || !policy.Enforce {
+		return nil, nil
+	}
+
+	p := &storepb.MaintenanceWindowPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal maintenance window policy")
+	}
+
+	return p, nil
+}
+
+// GetProjectRowFilters gets the row filters of the enforced row filter policy of the project.
+func (s *Store) GetProjectRowFilters(ctx context.Context, projectID string) ([]*storepb.RowFilterPolicy_RowFilter, error) {
+	resourceType := storepb.Policy_PROJECT
+	resource := common.FormatProject(projectID)
+	pType := storepb.Policy_ROW_FILTER
+	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
+		ResourceType: &resourceType,
+		Resource:     &resource,
+		Type:         &pType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get row filter policy")
+	}
+	if policy == nil || !policy.Enforce {
+		return nil, nil
+	}
+
+	p := &storepb.RowFilterPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal row filter policy")
+	}
+	return p.Filters, nil
+}
+
 type EffectiveQueryDataPolicy struct {
 	MaximumResultSize        int64
 	MaximumResultRows        int32
@@ -419,7 +524,7 @@ type UpdatePolicyMessage struct {
 // GetPolicyV2 gets a policy.
 func (s *Store) GetPolicyV2(ctx context.Context, find *FindPolicyMessage) (*PolicyMessage, error) {
 	if find.ResourceType != nil && find.Resource != nil && find.Type != nil {
-		if v, ok := s.policyCache.Get(getPolicyCacheKey(*find.ResourceType, *find.Resource, *find.Type)); ok && s.enableCache {
+		if v, ok := s.policyCache.Get(getPolicyCacheKey(*find.ResourceType, *find.Resource, *find.Type)); ok {
 			return v, nil
 		}
 	}
@@ -499,6 +604,7 @@ func (s *Store) CreatePolicyV2(ctx context.Context, create *PolicyMessage) (*Pol
 	}
 
 	s.policyCache.Add(getPolicyCacheKey(policy.ResourceType, policy.Resource, policy.Type), policy)
+	s.notifyCacheInvalidation(ctx, policyCacheName, getPolicyCacheKey(policy.ResourceType, policy.Resource, policy.Type))
 
 	return policy, nil
 }
@@ -551,6 +657,7 @@ func (s *Store) UpdatePolicyV2(ctx context.Context, patch *UpdatePolicyMessage)
 	}
 
 	s.policyCache.Add(getPolicyCacheKey(policy.ResourceType, policy.Resource, policy.Type), policy)
+	s.notifyCacheInvalidation(ctx, policyCacheName, getPolicyCacheKey(policy.ResourceType, policy.Resource, policy.Type))
 
 	return policy, nil
 }
@@ -583,6 +690,7 @@ func (s *Store) DeletePolicyV2(ctx context.Context, policy *PolicyMessage) error
 	}
 
 	s.policyCache.Remove(getPolicyCacheKey(policy.ResourceType, policy.Resource, policy.Type))
+	s.notifyCacheInvalidation(ctx, policyCacheName, getPolicyCacheKey(policy.ResourceType, policy.Resource, policy.Type))
 	return nil
 }
 
@@ -88,7 +88,7 @@ func (s *Store) GetSystemBotUser(ctx context.Context) *UserMessage {
 
 // GetUserByID gets the user by ID.
 func (s *Store) GetUserByID(ctx context.Context, id int) (*UserMessage, error) {
-	if v, ok := s.userIDCache.Get(id); ok && s.enableCache {
+	if v, ok := s.userIDCache.Get(id); ok {
 		return v, nil
 	}
 
@@ -102,7 +102,7 @@ func (s *Store) GetUserByID(ctx context.Context, id int) (*UserMessage, error) {
 
 // GetUserByEmail gets the user by email.
 func (s *Store) GetUserByEmail(ctx context.Context, email string) (*UserMessage, error) {
-	if v, ok := s.userEmailCache.Get(email); ok && s.enableCache {
+	if v, ok := s.userEmailCache.Get(email); ok {
 		return v, nil
 	}
 
@@ -411,6 +411,8 @@ func (s *Store) CreateUser(ctx context.Context, create *UserMessage) (*UserMessa
 	}
 	s.userIDCache.Add(user.ID, user)
 	s.userEmailCache.Add(user.Email, user)
+	s.notifyCacheInvalidation(ctx, userIDCacheName, user.ID)
+	s.notifyCacheInvalidation(ctx, userEmailCacheName, user.Email)
 	return user, nil
 }
 
@@ -487,5 +489,7 @@ func (s *Store) UpdateUser(ctx context.Context, currentUser *UserMessage, patch
 
 	s.userIDCache.Add(currentUser.ID, user)
 	s.userEmailCache.Add(user.Email, user)
+	s.notifyCacheInvalidation(ctx, userIDCacheName, currentUser.ID)
+	s.notifyCacheInvalidation(ctx, userEmailCacheName, currentUser.Email, user.Email)
 	return user, nil
 }
@@ -56,7 +56,7 @@ type UpdateProjectMessage struct {
 // GetProjectV2 gets project by resource ID.
 func (s *Store) GetProjectV2(ctx context.Context, find *FindProjectMessage) (*ProjectMessage, error) {
 	if find.ResourceID != nil {
-		if v, ok := s.projectCache.Get(*find.ResourceID); ok && s.enableCache {
+		if v, ok := s.projectCache.Get(*find.ResourceID); ok {
 			return v, nil
 		}
 	}
@@ -181,6 +181,7 @@ func (s *Store) CreateProjectV2(ctx context.Context, create *ProjectMessage, cre
 	}
 
 	s.storeProjectCache(project)
+	s.notifyCacheInvalidation(ctx, projectCacheName, project.ResourceID)
 	return project, nil
 }
 
@@ -201,6 +202,7 @@ func (s *Store) UpdateProjectV2(ctx context.Context, patch *UpdateProjectMessage
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	s.notifyCacheInvalidation(ctx, projectCacheName, patch.ResourceID)
 
 	return s.GetProjectV2(ctx, &FindProjectMessage{ResourceID: &patch.ResourceID})
 }
@@ -232,6 +234,11 @@ func (s *Store) BatchUpdateProjectsV2(ctx context.Context, patches []*UpdateProj
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
+	var cacheKeys []any
+	for _, patch := range patches {
+		cacheKeys = append(cacheKeys, patch.ResourceID)
+	}
+	s.notifyCacheInvalidation(ctx, projectCacheName, cacheKeys...)
 
 	// Fetch and return all updated projects
 	var updatedProjects []*ProjectMessage
@@ -566,6 +573,7 @@ func (s *Store) DeleteProject(ctx context.Context, resourceID string) error {
 
 	// Clear the project from cache
 	s.projectCache.Remove(resourceID)
+	s.notifyCacheInvalidation(ctx, projectCacheName, resourceID)
 
 	return nil
 }
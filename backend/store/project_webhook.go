@@ -84,6 +84,7 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, projectID string, cr
 	}
 
 	s.removeProjectCache(projectID)
+	s.notifyCacheInvalidation(ctx, projectCacheName, projectID)
 	return &projectWebhook, nil
 }
 
@@ -184,6 +185,7 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, projectResourceID st
 	}
 
 	s.removeProjectCache(projectResourceID)
+	s.notifyCacheInvalidation(ctx, projectCacheName, projectResourceID)
 	return &projectWebhook, nil
 }
 
@@ -210,6 +212,7 @@ func (s *Store) DeleteProjectWebhookV2(ctx context.Context, projectResourceID st
 	}
 
 	s.removeProjectCache(projectResourceID)
+	s.notifyCacheInvalidation(ctx, projectCacheName, projectResourceID)
 	return nil
 }
 
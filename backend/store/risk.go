@@ -115,7 +115,7 @@ func (s *Store) GetRisk(ctx context.Context, id int64) (*RiskMessage, error) {
 // ListRisks lists risks.
 // returned risks are sorted by source, level DESC, id.
 func (s *Store) ListRisks(ctx context.Context) ([]*RiskMessage, error) {
-	if v, ok := s.risksCache.Get(0); ok && s.enableCache {
+	if v, ok := s.risksCache.Get(0); ok {
 		return v, nil
 	}
 
@@ -230,6 +230,7 @@ func (s *Store) CreateRisk(ctx context.Context, risk *RiskMessage) (*RiskMessage
 	}
 
 	s.risksCache.Remove(0)
+	s.notifyCacheInvalidation(ctx, risksCacheName, 0)
 	return &RiskMessage{
 		ID:         id,
 		Source:     risk.Source,
@@ -288,6 +289,7 @@ func (s *Store) UpdateRisk(ctx context.Context, patch *UpdateRiskMessage, id int
 	}
 
 	s.risksCache.Remove(0)
+	s.notifyCacheInvalidation(ctx, risksCacheName, 0)
 	return s.GetRisk(ctx, id)
 }
 
@@ -314,5 +316,6 @@ func (s *Store) DeleteRisk(ctx context.Context, id int64) error {
 	}
 
 	s.risksCache.Remove(0)
+	s.notifyCacheInvalidation(ctx, risksCacheName, 0)
 	return nil
 }
@@ -99,12 +99,13 @@ func (s *Store) CreateRole(ctx context.Context, create *RoleMessage) (*RoleMessa
 		return nil, err
 	}
 	s.rolesCache.Add(create.ResourceID, create)
+	s.notifyCacheInvalidation(ctx, rolesCacheName, create.ResourceID)
 	return create, nil
 }
 
 // GetRole returns a role by ID.
 func (s *Store) GetRole(ctx context.Context, resourceID string) (*RoleMessage, error) {
-	if v, ok := s.rolesCache.Get(resourceID); ok && s.enableCache {
+	if v, ok := s.rolesCache.Get(resourceID); ok {
 		return v, nil
 	}
 
@@ -242,6 +243,7 @@ func (s *Store) UpdateRole(ctx context.Context, patch *UpdateRoleMessage) (*Role
 	}
 
 	s.rolesCache.Add(role.ResourceID, role)
+	s.notifyCacheInvalidation(ctx, rolesCacheName, role.ResourceID)
 	return role, nil
 }
 
@@ -261,5 +263,6 @@ func (s *Store) DeleteRole(ctx context.Context, resourceID string) error {
 		return err
 	}
 	s.rolesCache.Remove(resourceID)
+	s.notifyCacheInvalidation(ctx, rolesCacheName, resourceID)
 	return nil
 }
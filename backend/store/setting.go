@@ -108,6 +108,40 @@ func (s *Store) GetWorkspaceApprovalSetting(ctx context.Context) (*storepb.Works
 	return payload, nil
 }
 
+// GetWorkspaceExternalApprovalSetting gets the workspace external approval setting.
+func (s *Store) GetWorkspaceExternalApprovalSetting(ctx context.Context) (*storepb.WorkspaceExternalApprovalSetting, error) {
+	setting, err := s.GetSettingV2(ctx, storepb.SettingName_WORKSPACE_EXTERNAL_APPROVAL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %v", storepb.SettingName_WORKSPACE_EXTERNAL_APPROVAL)
+	}
+	if setting == nil || setting.Value == "" {
+		return &storepb.WorkspaceExternalApprovalSetting{}, nil
+	}
+
+	payload := new(storepb.WorkspaceExternalApprovalSetting)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetAuditLogSinkSetting gets the audit log sink setting.
+func (s *Store) GetAuditLogSinkSetting(ctx context.Context) (*storepb.AuditLogSinkSetting, error) {
+	setting, err := s.GetSettingV2(ctx, storepb.SettingName_AUDIT_LOG_SINK)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %v", storepb.SettingName_AUDIT_LOG_SINK)
+	}
+	if setting == nil || setting.Value == "" {
+		return &storepb.AuditLogSinkSetting{}, nil
+	}
+
+	payload := new(storepb.AuditLogSinkSetting)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // GetSemanticTypesSetting gets the semantic types setting.
 func (s *Store) GetSemanticTypesSetting(ctx context.Context) (*storepb.SemanticTypeSetting, error) {
 	setting, err := s.GetSettingV2(ctx, storepb.SettingName_SEMANTIC_TYPES)
@@ -172,6 +206,23 @@ func (s *Store) GetAISetting(ctx context.Context) (*storepb.AISetting, error) {
 	return aiSetting, nil
 }
 
+// GetEmailSetting gets the email setting.
+func (s *Store) GetEmailSetting(ctx context.Context) (*storepb.EmailSetting, error) {
+	emailSetting := &storepb.EmailSetting{}
+	setting, err := s.GetSettingV2(ctx, storepb.SettingName_EMAIL)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return emailSetting, nil
+	}
+
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), emailSetting); err != nil {
+		return nil, err
+	}
+	return emailSetting, nil
+}
+
 func (s *Store) GetEnvironmentSetting(ctx context.Context) (*storepb.EnvironmentSetting, error) {
 	envSetting := &storepb.EnvironmentSetting{}
 	setting, err := s.GetSettingV2(ctx, storepb.SettingName_ENVIRONMENT)
@@ -188,17 +239,20 @@ func (s *Store) GetEnvironmentSetting(ctx context.Context) (*storepb.Environment
 	return envSetting, nil
 }
 
-// DeleteCache deletes the cache.
-func (s *Store) DeleteCache() {
+// DeleteCache deletes the cache, and the caches of the other replicas as well.
+func (s *Store) DeleteCache(ctx context.Context) {
 	s.settingCache.Purge()
 	s.policyCache.Purge()
 	s.userEmailCache.Purge()
 	s.userIDCache.Purge()
+	for _, cache := range []string{settingCacheName, policyCacheName, userEmailCacheName, userIDCacheName} {
+		s.notifyCachePurge(ctx, cache)
+	}
 }
 
 // GetSettingV2 returns the setting by name.
 func (s *Store) GetSettingV2(ctx context.Context, name storepb.SettingName) (*SettingMessage, error) {
-	if v, ok := s.settingCache.Get(name); ok && s.enableCache {
+	if v, ok := s.settingCache.Get(name); ok {
 		return v, nil
 	}
 
@@ -304,12 +358,13 @@ func (s *Store) UpsertSettingV2(ctx context.Context, update *SetSettingMessage)
 		return nil, errors.Wrap(err, "failed to commit transaction")
 	}
 	s.settingCache.Add(setting.Name, &setting)
+	s.notifyCacheInvalidation(ctx, settingCacheName, setting.Name)
 	return &setting, nil
 }
 
 // CreateSettingIfNotExistV2 creates a new setting only if the named setting doesn't exist.
 func (s *Store) CreateSettingIfNotExistV2(ctx context.Context, create *SettingMessage) (*SettingMessage, bool, error) {
-	if v, ok := s.settingCache.Get(create.Name); ok && s.enableCache {
+	if v, ok := s.settingCache.Get(create.Name); ok {
 		return v, false, nil
 	}
 
@@ -359,6 +414,7 @@ func (s *Store) CreateSettingIfNotExistV2(ctx context.Context, create *SettingMe
 		return nil, false, errors.Wrap(err, "failed to commit transaction")
 	}
 	s.settingCache.Add(setting.Name, &setting)
+	s.notifyCacheInvalidation(ctx, settingCacheName, setting.Name)
 	return &setting, true, nil
 }
 
@@ -385,6 +441,7 @@ func (s *Store) DeleteSettingV2(ctx context.Context, name storepb.SettingName) e
 	}
 
 	s.settingCache.Remove(name)
+	s.notifyCacheInvalidation(ctx, settingCacheName, name)
 	return nil
 }
 
@@ -5,7 +5,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync"
 
+	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
 
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -15,7 +17,18 @@ import (
 // Store provides database access to all raw objects.
 type Store struct {
 	dbConnManager *DBConnectionManager
-	enableCache   bool
+
+	// ha is true if the store is shared by multiple replicas, and the caches are invalidated across the replicas.
+	ha bool
+	// replicaID identifies the replica in the events broadcast to the other replicas.
+	replicaID string
+	// lockConn is the dedicated connection holding the advisory locks of the replica.
+	lockMu   sync.Mutex
+	lockConn *sql.Conn
+	// lockedSlots is the slots of the cluster resources locked by the replica.
+	lockedSlots map[string]map[int]bool
+	// lockLostHandler handles the cluster resources whose locks are lost after the lock connection is reset.
+	lockLostHandler func(resources []string)
 
 	// Cache.
 	Secret               string
@@ -43,7 +56,8 @@ type Store struct {
 
 // New creates a new instance of Store.
 // pgURL can be either a direct PostgreSQL URL or a file path containing the URL.
-func New(ctx context.Context, pgURL string, enableCache bool) (*Store, error) {
+// ha is true if the store is shared by multiple replicas in the HA deployment.
+func New(ctx context.Context, pgURL string, ha bool) (*Store, error) {
 	userIDCache, err := lru.New[int, *UserMessage](32768)
 	if err != nil {
 		return nil, err
@@ -125,7 +139,9 @@ func New(ctx context.Context, pgURL string, enableCache bool) (*Store, error) {
 
 	s := &Store{
 		dbConnManager: dbConnManager,
-		enableCache:   enableCache,
+		ha:            ha,
+		replicaID:     uuid.NewString(),
+		lockedSlots:   map[string]map[int]bool{},
 
 		// Cache.
 		userIDCache:          userIDCache,
@@ -153,6 +169,12 @@ func New(ctx context.Context, pgURL string, enableCache bool) (*Store, error) {
 
 // Close closes underlying db.
 func (s *Store) Close() error {
+	s.lockMu.Lock()
+	if s.lockConn != nil {
+		_ = s.lockConn.Close()
+		s.lockConn = nil
+	}
+	s.lockMu.Unlock()
 	return s.dbConnManager.Close()
 }
 
@@ -227,6 +227,7 @@ func (s *Store) UpdateTaskRunStatus(ctx context.Context, patch *TaskRunStatusPat
 
 	// Invalidate pipeline cache since UpdatedAt depends on task run updates
 	s.pipelineCache.Remove(pipelineID)
+	s.notifyCacheInvalidation(ctx, pipelineCacheName, pipelineID)
 
 	return taskRun, nil
 }
@@ -252,6 +253,7 @@ func (s *Store) UpdateTaskRunStartAt(ctx context.Context, taskRunID int) error {
 
 	// Invalidate pipeline cache since UpdatedAt depends on task run updates
 	s.pipelineCache.Remove(pipelineID)
+	s.notifyCacheInvalidation(ctx, pipelineCacheName, pipelineID)
 
 	return nil
 }
@@ -427,9 +429,12 @@ func (s *Store) BatchCancelTaskRuns(ctx context.Context, taskRunIDs []int) error
 	}
 
 	// Invalidate pipeline caches since UpdatedAt depends on task run updates
+	var cacheKeys []any
 	for _, pipelineID := range pipelineIDs {
 		s.pipelineCache.Remove(pipelineID)
+		cacheKeys = append(cacheKeys, pipelineID)
 	}
+	s.notifyCacheInvalidation(ctx, pipelineCacheName, cacheKeys...)
 
 	return nil
 }
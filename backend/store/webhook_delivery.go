@@ -0,0 +1,365 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the status of a delivery being attempted or waiting for a retry.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is the status of a delivery accepted by the webhook endpoint.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is the status of a delivery that failed and will not be retried automatically.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookDeliveryMessage is the store model for a webhook delivery.
+type WebhookDeliveryMessage struct {
+	ProjectID string
+	WebhookID int
+	EventType storepb.Activity_Type
+	Status    WebhookDeliveryStatus
+	Attempts  int
+	Payload   *storepb.WebhookDeliveryPayload
+	// NextAttemptAt is the time of the next automatic retry, nil if no retry is scheduled.
+	NextAttemptAt *time.Time
+	// LeaseExpireAt is the time until which the delivery is being attempted, nil if it's not being attempted.
+	LeaseExpireAt *time.Time
+
+	// Output only fields.
+	ID        int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	ID        *int64
+	ProjectID *string
+	WebhookID *int
+	Limit     *int
+	Offset    *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery.
+type UpdateWebhookDeliveryMessage struct {
+	ID int64
+
+	// Status clears the scheduled retry unless it is WebhookDeliveryPending.
+	Status        *WebhookDeliveryStatus
+	Attempts      *int
+	Payload       *storepb.WebhookDeliveryPayload
+	NextAttemptAt *time.Time
+	// ReleaseLease releases the lease of the delivery after the attempt.
+	ReleaseLease bool
+}
+
+// CreateWebhookDelivery creates a webhook delivery.
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO webhook_delivery (
+			project,
+			webhook_id,
+			event_type,
+			status,
+			attempts,
+			payload,
+			next_attempt_at,
+			lease_expire_at
+		) VALUES (
+			?,
+			?,
+			?,
+			?,
+			?,
+			?,
+			?,
+			?
+		) RETURNING id, created_at, updated_at
+	`, create.ProjectID, create.WebhookID, create.EventType.String(), create.Status, create.Attempts, payload, create.NextAttemptAt, create.LeaseExpireAt)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(
+		&create.ID,
+		&create.CreatedAt,
+		&create.UpdatedAt,
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to insert webhook delivery")
+	}
+	return create, nil
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, errors.Errorf("found %d webhook deliveries, expect 1", len(deliveries))
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists webhook deliveries, most recent first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			created_at,
+			updated_at,
+			project,
+			webhook_id,
+			event_type,
+			status,
+			attempts,
+			payload,
+			next_attempt_at,
+			lease_expire_at
+		FROM webhook_delivery
+		WHERE TRUE
+	`)
+
+	if v := find.ID; v != nil {
+		q.And("id = ?", *v)
+	}
+	if v := find.ProjectID; v != nil {
+		q.And("project = ?", *v)
+	}
+	if v := find.WebhookID; v != nil {
+		q.And("webhook_id = ?", *v)
+	}
+
+	q.Space("ORDER BY id DESC")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	if v := find.Offset; v != nil {
+		q.Space("OFFSET ?", *v)
+	}
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	return s.queryWebhookDeliveries(ctx, query, args...)
+}
+
+// ClaimWebhookDeliveries claims up to limit PENDING deliveries whose retry is due and which are not being attempted.
+// The claimed deliveries are leased, and their retry is postponed by lease, so that they are not claimed again while being attempted.
+func (s *Store) ClaimWebhookDeliveries(ctx context.Context, lease time.Duration, limit int) ([]*WebhookDeliveryMessage, error) {
+	now := time.Now()
+	q := qb.Q().Space(`
+		UPDATE webhook_delivery
+		SET next_attempt_at = ?, lease_expire_at = ?
+		WHERE id IN (
+			SELECT id
+			FROM webhook_delivery
+			WHERE status = ? AND next_attempt_at <= ? AND (lease_expire_at IS NULL OR lease_expire_at <= ?)
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			project,
+			webhook_id,
+			event_type,
+			status,
+			attempts,
+			payload,
+			next_attempt_at,
+			lease_expire_at
+	`, now.Add(lease), now.Add(lease), WebhookDeliveryPending, now, now, limit)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	return s.queryWebhookDeliveries(ctx, query, args...)
+}
+
+// ClaimWebhookDelivery claims the delivery to attempt it manually, regardless of its status.
+// It returns nil if the delivery is being attempted. The automatic retry of a claimed PENDING delivery is postponed by lease.
+func (s *Store) ClaimWebhookDelivery(ctx context.Context, id int64, lease time.Duration) (*WebhookDeliveryMessage, error) {
+	now := time.Now()
+	q := qb.Q().Space(`
+		UPDATE webhook_delivery
+		SET
+			next_attempt_at = CASE WHEN status = ? THEN ? ELSE next_attempt_at END,
+			lease_expire_at = ?
+		WHERE id = ? AND (lease_expire_at IS NULL OR lease_expire_at <= ?)
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			project,
+			webhook_id,
+			event_type,
+			status,
+			attempts,
+			payload,
+			next_attempt_at,
+			lease_expire_at
+	`, WebhookDeliveryPending, now.Add(lease), now.Add(lease), id, now)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	deliveries, err := s.queryWebhookDeliveries(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	return deliveries[0], nil
+}
+
+// DeleteExpiredWebhookDeliveries deletes the deliveries created before the retention period, except the ones being attempted.
+func (s *Store) DeleteExpiredWebhookDeliveries(ctx context.Context, retentionPeriod time.Duration) (int64, error) {
+	now := time.Now()
+	q := qb.Q().Space("DELETE FROM webhook_delivery WHERE created_at < ? AND (lease_expire_at IS NULL OR lease_expire_at <= ?)", now.Add(-retentionPeriod), now)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build sql")
+	}
+
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete expired webhook deliveries")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get rows affected")
+	}
+	return rowsAffected, nil
+}
+
+func (s *Store) queryWebhookDeliveries(ctx context.Context, query string, args ...any) ([]*WebhookDeliveryMessage, error) {
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query rows")
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		d := WebhookDeliveryMessage{
+			Payload: &storepb.WebhookDeliveryPayload{},
+		}
+		var eventType string
+		var payload []byte
+		var nextAttemptAt, leaseExpireAt sql.NullTime
+		if err := rows.Scan(
+			&d.ID,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+			&d.ProjectID,
+			&d.WebhookID,
+			&eventType,
+			&d.Status,
+			&d.Attempts,
+			&payload,
+			&nextAttemptAt,
+			&leaseExpireAt,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan rows")
+		}
+		if v, ok := storepb.Activity_Type_value[eventType]; ok {
+			d.EventType = storepb.Activity_Type(v)
+		}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, d.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		if nextAttemptAt.Valid {
+			d.NextAttemptAt = &nextAttemptAt.Time
+		}
+		if leaseExpireAt.Valid {
+			d.LeaseExpireAt = &leaseExpireAt.Time
+		}
+		deliveries = append(deliveries, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "rows err")
+	}
+
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates a webhook delivery.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	set := qb.Q()
+	set.Comma("updated_at = ?", time.Now())
+	if v := update.Status; v != nil {
+		set.Comma("status = ?", *v)
+		if *v != WebhookDeliveryPending && update.NextAttemptAt == nil {
+			set.Comma("next_attempt_at = NULL")
+		}
+	}
+	if v := update.Attempts; v != nil {
+		set.Comma("attempts = ?", *v)
+	}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal payload")
+		}
+		set.Comma("payload = ?", payload)
+	}
+	if v := update.NextAttemptAt; v != nil {
+		set.Comma("next_attempt_at = ?", *v)
+	}
+	if update.ReleaseLease {
+		set.Comma("lease_expire_at = NULL")
+	}
+
+	query, args, err := qb.Q().Space("UPDATE webhook_delivery SET ? WHERE id = ?", set, update.ID).ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update webhook delivery")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get rows affected")
+	}
+	if rowsAffected == 0 {
+		return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("webhook delivery %d not found", update.ID)}
+	}
+
+	return s.GetWebhookDelivery(ctx, &FindWebhookDeliveryMessage{ID: &update.ID})
+}
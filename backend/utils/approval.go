@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// PendingApproverSet is an approver set of the current approval step waiting for approvals.
+type PendingApproverSet struct {
+	// Step is the index of the approval step.
+	Step int
+	// ApproverSet is the index of the approver set in the approval step.
+	ApproverSet int
+	// Approvers are the approvers of the approver set,
+	// in the format of "roles/{role}", "groups/{email}", "users/{email}" or "externalApprovalNodes/{id}".
+	Approvers []string
+	// ApprovedBy is the principal IDs who have approved the approver set.
+	ApprovedBy map[int32]bool
+	// StepApprovedBy is the principal IDs who have approved any approver set of the parallel step.
+	// It's nil for the sequential steps.
+	StepApprovedBy map[int32]bool
+}
+
+// CanApprove returns true if the principal hasn't approved the approver set.
+// One approval counts for at most one approver set of a parallel step, so the principal who has approved
+// another approver set of the parallel step can't approve it either.
+func (s *PendingApproverSet) CanApprove(principalID int32) bool {
+	return !s.ApprovedBy[principalID] && !s.StepApprovedBy[principalID]
+}
+
+// GetApprovalSteps returns the approval steps of the approval flow.
+// The legacy flow of roles is converted to the sequential steps with one role each.
+func GetApprovalSteps(flow *storepb.ApprovalFlow) []*storepb.ApprovalStep {
+	if len(flow.GetSteps()) > 0 {
+		return flow.GetSteps()
+	}
+	var steps []*storepb.ApprovalStep
+	for _, role := range flow.GetRoles() {
+		steps = append(steps, &storepb.ApprovalStep{
+			Type: storepb.ApprovalStep_SEQUENTIAL,
+			ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+				{Approvers: []string{role}},
+			},
+		})
+	}
+	return steps
+}
+
+// GetApproverPosition returns the indexes of the approval step and the approver set decided by the i-th approver.
+// The approvers of the legacy flow of roles decide the steps one by one.
+func GetApproverPosition(flow *storepb.ApprovalFlow, i int, approver *storepb.IssuePayloadApproval_Approver) (int, int) {
+	if len(flow.GetSteps()) == 0 {
+		return i, 0
+	}
+	return int(approver.Step), int(approver.ApproverSet)
+}
+
+// GetApproverSetRequiredCount returns the number of the distinct approvers required to approve the approver set.
+func GetApproverSetRequiredCount(approverSet *storepb.ApprovalStep_ApproverSet) int {
+	return max(int(approverSet.GetRequiredCount()), 1)
+}
+
+// IsApprovalRejected returns true if any approver has rejected, which fails the whole approval flow.
+func IsApprovalRejected(approval *storepb.IssuePayloadApproval) bool {
+	for _, approver := range approval.GetApprovers() {
+		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
+			return true
+		}
+	}
+	return false
+}
+
+// FindPendingApproverSets finds the approver sets waiting for approvals in the current approval step.
+// All unapproved approver sets of a parallel step are pending at the same time,
+// while only the first unapproved approver set of a sequential step is pending.
+// It returns nil if all approval steps have been approved.
+func FindPendingApproverSets(approval *storepb.IssuePayloadApproval) []*PendingApproverSet {
+	flow := approval.GetApprovalTemplate().GetFlow()
+	type position struct {
+		step, approverSet int
+	}
+	approvedBy := map[position]map[int32]bool{}
+	for i, approver := range approval.GetApprovers() {
+		if approver.Status != storepb.IssuePayloadApproval_Approver_APPROVED {
+			continue
+		}
+		step, approverSet := GetApproverPosition(flow, i, approver)
+		p := position{step: step, approverSet: approverSet}
+		if approvedBy[p] == nil {
+			approvedBy[p] = map[int32]bool{}
+		}
+		approvedBy[p][approver.PrincipalId] = true
+	}
+
+	for i, step := range GetApprovalSteps(flow) {
+		var stepApprovedBy map[int32]bool
+		if step.Type == storepb.ApprovalStep_PARALLEL {
+			stepApprovedBy = map[int32]bool{}
+			for j := range step.ApproverSets {
+				for principalID := range approvedBy[position{step: i, approverSet: j}] {
+					stepApprovedBy[principalID] = true
+				}
+			}
+		}
+		var pending []*PendingApproverSet
+		for j, approverSet := range step.ApproverSets {
+			approved := approvedBy[position{step: i, approverSet: j}]
+			if len(approved) >= GetApproverSetRequiredCount(approverSet) {
+				continue
+			}
+			pending = append(pending, &PendingApproverSet{
+				Step:           i,
+				ApproverSet:    j,
+				Approvers:      approverSet.Approvers,
+				ApprovedBy:     approved,
+				StepApprovedBy: stepApprovedBy,
+			})
+			if step.Type != storepb.ApprovalStep_PARALLEL {
+				break
+			}
+		}
+		if len(pending) > 0 {
+			return pending
+		}
+	}
+	return nil
+}
+
+// FindPendingApprovers finds the approvers to notify of the pending approver sets, excluding the external approval nodes.
+// The approver sets already pending in the previous pending approver sets are skipped.
+func FindPendingApprovers(approval *storepb.IssuePayloadApproval, previous []*PendingApproverSet) []string {
+	type position struct {
+		step, approverSet int
+	}
+	notified := map[position]bool{}
+	for _, pending := range previous {
+		notified[position{step: pending.Step, approverSet: pending.ApproverSet}] = true
+	}
+	var approvers []string
+	for _, pending := range FindPendingApproverSets(approval) {
+		if notified[position{step: pending.Step, approverSet: pending.ApproverSet}] {
+			continue
+		}
+		for _, approver := range pending.Approvers {
+			if strings.HasPrefix(approver, common.ExternalApprovalNodePrefix) {
+				continue
+			}
+			approvers = append(approvers, approver)
+		}
+	}
+	return Uniq(approvers)
+}
+
+// CheckApprovalApproved checks if the approval is approved.
+func CheckApprovalApproved(approval *storepb.IssuePayloadApproval) (bool, error) {
+	if approval == nil || !approval.ApprovalFindingDone {
+		return false, nil
+	}
+	if approval.ApprovalFindingError != "" {
+		return false, nil
+	}
+	if approval.ApprovalTemplate == nil {
+		return true, nil
+	}
+	return !IsApprovalRejected(approval) && len(FindPendingApproverSets(approval)) == 0, nil
+}
+
+// CheckIssueApproved checks if the issue is approved.
+func CheckIssueApproved(issue *store.IssueMessage) (bool, error) {
+	return CheckApprovalApproved(issue.Payload.Approval)
+}
+
+// HandleIncomingApprovalSteps handles incoming approval steps.
+// - Blocks approval steps if no user can approve the step.
+func HandleIncomingApprovalSteps(approval *storepb.IssuePayloadApproval) ([]*storepb.IssuePayloadApproval_Approver, error) {
+	if approval.ApprovalTemplate == nil {
+		return nil, nil
+	}
+
+	var approvers []*storepb.IssuePayloadApproval_Approver
+
+	if len(FindPendingApproverSets(approval)) == 0 {
+		return nil, nil
+	}
+	return approvers, nil
+}
+
+// ApproverContainsUser checks if the approver of the approver set contains the user.
+// The roles are the user roles in the roles/{role} format.
+func ApproverContainsUser(ctx context.Context, stores *store.Store, approver string, user *store.UserMessage, roles map[string]bool) bool {
+	switch {
+	case strings.HasPrefix(approver, common.RolePrefix):
+		return roles[approver]
+	case strings.HasPrefix(approver, common.GroupPrefix):
+		return MemberContainsUser(ctx, stores, approver, user)
+	case strings.HasPrefix(approver, common.UserNamePrefix):
+		email, err := common.GetUserEmail(approver)
+		if err != nil {
+			return false
+		}
+		return strings.EqualFold(email, user.Email)
+	default:
+		// The external approval nodes are not approved by the users.
+		return false
+	}
+}
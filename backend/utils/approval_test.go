@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestFindPendingApproverSets(t *testing.T) {
+	approved := func(principalID int32, step, approverSet int32) *storepb.IssuePayloadApproval_Approver {
+		return &storepb.IssuePayloadApproval_Approver{
+			Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+			PrincipalId: principalID,
+			Step:        step,
+			ApproverSet: approverSet,
+		}
+	}
+	stepsFlow := &storepb.ApprovalFlow{
+		Steps: []*storepb.ApprovalStep{
+			{
+				Type: storepb.ApprovalStep_PARALLEL,
+				ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+					{Approvers: []string{"roles/projectOwner"}},
+					{Approvers: []string{"groups/dba@example.com", "users/alice@example.com"}, RequiredCount: 2},
+				},
+			},
+			{
+				Type: storepb.ApprovalStep_SEQUENTIAL,
+				ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+					{Approvers: []string{"roles/workspaceDBA"}},
+					{Approvers: []string{"roles/workspaceAdmin"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		flow      *storepb.ApprovalFlow
+		approvers []*storepb.IssuePayloadApproval_Approver
+		// want is the pending positions in the format of [step, approverSet].
+		want     [][2]int
+		approved bool
+	}{
+		{
+			name:      "legacy roles",
+			flow:      &storepb.ApprovalFlow{Roles: []string{"roles/projectOwner", "roles/workspaceDBA"}},
+			approvers: []*storepb.IssuePayloadApproval_Approver{approved(1, 0, 0)},
+			want:      [][2]int{{1, 0}},
+		},
+		{
+			name:      "legacy roles approved",
+			flow:      &storepb.ApprovalFlow{Roles: []string{"roles/projectOwner", "roles/workspaceDBA"}},
+			approvers: []*storepb.IssuePayloadApproval_Approver{approved(1, 0, 0), approved(2, 0, 0)},
+			approved:  true,
+		},
+		{
+			name: "parallel step pending",
+			flow: stepsFlow,
+			want: [][2]int{{0, 0}, {0, 1}},
+		},
+		{
+			name:      "quorum not reached",
+			flow:      stepsFlow,
+			approvers: []*storepb.IssuePayloadApproval_Approver{approved(1, 0, 0), approved(2, 0, 1), approved(2, 0, 1)},
+			want:      [][2]int{{0, 1}},
+		},
+		{
+			name:      "sequential step pending",
+			flow:      stepsFlow,
+			approvers: []*storepb.IssuePayloadApproval_Approver{approved(1, 0, 0), approved(2, 0, 1), approved(3, 0, 1)},
+			want:      [][2]int{{1, 0}},
+		},
+		{
+			name: "all steps approved",
+			flow: stepsFlow,
+			approvers: []*storepb.IssuePayloadApproval_Approver{
+				approved(1, 0, 0), approved(2, 0, 1), approved(3, 0, 1), approved(4, 1, 0), approved(4, 1, 1),
+			},
+			approved: true,
+		},
+		{
+			name: "rejected",
+			flow: stepsFlow,
+			approvers: []*storepb.IssuePayloadApproval_Approver{
+				approved(2, 0, 1), approved(3, 0, 1),
+				{Status: storepb.IssuePayloadApproval_Approver_REJECTED, PrincipalId: 1, Step: 0, ApproverSet: 0},
+			},
+			want: [][2]int{{0, 0}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := require.New(t)
+			approval := &storepb.IssuePayloadApproval{
+				ApprovalFindingDone: true,
+				ApprovalTemplate:    &storepb.ApprovalTemplate{Flow: tc.flow},
+				Approvers:           tc.approvers,
+			}
+			var got [][2]int
+			for _, pending := range FindPendingApproverSets(approval) {
+				got = append(got, [2]int{pending.Step, pending.ApproverSet})
+			}
+			a.Equal(tc.want, got)
+			isApproved, err := CheckApprovalApproved(approval)
+			a.NoError(err)
+			a.Equal(tc.approved, isApproved)
+		})
+	}
+}
+
+func TestPendingApproverSetCanApprove(t *testing.T) {
+	a := require.New(t)
+	approval := &storepb.IssuePayloadApproval{
+		ApprovalFindingDone: true,
+		ApprovalTemplate: &storepb.ApprovalTemplate{
+			Flow: &storepb.ApprovalFlow{
+				Steps: []*storepb.ApprovalStep{
+					{
+						Type: storepb.ApprovalStep_PARALLEL,
+						ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+							{Approvers: []string{"roles/projectOwner"}},
+							{Approvers: []string{"roles/projectOwner", "roles/workspaceDBA"}, RequiredCount: 2},
+						},
+					},
+					{
+						Type: storepb.ApprovalStep_SEQUENTIAL,
+						ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+							{Approvers: []string{"roles/workspaceDBA"}},
+							{Approvers: []string{"roles/workspaceDBA"}},
+						},
+					},
+				},
+			},
+		},
+		Approvers: []*storepb.IssuePayloadApproval_Approver{
+			{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 1, Step: 0, ApproverSet: 0},
+		},
+	}
+	pending := FindPendingApproverSets(approval)
+	a.Len(pending, 1)
+	// The approval of the first approver set doesn't count for the other approver set of the parallel step.
+	a.False(pending[0].CanApprove(1))
+	a.True(pending[0].CanApprove(2))
+
+	approval.Approvers = append(approval.Approvers,
+		&storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 2, Step: 0, ApproverSet: 1},
+		&storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 3, Step: 0, ApproverSet: 1},
+		&storepb.IssuePayloadApproval_Approver{Status: storepb.IssuePayloadApproval_Approver_APPROVED, PrincipalId: 3, Step: 1, ApproverSet: 0},
+	)
+	pending = FindPendingApproverSets(approval)
+	a.Len(pending, 1)
+	// The approver sets of a sequential step can be approved by the same user one by one.
+	a.True(pending[0].CanApprove(3))
+}
+
+func TestFindPendingApprovers(t *testing.T) {
+	a := require.New(t)
+	approval := &storepb.IssuePayloadApproval{
+		ApprovalFindingDone: true,
+		ApprovalTemplate: &storepb.ApprovalTemplate{
+			Flow: &storepb.ApprovalFlow{
+				Steps: []*storepb.ApprovalStep{
+					{
+						Type: storepb.ApprovalStep_PARALLEL,
+						ApproverSets: []*storepb.ApprovalStep_ApproverSet{
+							{Approvers: []string{"roles/projectOwner", "users/alice@example.com"}},
+							{Approvers: []string{"externalApprovalNodes/change-board"}},
+							{Approvers: []string{"roles/projectOwner"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	a.Equal([]string{"roles/projectOwner", "users/alice@example.com"}, FindPendingApprovers(approval, nil))
+
+	// The approver sets already pending are not notified again.
+	previous := FindPendingApproverSets(approval)
+	approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+		Status:      storepb.IssuePayloadApproval_Approver_APPROVED,
+		PrincipalId: 1,
+	})
+	a.Empty(FindPendingApprovers(approval, previous))
+}
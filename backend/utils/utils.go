@@ -31,66 +31,6 @@ func DataSourceFromInstanceWithType(instance *store.InstanceMessage, dataSourceT
 	return nil
 }
 
-// FindNextPendingRole finds the next pending role in the approval flow.
-func FindNextPendingRole(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) string {
-	// We can do the finding like this for now because we are presuming that
-	// one role is approved by one approver.
-	// and the approver status is either
-	// APPROVED or REJECTED.
-	if len(approvers) >= len(template.Flow.Roles) {
-		return ""
-	}
-	return template.Flow.Roles[len(approvers)]
-}
-
-// FindRejectedRole finds the rejected role in the approval flow.
-func FindRejectedRole(template *storepb.ApprovalTemplate, approvers []*storepb.IssuePayloadApproval_Approver) string {
-	for i, approver := range approvers {
-		if i >= len(template.Flow.Roles) {
-			return ""
-		}
-		if approver.Status == storepb.IssuePayloadApproval_Approver_REJECTED {
-			return template.Flow.Roles[i]
-		}
-	}
-	return ""
-}
-
-// CheckApprovalApproved checks if the approval is approved.
-func CheckApprovalApproved(approval *storepb.IssuePayloadApproval) (bool, error) {
-	if approval == nil || !approval.ApprovalFindingDone {
-		return false, nil
-	}
-	if approval.ApprovalFindingError != "" {
-		return false, nil
-	}
-	if approval.ApprovalTemplate == nil {
-		return true, nil
-	}
-	return FindRejectedRole(approval.ApprovalTemplate, approval.Approvers) == "" && FindNextPendingRole(approval.ApprovalTemplate, approval.Approvers) == "", nil
-}
-
-// CheckIssueApproved checks if the issue is approved.
-func CheckIssueApproved(issue *store.IssueMessage) (bool, error) {
-	return CheckApprovalApproved(issue.Payload.Approval)
-}
-
-// HandleIncomingApprovalSteps handles incoming approval steps.
-// - Blocks approval steps if no user can approve the step.
-func HandleIncomingApprovalSteps(approval *storepb.IssuePayloadApproval) ([]*storepb.IssuePayloadApproval_Approver, error) {
-	if approval.ApprovalTemplate == nil {
-		return nil, nil
-	}
-
-	var approvers []*storepb.IssuePayloadApproval_Approver
-
-	role := FindNextPendingRole(approval.ApprovalTemplate, approval.Approvers)
-	if role == "" {
-		return nil, nil
-	}
-	return approvers, nil
-}
-
 // UpdateProjectPolicyFromGrantIssue updates the project policy from grant issue.
 func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store, issue *store.IssueMessage, grantRequest *storepb.GrantRequest) error {
 	policyMessage, err := stores.GetProjectIamPolicy(ctx, issue.Project.ResourceID)
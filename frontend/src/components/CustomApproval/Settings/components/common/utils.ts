@@ -33,6 +33,7 @@ import {
 import {
   CEL_ATTRIBUTE_LEVEL,
   CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS,
+  CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS,
   CEL_ATTRIBUTE_REQUEST_ROLE,
   CEL_ATTRIBUTE_RESOURCE_DATABASE_NAME,
   CEL_ATTRIBUTE_RESOURCE_DB_ENGINE,
@@ -139,6 +140,7 @@ export const RiskSourceFactorMap: Map<Risk_Source, Factor[]> = new Map([
       CEL_ATTRIBUTE_RESOURCE_ENVIRONMENT_ID,
       CEL_ATTRIBUTE_RESOURCE_PROJECT_ID,
       CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS,
+      CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS,
       CEL_ATTRIBUTE_REQUEST_ROLE,
     ],
   ],
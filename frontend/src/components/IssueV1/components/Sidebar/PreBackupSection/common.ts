@@ -5,4 +5,6 @@ export const ROLLBACK_AVAILABLE_ENGINES = [
   Engine.POSTGRES,
   Engine.MSSQL,
   Engine.ORACLE,
+  Engine.SNOWFLAKE,
+  Engine.REDSHIFT,
 ];
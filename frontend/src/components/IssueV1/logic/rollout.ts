@@ -100,6 +100,8 @@ export const semanticTaskType = (
           return "DML";
         case MigrationType.GHOST:
           return "gh-ost";
+        case MigrationType.PG_ONLINE:
+          return "Online DDL";
         default:
           return "DDL";
       }
@@ -6,4 +6,6 @@ export const BACKUP_AVAILABLE_ENGINES = [
   Engine.MSSQL,
   Engine.ORACLE,
   Engine.POSTGRES,
+  Engine.SNOWFLAKE,
+  Engine.REDSHIFT,
 ];
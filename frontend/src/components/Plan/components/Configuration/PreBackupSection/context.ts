@@ -36,6 +36,8 @@ export const PRE_BACKUP_AVAILABLE_ENGINES = [
   Engine.MSSQL,
   Engine.ORACLE,
   Engine.POSTGRES,
+  Engine.SNOWFLAKE,
+  Engine.REDSHIFT,
 ];
 
 const KEY = Symbol(
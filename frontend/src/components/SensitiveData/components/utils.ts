@@ -68,7 +68,12 @@ export type MaskingType =
   | "full-mask"
   | "range-mask"
   | "md5-mask"
-  | "inner-outer-mask";
+  | "inner-outer-mask"
+  | "fpe-mask"
+  | "tokenization-mask"
+  | "date-truncate-mask"
+  | "numeric-bucket-mask"
+  | "numeric-noise-mask";
 
 export const getMaskingType = (
   algorithm: Algorithm | undefined
@@ -85,6 +90,16 @@ export const getMaskingType = (
       return "inner-outer-mask";
     case "md5Mask":
       return "md5-mask";
+    case "formatPreservingEncryptionMask":
+      return "fpe-mask";
+    case "tokenizationMask":
+      return "tokenization-mask";
+    case "dateTruncateMask":
+      return "date-truncate-mask";
+    case "numericBucketMask":
+      return "numeric-bucket-mask";
+    case "numericNoiseMask":
+      return "numeric-noise-mask";
     default:
       return;
   }
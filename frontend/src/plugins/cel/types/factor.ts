@@ -1,6 +1,7 @@
 import {
   CEL_ATTRIBUTE_LEVEL,
   CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS,
+  CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS,
   CEL_ATTRIBUTE_REQUEST_ROLE,
   CEL_ATTRIBUTE_REQUEST_TIME,
   CEL_ATTRIBUTE_RESOURCE_CLASSIFICATION_LEVEL,
@@ -27,6 +28,7 @@ export const NumberFactorList = [
 
   // Request query/export factors
   CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS,
+  CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS,
 ] as const;
 export type NumberFactor = (typeof NumberFactorList)[number];
 
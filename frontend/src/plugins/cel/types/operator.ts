@@ -2,6 +2,7 @@ import { uniq } from "lodash-es";
 import {
   CEL_ATTRIBUTE_LEVEL,
   CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS,
+  CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS,
   CEL_ATTRIBUTE_REQUEST_ROLE,
   CEL_ATTRIBUTE_REQUEST_TIME,
   CEL_ATTRIBUTE_RESOURCE_CLASSIFICATION_LEVEL,
@@ -149,6 +150,10 @@ const OperatorList: Record<Factor, Operator[]> = {
     ...EqualityOperatorList,
     ...CompareOperatorList,
   ]),
+  [CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS]: uniq([
+    ...EqualityOperatorList,
+    ...CompareOperatorList,
+  ]),
   [CEL_ATTRIBUTE_REQUEST_ROLE]: uniq([
     ...EqualityOperatorList,
     ...CollectionOperatorList,
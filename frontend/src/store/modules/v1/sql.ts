@@ -39,14 +39,28 @@ export const useSQLStore = defineStore("sql", () => {
     }
   };
 
+  // The export file is streamed in chunks, so the export of a database is not
+  // limited by the maximum result size.
   const exportData = async (params: ExportRequest) => {
-    const newResponse = await sqlServiceClientConnect.export(params, {
+    const stream = sqlServiceClientConnect.exportStream(params, {
       // Won't jump to 403 page when permission denied.
       contextValues: createContextValues().set(ignoredCodesContextKey, [
         Code.PermissionDenied,
       ]),
     });
-    return newResponse.content;
+    const chunks: Uint8Array[] = [];
+    let size = 0;
+    for await (const response of stream) {
+      chunks.push(response.content);
+      size += response.content.length;
+    }
+    const content = new Uint8Array(size);
+    let offset = 0;
+    for (const chunk of chunks) {
+      content.set(chunk, offset);
+      offset += chunk.length;
+    }
+    return content;
   };
 
   return {
@@ -100,6 +100,7 @@ export type Permission =
   | "bb.sql.admin"
   | "bb.taskRuns.create"
   | "bb.taskRuns.list"
+  | "bb.taskRuns.override"
   | "bb.users.create"
   | "bb.users.delete"
   | "bb.users.undelete"
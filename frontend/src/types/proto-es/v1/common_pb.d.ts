@@ -398,6 +398,20 @@ export enum ExportFormat {
    * @generated from enum value: XLSX = 4;
    */
   XLSX = 4,
+
+  /**
+   * Apache Parquet columnar format.
+   *
+   * @generated from enum value: PARQUET = 5;
+   */
+  PARQUET = 5,
+
+  /**
+   * Apache Arrow IPC file format.
+   *
+   * @generated from enum value: ARROW = 6;
+   */
+  ARROW = 6,
 }
 
 /**
@@ -467,6 +481,13 @@ export enum MigrationType {
    * @generated from enum value: GHOST = 3;
    */
   GHOST = 3,
+
+  /**
+   * Used for DDL changes on PostgreSQL using a shadow table.
+   *
+   * @generated from enum value: PG_ONLINE = 4;
+   */
+  PG_ONLINE = 4,
 }
 
 /**
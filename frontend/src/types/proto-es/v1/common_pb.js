@@ -8,7 +8,7 @@ import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/code
  * Describes the file v1/common.proto.
  */
 export const file_v1_common = /*@__PURE__*/
-  fileDesc("Cg92MS9jb21tb24ucHJvdG8SC2J5dGViYXNlLnYxIigKCFBvc2l0aW9uEgwKBGxpbmUYASABKAUSDgoGY29sdW1uGAIgASgFIiMKBVJhbmdlEg0KBXN0YXJ0GAEgASgFEgsKA2VuZBgCIAEoBSo3CgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEgoKBkFDVElWRRABEgsKB0RFTEVURUQQAirwAgoGRW5naW5lEhYKEkVOR0lORV9VTlNQRUNJRklFRBAAEg4KCkNMSUNLSE9VU0UQARIJCgVNWVNRTBACEgwKCFBPU1RHUkVTEAMSDQoJU05PV0ZMQUtFEAQSCgoGU1FMSVRFEAUSCAoEVElEQhAGEgsKB01PTkdPREIQBxIJCgVSRURJUxAIEgoKBk9SQUNMRRAJEgsKB1NQQU5ORVIQChIJCgVNU1NRTBALEgwKCFJFRFNISUZUEAwSCwoHTUFSSUFEQhANEg0KCU9DRUFOQkFTRRAOEg0KCVNUQVJST0NLUxASEgkKBURPUklTEBMSCAoESElWRRAUEhEKDUVMQVNUSUNTRUFSQ0gQFRIMCghCSUdRVUVSWRAWEgwKCERZTkFNT0RCEBcSDgoKREFUQUJSSUNLUxAYEg8KC0NPQ0tST0FDSERCEBkSDAoIQ09TTU9TREIQGhIJCgVUUklOTxAbEg0KCUNBU1NBTkRSQRAcKlwKB1ZDU1R5cGUSGAoUVkNTX1RZUEVfVU5TUEVDSUZJRUQQABIKCgZHSVRIVUIQARIKCgZHSVRMQUIQAhINCglCSVRCVUNLRVQQAxIQCgxBWlVSRV9ERVZPUFMQBCpMCgxFeHBvcnRGb3JtYXQSFgoSRk9STUFUX1VOU1BFQ0lGSUVEEAASBwoDQ1NWEAESCAoESlNPThACEgcKA1NRTBADEggKBFhMU1gQBCpQChJEYXRhYmFzZUNoYW5nZVR5cGUSJAogREFUQUJBU0VfQ0hBTkdFX1RZUEVfVU5TUEVDSUZJRUQQABILCgdNSUdSQVRFEAISBwoDU0RMEAMqTAoNTWlncmF0aW9uVHlwZRIeChpNSUdSQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEgcKA0RETBABEgcKA0RNTBACEgkKBUdIT1NUEAMqSAoJUmlza0xldmVsEhoKFlJJU0tfTEVWRUxfVU5TUEVDSUZJRUQQABIHCgNMT1cQARIMCghNT0RFUkFURRACEggKBEhJR0gQA0KhAQoPY29tLmJ5dGViYXNlLnYxQgtDb21tb25Qcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM");
+  fileDesc("Cg92MS9jb21tb24ucHJvdG8SC2J5dGViYXNlLnYxIigKCFBvc2l0aW9uEgwKBGxpbmUYASABKAUSDgoGY29sdW1uGAIgASgFIiMKBVJhbmdlEg0KBXN0YXJ0GAEgASgFEgsKA2VuZBgCIAEoBSo3CgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEgoKBkFDVElWRRABEgsKB0RFTEVURUQQAirwAgoGRW5naW5lEhYKEkVOR0lORV9VTlNQRUNJRklFRBAAEg4KCkNMSUNLSE9VU0UQARIJCgVNWVNRTBACEgwKCFBPU1RHUkVTEAMSDQoJU05PV0ZMQUtFEAQSCgoGU1FMSVRFEAUSCAoEVElEQhAGEgsKB01PTkdPREIQBxIJCgVSRURJUxAIEgoKBk9SQUNMRRAJEgsKB1NQQU5ORVIQChIJCgVNU1NRTBALEgwKCFJFRFNISUZUEAwSCwoHTUFSSUFEQhANEg0KCU9DRUFOQkFTRRAOEg0KCVNUQVJST0NLUxASEgkKBURPUklTEBMSCAoESElWRRAUEhEKDUVMQVNUSUNTRUFSQ0gQFRIMCghCSUdRVUVSWRAWEgwKCERZTkFNT0RCEBcSDgoKREFUQUJSSUNLUxAYEg8KC0NPQ0tST0FDSERCEBkSDAoIQ09TTU9TREIQGhIJCgVUUklOTxAbEg0KCUNBU1NBTkRSQRAcKlwKB1ZDU1R5cGUSGAoUVkNTX1RZUEVfVU5TUEVDSUZJRUQQABIKCgZHSVRIVUIQARIKCgZHSVRMQUIQAhINCglCSVRCVUNLRVQQAxIQCgxBWlVSRV9ERVZPUFMQBCpkCgxFeHBvcnRGb3JtYXQSFgoSRk9STUFUX1VOU1BFQ0lGSUVEEAASBwoDQ1NWEAESCAoESlNPThACEgcKA1NRTBADEggKBFhMU1gQBBILCgdQQVJRVUVUEAUSCQoFQVJST1cQBipQChJEYXRhYmFzZUNoYW5nZVR5cGUSJAogREFUQUJBU0VfQ0hBTkdFX1RZUEVfVU5TUEVDSUZJRUQQABILCgdNSUdSQVRFEAISBwoDU0RMEAMqWwoNTWlncmF0aW9uVHlwZRIeChpNSUdSQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEgcKA0RETBABEgcKA0RNTBACEgkKBUdIT1NUEAMSDQoJUEdfT05MSU5FEAQqSAoJUmlza0xldmVsEhoKFlJJU0tfTEVWRUxfVU5TUEVDSUZJRUQQABIHCgNMT1cQARIMCghNT0RFUkFURRACEggKBEhJR0gQA0KhAQoPY29tLmJ5dGViYXNlLnYxQgtDb21tb25Qcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM");
 
 /**
  * Describes the message bytebase.v1.Position.
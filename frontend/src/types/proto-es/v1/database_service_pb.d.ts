@@ -3410,6 +3410,13 @@ export enum Changelog_MigrationType {
    * @generated from enum value: GHOST = 3;
    */
   GHOST = 3,
+
+  /**
+   * Used for DDL changes on PostgreSQL using a shadow table.
+   *
+   * @generated from enum value: PG_ONLINE = 4;
+   */
+  PG_ONLINE = 4,
 }
 
 /**
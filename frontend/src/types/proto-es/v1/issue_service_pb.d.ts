@@ -589,6 +589,22 @@ export declare type Issue_Approver = Message<"bytebase.v1.Issue.Approver"> & {
    * @generated from field: string principal = 2;
    */
   principal: string;
+
+  /**
+   * The index of the approval step approved by the approver.
+   * Only used by the approval flows with steps.
+   *
+   * @generated from field: int32 step = 3;
+   */
+  step: number;
+
+  /**
+   * The index of the approver set in the approval step approved by the approver.
+   * Only used by the approval flows with steps.
+   *
+   * @generated from field: int32 approver_set = 4;
+   */
+  approverSet: number;
 };
 
 /**
@@ -826,10 +842,18 @@ export declare const ApprovalTemplateSchema: GenMessage<ApprovalTemplate>;
 export declare type ApprovalFlow = Message<"bytebase.v1.ApprovalFlow"> & {
   /**
    * The roles required for approval in order.
+   * Ignored if the steps are set.
    *
    * @generated from field: repeated string roles = 1;
    */
   roles: string[];
+
+  /**
+   * The approval steps required for approval in order.
+   *
+   * @generated from field: repeated bytebase.v1.ApprovalStep steps = 2;
+   */
+  steps: ApprovalStep[];
 };
 
 /**
@@ -838,6 +862,95 @@ export declare type ApprovalFlow = Message<"bytebase.v1.ApprovalFlow"> & {
  */
 export declare const ApprovalFlowSchema: GenMessage<ApprovalFlow>;
 
+/**
+ * ApprovalStep is a step of the approval flow consisting of one or more approver sets.
+ *
+ * @generated from message bytebase.v1.ApprovalStep
+ */
+export declare type ApprovalStep = Message<"bytebase.v1.ApprovalStep"> & {
+  /**
+   * How the approver sets are approved.
+   *
+   * @generated from field: bytebase.v1.ApprovalStep.Type type = 1;
+   */
+  type: ApprovalStep_Type;
+
+  /**
+   * All approver sets must approve to complete the step.
+   *
+   * @generated from field: repeated bytebase.v1.ApprovalStep.ApproverSet approver_sets = 2;
+   */
+  approverSets: ApprovalStep_ApproverSet[];
+};
+
+/**
+ * Describes the message bytebase.v1.ApprovalStep.
+ * Use `create(ApprovalStepSchema)` to create a new message.
+ */
+export declare const ApprovalStepSchema: GenMessage<ApprovalStep>;
+
+/**
+ * ApproverSet is a set of approvers of which the required number of approvers must approve.
+ *
+ * @generated from message bytebase.v1.ApprovalStep.ApproverSet
+ */
+export declare type ApprovalStep_ApproverSet = Message<"bytebase.v1.ApprovalStep.ApproverSet"> & {
+  /**
+   * The approvers in the format of
+   * "roles/{role}", "groups/{email}", "users/{email}" or "externalApprovalNodes/{id}".
+   *
+   * @generated from field: repeated string approvers = 1;
+   */
+  approvers: string[];
+
+  /**
+   * The number of the distinct approvers required to approve the set.
+   * Zero means one.
+   *
+   * @generated from field: int32 required_count = 2;
+   */
+  requiredCount: number;
+};
+
+/**
+ * Describes the message bytebase.v1.ApprovalStep.ApproverSet.
+ * Use `create(ApprovalStep_ApproverSetSchema)` to create a new message.
+ */
+export declare const ApprovalStep_ApproverSetSchema: GenMessage<ApprovalStep_ApproverSet>;
+
+/**
+ * Type defines how the approver sets in the step are approved.
+ *
+ * @generated from enum bytebase.v1.ApprovalStep.Type
+ */
+export enum ApprovalStep_Type {
+  /**
+   * Unspecified type, treated as sequential.
+   *
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * The approver sets are approved one after another, in order.
+   *
+   * @generated from enum value: SEQUENTIAL = 1;
+   */
+  SEQUENTIAL = 1,
+
+  /**
+   * The approver sets are approved at the same time.
+   *
+   * @generated from enum value: PARALLEL = 2;
+   */
+  PARALLEL = 2,
+}
+
+/**
+ * Describes the enum bytebase.v1.ApprovalStep.Type.
+ */
+export declare const ApprovalStep_TypeSchema: GenEnum<ApprovalStep_Type>;
+
 /**
  * @generated from message bytebase.v1.ListIssueCommentsRequest
  */
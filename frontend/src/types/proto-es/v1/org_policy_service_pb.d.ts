@@ -253,6 +253,18 @@ export declare type Policy = Message<"bytebase.v1.Policy"> & {
      */
     value: QueryDataPolicy;
     case: "queryDataPolicy";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.MaintenanceWindowPolicy maintenance_window_policy = 25;
+     */
+    value: MaintenanceWindowPolicy;
+    case: "maintenanceWindowPolicy";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.RowFilterPolicy row_filter_policy = 26;
+     */
+    value: RowFilterPolicy;
+    case: "rowFilterPolicy";
   } | { case: undefined; value?: undefined };
 
   /**
@@ -385,6 +397,133 @@ export enum RolloutPolicy_Checkers_PlanCheckEnforcement {
  */
 export declare const RolloutPolicy_Checkers_PlanCheckEnforcementSchema: GenEnum<RolloutPolicy_Checkers_PlanCheckEnforcement>;
 
+/**
+ * Maintenance window policy configuration.
+ * Task runs in the environment can only start within one of the windows.
+ *
+ * @generated from message bytebase.v1.MaintenanceWindowPolicy
+ */
+export declare type MaintenanceWindowPolicy = Message<"bytebase.v1.MaintenanceWindowPolicy"> & {
+  /**
+   * The maintenance windows.
+   *
+   * @generated from field: repeated bytebase.v1.MaintenanceWindowPolicy.Window windows = 1;
+   */
+  windows: MaintenanceWindowPolicy_Window[];
+
+  /**
+   * The IANA time zone of the cron expressions, e.g. "Asia/Shanghai". Defaults to UTC.
+   *
+   * @generated from field: string time_zone = 2;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message bytebase.v1.MaintenanceWindowPolicy.
+ * Use `create(MaintenanceWindowPolicySchema)` to create a new message.
+ */
+export declare const MaintenanceWindowPolicySchema: GenMessage<MaintenanceWindowPolicy>;
+
+/**
+ * A recurring maintenance window.
+ *
+ * @generated from message bytebase.v1.MaintenanceWindowPolicy.Window
+ */
+export declare type MaintenanceWindowPolicy_Window = Message<"bytebase.v1.MaintenanceWindowPolicy.Window"> & {
+  /**
+   * The cron expression of the window start in the 5-field format "minute hour day-of-month month day-of-week".
+   * For example, "0 2 * * 2,4" starts the window at 02:00 on Tuesday and Thursday.
+   *
+   * @generated from field: string cron = 1;
+   */
+  cron: string;
+
+  /**
+   * The length of the window.
+   *
+   * @generated from field: google.protobuf.Duration duration = 2;
+   */
+  duration?: Duration;
+};
+
+/**
+ * Describes the message bytebase.v1.MaintenanceWindowPolicy.Window.
+ * Use `create(MaintenanceWindowPolicy_WindowSchema)` to create a new message.
+ */
+export declare const MaintenanceWindowPolicy_WindowSchema: GenMessage<MaintenanceWindowPolicy_Window>;
+
+/**
+ * Row filter policy configuration.
+ * The queries in the SQL editor only read the rows of the tables satisfying the filters.
+ *
+ * @generated from message bytebase.v1.RowFilterPolicy
+ */
+export declare type RowFilterPolicy = Message<"bytebase.v1.RowFilterPolicy"> & {
+  /**
+   * The row filters. The filters on the same table are combined with AND.
+   *
+   * @generated from field: repeated bytebase.v1.RowFilterPolicy.RowFilter filters = 1;
+   */
+  filters: RowFilterPolicy_RowFilter[];
+};
+
+/**
+ * Describes the message bytebase.v1.RowFilterPolicy.
+ * Use `create(RowFilterPolicySchema)` to create a new message.
+ */
+export declare const RowFilterPolicySchema: GenMessage<RowFilterPolicy>;
+
+/**
+ * A row filter on a table.
+ *
+ * @generated from message bytebase.v1.RowFilterPolicy.RowFilter
+ */
+export declare type RowFilterPolicy_RowFilter = Message<"bytebase.v1.RowFilterPolicy.RowFilter"> & {
+  /**
+   * The database of the table, which must be in the project of the policy.
+   * Format: instances/{instance}/databases/{database}
+   *
+   * @generated from field: string database = 1;
+   */
+  database: string;
+
+  /**
+   * The schema of the table. Empty for the engines without schemas.
+   *
+   * @generated from field: string schema = 2;
+   */
+  schema: string;
+
+  /**
+   * The table name.
+   *
+   * @generated from field: string table = 3;
+   */
+  table: string;
+
+  /**
+   * The CEL condition over the principal attributes, e.g. "'finance@example.com' in principal.groups".
+   * The filter applies to the principals matching the condition, or to all principals if the condition is empty.
+   *
+   * @generated from field: google.type.Expr condition = 4;
+   */
+  condition?: Expr;
+
+  /**
+   * The SQL predicate that the readable rows must satisfy, e.g. "region = 'us'".
+   *
+   * @generated from field: string predicate = 5;
+   */
+  predicate: string;
+};
+
+/**
+ * Describes the message bytebase.v1.RowFilterPolicy.RowFilter.
+ * Use `create(RowFilterPolicy_RowFilterSchema)` to create a new message.
+ */
+export declare const RowFilterPolicy_RowFilterSchema: GenMessage<RowFilterPolicy_RowFilter>;
+
 /**
  * QueryDataPolicy is the policy configuration for querying data.
  *
@@ -806,6 +945,20 @@ export enum PolicyType {
    * @generated from enum value: DATA_QUERY = 16;
    */
   DATA_QUERY = 16,
+
+  /**
+   * Maintenance window policy.
+   *
+   * @generated from enum value: MAINTENANCE_WINDOW = 17;
+   */
+  MAINTENANCE_WINDOW = 17,
+
+  /**
+   * Row filter policy.
+   *
+   * @generated from enum value: ROW_FILTER = 18;
+   */
+  ROW_FILTER = 18,
 }
 
 /**
@@ -966,6 +966,13 @@ export enum PlanCheckRun_Type {
    * @generated from enum value: DATABASE_GHOST_SYNC = 7;
    */
   DATABASE_GHOST_SYNC = 7,
+
+  /**
+   * PostgreSQL online migration check that validates the shadow table migration preconditions.
+   *
+   * @generated from enum value: DATABASE_PG_ONLINE_CHECK = 8;
+   */
+  DATABASE_PG_ONLINE_CHECK = 8,
 }
 
 /**
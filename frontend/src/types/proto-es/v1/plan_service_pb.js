@@ -18,7 +18,7 @@ import { file_v1_sql_service } from "./sql_service_pb";
  * Describes the file v1/plan_service.proto.
  */
 export const file_v1_plan_service = /*@__PURE__*/
-  fileDesc("ChV2MS9wbGFuX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIjkKDkdldFBsYW5SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4iZwoQTGlzdFBsYW5zUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiTgoRTGlzdFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJgChJTZWFyY2hQbGFuc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJIlAKE1NlYXJjaFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJnChFDcmVhdGVQbGFuUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSJAoEcGxhbhgCIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAiKGAQoRVXBkYXRlUGxhblJlcXVlc3QSJAoEcGxhbhgBIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIVCg1hbGxvd19taXNzaW5nGAMgASgIIr4NCgRQbGFuEgwKBG5hbWUYASABKAkSIQoFc3RhdGUYAiABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZRISCgVpc3N1ZRgDIAEoCUID4EEDEhQKB3JvbGxvdXQYDyABKAlCA+BBAxIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESHQoLZGVzY3JpcHRpb24YBSABKAlCCLpIBXIDGJBOEiUKBXNwZWNzGA4gAygLMhYuYnl0ZWJhc2UudjEuUGxhbi5TcGVjEhQKB2NyZWF0b3IYCCABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxJYChtwbGFuX2NoZWNrX3J1bl9zdGF0dXNfY291bnQYCyADKAsyLi5ieXRlYmFzZS52MS5QbGFuLlBsYW5DaGVja1J1blN0YXR1c0NvdW50RW50cnlCA+BBAxIwCgpkZXBsb3ltZW50GA0gASgLMhwuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50GvIBCgRTcGVjEgoKAmlkGAUgASgJEkgKFmNyZWF0ZV9kYXRhYmFzZV9jb25maWcYASABKAsyJi5ieXRlYmFzZS52MS5QbGFuLkNyZWF0ZURhdGFiYXNlQ29uZmlnSAASSAoWY2hhbmdlX2RhdGFiYXNlX2NvbmZpZxgCIAEoCzImLmJ5dGViYXNlLnYxLlBsYW4uQ2hhbmdlRGF0YWJhc2VDb25maWdIABJAChJleHBvcnRfZGF0YV9jb25maWcYByABKAsyIi5ieXRlYmFzZS52MS5QbGFuLkV4cG9ydERhdGFDb25maWdIAEIICgZjb25maWcaPgocUGxhbkNoZWNrUnVuU3RhdHVzQ291bnRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGs4BChRDcmVhdGVEYXRhYmFzZUNvbmZpZxITCgZ0YXJnZXQYASABKAlCA+BBAhIVCghkYXRhYmFzZRgCIAEoCUID4EECEhIKBXRhYmxlGAMgASgJQgPgQQESGgoNY2hhcmFjdGVyX3NldBgEIAEoCUID4EEBEhYKCWNvbGxhdGlvbhgFIAEoCUID4EEBEhQKB2NsdXN0ZXIYBiABKAlCA+BBARISCgVvd25lchgHIAEoCUID4EEBEhgKC2Vudmlyb25tZW50GAkgASgJQgPgQQEa7gIKFENoYW5nZURhdGFiYXNlQ29uZmlnEg8KB3RhcmdldHMYCiADKAkSDQoFc2hlZXQYAiABKAkSKgoHcmVsZWFzZRgJIAEoCUIZ+kEWChRieXRlYmFzZS5jb20vUmVsZWFzZRItCgR0eXBlGAMgASgOMh8uYnl0ZWJhc2UudjEuRGF0YWJhc2VDaGFuZ2VUeXBlEjIKDm1pZ3JhdGlvbl90eXBlGAsgASgOMhouYnl0ZWJhc2UudjEuTWlncmF0aW9uVHlwZRJLCgtnaG9zdF9mbGFncxgHIAMoCzI2LmJ5dGViYXNlLnYxLlBsYW4uQ2hhbmdlRGF0YWJhc2VDb25maWcuR2hvc3RGbGFnc0VudHJ5EhsKE2VuYWJsZV9wcmlvcl9iYWNrdXAYCCABKAgaMQoPR2hvc3RGbGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFKBAgFEAZKBAgGEAcagQEKEEV4cG9ydERhdGFDb25maWcSDwoHdGFyZ2V0cxgFIAMoCRINCgVzaGVldBgCIAEoCRIpCgZmb3JtYXQYAyABKA4yGS5ieXRlYmFzZS52MS5FeHBvcnRGb3JtYXQSFQoIcGFzc3dvcmQYBCABKAlIAIgBAUILCglfcGFzc3dvcmQauQEKCkRlcGxveW1lbnQSFAoMZW52aXJvbm1lbnRzGAEgAygJElIKF2RhdGFiYXNlX2dyb3VwX21hcHBpbmdzGAIgAygLMjEuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50LkRhdGFiYXNlR3JvdXBNYXBwaW5nGkEKFERhdGFiYXNlR3JvdXBNYXBwaW5nEhYKDmRhdGFiYXNlX2dyb3VwGAEgASgJEhEKCWRhdGFiYXNlcxgCIAMoCTo36kE0ChFieXRlYmFzZS5jb20vUGxhbhIfcHJvamVjdHMve3Byb2plY3R9L3BsYW5zL3twbGFufSJqChhMaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhMKC2xhdGVzdF9vbmx5GAIgASgIEg4KBmZpbHRlchgDIAEoCSJPChlMaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlEjIKD3BsYW5fY2hlY2tfcnVucxgBIAMoCzIZLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1biJhChRSdW5QbGFuQ2hlY2tzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhQKB3NwZWNfaWQYAiABKAlIAIgBAUIKCghfc3BlY19pZCIXChVSdW5QbGFuQ2hlY2tzUmVzcG9uc2UiZQofQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4SFwoPcGxhbl9jaGVja19ydW5zGAIgAygJIiIKIEJhdGNoQ2FuY2VsUGxhbkNoZWNrUnVuc1Jlc3BvbnNlIr8ICgxQbGFuQ2hlY2tSdW4SDAoEbmFtZRgBIAEoCRIsCgR0eXBlGAMgASgOMh4uYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlR5cGUSMAoGc3RhdHVzGAQgASgOMiAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlN0YXR1cxIOCgZ0YXJnZXQYBSABKAkSDQoFc2hlZXQYBiABKAkSMQoHcmVzdWx0cxgHIAMoCzIgLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQSDQoFZXJyb3IYCCABKAkSNAoLY3JlYXRlX3RpbWUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMamAQKBlJlc3VsdBIpCgZzdGF0dXMYASABKA4yGS5ieXRlYmFzZS52MS5BZHZpY2UuTGV2ZWwSDQoFdGl0bGUYAiABKAkSDwoHY29udGVudBgDIAEoCRIMCgRjb2RlGAQgASgFEk8KEnNxbF9zdW1tYXJ5X3JlcG9ydBgFIAEoCzIxLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuU3FsU3VtbWFyeVJlcG9ydEgAEk0KEXNxbF9yZXZpZXdfcmVwb3J0GAYgASgLMjAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlJlc3VsdC5TcWxSZXZpZXdSZXBvcnRIABqCAQoQU3FsU3VtbWFyeVJlcG9ydBIXCg9zdGF0ZW1lbnRfdHlwZXMYAiADKAkSFQoNYWZmZWN0ZWRfcm93cxgDIAEoAxI4ChFjaGFuZ2VkX3Jlc291cmNlcxgEIAEoCzIdLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZXNKBAgBEAIahQEKD1NxbFJldmlld1JlcG9ydBItCg5zdGFydF9wb3NpdGlvbhgFIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgGIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uSgQIARACSgQIAhADSgQIAxAESgQIBBAFQggKBnJlcG9ydCK1AQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASIgoeREFUQUJBU0VfU1RBVEVNRU5UX0ZBS0VfQURWSVNFEAESHQoZREFUQUJBU0VfU1RBVEVNRU5UX0FEVklTRRADEiUKIURBVEFCQVNFX1NUQVRFTUVOVF9TVU1NQVJZX1JFUE9SVBAFEhQKEERBVEFCQVNFX0NPTk5FQ1QQBhIXChNEQVRBQkFTRV9HSE9TVF9TWU5DEAciUQoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgsKB1JVTk5JTkcQARIICgRET05FEAISCgoGRkFJTEVEEAMSDAoIQ0FOQ0VMRUQQBEoECAIQAzLSCgoLUGxhblNlcnZpY2USewoHR2V0UGxhbhIbLmJ5dGViYXNlLnYxLkdldFBsYW5SZXF1ZXN0GhEuYnl0ZWJhc2UudjEuUGxhbiJA2kEEbmFtZYrqMAxiYi5wbGFucy5nZXSQ6jABgtPkkwIfEh0vdjEve25hbWU9cHJvamVjdHMvKi9wbGFucy8qfRKPAQoJTGlzdFBsYW5zEh0uYnl0ZWJhc2UudjEuTGlzdFBsYW5zUmVxdWVzdBoeLmJ5dGViYXNlLnYxLkxpc3RQbGFuc1Jlc3BvbnNlIkPaQQZwYXJlbnSK6jANYmIucGxhbnMubGlzdJDqMAGC0+STAh8SHS92MS97cGFyZW50PXByb2plY3RzLyp9L3BsYW5zEp4BCgtTZWFyY2hQbGFucxIfLmJ5dGViYXNlLnYxLlNlYXJjaFBsYW5zUmVxdWVzdBogLmJ5dGViYXNlLnYxLlNlYXJjaFBsYW5zUmVzcG9uc2UiTNpBBnBhcmVudIrqMAxiYi5wbGFucy5nZXSQ6jACgtPkkwIpOgEqIiQvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFuczpzZWFyY2gSlQEKCkNyZWF0ZVBsYW4SHi5ieXRlYmFzZS52MS5DcmVhdGVQbGFuUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlBsYW4iVNpBC3BhcmVudCxwbGFuiuowD2JiLnBsYW5zLmNyZWF0ZZDqMAGY6jABgtPkkwIlOgRwbGFuIh0vdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFucxKfAQoKVXBkYXRlUGxhbhIeLmJ5dGViYXNlLnYxLlVwZGF0ZVBsYW5SZXF1ZXN0GhEuYnl0ZWJhc2UudjEuUGxhbiJe2kEQcGxhbix1cGRhdGVfbWFza4rqMA9iYi5wbGFucy51cGRhdGWQ6jACmOowAYLT5JMCKjoEcGxhbjIiL3YxL3twbGFuLm5hbWU9cHJvamVjdHMvKi9wbGFucy8qfRK/AQoRTGlzdFBsYW5DaGVja1J1bnMSJS5ieXRlYmFzZS52MS5MaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QaJi5ieXRlYmFzZS52MS5MaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlIlvaQQZwYXJlbnSK6jAVYmIucGxhbkNoZWNrUnVucy5saXN0kOowAYLT5JMCLxItL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9wbGFucy8qfS9wbGFuQ2hlY2tSdW5zErEBCg1SdW5QbGFuQ2hlY2tzEiEuYnl0ZWJhc2UudjEuUnVuUGxhbkNoZWNrc1JlcXVlc3QaIi5ieXRlYmFzZS52MS5SdW5QbGFuQ2hlY2tzUmVzcG9uc2UiWdpBBG5hbWWK6jAUYmIucGxhbkNoZWNrUnVucy5ydW6Q6jABgtPkkwIwOgEqIisvdjEve25hbWU9cHJvamVjdHMvKi9wbGFucy8qfTpydW5QbGFuQ2hlY2tzEuIBChhCYXRjaENhbmNlbFBsYW5DaGVja1J1bnMSLC5ieXRlYmFzZS52MS5CYXRjaENhbmNlbFBsYW5DaGVja1J1bnNSZXF1ZXN0Gi0uYnl0ZWJhc2UudjEuQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVzcG9uc2UiadpBBnBhcmVudIrqMBRiYi5wbGFuQ2hlY2tSdW5zLnJ1bpDqMAGC0+STAj46ASoiOS92MS97cGFyZW50PXByb2plY3RzLyovcGxhbnMvKn0vcGxhbkNoZWNrUnVuczpiYXRjaENhbmNlbEKmAQoPY29tLmJ5dGViYXNlLnYxQhBQbGFuU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_service, file_v1_sql_service]);
+  fileDesc("ChV2MS9wbGFuX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIjkKDkdldFBsYW5SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4iZwoQTGlzdFBsYW5zUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiTgoRTGlzdFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJgChJTZWFyY2hQbGFuc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQISEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkSDgoGZmlsdGVyGAQgASgJIlAKE1NlYXJjaFBsYW5zUmVzcG9uc2USIAoFcGxhbnMYASADKAsyES5ieXRlYmFzZS52MS5QbGFuEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJnChFDcmVhdGVQbGFuUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSJAoEcGxhbhgCIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAiKGAQoRVXBkYXRlUGxhblJlcXVlc3QSJAoEcGxhbhgBIAEoCzIRLmJ5dGViYXNlLnYxLlBsYW5CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAhIVCg1hbGxvd19taXNzaW5nGAMgASgIIr4NCgRQbGFuEgwKBG5hbWUYASABKAkSIQoFc3RhdGUYAiABKA4yEi5ieXRlYmFzZS52MS5TdGF0ZRISCgVpc3N1ZRgDIAEoCUID4EEDEhQKB3JvbGxvdXQYDyABKAlCA+BBAxIXCgV0aXRsZRgEIAEoCUIIukgFcgMYyAESHQoLZGVzY3JpcHRpb24YBSABKAlCCLpIBXIDGJBOEiUKBXNwZWNzGA4gAygLMhYuYnl0ZWJhc2UudjEuUGxhbi5TcGVjEhQKB2NyZWF0b3IYCCABKAlCA+BBAxI0CgtjcmVhdGVfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxJYChtwbGFuX2NoZWNrX3J1bl9zdGF0dXNfY291bnQYCyADKAsyLi5ieXRlYmFzZS52MS5QbGFuLlBsYW5DaGVja1J1blN0YXR1c0NvdW50RW50cnlCA+BBAxIwCgpkZXBsb3ltZW50GA0gASgLMhwuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50GvIBCgRTcGVjEgoKAmlkGAUgASgJEkgKFmNyZWF0ZV9kYXRhYmFzZV9jb25maWcYASABKAsyJi5ieXRlYmFzZS52MS5QbGFuLkNyZWF0ZURhdGFiYXNlQ29uZmlnSAASSAoWY2hhbmdlX2RhdGFiYXNlX2NvbmZpZxgCIAEoCzImLmJ5dGViYXNlLnYxLlBsYW4uQ2hhbmdlRGF0YWJhc2VDb25maWdIABJAChJleHBvcnRfZGF0YV9jb25maWcYByABKAsyIi5ieXRlYmFzZS52MS5QbGFuLkV4cG9ydERhdGFDb25maWdIAEIICgZjb25maWcaPgocUGxhbkNoZWNrUnVuU3RhdHVzQ291bnRFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAU6AjgBGs4BChRDcmVhdGVEYXRhYmFzZUNvbmZpZxITCgZ0YXJnZXQYASABKAlCA+BBAhIVCghkYXRhYmFzZRgCIAEoCUID4EECEhIKBXRhYmxlGAMgASgJQgPgQQESGgoNY2hhcmFjdGVyX3NldBgEIAEoCUID4EEBEhYKCWNvbGxhdGlvbhgFIAEoCUID4EEBEhQKB2NsdXN0ZXIYBiABKAlCA+BBARISCgVvd25lchgHIAEoCUID4EEBEhgKC2Vudmlyb25tZW50GAkgASgJQgPgQQEa7gIKFENoYW5nZURhdGFiYXNlQ29uZmlnEg8KB3RhcmdldHMYCiADKAkSDQoFc2hlZXQYAiABKAkSKgoHcmVsZWFzZRgJIAEoCUIZ+kEWChRieXRlYmFzZS5jb20vUmVsZWFzZRItCgR0eXBlGAMgASgOMh8uYnl0ZWJhc2UudjEuRGF0YWJhc2VDaGFuZ2VUeXBlEjIKDm1pZ3JhdGlvbl90eXBlGAsgASgOMhouYnl0ZWJhc2UudjEuTWlncmF0aW9uVHlwZRJLCgtnaG9zdF9mbGFncxgHIAMoCzI2LmJ5dGViYXNlLnYxLlBsYW4uQ2hhbmdlRGF0YWJhc2VDb25maWcuR2hvc3RGbGFnc0VudHJ5EhsKE2VuYWJsZV9wcmlvcl9iYWNrdXAYCCABKAgaMQoPR2hvc3RGbGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFKBAgFEAZKBAgGEAcagQEKEEV4cG9ydERhdGFDb25maWcSDwoHdGFyZ2V0cxgFIAMoCRINCgVzaGVldBgCIAEoCRIpCgZmb3JtYXQYAyABKA4yGS5ieXRlYmFzZS52MS5FeHBvcnRGb3JtYXQSFQoIcGFzc3dvcmQYBCABKAlIAIgBAUILCglfcGFzc3dvcmQauQEKCkRlcGxveW1lbnQSFAoMZW52aXJvbm1lbnRzGAEgAygJElIKF2RhdGFiYXNlX2dyb3VwX21hcHBpbmdzGAIgAygLMjEuYnl0ZWJhc2UudjEuUGxhbi5EZXBsb3ltZW50LkRhdGFiYXNlR3JvdXBNYXBwaW5nGkEKFERhdGFiYXNlR3JvdXBNYXBwaW5nEhYKDmRhdGFiYXNlX2dyb3VwGAEgASgJEhEKCWRhdGFiYXNlcxgCIAMoCTo36kE0ChFieXRlYmFzZS5jb20vUGxhbhIfcHJvamVjdHMve3Byb2plY3R9L3BsYW5zL3twbGFufSJqChhMaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhMKC2xhdGVzdF9vbmx5GAIgASgIEg4KBmZpbHRlchgDIAEoCSJPChlMaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlEjIKD3BsYW5fY2hlY2tfcnVucxgBIAMoCzIZLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1biJhChRSdW5QbGFuQ2hlY2tzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEWJ5dGViYXNlLmNvbS9QbGFuEhQKB3NwZWNfaWQYAiABKAlIAIgBAUIKCghfc3BlY19pZCIXChVSdW5QbGFuQ2hlY2tzUmVzcG9uc2UiZQofQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRYnl0ZWJhc2UuY29tL1BsYW4SFwoPcGxhbl9jaGVja19ydW5zGAIgAygJIiIKIEJhdGNoQ2FuY2VsUGxhbkNoZWNrUnVuc1Jlc3BvbnNlIt0ICgxQbGFuQ2hlY2tSdW4SDAoEbmFtZRgBIAEoCRIsCgR0eXBlGAMgASgOMh4uYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlR5cGUSMAoGc3RhdHVzGAQgASgOMiAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlN0YXR1cxIOCgZ0YXJnZXQYBSABKAkSDQoFc2hlZXQYBiABKAkSMQoHcmVzdWx0cxgHIAMoCzIgLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQSDQoFZXJyb3IYCCABKAkSNAoLY3JlYXRlX3RpbWUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMamAQKBlJlc3VsdBIpCgZzdGF0dXMYASABKA4yGS5ieXRlYmFzZS52MS5BZHZpY2UuTGV2ZWwSDQoFdGl0bGUYAiABKAkSDwoHY29udGVudBgDIAEoCRIMCgRjb2RlGAQgASgFEk8KEnNxbF9zdW1tYXJ5X3JlcG9ydBgFIAEoCzIxLmJ5dGViYXNlLnYxLlBsYW5DaGVja1J1bi5SZXN1bHQuU3FsU3VtbWFyeVJlcG9ydEgAEk0KEXNxbF9yZXZpZXdfcmVwb3J0GAYgASgLMjAuYnl0ZWJhc2UudjEuUGxhbkNoZWNrUnVuLlJlc3VsdC5TcWxSZXZpZXdSZXBvcnRIABqCAQoQU3FsU3VtbWFyeVJlcG9ydBIXCg9zdGF0ZW1lbnRfdHlwZXMYAiADKAkSFQoNYWZmZWN0ZWRfcm93cxgDIAEoAxI4ChFjaGFuZ2VkX3Jlc291cmNlcxgEIAEoCzIdLmJ5dGViYXNlLnYxLkNoYW5nZWRSZXNvdXJjZXNKBAgBEAIahQEKD1NxbFJldmlld1JlcG9ydBItCg5zdGFydF9wb3NpdGlvbhgFIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uEisKDGVuZF9wb3NpdGlvbhgGIAEoCzIVLmJ5dGViYXNlLnYxLlBvc2l0aW9uSgQIARACSgQIAhADSgQIAxAESgQIBBAFQggKBnJlcG9ydCLTAQoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASIgoeREFUQUJBU0VfU1RBVEVNRU5UX0ZBS0VfQURWSVNFEAESHQoZREFUQUJBU0VfU1RBVEVNRU5UX0FEVklTRRADEiUKIURBVEFCQVNFX1NUQVRFTUVOVF9TVU1NQVJZX1JFUE9SVBAFEhQKEERBVEFCQVNFX0NPTk5FQ1QQBhIXChNEQVRBQkFTRV9HSE9TVF9TWU5DEAcSHAoYREFUQUJBU0VfUEdfT05MSU5FX0NIRUNLEAgiUQoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgsKB1JVTk5JTkcQARIICgRET05FEAISCgoGRkFJTEVEEAMSDAoIQ0FOQ0VMRUQQBEoECAIQAzLSCgoLUGxhblNlcnZpY2USewoHR2V0UGxhbhIbLmJ5dGViYXNlLnYxLkdldFBsYW5SZXF1ZXN0GhEuYnl0ZWJhc2UudjEuUGxhbiJA2kEEbmFtZYrqMAxiYi5wbGFucy5nZXSQ6jABgtPkkwIfEh0vdjEve25hbWU9cHJvamVjdHMvKi9wbGFucy8qfRKPAQoJTGlzdFBsYW5zEh0uYnl0ZWJhc2UudjEuTGlzdFBsYW5zUmVxdWVzdBoeLmJ5dGViYXNlLnYxLkxpc3RQbGFuc1Jlc3BvbnNlIkPaQQZwYXJlbnSK6jANYmIucGxhbnMubGlzdJDqMAGC0+STAh8SHS92MS97cGFyZW50PXByb2plY3RzLyp9L3BsYW5zEp4BCgtTZWFyY2hQbGFucxIfLmJ5dGViYXNlLnYxLlNlYXJjaFBsYW5zUmVxdWVzdBogLmJ5dGViYXNlLnYxLlNlYXJjaFBsYW5zUmVzcG9uc2UiTNpBBnBhcmVudIrqMAxiYi5wbGFucy5nZXSQ6jACgtPkkwIpOgEqIiQvdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFuczpzZWFyY2gSlQEKCkNyZWF0ZVBsYW4SHi5ieXRlYmFzZS52MS5DcmVhdGVQbGFuUmVxdWVzdBoRLmJ5dGViYXNlLnYxLlBsYW4iVNpBC3BhcmVudCxwbGFuiuowD2JiLnBsYW5zLmNyZWF0ZZDqMAGY6jABgtPkkwIlOgRwbGFuIh0vdjEve3BhcmVudD1wcm9qZWN0cy8qfS9wbGFucxKfAQoKVXBkYXRlUGxhbhIeLmJ5dGViYXNlLnYxLlVwZGF0ZVBsYW5SZXF1ZXN0GhEuYnl0ZWJhc2UudjEuUGxhbiJe2kEQcGxhbix1cGRhdGVfbWFza4rqMA9iYi5wbGFucy51cGRhdGWQ6jACmOowAYLT5JMCKjoEcGxhbjIiL3YxL3twbGFuLm5hbWU9cHJvamVjdHMvKi9wbGFucy8qfRK/AQoRTGlzdFBsYW5DaGVja1J1bnMSJS5ieXRlYmFzZS52MS5MaXN0UGxhbkNoZWNrUnVuc1JlcXVlc3QaJi5ieXRlYmFzZS52MS5MaXN0UGxhbkNoZWNrUnVuc1Jlc3BvbnNlIlvaQQZwYXJlbnSK6jAVYmIucGxhbkNoZWNrUnVucy5saXN0kOowAYLT5JMCLxItL3YxL3twYXJlbnQ9cHJvamVjdHMvKi9wbGFucy8qfS9wbGFuQ2hlY2tSdW5zErEBCg1SdW5QbGFuQ2hlY2tzEiEuYnl0ZWJhc2UudjEuUnVuUGxhbkNoZWNrc1JlcXVlc3QaIi5ieXRlYmFzZS52MS5SdW5QbGFuQ2hlY2tzUmVzcG9uc2UiWdpBBG5hbWWK6jAUYmIucGxhbkNoZWNrUnVucy5ydW6Q6jABgtPkkwIwOgEqIisvdjEve25hbWU9cHJvamVjdHMvKi9wbGFucy8qfTpydW5QbGFuQ2hlY2tzEuIBChhCYXRjaENhbmNlbFBsYW5DaGVja1J1bnMSLC5ieXRlYmFzZS52MS5CYXRjaENhbmNlbFBsYW5DaGVja1J1bnNSZXF1ZXN0Gi0uYnl0ZWJhc2UudjEuQmF0Y2hDYW5jZWxQbGFuQ2hlY2tSdW5zUmVzcG9uc2UiadpBBnBhcmVudIrqMBRiYi5wbGFuQ2hlY2tSdW5zLnJ1bpDqMAGC0+STAj46ASoiOS92MS97cGFyZW50PXByb2plY3RzLyovcGxhbnMvKn0vcGxhbkNoZWNrUnVuczpiYXRjaENhbmNlbEKmAQoPY29tLmJ5dGViYXNlLnYxQhBQbGFuU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_database_service, file_v1_sql_service]);
 
 /**
  * Describes the message bytebase.v1.GetPlanRequest.
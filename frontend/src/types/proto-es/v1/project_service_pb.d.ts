@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
-import type { EmptySchema, FieldMask } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
 import type { GetIamPolicyRequestSchema, IamPolicy, IamPolicySchema, SetIamPolicyRequestSchema } from "./iam_policy_pb";
 import type { State } from "./common_pb";
 
@@ -800,10 +800,27 @@ export declare type Webhook = Message<"bytebase.v1.Webhook"> & {
    * - ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE
    * - NOTIFY_ISSUE_APPROVED
    * - NOTIFY_PIPELINE_ROLLOUT
+   * - NOTIFY_GRANT_EXPIRED
    *
    * @generated from field: repeated bytebase.v1.Activity.Type notification_types = 5;
    */
   notificationTypes: Activity_Type[];
+
+  /**
+   * signing_secret is used to sign the event envelope of CUSTOM webhooks.
+   * The signature is the hex encoded HMAC-SHA256 of "{timestamp}.{body}",
+   * sent in the X-Bytebase-Signature header as "sha256={signature}".
+   *
+   * @generated from field: string signing_secret = 7;
+   */
+  signingSecret: string;
+
+  /**
+   * headers are additional HTTP headers sent to CUSTOM webhooks.
+   *
+   * @generated from field: map<string, string> headers = 8;
+   */
+  headers: { [key: string]: string };
 };
 
 /**
@@ -873,6 +890,13 @@ export enum Webhook_Type {
    * @generated from enum value: LARK = 8;
    */
   LARK = 8,
+
+  /**
+   * Custom HTTP endpoint receiving a signed JSON event envelope.
+   *
+   * @generated from enum value: CUSTOM = 9;
+   */
+  CUSTOM = 9,
 }
 
 /**
@@ -880,6 +904,218 @@ export enum Webhook_Type {
  */
 export declare const Webhook_TypeSchema: GenEnum<Webhook_Type>;
 
+/**
+ * @generated from message bytebase.v1.ListWebhookDeliveriesRequest
+ */
+export declare type ListWebhookDeliveriesRequest = Message<"bytebase.v1.ListWebhookDeliveriesRequest"> & {
+  /**
+   * The parent webhook.
+   * Format: projects/{project}/webhooks/{webhook}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * The maximum number of deliveries to return. The service may return fewer than this value.
+   * If unspecified, at most 10 deliveries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * A page token, received from a previous `ListWebhookDeliveries` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListWebhookDeliveries` must match
+   * the call that provided the page token.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export declare const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest>;
+
+/**
+ * @generated from message bytebase.v1.ListWebhookDeliveriesResponse
+ */
+export declare type ListWebhookDeliveriesResponse = Message<"bytebase.v1.ListWebhookDeliveriesResponse"> & {
+  /**
+   * The deliveries from the specified request, most recent first.
+   *
+   * @generated from field: repeated bytebase.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message bytebase.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export declare const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse>;
+
+/**
+ * @generated from message bytebase.v1.RetryWebhookDeliveryRequest
+ */
+export declare type RetryWebhookDeliveryRequest = Message<"bytebase.v1.RetryWebhookDeliveryRequest"> & {
+  /**
+   * The delivery to retry.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message bytebase.v1.RetryWebhookDeliveryRequest.
+ * Use `create(RetryWebhookDeliveryRequestSchema)` to create a new message.
+ */
+export declare const RetryWebhookDeliveryRequestSchema: GenMessage<RetryWebhookDeliveryRequest>;
+
+/**
+ * WebhookDelivery is a record of posting an event to a webhook.
+ *
+ * @generated from message bytebase.v1.WebhookDelivery
+ */
+export declare type WebhookDelivery = Message<"bytebase.v1.WebhookDelivery"> & {
+  /**
+   * The name of the delivery.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The activity type that triggered the delivery.
+   *
+   * @generated from field: bytebase.v1.Activity.Type event_type = 2;
+   */
+  eventType: Activity_Type;
+
+  /**
+   * The status of the delivery.
+   *
+   * @generated from field: bytebase.v1.WebhookDelivery.Status status = 3;
+   */
+  status: WebhookDelivery_Status;
+
+  /**
+   * The number of attempts made so far.
+   *
+   * @generated from field: int32 attempts = 4;
+   */
+  attempts: number;
+
+  /**
+   * The JSON event envelope of the delivery.
+   * It is the request body posted to CUSTOM webhooks.
+   *
+   * @generated from field: string request_body = 5;
+   */
+  requestBody: string;
+
+  /**
+   * The error of the latest attempt, empty if the delivery succeeded.
+   *
+   * @generated from field: string error = 6;
+   */
+  error: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 7;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp update_time = 8;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * The HTTP status code of the latest attempt, zero if no response was received.
+   *
+   * @generated from field: int32 status_code = 9;
+   */
+  statusCode: number;
+
+  /**
+   * The latency of the latest attempt.
+   *
+   * @generated from field: google.protobuf.Duration latency = 10;
+   */
+  latency?: Duration;
+
+  /**
+   * The time of the next automatic retry, unset if no retry is scheduled.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_time = 11;
+   */
+  nextAttemptTime?: Timestamp;
+};
+
+/**
+ * Describes the message bytebase.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export declare const WebhookDeliverySchema: GenMessage<WebhookDelivery>;
+
+/**
+ * Delivery status.
+ *
+ * @generated from enum bytebase.v1.WebhookDelivery.Status
+ */
+export enum WebhookDelivery_Status {
+  /**
+   * Unspecified status.
+   *
+   * @generated from enum value: STATUS_UNSPECIFIED = 0;
+   */
+  STATUS_UNSPECIFIED = 0,
+
+  /**
+   * The delivery is being attempted or waiting for a retry.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The webhook endpoint accepted the delivery.
+   *
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * The delivery failed and will not be retried automatically.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum bytebase.v1.WebhookDelivery.Status.
+ */
+export declare const WebhookDelivery_StatusSchema: GenEnum<WebhookDelivery_Status>;
+
 /**
  * Activity types for webhook notifications.
  *
@@ -923,6 +1159,13 @@ export enum Activity_Type {
    */
   NOTIFY_PIPELINE_ROLLOUT = 24,
 
+  /**
+   * NOTIFY_GRANT_EXPIRED represents the notification of revoking the expired role granted by the request.
+   *
+   * @generated from enum value: NOTIFY_GRANT_EXPIRED = 25;
+   */
+  NOTIFY_GRANT_EXPIRED = 25,
+
   /**
    * Issue related activity types.
    *
@@ -1152,5 +1395,27 @@ export declare const ProjectService: GenService<{
     input: typeof TestWebhookRequestSchema;
     output: typeof TestWebhookResponseSchema;
   },
+  /**
+   * Lists the delivery attempts of a webhook.
+   * Permissions required: bb.projects.get
+   *
+   * @generated from rpc bytebase.v1.ProjectService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * Retries a webhook delivery immediately, regardless of its status.
+   * Permissions required: bb.projects.update
+   *
+   * @generated from rpc bytebase.v1.ProjectService.RetryWebhookDelivery
+   */
+  retryWebhookDelivery: {
+    methodKind: "unary";
+    input: typeof RetryWebhookDeliveryRequestSchema;
+    output: typeof WebhookDeliverySchema;
+  },
 }>;
 
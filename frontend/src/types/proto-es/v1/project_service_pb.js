@@ -3,7 +3,7 @@
 /* eslint-disable */
 
 import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
-import { file_google_protobuf_empty, file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../buf/validate/validate_pb";
 import { file_google_api_annotations } from "../google/api/annotations_pb";
 import { file_google_api_client } from "../google/api/client_pb";
@@ -17,7 +17,7 @@ import { file_v1_iam_policy } from "./iam_policy_pb";
  * Describes the file v1/project_service.proto.
  */
 export const file_v1_project_service = /*@__PURE__*/
-  fileDesc("Chh2MS9wcm9qZWN0X3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIj8KEUdldFByb2plY3RSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QiYgoTTGlzdFByb2plY3RzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCRIUCgxzaG93X2RlbGV0ZWQYAyABKAgSDgoGZmlsdGVyGAQgASgJIlcKFExpc3RQcm9qZWN0c1Jlc3BvbnNlEiYKCHByb2plY3RzGAEgAygLMhQuYnl0ZWJhc2UudjEuUHJvamVjdBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiZAoVU2VhcmNoUHJvamVjdHNSZXF1ZXN0EhQKDHNob3dfZGVsZXRlZBgBIAEoCBIOCgZmaWx0ZXIYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkiWQoWU2VhcmNoUHJvamVjdHNSZXNwb25zZRImCghwcm9qZWN0cxgBIAMoCzIULmJ5dGViYXNlLnYxLlByb2plY3QSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIlYKFENyZWF0ZVByb2plY3RSZXF1ZXN0EioKB3Byb2plY3QYASABKAsyFC5ieXRlYmFzZS52MS5Qcm9qZWN0QgPgQQISEgoKcHJvamVjdF9pZBgCIAEoCSKKAQoUVXBkYXRlUHJvamVjdFJlcXVlc3QSKgoHcHJvamVjdBgBIAEoCzIULmJ5dGViYXNlLnYxLlByb2plY3RCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNYWxsb3dfbWlzc2luZxgDIAEoCCJgChREZWxldGVQcm9qZWN0UmVxdWVzdBIqCgRuYW1lGAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0Eg0KBWZvcmNlGAIgASgIEg0KBXB1cmdlGAMgASgIIkQKFlVuZGVsZXRlUHJvamVjdFJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdCJYChpCYXRjaERlbGV0ZVByb2plY3RzUmVxdWVzdBIrCgVuYW1lcxgBIAMoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBINCgVmb3JjZRgCIAEoCCI9ChhCYXRjaEdldElhbVBvbGljeVJlcXVlc3QSEgoFc2NvcGUYASABKAlCA+BBAhINCgVuYW1lcxgCIAMoCSKxAQoZQmF0Y2hHZXRJYW1Qb2xpY3lSZXNwb25zZRJLCg5wb2xpY3lfcmVzdWx0cxgBIAMoCzIzLmJ5dGViYXNlLnYxLkJhdGNoR2V0SWFtUG9saWN5UmVzcG9uc2UuUG9saWN5UmVzdWx0GkcKDFBvbGljeVJlc3VsdBIPCgdwcm9qZWN0GAEgASgJEiYKBnBvbGljeRgCIAEoCzIWLmJ5dGViYXNlLnYxLklhbVBvbGljeSI0CgVMYWJlbBINCgV2YWx1ZRgBIAEoCRINCgVjb2xvchgCIAEoCRINCgVncm91cBgDIAEoCSKpBgoHUHJvamVjdBIMCgRuYW1lGAEgASgJEiEKBXN0YXRlGAMgASgOMhIuYnl0ZWJhc2UudjEuU3RhdGUSFwoFdGl0bGUYBCABKAlCCLpIBXIDGMgBEiYKCHdlYmhvb2tzGAsgAygLMhQuYnl0ZWJhc2UudjEuV2ViaG9vaxIlCh1kYXRhX2NsYXNzaWZpY2F0aW9uX2NvbmZpZ19pZBgMIAEoCRIoCgxpc3N1ZV9sYWJlbHMYDSADKAsyEi5ieXRlYmFzZS52MS5MYWJlbBIaChJmb3JjZV9pc3N1ZV9sYWJlbHMYDiABKAgSHgoWYWxsb3dfbW9kaWZ5X3N0YXRlbWVudBgPIAEoCBIaChJhdXRvX3Jlc29sdmVfaXNzdWUYECABKAgSGwoTZW5mb3JjZV9pc3N1ZV90aXRsZRgRIAEoCBIaChJhdXRvX2VuYWJsZV9iYWNrdXAYEiABKAgSGgoSc2tpcF9iYWNrdXBfZXJyb3JzGBMgASgIEiUKHXBvc3RncmVzX2RhdGFiYXNlX3RlbmFudF9tb2RlGBQgASgIEhsKE2FsbG93X3NlbGZfYXBwcm92YWwYFSABKAgSSQoWZXhlY3V0aW9uX3JldHJ5X3BvbGljeRgWIAEoCzIpLmJ5dGViYXNlLnYxLlByb2plY3QuRXhlY3V0aW9uUmV0cnlQb2xpY3kSGAoQY2lfc2FtcGxpbmdfc2l6ZRgXIAEoBRIiChpwYXJhbGxlbF90YXNrc19wZXJfcm9sbG91dBgYIAEoBRIwCgZsYWJlbHMYGSADKAsyIC5ieXRlYmFzZS52MS5Qcm9qZWN0LkxhYmVsc0VudHJ5EhoKEmVuZm9yY2Vfc3FsX3JldmlldxgaIAEoCBovChRFeGVjdXRpb25SZXRyeVBvbGljeRIXCg9tYXhpbXVtX3JldHJpZXMYASABKAUaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ATot6kEqChRieXRlYmFzZS5jb20vUHJvamVjdBIScHJvamVjdHMve3Byb2plY3R9SgQIAhADIm4KEUFkZFdlYmhvb2tSZXF1ZXN0Ei0KB3Byb2plY3QYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSKgoHd2ViaG9vaxgCIAEoCzIULmJ5dGViYXNlLnYxLldlYmhvb2tCA+BBAiKKAQoUVXBkYXRlV2ViaG9va1JlcXVlc3QSKgoHd2ViaG9vaxgBIAEoCzIULmJ5dGViYXNlLnYxLldlYmhvb2tCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNYWxsb3dfbWlzc2luZxgDIAEoCCJCChRSZW1vdmVXZWJob29rUmVxdWVzdBIqCgd3ZWJob29rGAEgASgLMhQuYnl0ZWJhc2UudjEuV2ViaG9va0ID4EECIm8KElRlc3RXZWJob29rUmVxdWVzdBItCgdwcm9qZWN0GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EioKB3dlYmhvb2sYAiABKAsyFC5ieXRlYmFzZS52MS5XZWJob29rQgPgQQIiJAoTVGVzdFdlYmhvb2tSZXNwb25zZRINCgVlcnJvchgBIAEoCSLyAgoHV2ViaG9vaxIMCgRuYW1lGAEgASgJEiwKBHR5cGUYAiABKA4yGS5ieXRlYmFzZS52MS5XZWJob29rLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEhAKA3VybBgEIAEoCUID4EECEhYKDmRpcmVjdF9tZXNzYWdlGAYgASgIEjsKEm5vdGlmaWNhdGlvbl90eXBlcxgFIAMoDjIaLmJ5dGViYXNlLnYxLkFjdGl2aXR5LlR5cGVCA+BBBiJuCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVTTEFDSxABEgsKB0RJU0NPUkQQAhIJCgVURUFNUxADEgwKCERJTkdUQUxLEAQSCgoGRkVJU0hVEAUSCQoFV0VDT00QBhIICgRMQVJLEAg6QOpBPQoUYnl0ZWJhc2UuY29tL1dlYmhvb2sSJXByb2plY3RzL3twcm9qZWN0fS93ZWJob29rcy97d2ViaG9va30irAIKCEFjdGl2aXR5Ip8CCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIZChVOT1RJRllfSVNTVUVfQVBQUk9WRUQQFxIbChdOT1RJRllfUElQRUxJTkVfUk9MTE9VVBAYEhAKDElTU1VFX0NSRUFURRABEhgKFElTU1VFX0NPTU1FTlRfQ1JFQVRFEAISFgoSSVNTVUVfRklFTERfVVBEQVRFEAMSFwoTSVNTVUVfU1RBVFVTX1VQREFURRAEEhkKFUlTU1VFX0FQUFJPVkFMX05PVElGWRAVEiYKIklTU1VFX1BJUEVMSU5FX1NUQUdFX1NUQVRVU19VUERBVEUQBRIpCiVJU1NVRV9QSVBFTElORV9UQVNLX1JVTl9TVEFUVVNfVVBEQVRFEBYynRIKDlByb2plY3RTZXJ2aWNlEn8KCkdldFByb2plY3QSHi5ieXRlYmFzZS52MS5HZXRQcm9qZWN0UmVxdWVzdBoULmJ5dGViYXNlLnYxLlByb2plY3QiO9pBBG5hbWWK6jAPYmIucHJvamVjdHMuZ2V0kOowAYLT5JMCFxIVL3YxL3tuYW1lPXByb2plY3RzLyp9EoQBCgxMaXN0UHJvamVjdHMSIC5ieXRlYmFzZS52MS5MaXN0UHJvamVjdHNSZXF1ZXN0GiEuYnl0ZWJhc2UudjEuTGlzdFByb2plY3RzUmVzcG9uc2UiL9pBAIrqMBBiYi5wcm9qZWN0cy5saXN0kOowAYLT5JMCDhIML3YxL3Byb2plY3RzEoABCg5TZWFyY2hQcm9qZWN0cxIiLmJ5dGViYXNlLnYxLlNlYXJjaFByb2plY3RzUmVxdWVzdBojLmJ5dGViYXNlLnYxLlNlYXJjaFByb2plY3RzUmVzcG9uc2UiJdpBAJDqMAKC0+STAhg6ASoiEy92MS9wcm9qZWN0czpzZWFyY2gShAEKDUNyZWF0ZVByb2plY3QSIS5ieXRlYmFzZS52MS5DcmVhdGVQcm9qZWN0UmVxdWVzdBoULmJ5dGViYXNlLnYxLlByb2plY3QiOtpBAIrqMBJiYi5wcm9qZWN0cy5jcmVhdGWQ6jABgtPkkwIXOgdwcm9qZWN0IgwvdjEvcHJvamVjdHMSqAEKDVVwZGF0ZVByb2plY3QSIS5ieXRlYmFzZS52MS5VcGRhdGVQcm9qZWN0UmVxdWVzdBoULmJ5dGViYXNlLnYxLlByb2plY3QiXtpBE3Byb2plY3QsdXBkYXRlX21hc2uK6jASYmIucHJvamVjdHMudXBkYXRlkOowAYLT5JMCKDoHcHJvamVjdDIdL3YxL3twcm9qZWN0Lm5hbWU9cHJvamVjdHMvKn0SjgEKDURlbGV0ZVByb2plY3QSIS5ieXRlYmFzZS52MS5EZWxldGVQcm9qZWN0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSJC2kEEbmFtZYrqMBJiYi5wcm9qZWN0cy5kZWxldGWQ6jABmOowAYLT5JMCFyoVL3YxL3tuYW1lPXByb2plY3RzLyp9EpcBCg9VbmRlbGV0ZVByb2plY3QSIy5ieXRlYmFzZS52MS5VbmRlbGV0ZVByb2plY3RSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCJJiuowFGJiLnByb2plY3RzLnVuZGVsZXRlkOowAZjqMAGC0+STAiM6ASoiHi92MS97bmFtZT1wcm9qZWN0cy8qfTp1bmRlbGV0ZRKZAQoTQmF0Y2hEZWxldGVQcm9qZWN0cxInLmJ5dGViYXNlLnYxLkJhdGNoRGVsZXRlUHJvamVjdHNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IkGK6jASYmIucHJvamVjdHMuZGVsZXRlkOowAZjqMAGC0+STAh06ASoiGC92MS9wcm9qZWN0czpiYXRjaERlbGV0ZRKYAQoMR2V0SWFtUG9saWN5EiAuYnl0ZWJhc2UudjEuR2V0SWFtUG9saWN5UmVxdWVzdBoWLmJ5dGViYXNlLnYxLklhbVBvbGljeSJOiuowGGJiLnByb2plY3RzLmdldElhbVBvbGljeZDqMAGC0+STAigSJi92MS97cmVzb3VyY2U9cHJvamVjdHMvKn06Z2V0SWFtUG9saWN5ErABChFCYXRjaEdldElhbVBvbGljeRIlLmJ5dGViYXNlLnYxLkJhdGNoR2V0SWFtUG9saWN5UmVxdWVzdBomLmJ5dGViYXNlLnYxLkJhdGNoR2V0SWFtUG9saWN5UmVzcG9uc2UiTIrqMBhiYi5wcm9qZWN0cy5nZXRJYW1Qb2xpY3mQ6jACgtPkkwImEiQvdjEve3Njb3BlPSovKn0vaWFtUG9saWNpZXM6YmF0Y2hHZXQSnwEKDFNldElhbVBvbGljeRIgLmJ5dGViYXNlLnYxLlNldElhbVBvbGljeVJlcXVlc3QaFi5ieXRlYmFzZS52MS5JYW1Qb2xpY3kiVYrqMBhiYi5wcm9qZWN0cy5zZXRJYW1Qb2xpY3mQ6jABmOowAYLT5JMCKzoBKiImL3YxL3tyZXNvdXJjZT1wcm9qZWN0cy8qfTpzZXRJYW1Qb2xpY3kSjAEKCkFkZFdlYmhvb2sSHi5ieXRlYmFzZS52MS5BZGRXZWJob29rUmVxdWVzdBoULmJ5dGViYXNlLnYxLlByb2plY3QiSIrqMBJiYi5wcm9qZWN0cy51cGRhdGWQ6jABgtPkkwIoOgEqIiMvdjEve3Byb2plY3Q9cHJvamVjdHMvKn06YWRkV2ViaG9vaxLBAQoNVXBkYXRlV2ViaG9vaxIhLmJ5dGViYXNlLnYxLlVwZGF0ZVdlYmhvb2tSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCJ32kETd2ViaG9vayx1cGRhdGVfbWFza4rqMBJiYi5wcm9qZWN0cy51cGRhdGWQ6jABgtPkkwJBOgd3ZWJob29rMjYvdjEve3dlYmhvb2submFtZT1wcm9qZWN0cy8qL3dlYmhvb2tzLyp9OnVwZGF0ZVdlYmhvb2sSpQEKDVJlbW92ZVdlYmhvb2sSIS5ieXRlYmFzZS52MS5SZW1vdmVXZWJob29rUmVxdWVzdBoULmJ5dGViYXNlLnYxLlByb2plY3QiW4rqMBJiYi5wcm9qZWN0cy51cGRhdGWQ6jABgtPkkwI7OgEqIjYvdjEve3dlYmhvb2submFtZT1wcm9qZWN0cy8qL3dlYmhvb2tzLyp9OnJlbW92ZVdlYmhvb2sSmwEKC1Rlc3RXZWJob29rEh8uYnl0ZWJhc2UudjEuVGVzdFdlYmhvb2tSZXF1ZXN0GiAuYnl0ZWJhc2UudjEuVGVzdFdlYmhvb2tSZXNwb25zZSJJiuowEmJiLnByb2plY3RzLnVwZGF0ZZDqMAGC0+STAik6ASoiJC92MS97cHJvamVjdD1wcm9qZWN0cy8qfTp0ZXN0V2ViaG9va0KpAQoPY29tLmJ5dGViYXNlLnYxQhNQcm9qZWN0U2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_v1_annotation, file_v1_common, file_v1_iam_policy]);
+  fileDesc("Chh2MS9wcm9qZWN0X3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIj8KEUdldFByb2plY3RSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QiYgoTTGlzdFByb2plY3RzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCRIUCgxzaG93X2RlbGV0ZWQYAyABKAgSDgoGZmlsdGVyGAQgASgJIlcKFExpc3RQcm9qZWN0c1Jlc3BvbnNlEiYKCHByb2plY3RzGAEgAygLMhQuYnl0ZWJhc2UudjEuUHJvamVjdBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiZAoVU2VhcmNoUHJvamVjdHNSZXF1ZXN0EhQKDHNob3dfZGVsZXRlZBgBIAEoCBIOCgZmaWx0ZXIYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkiWQoWU2VhcmNoUHJvamVjdHNSZXNwb25zZRImCghwcm9qZWN0cxgBIAMoCzIULmJ5dGViYXNlLnYxLlByb2plY3QSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIlYKFENyZWF0ZVByb2plY3RSZXF1ZXN0EioKB3Byb2plY3QYASABKAsyFC5ieXRlYmFzZS52MS5Qcm9qZWN0QgPgQQISEgoKcHJvamVjdF9pZBgCIAEoCSKKAQoUVXBkYXRlUHJvamVjdFJlcXVlc3QSKgoHcHJvamVjdBgBIAEoCzIULmJ5dGViYXNlLnYxLlByb2plY3RCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNYWxsb3dfbWlzc2luZxgDIAEoCCJgChREZWxldGVQcm9qZWN0UmVxdWVzdBIqCgRuYW1lGAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0Eg0KBWZvcmNlGAIgASgIEg0KBXB1cmdlGAMgASgIIkQKFlVuZGVsZXRlUHJvamVjdFJlcXVlc3QSKgoEbmFtZRgBIAEoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdCJYChpCYXRjaERlbGV0ZVByb2plY3RzUmVxdWVzdBIrCgVuYW1lcxgBIAMoCUIc4EEC+kEWChRieXRlYmFzZS5jb20vUHJvamVjdBINCgVmb3JjZRgCIAEoCCI9ChhCYXRjaEdldElhbVBvbGljeVJlcXVlc3QSEgoFc2NvcGUYASABKAlCA+BBAhINCgVuYW1lcxgCIAMoCSKxAQoZQmF0Y2hHZXRJYW1Qb2xpY3lSZXNwb25zZRJLCg5wb2xpY3lfcmVzdWx0cxgBIAMoCzIzLmJ5dGViYXNlLnYxLkJhdGNoR2V0SWFtUG9saWN5UmVzcG9uc2UuUG9saWN5UmVzdWx0GkcKDFBvbGljeVJlc3VsdBIPCgdwcm9qZWN0GAEgASgJEiYKBnBvbGljeRgCIAEoCzIWLmJ5dGViYXNlLnYxLklhbVBvbGljeSI0CgVMYWJlbBINCgV2YWx1ZRgBIAEoCRINCgVjb2xvchgCIAEoCRINCgVncm91cBgDIAEoCSKpBgoHUHJvamVjdBIMCgRuYW1lGAEgASgJEiEKBXN0YXRlGAMgASgOMhIuYnl0ZWJhc2UudjEuU3RhdGUSFwoFdGl0bGUYBCABKAlCCLpIBXIDGMgBEiYKCHdlYmhvb2tzGAsgAygLMhQuYnl0ZWJhc2UudjEuV2ViaG9vaxIlCh1kYXRhX2NsYXNzaWZpY2F0aW9uX2NvbmZpZ19pZBgMIAEoCRIoCgxpc3N1ZV9sYWJlbHMYDSADKAsyEi5ieXRlYmFzZS52MS5MYWJlbBIaChJmb3JjZV9pc3N1ZV9sYWJlbHMYDiABKAgSHgoWYWxsb3dfbW9kaWZ5X3N0YXRlbWVudBgPIAEoCBIaChJhdXRvX3Jlc29sdmVfaXNzdWUYECABKAgSGwoTZW5mb3JjZV9pc3N1ZV90aXRsZRgRIAEoCBIaChJhdXRvX2VuYWJsZV9iYWNrdXAYEiABKAgSGgoSc2tpcF9iYWNrdXBfZXJyb3JzGBMgASgIEiUKHXBvc3RncmVzX2RhdGFiYXNlX3RlbmFudF9tb2RlGBQgASgIEhsKE2FsbG93X3NlbGZfYXBwcm92YWwYFSABKAgSSQoWZXhlY3V0aW9uX3JldHJ5X3BvbGljeRgWIAEoCzIpLmJ5dGViYXNlLnYxLlByb2plY3QuRXhlY3V0aW9uUmV0cnlQb2xpY3kSGAoQY2lfc2FtcGxpbmdfc2l6ZRgXIAEoBRIiChpwYXJhbGxlbF90YXNrc19wZXJfcm9sbG91dBgYIAEoBRIwCgZsYWJlbHMYGSADKAsyIC5ieXRlYmFzZS52MS5Qcm9qZWN0LkxhYmVsc0VudHJ5EhoKEmVuZm9yY2Vfc3FsX3JldmlldxgaIAEoCBovChRFeGVjdXRpb25SZXRyeVBvbGljeRIXCg9tYXhpbXVtX3JldHJpZXMYASABKAUaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ATot6kEqChRieXRlYmFzZS5jb20vUHJvamVjdBIScHJvamVjdHMve3Byb2plY3R9SgQIAhADIm4KEUFkZFdlYmhvb2tSZXF1ZXN0Ei0KB3Byb2plY3QYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1Byb2plY3QSKgoHd2ViaG9vaxgCIAEoCzIULmJ5dGViYXNlLnYxLldlYmhvb2tCA+BBAiKKAQoUVXBkYXRlV2ViaG9va1JlcXVlc3QSKgoHd2ViaG9vaxgBIAEoCzIULmJ5dGViYXNlLnYxLldlYmhvb2tCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2sSFQoNYWxsb3dfbWlzc2luZxgDIAEoCCJCChRSZW1vdmVXZWJob29rUmVxdWVzdBIqCgd3ZWJob29rGAEgASgLMhQuYnl0ZWJhc2UudjEuV2ViaG9va0ID4EECIm8KElRlc3RXZWJob29rUmVxdWVzdBItCgdwcm9qZWN0GAEgASgJQhzgQQL6QRYKFGJ5dGViYXNlLmNvbS9Qcm9qZWN0EioKB3dlYmhvb2sYAiABKAsyFC5ieXRlYmFzZS52MS5XZWJob29rQgPgQQIiJAoTVGVzdFdlYmhvb2tSZXNwb25zZRINCgVlcnJvchgBIAEoCSL/AwoHV2ViaG9vaxIMCgRuYW1lGAEgASgJEiwKBHR5cGUYAiABKA4yGS5ieXRlYmFzZS52MS5XZWJob29rLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEhAKA3VybBgEIAEoCUID4EECEhYKDmRpcmVjdF9tZXNzYWdlGAYgASgIEjsKEm5vdGlmaWNhdGlvbl90eXBlcxgFIAMoDjIaLmJ5dGViYXNlLnYxLkFjdGl2aXR5LlR5cGVCA+BBBhIbCg5zaWduaW5nX3NlY3JldBgHIAEoCUID4EEEEjIKB2hlYWRlcnMYCCADKAsyIS5ieXRlYmFzZS52MS5XZWJob29rLkhlYWRlcnNFbnRyeRouCgxIZWFkZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJ6CgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIJCgVTTEFDSxABEgsKB0RJU0NPUkQQAhIJCgVURUFNUxADEgwKCERJTkdUQUxLEAQSCgoGRkVJU0hVEAUSCQoFV0VDT00QBhIICgRMQVJLEAgSCgoGQ1VTVE9NEAk6QOpBPQoUYnl0ZWJhc2UuY29tL1dlYmhvb2sSJXByb2plY3RzL3twcm9qZWN0fS93ZWJob29rcy97d2ViaG9va30icwocTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVxdWVzdBIsCgZwYXJlbnQYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1dlYmhvb2sSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiagodTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2USMAoKZGVsaXZlcmllcxgBIAMoCzIcLmJ5dGViYXNlLnYxLldlYmhvb2tEZWxpdmVyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiUQobUmV0cnlXZWJob29rRGVsaXZlcnlSZXF1ZXN0EjIKBG5hbWUYASABKAlCJOBBAvpBHgocYnl0ZWJhc2UuY29tL1dlYmhvb2tEZWxpdmVyeSLxBAoPV2ViaG9va0RlbGl2ZXJ5EgwKBG5hbWUYASABKAkSMwoKZXZlbnRfdHlwZRgCIAEoDjIaLmJ5dGViYXNlLnYxLkFjdGl2aXR5LlR5cGVCA+BBAxI4CgZzdGF0dXMYAyABKA4yIy5ieXRlYmFzZS52MS5XZWJob29rRGVsaXZlcnkuU3RhdHVzQgPgQQMSFQoIYXR0ZW1wdHMYBCABKAVCA+BBAxIZCgxyZXF1ZXN0X2JvZHkYBSABKAlCA+BBAxISCgVlcnJvchgGIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEhgKC3N0YXR1c19jb2RlGAkgASgFQgPgQQMSLwoHbGF0ZW5jeRgKIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkID4EEDEjoKEW5leHRfYXR0ZW1wdF90aW1lGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIkgKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABILCgdQRU5ESU5HEAESDQoJU1VDQ0VFREVEEAISCgoGRkFJTEVEEAM6XupBWwocYnl0ZWJhc2UuY29tL1dlYmhvb2tEZWxpdmVyeRI7cHJvamVjdHMve3Byb2plY3R9L3dlYmhvb2tzL3t3ZWJob29rfS9kZWxpdmVyaWVzL3tkZWxpdmVyeX0ixgIKCEFjdGl2aXR5IrkCCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIZChVOT1RJRllfSVNTVUVfQVBQUk9WRUQQFxIbChdOT1RJRllfUElQRUxJTkVfUk9MTE9VVBAYEhgKFE5PVElGWV9HUkFOVF9FWFBJUkVEEBkSEAoMSVNTVUVfQ1JFQVRFEAESGAoUSVNTVUVfQ09NTUVOVF9DUkVBVEUQAhIWChJJU1NVRV9GSUVMRF9VUERBVEUQAxIXChNJU1NVRV9TVEFUVVNfVVBEQVRFEAQSGQoVSVNTVUVfQVBQUk9WQUxfTk9USUZZEBUSJgoiSVNTVUVfUElQRUxJTkVfU1RBR0VfU1RBVFVTX1VQREFURRAFEikKJUlTU1VFX1BJUEVMSU5FX1RBU0tfUlVOX1NUQVRVU19VUERBVEUQFjKnFQoOUHJvamVjdFNlcnZpY2USfwoKR2V0UHJvamVjdBIeLmJ5dGViYXNlLnYxLkdldFByb2plY3RSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCI72kEEbmFtZYrqMA9iYi5wcm9qZWN0cy5nZXSQ6jABgtPkkwIXEhUvdjEve25hbWU9cHJvamVjdHMvKn0ShAEKDExpc3RQcm9qZWN0cxIgLmJ5dGViYXNlLnYxLkxpc3RQcm9qZWN0c1JlcXVlc3QaIS5ieXRlYmFzZS52MS5MaXN0UHJvamVjdHNSZXNwb25zZSIv2kEAiuowEGJiLnByb2plY3RzLmxpc3SQ6jABgtPkkwIOEgwvdjEvcHJvamVjdHMSgAEKDlNlYXJjaFByb2plY3RzEiIuYnl0ZWJhc2UudjEuU2VhcmNoUHJvamVjdHNSZXF1ZXN0GiMuYnl0ZWJhc2UudjEuU2VhcmNoUHJvamVjdHNSZXNwb25zZSIl2kEAkOowAoLT5JMCGDoBKiITL3YxL3Byb2plY3RzOnNlYXJjaBKEAQoNQ3JlYXRlUHJvamVjdBIhLmJ5dGViYXNlLnYxLkNyZWF0ZVByb2plY3RSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCI62kEAiuowEmJiLnByb2plY3RzLmNyZWF0ZZDqMAGC0+STAhc6B3Byb2plY3QiDC92MS9wcm9qZWN0cxKoAQoNVXBkYXRlUHJvamVjdBIhLmJ5dGViYXNlLnYxLlVwZGF0ZVByb2plY3RSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCJe2kETcHJvamVjdCx1cGRhdGVfbWFza4rqMBJiYi5wcm9qZWN0cy51cGRhdGWQ6jABgtPkkwIoOgdwcm9qZWN0Mh0vdjEve3Byb2plY3QubmFtZT1wcm9qZWN0cy8qfRKOAQoNRGVsZXRlUHJvamVjdBIhLmJ5dGViYXNlLnYxLkRlbGV0ZVByb2plY3RSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IkLaQQRuYW1liuowEmJiLnByb2plY3RzLmRlbGV0ZZDqMAGY6jABgtPkkwIXKhUvdjEve25hbWU9cHJvamVjdHMvKn0SlwEKD1VuZGVsZXRlUHJvamVjdBIjLmJ5dGViYXNlLnYxLlVuZGVsZXRlUHJvamVjdFJlcXVlc3QaFC5ieXRlYmFzZS52MS5Qcm9qZWN0IkmK6jAUYmIucHJvamVjdHMudW5kZWxldGWQ6jABmOowAYLT5JMCIzoBKiIeL3YxL3tuYW1lPXByb2plY3RzLyp9OnVuZGVsZXRlEpkBChNCYXRjaERlbGV0ZVByb2plY3RzEicuYnl0ZWJhc2UudjEuQmF0Y2hEZWxldGVQcm9qZWN0c1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiQYrqMBJiYi5wcm9qZWN0cy5kZWxldGWQ6jABmOowAYLT5JMCHToBKiIYL3YxL3Byb2plY3RzOmJhdGNoRGVsZXRlEpgBCgxHZXRJYW1Qb2xpY3kSIC5ieXRlYmFzZS52MS5HZXRJYW1Qb2xpY3lSZXF1ZXN0GhYuYnl0ZWJhc2UudjEuSWFtUG9saWN5Ik6K6jAYYmIucHJvamVjdHMuZ2V0SWFtUG9saWN5kOowAYLT5JMCKBImL3YxL3tyZXNvdXJjZT1wcm9qZWN0cy8qfTpnZXRJYW1Qb2xpY3kSsAEKEUJhdGNoR2V0SWFtUG9saWN5EiUuYnl0ZWJhc2UudjEuQmF0Y2hHZXRJYW1Qb2xpY3lSZXF1ZXN0GiYuYnl0ZWJhc2UudjEuQmF0Y2hHZXRJYW1Qb2xpY3lSZXNwb25zZSJMiuowGGJiLnByb2plY3RzLmdldElhbVBvbGljeZDqMAKC0+STAiYSJC92MS97c2NvcGU9Ki8qfS9pYW1Qb2xpY2llczpiYXRjaEdldBKfAQoMU2V0SWFtUG9saWN5EiAuYnl0ZWJhc2UudjEuU2V0SWFtUG9saWN5UmVxdWVzdBoWLmJ5dGViYXNlLnYxLklhbVBvbGljeSJViuowGGJiLnByb2plY3RzLnNldElhbVBvbGljeZDqMAGY6jABgtPkkwIrOgEqIiYvdjEve3Jlc291cmNlPXByb2plY3RzLyp9OnNldElhbVBvbGljeRKMAQoKQWRkV2ViaG9vaxIeLmJ5dGViYXNlLnYxLkFkZFdlYmhvb2tSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCJIiuowEmJiLnByb2plY3RzLnVwZGF0ZZDqMAGC0+STAig6ASoiIy92MS97cHJvamVjdD1wcm9qZWN0cy8qfTphZGRXZWJob29rEsEBCg1VcGRhdGVXZWJob29rEiEuYnl0ZWJhc2UudjEuVXBkYXRlV2ViaG9va1JlcXVlc3QaFC5ieXRlYmFzZS52MS5Qcm9qZWN0InfaQRN3ZWJob29rLHVwZGF0ZV9tYXNriuowEmJiLnByb2plY3RzLnVwZGF0ZZDqMAGC0+STAkE6B3dlYmhvb2syNi92MS97d2ViaG9vay5uYW1lPXByb2plY3RzLyovd2ViaG9va3MvKn06dXBkYXRlV2ViaG9vaxKlAQoNUmVtb3ZlV2ViaG9vaxIhLmJ5dGViYXNlLnYxLlJlbW92ZVdlYmhvb2tSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuUHJvamVjdCJbiuowEmJiLnByb2plY3RzLnVwZGF0ZZDqMAGC0+STAjs6ASoiNi92MS97d2ViaG9vay5uYW1lPXByb2plY3RzLyovd2ViaG9va3MvKn06cmVtb3ZlV2ViaG9vaxKbAQoLVGVzdFdlYmhvb2sSHy5ieXRlYmFzZS52MS5UZXN0V2ViaG9va1JlcXVlc3QaIC5ieXRlYmFzZS52MS5UZXN0V2ViaG9va1Jlc3BvbnNlIkmK6jASYmIucHJvamVjdHMudXBkYXRlkOowAYLT5JMCKToBKiIkL3YxL3twcm9qZWN0PXByb2plY3RzLyp9OnRlc3RXZWJob29rEsUBChVMaXN0V2ViaG9va0RlbGl2ZXJpZXMSKS5ieXRlYmFzZS52MS5MaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0GiouYnl0ZWJhc2UudjEuTGlzdFdlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2UiVdpBBnBhcmVudIrqMA9iYi5wcm9qZWN0cy5nZXSQ6jABgtPkkwIvEi0vdjEve3BhcmVudD1wcm9qZWN0cy8qL3dlYmhvb2tzLyp9L2RlbGl2ZXJpZXMSvwEKFFJldHJ5V2ViaG9va0RlbGl2ZXJ5EiguYnl0ZWJhc2UudjEuUmV0cnlXZWJob29rRGVsaXZlcnlSZXF1ZXN0GhwuYnl0ZWJhc2UudjEuV2ViaG9va0RlbGl2ZXJ5Il/aQQRuYW1liuowEmJiLnByb2plY3RzLnVwZGF0ZZDqMAGC0+STAjg6ASoiMy92MS97bmFtZT1wcm9qZWN0cy8qL3dlYmhvb2tzLyovZGVsaXZlcmllcy8qfTpyZXRyeUKpAQoPY29tLmJ5dGViYXNlLnYxQhNQcm9qZWN0U2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_buf_validate_validate, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_v1_annotation, file_v1_common, file_v1_iam_policy]);
 
 /**
  * Describes the message bytebase.v1.GetProjectRequest.
@@ -187,18 +187,60 @@ export const Webhook_TypeSchema = /*@__PURE__*/
 export const Webhook_Type = /*@__PURE__*/
   tsEnum(Webhook_TypeSchema);
 
+/**
+ * Describes the message bytebase.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_project_service, 20);
+
+/**
+ * Describes the message bytebase.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesResponseSchema = /*@__PURE__*/
+  messageDesc(file_v1_project_service, 21);
+
+/**
+ * Describes the message bytebase.v1.RetryWebhookDeliveryRequest.
+ * Use `create(RetryWebhookDeliveryRequestSchema)` to create a new message.
+ */
+export const RetryWebhookDeliveryRequestSchema = /*@__PURE__*/
+  messageDesc(file_v1_project_service, 22);
+
+/**
+ * Describes the message bytebase.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema = /*@__PURE__*/
+  messageDesc(file_v1_project_service, 23);
+
+/**
+ * Describes the enum bytebase.v1.WebhookDelivery.Status.
+ */
+export const WebhookDelivery_StatusSchema = /*@__PURE__*/
+  enumDesc(file_v1_project_service, 23, 0);
+
+/**
+ * Delivery status.
+ *
+ * @generated from enum bytebase.v1.WebhookDelivery.Status
+ */
+export const WebhookDelivery_Status = /*@__PURE__*/
+  tsEnum(WebhookDelivery_StatusSchema);
+
 /**
  * Describes the message bytebase.v1.Activity.
  * Use `create(ActivitySchema)` to create a new message.
  */
 export const ActivitySchema = /*@__PURE__*/
-  messageDesc(file_v1_project_service, 20);
+  messageDesc(file_v1_project_service, 24);
 
 /**
  * Describes the enum bytebase.v1.Activity.Type.
  */
 export const Activity_TypeSchema = /*@__PURE__*/
-  enumDesc(file_v1_project_service, 20, 0);
+  enumDesc(file_v1_project_service, 24, 0);
 
 /**
  * Activity type enumeration.
@@ -190,6 +190,7 @@ export declare type Risk = Message<"bytebase.v1.Risk"> & {
    * resource.table_name: the table name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
    * statement.text: the SQL statement, support "contains()", "matches()", "startsWith()", "endsWith()" operations.
    * request.expiration_days: the role expiration days for the request, support "==", "!=", "<", "<=", ">", ">=" operations.
+   * request.expiration_hours: the role expiration hours for the request, support "==", "!=", "<", "<=", ">", ">=" operations.
    * request.role: the request role full name, support "==", "!=", "in [xx]", "!(in [xx])", "contains()", "matches()", "startsWith()", "endsWith()" operations.
    *
    * When the risk source is DDL/DML, support following variables:
@@ -221,6 +222,7 @@ export declare type Risk = Message<"bytebase.v1.Risk"> & {
    * When the risk source is REQUEST_ROLE, support following variables:
    * resource.project_id
    * request.expiration_days
+   * request.expiration_hours
    * request.role
    *
    * @generated from field: google.type.Expr condition = 8;
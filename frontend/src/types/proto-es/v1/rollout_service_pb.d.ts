@@ -1146,6 +1146,14 @@ export declare type TaskRun_SchedulerInfo_WaitingCause = Message<"bytebase.v1.Ta
      */
     value: boolean;
     case: "parallelTasksLimit";
+  } | {
+    /**
+     * Waiting for the maintenance window of the environment.
+     *
+     * @generated from field: bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow maintenance_window = 4;
+     */
+    value: TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow;
+    case: "maintenanceWindow";
   } | { case: undefined; value?: undefined };
 };
 
@@ -1182,6 +1190,26 @@ export declare type TaskRun_SchedulerInfo_WaitingCause_Task = Message<"bytebase.
  */
 export declare const TaskRun_SchedulerInfo_WaitingCause_TaskSchema: GenMessage<TaskRun_SchedulerInfo_WaitingCause_Task>;
 
+/**
+ * Information about the maintenance window to wait for.
+ *
+ * @generated from message bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow
+ */
+export declare type TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow = Message<"bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow"> & {
+  /**
+   * The start time of the next maintenance window.
+   *
+   * @generated from field: google.protobuf.Timestamp next_window_time = 1;
+   */
+  nextWindowTime?: Timestamp;
+};
+
+/**
+ * Describes the message bytebase.v1.TaskRun.SchedulerInfo.WaitingCause.MaintenanceWindow.
+ * Use `create(TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindowSchema)` to create a new message.
+ */
+export declare const TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindowSchema: GenMessage<TaskRun_SchedulerInfo_WaitingCause_MaintenanceWindow>;
+
 /**
  * @generated from enum bytebase.v1.TaskRun.Status
  */
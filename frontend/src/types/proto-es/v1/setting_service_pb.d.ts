@@ -241,6 +241,16 @@ export enum Setting_SettingName {
    * @generated from enum value: ENVIRONMENT = 19;
    */
   ENVIRONMENT = 19,
+
+  /**
+   * @generated from enum value: EMAIL = 20;
+   */
+  EMAIL = 20,
+
+  /**
+   * @generated from enum value: AUDIT_LOG_SINK = 21;
+   */
+  AUDIT_LOG_SINK = 21,
 }
 
 /**
@@ -329,6 +339,24 @@ export declare type Value = Message<"bytebase.v1.Value"> & {
      */
     value: EnvironmentSetting;
     case: "environmentSetting";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.EmailSetting email_setting = 19;
+     */
+    value: EmailSetting;
+    case: "emailSetting";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.WorkspaceExternalApprovalSetting workspace_external_approval_setting_value = 20;
+     */
+    value: WorkspaceExternalApprovalSetting;
+    case: "workspaceExternalApprovalSettingValue";
+  } | {
+    /**
+     * @generated from field: bytebase.v1.AuditLogSinkSetting audit_log_sink_setting = 21;
+     */
+    value: AuditLogSinkSetting;
+    case: "auditLogSinkSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -362,6 +390,13 @@ export declare type AppIMSetting_Slack = Message<"bytebase.v1.AppIMSetting.Slack
    * @generated from field: string token = 1;
    */
   token: string;
+
+  /**
+   * The signing secret is used to verify the interactive requests from Slack.
+   *
+   * @generated from field: string signing_secret = 2;
+   */
+  signingSecret: string;
 };
 
 /**
@@ -430,6 +465,13 @@ export declare type AppIMSetting_Lark = Message<"bytebase.v1.AppIMSetting.Lark">
    * @generated from field: string app_secret = 2;
    */
   appSecret: string;
+
+  /**
+   * The verification token is used to verify the message card callbacks from Lark.
+   *
+   * @generated from field: string verification_token = 3;
+   */
+  verificationToken: string;
 };
 
 /**
@@ -729,6 +771,71 @@ export declare type WorkspaceApprovalSetting_Rule = Message<"bytebase.v1.Workspa
  */
 export declare const WorkspaceApprovalSetting_RuleSchema: GenMessage<WorkspaceApprovalSetting_Rule>;
 
+/**
+ * @generated from message bytebase.v1.WorkspaceExternalApprovalSetting
+ */
+export declare type WorkspaceExternalApprovalSetting = Message<"bytebase.v1.WorkspaceExternalApprovalSetting"> & {
+  /**
+   * @generated from field: repeated bytebase.v1.WorkspaceExternalApprovalSetting.Node nodes = 1;
+   */
+  nodes: WorkspaceExternalApprovalSetting_Node[];
+};
+
+/**
+ * Describes the message bytebase.v1.WorkspaceExternalApprovalSetting.
+ * Use `create(WorkspaceExternalApprovalSettingSchema)` to create a new message.
+ */
+export declare const WorkspaceExternalApprovalSettingSchema: GenMessage<WorkspaceExternalApprovalSetting>;
+
+/**
+ * @generated from message bytebase.v1.WorkspaceExternalApprovalSetting.Node
+ */
+export declare type WorkspaceExternalApprovalSetting_Node = Message<"bytebase.v1.WorkspaceExternalApprovalSetting.Node"> & {
+  /**
+   * The unique identifier of the node.
+   * The approval flow references the node as "externalApprovalNodes/{id}".
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The endpoint of the external approval service.
+   * Bytebase sends the approval requests by POST to the endpoint,
+   * and polls the request status by GET from "{endpoint}/{request_id}".
+   *
+   * @generated from field: string endpoint = 3;
+   */
+  endpoint: string;
+
+  /**
+   * The token sent as the bearer token to the endpoint.
+   * The callbacks from the external approval service must carry the same bearer token.
+   *
+   * @generated from field: string token = 4;
+   */
+  token: string;
+
+  /**
+   * The approval request is rejected if it is not decided within the timeout.
+   * No timeout if unset.
+   *
+   * @generated from field: google.protobuf.Duration timeout = 5;
+   */
+  timeout?: Duration;
+};
+
+/**
+ * Describes the message bytebase.v1.WorkspaceExternalApprovalSetting.Node.
+ * Use `create(WorkspaceExternalApprovalSetting_NodeSchema)` to create a new message.
+ */
+export declare const WorkspaceExternalApprovalSetting_NodeSchema: GenMessage<WorkspaceExternalApprovalSetting_Node>;
+
 /**
  * @generated from message bytebase.v1.SchemaTemplateSetting
  */
@@ -1067,6 +1174,47 @@ export declare type Algorithm = Message<"bytebase.v1.Algorithm"> & {
      */
     value: Algorithm_InnerOuterMask;
     case: "innerOuterMask";
+  } | {
+    /**
+     * Encrypt the value while keeping its length and character classes with the workspace key.
+     *
+     * @generated from field: bytebase.v1.Algorithm.FormatPreservingEncryptionMask format_preserving_encryption_mask = 9;
+     */
+    value: Algorithm_FormatPreservingEncryptionMask;
+    case: "formatPreservingEncryptionMask";
+  } | {
+    /**
+     * Replace the value with a deterministic token derived from the workspace key,
+     * so the same value gets the same token in all tables.
+     *
+     * @generated from field: bytebase.v1.Algorithm.TokenizationMask tokenization_mask = 10;
+     */
+    value: Algorithm_TokenizationMask;
+    case: "tokenizationMask";
+  } | {
+    /**
+     * Truncate dates and timestamps to the year, month, day or hour.
+     *
+     * @generated from field: bytebase.v1.Algorithm.DateTruncateMask date_truncate_mask = 11;
+     */
+    value: Algorithm_DateTruncateMask;
+    case: "dateTruncateMask";
+  } | {
+    /**
+     * Round numbers down to buckets.
+     *
+     * @generated from field: bytebase.v1.Algorithm.NumericBucketMask numeric_bucket_mask = 12;
+     */
+    value: Algorithm_NumericBucketMask;
+    case: "numericBucketMask";
+  } | {
+    /**
+     * Add bounded random noise to numbers.
+     *
+     * @generated from field: bytebase.v1.Algorithm.NumericNoiseMask numeric_noise_mask = 13;
+     */
+    value: Algorithm_NumericNoiseMask;
+    case: "numericNoiseMask";
   } | { case: undefined; value?: undefined };
 };
 
@@ -1220,6 +1368,168 @@ export enum Algorithm_InnerOuterMask_MaskType {
  */
 export declare const Algorithm_InnerOuterMask_MaskTypeSchema: GenEnum<Algorithm_InnerOuterMask_MaskType>;
 
+/**
+ * @generated from message bytebase.v1.Algorithm.FormatPreservingEncryptionMask
+ */
+export declare type Algorithm_FormatPreservingEncryptionMask = Message<"bytebase.v1.Algorithm.FormatPreservingEncryptionMask"> & {
+  /**
+   * @generated from field: bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode mode = 1;
+   */
+  mode: Algorithm_FormatPreservingEncryptionMask_Mode;
+
+  /**
+   * tweak is mixed into the encryption, so the same value is encrypted differently with different tweaks.
+   *
+   * @generated from field: string tweak = 2;
+   */
+  tweak: string;
+};
+
+/**
+ * Describes the message bytebase.v1.Algorithm.FormatPreservingEncryptionMask.
+ * Use `create(Algorithm_FormatPreservingEncryptionMaskSchema)` to create a new message.
+ */
+export declare const Algorithm_FormatPreservingEncryptionMaskSchema: GenMessage<Algorithm_FormatPreservingEncryptionMask>;
+
+/**
+ * @generated from enum bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode
+ */
+export enum Algorithm_FormatPreservingEncryptionMask_Mode {
+  /**
+   * @generated from enum value: MODE_UNSPECIFIED = 0;
+   */
+  MODE_UNSPECIFIED = 0,
+
+  /**
+   * NIST SP 800-38G FF1.
+   *
+   * @generated from enum value: FF1 = 1;
+   */
+  FF1 = 1,
+
+  /**
+   * NIST SP 800-38G Revision 1 FF3-1.
+   *
+   * @generated from enum value: FF3_1 = 2;
+   */
+  FF3_1 = 2,
+}
+
+/**
+ * Describes the enum bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode.
+ */
+export declare const Algorithm_FormatPreservingEncryptionMask_ModeSchema: GenEnum<Algorithm_FormatPreservingEncryptionMask_Mode>;
+
+/**
+ * @generated from message bytebase.v1.Algorithm.TokenizationMask
+ */
+export declare type Algorithm_TokenizationMask = Message<"bytebase.v1.Algorithm.TokenizationMask"> & {
+  /**
+   * prefix is prepended to the tokens, such as "tok_".
+   *
+   * @generated from field: string prefix = 1;
+   */
+  prefix: string;
+};
+
+/**
+ * Describes the message bytebase.v1.Algorithm.TokenizationMask.
+ * Use `create(Algorithm_TokenizationMaskSchema)` to create a new message.
+ */
+export declare const Algorithm_TokenizationMaskSchema: GenMessage<Algorithm_TokenizationMask>;
+
+/**
+ * @generated from message bytebase.v1.Algorithm.DateTruncateMask
+ */
+export declare type Algorithm_DateTruncateMask = Message<"bytebase.v1.Algorithm.DateTruncateMask"> & {
+  /**
+   * granularity is the unit the dates and timestamps are truncated to,
+   * such as 2024-03-15 10:30:00 to 2024-01-01 00:00:00 with YEAR.
+   *
+   * @generated from field: bytebase.v1.Algorithm.DateTruncateMask.Granularity granularity = 1;
+   */
+  granularity: Algorithm_DateTruncateMask_Granularity;
+};
+
+/**
+ * Describes the message bytebase.v1.Algorithm.DateTruncateMask.
+ * Use `create(Algorithm_DateTruncateMaskSchema)` to create a new message.
+ */
+export declare const Algorithm_DateTruncateMaskSchema: GenMessage<Algorithm_DateTruncateMask>;
+
+/**
+ * @generated from enum bytebase.v1.Algorithm.DateTruncateMask.Granularity
+ */
+export enum Algorithm_DateTruncateMask_Granularity {
+  /**
+   * @generated from enum value: GRANULARITY_UNSPECIFIED = 0;
+   */
+  GRANULARITY_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: YEAR = 1;
+   */
+  YEAR = 1,
+
+  /**
+   * @generated from enum value: MONTH = 2;
+   */
+  MONTH = 2,
+
+  /**
+   * @generated from enum value: DAY = 3;
+   */
+  DAY = 3,
+
+  /**
+   * @generated from enum value: HOUR = 4;
+   */
+  HOUR = 4,
+}
+
+/**
+ * Describes the enum bytebase.v1.Algorithm.DateTruncateMask.Granularity.
+ */
+export declare const Algorithm_DateTruncateMask_GranularitySchema: GenEnum<Algorithm_DateTruncateMask_Granularity>;
+
+/**
+ * @generated from message bytebase.v1.Algorithm.NumericBucketMask
+ */
+export declare type Algorithm_NumericBucketMask = Message<"bytebase.v1.Algorithm.NumericBucketMask"> & {
+  /**
+   * bucket_size is the width of the buckets, it should be positive.
+   * Numbers are rounded down to the lower bound of their buckets, such as 37 to 30 with the bucket size 10.
+   *
+   * @generated from field: double bucket_size = 1;
+   */
+  bucketSize: number;
+};
+
+/**
+ * Describes the message bytebase.v1.Algorithm.NumericBucketMask.
+ * Use `create(Algorithm_NumericBucketMaskSchema)` to create a new message.
+ */
+export declare const Algorithm_NumericBucketMaskSchema: GenMessage<Algorithm_NumericBucketMask>;
+
+/**
+ * @generated from message bytebase.v1.Algorithm.NumericNoiseMask
+ */
+export declare type Algorithm_NumericNoiseMask = Message<"bytebase.v1.Algorithm.NumericNoiseMask"> & {
+  /**
+   * max_noise is the bound of the noise, it should be positive.
+   * The noise in [-max_noise, max_noise] is derived from the keyed hash of the number, so the same number always gets the same noise, and integers stay integers.
+   *
+   * @generated from field: double max_noise = 1;
+   */
+  maxNoise: number;
+};
+
+/**
+ * Describes the message bytebase.v1.Algorithm.NumericNoiseMask.
+ * Use `create(Algorithm_NumericNoiseMaskSchema)` to create a new message.
+ */
+export declare const Algorithm_NumericNoiseMaskSchema: GenMessage<Algorithm_NumericNoiseMask>;
+
 /**
  * @generated from message bytebase.v1.SCIMSetting
  */
@@ -1372,6 +1682,101 @@ export enum AISetting_Provider {
  */
 export declare const AISetting_ProviderSchema: GenEnum<AISetting_Provider>;
 
+/**
+ * @generated from message bytebase.v1.EmailSetting
+ */
+export declare type EmailSetting = Message<"bytebase.v1.EmailSetting"> & {
+  /**
+   * Whether to send email notifications.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * The host of the SMTP server.
+   *
+   * @generated from field: string host = 2;
+   */
+  host: string;
+
+  /**
+   * The port of the SMTP server.
+   *
+   * @generated from field: int32 port = 3;
+   */
+  port: number;
+
+  /**
+   * The username for SMTP authentication. Authentication is skipped if empty.
+   *
+   * @generated from field: string username = 4;
+   */
+  username: string;
+
+  /**
+   * The password for SMTP authentication.
+   * The existing password is kept if empty on update.
+   *
+   * @generated from field: string password = 5;
+   */
+  password: string;
+
+  /**
+   * @generated from field: bytebase.v1.EmailSetting.Encryption encryption = 6;
+   */
+  encryption: EmailSetting_Encryption;
+
+  /**
+   * The sender address, e.g. "Bytebase <noreply@example.com>".
+   *
+   * @generated from field: string from = 7;
+   */
+  from: string;
+};
+
+/**
+ * Describes the message bytebase.v1.EmailSetting.
+ * Use `create(EmailSettingSchema)` to create a new message.
+ */
+export declare const EmailSettingSchema: GenMessage<EmailSetting>;
+
+/**
+ * @generated from enum bytebase.v1.EmailSetting.Encryption
+ */
+export enum EmailSetting_Encryption {
+  /**
+   * @generated from enum value: ENCRYPTION_UNSPECIFIED = 0;
+   */
+  ENCRYPTION_UNSPECIFIED = 0,
+
+  /**
+   * Plain connection without encryption.
+   *
+   * @generated from enum value: NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * Upgrade the plain connection with STARTTLS.
+   *
+   * @generated from enum value: STARTTLS = 2;
+   */
+  STARTTLS = 2,
+
+  /**
+   * Implicit TLS from the start of the connection.
+   *
+   * @generated from enum value: SSL_TLS = 3;
+   */
+  SSL_TLS = 3,
+}
+
+/**
+ * Describes the enum bytebase.v1.EmailSetting.Encryption.
+ */
+export declare const EmailSetting_EncryptionSchema: GenEnum<EmailSetting_Encryption>;
+
 /**
  * @generated from message bytebase.v1.EnvironmentSetting
  */
@@ -1434,6 +1839,146 @@ export declare type EnvironmentSetting_Environment = Message<"bytebase.v1.Enviro
  */
 export declare const EnvironmentSetting_EnvironmentSchema: GenMessage<EnvironmentSetting_Environment>;
 
+/**
+ * @generated from message bytebase.v1.AuditLogSinkSetting
+ */
+export declare type AuditLogSinkSetting = Message<"bytebase.v1.AuditLogSinkSetting"> & {
+  /**
+   * @generated from field: repeated bytebase.v1.AuditLogSinkSetting.Sink sinks = 1;
+   */
+  sinks: AuditLogSinkSetting_Sink[];
+};
+
+/**
+ * Describes the message bytebase.v1.AuditLogSinkSetting.
+ * Use `create(AuditLogSinkSettingSchema)` to create a new message.
+ */
+export declare const AuditLogSinkSettingSchema: GenMessage<AuditLogSinkSetting>;
+
+/**
+ * @generated from message bytebase.v1.AuditLogSinkSetting.Sink
+ */
+export declare type AuditLogSinkSetting_Sink = Message<"bytebase.v1.AuditLogSinkSetting.Sink"> & {
+  /**
+   * The unique identifier of the sink, which tracks the delivery progress of the sink. A new sink starts from the latest audit log instead of replaying the history.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: bytebase.v1.AuditLogSinkSetting.Sink.Type type = 2;
+   */
+  type: AuditLogSinkSetting_Sink_Type;
+
+  /**
+   * The "host:port" of the syslog server for SYSLOG, or the URL for HTTP and OTLP.
+   *
+   * @generated from field: string endpoint = 3;
+   */
+  endpoint: string;
+
+  /**
+   * Whether to connect to the syslog server with TLS.
+   *
+   * @generated from field: bool tls = 4;
+   */
+  tls: boolean;
+
+  /**
+   * The token sent in the authorization header of HTTP and OTLP.
+   *
+   * @generated from field: string token = 5;
+   */
+  token: string;
+
+  /**
+   * The format of the HTTP sink. Unspecified means JSON.
+   *
+   * @generated from field: bytebase.v1.AuditLogSinkSetting.Sink.Format format = 6;
+   */
+  format: AuditLogSinkSetting_Sink_Format;
+
+  /**
+   * The filter of the audit logs to deliver, in the same syntax as searching the audit logs.
+   * All audit logs are delivered if empty.
+   *
+   * @generated from field: string filter = 7;
+   */
+  filter: string;
+};
+
+/**
+ * Describes the message bytebase.v1.AuditLogSinkSetting.Sink.
+ * Use `create(AuditLogSinkSetting_SinkSchema)` to create a new message.
+ */
+export declare const AuditLogSinkSetting_SinkSchema: GenMessage<AuditLogSinkSetting_Sink>;
+
+/**
+ * @generated from enum bytebase.v1.AuditLogSinkSetting.Sink.Type
+ */
+export enum AuditLogSinkSetting_Sink_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * RFC 5424 syslog messages over TCP, framed by the octet counting of RFC 6587.
+   *
+   * @generated from enum value: SYSLOG = 1;
+   */
+  SYSLOG = 1,
+
+  /**
+   * JSON batches posted to the HTTP endpoint, e.g. Splunk HTTP Event Collector.
+   *
+   * @generated from enum value: HTTP = 2;
+   */
+  HTTP = 2,
+
+  /**
+   * OTLP logs posted to the HTTP endpoint with the JSON encoding.
+   *
+   * @generated from enum value: OTLP = 3;
+   */
+  OTLP = 3,
+}
+
+/**
+ * Describes the enum bytebase.v1.AuditLogSinkSetting.Sink.Type.
+ */
+export declare const AuditLogSinkSetting_Sink_TypeSchema: GenEnum<AuditLogSinkSetting_Sink_Type>;
+
+/**
+ * @generated from enum bytebase.v1.AuditLogSinkSetting.Sink.Format
+ */
+export enum AuditLogSinkSetting_Sink_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * A JSON array of the audit logs with the bearer token.
+   *
+   * @generated from enum value: JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * The Splunk HTTP Event Collector events with the "Splunk {token}" authorization.
+   *
+   * @generated from enum value: SPLUNK_HEC = 2;
+   */
+  SPLUNK_HEC = 2,
+}
+
+/**
+ * Describes the enum bytebase.v1.AuditLogSinkSetting.Sink.Format.
+ */
+export declare const AuditLogSinkSetting_Sink_FormatSchema: GenEnum<AuditLogSinkSetting_Sink_Format>;
+
 /**
  * @generated from enum bytebase.v1.DatabaseChangeMode
  */
@@ -20,7 +20,7 @@ import { file_v1_project_service } from "./project_service_pb";
  * Describes the file v1/setting_service.proto.
  */
 export const file_v1_setting_service = /*@__PURE__*/
-  fileDesc("Chh2MS9zZXR0aW5nX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIhUKE0xpc3RTZXR0aW5nc1JlcXVlc3QiPgoUTGlzdFNldHRpbmdzUmVzcG9uc2USJgoIc2V0dGluZ3MYASADKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIj8KEUdldFNldHRpbmdSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1NldHRpbmciOwoSR2V0U2V0dGluZ1Jlc3BvbnNlEiUKB3NldHRpbmcYASABKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIqEBChRVcGRhdGVTZXR0aW5nUmVxdWVzdBIqCgdzZXR0aW5nGAEgASgLMhQuYnl0ZWJhc2UudjEuU2V0dGluZ0ID4EECEhUKDXZhbGlkYXRlX29ubHkYAiABKAgSFQoNYWxsb3dfbWlzc2luZxgDIAEoCBIvCgt1cGRhdGVfbWFzaxgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2si1QMKB1NldHRpbmcSDAoEbmFtZRgBIAEoCRIhCgV2YWx1ZRgCIAEoCzISLmJ5dGViYXNlLnYxLlZhbHVlIuMCCgtTZXR0aW5nTmFtZRIcChhTRVRUSU5HX05BTUVfVU5TUEVDSUZJRUQQABIPCgtBVVRIX1NFQ1JFVBABEhEKDUJSQU5ESU5HX0xPR08QAhIQCgxXT1JLU1BBQ0VfSUQQAxIVChFXT1JLU1BBQ0VfUFJPRklMRRAEEhYKEldPUktTUEFDRV9BUFBST1ZBTBAFEh8KG1dPUktTUEFDRV9FWFRFUk5BTF9BUFBST1ZBTBAGEhYKEkVOVEVSUFJJU0VfTElDRU5TRRAHEgoKBkFQUF9JTRAIEg0KCVdBVEVSTUFSSxAJEgYKAkFJEAoSEwoPU0NIRU1BX1RFTVBMQVRFEA0SFwoTREFUQV9DTEFTU0lGSUNBVElPThAOEhIKDlNFTUFOVElDX1RZUEVTEA8SCAoEU0NJTRAREhgKFFBBU1NXT1JEX1JFU1RSSUNUSU9OEBISDwoLRU5WSVJPTk1FTlQQEzot6kEqChRieXRlYmFzZS5jb20vU2V0dGluZxISc2V0dGluZ3Mve3NldHRpbmd9SgQIEBARIukFCgVWYWx1ZRIWCgxzdHJpbmdfdmFsdWUYASABKAlIABI5ChRhcHBfaW1fc2V0dGluZ192YWx1ZRgDIAEoCzIZLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZ0gAEk8KH3dvcmtzcGFjZV9wcm9maWxlX3NldHRpbmdfdmFsdWUYBSABKAsyJC5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VQcm9maWxlU2V0dGluZ0gAElEKIHdvcmtzcGFjZV9hcHByb3ZhbF9zZXR0aW5nX3ZhbHVlGAYgASgLMiUuYnl0ZWJhc2UudjEuV29ya3NwYWNlQXBwcm92YWxTZXR0aW5nSAASSwodc2NoZW1hX3RlbXBsYXRlX3NldHRpbmdfdmFsdWUYCSABKAsyIi5ieXRlYmFzZS52MS5TY2hlbWFUZW1wbGF0ZVNldHRpbmdIABJTCiFkYXRhX2NsYXNzaWZpY2F0aW9uX3NldHRpbmdfdmFsdWUYCiABKAsyJi5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nSAASRwobc2VtYW50aWNfdHlwZV9zZXR0aW5nX3ZhbHVlGAsgASgLMiAuYnl0ZWJhc2UudjEuU2VtYW50aWNUeXBlU2V0dGluZ0gAEjAKDHNjaW1fc2V0dGluZxgOIAEoCzIYLmJ5dGViYXNlLnYxLlNDSU1TZXR0aW5nSAASTwoccGFzc3dvcmRfcmVzdHJpY3Rpb25fc2V0dGluZxgPIAEoCzInLmJ5dGViYXNlLnYxLlBhc3N3b3JkUmVzdHJpY3Rpb25TZXR0aW5nSAASLAoKYWlfc2V0dGluZxgQIAEoCzIWLmJ5dGViYXNlLnYxLkFJU2V0dGluZ0gAEj4KE2Vudmlyb25tZW50X3NldHRpbmcYESABKAsyHy5ieXRlYmFzZS52MS5FbnZpcm9ubWVudFNldHRpbmdIAEIHCgV2YWx1ZUoECBIQEyK2BQoMQXBwSU1TZXR0aW5nEjUKCHNldHRpbmdzGAEgAygLMiMuYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLklNU2V0dGluZxobCgVTbGFjaxISCgV0b2tlbhgBIAEoCUID4EEEGjYKBkZlaXNodRITCgZhcHBfaWQYASABKAlCA+BBBBIXCgphcHBfc2VjcmV0GAIgASgJQgPgQQQaSQoFV2Vjb20SFAoHY29ycF9pZBgBIAEoCUID4EEEEhUKCGFnZW50X2lkGAIgASgJQgPgQQQSEwoGc2VjcmV0GAMgASgJQgPgQQQaNAoETGFyaxITCgZhcHBfaWQYASABKAlCA+BBBBIXCgphcHBfc2VjcmV0GAIgASgJQgPgQQQaVwoIRGluZ1RhbGsSFgoJY2xpZW50X2lkGAEgASgJQgPgQQQSGgoNY2xpZW50X3NlY3JldBgCIAEoCUID4EEEEhcKCnJvYm90X2NvZGUYAyABKAlCA+BBBBq/AgoJSU1TZXR0aW5nEicKBHR5cGUYASABKA4yGS5ieXRlYmFzZS52MS5XZWJob29rLlR5cGUSMAoFc2xhY2sYAiABKAsyHy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuU2xhY2tIABIyCgZmZWlzaHUYAyABKAsyIC5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuRmVpc2h1SAASMAoFd2Vjb20YBCABKAsyHy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuV2Vjb21IABIuCgRsYXJrGAUgASgLMh4uYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLkxhcmtIABI2CghkaW5ndGFsaxgGIAEoCzIiLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZy5EaW5nVGFsa0gAQgkKB3BheWxvYWQikAQKF1dvcmtzcGFjZVByb2ZpbGVTZXR0aW5nEhQKDGV4dGVybmFsX3VybBgBIAEoCRIXCg9kaXNhbGxvd19zaWdudXAYAiABKAgSEwoLcmVxdWlyZV8yZmEYAyABKAgSMQoOdG9rZW5fZHVyYXRpb24YBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SLwoMYW5ub3VuY2VtZW50GAcgASgLMhkuYnl0ZWJhc2UudjEuQW5ub3VuY2VtZW50EjoKF21heGltdW1fcm9sZV9leHBpcmF0aW9uGAggASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEg8KB2RvbWFpbnMYCSADKAkSHwoXZW5mb3JjZV9pZGVudGl0eV9kb21haW4YCiABKAgSPQoUZGF0YWJhc2VfY2hhbmdlX21vZGUYCyABKA4yHy5ieXRlYmFzZS52MS5EYXRhYmFzZUNoYW5nZU1vZGUSIAoYZGlzYWxsb3dfcGFzc3dvcmRfc2lnbmluGAwgASgIEiAKGGVuYWJsZV9tZXRyaWNfY29sbGVjdGlvbhgNIAEoCBI7ChhpbmFjdGl2ZV9zZXNzaW9uX3RpbWVvdXQYDiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SHwoXZW5hYmxlX2F1ZGl0X2xvZ19zdGRvdXQYDyABKAgirwEKDEFubm91bmNlbWVudBIzCgVsZXZlbBgBIAEoDjIkLmJ5dGViYXNlLnYxLkFubm91bmNlbWVudC5BbGVydExldmVsEgwKBHRleHQYAiABKAkSDAoEbGluaxgDIAEoCSJOCgpBbGVydExldmVsEhsKF0FMRVJUX0xFVkVMX1VOU1BFQ0lGSUVEEAASCAoESU5GTxABEgsKB1dBUk5JTkcQAhIMCghDUklUSUNBTBADIrQBChhXb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcSOQoFcnVsZXMYASADKAsyKi5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VBcHByb3ZhbFNldHRpbmcuUnVsZRpdCgRSdWxlEi8KCHRlbXBsYXRlGAEgASgLMh0uYnl0ZWJhc2UudjEuQXBwcm92YWxUZW1wbGF0ZRIkCgljb25kaXRpb24YAiABKAsyES5nb29nbGUudHlwZS5FeHByIqAFChVTY2hlbWFUZW1wbGF0ZVNldHRpbmcSSQoPZmllbGRfdGVtcGxhdGVzGAEgAygLMjAuYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nLkZpZWxkVGVtcGxhdGUSQwoMY29sdW1uX3R5cGVzGAIgAygLMi0uYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nLkNvbHVtblR5cGUSSQoPdGFibGVfdGVtcGxhdGVzGAMgAygLMjAuYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nLlRhYmxlVGVtcGxhdGUarAEKDUZpZWxkVGVtcGxhdGUSCgoCaWQYASABKAkSIwoGZW5naW5lGAIgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhAKCGNhdGVnb3J5GAMgASgJEisKBmNvbHVtbhgEIAEoCzIbLmJ5dGViYXNlLnYxLkNvbHVtbk1ldGFkYXRhEisKB2NhdGFsb2cYBSABKAsyGi5ieXRlYmFzZS52MS5Db2x1bW5DYXRhbG9nGlEKCkNvbHVtblR5cGUSIwoGZW5naW5lGAEgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEg8KB2VuYWJsZWQYAiABKAgSDQoFdHlwZXMYAyADKAkaqQEKDVRhYmxlVGVtcGxhdGUSCgoCaWQYASABKAkSIwoGZW5naW5lGAIgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhAKCGNhdGVnb3J5GAMgASgJEikKBXRhYmxlGAQgASgLMhouYnl0ZWJhc2UudjEuVGFibGVNZXRhZGF0YRIqCgdjYXRhbG9nGAUgASgLMhkuYnl0ZWJhc2UudjEuVGFibGVDYXRhbG9nIrwFChlEYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nElAKB2NvbmZpZ3MYASADKAsyPy5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nLkRhdGFDbGFzc2lmaWNhdGlvbkNvbmZpZxrMBAoYRGF0YUNsYXNzaWZpY2F0aW9uQ29uZmlnEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJElUKBmxldmVscxgDIAMoCzJFLmJ5dGViYXNlLnYxLkRhdGFDbGFzc2lmaWNhdGlvblNldHRpbmcuRGF0YUNsYXNzaWZpY2F0aW9uQ29uZmlnLkxldmVsEmsKDmNsYXNzaWZpY2F0aW9uGAQgAygLMlMuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZy5EYXRhQ2xhc3NpZmljYXRpb25Db25maWcuQ2xhc3NpZmljYXRpb25FbnRyeRIiChpjbGFzc2lmaWNhdGlvbl9mcm9tX2NvbmZpZxgFIAEoCBo3CgVMZXZlbBIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRpoChJEYXRhQ2xhc3NpZmljYXRpb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSFQoIbGV2ZWxfaWQYBCABKAlIAIgBAUILCglfbGV2ZWxfaWQaiQEKE0NsYXNzaWZpY2F0aW9uRW50cnkSCwoDa2V5GAEgASgJEmEKBXZhbHVlGAIgASgLMlIuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZy5EYXRhQ2xhc3NpZmljYXRpb25Db25maWcuRGF0YUNsYXNzaWZpY2F0aW9uOgI4ASLMAQoTU2VtYW50aWNUeXBlU2V0dGluZxI8CgV0eXBlcxgBIAMoCzItLmJ5dGViYXNlLnYxLlNlbWFudGljVHlwZVNldHRpbmcuU2VtYW50aWNUeXBlGncKDFNlbWFudGljVHlwZRIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIpCglhbGdvcml0aG0YBiABKAsyFi5ieXRlYmFzZS52MS5BbGdvcml0aG0SDAoEaWNvbhgHIAEoCSL/BAoJQWxnb3JpdGhtEjQKCWZ1bGxfbWFzaxgFIAEoCzIfLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5GdWxsTWFza0gAEjYKCnJhbmdlX21hc2sYBiABKAsyIC5ieXRlYmFzZS52MS5BbGdvcml0aG0uUmFuZ2VNYXNrSAASMgoIbWQ1X21hc2sYByABKAsyHi5ieXRlYmFzZS52MS5BbGdvcml0aG0uTUQ1TWFza0gAEkEKEGlubmVyX291dGVyX21hc2sYCCABKAsyJS5ieXRlYmFzZS52MS5BbGdvcml0aG0uSW5uZXJPdXRlck1hc2tIABogCghGdWxsTWFzaxIUCgxzdWJzdGl0dXRpb24YASABKAkafgoJUmFuZ2VNYXNrEjYKBnNsaWNlcxgBIAMoCzImLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5SYW5nZU1hc2suU2xpY2UaOQoFU2xpY2USDQoFc3RhcnQYASABKAUSCwoDZW5kGAIgASgFEhQKDHN1YnN0aXR1dGlvbhgDIAEoCRoXCgdNRDVNYXNrEgwKBHNhbHQYASABKAkayQEKDklubmVyT3V0ZXJNYXNrEhIKCnByZWZpeF9sZW4YASABKAUSEgoKc3VmZml4X2xlbhgCIAEoBRI8CgR0eXBlGAMgASgOMi4uYnl0ZWJhc2UudjEuQWxnb3JpdGhtLklubmVyT3V0ZXJNYXNrLk1hc2tUeXBlEhQKDHN1YnN0aXR1dGlvbhgEIAEoCSI7CghNYXNrVHlwZRIZChVNQVNLX1RZUEVfVU5TUEVDSUZJRUQQABIJCgVJTk5FUhABEgkKBU9VVEVSEAJCBgoEbWFzayIcCgtTQ0lNU2V0dGluZxINCgV0b2tlbhgBIAEoCSKLAgoaUGFzc3dvcmRSZXN0cmljdGlvblNldHRpbmcSEgoKbWluX2xlbmd0aBgBIAEoBRIWCg5yZXF1aXJlX251bWJlchgCIAEoCBIWCg5yZXF1aXJlX2xldHRlchgDIAEoCBIgChhyZXF1aXJlX3VwcGVyY2FzZV9sZXR0ZXIYBCABKAgSIQoZcmVxdWlyZV9zcGVjaWFsX2NoYXJhY3RlchgFIAEoCBIuCiZyZXF1aXJlX3Jlc2V0X3Bhc3N3b3JkX2Zvcl9maXJzdF9sb2dpbhgGIAEoCBI0ChFwYXNzd29yZF9yb3RhdGlvbhgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbiLvAQoJQUlTZXR0aW5nEg8KB2VuYWJsZWQYASABKAgSMQoIcHJvdmlkZXIYAiABKA4yHy5ieXRlYmFzZS52MS5BSVNldHRpbmcuUHJvdmlkZXISEAoIZW5kcG9pbnQYAyABKAkSDwoHYXBpX2tleRgEIAEoCRINCgVtb2RlbBgFIAEoCRIPCgd2ZXJzaW9uGAYgASgJIlsKCFByb3ZpZGVyEhgKFFBST1ZJREVSX1VOU1BFQ0lGSUVEEAASCwoHT1BFTl9BSRABEgoKBkNMQVVERRACEgoKBkdFTUlOSRADEhAKDEFaVVJFX09QRU5BSRAEIpYCChJFbnZpcm9ubWVudFNldHRpbmcSQQoMZW52aXJvbm1lbnRzGAEgAygLMisuYnl0ZWJhc2UudjEuRW52aXJvbm1lbnRTZXR0aW5nLkVudmlyb25tZW50GrwBCgtFbnZpcm9ubWVudBIRCgRuYW1lGAEgASgJQgPgQQMSCgoCaWQYAiABKAkSDQoFdGl0bGUYAyABKAkSQwoEdGFncxgEIAMoCzI1LmJ5dGViYXNlLnYxLkVudmlyb25tZW50U2V0dGluZy5FbnZpcm9ubWVudC5UYWdzRW50cnkSDQoFY29sb3IYBSABKAkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEqVAoSRGF0YWJhc2VDaGFuZ2VNb2RlEiQKIERBVEFCQVNFX0NIQU5HRV9NT0RFX1VOU1BFQ0lGSUVEEAASDAoIUElQRUxJTkUQARIKCgZFRElUT1IQAjKuAwoOU2V0dGluZ1NlcnZpY2UShAEKDExpc3RTZXR0aW5ncxIgLmJ5dGViYXNlLnYxLkxpc3RTZXR0aW5nc1JlcXVlc3QaIS5ieXRlYmFzZS52MS5MaXN0U2V0dGluZ3NSZXNwb25zZSIv2kEAiuowEGJiLnNldHRpbmdzLmxpc3SQ6jABgtPkkwIOEgwvdjEvc2V0dGluZ3MSfwoKR2V0U2V0dGluZxIeLmJ5dGViYXNlLnYxLkdldFNldHRpbmdSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuU2V0dGluZyI72kEEbmFtZYrqMA9iYi5zZXR0aW5ncy5nZXSQ6jABgtPkkwIXEhUvdjEve25hbWU9c2V0dGluZ3MvKn0SkwEKDVVwZGF0ZVNldHRpbmcSIS5ieXRlYmFzZS52MS5VcGRhdGVTZXR0aW5nUmVxdWVzdBoULmJ5dGViYXNlLnYxLlNldHRpbmciSYrqMA9iYi5zZXR0aW5ncy5zZXSQ6jABmOowAYLT5JMCKDoHc2V0dGluZzIdL3YxL3tzZXR0aW5nLm5hbWU9c2V0dGluZ3MvKn1CqQEKD2NvbS5ieXRlYmFzZS52MUITU2V0dGluZ1NlcnZpY2VQcm90b1ABWjRnaXRodWIuY29tL2J5dGViYXNlL2J5dGViYXNlL2JhY2tlbmQvZ2VuZXJhdGVkLWdvL3YxogIDQlhYqgILQnl0ZWJhc2UuVjHKAgtCeXRlYmFzZVxWMeICF0J5dGViYXNlXFYxXEdQQk1ldGFkYXRh6gIMQnl0ZWJhc2U6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_type_expr, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service, file_v1_issue_service, file_v1_project_service]);
+  fileDesc("Chh2MS9zZXR0aW5nX3NlcnZpY2UucHJvdG8SC2J5dGViYXNlLnYxIhUKE0xpc3RTZXR0aW5nc1JlcXVlc3QiPgoUTGlzdFNldHRpbmdzUmVzcG9uc2USJgoIc2V0dGluZ3MYASADKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIj8KEUdldFNldHRpbmdSZXF1ZXN0EioKBG5hbWUYASABKAlCHOBBAvpBFgoUYnl0ZWJhc2UuY29tL1NldHRpbmciOwoSR2V0U2V0dGluZ1Jlc3BvbnNlEiUKB3NldHRpbmcYASABKAsyFC5ieXRlYmFzZS52MS5TZXR0aW5nIqEBChRVcGRhdGVTZXR0aW5nUmVxdWVzdBIqCgdzZXR0aW5nGAEgASgLMhQuYnl0ZWJhc2UudjEuU2V0dGluZ0ID4EECEhUKDXZhbGlkYXRlX29ubHkYAiABKAgSFQoNYWxsb3dfbWlzc2luZxgDIAEoCBIvCgt1cGRhdGVfbWFzaxgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2si9AMKB1NldHRpbmcSDAoEbmFtZRgBIAEoCRIhCgV2YWx1ZRgCIAEoCzISLmJ5dGViYXNlLnYxLlZhbHVlIoIDCgtTZXR0aW5nTmFtZRIcChhTRVRUSU5HX05BTUVfVU5TUEVDSUZJRUQQABIPCgtBVVRIX1NFQ1JFVBABEhEKDUJSQU5ESU5HX0xPR08QAhIQCgxXT1JLU1BBQ0VfSUQQAxIVChFXT1JLU1BBQ0VfUFJPRklMRRAEEhYKEldPUktTUEFDRV9BUFBST1ZBTBAFEh8KG1dPUktTUEFDRV9FWFRFUk5BTF9BUFBST1ZBTBAGEhYKEkVOVEVSUFJJU0VfTElDRU5TRRAHEgoKBkFQUF9JTRAIEg0KCVdBVEVSTUFSSxAJEgYKAkFJEAoSEwoPU0NIRU1BX1RFTVBMQVRFEA0SFwoTREFUQV9DTEFTU0lGSUNBVElPThAOEhIKDlNFTUFOVElDX1RZUEVTEA8SCAoEU0NJTRAREhgKFFBBU1NXT1JEX1JFU1RSSUNUSU9OEBISDwoLRU5WSVJPTk1FTlQQExIJCgVFTUFJTBAUEhIKDkFVRElUX0xPR19TSU5LEBU6LepBKgoUYnl0ZWJhc2UuY29tL1NldHRpbmcSEnNldHRpbmdzL3tzZXR0aW5nfUoECBAQESLFBwoFVmFsdWUSFgoMc3RyaW5nX3ZhbHVlGAEgASgJSAASOQoUYXBwX2ltX3NldHRpbmdfdmFsdWUYAyABKAsyGS5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmdIABJPCh93b3Jrc3BhY2VfcHJvZmlsZV9zZXR0aW5nX3ZhbHVlGAUgASgLMiQuYnl0ZWJhc2UudjEuV29ya3NwYWNlUHJvZmlsZVNldHRpbmdIABJRCiB3b3Jrc3BhY2VfYXBwcm92YWxfc2V0dGluZ192YWx1ZRgGIAEoCzIlLmJ5dGViYXNlLnYxLldvcmtzcGFjZUFwcHJvdmFsU2V0dGluZ0gAEksKHXNjaGVtYV90ZW1wbGF0ZV9zZXR0aW5nX3ZhbHVlGAkgASgLMiIuYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nSAASUwohZGF0YV9jbGFzc2lmaWNhdGlvbl9zZXR0aW5nX3ZhbHVlGAogASgLMiYuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZ0gAEkcKG3NlbWFudGljX3R5cGVfc2V0dGluZ192YWx1ZRgLIAEoCzIgLmJ5dGViYXNlLnYxLlNlbWFudGljVHlwZVNldHRpbmdIABIwCgxzY2ltX3NldHRpbmcYDiABKAsyGC5ieXRlYmFzZS52MS5TQ0lNU2V0dGluZ0gAEk8KHHBhc3N3b3JkX3Jlc3RyaWN0aW9uX3NldHRpbmcYDyABKAsyJy5ieXRlYmFzZS52MS5QYXNzd29yZFJlc3RyaWN0aW9uU2V0dGluZ0gAEiwKCmFpX3NldHRpbmcYECABKAsyFi5ieXRlYmFzZS52MS5BSVNldHRpbmdIABI+ChNlbnZpcm9ubWVudF9zZXR0aW5nGBEgASgLMh8uYnl0ZWJhc2UudjEuRW52aXJvbm1lbnRTZXR0aW5nSAASMgoNZW1haWxfc2V0dGluZxgTIAEoCzIZLmJ5dGViYXNlLnYxLkVtYWlsU2V0dGluZ0gAEmIKKXdvcmtzcGFjZV9leHRlcm5hbF9hcHByb3ZhbF9zZXR0aW5nX3ZhbHVlGBQgASgLMi0uYnl0ZWJhc2UudjEuV29ya3NwYWNlRXh0ZXJuYWxBcHByb3ZhbFNldHRpbmdIABJCChZhdWRpdF9sb2dfc2lua19zZXR0aW5nGBUgASgLMiAuYnl0ZWJhc2UudjEuQXVkaXRMb2dTaW5rU2V0dGluZ0gAQgcKBXZhbHVlSgQIEhATIvQFCgxBcHBJTVNldHRpbmcSNQoIc2V0dGluZ3MYASADKAsyIy5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuSU1TZXR0aW5nGjgKBVNsYWNrEhIKBXRva2VuGAEgASgJQgPgQQQSGwoOc2lnbmluZ19zZWNyZXQYAiABKAlCA+BBBBo2CgZGZWlzaHUSEwoGYXBwX2lkGAEgASgJQgPgQQQSFwoKYXBwX3NlY3JldBgCIAEoCUID4EEEGkkKBVdlY29tEhQKB2NvcnBfaWQYASABKAlCA+BBBBIVCghhZ2VudF9pZBgCIAEoCUID4EEEEhMKBnNlY3JldBgDIAEoCUID4EEEGlUKBExhcmsSEwoGYXBwX2lkGAEgASgJQgPgQQQSFwoKYXBwX3NlY3JldBgCIAEoCUID4EEEEh8KEnZlcmlmaWNhdGlvbl90b2tlbhgDIAEoCUID4EEEGlcKCERpbmdUYWxrEhYKCWNsaWVudF9pZBgBIAEoCUID4EEEEhoKDWNsaWVudF9zZWNyZXQYAiABKAlCA+BBBBIXCgpyb2JvdF9jb2RlGAMgASgJQgPgQQQavwIKCUlNU2V0dGluZxInCgR0eXBlGAEgASgOMhkuYnl0ZWJhc2UudjEuV2ViaG9vay5UeXBlEjAKBXNsYWNrGAIgASgLMh8uYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLlNsYWNrSAASMgoGZmVpc2h1GAMgASgLMiAuYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLkZlaXNodUgAEjAKBXdlY29tGAQgASgLMh8uYnl0ZWJhc2UudjEuQXBwSU1TZXR0aW5nLldlY29tSAASLgoEbGFyaxgFIAEoCzIeLmJ5dGViYXNlLnYxLkFwcElNU2V0dGluZy5MYXJrSAASNgoIZGluZ3RhbGsYBiABKAsyIi5ieXRlYmFzZS52MS5BcHBJTVNldHRpbmcuRGluZ1RhbGtIAEIJCgdwYXlsb2FkIpAEChdXb3Jrc3BhY2VQcm9maWxlU2V0dGluZxIUCgxleHRlcm5hbF91cmwYASABKAkSFwoPZGlzYWxsb3dfc2lnbnVwGAIgASgIEhMKC3JlcXVpcmVfMmZhGAMgASgIEjEKDnRva2VuX2R1cmF0aW9uGAYgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEi8KDGFubm91bmNlbWVudBgHIAEoCzIZLmJ5dGViYXNlLnYxLkFubm91bmNlbWVudBI6ChdtYXhpbXVtX3JvbGVfZXhwaXJhdGlvbhgIIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIPCgdkb21haW5zGAkgAygJEh8KF2VuZm9yY2VfaWRlbnRpdHlfZG9tYWluGAogASgIEj0KFGRhdGFiYXNlX2NoYW5nZV9tb2RlGAsgASgOMh8uYnl0ZWJhc2UudjEuRGF0YWJhc2VDaGFuZ2VNb2RlEiAKGGRpc2FsbG93X3Bhc3N3b3JkX3NpZ25pbhgMIAEoCBIgChhlbmFibGVfbWV0cmljX2NvbGxlY3Rpb24YDSABKAgSOwoYaW5hY3RpdmVfc2Vzc2lvbl90aW1lb3V0GA4gASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEh8KF2VuYWJsZV9hdWRpdF9sb2dfc3Rkb3V0GA8gASgIIq8BCgxBbm5vdW5jZW1lbnQSMwoFbGV2ZWwYASABKA4yJC5ieXRlYmFzZS52MS5Bbm5vdW5jZW1lbnQuQWxlcnRMZXZlbBIMCgR0ZXh0GAIgASgJEgwKBGxpbmsYAyABKAkiTgoKQWxlcnRMZXZlbBIbChdBTEVSVF9MRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARILCgdXQVJOSU5HEAISDAoIQ1JJVElDQUwQAyK0AQoYV29ya3NwYWNlQXBwcm92YWxTZXR0aW5nEjkKBXJ1bGVzGAEgAygLMiouYnl0ZWJhc2UudjEuV29ya3NwYWNlQXBwcm92YWxTZXR0aW5nLlJ1bGUaXQoEUnVsZRIvCgh0ZW1wbGF0ZRgBIAEoCzIdLmJ5dGViYXNlLnYxLkFwcHJvdmFsVGVtcGxhdGUSJAoJY29uZGl0aW9uGAIgASgLMhEuZ29vZ2xlLnR5cGUuRXhwciLaAQogV29ya3NwYWNlRXh0ZXJuYWxBcHByb3ZhbFNldHRpbmcSQQoFbm9kZXMYASADKAsyMi5ieXRlYmFzZS52MS5Xb3Jrc3BhY2VFeHRlcm5hbEFwcHJvdmFsU2V0dGluZy5Ob2RlGnMKBE5vZGUSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEAoIZW5kcG9pbnQYAyABKAkSEgoFdG9rZW4YBCABKAlCA+BBBBIqCgd0aW1lb3V0GAUgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIqAFChVTY2hlbWFUZW1wbGF0ZVNldHRpbmcSSQoPZmllbGRfdGVtcGxhdGVzGAEgAygLMjAuYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nLkZpZWxkVGVtcGxhdGUSQwoMY29sdW1uX3R5cGVzGAIgAygLMi0uYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nLkNvbHVtblR5cGUSSQoPdGFibGVfdGVtcGxhdGVzGAMgAygLMjAuYnl0ZWJhc2UudjEuU2NoZW1hVGVtcGxhdGVTZXR0aW5nLlRhYmxlVGVtcGxhdGUarAEKDUZpZWxkVGVtcGxhdGUSCgoCaWQYASABKAkSIwoGZW5naW5lGAIgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhAKCGNhdGVnb3J5GAMgASgJEisKBmNvbHVtbhgEIAEoCzIbLmJ5dGViYXNlLnYxLkNvbHVtbk1ldGFkYXRhEisKB2NhdGFsb2cYBSABKAsyGi5ieXRlYmFzZS52MS5Db2x1bW5DYXRhbG9nGlEKCkNvbHVtblR5cGUSIwoGZW5naW5lGAEgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEg8KB2VuYWJsZWQYAiABKAgSDQoFdHlwZXMYAyADKAkaqQEKDVRhYmxlVGVtcGxhdGUSCgoCaWQYASABKAkSIwoGZW5naW5lGAIgASgOMhMuYnl0ZWJhc2UudjEuRW5naW5lEhAKCGNhdGVnb3J5GAMgASgJEikKBXRhYmxlGAQgASgLMhouYnl0ZWJhc2UudjEuVGFibGVNZXRhZGF0YRIqCgdjYXRhbG9nGAUgASgLMhkuYnl0ZWJhc2UudjEuVGFibGVDYXRhbG9nIrwFChlEYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nElAKB2NvbmZpZ3MYASADKAsyPy5ieXRlYmFzZS52MS5EYXRhQ2xhc3NpZmljYXRpb25TZXR0aW5nLkRhdGFDbGFzc2lmaWNhdGlvbkNvbmZpZxrMBAoYRGF0YUNsYXNzaWZpY2F0aW9uQ29uZmlnEgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJElUKBmxldmVscxgDIAMoCzJFLmJ5dGViYXNlLnYxLkRhdGFDbGFzc2lmaWNhdGlvblNldHRpbmcuRGF0YUNsYXNzaWZpY2F0aW9uQ29uZmlnLkxldmVsEmsKDmNsYXNzaWZpY2F0aW9uGAQgAygLMlMuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZy5EYXRhQ2xhc3NpZmljYXRpb25Db25maWcuQ2xhc3NpZmljYXRpb25FbnRyeRIiChpjbGFzc2lmaWNhdGlvbl9mcm9tX2NvbmZpZxgFIAEoCBo3CgVMZXZlbBIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRpoChJEYXRhQ2xhc3NpZmljYXRpb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSFQoIbGV2ZWxfaWQYBCABKAlIAIgBAUILCglfbGV2ZWxfaWQaiQEKE0NsYXNzaWZpY2F0aW9uRW50cnkSCwoDa2V5GAEgASgJEmEKBXZhbHVlGAIgASgLMlIuYnl0ZWJhc2UudjEuRGF0YUNsYXNzaWZpY2F0aW9uU2V0dGluZy5EYXRhQ2xhc3NpZmljYXRpb25Db25maWcuRGF0YUNsYXNzaWZpY2F0aW9uOgI4ASLMAQoTU2VtYW50aWNUeXBlU2V0dGluZxI8CgV0eXBlcxgBIAMoCzItLmJ5dGViYXNlLnYxLlNlbWFudGljVHlwZVNldHRpbmcuU2VtYW50aWNUeXBlGncKDFNlbWFudGljVHlwZRIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIpCglhbGdvcml0aG0YBiABKAsyFi5ieXRlYmFzZS52MS5BbGdvcml0aG0SDAoEaWNvbhgHIAEoCSLWCwoJQWxnb3JpdGhtEjQKCWZ1bGxfbWFzaxgFIAEoCzIfLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5GdWxsTWFza0gAEjYKCnJhbmdlX21hc2sYBiABKAsyIC5ieXRlYmFzZS52MS5BbGdvcml0aG0uUmFuZ2VNYXNrSAASMgoIbWQ1X21hc2sYByABKAsyHi5ieXRlYmFzZS52MS5BbGdvcml0aG0uTUQ1TWFza0gAEkEKEGlubmVyX291dGVyX21hc2sYCCABKAsyJS5ieXRlYmFzZS52MS5BbGdvcml0aG0uSW5uZXJPdXRlck1hc2tIABJiCiFmb3JtYXRfcHJlc2VydmluZ19lbmNyeXB0aW9uX21hc2sYCSABKAsyNS5ieXRlYmFzZS52MS5BbGdvcml0aG0uRm9ybWF0UHJlc2VydmluZ0VuY3J5cHRpb25NYXNrSAASRAoRdG9rZW5pemF0aW9uX21hc2sYCiABKAsyJy5ieXRlYmFzZS52MS5BbGdvcml0aG0uVG9rZW5pemF0aW9uTWFza0gAEkUKEmRhdGVfdHJ1bmNhdGVfbWFzaxgLIAEoCzInLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5EYXRlVHJ1bmNhdGVNYXNrSAASRwoTbnVtZXJpY19idWNrZXRfbWFzaxgMIAEoCzIoLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5OdW1lcmljQnVja2V0TWFza0gAEkUKEm51bWVyaWNfbm9pc2VfbWFzaxgNIAEoCzInLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5OdW1lcmljTm9pc2VNYXNrSAAaIAoIRnVsbE1hc2sSFAoMc3Vic3RpdHV0aW9uGAEgASgJGn4KCVJhbmdlTWFzaxI2CgZzbGljZXMYASADKAsyJi5ieXRlYmFzZS52MS5BbGdvcml0aG0uUmFuZ2VNYXNrLlNsaWNlGjkKBVNsaWNlEg0KBXN0YXJ0GAEgASgFEgsKA2VuZBgCIAEoBRIUCgxzdWJzdGl0dXRpb24YAyABKAkaFwoHTUQ1TWFzaxIMCgRzYWx0GAEgASgJGskBCg5Jbm5lck91dGVyTWFzaxISCgpwcmVmaXhfbGVuGAEgASgFEhIKCnN1ZmZpeF9sZW4YAiABKAUSPAoEdHlwZRgDIAEoDjIuLmJ5dGViYXNlLnYxLkFsZ29yaXRobS5Jbm5lck91dGVyTWFzay5NYXNrVHlwZRIUCgxzdWJzdGl0dXRpb24YBCABKAkiOwoITWFza1R5cGUSGQoVTUFTS19UWVBFX1VOU1BFQ0lGSUVEEAASCQoFSU5ORVIQARIJCgVPVVRFUhACGqsBCh5Gb3JtYXRQcmVzZXJ2aW5nRW5jcnlwdGlvbk1hc2sSSAoEbW9kZRgBIAEoDjI6LmJ5dGViYXNlLnYxLkFsZ29yaXRobS5Gb3JtYXRQcmVzZXJ2aW5nRW5jcnlwdGlvbk1hc2suTW9kZRINCgV0d2VhaxgCIAEoCSIwCgRNb2RlEhQKEE1PREVfVU5TUEVDSUZJRUQQABIHCgNGRjEQARIJCgVGRjNfMRACGiIKEFRva2VuaXphdGlvbk1hc2sSDgoGcHJlZml4GAEgASgJGrABChBEYXRlVHJ1bmNhdGVNYXNrEkgKC2dyYW51bGFyaXR5GAEgASgOMjMuYnl0ZWJhc2UudjEuQWxnb3JpdGhtLkRhdGVUcnVuY2F0ZU1hc2suR3JhbnVsYXJpdHkiUgoLR3JhbnVsYXJpdHkSGwoXR1JBTlVMQVJJVFlfVU5TUEVDSUZJRUQQABIICgRZRUFSEAESCQoFTU9OVEgQAhIHCgNEQVkQAxIICgRIT1VSEAQaKAoRTnVtZXJpY0J1Y2tldE1hc2sSEwoLYnVja2V0X3NpemUYASABKAEaJQoQTnVtZXJpY05vaXNlTWFzaxIRCgltYXhfbm9pc2UYASABKAFCBgoEbWFzayIcCgtTQ0lNU2V0dGluZxINCgV0b2tlbhgBIAEoCSKLAgoaUGFzc3dvcmRSZXN0cmljdGlvblNldHRpbmcSEgoKbWluX2xlbmd0aBgBIAEoBRIWCg5yZXF1aXJlX251bWJlchgCIAEoCBIWCg5yZXF1aXJlX2xldHRlchgDIAEoCBIgChhyZXF1aXJlX3VwcGVyY2FzZV9sZXR0ZXIYBCABKAgSIQoZcmVxdWlyZV9zcGVjaWFsX2NoYXJhY3RlchgFIAEoCBIuCiZyZXF1aXJlX3Jlc2V0X3Bhc3N3b3JkX2Zvcl9maXJzdF9sb2dpbhgGIAEoCBI0ChFwYXNzd29yZF9yb3RhdGlvbhgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbiLvAQoJQUlTZXR0aW5nEg8KB2VuYWJsZWQYASABKAgSMQoIcHJvdmlkZXIYAiABKA4yHy5ieXRlYmFzZS52MS5BSVNldHRpbmcuUHJvdmlkZXISEAoIZW5kcG9pbnQYAyABKAkSDwoHYXBpX2tleRgEIAEoCRINCgVtb2RlbBgFIAEoCRIPCgd2ZXJzaW9uGAYgASgJIlsKCFByb3ZpZGVyEhgKFFBST1ZJREVSX1VOU1BFQ0lGSUVEEAASCwoHT1BFTl9BSRABEgoKBkNMQVVERRACEgoKBkdFTUlOSRADEhAKDEFaVVJFX09QRU5BSRAEIvsBCgxFbWFpbFNldHRpbmcSDwoHZW5hYmxlZBgBIAEoCBIMCgRob3N0GAIgASgJEgwKBHBvcnQYAyABKAUSEAoIdXNlcm5hbWUYBCABKAkSFQoIcGFzc3dvcmQYBSABKAlCA+BBBBI4CgplbmNyeXB0aW9uGAYgASgOMiQuYnl0ZWJhc2UudjEuRW1haWxTZXR0aW5nLkVuY3J5cHRpb24SDAoEZnJvbRgHIAEoCSJNCgpFbmNyeXB0aW9uEhoKFkVOQ1JZUFRJT05fVU5TUEVDSUZJRUQQABIICgROT05FEAESDAoIU1RBUlRUTFMQAhILCgdTU0xfVExTEAMilgIKEkVudmlyb25tZW50U2V0dGluZxJBCgxlbnZpcm9ubWVudHMYASADKAsyKy5ieXRlYmFzZS52MS5FbnZpcm9ubWVudFNldHRpbmcuRW52aXJvbm1lbnQavAEKC0Vudmlyb25tZW50EhEKBG5hbWUYASABKAlCA+BBAxIKCgJpZBgCIAEoCRINCgV0aXRsZRgDIAEoCRJDCgR0YWdzGAQgAygLMjUuYnl0ZWJhc2UudjEuRW52aXJvbm1lbnRTZXR0aW5nLkVudmlyb25tZW50LlRhZ3NFbnRyeRINCgVjb2xvchgFIAEoCRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKVAwoTQXVkaXRMb2dTaW5rU2V0dGluZxI0CgVzaW5rcxgBIAMoCzIlLmJ5dGViYXNlLnYxLkF1ZGl0TG9nU2lua1NldHRpbmcuU2luaxrHAgoEU2luaxIKCgJpZBgBIAEoCRI4CgR0eXBlGAIgASgOMiouYnl0ZWJhc2UudjEuQXVkaXRMb2dTaW5rU2V0dGluZy5TaW5rLlR5cGUSEAoIZW5kcG9pbnQYAyABKAkSCwoDdGxzGAQgASgIEhIKBXRva2VuGAUgASgJQgPgQQQSPAoGZm9ybWF0GAYgASgOMiwuYnl0ZWJhc2UudjEuQXVkaXRMb2dTaW5rU2V0dGluZy5TaW5rLkZvcm1hdBIOCgZmaWx0ZXIYByABKAkiPAoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASCgoGU1lTTE9HEAESCAoESFRUUBACEggKBE9UTFAQAyI6CgZGb3JtYXQSFgoSRk9STUFUX1VOU1BFQ0lGSUVEEAASCAoESlNPThABEg4KClNQTFVOS19IRUMQAipUChJEYXRhYmFzZUNoYW5nZU1vZGUSJAogREFUQUJBU0VfQ0hBTkdFX01PREVfVU5TUEVDSUZJRUQQABIMCghQSVBFTElORRABEgoKBkVESVRPUhACMq4DCg5TZXR0aW5nU2VydmljZRKEAQoMTGlzdFNldHRpbmdzEiAuYnl0ZWJhc2UudjEuTGlzdFNldHRpbmdzUmVxdWVzdBohLmJ5dGViYXNlLnYxLkxpc3RTZXR0aW5nc1Jlc3BvbnNlIi/aQQCK6jAQYmIuc2V0dGluZ3MubGlzdJDqMAGC0+STAg4SDC92MS9zZXR0aW5ncxJ/CgpHZXRTZXR0aW5nEh4uYnl0ZWJhc2UudjEuR2V0U2V0dGluZ1JlcXVlc3QaFC5ieXRlYmFzZS52MS5TZXR0aW5nIjvaQQRuYW1liuowD2JiLnNldHRpbmdzLmdldJDqMAGC0+STAhcSFS92MS97bmFtZT1zZXR0aW5ncy8qfRKTAQoNVXBkYXRlU2V0dGluZxIhLmJ5dGViYXNlLnYxLlVwZGF0ZVNldHRpbmdSZXF1ZXN0GhQuYnl0ZWJhc2UudjEuU2V0dGluZyJJiuowD2JiLnNldHRpbmdzLnNldJDqMAGY6jABgtPkkwIoOgdzZXR0aW5nMh0vdjEve3NldHRpbmcubmFtZT1zZXR0aW5ncy8qfUKpAQoPY29tLmJ5dGViYXNlLnYxQhNTZXR0aW5nU2VydmljZVByb3RvUAFaNGdpdGh1Yi5jb20vYnl0ZWJhc2UvYnl0ZWJhc2UvYmFja2VuZC9nZW5lcmF0ZWQtZ28vdjGiAgNCWFiqAgtCeXRlYmFzZS5WMcoCC0J5dGViYXNlXFYx4gIXQnl0ZWJhc2VcVjFcR1BCTWV0YWRhdGHqAgxCeXRlYmFzZTo6VjFiBnByb3RvMw", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_duration, file_google_protobuf_field_mask, file_google_type_expr, file_v1_annotation, file_v1_common, file_v1_database_catalog_service, file_v1_database_service, file_v1_issue_service, file_v1_project_service]);
 
 /**
  * Describes the message bytebase.v1.ListSettingsRequest.
@@ -174,123 +174,137 @@ export const WorkspaceApprovalSettingSchema = /*@__PURE__*/
 export const WorkspaceApprovalSetting_RuleSchema = /*@__PURE__*/
   messageDesc(file_v1_setting_service, 10, 0);
 
+/**
+ * Describes the message bytebase.v1.WorkspaceExternalApprovalSetting.
+ * Use `create(WorkspaceExternalApprovalSettingSchema)` to create a new message.
+ */
+export const WorkspaceExternalApprovalSettingSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 11);
+
+/**
+ * Describes the message bytebase.v1.WorkspaceExternalApprovalSetting.Node.
+ * Use `create(WorkspaceExternalApprovalSetting_NodeSchema)` to create a new message.
+ */
+export const WorkspaceExternalApprovalSetting_NodeSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 11, 0);
+
 /**
  * Describes the message bytebase.v1.SchemaTemplateSetting.
  * Use `create(SchemaTemplateSettingSchema)` to create a new message.
  */
 export const SchemaTemplateSettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 11);
+  messageDesc(file_v1_setting_service, 12);
 
 /**
  * Describes the message bytebase.v1.SchemaTemplateSetting.FieldTemplate.
  * Use `create(SchemaTemplateSetting_FieldTemplateSchema)` to create a new message.
  */
 export const SchemaTemplateSetting_FieldTemplateSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 11, 0);
+  messageDesc(file_v1_setting_service, 12, 0);
 
 /**
  * Describes the message bytebase.v1.SchemaTemplateSetting.ColumnType.
  * Use `create(SchemaTemplateSetting_ColumnTypeSchema)` to create a new message.
  */
 export const SchemaTemplateSetting_ColumnTypeSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 11, 1);
+  messageDesc(file_v1_setting_service, 12, 1);
 
 /**
  * Describes the message bytebase.v1.SchemaTemplateSetting.TableTemplate.
  * Use `create(SchemaTemplateSetting_TableTemplateSchema)` to create a new message.
  */
 export const SchemaTemplateSetting_TableTemplateSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 11, 2);
+  messageDesc(file_v1_setting_service, 12, 2);
 
 /**
  * Describes the message bytebase.v1.DataClassificationSetting.
  * Use `create(DataClassificationSettingSchema)` to create a new message.
  */
 export const DataClassificationSettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 12);
+  messageDesc(file_v1_setting_service, 13);
 
 /**
  * Describes the message bytebase.v1.DataClassificationSetting.DataClassificationConfig.
  * Use `create(DataClassificationSetting_DataClassificationConfigSchema)` to create a new message.
  */
 export const DataClassificationSetting_DataClassificationConfigSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 12, 0);
+  messageDesc(file_v1_setting_service, 13, 0);
 
 /**
  * Describes the message bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level.
  * Use `create(DataClassificationSetting_DataClassificationConfig_LevelSchema)` to create a new message.
  */
 export const DataClassificationSetting_DataClassificationConfig_LevelSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 12, 0, 0);
+  messageDesc(file_v1_setting_service, 13, 0, 0);
 
 /**
  * Describes the message bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification.
  * Use `create(DataClassificationSetting_DataClassificationConfig_DataClassificationSchema)` to create a new message.
  */
 export const DataClassificationSetting_DataClassificationConfig_DataClassificationSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 12, 0, 1);
+  messageDesc(file_v1_setting_service, 13, 0, 1);
 
 /**
  * Describes the message bytebase.v1.SemanticTypeSetting.
  * Use `create(SemanticTypeSettingSchema)` to create a new message.
  */
 export const SemanticTypeSettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 13);
+  messageDesc(file_v1_setting_service, 14);
 
 /**
  * Describes the message bytebase.v1.SemanticTypeSetting.SemanticType.
  * Use `create(SemanticTypeSetting_SemanticTypeSchema)` to create a new message.
  */
 export const SemanticTypeSetting_SemanticTypeSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 13, 0);
+  messageDesc(file_v1_setting_service, 14, 0);
 
 /**
  * Describes the message bytebase.v1.Algorithm.
  * Use `create(AlgorithmSchema)` to create a new message.
  */
 export const AlgorithmSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 14);
+  messageDesc(file_v1_setting_service, 15);
 
 /**
  * Describes the message bytebase.v1.Algorithm.FullMask.
  * Use `create(Algorithm_FullMaskSchema)` to create a new message.
  */
 export const Algorithm_FullMaskSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 14, 0);
+  messageDesc(file_v1_setting_service, 15, 0);
 
 /**
  * Describes the message bytebase.v1.Algorithm.RangeMask.
  * Use `create(Algorithm_RangeMaskSchema)` to create a new message.
  */
 export const Algorithm_RangeMaskSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 14, 1);
+  messageDesc(file_v1_setting_service, 15, 1);
 
 /**
  * Describes the message bytebase.v1.Algorithm.RangeMask.Slice.
  * Use `create(Algorithm_RangeMask_SliceSchema)` to create a new message.
  */
 export const Algorithm_RangeMask_SliceSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 14, 1, 0);
+  messageDesc(file_v1_setting_service, 15, 1, 0);
 
 /**
  * Describes the message bytebase.v1.Algorithm.MD5Mask.
  * Use `create(Algorithm_MD5MaskSchema)` to create a new message.
  */
 export const Algorithm_MD5MaskSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 14, 2);
+  messageDesc(file_v1_setting_service, 15, 2);
 
 /**
  * Describes the message bytebase.v1.Algorithm.InnerOuterMask.
  * Use `create(Algorithm_InnerOuterMaskSchema)` to create a new message.
  */
 export const Algorithm_InnerOuterMaskSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 14, 3);
+  messageDesc(file_v1_setting_service, 15, 3);
 
 /**
  * Describes the enum bytebase.v1.Algorithm.InnerOuterMask.MaskType.
  */
 export const Algorithm_InnerOuterMask_MaskTypeSchema = /*@__PURE__*/
-  enumDesc(file_v1_setting_service, 14, 3, 0);
+  enumDesc(file_v1_setting_service, 15, 3, 0);
 
 /**
  * @generated from enum bytebase.v1.Algorithm.InnerOuterMask.MaskType
@@ -298,32 +312,91 @@ export const Algorithm_InnerOuterMask_MaskTypeSchema = /*@__PURE__*/
 export const Algorithm_InnerOuterMask_MaskType = /*@__PURE__*/
   tsEnum(Algorithm_InnerOuterMask_MaskTypeSchema);
 
+/**
+ * Describes the message bytebase.v1.Algorithm.FormatPreservingEncryptionMask.
+ * Use `create(Algorithm_FormatPreservingEncryptionMaskSchema)` to create a new message.
+ */
+export const Algorithm_FormatPreservingEncryptionMaskSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 15, 4);
+
+/**
+ * Describes the enum bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode.
+ */
+export const Algorithm_FormatPreservingEncryptionMask_ModeSchema = /*@__PURE__*/
+  enumDesc(file_v1_setting_service, 15, 4, 0);
+
+/**
+ * @generated from enum bytebase.v1.Algorithm.FormatPreservingEncryptionMask.Mode
+ */
+export const Algorithm_FormatPreservingEncryptionMask_Mode = /*@__PURE__*/
+  tsEnum(Algorithm_FormatPreservingEncryptionMask_ModeSchema);
+
+/**
+ * Describes the message bytebase.v1.Algorithm.TokenizationMask.
+ * Use `create(Algorithm_TokenizationMaskSchema)` to create a new message.
+ */
+export const Algorithm_TokenizationMaskSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 15, 5);
+
+/**
+ * Describes the message bytebase.v1.Algorithm.DateTruncateMask.
+ * Use `create(Algorithm_DateTruncateMaskSchema)` to create a new message.
+ */
+export const Algorithm_DateTruncateMaskSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 15, 6);
+
+/**
+ * Describes the enum bytebase.v1.Algorithm.DateTruncateMask.Granularity.
+ */
+export const Algorithm_DateTruncateMask_GranularitySchema = /*@__PURE__*/
+  enumDesc(file_v1_setting_service, 15, 6, 0);
+
+/**
+ * @generated from enum bytebase.v1.Algorithm.DateTruncateMask.Granularity
+ */
+export const Algorithm_DateTruncateMask_Granularity = /*@__PURE__*/
+  tsEnum(Algorithm_DateTruncateMask_GranularitySchema);
+
+/**
+ * Describes the message bytebase.v1.Algorithm.NumericBucketMask.
+ * Use `create(Algorithm_NumericBucketMaskSchema)` to create a new message.
+ */
+export const Algorithm_NumericBucketMaskSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 15, 7);
+
+/**
+ * Describes the message bytebase.v1.Algorithm.NumericNoiseMask.
+ * Use `create(Algorithm_NumericNoiseMaskSchema)` to create a new message.
+ */
+export const Algorithm_NumericNoiseMaskSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 15, 8);
+
 /**
  * Describes the message bytebase.v1.SCIMSetting.
  * Use `create(SCIMSettingSchema)` to create a new message.
  */
 export const SCIMSettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 15);
+  messageDesc(file_v1_setting_service, 16);
 
 /**
  * Describes the message bytebase.v1.PasswordRestrictionSetting.
  * Use `create(PasswordRestrictionSettingSchema)` to create a new message.
  */
 export const PasswordRestrictionSettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 16);
+  messageDesc(file_v1_setting_service, 17);
 
 /**
  * Describes the message bytebase.v1.AISetting.
  * Use `create(AISettingSchema)` to create a new message.
  */
 export const AISettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 17);
+  messageDesc(file_v1_setting_service, 18);
 
 /**
  * Describes the enum bytebase.v1.AISetting.Provider.
  */
 export const AISetting_ProviderSchema = /*@__PURE__*/
-  enumDesc(file_v1_setting_service, 17, 0);
+  enumDesc(file_v1_setting_service, 18, 0);
 
 /**
  * @generated from enum bytebase.v1.AISetting.Provider
@@ -331,19 +404,76 @@ export const AISetting_ProviderSchema = /*@__PURE__*/
 export const AISetting_Provider = /*@__PURE__*/
   tsEnum(AISetting_ProviderSchema);
 
+/**
+ * Describes the message bytebase.v1.EmailSetting.
+ * Use `create(EmailSettingSchema)` to create a new message.
+ */
+export const EmailSettingSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 19);
+
+/**
+ * Describes the enum bytebase.v1.EmailSetting.Encryption.
+ */
+export const EmailSetting_EncryptionSchema = /*@__PURE__*/
+  enumDesc(file_v1_setting_service, 19, 0);
+
+/**
+ * @generated from enum bytebase.v1.EmailSetting.Encryption
+ */
+export const EmailSetting_Encryption = /*@__PURE__*/
+  tsEnum(EmailSetting_EncryptionSchema);
+
 /**
  * Describes the message bytebase.v1.EnvironmentSetting.
  * Use `create(EnvironmentSettingSchema)` to create a new message.
  */
 export const EnvironmentSettingSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 18);
+  messageDesc(file_v1_setting_service, 20);
 
 /**
  * Describes the message bytebase.v1.EnvironmentSetting.Environment.
  * Use `create(EnvironmentSetting_EnvironmentSchema)` to create a new message.
  */
 export const EnvironmentSetting_EnvironmentSchema = /*@__PURE__*/
-  messageDesc(file_v1_setting_service, 18, 0);
+  messageDesc(file_v1_setting_service, 20, 0);
+
+/**
+ * Describes the message bytebase.v1.AuditLogSinkSetting.
+ * Use `create(AuditLogSinkSettingSchema)` to create a new message.
+ */
+export const AuditLogSinkSettingSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 21);
+
+/**
+ * Describes the message bytebase.v1.AuditLogSinkSetting.Sink.
+ * Use `create(AuditLogSinkSetting_SinkSchema)` to create a new message.
+ */
+export const AuditLogSinkSetting_SinkSchema = /*@__PURE__*/
+  messageDesc(file_v1_setting_service, 21, 0);
+
+/**
+ * Describes the enum bytebase.v1.AuditLogSinkSetting.Sink.Type.
+ */
+export const AuditLogSinkSetting_Sink_TypeSchema = /*@__PURE__*/
+  enumDesc(file_v1_setting_service, 21, 0, 0);
+
+/**
+ * @generated from enum bytebase.v1.AuditLogSinkSetting.Sink.Type
+ */
+export const AuditLogSinkSetting_Sink_Type = /*@__PURE__*/
+  tsEnum(AuditLogSinkSetting_Sink_TypeSchema);
+
+/**
+ * Describes the enum bytebase.v1.AuditLogSinkSetting.Sink.Format.
+ */
+export const AuditLogSinkSetting_Sink_FormatSchema = /*@__PURE__*/
+  enumDesc(file_v1_setting_service, 21, 0, 1);
+
+/**
+ * @generated from enum bytebase.v1.AuditLogSinkSetting.Sink.Format
+ */
+export const AuditLogSinkSetting_Sink_Format = /*@__PURE__*/
+  tsEnum(AuditLogSinkSetting_Sink_FormatSchema);
 
 /**
  * Describes the enum bytebase.v1.DatabaseChangeMode.
@@ -1073,6 +1073,7 @@ export declare const ExportRequestSchema: GenMessage<ExportRequest>;
 export declare type ExportResponse = Message<"bytebase.v1.ExportResponse"> & {
   /**
    * The export file content.
+   * It's a chunk of the file content in ExportStream.
    *
    * @generated from field: bytes content = 1;
    */
@@ -1153,6 +1154,56 @@ export declare type DiffMetadataResponse = Message<"bytebase.v1.DiffMetadataResp
  */
 export declare const DiffMetadataResponseSchema: GenMessage<DiffMetadataResponse>;
 
+/**
+ * @generated from message bytebase.v1.FormatRequest
+ */
+export declare type FormatRequest = Message<"bytebase.v1.FormatRequest"> & {
+  /**
+   * The database engine of the statement.
+   *
+   * @generated from field: bytebase.v1.Engine engine = 1;
+   */
+  engine: Engine;
+
+  /**
+   * The statement to format.
+   *
+   * @generated from field: string statement = 2;
+   */
+  statement: string;
+
+  /**
+   * The string of one indentation level. Defaults to two spaces.
+   *
+   * @generated from field: string indent = 3;
+   */
+  indent: string;
+};
+
+/**
+ * Describes the message bytebase.v1.FormatRequest.
+ * Use `create(FormatRequestSchema)` to create a new message.
+ */
+export declare const FormatRequestSchema: GenMessage<FormatRequest>;
+
+/**
+ * @generated from message bytebase.v1.FormatResponse
+ */
+export declare type FormatResponse = Message<"bytebase.v1.FormatResponse"> & {
+  /**
+   * The formatted statement.
+   *
+   * @generated from field: string statement = 1;
+   */
+  statement: string;
+};
+
+/**
+ * Describes the message bytebase.v1.FormatResponse.
+ * Use `create(FormatResponseSchema)` to create a new message.
+ */
+export declare const FormatResponseSchema: GenMessage<FormatResponse>;
+
 /**
  * @generated from message bytebase.v1.SearchQueryHistoriesRequest
  */
@@ -1282,6 +1333,13 @@ export declare type QueryHistory = Message<"bytebase.v1.QueryHistory"> & {
    * @generated from field: bytebase.v1.QueryHistory.Type type = 8;
    */
   type: QueryHistory_Type;
+
+  /**
+   * The row filters applied to the query.
+   *
+   * @generated from field: repeated bytebase.v1.QueryHistory.RowFilter row_filters = 9;
+   */
+  rowFilters: QueryHistory_RowFilter[];
 };
 
 /**
@@ -1290,6 +1348,33 @@ export declare type QueryHistory = Message<"bytebase.v1.QueryHistory"> & {
  */
 export declare const QueryHistorySchema: GenMessage<QueryHistory>;
 
+/**
+ * A row filter applied to the query.
+ *
+ * @generated from message bytebase.v1.QueryHistory.RowFilter
+ */
+export declare type QueryHistory_RowFilter = Message<"bytebase.v1.QueryHistory.RowFilter"> & {
+  /**
+   * The table that the filter applied to, e.g. "public.orders".
+   *
+   * @generated from field: string table = 1;
+   */
+  table: string;
+
+  /**
+   * The SQL predicate of the filter.
+   *
+   * @generated from field: string predicate = 2;
+   */
+  predicate: string;
+};
+
+/**
+ * Describes the message bytebase.v1.QueryHistory.RowFilter.
+ * Use `create(QueryHistory_RowFilterSchema)` to create a new message.
+ */
+export declare const QueryHistory_RowFilterSchema: GenMessage<QueryHistory_RowFilter>;
+
 /**
  * @generated from enum bytebase.v1.QueryHistory.Type
  */
@@ -1477,6 +1562,18 @@ export declare const SQLService: GenService<{
     input: typeof ExportRequestSchema;
     output: typeof ExportResponseSchema;
   },
+  /**
+   * Exports query results to a file format, and streams the file content in chunks.
+   * The export of a database is not limited by the maximum result size.
+   * Permissions required: bb.databases.get
+   *
+   * @generated from rpc bytebase.v1.SQLService.ExportStream
+   */
+  exportStream: {
+    methodKind: "server_streaming";
+    input: typeof ExportRequestSchema;
+    output: typeof ExportResponseSchema;
+  },
   /**
    * Computes schema differences between two database metadata.
    * Permissions required: None
@@ -1488,6 +1585,17 @@ export declare const SQLService: GenService<{
     input: typeof DiffMetadataRequestSchema;
     output: typeof DiffMetadataResponseSchema;
   },
+  /**
+   * Formats SQL statements. Only the whitespaces are changed, the comments are preserved.
+   * Permissions required: None (authenticated users only)
+   *
+   * @generated from rpc bytebase.v1.SQLService.Format
+   */
+  format: {
+    methodKind: "unary";
+    input: typeof FormatRequestSchema;
+    output: typeof FormatResponseSchema;
+  },
   /**
    * Provides AI-powered SQL completion and generation.
    * Permissions required: None (authenticated users only, requires AI to be enabled)
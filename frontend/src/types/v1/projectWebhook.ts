@@ -160,5 +160,11 @@ export const projectWebhookV1ActivityItemList =
         activity: Activity_Type.NOTIFY_PIPELINE_ROLLOUT,
         supportDirectMessage: true,
       },
+      {
+        title: t("project.webhook.activity-item.notify-grant-expired.title"),
+        label: t("project.webhook.activity-item.notify-grant-expired.label"),
+        activity: Activity_Type.NOTIFY_GRANT_EXPIRED,
+        supportDirectMessage: true,
+      },
     ];
   };
@@ -21,6 +21,7 @@ export const CEL_ATTRIBUTE_STATEMENT_TEXT = "statement.text";
 
 // CEL attribute names for request scope.
 export const CEL_ATTRIBUTE_REQUEST_EXPIRATION_DAYS = "request.expiration_days";
+export const CEL_ATTRIBUTE_REQUEST_EXPIRATION_HOURS = "request.expiration_hours";
 export const CEL_ATTRIBUTE_REQUEST_ROLE = "request.role";
 export const CEL_ATTRIBUTE_REQUEST_TIME = "request.time";
 
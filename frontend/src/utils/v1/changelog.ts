@@ -119,6 +119,7 @@ export const getChangelogChangeType = (
       switch (migrationType) {
         case Changelog_MigrationType.DDL:
         case Changelog_MigrationType.GHOST:
+        case Changelog_MigrationType.PG_ONLINE:
           return "DDL";
         case Changelog_MigrationType.DML:
           return "DML";